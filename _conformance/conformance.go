@@ -30,7 +30,10 @@
 // OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
 
 // conformance implements the conformance test subprocess protocol as
-// documented in conformance.proto.
+// documented in conformance.proto, with the proto, jsonpb and text format
+// implementations of this repository. Run it under the conformance test
+// runner of the protobuf distribution with "make test"; the tests listed
+// in failure_list_go.txt are expected to fail.
 package main
 
 import (
@@ -108,6 +111,8 @@ func handle(req *pb.ConformanceRequest) *pb.ConformanceResponse {
 				},
 			}
 		}
+	case *pb.ConformanceRequest_TextPayload:
+		err = proto.UnmarshalText(p.TextPayload, &msg)
 	default:
 		return &pb.ConformanceResponse{
 			Result: &pb.ConformanceResponse_RuntimeError{
@@ -151,6 +156,18 @@ func handle(req *pb.ConformanceRequest) *pb.ConformanceResponse {
 				JsonPayload: p,
 			},
 		}
+	case pb.WireFormat_TEXT_FORMAT:
+		return &pb.ConformanceResponse{
+			Result: &pb.ConformanceResponse_TextPayload{
+				TextPayload: proto.MarshalTextString(&msg),
+			},
+		}
+	case pb.WireFormat_JSPB:
+		return &pb.ConformanceResponse{
+			Result: &pb.ConformanceResponse_Skipped{
+				Skipped: "JSPB is not supported",
+			},
+		}
 	default:
 		return &pb.ConformanceResponse{
 			Result: &pb.ConformanceResponse_RuntimeError{
@@ -15,15 +15,18 @@ It has these top-level messages:
 */
 package conformance
 
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import google_protobuf "github.com/golang/protobuf/ptypes/any"
-import google_protobuf1 "github.com/golang/protobuf/ptypes/duration"
-import google_protobuf2 "google.golang.org/genproto/protobuf"
-import google_protobuf3 "github.com/golang/protobuf/ptypes/struct"
-import google_protobuf4 "github.com/golang/protobuf/ptypes/timestamp"
-import google_protobuf5 "github.com/golang/protobuf/ptypes/wrappers"
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	google_protobuf "github.com/golang/protobuf/ptypes/any"
+	google_protobuf1 "github.com/golang/protobuf/ptypes/duration"
+	google_protobuf2 "github.com/golang/protobuf/ptypes/fieldmask"
+	google_protobuf3 "github.com/golang/protobuf/ptypes/struct"
+	google_protobuf4 "github.com/golang/protobuf/ptypes/timestamp"
+	google_protobuf5 "github.com/golang/protobuf/ptypes/wrappers"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -42,17 +45,23 @@ const (
 	WireFormat_UNSPECIFIED WireFormat = 0
 	WireFormat_PROTOBUF    WireFormat = 1
 	WireFormat_JSON        WireFormat = 2
+	WireFormat_JSPB        WireFormat = 3
+	WireFormat_TEXT_FORMAT WireFormat = 4
 )
 
 var WireFormat_name = map[int32]string{
 	0: "UNSPECIFIED",
 	1: "PROTOBUF",
 	2: "JSON",
+	3: "JSPB",
+	4: "TEXT_FORMAT",
 }
 var WireFormat_value = map[string]int32{
 	"UNSPECIFIED": 0,
 	"PROTOBUF":    1,
 	"JSON":        2,
+	"JSPB":        3,
+	"TEXT_FORMAT": 4,
 }
 
 func (x WireFormat) String() string {
@@ -117,12 +126,13 @@ func (TestAllTypes_NestedEnum) EnumDescriptor() ([]byte, []int) { return fileDes
 //   2. parse the protobuf or JSON payload in "payload" (which may fail)
 //   3. if the parse succeeded, serialize the message in the requested format.
 type ConformanceRequest struct {
-	// The payload (whether protobuf of JSON) is always for a TestAllTypes proto
-	// (see below).
+	// The payload (whether protobuf, JSON or text) is always for a
+	// TestAllTypes proto (see below).
 	//
 	// Types that are valid to be assigned to Payload:
 	//	*ConformanceRequest_ProtobufPayload
 	//	*ConformanceRequest_JsonPayload
+	//	*ConformanceRequest_TextPayload
 	Payload isConformanceRequest_Payload `protobuf_oneof:"payload"`
 	// Which format should the testee serialize its message to?
 	RequestedOutputFormat WireFormat `protobuf:"varint,3,opt,name=requested_output_format,json=requestedOutputFormat,enum=conformance.WireFormat" json:"requested_output_format,omitempty"`
@@ -143,9 +153,13 @@ type ConformanceRequest_ProtobufPayload struct {
 type ConformanceRequest_JsonPayload struct {
 	JsonPayload string `protobuf:"bytes,2,opt,name=json_payload,json=jsonPayload,oneof"`
 }
+type ConformanceRequest_TextPayload struct {
+	TextPayload string `protobuf:"bytes,8,opt,name=text_payload,json=textPayload,oneof"`
+}
 
 func (*ConformanceRequest_ProtobufPayload) isConformanceRequest_Payload() {}
 func (*ConformanceRequest_JsonPayload) isConformanceRequest_Payload()     {}
+func (*ConformanceRequest_TextPayload) isConformanceRequest_Payload()     {}
 
 func (m *ConformanceRequest) GetPayload() isConformanceRequest_Payload {
 	if m != nil {
@@ -168,6 +182,13 @@ func (m *ConformanceRequest) GetJsonPayload() string {
 	return ""
 }
 
+func (m *ConformanceRequest) GetTextPayload() string {
+	if x, ok := m.GetPayload().(*ConformanceRequest_TextPayload); ok {
+		return x.TextPayload
+	}
+	return ""
+}
+
 func (m *ConformanceRequest) GetRequestedOutputFormat() WireFormat {
 	if m != nil {
 		return m.RequestedOutputFormat
@@ -180,6 +201,7 @@ func (*ConformanceRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Bu
 	return _ConformanceRequest_OneofMarshaler, _ConformanceRequest_OneofUnmarshaler, _ConformanceRequest_OneofSizer, []interface{}{
 		(*ConformanceRequest_ProtobufPayload)(nil),
 		(*ConformanceRequest_JsonPayload)(nil),
+		(*ConformanceRequest_TextPayload)(nil),
 	}
 }
 
@@ -193,6 +215,9 @@ func _ConformanceRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) erro
 	case *ConformanceRequest_JsonPayload:
 		b.EncodeVarint(2<<3 | proto.WireBytes)
 		b.EncodeStringBytes(x.JsonPayload)
+	case *ConformanceRequest_TextPayload:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.TextPayload)
 	case nil:
 	default:
 		return fmt.Errorf("ConformanceRequest.Payload has unexpected type %T", x)
@@ -217,6 +242,13 @@ func _ConformanceRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *p
 		x, err := b.DecodeStringBytes()
 		m.Payload = &ConformanceRequest_JsonPayload{x}
 		return true, err
+	case 8: // payload.text_payload
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Payload = &ConformanceRequest_TextPayload{x}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -234,6 +266,10 @@ func _ConformanceRequest_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(2<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(len(x.JsonPayload)))
 		n += len(x.JsonPayload)
+	case *ConformanceRequest_TextPayload:
+		n += proto.SizeVarint(8<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.TextPayload)))
+		n += len(x.TextPayload)
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -249,6 +285,7 @@ type ConformanceResponse struct {
 	//	*ConformanceResponse_RuntimeError
 	//	*ConformanceResponse_ProtobufPayload
 	//	*ConformanceResponse_JsonPayload
+	//	*ConformanceResponse_TextPayload
 	//	*ConformanceResponse_Skipped
 	Result isConformanceResponse_Result `protobuf_oneof:"result"`
 }
@@ -277,6 +314,9 @@ type ConformanceResponse_ProtobufPayload struct {
 type ConformanceResponse_JsonPayload struct {
 	JsonPayload string `protobuf:"bytes,4,opt,name=json_payload,json=jsonPayload,oneof"`
 }
+type ConformanceResponse_TextPayload struct {
+	TextPayload string `protobuf:"bytes,8,opt,name=text_payload,json=textPayload,oneof"`
+}
 type ConformanceResponse_Skipped struct {
 	Skipped string `protobuf:"bytes,5,opt,name=skipped,oneof"`
 }
@@ -286,6 +326,7 @@ func (*ConformanceResponse_SerializeError) isConformanceResponse_Result()  {}
 func (*ConformanceResponse_RuntimeError) isConformanceResponse_Result()    {}
 func (*ConformanceResponse_ProtobufPayload) isConformanceResponse_Result() {}
 func (*ConformanceResponse_JsonPayload) isConformanceResponse_Result()     {}
+func (*ConformanceResponse_TextPayload) isConformanceResponse_Result()     {}
 func (*ConformanceResponse_Skipped) isConformanceResponse_Result()         {}
 
 func (m *ConformanceResponse) GetResult() isConformanceResponse_Result {
@@ -330,6 +371,13 @@ func (m *ConformanceResponse) GetJsonPayload() string {
 	return ""
 }
 
+func (m *ConformanceResponse) GetTextPayload() string {
+	if x, ok := m.GetResult().(*ConformanceResponse_TextPayload); ok {
+		return x.TextPayload
+	}
+	return ""
+}
+
 func (m *ConformanceResponse) GetSkipped() string {
 	if x, ok := m.GetResult().(*ConformanceResponse_Skipped); ok {
 		return x.Skipped
@@ -345,6 +393,7 @@ func (*ConformanceResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.B
 		(*ConformanceResponse_RuntimeError)(nil),
 		(*ConformanceResponse_ProtobufPayload)(nil),
 		(*ConformanceResponse_JsonPayload)(nil),
+		(*ConformanceResponse_TextPayload)(nil),
 		(*ConformanceResponse_Skipped)(nil),
 	}
 }
@@ -368,6 +417,9 @@ func _ConformanceResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) err
 	case *ConformanceResponse_JsonPayload:
 		b.EncodeVarint(4<<3 | proto.WireBytes)
 		b.EncodeStringBytes(x.JsonPayload)
+	case *ConformanceResponse_TextPayload:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.TextPayload)
 	case *ConformanceResponse_Skipped:
 		b.EncodeVarint(5<<3 | proto.WireBytes)
 		b.EncodeStringBytes(x.Skipped)
@@ -416,6 +468,13 @@ func _ConformanceResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *
 		x, err := b.DecodeStringBytes()
 		m.Result = &ConformanceResponse_JsonPayload{x}
 		return true, err
+	case 8: // result.text_payload
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Result = &ConformanceResponse_TextPayload{x}
+		return true, err
 	case 5: // result.skipped
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -452,6 +511,10 @@ func _ConformanceResponse_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(4<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(len(x.JsonPayload)))
 		n += len(x.JsonPayload)
+	case *ConformanceResponse_TextPayload:
+		n += proto.SizeVarint(8<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.TextPayload)))
+		n += len(x.TextPayload)
 	case *ConformanceResponse_Skipped:
 		n += proto.SizeVarint(5<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(len(x.Skipped)))
@@ -1709,177 +1772,178 @@ func init() {
 func init() { proto.RegisterFile("conformance_proto/conformance.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x5a, 0xd9, 0x72, 0xdb, 0xc8,
-	0xd5, 0x16, 0x08, 0x59, 0x4b, 0x93, 0x92, 0xa8, 0xd6, 0xd6, 0x96, 0x5d, 0x63, 0x58, 0xb2, 0x7f,
-	0xd3, 0xf6, 0x8c, 0xac, 0x05, 0x86, 0x65, 0xcf, 0x3f, 0x8e, 0x45, 0x9b, 0xb4, 0xe4, 0x8c, 0x25,
-	0x17, 0x64, 0x8d, 0xab, 0x9c, 0x0b, 0x06, 0xa6, 0x20, 0x15, 0xc7, 0x24, 0xc1, 0x01, 0x48, 0x4f,
-	0x94, 0xcb, 0xbc, 0x41, 0xf6, 0x7d, 0xbd, 0xcf, 0x7a, 0x93, 0xa4, 0x92, 0xab, 0x54, 0x6e, 0xb2,
-	0x27, 0x95, 0x3d, 0x79, 0x85, 0xbc, 0x43, 0x52, 0xbd, 0xa2, 0xbb, 0x01, 0x50, 0xf4, 0x54, 0x0d,
-	0x25, 0x1e, 0x7c, 0xfd, 0x9d, 0xd3, 0xe7, 0x1c, 0x7c, 0x2d, 0x1c, 0x18, 0x2c, 0xd7, 0x83, 0xf6,
-	0x51, 0x10, 0xb6, 0xbc, 0x76, 0xdd, 0xaf, 0x75, 0xc2, 0xa0, 0x1b, 0xdc, 0x90, 0x2c, 0x2b, 0xc4,
-	0x02, 0xf3, 0x92, 0x69, 0xf1, 0xec, 0x71, 0x10, 0x1c, 0x37, 0xfd, 0x1b, 0xe4, 0xd2, 0x8b, 0xde,
-	0xd1, 0x0d, 0xaf, 0x7d, 0x42, 0x71, 0x8b, 0x6f, 0xe8, 0x97, 0x0e, 0x7b, 0xa1, 0xd7, 0x6d, 0x04,
-	0x6d, 0x76, 0xdd, 0xd2, 0xaf, 0x1f, 0x35, 0xfc, 0xe6, 0x61, 0xad, 0xe5, 0x45, 0x2f, 0x19, 0xe2,
-	0xbc, 0x8e, 0x88, 0xba, 0x61, 0xaf, 0xde, 0x65, 0x57, 0x2f, 0xe8, 0x57, 0xbb, 0x8d, 0x96, 0x1f,
-	0x75, 0xbd, 0x56, 0x27, 0x2b, 0x80, 0x0f, 0x43, 0xaf, 0xd3, 0xf1, 0xc3, 0x88, 0x5e, 0x5f, 0xfa,
-	0x85, 0x01, 0xe0, 0xfd, 0x78, 0x2f, 0xae, 0xff, 0x41, 0xcf, 0x8f, 0xba, 0xf0, 0x3a, 0x28, 0xf2,
-	0x15, 0xb5, 0x8e, 0x77, 0xd2, 0x0c, 0xbc, 0x43, 0x64, 0x58, 0x46, 0xa9, 0xb0, 0x3d, 0xe4, 0x4e,
-	0xf1, 0x2b, 0x4f, 0xe8, 0x05, 0xb8, 0x0c, 0x0a, 0xef, 0x47, 0x41, 0x5b, 0x00, 0x73, 0x96, 0x51,
-	0x1a, 0xdf, 0x1e, 0x72, 0xf3, 0xd8, 0xca, 0x41, 0x7b, 0x60, 0x21, 0xa4, 0xe4, 0xfe, 0x61, 0x2d,
-	0xe8, 0x75, 0x3b, 0xbd, 0x6e, 0x8d, 0x78, 0xed, 0x22, 0xd3, 0x32, 0x4a, 0x93, 0xeb, 0x0b, 0x2b,
-	0x72, 0x9a, 0x9f, 0x35, 0x42, 0xbf, 0x4a, 0x2e, 0xbb, 0x73, 0x62, 0xdd, 0x1e, 0x59, 0x46, 0xcd,
-	0xe5, 0x71, 0x30, 0xca, 0x1c, 0x2e, 0x7d, 0x2a, 0x07, 0x66, 0x94, 0x4d, 0x44, 0x9d, 0xa0, 0x1d,
-	0xf9, 0xf0, 0x22, 0xc8, 0x77, 0xbc, 0x30, 0xf2, 0x6b, 0x7e, 0x18, 0x06, 0x21, 0xd9, 0x00, 0x8e,
-	0x0b, 0x10, 0x63, 0x05, 0xdb, 0xe0, 0x55, 0x30, 0x15, 0xf9, 0x61, 0xc3, 0x6b, 0x36, 0x3e, 0xc9,
-	0x61, 0x23, 0x0c, 0x36, 0x29, 0x2e, 0x50, 0xe8, 0x65, 0x30, 0x11, 0xf6, 0xda, 0x38, 0xc1, 0x0c,
-	0xc8, 0xf7, 0x59, 0x60, 0x66, 0x0a, 0x4b, 0x4b, 0x9d, 0x39, 0x68, 0xea, 0x86, 0xd3, 0x52, 0xb7,
-	0x08, 0x46, 0xa3, 0x97, 0x8d, 0x4e, 0xc7, 0x3f, 0x44, 0x67, 0xd8, 0x75, 0x6e, 0x28, 0x8f, 0x81,
-	0x91, 0xd0, 0x8f, 0x7a, 0xcd, 0xee, 0xd2, 0x7f, 0xaa, 0xa0, 0xf0, 0xd4, 0x8f, 0xba, 0x5b, 0xcd,
-	0xe6, 0xd3, 0x93, 0x8e, 0x1f, 0xc1, 0xcb, 0x60, 0x32, 0xe8, 0xe0, 0x5e, 0xf3, 0x9a, 0xb5, 0x46,
-	0xbb, 0xbb, 0xb1, 0x4e, 0x12, 0x70, 0xc6, 0x9d, 0xe0, 0xd6, 0x1d, 0x6c, 0xd4, 0x61, 0x8e, 0x4d,
-	0xf6, 0x65, 0x2a, 0x30, 0xc7, 0x86, 0x57, 0xc0, 0x94, 0x80, 0xf5, 0x28, 0x1d, 0xde, 0xd5, 0x84,
-	0x2b, 0x56, 0x1f, 0x10, 0x6b, 0x02, 0xe8, 0xd8, 0x64, 0x57, 0xc3, 0x2a, 0x50, 0x63, 0x8c, 0x28,
-	0x23, 0xde, 0xde, 0x74, 0x0c, 0xdc, 0x4f, 0x32, 0x46, 0x94, 0x11, 0xd7, 0x08, 0xaa, 0x40, 0xc7,
-	0x86, 0x57, 0x41, 0x51, 0x00, 0x8f, 0x1a, 0x9f, 0xf0, 0x0f, 0x37, 0xd6, 0xd1, 0xa8, 0x65, 0x94,
-	0x46, 0x5d, 0x41, 0x50, 0xa5, 0xe6, 0x24, 0xd4, 0xb1, 0xd1, 0x98, 0x65, 0x94, 0x46, 0x34, 0xa8,
-	0x63, 0xc3, 0xeb, 0x60, 0x3a, 0x76, 0xcf, 0x69, 0xc7, 0x2d, 0xa3, 0x34, 0xe5, 0x0a, 0x8e, 0x7d,
-	0x66, 0x4f, 0x01, 0x3b, 0x36, 0x02, 0x96, 0x51, 0x2a, 0xea, 0x60, 0xc7, 0x56, 0x52, 0x7f, 0xd4,
-	0x0c, 0xbc, 0x2e, 0xca, 0x5b, 0x46, 0x29, 0x17, 0xa7, 0xbe, 0x8a, 0x8d, 0xca, 0xfe, 0x0f, 0x83,
-	0xde, 0x8b, 0xa6, 0x8f, 0x0a, 0x96, 0x51, 0x32, 0xe2, 0xfd, 0x3f, 0x20, 0x56, 0xb8, 0x0c, 0xc4,
-	0xca, 0xda, 0x8b, 0x20, 0x68, 0xa2, 0x09, 0xcb, 0x28, 0x8d, 0xb9, 0x05, 0x6e, 0x2c, 0x07, 0x41,
-	0x53, 0xcd, 0x66, 0x37, 0x6c, 0xb4, 0x8f, 0xd1, 0x24, 0xee, 0x2a, 0x29, 0x9b, 0xc4, 0xaa, 0x44,
-	0xf7, 0xe2, 0xa4, 0xeb, 0x47, 0x68, 0x0a, 0xb7, 0x71, 0x1c, 0x5d, 0x19, 0x1b, 0x61, 0x0d, 0x2c,
-	0x08, 0x58, 0x9b, 0xde, 0xde, 0x2d, 0x3f, 0x8a, 0xbc, 0x63, 0x1f, 0x41, 0xcb, 0x28, 0xe5, 0xd7,
-	0xaf, 0x28, 0x37, 0xb6, 0xdc, 0xa2, 0x2b, 0xbb, 0x04, 0xff, 0x98, 0xc2, 0xdd, 0x39, 0xce, 0xa3,
-	0x98, 0xe1, 0x01, 0x40, 0x71, 0x96, 0x82, 0xd0, 0x6f, 0x1c, 0xb7, 0x85, 0x87, 0x19, 0xe2, 0xe1,
-	0x9c, 0xe2, 0xa1, 0x4a, 0x31, 0x9c, 0x75, 0x5e, 0x24, 0x53, 0xb1, 0xc3, 0xf7, 0xc0, 0xac, 0x1e,
-	0xb7, 0xdf, 0xee, 0xb5, 0xd0, 0x1c, 0x51, 0xa3, 0x4b, 0xa7, 0x05, 0x5d, 0x69, 0xf7, 0x5a, 0x2e,
-	0x54, 0x23, 0xc6, 0x36, 0xf8, 0x2e, 0x98, 0x4b, 0x84, 0x4b, 0x88, 0xe7, 0x09, 0x31, 0x4a, 0x8b,
-	0x95, 0x90, 0xcd, 0x68, 0x81, 0x12, 0x36, 0x47, 0x62, 0xa3, 0xd5, 0xaa, 0x75, 0x1a, 0x7e, 0xdd,
-	0x47, 0x08, 0xd7, 0xac, 0x9c, 0x1b, 0xcb, 0xc5, 0xeb, 0x68, 0xdd, 0x9e, 0xe0, 0xcb, 0xf0, 0x8a,
-	0xd4, 0x0a, 0xf5, 0x20, 0x3c, 0x44, 0x67, 0x19, 0xde, 0x88, 0xdb, 0xe1, 0x7e, 0x10, 0x1e, 0xc2,
-	0x2a, 0x98, 0x0e, 0xfd, 0x7a, 0x2f, 0x8c, 0x1a, 0xaf, 0x7c, 0x91, 0xd6, 0x73, 0x24, 0xad, 0x67,
-	0x33, 0x73, 0xe0, 0x16, 0xc5, 0x1a, 0x9e, 0xce, 0xcb, 0x60, 0x32, 0xf4, 0x3b, 0xbe, 0x87, 0xf3,
-	0x48, 0x6f, 0xe6, 0x0b, 0x96, 0x89, 0xd5, 0x86, 0x5b, 0x85, 0xda, 0xc8, 0x30, 0xc7, 0x46, 0x96,
-	0x65, 0x62, 0xb5, 0x91, 0x60, 0x54, 0x1b, 0x04, 0x8c, 0xa9, 0xcd, 0x45, 0xcb, 0xc4, 0x6a, 0xc3,
-	0xcd, 0xb1, 0xda, 0x28, 0x40, 0xc7, 0x46, 0x4b, 0x96, 0x89, 0xd5, 0x46, 0x06, 0x6a, 0x8c, 0x4c,
-	0x6d, 0x96, 0x2d, 0x13, 0xab, 0x0d, 0x37, 0xef, 0x27, 0x19, 0x99, 0xda, 0x5c, 0xb2, 0x4c, 0xac,
-	0x36, 0x32, 0x90, 0xaa, 0x8d, 0x00, 0x72, 0x59, 0xb8, 0x6c, 0x99, 0x58, 0x6d, 0xb8, 0x5d, 0x52,
-	0x1b, 0x15, 0xea, 0xd8, 0xe8, 0xff, 0x2c, 0x13, 0xab, 0x8d, 0x02, 0xa5, 0x6a, 0x13, 0xbb, 0xe7,
-	0xb4, 0x57, 0x2c, 0x13, 0xab, 0x8d, 0x08, 0x40, 0x52, 0x1b, 0x0d, 0xec, 0xd8, 0xa8, 0x64, 0x99,
-	0x58, 0x6d, 0x54, 0x30, 0x55, 0x9b, 0x38, 0x08, 0xa2, 0x36, 0x57, 0x2d, 0x13, 0xab, 0x8d, 0x08,
-	0x81, 0xab, 0x8d, 0x80, 0x31, 0xb5, 0xb9, 0x66, 0x99, 0x58, 0x6d, 0xb8, 0x39, 0x56, 0x1b, 0x01,
-	0x24, 0x6a, 0x73, 0xdd, 0x32, 0xb1, 0xda, 0x70, 0x23, 0x57, 0x9b, 0x38, 0x42, 0xaa, 0x36, 0x6f,
-	0x5a, 0x26, 0x56, 0x1b, 0x11, 0x9f, 0x50, 0x9b, 0x98, 0x8d, 0xa8, 0xcd, 0x5b, 0x96, 0x89, 0xd5,
-	0x46, 0xd0, 0x71, 0xb5, 0x11, 0x30, 0x4d, 0x6d, 0x56, 0x2d, 0xf3, 0xb5, 0xd4, 0x86, 0xf3, 0x24,
-	0xd4, 0x26, 0xce, 0x92, 0xa6, 0x36, 0x6b, 0xc4, 0x43, 0x7f, 0xb5, 0x11, 0xc9, 0x4c, 0xa8, 0x8d,
-	0x1e, 0x37, 0x11, 0x85, 0x0d, 0xcb, 0x1c, 0x5c, 0x6d, 0xd4, 0x88, 0xb9, 0xda, 0x24, 0xc2, 0x25,
-	0xc4, 0x36, 0x21, 0xee, 0xa3, 0x36, 0x5a, 0xa0, 0x5c, 0x6d, 0xb4, 0x6a, 0x31, 0xb5, 0x71, 0x70,
-	0xcd, 0xa8, 0xda, 0xa8, 0x75, 0x13, 0x6a, 0x23, 0xd6, 0x11, 0xb5, 0xb9, 0xc5, 0xf0, 0x46, 0xdc,
-	0x0e, 0x44, 0x6d, 0x9e, 0x82, 0xa9, 0x96, 0xd7, 0xa1, 0x02, 0xc1, 0x64, 0x62, 0x93, 0x24, 0xf5,
-	0xcd, 0xec, 0x0c, 0x3c, 0xf6, 0x3a, 0x44, 0x3b, 0xc8, 0x47, 0xa5, 0xdd, 0x0d, 0x4f, 0xdc, 0x89,
-	0x96, 0x6c, 0x93, 0x58, 0x1d, 0x9b, 0xa9, 0xca, 0xed, 0xc1, 0x58, 0x1d, 0x9b, 0x7c, 0x28, 0xac,
-	0xcc, 0x06, 0x9f, 0x83, 0x69, 0xcc, 0x4a, 0xe5, 0x87, 0xab, 0xd0, 0x1d, 0xc2, 0xbb, 0xd2, 0x97,
-	0x97, 0x4a, 0x13, 0xfd, 0xa4, 0xcc, 0x38, 0x3c, 0xd9, 0x2a, 0x73, 0x3b, 0x36, 0x17, 0xae, 0xb7,
-	0x07, 0xe4, 0x76, 0x6c, 0xfa, 0xa9, 0x72, 0x73, 0x2b, 0xe7, 0xa6, 0x22, 0xc7, 0xb5, 0xee, 0xff,
-	0x07, 0xe0, 0xa6, 0x02, 0xb8, 0xaf, 0xc5, 0x2d, 0x5b, 0x65, 0x6e, 0xc7, 0xe6, 0xf2, 0xf8, 0xce,
-	0x80, 0xdc, 0x8e, 0xbd, 0xaf, 0xc5, 0x2d, 0x5b, 0xe1, 0xc7, 0xc1, 0x0c, 0xe6, 0x66, 0xda, 0x26,
-	0x24, 0xf5, 0x2e, 0x61, 0x5f, 0xed, 0xcb, 0xce, 0x74, 0x96, 0xfd, 0xa0, 0xfc, 0x38, 0x50, 0xd5,
-	0xae, 0x78, 0x70, 0x6c, 0xa1, 0xc4, 0x1f, 0x19, 0xd4, 0x83, 0x63, 0xb3, 0x1f, 0x9a, 0x07, 0x61,
-	0x87, 0x47, 0x60, 0x8e, 0xe4, 0x87, 0x6f, 0x42, 0x28, 0xf8, 0x3d, 0xe2, 0x63, 0xbd, 0x7f, 0x8e,
-	0x18, 0x98, 0xff, 0xa4, 0x5e, 0x70, 0xc8, 0xfa, 0x15, 0xd5, 0x0f, 0xae, 0x04, 0xdf, 0xcb, 0xd6,
-	0xc0, 0x7e, 0x1c, 0x9b, 0xff, 0xd4, 0xfd, 0xc4, 0x57, 0xd4, 0xfb, 0x95, 0x1e, 0x1a, 0xe5, 0x41,
-	0xef, 0x57, 0x72, 0x9c, 0x68, 0xf7, 0x2b, 0x3d, 0x62, 0x9e, 0x81, 0x62, 0xcc, 0xca, 0xce, 0x98,
-	0xfb, 0x84, 0xf6, 0xad, 0xd3, 0x69, 0xe9, 0xe9, 0x43, 0x79, 0x27, 0x5b, 0x8a, 0x11, 0xee, 0x02,
-	0xec, 0x89, 0x9c, 0x46, 0xf4, 0x48, 0x7a, 0x40, 0x58, 0xaf, 0xf5, 0x65, 0xc5, 0xe7, 0x14, 0xfe,
-	0x9f, 0x52, 0xe6, 0x5b, 0xb1, 0x45, 0xb4, 0x3b, 0x95, 0x42, 0x76, 0x7e, 0x55, 0x06, 0x69, 0x77,
-	0x02, 0xa5, 0x9f, 0x52, 0xbb, 0x4b, 0x56, 0x9e, 0x04, 0xc6, 0x4d, 0x8f, 0xbc, 0xea, 0x00, 0x49,
-	0xa0, 0xcb, 0xc9, 0x69, 0x18, 0x27, 0x41, 0x32, 0xc2, 0x0e, 0x38, 0x2b, 0x11, 0x6b, 0x87, 0xe4,
-	0x43, 0xe2, 0xe1, 0xe6, 0x00, 0x1e, 0x94, 0x63, 0x91, 0x7a, 0x9a, 0x6f, 0xa5, 0x5e, 0x84, 0x11,
-	0x58, 0x94, 0x3c, 0xea, 0xa7, 0xe6, 0x36, 0x71, 0xe9, 0x0c, 0xe0, 0x52, 0x3d, 0x33, 0xa9, 0xcf,
-	0x85, 0x56, 0xfa, 0x55, 0x78, 0x0c, 0xe6, 0x93, 0xdb, 0x24, 0x47, 0xdf, 0xce, 0x20, 0xf7, 0x80,
-	0xb4, 0x0d, 0x7c, 0xf4, 0x49, 0xf7, 0x80, 0x76, 0x05, 0xbe, 0x0f, 0x16, 0x52, 0x76, 0x47, 0x3c,
-	0x3d, 0x22, 0x9e, 0x36, 0x06, 0xdf, 0x5a, 0xec, 0x6a, 0xb6, 0x95, 0x72, 0x09, 0x2e, 0x83, 0x42,
-	0xd0, 0xf6, 0x83, 0x23, 0x7e, 0xdc, 0x04, 0xf8, 0x11, 0x7b, 0x7b, 0xc8, 0xcd, 0x13, 0x2b, 0x3b,
-	0x3c, 0x3e, 0x06, 0x66, 0x29, 0x48, 0xab, 0x6d, 0xe7, 0xb5, 0x1e, 0xb7, 0xb6, 0x87, 0x5c, 0x48,
-	0x68, 0xd4, 0x5a, 0x8a, 0x08, 0x58, 0xb7, 0x7f, 0xc0, 0x27, 0x12, 0xc4, 0xca, 0x7a, 0xf7, 0x22,
-	0xa0, 0x5f, 0x59, 0xdb, 0x86, 0x6c, 0xbc, 0x01, 0x88, 0x91, 0x76, 0xe1, 0x05, 0x00, 0x18, 0x04,
-	0xdf, 0x87, 0x11, 0x7e, 0x10, 0xdd, 0x1e, 0x72, 0xc7, 0x29, 0x02, 0xdf, 0x5b, 0xca, 0x56, 0x1d,
-	0x1b, 0x75, 0x2d, 0xa3, 0x34, 0xac, 0x6c, 0xd5, 0xb1, 0x63, 0x47, 0x54, 0x7b, 0x7a, 0xf8, 0xf1,
-	0x58, 0x38, 0xa2, 0x62, 0x22, 0x78, 0x98, 0x90, 0xbc, 0xc2, 0x8f, 0xc6, 0x82, 0x87, 0x09, 0x43,
-	0x85, 0x47, 0x43, 0xca, 0xf6, 0xe1, 0xe0, 0x8f, 0x78, 0x22, 0x66, 0x52, 0x9e, 0x3d, 0xe9, 0x69,
-	0x8c, 0x88, 0x0c, 0x9b, 0xa6, 0xa1, 0x5f, 0x19, 0x24, 0xf7, 0x8b, 0x2b, 0x74, 0xdc, 0xb6, 0xc2,
-	0xe7, 0x3c, 0x2b, 0x78, 0xab, 0xef, 0x79, 0xcd, 0x9e, 0x1f, 0x3f, 0xa6, 0x61, 0xd3, 0x33, 0xba,
-	0x0e, 0xba, 0x60, 0x5e, 0x9d, 0xd1, 0x08, 0xc6, 0x5f, 0x1b, 0xec, 0xd1, 0x56, 0x67, 0x24, 0x7a,
-	0x47, 0x29, 0x67, 0x95, 0x49, 0x4e, 0x06, 0xa7, 0x63, 0x0b, 0xce, 0xdf, 0xf4, 0xe1, 0x74, 0xec,
-	0x24, 0xa7, 0x63, 0x73, 0xce, 0x03, 0xe9, 0x21, 0xbf, 0xa7, 0x06, 0xfa, 0x5b, 0x4a, 0x7a, 0x3e,
-	0x41, 0x7a, 0x20, 0x45, 0x3a, 0xa7, 0x0e, 0x89, 0xb2, 0x68, 0xa5, 0x58, 0x7f, 0xd7, 0x8f, 0x96,
-	0x07, 0x3b, 0xa7, 0x8e, 0x94, 0xd2, 0x32, 0x40, 0x1a, 0x47, 0xb0, 0xfe, 0x3e, 0x2b, 0x03, 0xa4,
-	0x97, 0xb4, 0x0c, 0x10, 0x5b, 0x5a, 0xa8, 0xb4, 0xd3, 0x04, 0xe9, 0x1f, 0xb2, 0x42, 0xa5, 0xcd,
-	0xa7, 0x85, 0x4a, 0x8d, 0x69, 0xb4, 0x4c, 0x61, 0x38, 0xed, 0x1f, 0xb3, 0x68, 0xe9, 0x4d, 0xa8,
-	0xd1, 0x52, 0x63, 0x5a, 0x06, 0xc8, 0x3d, 0x2a, 0x58, 0xff, 0x94, 0x95, 0x01, 0x72, 0xdb, 0x6a,
-	0x19, 0x20, 0x36, 0xce, 0xb9, 0x27, 0x3d, 0x1c, 0x28, 0xcd, 0xff, 0x67, 0x83, 0xc8, 0x60, 0xdf,
-	0xe6, 0x97, 0x1f, 0x0a, 0xa5, 0x20, 0xd5, 0x91, 0x81, 0x60, 0xfc, 0x8b, 0xc1, 0x9e, 0xb4, 0xfa,
-	0x35, 0xbf, 0x32, 0x58, 0xc8, 0xe0, 0x94, 0x1a, 0xea, 0xaf, 0x7d, 0x38, 0x45, 0xf3, 0x2b, 0x53,
-	0x08, 0xa9, 0x46, 0xda, 0x30, 0x42, 0x90, 0xfe, 0x8d, 0x92, 0x9e, 0xd2, 0xfc, 0xea, 0xcc, 0x22,
-	0x8b, 0x56, 0x8a, 0xf5, 0xef, 0xfd, 0x68, 0x45, 0xf3, 0xab, 0x13, 0x8e, 0xb4, 0x0c, 0xa8, 0xcd,
-	0xff, 0x8f, 0xac, 0x0c, 0xc8, 0xcd, 0xaf, 0x0c, 0x03, 0xd2, 0x42, 0xd5, 0x9a, 0xff, 0x9f, 0x59,
-	0xa1, 0x2a, 0xcd, 0xaf, 0x8e, 0x0e, 0xd2, 0x68, 0xb5, 0xe6, 0xff, 0x57, 0x16, 0xad, 0xd2, 0xfc,
-	0xea, 0xb3, 0x68, 0x5a, 0x06, 0xd4, 0xe6, 0xff, 0x77, 0x56, 0x06, 0xe4, 0xe6, 0x57, 0x06, 0x0e,
-	0x9c, 0xf3, 0xa1, 0x34, 0xd7, 0xe5, 0xef, 0x70, 0xd0, 0x77, 0x73, 0x6c, 0x4e, 0x96, 0xd8, 0x3b,
-	0x43, 0xc4, 0x33, 0x5f, 0x6e, 0x81, 0x8f, 0x80, 0x18, 0x1a, 0xd6, 0xc4, 0xcb, 0x1a, 0xf4, 0xbd,
-	0x5c, 0xc6, 0xf9, 0xf1, 0x94, 0x43, 0x5c, 0xe1, 0x5f, 0x98, 0xe0, 0x47, 0xc1, 0x8c, 0x34, 0xc4,
-	0xe6, 0x2f, 0x8e, 0xd0, 0xf7, 0xb3, 0xc8, 0xaa, 0x18, 0xf3, 0xd8, 0x8b, 0x5e, 0xc6, 0x64, 0xc2,
-	0x04, 0xb7, 0xd4, 0xb9, 0x70, 0xaf, 0xde, 0x45, 0x3f, 0xa0, 0x44, 0x0b, 0x69, 0x45, 0xe8, 0xd5,
-	0xbb, 0xca, 0xc4, 0xb8, 0x57, 0xef, 0xc2, 0x4d, 0x20, 0x66, 0x8b, 0x35, 0xaf, 0x7d, 0x82, 0x7e,
-	0x48, 0xd7, 0xcf, 0x26, 0xd6, 0x6f, 0xb5, 0x4f, 0xdc, 0x3c, 0x87, 0x6e, 0xb5, 0x4f, 0xe0, 0x5d,
-	0x69, 0xd6, 0xfc, 0x0a, 0x97, 0x01, 0xfd, 0x88, 0xae, 0x9d, 0x4f, 0xac, 0xa5, 0x55, 0x12, 0xd3,
-	0x4d, 0xf2, 0x15, 0x97, 0x27, 0x6e, 0x50, 0x5e, 0x9e, 0x1f, 0xe7, 0x48, 0xb5, 0xfb, 0x95, 0x47,
-	0xf4, 0xa5, 0x54, 0x1e, 0x41, 0x14, 0x97, 0xe7, 0x27, 0xb9, 0x0c, 0x85, 0x93, 0xca, 0xc3, 0x97,
-	0xc5, 0xe5, 0x91, 0xb9, 0x48, 0x79, 0x48, 0x75, 0x7e, 0x9a, 0xc5, 0x25, 0x55, 0x27, 0x1e, 0x0a,
-	0xb2, 0x55, 0xb8, 0x3a, 0xf2, 0xad, 0x82, 0xab, 0xf3, 0x4b, 0x4a, 0x94, 0x5d, 0x1d, 0xe9, 0xee,
-	0x60, 0xd5, 0x11, 0x14, 0xb8, 0x3a, 0x3f, 0xa3, 0xeb, 0x33, 0xaa, 0xc3, 0xa1, 0xac, 0x3a, 0x62,
-	0x25, 0xad, 0xce, 0xcf, 0xe9, 0xda, 0xcc, 0xea, 0x70, 0x38, 0xad, 0xce, 0x05, 0x00, 0xc8, 0xfe,
-	0xdb, 0x5e, 0xcb, 0x5f, 0x43, 0x9f, 0x36, 0xc9, 0x6b, 0x28, 0xc9, 0x04, 0x2d, 0x90, 0xa7, 0xfd,
-	0x8b, 0xbf, 0xae, 0xa3, 0xcf, 0xc8, 0x88, 0x5d, 0x6c, 0x82, 0x17, 0x41, 0xa1, 0x16, 0x43, 0x36,
-	0xd0, 0x67, 0x19, 0xa4, 0xca, 0x21, 0x1b, 0x70, 0x09, 0x4c, 0x50, 0x04, 0x81, 0xd8, 0x35, 0xf4,
-	0x39, 0x9d, 0x86, 0xfc, 0x3d, 0x49, 0xbe, 0xad, 0x62, 0xc8, 0x4d, 0xf4, 0x79, 0x8a, 0x90, 0x6d,
-	0x70, 0x99, 0xd3, 0xac, 0x12, 0x1e, 0x07, 0x7d, 0x41, 0x01, 0x61, 0x1e, 0x47, 0xec, 0x08, 0x7f,
-	0xbb, 0x85, 0xbe, 0xa8, 0x3b, 0xba, 0x85, 0x01, 0x22, 0xb4, 0x4d, 0xf4, 0x25, 0x3d, 0xda, 0xcd,
-	0x78, 0xcb, 0xf8, 0xeb, 0x6d, 0xf4, 0x65, 0x9d, 0xe2, 0x36, 0x5c, 0x02, 0x85, 0xaa, 0x40, 0xac,
-	0xad, 0xa2, 0xaf, 0xb0, 0x38, 0x04, 0xc9, 0xda, 0x2a, 0xc1, 0xec, 0x54, 0xde, 0x7d, 0x50, 0xdb,
-	0xdd, 0x7a, 0x5c, 0x59, 0x5b, 0x43, 0x5f, 0xe5, 0x18, 0x6c, 0xa4, 0xb6, 0x18, 0x43, 0x72, 0xbd,
-	0x8e, 0xbe, 0xa6, 0x60, 0x88, 0x0d, 0x5e, 0x02, 0x93, 0x35, 0x29, 0xbf, 0x6b, 0x1b, 0xe8, 0xeb,
-	0x09, 0x6f, 0x1b, 0x14, 0x55, 0x8d, 0x51, 0x36, 0xfa, 0x46, 0x02, 0x65, 0xc7, 0x09, 0xa4, 0xa0,
-	0x9b, 0xe8, 0x9b, 0x72, 0x02, 0x09, 0x48, 0xca, 0x32, 0xdd, 0x9d, 0x83, 0xbe, 0x95, 0x00, 0x39,
-	0xd8, 0x9f, 0x14, 0xd3, 0xad, 0x5a, 0x0d, 0x7d, 0x3b, 0x81, 0xba, 0x85, 0x51, 0x52, 0x4c, 0x9b,
-	0xb5, 0x1a, 0xfa, 0x4e, 0x22, 0xaa, 0xcd, 0xc5, 0xe7, 0x60, 0x42, 0x7d, 0xd0, 0x29, 0x00, 0xc3,
-	0x63, 0x6f, 0x44, 0x0d, 0x0f, 0xbe, 0x0d, 0xf2, 0xf5, 0x40, 0xbc, 0xd4, 0x40, 0xb9, 0xd3, 0x5e,
-	0x80, 0xc8, 0xe8, 0xc5, 0x7b, 0x00, 0x26, 0x87, 0x94, 0xb0, 0x08, 0xcc, 0x97, 0xfe, 0x09, 0x73,
-	0x81, 0x7f, 0x85, 0xb3, 0xe0, 0x0c, 0xbd, 0x7d, 0x72, 0xc4, 0x46, 0xbf, 0xdc, 0xc9, 0x6d, 0x1a,
-	0x31, 0x83, 0x3c, 0x90, 0x94, 0x19, 0xcc, 0x14, 0x06, 0x53, 0x66, 0x28, 0x83, 0xd9, 0xb4, 0xd1,
-	0xa3, 0xcc, 0x31, 0x91, 0xc2, 0x31, 0x91, 0xce, 0xa1, 0x8c, 0x18, 0x65, 0x8e, 0xe1, 0x14, 0x8e,
-	0xe1, 0x24, 0x47, 0x62, 0x94, 0x28, 0x73, 0x4c, 0xa7, 0x70, 0x4c, 0xa7, 0x73, 0x28, 0x23, 0x43,
-	0x99, 0x03, 0xa6, 0x70, 0x40, 0x99, 0xe3, 0x01, 0x98, 0x4f, 0x1f, 0x0c, 0xca, 0x2c, 0xa3, 0x29,
-	0x2c, 0xa3, 0x19, 0x2c, 0xea, 0xf0, 0x4f, 0x66, 0x19, 0x49, 0x61, 0x19, 0x91, 0x59, 0xaa, 0x00,
-	0x65, 0x8d, 0xf7, 0x64, 0x9e, 0xa9, 0x14, 0x9e, 0xa9, 0x2c, 0x1e, 0x6d, 0x7c, 0x27, 0xf3, 0x14,
-	0x53, 0x78, 0x8a, 0xa9, 0xdd, 0x26, 0x0f, 0xe9, 0x4e, 0xeb, 0xd7, 0x9c, 0xcc, 0xb0, 0x05, 0x66,
-	0x52, 0xe6, 0x71, 0xa7, 0x51, 0x18, 0x32, 0xc5, 0x5d, 0x50, 0xd4, 0x87, 0x6f, 0xf2, 0xfa, 0xb1,
-	0x94, 0xf5, 0x63, 0x29, 0x4d, 0xa2, 0x0f, 0xda, 0x64, 0x8e, 0xf1, 0x14, 0x8e, 0xf1, 0xe4, 0x36,
-	0xf4, 0x89, 0xda, 0x69, 0x14, 0x05, 0x99, 0x22, 0x04, 0xe7, 0xfa, 0x8c, 0xcc, 0x52, 0xa8, 0xde,
-	0x91, 0xa9, 0x5e, 0xe3, 0x7d, 0x95, 0xe4, 0xf3, 0x18, 0x9c, 0xef, 0x37, 0x33, 0x4b, 0x71, 0xba,
-	0xa6, 0x3a, 0xed, 0xfb, 0x0a, 0x4b, 0x72, 0xd4, 0xa4, 0x0d, 0x97, 0x36, 0x2b, 0x4b, 0x71, 0x72,
-	0x47, 0x76, 0x32, 0xe8, 0x4b, 0x2d, 0xc9, 0x9b, 0x07, 0xce, 0x66, 0xce, 0xcb, 0x52, 0xdc, 0xad,
-	0xa8, 0xee, 0xb2, 0x5f, 0x75, 0xc5, 0x2e, 0x96, 0x6e, 0x03, 0x20, 0x4d, 0xf6, 0x46, 0x81, 0x59,
-	0xdd, 0xdb, 0x2b, 0x0e, 0xe1, 0x5f, 0xca, 0x5b, 0x6e, 0xd1, 0xa0, 0xbf, 0x3c, 0x2f, 0xe6, 0xb0,
-	0xbb, 0xdd, 0xca, 0xc3, 0xe2, 0x7f, 0xf9, 0x7f, 0x46, 0x79, 0x42, 0x8c, 0xa2, 0xf0, 0xa9, 0xb2,
-	0xf4, 0x06, 0x98, 0xd4, 0x06, 0x92, 0x05, 0x60, 0xd4, 0xf9, 0x81, 0x52, 0xbf, 0x76, 0x13, 0x80,
-	0xf8, 0xdf, 0x30, 0xc1, 0x29, 0x90, 0x3f, 0xd8, 0xdd, 0x7f, 0x52, 0xb9, 0xbf, 0x53, 0xdd, 0xa9,
-	0x3c, 0x28, 0x0e, 0xc1, 0x02, 0x18, 0x7b, 0xe2, 0xee, 0x3d, 0xdd, 0x2b, 0x1f, 0x54, 0x8b, 0x06,
-	0x1c, 0x03, 0xc3, 0x8f, 0xf6, 0xf7, 0x76, 0x8b, 0xb9, 0x6b, 0xf7, 0x40, 0x5e, 0x9e, 0x07, 0x4e,
-	0x81, 0x7c, 0x75, 0xcf, 0xad, 0xec, 0x3c, 0xdc, 0xad, 0xd1, 0x48, 0x25, 0x03, 0x8d, 0x58, 0x31,
-	0x3c, 0x2f, 0xe6, 0xca, 0x17, 0xc1, 0x85, 0x7a, 0xd0, 0x4a, 0xfc, 0x61, 0x26, 0x25, 0xe7, 0xc5,
-	0x08, 0xb1, 0x6e, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x33, 0xc2, 0x0c, 0xb6, 0xeb, 0x26, 0x00,
-	0x00,
+	// 2761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x5a, 0x59, 0x77, 0xdb, 0xc6,
+	0x15, 0x16, 0x08, 0x45, 0xcb, 0x90, 0x92, 0xa8, 0xd1, 0x36, 0x56, 0x72, 0x62, 0x58, 0x8e, 0x6b,
+	0xc5, 0x49, 0x64, 0x2d, 0x30, 0x2c, 0x3b, 0x8d, 0x6b, 0xd1, 0x26, 0x6d, 0xb9, 0xb1, 0xa4, 0x43,
+	0x49, 0x71, 0x8f, 0xfb, 0x80, 0xc2, 0x14, 0xa4, 0xc3, 0x98, 0x24, 0x18, 0x00, 0x74, 0xa2, 0xfe,
+	0x8b, 0xee, 0xfb, 0xfa, 0xd2, 0xa7, 0xae, 0x2f, 0x6d, 0x4f, 0xfb, 0xd8, 0x97, 0xee, 0xed, 0xe9,
+	0xde, 0xfe, 0x80, 0xbe, 0xf4, 0x3f, 0xb4, 0x67, 0x56, 0xcc, 0x0c, 0x00, 0x8a, 0x6e, 0xce, 0x09,
+	0x41, 0xde, 0xf9, 0xe6, 0xbb, 0x77, 0xee, 0x5c, 0x7c, 0x23, 0x5c, 0x18, 0x5c, 0x6c, 0x04, 0x9d,
+	0xe3, 0x20, 0x6c, 0x7b, 0x9d, 0x86, 0xef, 0x76, 0xc3, 0x20, 0x0e, 0xae, 0x4a, 0x96, 0x15, 0x62,
+	0x81, 0x45, 0xc9, 0xb4, 0x78, 0xee, 0x24, 0x08, 0x4e, 0x5a, 0xfe, 0x55, 0x32, 0xf4, 0xa4, 0x77,
+	0x7c, 0xd5, 0xeb, 0x9c, 0x52, 0xdc, 0xe2, 0xcb, 0xfa, 0xd0, 0x51, 0x2f, 0xf4, 0xe2, 0x66, 0xd0,
+	0x61, 0xe3, 0x96, 0x3e, 0x7e, 0xdc, 0xf4, 0x5b, 0x47, 0x6e, 0xdb, 0x8b, 0x9e, 0x32, 0xc4, 0x4b,
+	0x3a, 0x22, 0x8a, 0xc3, 0x5e, 0x23, 0x66, 0xa3, 0xe7, 0xf5, 0xd1, 0xb8, 0xd9, 0xf6, 0xa3, 0xd8,
+	0x6b, 0x77, 0xf3, 0x02, 0x78, 0x3f, 0xf4, 0xba, 0x5d, 0x3f, 0x8c, 0xe8, 0xf8, 0xd2, 0xbf, 0x0d,
+	0x00, 0xef, 0x24, 0x6b, 0xa9, 0xfb, 0xef, 0xf5, 0xfc, 0x28, 0x86, 0xaf, 0x81, 0x32, 0x9f, 0xe1,
+	0x76, 0xbd, 0xd3, 0x56, 0xe0, 0x1d, 0x21, 0xc3, 0x32, 0x96, 0x4b, 0xf7, 0x87, 0xea, 0x53, 0x7c,
+	0x64, 0x8f, 0x0e, 0xc0, 0x8b, 0xa0, 0xf4, 0x6e, 0x14, 0x74, 0x04, 0xb0, 0x60, 0x19, 0xcb, 0xe3,
+	0xf7, 0x87, 0xea, 0x45, 0x6c, 0x95, 0x40, 0xb1, 0xff, 0x41, 0x2c, 0x40, 0x63, 0x1c, 0x84, 0xad,
+	0x1c, 0xb4, 0x0b, 0x16, 0x42, 0x1a, 0x81, 0x7f, 0xe4, 0x06, 0xbd, 0xb8, 0xdb, 0x8b, 0x5d, 0x12,
+	0x5a, 0x8c, 0x4c, 0xcb, 0x58, 0x9e, 0x5c, 0x5f, 0x58, 0x91, 0xf7, 0xe2, 0x51, 0x33, 0xf4, 0x6b,
+	0x64, 0xb8, 0x3e, 0x27, 0xe6, 0xed, 0x92, 0x69, 0xd4, 0x5c, 0x19, 0x07, 0xa3, 0xcc, 0xe1, 0xd2,
+	0x77, 0x0a, 0x60, 0x46, 0x59, 0x69, 0xd4, 0x0d, 0x3a, 0x91, 0x0f, 0x2f, 0x80, 0x62, 0xd7, 0x0b,
+	0x23, 0xdf, 0xf5, 0xc3, 0x30, 0x08, 0x91, 0xc1, 0xe2, 0x02, 0xc4, 0x58, 0xc5, 0x36, 0xf8, 0x2a,
+	0x98, 0x8a, 0xfc, 0xb0, 0xe9, 0xb5, 0x9a, 0x9f, 0xe4, 0xb0, 0x11, 0x06, 0x9b, 0x14, 0x03, 0x14,
+	0x7a, 0x09, 0x4c, 0x84, 0xbd, 0x0e, 0xde, 0x05, 0x06, 0xe4, 0xc9, 0x28, 0x31, 0x33, 0x85, 0x65,
+	0xe5, 0xd7, 0x1c, 0x34, 0xbf, 0xc3, 0xff, 0x77, 0x7e, 0x17, 0xc1, 0x68, 0xf4, 0xb4, 0xd9, 0xed,
+	0xfa, 0x47, 0xe8, 0x05, 0x36, 0xce, 0x0d, 0x95, 0x31, 0x30, 0x12, 0xfa, 0x51, 0xaf, 0x15, 0x2f,
+	0xfd, 0xa7, 0x06, 0x4a, 0x07, 0x7e, 0x14, 0x6f, 0xb5, 0x5a, 0x07, 0xa7, 0x5d, 0x3f, 0x82, 0x97,
+	0xc0, 0x64, 0xd0, 0xc5, 0x55, 0xeb, 0xb5, 0xdc, 0x66, 0x27, 0xde, 0x58, 0x27, 0x59, 0x7a, 0xa1,
+	0x3e, 0xc1, 0xad, 0xdb, 0xd8, 0xa8, 0xc3, 0x1c, 0x9b, 0x2c, 0xde, 0x54, 0x60, 0x8e, 0x0d, 0x2f,
+	0x83, 0x29, 0x01, 0xeb, 0x51, 0x3a, 0xbc, 0xf4, 0x89, 0xba, 0x98, 0x7d, 0x48, 0xac, 0x29, 0xa0,
+	0x63, 0x93, 0xa5, 0x0f, 0xab, 0x40, 0x8d, 0x31, 0xa2, 0x8c, 0x78, 0x79, 0xd3, 0x09, 0x70, 0x3f,
+	0xcd, 0x18, 0x51, 0x46, 0xbc, 0x91, 0x50, 0x05, 0x3a, 0x36, 0x7c, 0x15, 0x94, 0x05, 0xf0, 0xb8,
+	0xf9, 0x81, 0x7f, 0xb4, 0xb1, 0x8e, 0x46, 0x2d, 0x63, 0x79, 0xb4, 0x2e, 0x08, 0x6a, 0xd4, 0x9c,
+	0x86, 0x3a, 0x36, 0x49, 0xfe, 0x88, 0x06, 0x75, 0x6c, 0xf8, 0x1a, 0x98, 0x4e, 0xdc, 0x73, 0xda,
+	0x71, 0xcb, 0x58, 0x9e, 0xaa, 0x0b, 0x8e, 0x7d, 0x66, 0xcf, 0x00, 0x3b, 0x36, 0x02, 0x96, 0xb1,
+	0x5c, 0xd6, 0xc1, 0x8e, 0xad, 0xa4, 0xfe, 0xb8, 0x15, 0x78, 0x31, 0x2a, 0x5a, 0xc6, 0x72, 0x21,
+	0x49, 0x7d, 0x0d, 0x1b, 0x95, 0xf5, 0x1f, 0x05, 0xbd, 0x27, 0x2d, 0x1f, 0x95, 0x2c, 0x63, 0xd9,
+	0x48, 0xd6, 0x7f, 0x97, 0x58, 0xe1, 0x45, 0x20, 0x66, 0xba, 0x4f, 0x82, 0xa0, 0x85, 0x26, 0x2c,
+	0x63, 0x79, 0xac, 0x5e, 0xe2, 0xc6, 0x4a, 0x10, 0xb4, 0xd4, 0x6c, 0xc6, 0x61, 0xb3, 0x73, 0x82,
+	0x26, 0x71, 0x55, 0x49, 0xd9, 0x24, 0x56, 0x25, 0xba, 0x27, 0xa7, 0xb1, 0x1f, 0xa1, 0x29, 0x5c,
+	0xeb, 0x49, 0x74, 0x15, 0x6c, 0x84, 0x2e, 0x58, 0x10, 0xb0, 0x0e, 0xd5, 0x80, 0xb6, 0x1f, 0x45,
+	0xde, 0x89, 0x8f, 0xa0, 0x65, 0x2c, 0x17, 0xd7, 0x2f, 0x2b, 0x77, 0xbf, 0x5c, 0xa2, 0x2b, 0x3b,
+	0x04, 0xff, 0x90, 0xc2, 0xeb, 0x73, 0x9c, 0x47, 0x31, 0xc3, 0x43, 0x80, 0x92, 0x2c, 0x05, 0xa1,
+	0xdf, 0x3c, 0xe9, 0x08, 0x0f, 0x33, 0xc4, 0xc3, 0x8b, 0x8a, 0x87, 0x1a, 0xc5, 0x70, 0xd6, 0x79,
+	0x91, 0x4c, 0xc5, 0x0e, 0xdf, 0x01, 0xb3, 0x7a, 0xdc, 0x7e, 0xa7, 0xd7, 0x46, 0x73, 0x44, 0xb2,
+	0x5e, 0x39, 0x2b, 0xe8, 0x6a, 0xa7, 0xd7, 0xae, 0x43, 0x35, 0x62, 0x6c, 0x83, 0x6f, 0x83, 0xb9,
+	0x54, 0xb8, 0x84, 0x78, 0x9e, 0x10, 0xa3, 0xac, 0x58, 0x09, 0xd9, 0x8c, 0x16, 0x28, 0x61, 0x73,
+	0xc0, 0x9c, 0xb6, 0x5b, 0x6e, 0xb7, 0xe9, 0x37, 0x7c, 0x84, 0xf0, 0x9e, 0x55, 0x0a, 0x63, 0x85,
+	0xfa, 0x8c, 0xba, 0x6f, 0x7b, 0x78, 0x18, 0x5e, 0x96, 0x4a, 0xa1, 0x11, 0x84, 0x47, 0xe8, 0x1c,
+	0xc3, 0x1b, 0x49, 0x39, 0xdc, 0x09, 0xc2, 0x23, 0x58, 0x03, 0xd3, 0xa1, 0xdf, 0xe8, 0x85, 0x51,
+	0xf3, 0x99, 0x2f, 0xd2, 0xfa, 0x22, 0x49, 0xeb, 0xb9, 0xdc, 0x1c, 0xd4, 0xcb, 0x62, 0x0e, 0x4f,
+	0xe7, 0x25, 0x30, 0x19, 0xfa, 0x5d, 0xdf, 0xc3, 0x79, 0xa4, 0x37, 0xf3, 0x79, 0xcb, 0xc4, 0x6a,
+	0xc3, 0xad, 0x42, 0x6d, 0x64, 0x98, 0x63, 0x23, 0xcb, 0x32, 0xb1, 0xda, 0x48, 0x30, 0xaa, 0x0d,
+	0x02, 0xc6, 0xd4, 0xe6, 0x82, 0x65, 0x62, 0xb5, 0xe1, 0xe6, 0x44, 0x6d, 0x14, 0xa0, 0x63, 0xa3,
+	0x25, 0xcb, 0xc4, 0x6a, 0x23, 0x03, 0x35, 0x46, 0xa6, 0x36, 0x17, 0x2d, 0x13, 0xab, 0x0d, 0x37,
+	0xef, 0xa7, 0x19, 0x99, 0xda, 0xbc, 0x62, 0x99, 0x58, 0x6d, 0x64, 0x20, 0x55, 0x1b, 0x01, 0xe4,
+	0xb2, 0x70, 0xc9, 0x32, 0xb1, 0xda, 0x70, 0xbb, 0xa4, 0x36, 0x2a, 0xd4, 0xb1, 0xd1, 0x87, 0x2c,
+	0x13, 0xab, 0x8d, 0x02, 0xa5, 0x6a, 0x93, 0xb8, 0xe7, 0xb4, 0x97, 0x2d, 0x13, 0xab, 0x8d, 0x08,
+	0x40, 0x52, 0x1b, 0x0d, 0xec, 0xd8, 0x68, 0xd9, 0x32, 0xb1, 0xda, 0xa8, 0x60, 0xaa, 0x36, 0x49,
+	0x10, 0x44, 0x6d, 0x5e, 0xb5, 0x4c, 0xac, 0x36, 0x22, 0x04, 0xae, 0x36, 0x02, 0xc6, 0xd4, 0xe6,
+	0x8a, 0x65, 0x62, 0xb5, 0xe1, 0xe6, 0x44, 0x6d, 0x04, 0x90, 0xa8, 0xcd, 0x6b, 0x96, 0x89, 0xd5,
+	0x86, 0x1b, 0xb9, 0xda, 0x24, 0x11, 0x52, 0xb5, 0x79, 0xdd, 0x32, 0xb1, 0xda, 0x88, 0xf8, 0x84,
+	0xda, 0x24, 0x6c, 0x44, 0x6d, 0xde, 0xb0, 0x4c, 0xac, 0x36, 0x82, 0x8e, 0xab, 0x8d, 0x80, 0x69,
+	0x6a, 0xb3, 0x6a, 0x99, 0xcf, 0xa5, 0x36, 0x9c, 0x27, 0xa5, 0x36, 0x49, 0x96, 0x34, 0xb5, 0x59,
+	0xb3, 0xcc, 0x33, 0xd5, 0x46, 0x24, 0x33, 0xa5, 0x36, 0x7a, 0xdc, 0x44, 0x14, 0x36, 0x2c, 0x73,
+	0x70, 0xb5, 0x51, 0x23, 0xe6, 0x6a, 0x93, 0x0a, 0x97, 0x10, 0xdb, 0x96, 0xd9, 0x5f, 0x6d, 0xb4,
+	0x40, 0xb9, 0xda, 0x68, 0xbb, 0xc5, 0xd4, 0xc6, 0xb1, 0x4c, 0xae, 0x36, 0xea, 0xbe, 0x09, 0xb5,
+	0x11, 0xf3, 0x88, 0xda, 0x5c, 0x67, 0x78, 0x23, 0x29, 0x07, 0xa2, 0x36, 0x07, 0x60, 0xaa, 0xed,
+	0x75, 0xa9, 0x40, 0xd0, 0x4f, 0xb4, 0x49, 0x92, 0xfa, 0x7a, 0x7e, 0x06, 0x1e, 0x7a, 0x5d, 0xa2,
+	0x1d, 0xe4, 0xa3, 0xda, 0x89, 0xc3, 0xd3, 0xfa, 0x44, 0x5b, 0xb6, 0x49, 0xac, 0x8e, 0x4d, 0x3f,
+	0xd1, 0x8d, 0xc1, 0x58, 0x1d, 0x9b, 0x7c, 0x28, 0xac, 0xcc, 0x06, 0x1f, 0x83, 0x69, 0xcc, 0x4a,
+	0xe5, 0x87, 0x5d, 0xd0, 0x4d, 0xc2, 0xbb, 0xd2, 0x97, 0x97, 0x4a, 0x13, 0xfd, 0xa4, 0xcc, 0x38,
+	0x3c, 0xd9, 0x2a, 0x73, 0x3b, 0x36, 0xbb, 0xa0, 0x37, 0x07, 0xe4, 0x76, 0x6c, 0xfa, 0xa9, 0x72,
+	0x73, 0x2b, 0xe7, 0xa6, 0x22, 0xc7, 0x2e, 0xe8, 0xc3, 0x03, 0x70, 0x53, 0x01, 0xdc, 0xd7, 0xe2,
+	0x96, 0xad, 0x32, 0xb7, 0x63, 0xb3, 0x0b, 0x7a, 0x6b, 0x40, 0x6e, 0xc7, 0xde, 0xd7, 0xe2, 0x96,
+	0xad, 0xf0, 0x13, 0x60, 0x06, 0x73, 0x33, 0x6d, 0xe3, 0x57, 0x74, 0x8b, 0xb0, 0xaf, 0xf6, 0x65,
+	0x67, 0x3a, 0xcb, 0x2e, 0x94, 0x1f, 0x07, 0xaa, 0xda, 0x15, 0x0f, 0x8e, 0xcd, 0xaf, 0xe8, 0x23,
+	0x83, 0x7a, 0x70, 0x6c, 0x76, 0xd1, 0x3c, 0x08, 0x3b, 0x3c, 0x06, 0x73, 0x24, 0x3f, 0x7c, 0x11,
+	0xfc, 0x0b, 0xba, 0x4d, 0x7c, 0xac, 0xf7, 0xcf, 0x11, 0x03, 0xf3, 0x2b, 0xf5, 0x82, 0x43, 0xd6,
+	0x47, 0x54, 0x3f, 0x8e, 0x2d, 0xbe, 0xa0, 0xad, 0x81, 0xfd, 0x38, 0x36, 0xbf, 0xea, 0x7e, 0x92,
+	0x11, 0xf5, 0x7e, 0xa5, 0x87, 0x46, 0x65, 0xd0, 0xfb, 0x95, 0x1c, 0x27, 0xda, 0xfd, 0x4a, 0x6c,
+	0xf0, 0x11, 0x28, 0x27, 0xac, 0xec, 0x8c, 0xb9, 0x43, 0x68, 0xdf, 0x38, 0x9b, 0x96, 0x9e, 0x3e,
+	0x94, 0x77, 0xb2, 0xad, 0x18, 0xe1, 0x0e, 0xc0, 0x9e, 0xc8, 0x69, 0x44, 0x3e, 0xd0, 0x5d, 0xc2,
+	0x7a, 0xa5, 0x2f, 0x2b, 0x3e, 0xa7, 0xf0, 0xff, 0x94, 0xb2, 0xd8, 0x4e, 0x2c, 0xa2, 0xdc, 0xa9,
+	0x14, 0xd2, 0x0b, 0xaa, 0x0e, 0x52, 0xee, 0x04, 0x4a, 0x3f, 0xa5, 0x72, 0x97, 0xac, 0x3c, 0x09,
+	0x8c, 0x9b, 0x1e, 0x79, 0xb5, 0x01, 0x92, 0x40, 0xa7, 0x93, 0xd3, 0x30, 0x49, 0x82, 0x64, 0x84,
+	0x5d, 0x70, 0x4e, 0x22, 0xd6, 0x0e, 0xc9, 0x7b, 0xc4, 0xc3, 0xb5, 0x01, 0x3c, 0x28, 0xc7, 0x22,
+	0xf5, 0x34, 0xdf, 0xce, 0x1c, 0x84, 0x11, 0x58, 0x94, 0x3c, 0xea, 0xa7, 0xe6, 0x7d, 0xe2, 0xd2,
+	0x19, 0xc0, 0xa5, 0x7a, 0x66, 0x52, 0x9f, 0x0b, 0xed, 0xec, 0x51, 0x78, 0x02, 0xe6, 0xd3, 0xcb,
+	0x24, 0x47, 0xdf, 0xf6, 0x20, 0xf7, 0x80, 0xb4, 0x0c, 0x7c, 0xf4, 0x49, 0xf7, 0x80, 0x36, 0x02,
+	0xdf, 0x05, 0x0b, 0x19, 0xab, 0x23, 0x9e, 0x1e, 0x10, 0x4f, 0x1b, 0x83, 0x2f, 0x2d, 0x71, 0x35,
+	0xdb, 0xce, 0x18, 0xc2, 0xfd, 0x80, 0xa0, 0xe3, 0x07, 0xc7, 0xfc, 0xb8, 0x09, 0xf0, 0x23, 0x36,
+	0xee, 0x07, 0x10, 0x2b, 0x3b, 0x3c, 0x3e, 0x0e, 0x66, 0x29, 0x48, 0xdb, 0xdb, 0xee, 0x73, 0x3d,
+	0x6e, 0xdd, 0x1f, 0xaa, 0x43, 0x42, 0xa3, 0xee, 0xa5, 0x88, 0x80, 0x55, 0xfb, 0x7b, 0xbc, 0x23,
+	0x41, 0xac, 0xac, 0x76, 0x2f, 0x00, 0xfa, 0x93, 0x95, 0x6d, 0xc8, 0x7a, 0x20, 0x80, 0x18, 0x69,
+	0x15, 0x9e, 0x07, 0x80, 0x41, 0xf0, 0x7d, 0x18, 0xe1, 0x07, 0xd1, 0xfb, 0x43, 0xf5, 0x71, 0x8a,
+	0xc0, 0xf7, 0x96, 0xb2, 0x54, 0xc7, 0x46, 0x31, 0x6e, 0x12, 0x28, 0x4b, 0x75, 0xec, 0xc4, 0x11,
+	0xd5, 0x9e, 0x1e, 0x7e, 0x3c, 0x16, 0x8e, 0xa8, 0x98, 0x08, 0x1e, 0x26, 0x24, 0xcf, 0xf0, 0xa3,
+	0xb1, 0xe0, 0x61, 0xc2, 0x50, 0xe5, 0xd1, 0x90, 0x6d, 0x7b, 0x7f, 0xf0, 0x47, 0x3c, 0x11, 0x33,
+	0xd9, 0x9e, 0x5d, 0xe9, 0x69, 0x8c, 0x88, 0x0c, 0xeb, 0xcb, 0xa1, 0x5f, 0x1a, 0x24, 0xf7, 0x8b,
+	0x2b, 0xb4, 0x71, 0xb7, 0xc2, 0x9b, 0x41, 0x2b, 0x78, 0xa9, 0xef, 0x78, 0xad, 0x9e, 0x9f, 0x3c,
+	0xa6, 0x61, 0xd3, 0x23, 0x3a, 0x0f, 0xd6, 0xc1, 0xbc, 0xda, 0xa3, 0x11, 0x8c, 0xbf, 0x32, 0xd8,
+	0xa3, 0xad, 0xce, 0x48, 0xf4, 0x8e, 0x52, 0xce, 0x2a, 0x9d, 0x9c, 0x1c, 0x4e, 0xc7, 0x16, 0x9c,
+	0xbf, 0xee, 0xc3, 0xe9, 0xd8, 0x69, 0x4e, 0xc7, 0xe6, 0x9c, 0x87, 0xd2, 0x43, 0x7e, 0x4f, 0x0d,
+	0xf4, 0x37, 0x94, 0xf4, 0xa5, 0x14, 0xe9, 0xa1, 0x14, 0xe9, 0x9c, 0xda, 0x24, 0xca, 0xa3, 0x95,
+	0x62, 0xfd, 0x6d, 0x3f, 0x5a, 0xc7, 0xce, 0xa0, 0x75, 0xec, 0xac, 0x0c, 0x90, 0xc2, 0x11, 0xac,
+	0xbf, 0xcb, 0xcb, 0x00, 0xa9, 0x25, 0x2d, 0x03, 0xc4, 0x96, 0x15, 0x2a, 0xad, 0x34, 0x41, 0xfa,
+	0xfb, 0xbc, 0x50, 0x69, 0xf1, 0x69, 0xa1, 0x52, 0x63, 0x16, 0x2d, 0x53, 0x18, 0x4e, 0xfb, 0x87,
+	0x3c, 0x5a, 0x7a, 0x13, 0x6a, 0xb4, 0xd4, 0x98, 0x95, 0x01, 0x72, 0x8f, 0x0a, 0xd6, 0x3f, 0xe6,
+	0x65, 0x80, 0xdc, 0xb6, 0x5a, 0x06, 0x88, 0x8d, 0x73, 0xee, 0x4a, 0x0f, 0x07, 0x4a, 0xf1, 0xff,
+	0xc9, 0xb0, 0xcc, 0xb3, 0x8a, 0x5f, 0x7e, 0x28, 0x94, 0x82, 0x54, 0x5b, 0x06, 0x82, 0xf1, 0xcf,
+	0x06, 0x7b, 0xd2, 0xea, 0x57, 0xfc, 0x4a, 0x63, 0x21, 0x87, 0x53, 0x2a, 0xa8, 0xbf, 0xf4, 0xe1,
+	0x14, 0xc5, 0xaf, 0x74, 0x21, 0xa4, 0x3d, 0xd2, 0x9a, 0x11, 0x82, 0xf4, 0xaf, 0x94, 0xf4, 0x8c,
+	0xe2, 0x57, 0x7b, 0x16, 0x79, 0xb4, 0x52, 0xac, 0x7f, 0xeb, 0x47, 0x2b, 0x8a, 0x5f, 0xed, 0x70,
+	0x64, 0x65, 0x40, 0x2d, 0xfe, 0xbf, 0xe7, 0x65, 0x40, 0x2e, 0x7e, 0xa5, 0x19, 0x90, 0x15, 0xaa,
+	0x56, 0xfc, 0xff, 0xc8, 0x0b, 0x55, 0x29, 0x7e, 0xb5, 0x75, 0x90, 0x45, 0xab, 0x15, 0xff, 0x3f,
+	0xf3, 0x68, 0x95, 0xe2, 0x57, 0x9f, 0x45, 0xb3, 0x32, 0xa0, 0x16, 0xff, 0xbf, 0xf2, 0x32, 0x20,
+	0x17, 0xbf, 0xd2, 0x70, 0xe0, 0x9c, 0xf7, 0xa4, 0xbe, 0x2e, 0x7f, 0x1b, 0x84, 0xbe, 0x5b, 0x60,
+	0x7d, 0xb2, 0xd4, 0xda, 0x19, 0x22, 0xe9, 0xf9, 0x72, 0x0b, 0x7c, 0x00, 0x44, 0xd3, 0xd0, 0x15,
+	0xaf, 0x7d, 0xd0, 0xf7, 0x0a, 0x39, 0xe7, 0xc7, 0x01, 0x87, 0xd4, 0x85, 0x7f, 0x61, 0x82, 0x1f,
+	0x05, 0x33, 0x52, 0x13, 0x9b, 0xbf, 0x82, 0x42, 0xdf, 0xcf, 0x23, 0xab, 0x61, 0xcc, 0x43, 0x2f,
+	0x7a, 0x9a, 0x90, 0x09, 0x13, 0xdc, 0x52, 0xfb, 0xc2, 0xbd, 0x46, 0x8c, 0x7e, 0x40, 0x89, 0x16,
+	0xb2, 0x36, 0xa1, 0xd7, 0x88, 0x95, 0x8e, 0x71, 0xaf, 0x11, 0xc3, 0x4d, 0x20, 0x7a, 0x8b, 0xae,
+	0xd7, 0x39, 0x45, 0x3f, 0xa4, 0xf3, 0x67, 0x53, 0xf3, 0xb7, 0x3a, 0xa7, 0xf5, 0x22, 0x87, 0x6e,
+	0x75, 0x4e, 0xe1, 0x2d, 0xa9, 0xd7, 0xfc, 0x0c, 0x6f, 0x03, 0xfa, 0x11, 0x9d, 0x3b, 0x9f, 0x9a,
+	0x4b, 0x77, 0x49, 0x74, 0x37, 0xc9, 0x4f, 0xbc, 0x3d, 0x49, 0x81, 0xf2, 0xed, 0xf9, 0x71, 0xc1,
+	0x32, 0xcf, 0xd8, 0x1e, 0x51, 0x97, 0xd2, 0xf6, 0x08, 0xa2, 0x64, 0x7b, 0x7e, 0x52, 0xc8, 0x51,
+	0x38, 0x69, 0x7b, 0xf8, 0xb4, 0x64, 0x7b, 0x64, 0x2e, 0xb2, 0x3d, 0x64, 0x77, 0x7e, 0x9a, 0xc7,
+	0x25, 0xed, 0x4e, 0xd2, 0x14, 0x64, 0xb3, 0xf0, 0xee, 0xc8, 0xb7, 0x0a, 0xde, 0x9d, 0x5f, 0x50,
+	0xa2, 0xfc, 0xdd, 0x91, 0xee, 0x0e, 0xb6, 0x3b, 0x82, 0x02, 0xef, 0xce, 0xcf, 0xe8, 0xfc, 0x9c,
+	0xdd, 0xe1, 0x50, 0xb6, 0x3b, 0x62, 0x26, 0xdd, 0x9d, 0x9f, 0xd3, 0xb9, 0xb9, 0xbb, 0xc3, 0xe1,
+	0x74, 0x77, 0xce, 0x03, 0x40, 0xd6, 0xdf, 0xf1, 0xda, 0xfe, 0x1a, 0xfa, 0x94, 0x49, 0x5e, 0x43,
+	0x49, 0x26, 0x68, 0x81, 0x22, 0xf9, 0xe5, 0xe2, 0x9f, 0xeb, 0xe8, 0xd3, 0x32, 0x62, 0x07, 0x9b,
+	0xe0, 0x05, 0x50, 0x72, 0x13, 0xc8, 0x06, 0xfa, 0x0c, 0x83, 0xd4, 0x38, 0x64, 0x03, 0x2e, 0x81,
+	0x09, 0x8a, 0x20, 0x10, 0xdb, 0x45, 0x9f, 0xd5, 0x69, 0xc8, 0xdf, 0x93, 0xe4, 0xd7, 0x2a, 0x86,
+	0x5c, 0x43, 0x9f, 0xa3, 0x08, 0xd9, 0x86, 0xdb, 0x9a, 0x94, 0x66, 0x95, 0xf0, 0x38, 0xe8, 0xf3,
+	0x0a, 0x08, 0xf3, 0x38, 0x62, 0x45, 0xf8, 0xd7, 0x75, 0xf4, 0x05, 0xdd, 0xd1, 0x75, 0x0c, 0x10,
+	0xa1, 0x6d, 0xa2, 0x2f, 0xea, 0xd1, 0x6e, 0x26, 0x4b, 0xc6, 0x3f, 0x6f, 0xa0, 0x2f, 0xe9, 0x14,
+	0x37, 0xe0, 0x12, 0x28, 0xd5, 0x04, 0x62, 0x6d, 0x15, 0x7d, 0x99, 0xc5, 0x21, 0x48, 0xd6, 0x56,
+	0x09, 0x66, 0xbb, 0xfa, 0xf6, 0x5d, 0x77, 0x67, 0xeb, 0x61, 0x75, 0x6d, 0x0d, 0x7d, 0x85, 0x63,
+	0xb0, 0x91, 0xda, 0x12, 0x0c, 0xc9, 0xf5, 0x3a, 0xfa, 0xaa, 0x82, 0x21, 0x36, 0xf8, 0x0a, 0x98,
+	0x74, 0xa5, 0xfc, 0xae, 0x6d, 0xa0, 0xaf, 0xa5, 0xbc, 0x6d, 0x50, 0x54, 0x2d, 0x41, 0xd9, 0xe8,
+	0xeb, 0x29, 0x94, 0x9d, 0x24, 0x90, 0x82, 0xae, 0xa1, 0x6f, 0xc8, 0x09, 0x24, 0x20, 0x29, 0xcb,
+	0x74, 0x75, 0x0e, 0xfa, 0x66, 0x0a, 0xe4, 0x60, 0x7f, 0x52, 0x4c, 0xd7, 0x5d, 0x17, 0x7d, 0x2b,
+	0x85, 0xba, 0x8e, 0x51, 0x52, 0x4c, 0x9b, 0xae, 0x8b, 0xbe, 0x9d, 0x8a, 0x6a, 0x73, 0xf1, 0x31,
+	0x98, 0x50, 0x1f, 0x74, 0x4a, 0xc0, 0xf0, 0xd8, 0x1b, 0x51, 0xc3, 0x83, 0x6f, 0x82, 0x62, 0x23,
+	0x10, 0x2f, 0x35, 0x50, 0xe1, 0xac, 0x17, 0x20, 0x32, 0x7a, 0xf1, 0x36, 0x80, 0xe9, 0x26, 0x25,
+	0x2c, 0x03, 0xf3, 0xa9, 0x7f, 0xca, 0x5c, 0xe0, 0xaf, 0x70, 0x16, 0xbc, 0x40, 0x6f, 0x9f, 0x02,
+	0xb1, 0xd1, 0x1f, 0x37, 0x0b, 0x9b, 0x46, 0xc2, 0x20, 0x37, 0x24, 0x65, 0x06, 0x33, 0x83, 0xc1,
+	0x94, 0x19, 0x2a, 0x60, 0x36, 0xab, 0xf5, 0x28, 0x73, 0x4c, 0x64, 0x70, 0x4c, 0x64, 0x73, 0x28,
+	0x2d, 0x46, 0x99, 0x63, 0x38, 0x83, 0x63, 0x38, 0xcd, 0x91, 0x6a, 0x25, 0xca, 0x1c, 0xd3, 0x19,
+	0x1c, 0xd3, 0xd9, 0x1c, 0x4a, 0xcb, 0x50, 0xe6, 0x80, 0x19, 0x1c, 0x50, 0xe6, 0xb8, 0x0b, 0xe6,
+	0xb3, 0x1b, 0x83, 0x32, 0xcb, 0x68, 0x06, 0xcb, 0x68, 0x0e, 0x8b, 0xda, 0xfc, 0x93, 0x59, 0x46,
+	0x32, 0x58, 0x46, 0x64, 0x96, 0x1a, 0x40, 0x79, 0xed, 0x3d, 0x99, 0x67, 0x2a, 0x83, 0x67, 0x2a,
+	0x8f, 0x47, 0x6b, 0xdf, 0xc9, 0x3c, 0xe5, 0x0c, 0x9e, 0x72, 0x66, 0xb5, 0xc9, 0x4d, 0xba, 0xb3,
+	0xea, 0xb5, 0x20, 0x33, 0x6c, 0x81, 0x99, 0x8c, 0x7e, 0xdc, 0x59, 0x14, 0x86, 0x4c, 0x71, 0x0b,
+	0x94, 0xf5, 0xe6, 0x9b, 0x3c, 0x7f, 0x2c, 0x63, 0xfe, 0x58, 0x46, 0x91, 0xe8, 0x8d, 0x36, 0x99,
+	0x63, 0x3c, 0x83, 0x63, 0x3c, 0xbd, 0x0c, 0xbd, 0xa3, 0x76, 0x16, 0x45, 0x49, 0xa6, 0x08, 0xc1,
+	0x8b, 0x7d, 0x5a, 0x66, 0x19, 0x54, 0x6f, 0xc9, 0x54, 0xcf, 0xf1, 0xbe, 0x4a, 0xf2, 0x79, 0x02,
+	0x5e, 0xea, 0xd7, 0x33, 0xcb, 0x70, 0xba, 0xa6, 0x3a, 0xed, 0xfb, 0x0a, 0x4b, 0x72, 0xd4, 0x02,
+	0x48, 0x38, 0xd2, 0x7a, 0x65, 0x19, 0x4e, 0x6e, 0xca, 0x4e, 0x06, 0x7d, 0xa9, 0x25, 0x79, 0xf3,
+	0xc0, 0xb9, 0xdc, 0x7e, 0x59, 0x86, 0xbb, 0x15, 0xd5, 0x5d, 0xfe, 0xab, 0xae, 0xc4, 0xc5, 0xd2,
+	0x0d, 0x00, 0x12, 0xdf, 0x70, 0x14, 0x98, 0xb5, 0xdd, 0xdd, 0xf2, 0x10, 0xfe, 0x52, 0xd9, 0xaa,
+	0x97, 0x0d, 0xfa, 0xe5, 0x71, 0xb9, 0x80, 0xdd, 0xed, 0x54, 0xef, 0x95, 0xff, 0xcb, 0xff, 0x33,
+	0x2a, 0x13, 0xa2, 0x15, 0x85, 0x4f, 0x95, 0xa5, 0x97, 0xc1, 0xa4, 0xd6, 0x90, 0x2c, 0x01, 0xa3,
+	0xc1, 0x0f, 0x94, 0xc6, 0x95, 0x3d, 0x00, 0x92, 0x7f, 0xe8, 0x04, 0xa7, 0x40, 0xf1, 0x70, 0x67,
+	0x7f, 0xaf, 0x7a, 0x67, 0xbb, 0xb6, 0x5d, 0xbd, 0x5b, 0x1e, 0x82, 0x25, 0x30, 0xb6, 0x57, 0xdf,
+	0x3d, 0xd8, 0xad, 0x1c, 0xd6, 0xca, 0x06, 0x1c, 0x03, 0xc3, 0x0f, 0xf6, 0x77, 0x77, 0xca, 0x05,
+	0xfa, 0x6d, 0xaf, 0x52, 0x36, 0xf1, 0x94, 0x83, 0xea, 0xc7, 0x0e, 0xdc, 0xda, 0x6e, 0xfd, 0xe1,
+	0xd6, 0x41, 0x79, 0xf8, 0xca, 0x6d, 0x50, 0x94, 0x5b, 0x85, 0x53, 0xa0, 0x58, 0xdb, 0xad, 0x57,
+	0xb7, 0xef, 0xed, 0xb8, 0x74, 0x11, 0x92, 0x81, 0x2e, 0x46, 0x31, 0x3c, 0x2e, 0x17, 0x2a, 0x17,
+	0xc0, 0xf9, 0x46, 0xd0, 0x4e, 0xfd, 0xcd, 0x26, 0xe5, 0xed, 0xc9, 0x08, 0xb1, 0x6e, 0xfc, 0x6f,
+	0x00, 0x42, 0xe0, 0x4b, 0xc4, 0x50, 0x27, 0x00, 0x00,
 }
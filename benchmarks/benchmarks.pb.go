@@ -0,0 +1,2204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: benchmarks.proto
+
+/*
+Package benchmarks is a generated protocol buffer package.
+
+Package benchmarks holds messages shaped like the requests and
+responses of carno services, with the methods of the fastpath, clone
+and equal plugins, to measure their encoding, cloning and comparison.
+
+It is generated from these files:
+
+	benchmarks.proto
+
+It has these top-level messages:
+
+	Small
+	Medium
+	Address
+	Large
+	MapHeavy
+	RepeatedHeavy
+*/
+package benchmarks
+
+import (
+	binary "encoding/binary"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Status int32
+
+const (
+	Status_UNKNOWN   Status = 0
+	Status_ACTIVE    Status = 1
+	Status_SUSPENDED Status = 2
+	Status_DELETED   Status = 3
+)
+
+var Status_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ACTIVE",
+	2: "SUSPENDED",
+	3: "DELETED",
+}
+var Status_value = map[string]int32{
+	"UNKNOWN":   0,
+	"ACTIVE":    1,
+	"SUSPENDED": 2,
+	"DELETED":   3,
+}
+
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+func (Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+// Small is a point lookup: a request header and a key.
+type Small struct {
+	RequestId        uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	Method           string `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
+	DeadlineUnixNano int64  `protobuf:"varint,3,opt,name=deadline_unix_nano,json=deadlineUnixNano" json:"deadline_unix_nano,omitempty"`
+	Idempotent       bool   `protobuf:"varint,4,opt,name=idempotent" json:"idempotent,omitempty"`
+	Key              []byte `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *Small) Reset()                    { *m = Small{} }
+func (m *Small) String() string            { return proto.CompactTextString(m) }
+func (*Small) ProtoMessage()               {}
+func (*Small) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Small) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *Small) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Small) GetDeadlineUnixNano() int64 {
+	if m != nil {
+		return m.DeadlineUnixNano
+	}
+	return 0
+}
+
+func (m *Small) GetIdempotent() bool {
+	if m != nil {
+		return m.Idempotent
+	}
+	return false
+}
+
+func (m *Small) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// Medium is a typical entity returned by a read.
+type Medium struct {
+	Header      *Small   `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Id          string   `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	DisplayName string   `protobuf:"bytes,3,opt,name=display_name,json=displayName" json:"display_name,omitempty"`
+	Email       string   `protobuf:"bytes,4,opt,name=email" json:"email,omitempty"`
+	Status      Status   `protobuf:"varint,5,opt,name=status,enum=benchmarks.Status" json:"status,omitempty"`
+	CreatedUnix int64    `protobuf:"varint,6,opt,name=created_unix,json=createdUnix" json:"created_unix,omitempty"`
+	UpdatedUnix int64    `protobuf:"varint,7,opt,name=updated_unix,json=updatedUnix" json:"updated_unix,omitempty"`
+	Score       float64  `protobuf:"fixed64,8,opt,name=score" json:"score,omitempty"`
+	Tags        []string `protobuf:"bytes,9,rep,name=tags" json:"tags,omitempty"`
+	Address     *Address `protobuf:"bytes,10,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *Medium) Reset()                    { *m = Medium{} }
+func (m *Medium) String() string            { return proto.CompactTextString(m) }
+func (*Medium) ProtoMessage()               {}
+func (*Medium) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Medium) GetHeader() *Small {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *Medium) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Medium) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *Medium) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Medium) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (m *Medium) GetCreatedUnix() int64 {
+	if m != nil {
+		return m.CreatedUnix
+	}
+	return 0
+}
+
+func (m *Medium) GetUpdatedUnix() int64 {
+	if m != nil {
+		return m.UpdatedUnix
+	}
+	return 0
+}
+
+func (m *Medium) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *Medium) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Medium) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type Address struct {
+	Street     string  `protobuf:"bytes,1,opt,name=street" json:"street,omitempty"`
+	City       string  `protobuf:"bytes,2,opt,name=city" json:"city,omitempty"`
+	Country    string  `protobuf:"bytes,3,opt,name=country" json:"country,omitempty"`
+	PostalCode string  `protobuf:"bytes,4,opt,name=postal_code,json=postalCode" json:"postal_code,omitempty"`
+	Latitude   float32 `protobuf:"fixed32,5,opt,name=latitude" json:"latitude,omitempty"`
+	Longitude  float32 `protobuf:"fixed32,6,opt,name=longitude" json:"longitude,omitempty"`
+}
+
+func (m *Address) Reset()                    { *m = Address{} }
+func (m *Address) String() string            { return proto.CompactTextString(m) }
+func (*Address) ProtoMessage()               {}
+func (*Address) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Address) GetStreet() string {
+	if m != nil {
+		return m.Street
+	}
+	return ""
+}
+
+func (m *Address) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+func (m *Address) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *Address) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
+	}
+	return ""
+}
+
+func (m *Address) GetLatitude() float32 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *Address) GetLongitude() float32 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+// Large is a page of results from a list call, nested three levels deep.
+type Large struct {
+	Header        *Small      `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Items         []*Medium   `protobuf:"bytes,2,rep,name=items" json:"items,omitempty"`
+	NextPageToken string      `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+	Page          *Large_Page `protobuf:"bytes,4,opt,name=page" json:"page,omitempty"`
+	Payload       []byte      `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *Large) Reset()                    { *m = Large{} }
+func (m *Large) String() string            { return proto.CompactTextString(m) }
+func (*Large) ProtoMessage()               {}
+func (*Large) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Large) GetHeader() *Small {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *Large) GetItems() []*Medium {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Large) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *Large) GetPage() *Large_Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *Large) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type Large_Page struct {
+	Offset      uint32    `protobuf:"varint,1,opt,name=offset" json:"offset,omitempty"`
+	Limit       uint32    `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	Total       uint64    `protobuf:"varint,3,opt,name=total" json:"total,omitempty"`
+	Highlighted []*Medium `protobuf:"bytes,4,rep,name=highlighted" json:"highlighted,omitempty"`
+}
+
+func (m *Large_Page) Reset()                    { *m = Large_Page{} }
+func (m *Large_Page) String() string            { return proto.CompactTextString(m) }
+func (*Large_Page) ProtoMessage()               {}
+func (*Large_Page) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3, 0} }
+
+func (m *Large_Page) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Large_Page) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *Large_Page) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *Large_Page) GetHighlighted() []*Medium {
+	if m != nil {
+		return m.Highlighted
+	}
+	return nil
+}
+
+// MapHeavy is a bag of metadata keyed by name, as carried by
+// configuration and routing messages.
+type MapHeavy struct {
+	Labels   map[string]string  `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Counters map[string]int64   `protobuf:"bytes,2,rep,name=counters" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ById     map[uint64]*Medium `protobuf:"bytes,3,rep,name=by_id,json=byId" json:"by_id,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Blobs    map[string][]byte  `protobuf:"bytes,4,rep,name=blobs" json:"blobs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *MapHeavy) Reset()                    { *m = MapHeavy{} }
+func (m *MapHeavy) String() string            { return proto.CompactTextString(m) }
+func (*MapHeavy) ProtoMessage()               {}
+func (*MapHeavy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *MapHeavy) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *MapHeavy) GetCounters() map[string]int64 {
+	if m != nil {
+		return m.Counters
+	}
+	return nil
+}
+
+func (m *MapHeavy) GetById() map[uint64]*Medium {
+	if m != nil {
+		return m.ById
+	}
+	return nil
+}
+
+func (m *MapHeavy) GetBlobs() map[string][]byte {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+// RepeatedHeavy is a batch of samples, as sent by metrics and tracing
+// clients.
+type RepeatedHeavy struct {
+	Timestamps []int64   `protobuf:"varint,1,rep,packed,name=timestamps" json:"timestamps,omitempty"`
+	Values     []float64 `protobuf:"fixed64,2,rep,packed,name=values" json:"values,omitempty"`
+	Deltas     []int32   `protobuf:"zigzag32,3,rep,packed,name=deltas" json:"deltas,omitempty"`
+	Hashes     []uint64  `protobuf:"fixed64,4,rep,packed,name=hashes" json:"hashes,omitempty"`
+	Names      []string  `protobuf:"bytes,5,rep,name=names" json:"names,omitempty"`
+	Chunks     [][]byte  `protobuf:"bytes,6,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	Requests   []*Small  `protobuf:"bytes,7,rep,name=requests" json:"requests,omitempty"`
+	Flags      []bool    `protobuf:"varint,8,rep,packed,name=flags" json:"flags,omitempty"`
+}
+
+func (m *RepeatedHeavy) Reset()                    { *m = RepeatedHeavy{} }
+func (m *RepeatedHeavy) String() string            { return proto.CompactTextString(m) }
+func (*RepeatedHeavy) ProtoMessage()               {}
+func (*RepeatedHeavy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *RepeatedHeavy) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetDeltas() []int32 {
+	if m != nil {
+		return m.Deltas
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetHashes() []uint64 {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetChunks() [][]byte {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetRequests() []*Small {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetFlags() []bool {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Small)(nil), "benchmarks.Small")
+	proto.RegisterType((*Medium)(nil), "benchmarks.Medium")
+	proto.RegisterType((*Address)(nil), "benchmarks.Address")
+	proto.RegisterType((*Large)(nil), "benchmarks.Large")
+	proto.RegisterType((*Large_Page)(nil), "benchmarks.Large.Page")
+	proto.RegisterType((*MapHeavy)(nil), "benchmarks.MapHeavy")
+	proto.RegisterType((*RepeatedHeavy)(nil), "benchmarks.RepeatedHeavy")
+	proto.RegisterEnum("benchmarks.Status", Status_name, Status_value)
+}
+
+// CloneMessage returns a deep copy of m.
+func (m *Small) CloneMessage() *Small {
+	if m == nil {
+		return nil
+	}
+	c := new(Small)
+	c.RequestId = m.RequestId
+	c.Method = m.Method
+	c.DeadlineUnixNano = m.DeadlineUnixNano
+	c.Idempotent = m.Idempotent
+	if m.Key != nil {
+		c.Key = append([]byte{}, m.Key...)
+	}
+	return c
+}
+
+// CloneMessage returns a deep copy of m.
+func (m *Medium) CloneMessage() *Medium {
+	if m == nil {
+		return nil
+	}
+	c := new(Medium)
+	c.Header = m.Header.CloneMessage()
+	c.Id = m.Id
+	c.DisplayName = m.DisplayName
+	c.Email = m.Email
+	c.Status = m.Status
+	c.CreatedUnix = m.CreatedUnix
+	c.UpdatedUnix = m.UpdatedUnix
+	c.Score = m.Score
+	if m.Tags != nil {
+		c.Tags = make([]string, len(m.Tags))
+		copy(c.Tags, m.Tags)
+	}
+	c.Address = m.Address.CloneMessage()
+	return c
+}
+
+// CloneMessage returns a deep copy of m.
+func (m *Address) CloneMessage() *Address {
+	if m == nil {
+		return nil
+	}
+	c := new(Address)
+	c.Street = m.Street
+	c.City = m.City
+	c.Country = m.Country
+	c.PostalCode = m.PostalCode
+	c.Latitude = m.Latitude
+	c.Longitude = m.Longitude
+	return c
+}
+
+// CloneMessage returns a deep copy of m.
+func (m *Large) CloneMessage() *Large {
+	if m == nil {
+		return nil
+	}
+	c := new(Large)
+	c.Header = m.Header.CloneMessage()
+	if m.Items != nil {
+		c.Items = make([]*Medium, len(m.Items))
+		for i, x := range m.Items {
+			c.Items[i] = x.CloneMessage()
+		}
+	}
+	c.NextPageToken = m.NextPageToken
+	c.Page = m.Page.CloneMessage()
+	if m.Payload != nil {
+		c.Payload = append([]byte{}, m.Payload...)
+	}
+	return c
+}
+
+// CloneMessage returns a deep copy of m.
+func (m *Large_Page) CloneMessage() *Large_Page {
+	if m == nil {
+		return nil
+	}
+	c := new(Large_Page)
+	c.Offset = m.Offset
+	c.Limit = m.Limit
+	c.Total = m.Total
+	if m.Highlighted != nil {
+		c.Highlighted = make([]*Medium, len(m.Highlighted))
+		for i, x := range m.Highlighted {
+			c.Highlighted[i] = x.CloneMessage()
+		}
+	}
+	return c
+}
+
+// CloneMessage returns a deep copy of m.
+func (m *MapHeavy) CloneMessage() *MapHeavy {
+	if m == nil {
+		return nil
+	}
+	c := new(MapHeavy)
+	if m.Labels != nil {
+		c.Labels = make(map[string]string, len(m.Labels))
+		for k, v := range m.Labels {
+			c.Labels[k] = v
+		}
+	}
+	if m.Counters != nil {
+		c.Counters = make(map[string]int64, len(m.Counters))
+		for k, v := range m.Counters {
+			c.Counters[k] = v
+		}
+	}
+	if m.ById != nil {
+		c.ById = make(map[uint64]*Medium, len(m.ById))
+		for k, v := range m.ById {
+			c.ById[k] = v.CloneMessage()
+		}
+	}
+	if m.Blobs != nil {
+		c.Blobs = make(map[string][]byte, len(m.Blobs))
+		for k, v := range m.Blobs {
+			if v != nil {
+				v = append([]byte{}, v...)
+			}
+			c.Blobs[k] = v
+		}
+	}
+	return c
+}
+
+// CloneMessage returns a deep copy of m.
+func (m *RepeatedHeavy) CloneMessage() *RepeatedHeavy {
+	if m == nil {
+		return nil
+	}
+	c := new(RepeatedHeavy)
+	if m.Timestamps != nil {
+		c.Timestamps = make([]int64, len(m.Timestamps))
+		copy(c.Timestamps, m.Timestamps)
+	}
+	if m.Values != nil {
+		c.Values = make([]float64, len(m.Values))
+		copy(c.Values, m.Values)
+	}
+	if m.Deltas != nil {
+		c.Deltas = make([]int32, len(m.Deltas))
+		copy(c.Deltas, m.Deltas)
+	}
+	if m.Hashes != nil {
+		c.Hashes = make([]uint64, len(m.Hashes))
+		copy(c.Hashes, m.Hashes)
+	}
+	if m.Names != nil {
+		c.Names = make([]string, len(m.Names))
+		copy(c.Names, m.Names)
+	}
+	if m.Chunks != nil {
+		c.Chunks = make([][]byte, len(m.Chunks))
+		for i, b := range m.Chunks {
+			if b != nil {
+				c.Chunks[i] = append([]byte{}, b...)
+			}
+		}
+	}
+	if m.Requests != nil {
+		c.Requests = make([]*Small, len(m.Requests))
+		for i, x := range m.Requests {
+			c.Requests[i] = x.CloneMessage()
+		}
+	}
+	if m.Flags != nil {
+		c.Flags = make([]bool, len(m.Flags))
+		copy(c.Flags, m.Flags)
+	}
+	return c
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *Small) EqualMessage(o *Small) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.RequestId != o.RequestId {
+		return false
+	}
+	if m.Method != o.Method {
+		return false
+	}
+	if m.DeadlineUnixNano != o.DeadlineUnixNano {
+		return false
+	}
+	if m.Idempotent != o.Idempotent {
+		return false
+	}
+	if string(m.Key) != string(o.Key) {
+		return false
+	}
+	return true
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *Medium) EqualMessage(o *Medium) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if !m.Header.EqualMessage(o.Header) {
+		return false
+	}
+	if m.Id != o.Id {
+		return false
+	}
+	if m.DisplayName != o.DisplayName {
+		return false
+	}
+	if m.Email != o.Email {
+		return false
+	}
+	if m.Status != o.Status {
+		return false
+	}
+	if m.CreatedUnix != o.CreatedUnix {
+		return false
+	}
+	if m.UpdatedUnix != o.UpdatedUnix {
+		return false
+	}
+	if m.Score != o.Score {
+		return false
+	}
+	if len(m.Tags) != len(o.Tags) {
+		return false
+	}
+	for i, v := range m.Tags {
+		if v != o.Tags[i] {
+			return false
+		}
+	}
+	if !m.Address.EqualMessage(o.Address) {
+		return false
+	}
+	return true
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *Address) EqualMessage(o *Address) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.Street != o.Street {
+		return false
+	}
+	if m.City != o.City {
+		return false
+	}
+	if m.Country != o.Country {
+		return false
+	}
+	if m.PostalCode != o.PostalCode {
+		return false
+	}
+	if m.Latitude != o.Latitude {
+		return false
+	}
+	if m.Longitude != o.Longitude {
+		return false
+	}
+	return true
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *Large) EqualMessage(o *Large) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if !m.Header.EqualMessage(o.Header) {
+		return false
+	}
+	if len(m.Items) != len(o.Items) {
+		return false
+	}
+	for i, v := range m.Items {
+		if !v.EqualMessage(o.Items[i]) {
+			return false
+		}
+	}
+	if m.NextPageToken != o.NextPageToken {
+		return false
+	}
+	if !m.Page.EqualMessage(o.Page) {
+		return false
+	}
+	if string(m.Payload) != string(o.Payload) {
+		return false
+	}
+	return true
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *Large_Page) EqualMessage(o *Large_Page) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.Offset != o.Offset {
+		return false
+	}
+	if m.Limit != o.Limit {
+		return false
+	}
+	if m.Total != o.Total {
+		return false
+	}
+	if len(m.Highlighted) != len(o.Highlighted) {
+		return false
+	}
+	for i, v := range m.Highlighted {
+		if !v.EqualMessage(o.Highlighted[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *MapHeavy) EqualMessage(o *MapHeavy) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if len(m.Labels) != len(o.Labels) {
+		return false
+	}
+	for k, v := range m.Labels {
+		if w, ok := o.Labels[k]; !ok || v != w {
+			return false
+		}
+	}
+	if len(m.Counters) != len(o.Counters) {
+		return false
+	}
+	for k, v := range m.Counters {
+		if w, ok := o.Counters[k]; !ok || v != w {
+			return false
+		}
+	}
+	if len(m.ById) != len(o.ById) {
+		return false
+	}
+	for k, v := range m.ById {
+		if w, ok := o.ById[k]; !ok || !v.EqualMessage(w) {
+			return false
+		}
+	}
+	if len(m.Blobs) != len(o.Blobs) {
+		return false
+	}
+	for k, v := range m.Blobs {
+		if w, ok := o.Blobs[k]; !ok || (v == nil) != (w == nil) || string(v) != string(w) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *RepeatedHeavy) EqualMessage(o *RepeatedHeavy) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if len(m.Timestamps) != len(o.Timestamps) {
+		return false
+	}
+	for i, v := range m.Timestamps {
+		if v != o.Timestamps[i] {
+			return false
+		}
+	}
+	if len(m.Values) != len(o.Values) {
+		return false
+	}
+	for i, v := range m.Values {
+		if v != o.Values[i] {
+			return false
+		}
+	}
+	if len(m.Deltas) != len(o.Deltas) {
+		return false
+	}
+	for i, v := range m.Deltas {
+		if v != o.Deltas[i] {
+			return false
+		}
+	}
+	if len(m.Hashes) != len(o.Hashes) {
+		return false
+	}
+	for i, v := range m.Hashes {
+		if v != o.Hashes[i] {
+			return false
+		}
+	}
+	if len(m.Names) != len(o.Names) {
+		return false
+	}
+	for i, v := range m.Names {
+		if v != o.Names[i] {
+			return false
+		}
+	}
+	if len(m.Chunks) != len(o.Chunks) {
+		return false
+	}
+	for i, v := range m.Chunks {
+		if string(v) != string(o.Chunks[i]) {
+			return false
+		}
+	}
+	if len(m.Requests) != len(o.Requests) {
+		return false
+	}
+	for i, v := range m.Requests {
+		if !v.EqualMessage(o.Requests[i]) {
+			return false
+		}
+	}
+	if len(m.Flags) != len(o.Flags) {
+		return false
+	}
+	for i, v := range m.Flags {
+		if v != o.Flags[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Small) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.RequestId != 0 {
+		n += 1 + proto.SizeVarint(m.RequestId)
+	}
+	if len(m.Method) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.Method))) + len(m.Method)
+	}
+	if m.DeadlineUnixNano != 0 {
+		n += 1 + proto.SizeVarint(uint64(m.DeadlineUnixNano))
+	}
+	if m.Idempotent {
+		n += 1 + 1
+	}
+	if len(m.Key) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.Key))) + len(m.Key)
+	}
+	return n
+}
+
+func (m *Small) Marshal() ([]byte, error) {
+	if m == nil {
+		return nil, proto.ErrNil
+	}
+	dAtA := make([]byte, m.Size())
+	n, err := m.MarshalToSizedBuffer(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[len(dAtA)-n:], nil
+}
+
+func (m *Small) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, proto.ErrNil
+	}
+	i := len(dAtA)
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Idempotent {
+		i--
+		if m.Idempotent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DeadlineUnixNano != 0 {
+		i = proto.PrependVarint(dAtA, i, uint64(m.DeadlineUnixNano))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequestId != 0 {
+		i = proto.PrependVarint(dAtA, i, m.RequestId)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Small) Unmarshal(dAtA []byte) error {
+	return m.XXX_UnmarshalDepth(dAtA, proto.DefaultMaxDepth)
+}
+
+func (m *Small) XXX_UnmarshalDepth(dAtA []byte, maxDepth int) error {
+	if maxDepth <= 0 {
+		return proto.ErrTooDeep
+	}
+	for i := 0; i < len(dAtA); {
+		num, wire, n, err := proto.ConsumeTag(dAtA[i:])
+		if err != nil {
+			return err
+		}
+		i += n
+		switch num {
+		case 1:
+			if wire != proto.WireVarint {
+				return fmt.Errorf("proto: bad wiretype for field Small.RequestId: got wiretype %d, want 0", wire)
+			}
+			v, n, err := proto.ConsumeVarint(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.RequestId = v
+		case 2:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Small.Method: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Method = string(v)
+		case 3:
+			if wire != proto.WireVarint {
+				return fmt.Errorf("proto: bad wiretype for field Small.DeadlineUnixNano: got wiretype %d, want 0", wire)
+			}
+			v, n, err := proto.ConsumeVarint(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.DeadlineUnixNano = int64(v)
+		case 4:
+			if wire != proto.WireVarint {
+				return fmt.Errorf("proto: bad wiretype for field Small.Idempotent: got wiretype %d, want 0", wire)
+			}
+			v, n, err := proto.ConsumeVarint(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Idempotent = v != 0
+		case 5:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Small.Key: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Key = append([]byte{}, v...)
+		default:
+			n, err = proto.ConsumeField(dAtA[i:], wire)
+			if err != nil {
+				return err
+			}
+			i += n
+		}
+	}
+	return nil
+}
+
+func (m *Medium) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.Header != nil {
+		l := proto.Size(m.Header)
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if len(m.Id) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.Id))) + len(m.Id)
+	}
+	if len(m.DisplayName) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.DisplayName))) + len(m.DisplayName)
+	}
+	if len(m.Email) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.Email))) + len(m.Email)
+	}
+	if m.Status != 0 {
+		n += 1 + proto.SizeVarint(uint64(m.Status))
+	}
+	if m.CreatedUnix != 0 {
+		n += 1 + proto.SizeVarint(uint64(m.CreatedUnix))
+	}
+	if m.UpdatedUnix != 0 {
+		n += 1 + proto.SizeVarint(uint64(m.UpdatedUnix))
+	}
+	if math.Float64bits(m.Score) != 0 {
+		n += 1 + 8
+	}
+	for _, e := range m.Tags {
+		n += 1 + proto.SizeVarint(uint64(len(e))) + len(e)
+	}
+	if m.Address != nil {
+		l := proto.Size(m.Address)
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Medium) Marshal() ([]byte, error) {
+	if m == nil {
+		return nil, proto.ErrNil
+	}
+	dAtA := make([]byte, m.Size())
+	n, err := m.MarshalToSizedBuffer(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[len(dAtA)-n:], nil
+}
+
+func (m *Medium) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, proto.ErrNil
+	}
+	i := len(dAtA)
+	if m.Address != nil {
+		n, err := proto.MarshalToSizedBuffer(dAtA[:i], m.Address)
+		if err != nil {
+			return 0, err
+		}
+		i -= n
+		i = proto.PrependVarint(dAtA, i, uint64(n))
+		i--
+		dAtA[i] = 0x52
+	}
+	for j := len(m.Tags) - 1; j >= 0; j-- {
+		i -= len(m.Tags[j])
+		copy(dAtA[i:], m.Tags[j])
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.Tags[j])))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if math.Float64bits(m.Score) != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], math.Float64bits(m.Score))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.UpdatedUnix != 0 {
+		i = proto.PrependVarint(dAtA, i, uint64(m.UpdatedUnix))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreatedUnix != 0 {
+		i = proto.PrependVarint(dAtA, i, uint64(m.CreatedUnix))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = proto.PrependVarint(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		n, err := proto.MarshalToSizedBuffer(dAtA[:i], m.Header)
+		if err != nil {
+			return 0, err
+		}
+		i -= n
+		i = proto.PrependVarint(dAtA, i, uint64(n))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Medium) Unmarshal(dAtA []byte) error {
+	return m.XXX_UnmarshalDepth(dAtA, proto.DefaultMaxDepth)
+}
+
+func (m *Medium) XXX_UnmarshalDepth(dAtA []byte, maxDepth int) error {
+	if maxDepth <= 0 {
+		return proto.ErrTooDeep
+	}
+	for i := 0; i < len(dAtA); {
+		num, wire, n, err := proto.ConsumeTag(dAtA[i:])
+		if err != nil {
+			return err
+		}
+		i += n
+		switch num {
+		case 1:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Medium.Header: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			if m.Header == nil {
+				m.Header = &Small{}
+			}
+			if err := proto.UnmarshalMergeDepth(v, m.Header, maxDepth-1); err != nil {
+				return err
+			}
+		case 2:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Medium.Id: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Id = string(v)
+		case 3:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Medium.DisplayName: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.DisplayName = string(v)
+		case 4:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Medium.Email: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Email = string(v)
+		case 5:
+			if wire != proto.WireVarint {
+				return fmt.Errorf("proto: bad wiretype for field Medium.Status: got wiretype %d, want 0", wire)
+			}
+			v, n, err := proto.ConsumeVarint(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Status = Status(v)
+		case 6:
+			if wire != proto.WireVarint {
+				return fmt.Errorf("proto: bad wiretype for field Medium.CreatedUnix: got wiretype %d, want 0", wire)
+			}
+			v, n, err := proto.ConsumeVarint(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.CreatedUnix = int64(v)
+		case 7:
+			if wire != proto.WireVarint {
+				return fmt.Errorf("proto: bad wiretype for field Medium.UpdatedUnix: got wiretype %d, want 0", wire)
+			}
+			v, n, err := proto.ConsumeVarint(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.UpdatedUnix = int64(v)
+		case 8:
+			if wire != proto.WireFixed64 {
+				return fmt.Errorf("proto: bad wiretype for field Medium.Score: got wiretype %d, want 1", wire)
+			}
+			v, n, err := proto.ConsumeFixed64(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Score = math.Float64frombits(v)
+		case 9:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Medium.Tags: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Tags = append(m.Tags, string(v))
+		case 10:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Medium.Address: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			if m.Address == nil {
+				m.Address = &Address{}
+			}
+			if err := proto.UnmarshalMergeDepth(v, m.Address, maxDepth-1); err != nil {
+				return err
+			}
+		default:
+			n, err = proto.ConsumeField(dAtA[i:], wire)
+			if err != nil {
+				return err
+			}
+			i += n
+		}
+	}
+	return nil
+}
+
+func (m *Address) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if len(m.Street) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.Street))) + len(m.Street)
+	}
+	if len(m.City) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.City))) + len(m.City)
+	}
+	if len(m.Country) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.Country))) + len(m.Country)
+	}
+	if len(m.PostalCode) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.PostalCode))) + len(m.PostalCode)
+	}
+	if math.Float32bits(m.Latitude) != 0 {
+		n += 1 + 4
+	}
+	if math.Float32bits(m.Longitude) != 0 {
+		n += 1 + 4
+	}
+	return n
+}
+
+func (m *Address) Marshal() ([]byte, error) {
+	if m == nil {
+		return nil, proto.ErrNil
+	}
+	dAtA := make([]byte, m.Size())
+	n, err := m.MarshalToSizedBuffer(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[len(dAtA)-n:], nil
+}
+
+func (m *Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, proto.ErrNil
+	}
+	i := len(dAtA)
+	if math.Float32bits(m.Longitude) != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(m.Longitude))
+		i--
+		dAtA[i] = 0x35
+	}
+	if math.Float32bits(m.Latitude) != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(m.Latitude))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if len(m.PostalCode) > 0 {
+		i -= len(m.PostalCode)
+		copy(dAtA[i:], m.PostalCode)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.PostalCode)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Street) > 0 {
+		i -= len(m.Street)
+		copy(dAtA[i:], m.Street)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.Street)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Address) Unmarshal(dAtA []byte) error {
+	return m.XXX_UnmarshalDepth(dAtA, proto.DefaultMaxDepth)
+}
+
+func (m *Address) XXX_UnmarshalDepth(dAtA []byte, maxDepth int) error {
+	if maxDepth <= 0 {
+		return proto.ErrTooDeep
+	}
+	for i := 0; i < len(dAtA); {
+		num, wire, n, err := proto.ConsumeTag(dAtA[i:])
+		if err != nil {
+			return err
+		}
+		i += n
+		switch num {
+		case 1:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Address.Street: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Street = string(v)
+		case 2:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Address.City: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.City = string(v)
+		case 3:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Address.Country: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Country = string(v)
+		case 4:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Address.PostalCode: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.PostalCode = string(v)
+		case 5:
+			if wire != proto.WireFixed32 {
+				return fmt.Errorf("proto: bad wiretype for field Address.Latitude: got wiretype %d, want 5", wire)
+			}
+			v, n, err := proto.ConsumeFixed32(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Latitude = math.Float32frombits(v)
+		case 6:
+			if wire != proto.WireFixed32 {
+				return fmt.Errorf("proto: bad wiretype for field Address.Longitude: got wiretype %d, want 5", wire)
+			}
+			v, n, err := proto.ConsumeFixed32(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Longitude = math.Float32frombits(v)
+		default:
+			n, err = proto.ConsumeField(dAtA[i:], wire)
+			if err != nil {
+				return err
+			}
+			i += n
+		}
+	}
+	return nil
+}
+
+func (m *Large) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.Header != nil {
+		l := proto.Size(m.Header)
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	for _, e := range m.Items {
+		l := proto.Size(e)
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if len(m.NextPageToken) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.NextPageToken))) + len(m.NextPageToken)
+	}
+	if m.Page != nil {
+		l := proto.Size(m.Page)
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if len(m.Payload) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.Payload))) + len(m.Payload)
+	}
+	return n
+}
+
+func (m *Large) Marshal() ([]byte, error) {
+	if m == nil {
+		return nil, proto.ErrNil
+	}
+	dAtA := make([]byte, m.Size())
+	n, err := m.MarshalToSizedBuffer(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[len(dAtA)-n:], nil
+}
+
+func (m *Large) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, proto.ErrNil
+	}
+	i := len(dAtA)
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Page != nil {
+		n, err := proto.MarshalToSizedBuffer(dAtA[:i], m.Page)
+		if err != nil {
+			return 0, err
+		}
+		i -= n
+		i = proto.PrependVarint(dAtA, i, uint64(n))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	for j := len(m.Items) - 1; j >= 0; j-- {
+		n, err := proto.MarshalToSizedBuffer(dAtA[:i], m.Items[j])
+		if err != nil {
+			return 0, err
+		}
+		i -= n
+		i = proto.PrependVarint(dAtA, i, uint64(n))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		n, err := proto.MarshalToSizedBuffer(dAtA[:i], m.Header)
+		if err != nil {
+			return 0, err
+		}
+		i -= n
+		i = proto.PrependVarint(dAtA, i, uint64(n))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Large) Unmarshal(dAtA []byte) error {
+	return m.XXX_UnmarshalDepth(dAtA, proto.DefaultMaxDepth)
+}
+
+func (m *Large) XXX_UnmarshalDepth(dAtA []byte, maxDepth int) error {
+	if maxDepth <= 0 {
+		return proto.ErrTooDeep
+	}
+	for i := 0; i < len(dAtA); {
+		num, wire, n, err := proto.ConsumeTag(dAtA[i:])
+		if err != nil {
+			return err
+		}
+		i += n
+		switch num {
+		case 1:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Large.Header: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			if m.Header == nil {
+				m.Header = &Small{}
+			}
+			if err := proto.UnmarshalMergeDepth(v, m.Header, maxDepth-1); err != nil {
+				return err
+			}
+		case 2:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Large.Items: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			e := &Medium{}
+			if err := proto.UnmarshalMergeDepth(v, e, maxDepth-1); err != nil {
+				return err
+			}
+			m.Items = append(m.Items, e)
+		case 3:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Large.NextPageToken: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.NextPageToken = string(v)
+		case 4:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Large.Page: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			if m.Page == nil {
+				m.Page = &Large_Page{}
+			}
+			if err := proto.UnmarshalMergeDepth(v, m.Page, maxDepth-1); err != nil {
+				return err
+			}
+		case 5:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Large.Payload: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Payload = append([]byte{}, v...)
+		default:
+			n, err = proto.ConsumeField(dAtA[i:], wire)
+			if err != nil {
+				return err
+			}
+			i += n
+		}
+	}
+	return nil
+}
+
+func (m *Large_Page) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.Offset != 0 {
+		n += 1 + proto.SizeVarint(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + proto.SizeVarint(uint64(m.Limit))
+	}
+	if m.Total != 0 {
+		n += 1 + proto.SizeVarint(m.Total)
+	}
+	for _, e := range m.Highlighted {
+		l := proto.Size(e)
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Large_Page) Marshal() ([]byte, error) {
+	if m == nil {
+		return nil, proto.ErrNil
+	}
+	dAtA := make([]byte, m.Size())
+	n, err := m.MarshalToSizedBuffer(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[len(dAtA)-n:], nil
+}
+
+func (m *Large_Page) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, proto.ErrNil
+	}
+	i := len(dAtA)
+	for j := len(m.Highlighted) - 1; j >= 0; j-- {
+		n, err := proto.MarshalToSizedBuffer(dAtA[:i], m.Highlighted[j])
+		if err != nil {
+			return 0, err
+		}
+		i -= n
+		i = proto.PrependVarint(dAtA, i, uint64(n))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Total != 0 {
+		i = proto.PrependVarint(dAtA, i, m.Total)
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = proto.PrependVarint(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Offset != 0 {
+		i = proto.PrependVarint(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Large_Page) Unmarshal(dAtA []byte) error {
+	return m.XXX_UnmarshalDepth(dAtA, proto.DefaultMaxDepth)
+}
+
+func (m *Large_Page) XXX_UnmarshalDepth(dAtA []byte, maxDepth int) error {
+	if maxDepth <= 0 {
+		return proto.ErrTooDeep
+	}
+	for i := 0; i < len(dAtA); {
+		num, wire, n, err := proto.ConsumeTag(dAtA[i:])
+		if err != nil {
+			return err
+		}
+		i += n
+		switch num {
+		case 1:
+			if wire != proto.WireVarint {
+				return fmt.Errorf("proto: bad wiretype for field Large_Page.Offset: got wiretype %d, want 0", wire)
+			}
+			v, n, err := proto.ConsumeVarint(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Offset = uint32(v)
+		case 2:
+			if wire != proto.WireVarint {
+				return fmt.Errorf("proto: bad wiretype for field Large_Page.Limit: got wiretype %d, want 0", wire)
+			}
+			v, n, err := proto.ConsumeVarint(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Limit = uint32(v)
+		case 3:
+			if wire != proto.WireVarint {
+				return fmt.Errorf("proto: bad wiretype for field Large_Page.Total: got wiretype %d, want 0", wire)
+			}
+			v, n, err := proto.ConsumeVarint(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Total = v
+		case 4:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field Large_Page.Highlighted: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			e := &Medium{}
+			if err := proto.UnmarshalMergeDepth(v, e, maxDepth-1); err != nil {
+				return err
+			}
+			m.Highlighted = append(m.Highlighted, e)
+		default:
+			n, err = proto.ConsumeField(dAtA[i:], wire)
+			if err != nil {
+				return err
+			}
+			i += n
+		}
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if len(m.Timestamps) > 0 {
+		l := 0
+		for _, e := range m.Timestamps {
+			l += proto.SizeVarint(uint64(e))
+		}
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if len(m.Values) > 0 {
+		l := len(m.Values) * 8
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if len(m.Deltas) > 0 {
+		l := 0
+		for _, e := range m.Deltas {
+			l += proto.SizeVarint(uint64((uint32(e) << 1) ^ uint32(e>>31)))
+		}
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if len(m.Hashes) > 0 {
+		l := len(m.Hashes) * 8
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	for _, e := range m.Names {
+		n += 1 + proto.SizeVarint(uint64(len(e))) + len(e)
+	}
+	for _, e := range m.Chunks {
+		n += 1 + proto.SizeVarint(uint64(len(e))) + len(e)
+	}
+	for _, e := range m.Requests {
+		l := proto.Size(e)
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if len(m.Flags) > 0 {
+		l := len(m.Flags)
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *RepeatedHeavy) Marshal() ([]byte, error) {
+	if m == nil {
+		return nil, proto.ErrNil
+	}
+	dAtA := make([]byte, m.Size())
+	n, err := m.MarshalToSizedBuffer(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[len(dAtA)-n:], nil
+}
+
+func (m *RepeatedHeavy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, proto.ErrNil
+	}
+	i := len(dAtA)
+	if len(m.Flags) > 0 {
+		end := i
+		for j := len(m.Flags) - 1; j >= 0; j-- {
+			i--
+			if m.Flags[j] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = proto.PrependVarint(dAtA, i, uint64(end-i))
+		i--
+		dAtA[i] = 0x42
+	}
+	for j := len(m.Requests) - 1; j >= 0; j-- {
+		n, err := proto.MarshalToSizedBuffer(dAtA[:i], m.Requests[j])
+		if err != nil {
+			return 0, err
+		}
+		i -= n
+		i = proto.PrependVarint(dAtA, i, uint64(n))
+		i--
+		dAtA[i] = 0x3a
+	}
+	for j := len(m.Chunks) - 1; j >= 0; j-- {
+		i -= len(m.Chunks[j])
+		copy(dAtA[i:], m.Chunks[j])
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.Chunks[j])))
+		i--
+		dAtA[i] = 0x32
+	}
+	for j := len(m.Names) - 1; j >= 0; j-- {
+		i -= len(m.Names[j])
+		copy(dAtA[i:], m.Names[j])
+		i = proto.PrependVarint(dAtA, i, uint64(len(m.Names[j])))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Hashes) > 0 {
+		end := i
+		for j := len(m.Hashes) - 1; j >= 0; j-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], m.Hashes[j])
+		}
+		i = proto.PrependVarint(dAtA, i, uint64(end-i))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Deltas) > 0 {
+		end := i
+		for j := len(m.Deltas) - 1; j >= 0; j-- {
+			i = proto.PrependVarint(dAtA, i, uint64((uint32(m.Deltas[j])<<1)^uint32(m.Deltas[j]>>31)))
+		}
+		i = proto.PrependVarint(dAtA, i, uint64(end-i))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Values) > 0 {
+		end := i
+		for j := len(m.Values) - 1; j >= 0; j-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], math.Float64bits(m.Values[j]))
+		}
+		i = proto.PrependVarint(dAtA, i, uint64(end-i))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Timestamps) > 0 {
+		end := i
+		for j := len(m.Timestamps) - 1; j >= 0; j-- {
+			i = proto.PrependVarint(dAtA, i, uint64(m.Timestamps[j]))
+		}
+		i = proto.PrependVarint(dAtA, i, uint64(end-i))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepeatedHeavy) Unmarshal(dAtA []byte) error {
+	return m.XXX_UnmarshalDepth(dAtA, proto.DefaultMaxDepth)
+}
+
+func (m *RepeatedHeavy) XXX_UnmarshalDepth(dAtA []byte, maxDepth int) error {
+	if maxDepth <= 0 {
+		return proto.ErrTooDeep
+	}
+	for i := 0; i < len(dAtA); {
+		num, wire, n, err := proto.ConsumeTag(dAtA[i:])
+		if err != nil {
+			return err
+		}
+		i += n
+		switch num {
+		case 1:
+			switch wire {
+			case proto.WireVarint:
+				v, n, err := proto.ConsumeVarint(dAtA[i:])
+				if err != nil {
+					return err
+				}
+				i += n
+				m.Timestamps = append(m.Timestamps, int64(v))
+			case proto.WireBytes:
+				b, n, err := proto.ConsumeBytes(dAtA[i:])
+				if err != nil {
+					return err
+				}
+				i += n
+				for len(b) > 0 {
+					v, n, err := proto.ConsumeVarint(b)
+					if err != nil {
+						return err
+					}
+					b = b[n:]
+					m.Timestamps = append(m.Timestamps, int64(v))
+				}
+			default:
+				return fmt.Errorf("proto: bad wiretype for field RepeatedHeavy.Timestamps: got wiretype %d, want 0", wire)
+			}
+		case 2:
+			switch wire {
+			case proto.WireFixed64:
+				v, n, err := proto.ConsumeFixed64(dAtA[i:])
+				if err != nil {
+					return err
+				}
+				i += n
+				m.Values = append(m.Values, math.Float64frombits(v))
+			case proto.WireBytes:
+				b, n, err := proto.ConsumeBytes(dAtA[i:])
+				if err != nil {
+					return err
+				}
+				i += n
+				for len(b) > 0 {
+					v, n, err := proto.ConsumeFixed64(b)
+					if err != nil {
+						return err
+					}
+					b = b[n:]
+					m.Values = append(m.Values, math.Float64frombits(v))
+				}
+			default:
+				return fmt.Errorf("proto: bad wiretype for field RepeatedHeavy.Values: got wiretype %d, want 1", wire)
+			}
+		case 3:
+			switch wire {
+			case proto.WireVarint:
+				v, n, err := proto.ConsumeVarint(dAtA[i:])
+				if err != nil {
+					return err
+				}
+				i += n
+				m.Deltas = append(m.Deltas, int32(uint32(v)>>1)^-int32(v&1))
+			case proto.WireBytes:
+				b, n, err := proto.ConsumeBytes(dAtA[i:])
+				if err != nil {
+					return err
+				}
+				i += n
+				for len(b) > 0 {
+					v, n, err := proto.ConsumeVarint(b)
+					if err != nil {
+						return err
+					}
+					b = b[n:]
+					m.Deltas = append(m.Deltas, int32(uint32(v)>>1)^-int32(v&1))
+				}
+			default:
+				return fmt.Errorf("proto: bad wiretype for field RepeatedHeavy.Deltas: got wiretype %d, want 0", wire)
+			}
+		case 4:
+			switch wire {
+			case proto.WireFixed64:
+				v, n, err := proto.ConsumeFixed64(dAtA[i:])
+				if err != nil {
+					return err
+				}
+				i += n
+				m.Hashes = append(m.Hashes, v)
+			case proto.WireBytes:
+				b, n, err := proto.ConsumeBytes(dAtA[i:])
+				if err != nil {
+					return err
+				}
+				i += n
+				for len(b) > 0 {
+					v, n, err := proto.ConsumeFixed64(b)
+					if err != nil {
+						return err
+					}
+					b = b[n:]
+					m.Hashes = append(m.Hashes, v)
+				}
+			default:
+				return fmt.Errorf("proto: bad wiretype for field RepeatedHeavy.Hashes: got wiretype %d, want 1", wire)
+			}
+		case 5:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field RepeatedHeavy.Names: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Names = append(m.Names, string(v))
+		case 6:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field RepeatedHeavy.Chunks: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Chunks = append(m.Chunks, append([]byte{}, v...))
+		case 7:
+			if wire != proto.WireBytes {
+				return fmt.Errorf("proto: bad wiretype for field RepeatedHeavy.Requests: got wiretype %d, want 2", wire)
+			}
+			v, n, err := proto.ConsumeBytes(dAtA[i:])
+			if err != nil {
+				return err
+			}
+			i += n
+			e := &Small{}
+			if err := proto.UnmarshalMergeDepth(v, e, maxDepth-1); err != nil {
+				return err
+			}
+			m.Requests = append(m.Requests, e)
+		case 8:
+			switch wire {
+			case proto.WireVarint:
+				v, n, err := proto.ConsumeVarint(dAtA[i:])
+				if err != nil {
+					return err
+				}
+				i += n
+				m.Flags = append(m.Flags, v != 0)
+			case proto.WireBytes:
+				b, n, err := proto.ConsumeBytes(dAtA[i:])
+				if err != nil {
+					return err
+				}
+				i += n
+				for len(b) > 0 {
+					v, n, err := proto.ConsumeVarint(b)
+					if err != nil {
+						return err
+					}
+					b = b[n:]
+					m.Flags = append(m.Flags, v != 0)
+				}
+			default:
+				return fmt.Errorf("proto: bad wiretype for field RepeatedHeavy.Flags: got wiretype %d, want 0", wire)
+			}
+		default:
+			n, err = proto.ConsumeField(dAtA[i:], wire)
+			if err != nil {
+				return err
+			}
+			i += n
+		}
+	}
+	return nil
+}
+
+func init() { proto.RegisterFile("benchmarks.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x66, 0xbd, 0xf6, 0xda, 0x7b, 0x1c, 0x17, 0x77, 0xa8, 0xaa, 0x55, 0x04, 0xe9, 0xe2, 0x0b,
+	0xb4, 0x44, 0x34, 0x17, 0x29, 0x48, 0x01, 0x04, 0x52, 0x49, 0x2c, 0x11, 0x35, 0x35, 0xd5, 0x24,
+	0x81, 0x4b, 0x6b, 0xec, 0x39, 0xb1, 0x47, 0x99, 0xfd, 0x61, 0x67, 0x5c, 0x65, 0xef, 0x78, 0x12,
+	0x1e, 0x80, 0x17, 0xe1, 0x8a, 0x17, 0xe1, 0x86, 0x57, 0x40, 0xf3, 0xe3, 0x64, 0x23, 0xa5, 0xaa,
+	0x7a, 0xb7, 0xdf, 0x37, 0xdf, 0xb7, 0x7b, 0xce, 0x77, 0xc6, 0xc7, 0x30, 0x5e, 0x60, 0xb1, 0x5c,
+	0xe7, 0xac, 0xbe, 0x56, 0x07, 0x55, 0x5d, 0xea, 0x92, 0xc0, 0x1d, 0x33, 0xf9, 0x33, 0x80, 0xde,
+	0x79, 0xce, 0xa4, 0x24, 0x9f, 0x01, 0xd4, 0xf8, 0xfb, 0x06, 0x95, 0x9e, 0x0b, 0x9e, 0x04, 0x69,
+	0x90, 0x75, 0x69, 0xec, 0x99, 0x53, 0x4e, 0x9e, 0x42, 0x94, 0xa3, 0x5e, 0x97, 0x3c, 0xe9, 0xa4,
+	0x41, 0x16, 0x53, 0x8f, 0xc8, 0x57, 0x40, 0x38, 0x32, 0x2e, 0x45, 0x81, 0xf3, 0x4d, 0x21, 0x6e,
+	0xe6, 0x05, 0x2b, 0xca, 0x24, 0x4c, 0x83, 0x2c, 0xa4, 0xe3, 0xed, 0xc9, 0x65, 0x21, 0x6e, 0x66,
+	0xac, 0x28, 0xc9, 0x1e, 0x80, 0xe0, 0x98, 0x57, 0xa5, 0xc6, 0x42, 0x27, 0xdd, 0x34, 0xc8, 0x06,
+	0xb4, 0xc5, 0x90, 0x31, 0x84, 0xd7, 0xd8, 0x24, 0xbd, 0x34, 0xc8, 0x76, 0xa8, 0x79, 0x9c, 0xfc,
+	0xdd, 0x81, 0xe8, 0x35, 0x72, 0xb1, 0xc9, 0xc9, 0x97, 0x10, 0xad, 0x91, 0x71, 0xac, 0x6d, 0x75,
+	0xc3, 0xc3, 0xc7, 0x07, 0xad, 0xd6, 0x6c, 0x13, 0xd4, 0x0b, 0xc8, 0x23, 0xe8, 0x88, 0x6d, 0xa5,
+	0x1d, 0xc1, 0xc9, 0xe7, 0xb0, 0xc3, 0x85, 0xaa, 0x24, 0x6b, 0xe6, 0x05, 0xcb, 0xd1, 0xd6, 0x17,
+	0xd3, 0xa1, 0xe7, 0x66, 0x2c, 0x47, 0xf2, 0x04, 0x7a, 0x98, 0x33, 0x21, 0x6d, 0x55, 0x31, 0x75,
+	0x80, 0xec, 0x43, 0xa4, 0x34, 0xd3, 0x1b, 0x65, 0x6b, 0x7a, 0x74, 0x48, 0xee, 0x7d, 0xd3, 0x9e,
+	0x50, 0xaf, 0x30, 0x1f, 0x59, 0xd6, 0xc8, 0x34, 0x72, 0x9b, 0x44, 0x12, 0xd9, 0x10, 0x86, 0x9e,
+	0x33, 0x19, 0x18, 0xc9, 0xa6, 0xe2, 0x77, 0x92, 0xbe, 0x93, 0x78, 0xce, 0x4a, 0x9e, 0x40, 0x4f,
+	0x2d, 0xcb, 0x1a, 0x93, 0x41, 0x1a, 0x64, 0x01, 0x75, 0x80, 0x10, 0xe8, 0x6a, 0xb6, 0x52, 0x49,
+	0x9c, 0x86, 0x59, 0x4c, 0xed, 0x33, 0x79, 0x0e, 0x7d, 0xc6, 0x79, 0x8d, 0x4a, 0x25, 0x60, 0x03,
+	0xf9, 0xa4, 0x5d, 0xdc, 0x4b, 0x77, 0x44, 0xb7, 0x9a, 0xc9, 0x5f, 0x01, 0xf4, 0x3d, 0x69, 0xa6,
+	0xa9, 0x74, 0x8d, 0xa8, 0x6d, 0x94, 0x31, 0xf5, 0xc8, 0x7c, 0x66, 0x29, 0x74, 0xe3, 0x93, 0xb3,
+	0xcf, 0x24, 0x81, 0xfe, 0xb2, 0xdc, 0x14, 0xba, 0x6e, 0x7c, 0x6c, 0x5b, 0x48, 0x9e, 0xc1, 0xb0,
+	0x2a, 0x95, 0x66, 0x72, 0xbe, 0x2c, 0x39, 0xfa, 0xe0, 0xc0, 0x51, 0xc7, 0x25, 0x47, 0xb2, 0x0b,
+	0x03, 0xc9, 0xb4, 0xd0, 0x1b, 0x8e, 0x36, 0xbf, 0x0e, 0xbd, 0xc5, 0xe4, 0x53, 0x88, 0x65, 0x59,
+	0xac, 0xdc, 0x61, 0x64, 0x0f, 0xef, 0x88, 0xc9, 0x3f, 0x1d, 0xe8, 0x9d, 0xb1, 0x7a, 0x85, 0x1f,
+	0x32, 0xf5, 0x0c, 0x7a, 0x42, 0x63, 0xae, 0x92, 0x4e, 0x1a, 0x66, 0xc3, 0xfb, 0xb3, 0x72, 0x77,
+	0x88, 0x3a, 0x01, 0xf9, 0x02, 0x3e, 0x2e, 0xf0, 0x46, 0xcf, 0x2b, 0xb6, 0xc2, 0xb9, 0x2e, 0xaf,
+	0xb1, 0xf0, 0xbd, 0x8d, 0x0c, 0xfd, 0x86, 0xad, 0xf0, 0xc2, 0x90, 0x64, 0x1f, 0xba, 0x46, 0x62,
+	0x5b, 0x1b, 0x1e, 0x3e, 0x6d, 0xbf, 0xd0, 0x56, 0x77, 0x60, 0xa4, 0xd4, 0x6a, 0x4c, 0x4e, 0x15,
+	0x6b, 0x64, 0xc9, 0xb8, 0xbf, 0xbf, 0x5b, 0xb8, 0xfb, 0x47, 0x00, 0x5d, 0x23, 0x34, 0xb1, 0x97,
+	0x57, 0x57, 0xca, 0xc7, 0x3e, 0xa2, 0x1e, 0x99, 0x99, 0x4b, 0x91, 0x0b, 0x6d, 0x73, 0x1f, 0x51,
+	0x07, 0x0c, 0xab, 0x4b, 0xcd, 0xa4, 0x2d, 0xad, 0x4b, 0x1d, 0x20, 0x5f, 0xc3, 0x70, 0x2d, 0x56,
+	0x6b, 0x29, 0x56, 0x6b, 0x8d, 0x3c, 0xe9, 0xbe, 0xb3, 0xd5, 0xb6, 0x6c, 0xf2, 0x5f, 0x08, 0x83,
+	0xd7, 0xac, 0xfa, 0x19, 0xd9, 0xdb, 0x86, 0x1c, 0x41, 0x24, 0xd9, 0x02, 0xa5, 0x4a, 0x02, 0xeb,
+	0x4e, 0xef, 0xb9, 0xbd, 0xea, 0xe0, 0xcc, 0x4a, 0xa6, 0x66, 0xd2, 0xd4, 0xeb, 0xc9, 0x8f, 0x30,
+	0xb0, 0xc3, 0xc7, 0x7a, 0x1b, 0xf2, 0xe4, 0x41, 0xef, 0xb1, 0x17, 0x39, 0xf7, 0xad, 0x87, 0xbc,
+	0x80, 0xde, 0xa2, 0x31, 0xfb, 0x25, 0xb4, 0xe6, 0xbd, 0x07, 0xcd, 0x3f, 0x35, 0xa7, 0xdc, 0x19,
+	0xbb, 0x8b, 0xe6, 0x94, 0x93, 0x6f, 0xa0, 0xb7, 0x90, 0xe5, 0x42, 0xf9, 0x5e, 0x9f, 0x3d, 0x6c,
+	0x32, 0x0a, 0xe7, 0x72, 0xea, 0xdd, 0x6f, 0x61, 0xd8, 0x6a, 0x61, 0xbb, 0x5a, 0xdc, 0x7d, 0x37,
+	0x8f, 0x26, 0xdf, 0xb7, 0x4c, 0x6e, 0xd0, 0xdf, 0x76, 0x07, 0xbe, 0xeb, 0x1c, 0x05, 0xbb, 0xdf,
+	0xc3, 0xe8, 0x5e, 0x07, 0xef, 0x33, 0x87, 0x6d, 0xf3, 0x2b, 0x88, 0x6f, 0x3b, 0x68, 0x1b, 0xbb,
+	0xce, 0x98, 0xb5, 0x8d, 0xef, 0xb8, 0xa4, 0x77, 0x2f, 0x3b, 0x02, 0xb8, 0xeb, 0xec, 0x7d, 0x65,
+	0xec, 0xb4, 0x9c, 0x93, 0x7f, 0x03, 0x18, 0x51, 0xac, 0xec, 0xee, 0x71, 0x63, 0xdf, 0x03, 0xd0,
+	0x22, 0x47, 0xa5, 0x59, 0x5e, 0xb9, 0xd1, 0x87, 0xb4, 0xc5, 0x98, 0xdb, 0x69, 0xed, 0x6e, 0xb4,
+	0x01, 0xf5, 0xc8, 0xf0, 0x1c, 0xa5, 0x66, 0xca, 0x4e, 0xed, 0x31, 0xf5, 0xc8, 0xf0, 0x6b, 0xa6,
+	0xd6, 0xe8, 0x06, 0x13, 0x51, 0x8f, 0x4c, 0x4d, 0x66, 0xc9, 0x9a, 0x95, 0x69, 0x96, 0x95, 0x03,
+	0x46, 0xbd, 0x5c, 0x6f, 0x8a, 0x6b, 0x95, 0x44, 0x69, 0x98, 0xed, 0x50, 0x8f, 0xc8, 0x73, 0x18,
+	0xf8, 0x7f, 0x19, 0x95, 0xf4, 0xd3, 0xf0, 0xe1, 0x5f, 0xf8, 0xad, 0xc4, 0xbc, 0xfc, 0x4a, 0x9a,
+	0x4d, 0x38, 0x48, 0xc3, 0x6c, 0x40, 0x1d, 0xd8, 0xff, 0x01, 0x22, 0xb7, 0x8c, 0xc9, 0x10, 0xfa,
+	0x97, 0xb3, 0x57, 0xb3, 0x5f, 0x7e, 0x9b, 0x8d, 0x3f, 0x22, 0x00, 0xd1, 0xcb, 0xe3, 0x8b, 0xd3,
+	0x5f, 0xa7, 0xe3, 0x80, 0x8c, 0x20, 0x3e, 0xbf, 0x3c, 0x7f, 0x33, 0x9d, 0x9d, 0x4c, 0x4f, 0xc6,
+	0x1d, 0xa3, 0x3b, 0x99, 0x9e, 0x4d, 0x2f, 0xa6, 0x27, 0xe3, 0x70, 0x11, 0xd9, 0x3f, 0xc6, 0x17,
+	0xff, 0x0f, 0x00, 0x97, 0x37, 0x1c, 0xa2, 0x2c, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,325 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// +build go1.7
+
+package benchmarks
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/benchmarks/reflectpb"
+	"github.com/golang/protobuf/proto"
+)
+
+func small(i int) *Small {
+	return &Small{
+		RequestId:        uint64(i) * 7919,
+		Method:           "/carno.users.Users/Get",
+		DeadlineUnixNano: 1500000000000000000 + int64(i),
+		Idempotent:       i%2 == 0,
+		Key:              []byte(fmt.Sprintf("user:%08d", i)),
+	}
+}
+
+func medium(i int) *Medium {
+	return &Medium{
+		Header:      small(i),
+		Id:          fmt.Sprintf("%016x", i*104729),
+		DisplayName: fmt.Sprintf("User Number %d", i),
+		Email:       fmt.Sprintf("user%d@example.com", i),
+		Status:      Status(i % 4),
+		CreatedUnix: 1400000000 + int64(i),
+		UpdatedUnix: 1500000000 + int64(i),
+		Score:       float64(i) / 3,
+		Tags:        []string{"beta", "eu-west", "paying"},
+		Address: &Address{
+			Street:     fmt.Sprintf("%d Long Street", i),
+			City:       "Cape Town",
+			Country:    "ZA",
+			PostalCode: "8001",
+			Latitude:   -33.92,
+			Longitude:  18.42,
+		},
+	}
+}
+
+func large() *Large {
+	m := &Large{
+		Header:        small(0),
+		NextPageToken: strings.Repeat("t", 64),
+		Page:          &Large_Page{Offset: 100, Limit: 50, Total: 12345},
+		Payload:       bytes.Repeat([]byte{0xa5}, 4096),
+	}
+	for i := 0; i < 50; i++ {
+		m.Items = append(m.Items, medium(i))
+	}
+	for i := 0; i < 5; i++ {
+		m.Page.Highlighted = append(m.Page.Highlighted, medium(i))
+	}
+	return m
+}
+
+func mapHeavy() *MapHeavy {
+	m := &MapHeavy{
+		Labels:   make(map[string]string),
+		Counters: make(map[string]int64),
+		ById:     make(map[uint64]*Medium),
+		Blobs:    make(map[string][]byte),
+	}
+	for i := 0; i < 32; i++ {
+		m.Labels[fmt.Sprintf("label-%d", i)] = fmt.Sprintf("value-%d", i)
+		m.Counters[fmt.Sprintf("counter-%d", i)] = int64(i) << 20
+	}
+	for i := 0; i < 16; i++ {
+		m.ById[uint64(i)*7919] = medium(i)
+	}
+	for i := 0; i < 8; i++ {
+		m.Blobs[fmt.Sprintf("blob-%d", i)] = bytes.Repeat([]byte{byte(i)}, 256)
+	}
+	return m
+}
+
+func repeatedHeavy() *RepeatedHeavy {
+	m := new(RepeatedHeavy)
+	for i := 0; i < 256; i++ {
+		m.Timestamps = append(m.Timestamps, 1500000000000+int64(i)*1000)
+		m.Values = append(m.Values, float64(i)*0.25)
+		m.Deltas = append(m.Deltas, int32(i%7-3))
+		m.Hashes = append(m.Hashes, uint64(i)*0x9e3779b97f4a7c15)
+	}
+	for i := 0; i < 64; i++ {
+		m.Flags = append(m.Flags, i%3 == 0)
+	}
+	for i := 0; i < 32; i++ {
+		m.Names = append(m.Names, fmt.Sprintf("metric.%d.count", i))
+		m.Requests = append(m.Requests, small(i))
+	}
+	for i := 0; i < 8; i++ {
+		m.Chunks = append(m.Chunks, bytes.Repeat([]byte{byte(i)}, 512))
+	}
+	return m
+}
+
+// A shape is a sample message, as a generated message of this package
+// and as the message of the same type in reflectpb, which has no
+// generated methods.
+type shape struct {
+	name    string
+	gen     proto.Message
+	reflect proto.Message
+	clone   func() proto.Message     // the generated CloneMessage
+	equal   func(proto.Message) bool // the generated EqualMessage of gen
+}
+
+var shapes = func() []shape {
+	sm, md, lg, mh, rh := small(1), medium(1), large(), mapHeavy(), repeatedHeavy()
+	shapes := []shape{
+		{"Small", sm, new(reflectpb.Small), func() proto.Message { return sm.CloneMessage() },
+			func(o proto.Message) bool { return sm.EqualMessage(o.(*Small)) }},
+		{"Medium", md, new(reflectpb.Medium), func() proto.Message { return md.CloneMessage() },
+			func(o proto.Message) bool { return md.EqualMessage(o.(*Medium)) }},
+		{"Large", lg, new(reflectpb.Large), func() proto.Message { return lg.CloneMessage() },
+			func(o proto.Message) bool { return lg.EqualMessage(o.(*Large)) }},
+		{"MapHeavy", mh, new(reflectpb.MapHeavy), func() proto.Message { return mh.CloneMessage() },
+			func(o proto.Message) bool { return mh.EqualMessage(o.(*MapHeavy)) }},
+		{"RepeatedHeavy", rh, new(reflectpb.RepeatedHeavy), func() proto.Message { return rh.CloneMessage() },
+			func(o proto.Message) bool { return rh.EqualMessage(o.(*RepeatedHeavy)) }},
+	}
+	for _, s := range shapes {
+		b, err := proto.Marshal(s.gen)
+		if err != nil {
+			panic(err)
+		}
+		if err := proto.Unmarshal(b, s.reflect); err != nil {
+			panic(err)
+		}
+	}
+	return shapes
+}()
+
+func deterministic(t *testing.T, m proto.Message) []byte {
+	var buf proto.Buffer
+	buf.SetDeterministic(true)
+	if err := buf.Marshal(m); err != nil {
+		t.Fatalf("Marshal(%T): %v", m, err)
+	}
+	return buf.Bytes()
+}
+
+// TestShapes checks that both versions of each shape hold the same
+// message, so that the benchmarks compare like with like.
+func TestShapes(t *testing.T) {
+	for _, s := range shapes {
+		// The fastpath plugin leaves messages with maps to reflection,
+		// so only the values of MapHeavy have generated methods.
+		if _, ok := s.gen.(proto.SizedMarshaler); !ok && s.name != "MapHeavy" {
+			t.Errorf("%s: %T has no generated methods", s.name, s.gen)
+		}
+		if _, ok := s.reflect.(proto.SizedMarshaler); ok {
+			t.Errorf("%s: %T has generated methods", s.name, s.reflect)
+		}
+		if g, r := deterministic(t, s.gen), deterministic(t, s.reflect); !bytes.Equal(g, r) {
+			t.Errorf("%s: generated and reflection encodings differ:\n%x\n%x", s.name, g, r)
+		}
+		if c := s.clone(); !proto.Equal(c, s.gen) {
+			t.Errorf("%s: CloneMessage = %v, want %v", s.name, c, s.gen)
+		}
+		if c := s.clone(); !s.equal(c) {
+			t.Errorf("%s: EqualMessage(%v) = false, want true", s.name, c)
+		}
+	}
+}
+
+// each runs f for the generated and the reflection version of every
+// shape, as the sub-benchmarks <shape>/generated and <shape>/reflect.
+func each(b *testing.B, f func(b *testing.B, m proto.Message)) {
+	for _, s := range shapes {
+		s := s
+		b.Run(s.name+"/generated", func(b *testing.B) { f(b, s.gen) })
+		b.Run(s.name+"/reflect", func(b *testing.B) { f(b, s.reflect) })
+	}
+}
+
+var (
+	blackhole    []byte
+	blackholeMsg proto.Message
+	blackholeInt int
+)
+
+func BenchmarkMarshal(b *testing.B) {
+	each(b, func(b *testing.B, m proto.Message) {
+		b.SetBytes(int64(proto.Size(m)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf, err := proto.Marshal(m)
+			if err != nil {
+				b.Fatal(err)
+			}
+			blackhole = buf
+		}
+	})
+}
+
+func BenchmarkMarshalAppend(b *testing.B) {
+	each(b, func(b *testing.B, m proto.Message) {
+		b.SetBytes(int64(proto.Size(m)))
+		b.ReportAllocs()
+		buf := make([]byte, 0, proto.Size(m))
+		for i := 0; i < b.N; i++ {
+			var err error
+			buf, err = proto.MarshalOptions{}.MarshalAppend(buf[:0], m)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		blackhole = buf
+	})
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	each(b, func(b *testing.B, m proto.Message) {
+		buf, err := proto.Marshal(m)
+		if err != nil {
+			b.Fatal(err)
+		}
+		t := reflect.TypeOf(m).Elem()
+		b.SetBytes(int64(len(buf)))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			m := reflect.New(t).Interface().(proto.Message)
+			if err := proto.Unmarshal(buf, m); err != nil {
+				b.Fatal(err)
+			}
+			blackholeMsg = m
+		}
+	})
+}
+
+func BenchmarkSize(b *testing.B) {
+	each(b, func(b *testing.B, m proto.Message) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			blackholeInt = proto.Size(m)
+		}
+	})
+}
+
+// BenchmarkClone compares the generated CloneMessage with proto.Clone.
+func BenchmarkClone(b *testing.B) {
+	for _, s := range shapes {
+		s := s
+		b.Run(s.name+"/generated", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				blackholeMsg = s.clone()
+			}
+		})
+		b.Run(s.name+"/reflect", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				blackholeMsg = proto.Clone(s.reflect)
+			}
+		})
+	}
+}
+
+// BenchmarkEqual compares the generated EqualMessage with proto.Equal.
+// Each message is compared with a copy of itself, so that every field is
+// visited.
+func BenchmarkEqual(b *testing.B) {
+	for _, s := range shapes {
+		s := s
+		b.Run(s.name+"/generated", func(b *testing.B) {
+			c := proto.Clone(s.gen)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if !s.equal(c) {
+					b.Fatal("copy is not equal")
+				}
+			}
+		})
+		b.Run(s.name+"/reflect", func(b *testing.B) {
+			c := proto.Clone(s.reflect)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if !proto.Equal(s.reflect, c) {
+					b.Fatal("copy is not equal")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: reflectpb/benchmarks.proto
+
+/*
+Package reflectpb is a generated protocol buffer package.
+
+Package reflectpb holds messages shaped like the requests and
+responses of carno services, with the methods of the fastpath, clone
+and equal plugins left out, to compare with package benchmarks.
+
+It is generated from these files:
+	reflectpb/benchmarks.proto
+
+It has these top-level messages:
+	Small
+	Medium
+	Address
+	Large
+	MapHeavy
+	RepeatedHeavy
+*/
+package reflectpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Status int32
+
+const (
+	Status_UNKNOWN   Status = 0
+	Status_ACTIVE    Status = 1
+	Status_SUSPENDED Status = 2
+	Status_DELETED   Status = 3
+)
+
+var Status_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ACTIVE",
+	2: "SUSPENDED",
+	3: "DELETED",
+}
+var Status_value = map[string]int32{
+	"UNKNOWN":   0,
+	"ACTIVE":    1,
+	"SUSPENDED": 2,
+	"DELETED":   3,
+}
+
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+func (Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+// Small is a point lookup: a request header and a key.
+type Small struct {
+	RequestId        uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	Method           string `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
+	DeadlineUnixNano int64  `protobuf:"varint,3,opt,name=deadline_unix_nano,json=deadlineUnixNano" json:"deadline_unix_nano,omitempty"`
+	Idempotent       bool   `protobuf:"varint,4,opt,name=idempotent" json:"idempotent,omitempty"`
+	Key              []byte `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *Small) Reset()                    { *m = Small{} }
+func (m *Small) String() string            { return proto.CompactTextString(m) }
+func (*Small) ProtoMessage()               {}
+func (*Small) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Small) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *Small) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Small) GetDeadlineUnixNano() int64 {
+	if m != nil {
+		return m.DeadlineUnixNano
+	}
+	return 0
+}
+
+func (m *Small) GetIdempotent() bool {
+	if m != nil {
+		return m.Idempotent
+	}
+	return false
+}
+
+func (m *Small) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// Medium is a typical entity returned by a read.
+type Medium struct {
+	Header      *Small   `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Id          string   `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	DisplayName string   `protobuf:"bytes,3,opt,name=display_name,json=displayName" json:"display_name,omitempty"`
+	Email       string   `protobuf:"bytes,4,opt,name=email" json:"email,omitempty"`
+	Status      Status   `protobuf:"varint,5,opt,name=status,enum=benchmarks.reflectpb.Status" json:"status,omitempty"`
+	CreatedUnix int64    `protobuf:"varint,6,opt,name=created_unix,json=createdUnix" json:"created_unix,omitempty"`
+	UpdatedUnix int64    `protobuf:"varint,7,opt,name=updated_unix,json=updatedUnix" json:"updated_unix,omitempty"`
+	Score       float64  `protobuf:"fixed64,8,opt,name=score" json:"score,omitempty"`
+	Tags        []string `protobuf:"bytes,9,rep,name=tags" json:"tags,omitempty"`
+	Address     *Address `protobuf:"bytes,10,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *Medium) Reset()                    { *m = Medium{} }
+func (m *Medium) String() string            { return proto.CompactTextString(m) }
+func (*Medium) ProtoMessage()               {}
+func (*Medium) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Medium) GetHeader() *Small {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *Medium) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Medium) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *Medium) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Medium) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (m *Medium) GetCreatedUnix() int64 {
+	if m != nil {
+		return m.CreatedUnix
+	}
+	return 0
+}
+
+func (m *Medium) GetUpdatedUnix() int64 {
+	if m != nil {
+		return m.UpdatedUnix
+	}
+	return 0
+}
+
+func (m *Medium) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *Medium) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Medium) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type Address struct {
+	Street     string  `protobuf:"bytes,1,opt,name=street" json:"street,omitempty"`
+	City       string  `protobuf:"bytes,2,opt,name=city" json:"city,omitempty"`
+	Country    string  `protobuf:"bytes,3,opt,name=country" json:"country,omitempty"`
+	PostalCode string  `protobuf:"bytes,4,opt,name=postal_code,json=postalCode" json:"postal_code,omitempty"`
+	Latitude   float32 `protobuf:"fixed32,5,opt,name=latitude" json:"latitude,omitempty"`
+	Longitude  float32 `protobuf:"fixed32,6,opt,name=longitude" json:"longitude,omitempty"`
+}
+
+func (m *Address) Reset()                    { *m = Address{} }
+func (m *Address) String() string            { return proto.CompactTextString(m) }
+func (*Address) ProtoMessage()               {}
+func (*Address) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Address) GetStreet() string {
+	if m != nil {
+		return m.Street
+	}
+	return ""
+}
+
+func (m *Address) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+func (m *Address) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *Address) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
+	}
+	return ""
+}
+
+func (m *Address) GetLatitude() float32 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *Address) GetLongitude() float32 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+// Large is a page of results from a list call, nested three levels deep.
+type Large struct {
+	Header        *Small      `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Items         []*Medium   `protobuf:"bytes,2,rep,name=items" json:"items,omitempty"`
+	NextPageToken string      `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+	Page          *Large_Page `protobuf:"bytes,4,opt,name=page" json:"page,omitempty"`
+	Payload       []byte      `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *Large) Reset()                    { *m = Large{} }
+func (m *Large) String() string            { return proto.CompactTextString(m) }
+func (*Large) ProtoMessage()               {}
+func (*Large) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Large) GetHeader() *Small {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *Large) GetItems() []*Medium {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Large) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *Large) GetPage() *Large_Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *Large) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type Large_Page struct {
+	Offset      uint32    `protobuf:"varint,1,opt,name=offset" json:"offset,omitempty"`
+	Limit       uint32    `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	Total       uint64    `protobuf:"varint,3,opt,name=total" json:"total,omitempty"`
+	Highlighted []*Medium `protobuf:"bytes,4,rep,name=highlighted" json:"highlighted,omitempty"`
+}
+
+func (m *Large_Page) Reset()                    { *m = Large_Page{} }
+func (m *Large_Page) String() string            { return proto.CompactTextString(m) }
+func (*Large_Page) ProtoMessage()               {}
+func (*Large_Page) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3, 0} }
+
+func (m *Large_Page) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Large_Page) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *Large_Page) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *Large_Page) GetHighlighted() []*Medium {
+	if m != nil {
+		return m.Highlighted
+	}
+	return nil
+}
+
+// MapHeavy is a bag of metadata keyed by name, as carried by
+// configuration and routing messages.
+type MapHeavy struct {
+	Labels   map[string]string  `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Counters map[string]int64   `protobuf:"bytes,2,rep,name=counters" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ById     map[uint64]*Medium `protobuf:"bytes,3,rep,name=by_id,json=byId" json:"by_id,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Blobs    map[string][]byte  `protobuf:"bytes,4,rep,name=blobs" json:"blobs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *MapHeavy) Reset()                    { *m = MapHeavy{} }
+func (m *MapHeavy) String() string            { return proto.CompactTextString(m) }
+func (*MapHeavy) ProtoMessage()               {}
+func (*MapHeavy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *MapHeavy) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *MapHeavy) GetCounters() map[string]int64 {
+	if m != nil {
+		return m.Counters
+	}
+	return nil
+}
+
+func (m *MapHeavy) GetById() map[uint64]*Medium {
+	if m != nil {
+		return m.ById
+	}
+	return nil
+}
+
+func (m *MapHeavy) GetBlobs() map[string][]byte {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+// RepeatedHeavy is a batch of samples, as sent by metrics and tracing
+// clients.
+type RepeatedHeavy struct {
+	Timestamps []int64   `protobuf:"varint,1,rep,packed,name=timestamps" json:"timestamps,omitempty"`
+	Values     []float64 `protobuf:"fixed64,2,rep,packed,name=values" json:"values,omitempty"`
+	Deltas     []int32   `protobuf:"zigzag32,3,rep,packed,name=deltas" json:"deltas,omitempty"`
+	Hashes     []uint64  `protobuf:"fixed64,4,rep,packed,name=hashes" json:"hashes,omitempty"`
+	Names      []string  `protobuf:"bytes,5,rep,name=names" json:"names,omitempty"`
+	Chunks     [][]byte  `protobuf:"bytes,6,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	Requests   []*Small  `protobuf:"bytes,7,rep,name=requests" json:"requests,omitempty"`
+	Flags      []bool    `protobuf:"varint,8,rep,packed,name=flags" json:"flags,omitempty"`
+}
+
+func (m *RepeatedHeavy) Reset()                    { *m = RepeatedHeavy{} }
+func (m *RepeatedHeavy) String() string            { return proto.CompactTextString(m) }
+func (*RepeatedHeavy) ProtoMessage()               {}
+func (*RepeatedHeavy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *RepeatedHeavy) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetDeltas() []int32 {
+	if m != nil {
+		return m.Deltas
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetHashes() []uint64 {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetChunks() [][]byte {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetRequests() []*Small {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *RepeatedHeavy) GetFlags() []bool {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Small)(nil), "benchmarks.reflectpb.Small")
+	proto.RegisterType((*Medium)(nil), "benchmarks.reflectpb.Medium")
+	proto.RegisterType((*Address)(nil), "benchmarks.reflectpb.Address")
+	proto.RegisterType((*Large)(nil), "benchmarks.reflectpb.Large")
+	proto.RegisterType((*Large_Page)(nil), "benchmarks.reflectpb.Large.Page")
+	proto.RegisterType((*MapHeavy)(nil), "benchmarks.reflectpb.MapHeavy")
+	proto.RegisterType((*RepeatedHeavy)(nil), "benchmarks.reflectpb.RepeatedHeavy")
+	proto.RegisterEnum("benchmarks.reflectpb.Status", Status_name, Status_value)
+}
+
+func init() { proto.RegisterFile("reflectpb/benchmarks.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 913 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x66, 0xbd, 0xeb, 0xb5, 0xf7, 0x6c, 0x5c, 0xcc, 0x28, 0x42, 0xab, 0xd0, 0x96, 0xc5, 0x17,
+	0x68, 0xa9, 0xaa, 0x20, 0xb9, 0x95, 0x5a, 0x40, 0x05, 0x35, 0x89, 0xa5, 0x46, 0xa4, 0xa6, 0x9a,
+	0x24, 0x20, 0x71, 0x63, 0x8d, 0x3d, 0x27, 0xf6, 0x2a, 0xb3, 0x3f, 0xec, 0x8c, 0xab, 0xf8, 0x15,
+	0x78, 0x08, 0x24, 0x6e, 0x79, 0x2f, 0xde, 0x00, 0xee, 0xd1, 0xfc, 0xd8, 0x31, 0x52, 0x22, 0x23,
+	0xee, 0xe6, 0xfb, 0xe6, 0x3b, 0x67, 0xce, 0xdf, 0x9e, 0x85, 0x83, 0x06, 0xaf, 0x04, 0xce, 0x54,
+	0x3d, 0xfd, 0x72, 0x8a, 0xe5, 0x6c, 0x51, 0xb0, 0xe6, 0x5a, 0x1e, 0xd6, 0x4d, 0xa5, 0x2a, 0xb2,
+	0xbf, 0xc5, 0x6c, 0x64, 0x83, 0xdf, 0x3c, 0x68, 0x9f, 0x17, 0x4c, 0x08, 0xf2, 0x08, 0xa0, 0xc1,
+	0x5f, 0x96, 0x28, 0xd5, 0x24, 0xe7, 0x89, 0x97, 0x7a, 0x59, 0x40, 0x23, 0xc7, 0x9c, 0x72, 0xf2,
+	0x31, 0x84, 0x05, 0xaa, 0x45, 0xc5, 0x93, 0x56, 0xea, 0x65, 0x11, 0x75, 0x88, 0x3c, 0x05, 0xc2,
+	0x91, 0x71, 0x91, 0x97, 0x38, 0x59, 0x96, 0xf9, 0xcd, 0xa4, 0x64, 0x65, 0x95, 0xf8, 0xa9, 0x97,
+	0xf9, 0xb4, 0xbf, 0xbe, 0xb9, 0x2c, 0xf3, 0x9b, 0x31, 0x2b, 0x2b, 0xf2, 0x18, 0x20, 0xe7, 0x58,
+	0xd4, 0x95, 0xc2, 0x52, 0x25, 0x41, 0xea, 0x65, 0x5d, 0xba, 0xc5, 0x90, 0x3e, 0xf8, 0xd7, 0xb8,
+	0x4a, 0xda, 0xa9, 0x97, 0xed, 0x51, 0x7d, 0x1c, 0xfc, 0xd9, 0x82, 0xf0, 0x2d, 0xf2, 0x7c, 0x59,
+	0x90, 0x67, 0x10, 0x2e, 0x90, 0x71, 0x6c, 0x4c, 0x74, 0xf1, 0xf0, 0x93, 0xc3, 0xbb, 0x52, 0x3a,
+	0x34, 0xe9, 0x50, 0x27, 0x25, 0x0f, 0xa0, 0x95, 0xaf, 0x63, 0x6e, 0xe5, 0x9c, 0x7c, 0x06, 0x7b,
+	0x3c, 0x97, 0xb5, 0x60, 0xab, 0x49, 0xc9, 0x0a, 0x34, 0x91, 0x46, 0x34, 0x76, 0xdc, 0x98, 0x15,
+	0x48, 0xf6, 0xa1, 0x8d, 0x05, 0xcb, 0x85, 0x89, 0x2f, 0xa2, 0x16, 0x90, 0xe7, 0x10, 0x4a, 0xc5,
+	0xd4, 0x52, 0x9a, 0xe8, 0x1e, 0x0c, 0x1f, 0xde, 0xf3, 0xba, 0xd1, 0x50, 0xa7, 0xd5, 0xcf, 0xcd,
+	0x1a, 0x64, 0x0a, 0xb9, 0xa9, 0x4e, 0x12, 0x9a, 0xc2, 0xc4, 0x8e, 0xd3, 0x75, 0xd1, 0x92, 0x65,
+	0xcd, 0x6f, 0x25, 0x1d, 0x2b, 0x71, 0x9c, 0x91, 0xec, 0x43, 0x5b, 0xce, 0xaa, 0x06, 0x93, 0x6e,
+	0xea, 0x65, 0x1e, 0xb5, 0x80, 0x10, 0x08, 0x14, 0x9b, 0xcb, 0x24, 0x4a, 0xfd, 0x2c, 0xa2, 0xe6,
+	0x4c, 0x5e, 0x40, 0x87, 0x71, 0xde, 0xa0, 0x94, 0x09, 0x98, 0x22, 0x3d, 0xba, 0x3b, 0xcc, 0xd7,
+	0x56, 0x44, 0xd7, 0xea, 0xc1, 0x1f, 0x1e, 0x74, 0x1c, 0xa9, 0x7b, 0x2d, 0x55, 0x83, 0xa8, 0x4c,
+	0xa1, 0x23, 0xea, 0x90, 0x7e, 0x70, 0x96, 0xab, 0x95, 0xab, 0xa6, 0x39, 0x93, 0x04, 0x3a, 0xb3,
+	0x6a, 0x59, 0xaa, 0x66, 0xe5, 0x4a, 0xb9, 0x86, 0xe4, 0x53, 0x88, 0xeb, 0x4a, 0x2a, 0x26, 0x26,
+	0xb3, 0x8a, 0xa3, 0x2b, 0x26, 0x58, 0xea, 0xb8, 0xe2, 0x48, 0x0e, 0xa0, 0x2b, 0x98, 0xca, 0xd5,
+	0x92, 0xa3, 0xa9, 0x69, 0x8b, 0x6e, 0x30, 0x79, 0x08, 0x91, 0xa8, 0xca, 0xb9, 0xbd, 0x0c, 0xcd,
+	0xe5, 0x2d, 0x31, 0xf8, 0xbb, 0x05, 0xed, 0x33, 0xd6, 0xcc, 0xf1, 0xff, 0xcd, 0xc4, 0x10, 0xda,
+	0xb9, 0xc2, 0x42, 0x26, 0xad, 0xd4, 0xcf, 0xe2, 0xfb, 0x3a, 0x69, 0xa7, 0x8e, 0x5a, 0x29, 0xf9,
+	0x1c, 0x3e, 0x2c, 0xf1, 0x46, 0x4d, 0x6a, 0x36, 0xc7, 0x89, 0xaa, 0xae, 0xb1, 0x74, 0xf9, 0xf6,
+	0x34, 0xfd, 0x8e, 0xcd, 0xf1, 0x42, 0x93, 0xe4, 0x39, 0x04, 0x5a, 0x62, 0xd2, 0x8d, 0x87, 0xe9,
+	0xdd, 0xae, 0x4d, 0xec, 0x87, 0xda, 0x88, 0x1a, 0xb5, 0xae, 0x62, 0xcd, 0x56, 0xa2, 0x62, 0xdc,
+	0xcd, 0xfe, 0x1a, 0x1e, 0xfc, 0xea, 0x41, 0xa0, 0x85, 0xba, 0x29, 0xd5, 0xd5, 0x95, 0x74, 0x4d,
+	0xe9, 0x51, 0x87, 0xf4, 0x6c, 0x88, 0xbc, 0xc8, 0x95, 0xe9, 0x4a, 0x8f, 0x5a, 0xa0, 0x59, 0x55,
+	0x29, 0x26, 0x4c, 0x90, 0x01, 0xb5, 0x80, 0x7c, 0x0b, 0xf1, 0x22, 0x9f, 0x2f, 0x44, 0x3e, 0x5f,
+	0x28, 0xe4, 0x49, 0xf0, 0x1f, 0xd2, 0xdf, 0x36, 0x18, 0xfc, 0x1e, 0x40, 0xf7, 0x2d, 0xab, 0xdf,
+	0x20, 0x7b, 0xbf, 0x22, 0x47, 0x10, 0x0a, 0x36, 0x45, 0x21, 0x13, 0xcf, 0xf8, 0x79, 0x72, 0x8f,
+	0x1f, 0xa7, 0x3f, 0x3c, 0x33, 0xe2, 0x91, 0x9e, 0x0d, 0xea, 0x2c, 0xc9, 0x1b, 0xe8, 0x9a, 0x71,
+	0xc1, 0x66, 0xdd, 0x8c, 0xa7, 0x3b, 0xbc, 0x1c, 0x3b, 0xb9, 0xf5, 0xb3, 0xb1, 0x26, 0xaf, 0xa0,
+	0x3d, 0x5d, 0xe9, 0xcd, 0xe5, 0x1b, 0x37, 0xd9, 0x0e, 0x37, 0x47, 0xab, 0x53, 0x6e, 0x5d, 0x04,
+	0xd3, 0xd5, 0x29, 0x27, 0xdf, 0x41, 0x7b, 0x2a, 0xaa, 0xa9, 0x74, 0x35, 0xf9, 0x62, 0x97, 0xb9,
+	0xd6, 0x5a, 0x7b, 0x6b, 0x77, 0xf0, 0x15, 0xc4, 0x5b, 0x09, 0xae, 0x17, 0x99, 0xfd, 0x7e, 0xf4,
+	0x51, 0x77, 0xe4, 0x3d, 0x13, 0x4b, 0x74, 0x5f, 0x8f, 0x05, 0x5f, 0xb7, 0x5e, 0x7a, 0x07, 0xdf,
+	0x40, 0xef, 0x5f, 0x59, 0xed, 0x32, 0xf6, 0xb7, 0x8d, 0x2f, 0x21, 0xda, 0xe4, 0xb2, 0x6d, 0x18,
+	0x58, 0xc3, 0xe1, 0xb6, 0xe1, 0xce, 0x51, 0xbf, 0x75, 0xfb, 0x12, 0xe0, 0x36, 0xc7, 0x5d, 0x01,
+	0xed, 0x6d, 0x59, 0x0e, 0xfe, 0xf2, 0xa0, 0x47, 0xb1, 0x36, 0xfb, 0xcd, 0x0e, 0xca, 0x63, 0x00,
+	0x95, 0x17, 0x28, 0x15, 0x2b, 0x6a, 0x3b, 0x2c, 0x3e, 0xdd, 0x62, 0xf4, 0x64, 0x1b, 0x73, 0x3b,
+	0x02, 0x1e, 0x75, 0x48, 0xf3, 0x1c, 0x85, 0x62, 0xd2, 0xf4, 0xf4, 0x23, 0xea, 0x90, 0xe6, 0x17,
+	0x4c, 0x2e, 0xd0, 0x36, 0x2b, 0xa4, 0x0e, 0xe9, 0x98, 0xf4, 0x4a, 0xd7, 0x0b, 0x5a, 0x2f, 0x44,
+	0x0b, 0xb4, 0x7a, 0xb6, 0x58, 0x96, 0xd7, 0x32, 0x09, 0x53, 0x3f, 0xdb, 0xa3, 0x0e, 0x91, 0x17,
+	0xd0, 0x75, 0x7f, 0x37, 0x99, 0x74, 0x52, 0x7f, 0xd7, 0xee, 0xd8, 0x88, 0xf5, 0x33, 0x57, 0x42,
+	0xef, 0xdd, 0x6e, 0xea, 0x67, 0x5d, 0x6a, 0xc1, 0x93, 0x57, 0x10, 0xda, 0xd5, 0x4f, 0x62, 0xe8,
+	0x5c, 0x8e, 0xbf, 0x1f, 0xff, 0xf0, 0xd3, 0xb8, 0xff, 0x01, 0x01, 0x08, 0x5f, 0x1f, 0x5f, 0x9c,
+	0xfe, 0x38, 0xea, 0x7b, 0xa4, 0x07, 0xd1, 0xf9, 0xe5, 0xf9, 0xbb, 0xd1, 0xf8, 0x64, 0x74, 0xd2,
+	0x6f, 0x69, 0xdd, 0xc9, 0xe8, 0x6c, 0x74, 0x31, 0x3a, 0xe9, 0xfb, 0x47, 0xf1, 0xcf, 0xd1, 0xe6,
+	0xc5, 0x69, 0x68, 0xfe, 0xd8, 0xcf, 0xfe, 0x19, 0x00, 0x0a, 0xa8, 0x11, 0xf1, 0xcf, 0x07, 0x00,
+	0x00,
+}
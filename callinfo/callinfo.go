@@ -0,0 +1,293 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package callinfo describes the methods of services generated by the carno
+plugin, so that client interceptors and server middleware can decide what
+to do from the schema rather than from a hand-kept list of method names.
+
+Generated code registers a CallInfo for every method of its services when
+the program starts, and each generated client method attaches the CallInfo
+of the call to its context. A client interceptor can therefore use
+FromContext, and server middleware, which knows the method being called,
+can use Lookup:
+
+	func retry(ctx context.Context, call func(context.Context) error) error {
+		err := call(ctx)
+		if info, ok := callinfo.FromContext(ctx); ok && err != nil && info.Idempotent() {
+			err = call(ctx)
+		}
+		return err
+	}
+
+Method names have the form "pkg@Service/Method", as in package toggle.
+*/
+package callinfo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// A CallInfo describes one method of a carno service. Generated code
+// creates them; callers must not modify them.
+type CallInfo struct {
+	Service string // carno service name, such as "demo.users@UserService"
+	Method  string // method name as declared in the .proto file, such as "GetUser"
+
+	// Fully-qualified names of the request and response messages, such as
+	// "demo.users.GetUserRequest".
+	RequestType, ResponseType string
+
+	ClientStreaming, ServerStreaming bool
+
+	File string // name of the .proto file declaring the method
+
+	// Limits on the encoded request from the method's
+	// (carno.max_request_bytes) and (carno.max_request_fields) options;
+	// zero means no limit. See UnmarshalRequest.
+	MaxRequestBytes, MaxRequestFields int
+
+	// Rate limit from the method's (carno.rate_limit) option: calls per
+	// second on average, and at once; zero means no limit. See package
+	// ratelimit.
+	RateLimit float64
+	RateBurst int
+
+	// Timeout from the method's (carno.default_timeout) option for calls
+	// whose context has no deadline; zero means none. See
+	// WithDefaultTimeout.
+	DefaultTimeout time.Duration
+
+	// Oneway reports that the method has the (carno.oneway) option: the
+	// client does not wait for its response, which is always empty.
+	Oneway bool
+
+	// Name of the request field with the (carno.idempotency_key) option,
+	// or "" if it has none. See package dedupe.
+	IdempotencyKey string
+
+	once    sync.Once
+	options *pb.MethodOptions
+}
+
+// FullMethod returns the method name in the form "pkg@Service/Method".
+func (c *CallInfo) FullMethod() string { return c.Service + "/" + c.Method }
+
+// WithDefaultTimeout returns ctx with the method's DefaultTimeout applied
+// if ctx has no deadline, and a function to release its resources, as
+// context.WithTimeout does. Otherwise it returns ctx and a function that
+// does nothing.
+func (c *CallInfo) WithDefaultTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || c.DefaultTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.DefaultTimeout)
+}
+
+// UnmarshalRequest unmarshals the encoded request b into m, failing with
+// proto.ErrInputTooLarge or proto.ErrTooManyFields if it exceeds the
+// limits of the method. Server middleware decoding requests itself
+// should use it to reject oversized payloads before allocating them, as
+// packages httprpc, queuerpc and carnotest do. The limits apply only
+// there: the carno transport and the generated gRPC adapter decode
+// requests without them.
+func (c *CallInfo) UnmarshalRequest(b []byte, m proto.Message) error {
+	return proto.UnmarshalWithLimit(b, m, c.MaxRequestBytes, c.MaxRequestFields)
+}
+
+// Options returns the options of the method, read from the descriptor of
+// its file. It returns nil if the method has none.
+func (c *CallInfo) Options() *pb.MethodOptions {
+	c.once.Do(func() {
+		md := c.descriptor()
+		if md != nil {
+			c.options = md.Options
+		}
+	})
+	return c.options
+}
+
+// descriptor returns the MethodDescriptorProto of the method, or nil if
+// its file is not registered.
+func (c *CallInfo) descriptor() *pb.MethodDescriptorProto {
+	gz := proto.FileDescriptor(c.File)
+	if gz == nil {
+		return nil
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil
+	}
+	fd := new(pb.FileDescriptorProto)
+	if err := proto.Unmarshal(b, fd); err != nil {
+		return nil
+	}
+	service := c.Service[strings.LastIndex(c.Service, "@")+1:]
+	for _, sd := range fd.Service {
+		if sd.GetName() != service {
+			continue
+		}
+		for _, md := range sd.Method {
+			if md.GetName() == c.Method {
+				return md
+			}
+		}
+	}
+	return nil
+}
+
+// Idempotency returns the (idempotency_level) option of the method.
+func (c *CallInfo) Idempotency() pb.MethodOptions_IdempotencyLevel {
+	return c.Options().GetIdempotencyLevel()
+}
+
+// Idempotent reports whether calling the method twice has the same effect
+// as calling it once, because it is marked idempotent or to have no side
+// effects.
+func (c *CallInfo) Idempotent() bool {
+	switch c.Idempotency() {
+	case pb.MethodOptions_IDEMPOTENT, pb.MethodOptions_NO_SIDE_EFFECTS:
+		return true
+	}
+	return false
+}
+
+// RequestDescriptor returns the descriptor of the request message, or nil
+// if its Go type is not linked into the program.
+func (c *CallInfo) RequestDescriptor() *pb.DescriptorProto { return messageDescriptor(c.RequestType) }
+
+// ResponseDescriptor returns the descriptor of the response message, or nil
+// if its Go type is not linked into the program.
+func (c *CallInfo) ResponseDescriptor() *pb.DescriptorProto { return messageDescriptor(c.ResponseType) }
+
+func messageDescriptor(name string) *pb.DescriptorProto {
+	t := proto.MessageType(name)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return nil
+	}
+	m, ok := reflect.New(t.Elem()).Interface().(descriptor.Message)
+	if !ok {
+		return nil
+	}
+	_, md := descriptor.ForMessage(m)
+	return md
+}
+
+var (
+	mu       sync.RWMutex
+	calls    = make(map[string]*CallInfo)   // by FullMethod
+	services = make(map[string][]*CallInfo) // by Service, in order of registration
+	served   = make(map[string]bool)        // by Service
+)
+
+// Register records the CallInfos of a service so that Lookup can find
+// them. Generated code calls it from an init function.
+func Register(infos ...*CallInfo) {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, info := range infos {
+		name := info.FullMethod()
+		if _, ok := calls[name]; ok {
+			log.Printf("callinfo: duplicate method registered: %s", name)
+			continue
+		}
+		calls[name] = info
+		services[info.Service] = append(services[info.Service], info)
+	}
+}
+
+// Methods returns the CallInfos of the methods of the service with the
+// given carno name, such as "demo.users@UserService", in the order they
+// are declared.
+func Methods(service string) []*CallInfo {
+	mu.RLock()
+	defer mu.RUnlock()
+	return append([]*CallInfo(nil), services[service]...)
+}
+
+// RegisterServer records that the program serves the service with the
+// given carno name. Generated Register<Service>Server functions call it.
+func RegisterServer(service string) {
+	mu.Lock()
+	defer mu.Unlock()
+	served[service] = true
+}
+
+// Servers returns the carno names of the services the program serves,
+// sorted.
+func Servers() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(served))
+	for name := range served {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the CallInfo of the method named "pkg@Service/Method", or
+// nil if no such method is registered.
+func Lookup(method string) *CallInfo {
+	mu.RLock()
+	defer mu.RUnlock()
+	return calls[method]
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying info.
+func NewContext(ctx context.Context, info *CallInfo) context.Context {
+	return context.WithValue(ctx, contextKey{}, info)
+}
+
+// FromContext returns the CallInfo carried by ctx, if any.
+func FromContext(ctx context.Context) (*CallInfo, bool) {
+	info, ok := ctx.Value(contextKey{}).(*CallInfo)
+	return info, ok
+}
@@ -0,0 +1,208 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package callinfo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+func init() {
+	fd := &pb.FileDescriptorProto{
+		Name:    proto.String("callinfo_test.proto"),
+		Package: proto.String("test"),
+		Service: []*pb.ServiceDescriptorProto{{
+			Name: proto.String("Files"),
+			Method: []*pb.MethodDescriptorProto{
+				{Name: proto.String("Put")},
+				{
+					Name: proto.String("Get"),
+					Options: &pb.MethodOptions{
+						IdempotencyLevel: pb.MethodOptions_NO_SIDE_EFFECTS.Enum(),
+					},
+				},
+			},
+		}},
+	}
+	b, err := proto.Marshal(fd)
+	if err != nil {
+		panic(err)
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write(b)
+	w.Close()
+	proto.RegisterFile("callinfo_test.proto", buf.Bytes())
+}
+
+var (
+	put = &CallInfo{
+		Service:      "test@Files",
+		Method:       "Put",
+		RequestType:  "google.protobuf.FileDescriptorProto",
+		ResponseType: "google.protobuf.FileDescriptorSet",
+		File:         "callinfo_test.proto",
+	}
+	get = &CallInfo{
+		Service:      "test@Files",
+		Method:       "Get",
+		RequestType:  "test.Missing",
+		ResponseType: "google.protobuf.FileDescriptorProto",
+		File:         "callinfo_test.proto",
+	}
+)
+
+func init() {
+	Register(put, get)
+}
+
+func TestLookup(t *testing.T) {
+	if got := Lookup("test@Files/Get"); got != get {
+		t.Errorf("Lookup(test@Files/Get) = %v, want %v", got, get)
+	}
+	if got := Lookup("test@Files/Delete"); got != nil {
+		t.Errorf("Lookup(test@Files/Delete) = %v, want nil", got)
+	}
+}
+
+func TestMethods(t *testing.T) {
+	if got := Methods("test@Files"); len(got) != 2 || got[0] != put || got[1] != get {
+		t.Errorf("Methods(test@Files) = %v, want [%v %v]", got, put, get)
+	}
+	if got := Methods("test@Nothing"); len(got) != 0 {
+		t.Errorf("Methods(test@Nothing) = %v, want none", got)
+	}
+}
+
+func TestServers(t *testing.T) {
+	RegisterServer("test@Files")
+	RegisterServer("test@Aardvarks")
+	RegisterServer("test@Files")
+	if got := Servers(); len(got) != 2 || got[0] != "test@Aardvarks" || got[1] != "test@Files" {
+		t.Errorf("Servers() = %v, want [test@Aardvarks test@Files]", got)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	if put.Options() != nil || put.Idempotent() {
+		t.Errorf("Put: options %v, idempotent %v; want none", put.Options(), put.Idempotent())
+	}
+	if !get.Idempotent() {
+		t.Errorf("Get: idempotency %v, want idempotent", get.Idempotency())
+	}
+	unknown := &CallInfo{Service: "test@Files", Method: "Get", File: "unknown.proto"}
+	if unknown.Options() != nil {
+		t.Errorf("options of a method in an unregistered file = %v, want nil", unknown.Options())
+	}
+}
+
+func TestUnmarshalRequest(t *testing.T) {
+	fd := &pb.FileDescriptorProto{
+		Name:       proto.String("a.proto"),
+		Dependency: []string{"b.proto", "c.proto"},
+	}
+	b, err := proto.Marshal(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		maxBytes, maxFields int
+		err                 error
+	}{
+		{0, 0, nil},
+		{len(b), 3, nil},
+		{len(b) - 1, 0, proto.ErrInputTooLarge},
+		{0, 2, proto.ErrTooManyFields},
+	} {
+		info := &CallInfo{MaxRequestBytes: test.maxBytes, MaxRequestFields: test.maxFields}
+		got := new(pb.FileDescriptorProto)
+		if err := info.UnmarshalRequest(b, got); err != test.err {
+			t.Errorf("UnmarshalRequest with limits %d, %d: %v, want %v", test.maxBytes, test.maxFields, err, test.err)
+		} else if err == nil && !proto.Equal(got, fd) {
+			t.Errorf("UnmarshalRequest with limits %d, %d = %v, want %v", test.maxBytes, test.maxFields, got, fd)
+		}
+	}
+}
+
+func TestMessageDescriptors(t *testing.T) {
+	if got := put.RequestDescriptor().GetName(); got != "FileDescriptorProto" {
+		t.Errorf("Put request descriptor is %q, want FileDescriptorProto", got)
+	}
+	if got := put.ResponseDescriptor().GetName(); got != "FileDescriptorSet" {
+		t.Errorf("Put response descriptor is %q, want FileDescriptorSet", got)
+	}
+	if got := get.RequestDescriptor(); got != nil {
+		t.Errorf("descriptor of an unlinked message = %v, want nil", got)
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if info, ok := FromContext(ctx); ok {
+		t.Errorf("FromContext(Background) = %v, want none", info)
+	}
+	if info, ok := FromContext(NewContext(ctx, get)); !ok || info != get {
+		t.Errorf("FromContext = %v, %v; want %v", info, ok, get)
+	}
+}
+
+func TestWithDefaultTimeout(t *testing.T) {
+	info := &CallInfo{Service: "test@Files", Method: "Get", DefaultTimeout: time.Minute}
+	ctx, cancel := info.WithDefaultTimeout(context.Background())
+	defer cancel()
+	if d, ok := ctx.Deadline(); !ok || d.Sub(time.Now()) > time.Minute {
+		t.Errorf("deadline = %v, %v; want one within a minute", d, ok)
+	}
+
+	// A deadline already set is kept, even a later one.
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelParent()
+	want, _ := parent.Deadline()
+	ctx, cancel = info.WithDefaultTimeout(parent)
+	defer cancel()
+	if d, _ := ctx.Deadline(); !d.Equal(want) {
+		t.Errorf("deadline = %v, want the parent's %v", d, want)
+	}
+
+	// Without a DefaultTimeout, there is no deadline.
+	ctx, cancel = (&CallInfo{}).WithDefaultTimeout(context.Background())
+	defer cancel()
+	if d, ok := ctx.Deadline(); ok {
+		t.Errorf("deadline = %v, want none", d)
+	}
+}
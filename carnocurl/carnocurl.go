@@ -0,0 +1,232 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package carnocurl calls the methods of carno services by name, with
+requests written in JSON, using descriptors of the services and their
+messages found at run time, so that a generic command-line tool can call
+any carno service without being compiled against it.
+
+The descriptors come from a descriptor.Registry: descriptor.Global for the
+files linked into the program, or FilesFrom for those a server serves
+with package carnoreflect:
+
+	rc, err := carnoreflect.NewClient("demo.users")
+	if err != nil {
+		...
+	}
+	files, err := carnocurl.FilesFrom(ctx, rc)
+	if err != nil {
+		...
+	}
+	c, err := carnocurl.Dial("demo.users", files)
+	if err != nil {
+		...
+	}
+	resp, err := c.Call(ctx, "demo.users.UserService", "GetUser", []byte(`{"id": "42"}`))
+	if err != nil {
+		...
+	}
+	s, err := new(jsonpb.Marshaler).MarshalToString(resp)
+
+Streaming methods cannot be called, as with the generated clients.
+*/
+package carnocurl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ccsnake/carno"
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/carnoreflect"
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/dynamic"
+	"github.com/golang/protobuf/jsonpb"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// A Caller calls the methods of the services of one carno server, building
+// their requests and responses as dynamic messages. It is safe for
+// concurrent use.
+type Caller struct {
+	// Client is the carno client for the server, which is named after the
+	// package of its services.
+	Client client.Client
+
+	// Files holds the descriptors of the services and their messages. If
+	// it is nil, the caller uses descriptor.Global.
+	Files *descriptor.Registry
+
+	mu    sync.Mutex
+	types *dynamic.Registry // The message types of the files of the methods called.
+}
+
+// Dial creates and starts a client for the carno server with the given
+// name, such as "demo.users", and returns a Caller using it and the
+// descriptors in files.
+func Dial(server string, files *descriptor.Registry, opts ...client.Option) (*Caller, error) {
+	c, err := carno.NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Start(); err != nil {
+		return nil, err
+	}
+	return &Caller{Client: c, Files: files}, nil
+}
+
+func (c *Caller) files() *descriptor.Registry {
+	if c.Files != nil {
+		return c.Files
+	}
+	return descriptor.Global()
+}
+
+// Call calls the method of the service with the given fully-qualified
+// name, such as "demo.users.UserService", with the request written in
+// req, in the JSON format of package jsonpb. An empty req is an empty
+// request. The response is a *dynamic.Message, which jsonpb can write.
+func (c *Caller) Call(ctx context.Context, service, method string, req []byte, opts ...client.CallOption) (*dynamic.Message, error) {
+	sd := c.files().FindServiceByName(service)
+	if sd == nil {
+		return nil, fmt.Errorf("carnocurl: no service %q", service)
+	}
+	var md *pb.MethodDescriptorProto
+	for _, m := range sd.Proto.GetMethod() {
+		if m.GetName() == method {
+			md = m
+		}
+	}
+	if md == nil {
+		return nil, fmt.Errorf("carnocurl: service %s has no method %q", service, method)
+	}
+	if md.GetClientStreaming() || md.GetServerStreaming() {
+		return nil, fmt.Errorf("carnocurl: %s.%s is a streaming method", service, method)
+	}
+
+	in, out, err := c.newMessages(sd.File, md, req)
+	if err != nil {
+		return nil, fmt.Errorf("carnocurl: %s.%s: %v", service, method, err)
+	}
+
+	ctx = callinfo.NewContext(ctx, callInfo(sd, md))
+	if err := c.Client.Call(ctx, sd.Proto.GetName(), method, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// newMessages returns the request read from req and an empty response
+// for md, a method of a service declared in fd, adding fd and the files
+// it imports to c.types the first time. The request is read with c.mu
+// held too, since it resolves the types in Any fields with c.types.
+func (c *Caller) newMessages(fd *pb.FileDescriptorProto, md *pb.MethodDescriptorProto, req []byte) (in, out *dynamic.Message, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.types == nil {
+		c.types = dynamic.NewRegistry()
+	}
+	if err := c.types.AddFileFrom(c.files(), fd.GetName()); err != nil {
+		return nil, nil, err
+	}
+	if in, err = c.types.NewMessage(md.GetInputType()); err != nil {
+		return nil, nil, err
+	}
+	if out, err = c.types.NewMessage(md.GetOutputType()); err != nil {
+		return nil, nil, err
+	}
+	if len(bytes.TrimSpace(req)) > 0 {
+		u := &jsonpb.Unmarshaler{AnyResolver: c.types}
+		if err := u.Unmarshal(bytes.NewReader(req), in); err != nil {
+			return nil, nil, fmt.Errorf("bad request: %v", err)
+		}
+	}
+	return in, out, nil
+}
+
+// callInfo returns the CallInfo of md, a method of sd: the one its
+// generated code registered, if it is linked in, so that the method's
+// options apply as they would to a generated client, or else one made
+// from the descriptors.
+func callInfo(sd *descriptor.ServiceDescriptor, md *pb.MethodDescriptorProto) *callinfo.CallInfo {
+	service := sd.File.GetPackage() + "@" + sd.Proto.GetName()
+	if info := callinfo.Lookup(service + "/" + md.GetName()); info != nil {
+		return info
+	}
+	return &callinfo.CallInfo{
+		Service:      service,
+		Method:       md.GetName(),
+		RequestType:  strings.TrimPrefix(md.GetInputType(), "."),
+		ResponseType: strings.TrimPrefix(md.GetOutputType(), "."),
+		File:         sd.File.GetName(),
+	}
+}
+
+// FilesFrom asks the Reflection service of a carno server for the files
+// declaring the given services, such as "demo.users.UserService", and the
+// files they import, and returns a registry of them. With no services, it
+// asks for those of every service the server lists.
+func FilesFrom(ctx context.Context, rc carnoreflect.ReflectionClient, services ...string) (*descriptor.Registry, error) {
+	if len(services) == 0 {
+		resp, err := rc.ListServices(ctx, new(carnoreflect.ListServicesRequest))
+		if err != nil {
+			return nil, err
+		}
+		for _, svc := range resp.GetServices() {
+			services = append(services, svc.GetFullName())
+		}
+	}
+	files := descriptor.NewRegistry()
+	for _, service := range services {
+		if files.FindServiceByName(service) != nil {
+			continue
+		}
+		resp, err := rc.FileContainingSymbol(ctx, &carnoreflect.FileContainingSymbolRequest{Symbol: service})
+		if err != nil {
+			return nil, err
+		}
+		set, err := resp.Files()
+		if err != nil {
+			return nil, fmt.Errorf("carnocurl: files of %s: %v", service, err)
+		}
+		for _, fd := range set.GetFile() {
+			if err := files.RegisterFile(fd); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,166 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carnocurl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/carnoreflect"
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// fakeClient stands in for the carno client of the carnoreflect server.
+// It answers FileByName with the requested name as the file set, after
+// checking that the request encodes as a generated one would.
+type fakeClient struct{}
+
+func (fakeClient) Start() error { return nil }
+
+func (fakeClient) Call(ctx context.Context, service, method string, in, out interface{}, opts ...client.CallOption) error {
+	if info, ok := callinfo.FromContext(ctx); !ok || info.FullMethod() != "carnoreflect@"+service+"/"+method {
+		return fmt.Errorf("call to %s.%s has CallInfo %+v", service, method, info)
+	}
+	if service != "Reflection" || method != "FileByName" {
+		return fmt.Errorf("unexpected call to %s.%s", service, method)
+	}
+	b, err := proto.Marshal(in.(proto.Message))
+	if err != nil {
+		return err
+	}
+	req := new(carnoreflect.FileByNameRequest)
+	if err := proto.Unmarshal(b, req); err != nil {
+		return err
+	}
+	b, err = proto.Marshal(&carnoreflect.FileResponse{FileDescriptorSet: []byte(req.Name)})
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(b, out.(proto.Message))
+}
+
+func TestCall(t *testing.T) {
+	c := &Caller{Client: fakeClient{}}
+	resp, err := c.Call(context.Background(), "carnoreflect.Reflection", "FileByName", []byte(`{"name": "a.proto"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := resp.Get("file_descriptor_set"); err != nil || !bytes.Equal(v.([]byte), []byte("a.proto")) {
+		t.Errorf("file_descriptor_set = %v, %v; want a.proto", v, err)
+	}
+	s, err := new(jsonpb.Marshaler).MarshalToString(resp)
+	if want := `{"fileDescriptorSet":"YS5wcm90bw=="}`; err != nil || s != want {
+		t.Errorf("response JSON = %s, %v; want %s", s, err, want)
+	}
+
+	// An empty request is an empty message.
+	resp, err = c.Call(context.Background(), "carnoreflect.Reflection", "FileByName", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Has("file_descriptor_set") {
+		t.Errorf("response to empty request = %v", resp)
+	}
+}
+
+func TestCallErrors(t *testing.T) {
+	c := &Caller{Client: fakeClient{}}
+	for _, tc := range []struct {
+		service, method, req string
+		err                  string
+	}{
+		{"carnoreflect.Nope", "FileByName", "", `no service "carnoreflect.Nope"`},
+		{"carnoreflect.Reflection", "Nope", "", `has no method "Nope"`},
+		{"carnoreflect.Reflection", "FileByName", `{"nope": 1}`, "bad request"},
+	} {
+		_, err := c.Call(context.Background(), tc.service, tc.method, []byte(tc.req))
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("Call(%s, %s, %s) = %v, want error containing %q", tc.service, tc.method, tc.req, err, tc.err)
+		}
+	}
+}
+
+// reflectionClient calls a carnoreflect.Server directly.
+type reflectionClient struct {
+	s *carnoreflect.Server
+}
+
+func (r reflectionClient) ListServices(ctx context.Context, in *carnoreflect.ListServicesRequest, opts ...client.CallOption) (*carnoreflect.ListServicesResponse, error) {
+	return r.s.ListServices(ctx, in)
+}
+
+func (r reflectionClient) FileByName(ctx context.Context, in *carnoreflect.FileByNameRequest, opts ...client.CallOption) (*carnoreflect.FileResponse, error) {
+	return r.s.FileByName(ctx, in)
+}
+
+func (r reflectionClient) FileContainingSymbol(ctx context.Context, in *carnoreflect.FileContainingSymbolRequest, opts ...client.CallOption) (*carnoreflect.FileResponse, error) {
+	return r.s.FileContainingSymbol(ctx, in)
+}
+
+func TestFilesFrom(t *testing.T) {
+	carnoreflect.Register()
+	files, err := FilesFrom(context.Background(), reflectionClient{new(carnoreflect.Server)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if files.FindServiceByName("carnoreflect.Reflection") == nil {
+		t.Fatal("files lack carnoreflect.Reflection")
+	}
+	c := &Caller{Client: fakeClient{}, Files: files}
+	if _, err := c.Call(context.Background(), "carnoreflect.Reflection", "FileByName", []byte(`{"name": "a.proto"}`)); err != nil {
+		t.Error(err)
+	}
+}
+
+// A method whose generated code is not linked in gets a CallInfo made
+// from its descriptors.
+func TestCallInfoFromDescriptors(t *testing.T) {
+	sd := &descriptor.ServiceDescriptor{
+		Name:  "demo.Svc",
+		File:  &pb.FileDescriptorProto{Name: proto.String("demo/svc.proto"), Package: proto.String("demo")},
+		Proto: &pb.ServiceDescriptorProto{Name: proto.String("Svc")},
+	}
+	md := &pb.MethodDescriptorProto{Name: proto.String("Get"), InputType: proto.String(".demo.In"), OutputType: proto.String(".demo.Out")}
+	info := callInfo(sd, md)
+	if info.FullMethod() != "demo@Svc/Get" || info.RequestType != "demo.In" || info.ResponseType != "demo.Out" || info.File != "demo/svc.proto" {
+		t.Errorf("callInfo = %s (%s, %s) in %s; want demo@Svc/Get (demo.In, demo.Out) in demo/svc.proto",
+			info.FullMethod(), info.RequestType, info.ResponseType, info.File)
+	}
+}
@@ -0,0 +1,198 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package carnoreflect serves a description of the services of a carno
+server, and the schemas of their messages, over carno itself, so that
+tools can discover and call the methods of a live server without being
+compiled against them.
+
+A server registers the service along with its own:
+
+	users.RegisterUserServiceServer(srv)
+	carnoreflect.Register()
+
+and a tool asks the server, by its name, what it serves:
+
+	c, err := carnoreflect.NewClient("demo.users")
+	if err != nil {
+		...
+	}
+	resp, err := c.ListServices(ctx, new(carnoreflect.ListServicesRequest))
+
+The services listed are those registered with the generated
+Register<Service>Server functions, which record them with package
+callinfo. The descriptors served are those of the files linked into the
+program; package dynamic can build messages from them.
+*/
+package carnoreflect
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ccsnake/carno"
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Register registers a Server describing the program's services and
+// files, as descriptor.Global has them, with carno.
+func Register() {
+	RegisterReflectionServer(new(Server))
+}
+
+// NewClient creates and starts a client for the Reflection service of
+// the carno server with the given name, which is the package of the
+// services it serves, such as "demo.users". NewReflectionClient instead
+// calls a server named "carnoreflect".
+func NewClient(server string, opts ...client.Option) (ReflectionClient, error) {
+	c, err := carno.NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &reflectionClient{Client: c}
+	return rv, c.Start()
+}
+
+// Server implements ReflectionServer.
+type Server struct {
+	// Files holds the descriptors the server serves. If it is nil, the
+	// server serves those of descriptor.Global.
+	Files *descriptor.Registry
+}
+
+func (s *Server) files() *descriptor.Registry {
+	if s.Files != nil {
+		return s.Files
+	}
+	return descriptor.Global()
+}
+
+// ListServices lists the services registered with callinfo.RegisterServer.
+func (s *Server) ListServices(ctx context.Context, req *ListServicesRequest) (*ListServicesResponse, error) {
+	resp := new(ListServicesResponse)
+	for _, name := range callinfo.Servers() {
+		svc := &Service{
+			Name:     name,
+			FullName: strings.Replace(name, "@", ".", 1),
+		}
+		for _, info := range callinfo.Methods(name) {
+			svc.File = info.File
+			svc.Methods = append(svc.Methods, &Method{
+				Name:            info.Method,
+				RequestType:     info.RequestType,
+				ResponseType:    info.ResponseType,
+				ClientStreaming: info.ClientStreaming,
+				ServerStreaming: info.ServerStreaming,
+			})
+		}
+		if sd := s.files().FindServiceByName(svc.FullName); sd != nil {
+			svc.File = sd.File.GetName()
+		}
+		resp.Services = append(resp.Services, svc)
+	}
+	return resp, nil
+}
+
+// FileByName returns the file with the given name and the files it
+// imports.
+func (s *Server) FileByName(ctx context.Context, req *FileByNameRequest) (*FileResponse, error) {
+	fd := s.files().FindFileByPath(req.Name)
+	if fd == nil {
+		return nil, fmt.Errorf("carnoreflect: no file %q", req.Name)
+	}
+	return s.fileResponse(fd)
+}
+
+// FileContainingSymbol returns the file declaring the message, enum or
+// service with the given name, and the files it imports.
+func (s *Server) FileContainingSymbol(ctx context.Context, req *FileContainingSymbolRequest) (*FileResponse, error) {
+	files := s.files()
+	var fd *pb.FileDescriptorProto
+	if m := files.FindMessageByName(req.Symbol); m != nil {
+		fd = m.File
+	} else if e := files.FindEnumByName(req.Symbol); e != nil {
+		fd = e.File
+	} else if sd := files.FindServiceByName(req.Symbol); sd != nil {
+		fd = sd.File
+	} else {
+		return nil, fmt.Errorf("carnoreflect: no symbol %q", req.Symbol)
+	}
+	return s.fileResponse(fd)
+}
+
+// fileResponse returns fd and the files it imports, each after those it
+// imports.
+func (s *Server) fileResponse(fd *pb.FileDescriptorProto) (*FileResponse, error) {
+	files := s.files()
+	set := new(pb.FileDescriptorSet)
+	seen := make(map[string]bool)
+	var add func(fd *pb.FileDescriptorProto) error
+	add = func(fd *pb.FileDescriptorProto) error {
+		if seen[fd.GetName()] {
+			return nil
+		}
+		seen[fd.GetName()] = true
+		for _, dep := range fd.GetDependency() {
+			d := files.FindFileByPath(dep)
+			if d == nil {
+				return fmt.Errorf("carnoreflect: %s imports %s, which is not registered", fd.GetName(), dep)
+			}
+			if err := add(d); err != nil {
+				return err
+			}
+		}
+		set.File = append(set.File, fd)
+		return nil
+	}
+	if err := add(fd); err != nil {
+		return nil, err
+	}
+	b, err := proto.Marshal(set)
+	if err != nil {
+		return nil, err
+	}
+	return &FileResponse{FileDescriptorSet: b}, nil
+}
+
+// Files decodes the files in r.
+func (r *FileResponse) Files() (*pb.FileDescriptorSet, error) {
+	set := new(pb.FileDescriptorSet)
+	if err := proto.Unmarshal(r.GetFileDescriptorSet(), set); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
@@ -0,0 +1,127 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carnoreflect
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+func init() {
+	Register()
+}
+
+func TestListServices(t *testing.T) {
+	resp, err := new(Server).ListServices(context.Background(), new(ListServicesRequest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var svc *Service
+	for _, s := range resp.Services {
+		if s.Name == "carnoreflect@Reflection" {
+			svc = s
+		}
+	}
+	if svc == nil {
+		t.Fatalf("ListServices = %v, missing carnoreflect@Reflection", resp)
+	}
+	if svc.FullName != "carnoreflect.Reflection" || svc.File != "carnoreflect/reflection.proto" {
+		t.Errorf("service = %v", svc)
+	}
+	want := []*Method{
+		{Name: "ListServices", RequestType: "carnoreflect.ListServicesRequest", ResponseType: "carnoreflect.ListServicesResponse"},
+		{Name: "FileByName", RequestType: "carnoreflect.FileByNameRequest", ResponseType: "carnoreflect.FileResponse"},
+		{Name: "FileContainingSymbol", RequestType: "carnoreflect.FileContainingSymbolRequest", ResponseType: "carnoreflect.FileResponse"},
+	}
+	if len(svc.Methods) != len(want) {
+		t.Fatalf("methods = %v, want %v", svc.Methods, want)
+	}
+	for i, m := range svc.Methods {
+		if !proto.Equal(m, want[i]) {
+			t.Errorf("method %d = %v, want %v", i, m, want[i])
+		}
+	}
+}
+
+func TestFileContainingSymbol(t *testing.T) {
+	s := new(Server)
+	for _, sym := range []string{"carnoreflect.Method", ".carnoreflect.Reflection"} {
+		resp, err := s.FileContainingSymbol(context.Background(), &FileContainingSymbolRequest{Symbol: sym})
+		if err != nil {
+			t.Errorf("FileContainingSymbol(%q): %v", sym, err)
+			continue
+		}
+		set, err := resp.Files()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(set.File) != 1 || set.File[0].GetName() != "carnoreflect/reflection.proto" {
+			t.Errorf("FileContainingSymbol(%q) = %v", sym, set)
+		}
+	}
+	if _, err := s.FileContainingSymbol(context.Background(), &FileContainingSymbolRequest{Symbol: "carnoreflect.Nope"}); err == nil {
+		t.Error("FileContainingSymbol of an unknown symbol succeeded")
+	}
+}
+
+func TestFileByNameImports(t *testing.T) {
+	r := descriptor.NewRegistry()
+	files := []*pb.FileDescriptorProto{
+		{Name: proto.String("a.proto"), Package: proto.String("a")},
+		{Name: proto.String("b.proto"), Package: proto.String("b"), Dependency: []string{"a.proto"}},
+		{Name: proto.String("c.proto"), Package: proto.String("c"), Dependency: []string{"a.proto", "b.proto"}},
+	}
+	for _, fd := range files {
+		if err := r.RegisterFile(fd); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := &Server{Files: r}
+	resp, err := s.FileByName(context.Background(), &FileByNameRequest{Name: "c.proto"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := resp.Files()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(set, &pb.FileDescriptorSet{File: files}) {
+		t.Errorf("FileByName = %v, want %v", set, files)
+	}
+	if _, err := s.FileByName(context.Background(), &FileByNameRequest{Name: "d.proto"}); err == nil {
+		t.Error("FileByName of an unknown file succeeded")
+	}
+}
@@ -0,0 +1,118 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package carnotest calls carno server implementations in memory, so that
+unit tests of handlers go through the same encoding, decoding and checks
+as calls over the network without starting a carno listener. The carno
+plugin generates, for each service with carno:testserver=true,
+
+	func New<Service>TestServer(srv <Service>Server) <Service>Client
+
+which returns the generated client of the service on a Client from
+NewClient. Each call encodes the request, decodes it with the method's
+request limits, which the carno transport does not enforce, passes it
+through the checks of Register<Service>Server to srv, and encodes the
+response and decodes it into the client's, all within the calling
+goroutine. srv gets the caller's context, so a test can give it values,
+such as those an Authorizer reads, as well as a deadline. Streaming
+methods are not served.
+*/
+package carnotest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+)
+
+// A Method is one method of a service called by a Client.
+type Method struct {
+	// Info describes the method. If it is not nil, requests are decoded
+	// with its UnmarshalRequest, which enforces the method's request
+	// limits.
+	Info *callinfo.CallInfo
+
+	// Call decodes the request with decode and calls the server method
+	// with it.
+	Call func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error)
+}
+
+// NewClient returns a carno client that calls the methods of service, by
+// name, in memory. It needs no Start, but allows one.
+func NewClient(service string, methods map[string]Method) client.Client {
+	return &loopback{service: service, methods: methods}
+}
+
+type loopback struct {
+	service string
+	methods map[string]Method
+}
+
+func (c *loopback) Start() error { return nil }
+
+// Call calls the method with a copy of in, made by encoding and decoding
+// it, and decodes the response into out. Call options are ignored.
+func (c *loopback) Call(ctx context.Context, service, method string, in, out interface{}, opts ...client.CallOption) error {
+	m, ok := c.methods[method]
+	if service != c.service || !ok {
+		return fmt.Errorf("carnotest: no method %s in %s", method, service)
+	}
+	req, ok := in.(proto.Message)
+	if !ok {
+		return fmt.Errorf("carnotest: %s.%s: request %T is not a proto.Message", service, method, in)
+	}
+	resp, ok := out.(proto.Message)
+	if !ok {
+		return fmt.Errorf("carnotest: %s.%s: response %T is not a proto.Message", service, method, out)
+	}
+
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	got, err := m.Call(ctx, func(in proto.Message) error {
+		if m.Info != nil {
+			return m.Info.UnmarshalRequest(data, in)
+		}
+		return proto.Unmarshal(data, in)
+	})
+	if err != nil {
+		return err
+	}
+	if data, err = proto.Marshal(got); err != nil {
+		return fmt.Errorf("carnotest: %s.%s: %v", service, method, err)
+	}
+	return proto.Unmarshal(data, resp)
+}
@@ -0,0 +1,100 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carnotest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/proto3_proto"
+)
+
+type key struct{}
+
+// echo returns a method that records the requests it gets in seen, and
+// fails for the bunny "broken".
+func echo(seen *[]*pb.Nested) Method {
+	return Method{Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+		in := new(pb.Nested)
+		if err := decode(in); err != nil {
+			return nil, err
+		}
+		*seen = append(*seen, in)
+		if in.Bunny == "broken" {
+			return nil, errors.New("broken bunny")
+		}
+		if v, ok := ctx.Value(key{}).(string); ok {
+			in.Bunny += v
+		}
+		return in, nil
+	}}
+}
+
+func TestCall(t *testing.T) {
+	var seen []*pb.Nested
+	limited := echo(&seen)
+	limited.Info = &callinfo.CallInfo{MaxRequestBytes: 8}
+	c := NewClient("Hutch", map[string]Method{"Echo": echo(&seen), "Limited": limited})
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), key{}, "!")
+
+	in := &pb.Nested{Bunny: "Flopsy", Cute: true}
+	out := new(pb.Nested)
+	if err := c.Call(ctx, "Hutch", "Echo", in, out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Bunny != "Flopsy!" || !out.Cute {
+		t.Errorf("response = %v, want the request with the context's value", out)
+	}
+	// The server must get a copy, as it would over the network.
+	if len(seen) != 1 || seen[0] == in || in.Bunny != "Flopsy" {
+		t.Errorf("server got %p, the client's request %p; request now %v", seen[0], in, in)
+	}
+
+	if err := c.Call(ctx, "Hutch", "Echo", &pb.Nested{Bunny: "broken"}, out); err == nil || err.Error() != "broken bunny" {
+		t.Errorf("failing call: err = %v, want the server's error", err)
+	}
+	if err := c.Call(ctx, "Hutch", "Limited", &pb.Nested{Bunny: "far too long"}, out); err == nil {
+		t.Error("request over the method's limit was accepted")
+	}
+	for _, test := range []struct{ service, method string }{{"Hutch", "Frob"}, {"Burrow", "Echo"}} {
+		if err := c.Call(ctx, test.service, test.method, in, out); err == nil || !strings.Contains(err.Error(), "no method") {
+			t.Errorf("call to %s.%s: err = %v, want no method", test.service, test.method, err)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package dedupe answers repeated calls to the methods of carno services
+whose requests carry an idempotency key, in a field with the
+(carno.idempotency_key) option:
+
+	message ChargeRequest {
+	  string request_id = 1 [(carno.idempotency_key) = true];
+	  int64 amount = 2;
+	}
+
+The method's callinfo.CallInfo names the field, and the server generated
+by the carno plugin calls Do for each call, which returns the response
+of an earlier successful call with the same key, if the registered Store
+has kept it, and otherwise makes the call and stores its response. The
+response is stored with a hash of the request, and a call reusing a key
+with a different request fails with ErrKeyReused rather than getting
+another request's response. Calls with an empty key are made as usual,
+and failed calls are not stored, so that they can be retried. A
+duplicate of a call still in progress in the process waits for it to
+finish.
+
+The default Store, a MemoryStore, keeps up to DefaultMaxEntries records
+in the process for a day, evicting the least recently used; SetStore
+replaces it with one shared between servers, such as one backed by a
+database.
+*/
+package dedupe
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+)
+
+// A Store keeps records of calls by idempotency key. A record holds a
+// hash of the call's request and its encoded response; to a Store it is
+// just bytes.
+type Store interface {
+	// Get returns the record stored for key by a call to the method
+	// described by info, and whether there is one.
+	Get(ctx context.Context, info *callinfo.CallInfo, key string) ([]byte, bool, error)
+
+	// Put stores record, made by a call to the method described by
+	// info, for key.
+	Put(ctx context.Context, info *callinfo.CallInfo, key string, record []byte) error
+}
+
+// ErrKeyReused is returned by Do for a call whose key was used before
+// with a different request.
+var ErrKeyReused = errors.New("dedupe: idempotency key reused with a different request")
+
+var (
+	mu    sync.RWMutex
+	store Store = NewMemoryStore(24 * time.Hour)
+)
+
+// SetStore registers the Store that Do consults. If s is nil, calls are
+// not deduplicated.
+func SetStore(s Store) {
+	mu.Lock()
+	defer mu.Unlock()
+	store = s
+}
+
+// GetStore returns the registered Store.
+func GetStore() Store {
+	mu.RLock()
+	defer mu.RUnlock()
+	return store
+}
+
+// flights are the calls in progress, by method and key.
+var (
+	flightMu sync.Mutex
+	flights  = make(map[string]chan struct{})
+)
+
+// Do returns the response stored for key by an earlier call to the
+// method described by info with the request in, unmarshaled into out, if
+// there is one, or ErrKeyReused if the earlier call had another request.
+// If there is none, it returns the response and error of call, after
+// storing the response if the call succeeds. A failure to store the
+// response is not reported, as the call itself succeeded. If key is
+// empty, Do just makes the call. An error from the Store's Get is
+// returned without making the call, since the call may be a duplicate.
+func Do(ctx context.Context, info *callinfo.CallInfo, key string, in, out proto.Message, call func(ctx context.Context) (proto.Message, error)) (proto.Message, error) {
+	s := GetStore()
+	if key == "" || s == nil {
+		return call(ctx)
+	}
+	hash, err := proto.HashCanonical(in, sha256.New())
+	if err != nil {
+		return nil, err
+	}
+	name := info.FullMethod() + "\x00" + key
+	var done chan struct{}
+	for {
+		rec, ok, err := s.Get(ctx, info, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			// A record is the request's hash followed by the response.
+			if len(rec) < len(hash) || !bytes.Equal(rec[:len(hash)], hash) {
+				return nil, ErrKeyReused
+			}
+			if err := proto.Unmarshal(rec[len(hash):], out); err != nil {
+				return nil, err
+			}
+			return out, nil
+		}
+
+		flightMu.Lock()
+		wait, busy := flights[name]
+		if !busy {
+			done = make(chan struct{})
+			flights[name] = done
+		}
+		flightMu.Unlock()
+		if !busy {
+			break
+		}
+		// Wait for the call in progress, then look for its response.
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	defer func() {
+		flightMu.Lock()
+		delete(flights, name)
+		flightMu.Unlock()
+		close(done)
+	}()
+
+	resp, err := call(ctx)
+	if err != nil {
+		return resp, err
+	}
+	if b, err := proto.Marshal(resp); err == nil {
+		s.Put(ctx, info, key, append(hash, b...))
+	}
+	return resp, nil
+}
+
+// DefaultMaxEntries is the most records a MemoryStore keeps if its
+// MaxEntries is not set.
+const DefaultMaxEntries = 10000
+
+// MemoryStore is a Store that keeps records in memory for TTL after
+// they are put, evicting the least recently used when it holds
+// MaxEntries, so that clients sending new keys cannot grow it without
+// bound. The zero value is an empty MemoryStore that keeps nothing until
+// TTL is set. It is safe for concurrent use.
+type MemoryStore struct {
+	TTL time.Duration
+
+	// MaxEntries, if positive, is the most records kept; otherwise it
+	// is DefaultMaxEntries.
+	MaxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element // of *entry, in lru
+	lru     list.List                // most recently used first
+	swept   time.Time                // when expired entries were last removed
+	now     func() time.Time         // time.Now, but for tests
+}
+
+type entry struct {
+	key     string
+	record  []byte
+	expires time.Time
+}
+
+// NewMemoryStore returns an empty MemoryStore keeping records for ttl.
+func NewMemoryStore(ttl time.Duration) *MemoryStore {
+	return &MemoryStore{TTL: ttl}
+}
+
+// clock returns the current time.
+func (m *MemoryStore) clock() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+	return time.Now()
+}
+
+// Get implements Store.
+func (m *MemoryStore) Get(ctx context.Context, info *callinfo.CallInfo, key string) ([]byte, bool, error) {
+	now := m.clock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	el, ok := m.entries[info.FullMethod()+"\x00"+key]
+	if !ok {
+		return nil, false, nil
+	}
+	e := el.Value.(*entry)
+	if !now.Before(e.expires) {
+		m.remove(el)
+		return nil, false, nil
+	}
+	m.lru.MoveToFront(el)
+	return e.record, true, nil
+}
+
+// Put implements Store.
+func (m *MemoryStore) Put(ctx context.Context, info *callinfo.CallInfo, key string, record []byte) error {
+	now := m.clock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.entries == nil {
+		m.entries = make(map[string]*list.Element)
+	}
+	// Remove the expired entries now and then, so that they do not pile up.
+	if now.Sub(m.swept) >= m.TTL {
+		for _, el := range m.entries {
+			if !now.Before(el.Value.(*entry).expires) {
+				m.remove(el)
+			}
+		}
+		m.swept = now
+	}
+	k := info.FullMethod() + "\x00" + key
+	if el, ok := m.entries[k]; ok {
+		e := el.Value.(*entry)
+		e.record, e.expires = record, now.Add(m.TTL)
+		m.lru.MoveToFront(el)
+		return nil
+	}
+	m.entries[k] = m.lru.PushFront(&entry{k, record, now.Add(m.TTL)})
+	max := m.MaxEntries
+	if max <= 0 {
+		max = DefaultMaxEntries
+	}
+	for m.lru.Len() > max {
+		m.remove(m.lru.Back())
+	}
+	return nil
+}
+
+// remove removes the entry in el. m.mu must be held.
+func (m *MemoryStore) remove(el *list.Element) {
+	delete(m.entries, el.Value.(*entry).key)
+	m.lru.Remove(el)
+}
@@ -0,0 +1,222 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dedupe
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/proto3_proto"
+)
+
+var info = &callinfo.CallInfo{Service: "test@Payments", Method: "Charge"}
+
+// counter returns a call answering with the number of calls so far,
+// which it counts in n, or failing with err.
+func counter(n *int32, err error) func(context.Context) (proto.Message, error) {
+	return func(ctx context.Context) (proto.Message, error) {
+		i := atomic.AddInt32(n, 1)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.Message{ResultCount: int64(i)}, nil
+	}
+}
+
+// req is the request of the calls made by do.
+var req = &pb.Message{Name: "charge"}
+
+// do calls Do with the given key, req and call, and returns the
+// ResultCount of the response.
+func do(key string, call func(context.Context) (proto.Message, error)) (int64, error) {
+	m, err := Do(context.Background(), info, key, req, new(pb.Message), call)
+	if err != nil {
+		return 0, err
+	}
+	return m.(*pb.Message).ResultCount, nil
+}
+
+func TestDo(t *testing.T) {
+	SetStore(NewMemoryStore(time.Hour))
+	defer SetStore(NewMemoryStore(24 * time.Hour))
+
+	var n int32
+	if got, err := do("a", counter(&n, nil)); got != 1 || err != nil {
+		t.Fatalf("first call = %d, %v; want 1", got, err)
+	}
+	// A duplicate gets the first response without a call.
+	if got, err := do("a", counter(&n, nil)); got != 1 || err != nil || n != 1 {
+		t.Errorf("duplicate = %d, %v after %d calls; want 1 after 1", got, err, n)
+	}
+	if got, _ := do("b", counter(&n, nil)); got != 2 {
+		t.Errorf("call with another key = %d, want 2", got)
+	}
+	// Calls without a key are not deduplicated.
+	do("", counter(&n, nil))
+	if got, _ := do("", counter(&n, nil)); got != 4 {
+		t.Errorf("call without a key = %d, want 4", got)
+	}
+	// Failed calls are not stored, so they can be retried.
+	fail := errors.New("declined")
+	if _, err := do("c", counter(&n, fail)); err != fail {
+		t.Errorf("failing call returned %v, want %v", err, fail)
+	}
+	if got, err := do("c", counter(&n, nil)); got != 6 || err != nil {
+		t.Errorf("retry = %d, %v; want 6", got, err)
+	}
+	// Keys are per method.
+	other := &callinfo.CallInfo{Service: "test@Payments", Method: "Refund"}
+	if m, _ := Do(context.Background(), other, "a", req, new(pb.Message), counter(&n, nil)); m.(*pb.Message).ResultCount != 7 {
+		t.Errorf("call to another method = %d, want 7", m.(*pb.Message).ResultCount)
+	}
+	// A key reused with another request gets an error, not the response.
+	if _, err := Do(context.Background(), info, "a", &pb.Message{Name: "refund"}, new(pb.Message), counter(&n, nil)); err != ErrKeyReused || n != 7 {
+		t.Errorf("reused key returned %v after %d calls; want %v after 7", err, n, ErrKeyReused)
+	}
+}
+
+func TestDoConcurrent(t *testing.T) {
+	SetStore(NewMemoryStore(time.Hour))
+	defer SetStore(NewMemoryStore(24 * time.Hour))
+
+	var n int32
+	release := make(chan struct{})
+	slow := func(ctx context.Context) (proto.Message, error) {
+		<-release
+		return counter(&n, nil)(ctx)
+	}
+	var wg sync.WaitGroup
+	results := make([]int64, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = do("k", slow)
+		}(i)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if n != 1 {
+		t.Errorf("%d calls were made, want 1", n)
+	}
+	for i, r := range results {
+		if r != 1 {
+			t.Errorf("call %d = %d, want 1", i, r)
+		}
+	}
+}
+
+func TestDoWithoutStore(t *testing.T) {
+	SetStore(nil)
+	defer SetStore(NewMemoryStore(24 * time.Hour))
+
+	var n int32
+	do("a", counter(&n, nil))
+	if got, _ := do("a", counter(&n, nil)); got != 2 {
+		t.Errorf("duplicate without a store = %d, want 2", got)
+	}
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	now := time.Unix(0, 0)
+	m := NewMemoryStore(time.Minute)
+	m.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	m.Put(ctx, info, "a", []byte("x"))
+	now = now.Add(59 * time.Second)
+	if b, ok, _ := m.Get(ctx, info, "a"); !ok || string(b) != "x" {
+		t.Errorf("Get before TTL = %q, %v; want \"x\", true", b, ok)
+	}
+	now = now.Add(time.Second)
+	if _, ok, _ := m.Get(ctx, info, "a"); ok {
+		t.Error("Get after TTL found the response")
+	}
+	// Expired entries are removed by later puts.
+	now = now.Add(time.Minute)
+	m.Put(ctx, info, "b", []byte("y"))
+	if len(m.entries) != 1 {
+		t.Errorf("%d entries after expiry, want 1", len(m.entries))
+	}
+}
+
+func TestMemoryStoreZero(t *testing.T) {
+	ctx := context.Background()
+	var zero MemoryStore
+	if _, ok, err := zero.Get(ctx, info, "a"); ok || err != nil {
+		t.Errorf("Get from zero MemoryStore = %v, %v; want false, nil", ok, err)
+	}
+	m := &MemoryStore{TTL: time.Minute}
+	if err := m.Put(ctx, info, "a", []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	if b, ok, _ := m.Get(ctx, info, "a"); !ok || string(b) != "x" {
+		t.Errorf("Get = %q, %v; want \"x\", true", b, ok)
+	}
+}
+
+func TestMemoryStoreEviction(t *testing.T) {
+	ctx := context.Background()
+	m := &MemoryStore{TTL: time.Hour, MaxEntries: 2}
+	m.Put(ctx, info, "a", []byte("x"))
+	m.Put(ctx, info, "b", []byte("y"))
+	// Using a makes b the least recently used.
+	m.Get(ctx, info, "a")
+	m.Put(ctx, info, "c", []byte("z"))
+	for _, test := range []struct {
+		key string
+		ok  bool
+	}{{"a", true}, {"b", false}, {"c", true}} {
+		if _, ok, _ := m.Get(ctx, info, test.key); ok != test.ok {
+			t.Errorf("Get(%q) found %v, want %v", test.key, ok, test.ok)
+		}
+	}
+	if len(m.entries) != 2 || m.lru.Len() != 2 {
+		t.Errorf("%d entries, %d in use order; want 2", len(m.entries), m.lru.Len())
+	}
+
+	// Without MaxEntries, DefaultMaxEntries are kept.
+	m = NewMemoryStore(time.Hour)
+	for i := 0; i <= DefaultMaxEntries; i++ {
+		m.Put(ctx, info, string(rune(i)), nil)
+	}
+	if len(m.entries) != DefaultMaxEntries {
+		t.Errorf("%d entries, want %d", len(m.entries), DefaultMaxEntries)
+	}
+}
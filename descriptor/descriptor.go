@@ -30,7 +30,8 @@
 // OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
 
 // Package descriptor provides functions for obtaining protocol buffer
-// descriptors for generated Go types.
+// descriptors for generated Go types, and a registry of descriptors by
+// the names of the types and services they declare.
 //
 // These functions cannot go in package proto because they depend on the
 // generated protobuf descriptor messages, which themselves depend on proto.
@@ -46,8 +47,8 @@ import (
 	protobuf "github.com/golang/protobuf/protoc-gen-go/descriptor"
 )
 
-// extractFile extracts a FileDescriptorProto from a gzip'd buffer.
-func extractFile(gz []byte) (*protobuf.FileDescriptorProto, error) {
+// uncompress returns the contents of a gzip'd buffer.
+func uncompress(gz []byte) ([]byte, error) {
 	r, err := gzip.NewReader(bytes.NewReader(gz))
 	if err != nil {
 		return nil, fmt.Errorf("failed to open gzip reader: %v", err)
@@ -58,6 +59,15 @@ func extractFile(gz []byte) (*protobuf.FileDescriptorProto, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to uncompress descriptor: %v", err)
 	}
+	return b, nil
+}
+
+// extractFile extracts a FileDescriptorProto from a gzip'd buffer.
+func extractFile(gz []byte) (*protobuf.FileDescriptorProto, error) {
+	b, err := uncompress(gz)
+	if err != nil {
+		return nil, err
+	}
 
 	fd := new(protobuf.FileDescriptorProto)
 	if err := proto.Unmarshal(b, fd); err != nil {
@@ -67,6 +77,21 @@ func extractFile(gz []byte) (*protobuf.FileDescriptorProto, error) {
 	return fd, nil
 }
 
+// extractSet extracts a FileDescriptorSet from a gzip'd buffer.
+func extractSet(gz []byte) (*protobuf.FileDescriptorSet, error) {
+	b, err := uncompress(gz)
+	if err != nil {
+		return nil, err
+	}
+
+	set := new(protobuf.FileDescriptorSet)
+	if err := proto.Unmarshal(b, set); err != nil {
+		return nil, fmt.Errorf("malformed FileDescriptorSet: %v", err)
+	}
+
+	return set, nil
+}
+
 // Message is a proto.Message with a method to return its descriptor.
 //
 // Message types generated by the protocol compiler always satisfy
@@ -91,3 +116,40 @@ func ForMessage(msg Message) (fd *protobuf.FileDescriptorProto, md *protobuf.Des
 	}
 	return fd, md
 }
+
+// SetMessage is a Message with a method to return the FileDescriptorSet of
+// its file.
+//
+// Message types generated by protoc-gen-go with descriptor_set=true satisfy
+// the SetMessage interface, unless a field or oneof of theirs is named
+// FileDescriptor.
+type SetMessage interface {
+	Message
+	FileDescriptor() []byte
+}
+
+// SetForMessage returns the FileDescriptorSet embedded in the generated code
+// of the given message. It holds the message's file and the files it
+// imports, each file after the files it imports.
+func SetForMessage(msg SetMessage) *protobuf.FileDescriptorSet {
+	set, err := extractSet(msg.FileDescriptor())
+	if err != nil {
+		panic(fmt.Sprintf("invalid FileDescriptorSet for %T: %v", msg, err))
+	}
+	return set
+}
+
+// FileSet returns the FileDescriptorSet registered for the .proto file with
+// the given name by code generated with descriptor_set=true. It holds the
+// file and the files it imports, each file after the files it imports.
+func FileSet(filename string) (*protobuf.FileDescriptorSet, error) {
+	gz := proto.FileDescriptorSet(filename)
+	if gz == nil {
+		return nil, fmt.Errorf("descriptor: no FileDescriptorSet is registered for %s", filename)
+	}
+	set, err := extractSet(gz)
+	if err != nil {
+		return nil, fmt.Errorf("descriptor: %s: %v", filename, err)
+	}
+	return set, nil
+}
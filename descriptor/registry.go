@@ -0,0 +1,300 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package descriptor
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	protobuf "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// MessageDescriptor describes a message type in a Registry.
+type MessageDescriptor struct {
+	Name   string // The fully-qualified name, such as "foo.Request".
+	File   *protobuf.FileDescriptorProto
+	Proto  *protobuf.DescriptorProto
+	GoType reflect.Type // The generated type (pointer to struct), or nil if none is linked in.
+}
+
+// EnumDescriptor describes an enum type in a Registry.
+type EnumDescriptor struct {
+	Name  string // The fully-qualified name, such as "foo.Color".
+	File  *protobuf.FileDescriptorProto
+	Proto *protobuf.EnumDescriptorProto
+}
+
+// ServiceDescriptor describes a service in a Registry.
+type ServiceDescriptor struct {
+	Name  string // The fully-qualified name, such as "foo.Greeter".
+	File  *protobuf.FileDescriptorProto
+	Proto *protobuf.ServiceDescriptorProto
+}
+
+// A Registry maps the fully-qualified names of the messages, enums and
+// services declared in a set of .proto files to their descriptors, and
+// messages to their generated Go types. Global returns the registry of the
+// files linked into the program; code that looks types up should accept a
+// *Registry so that callers can pass another. A Registry is safe for
+// concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	files    map[string]*protobuf.FileDescriptorProto
+	messages map[string]*MessageDescriptor
+	enums    map[string]*EnumDescriptor
+	services map[string]*ServiceDescriptor
+	loaded   int // How many of proto.RegisteredFiles the global registry has loaded.
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		files:    make(map[string]*protobuf.FileDescriptorProto),
+		messages: make(map[string]*MessageDescriptor),
+		enums:    make(map[string]*EnumDescriptor),
+		services: make(map[string]*ServiceDescriptor),
+	}
+}
+
+var global = NewRegistry()
+
+// Global returns the registry of the .proto files whose generated code is
+// linked into the program, which registers them with proto.RegisterFile.
+// Each call first adds any files registered since the last; a file
+// declaring a name already in the registry is left out, and logged.
+func Global() *Registry {
+	global.mu.RLock()
+	loaded := global.loaded
+	global.mu.RUnlock()
+	names := proto.RegisteredFiles()
+	if len(names) == loaded {
+		return global
+	}
+
+	global.mu.Lock()
+	defer global.mu.Unlock()
+	for _, name := range names {
+		if _, ok := global.files[name]; ok {
+			continue
+		}
+		fd, err := extractFile(proto.FileDescriptor(name))
+		if err == nil {
+			err = global.registerLocked(fd)
+		}
+		if err != nil {
+			log.Printf("descriptor: leaving %s out of the global registry: %v", name, err)
+		}
+	}
+	global.loaded = len(names)
+	return global
+}
+
+// RegisterFile adds the types and services declared in fd to r. It adds
+// nothing, and returns an error, if r already has one of their names from
+// another file. Registering a file again does nothing; the files fd imports
+// need not be registered.
+func (r *Registry) RegisterFile(fd *protobuf.FileDescriptorProto) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.registerLocked(fd)
+}
+
+func (r *Registry) registerLocked(fd *protobuf.FileDescriptorProto) error {
+	if _, ok := r.files[fd.GetName()]; ok {
+		return nil
+	}
+
+	var (
+		messages []*MessageDescriptor
+		enums    []*EnumDescriptor
+		services []*ServiceDescriptor
+	)
+	prefix := fd.GetPackage()
+	if prefix != "" {
+		prefix += "."
+	}
+	var walk func(prefix string, msgs []*protobuf.DescriptorProto, ens []*protobuf.EnumDescriptorProto)
+	walk = func(prefix string, msgs []*protobuf.DescriptorProto, ens []*protobuf.EnumDescriptorProto) {
+		for _, e := range ens {
+			enums = append(enums, &EnumDescriptor{Name: prefix + e.GetName(), File: fd, Proto: e})
+		}
+		for _, d := range msgs {
+			name := prefix + d.GetName()
+			messages = append(messages, &MessageDescriptor{Name: name, File: fd, Proto: d, GoType: proto.MessageType(name)})
+			walk(name+".", d.GetNestedType(), d.GetEnumType())
+		}
+	}
+	walk(prefix, fd.GetMessageType(), fd.GetEnumType())
+	for _, s := range fd.GetService() {
+		services = append(services, &ServiceDescriptor{Name: prefix + s.GetName(), File: fd, Proto: s})
+	}
+
+	// Check every name before adding any. Services have names of their
+	// own, but protoc keeps them apart from types too.
+	seen := make(map[string]bool)
+	check := func(name string) error {
+		if seen[name] || r.messages[name] != nil || r.enums[name] != nil || r.services[name] != nil {
+			return fmt.Errorf("descriptor: %s: %s is already registered", fd.GetName(), name)
+		}
+		seen[name] = true
+		return nil
+	}
+	for _, m := range messages {
+		if err := check(m.Name); err != nil {
+			return err
+		}
+	}
+	for _, e := range enums {
+		if err := check(e.Name); err != nil {
+			return err
+		}
+	}
+	for _, s := range services {
+		if err := check(s.Name); err != nil {
+			return err
+		}
+	}
+
+	r.files[fd.GetName()] = fd
+	for _, m := range messages {
+		r.messages[m.Name] = m
+	}
+	for _, e := range enums {
+		r.enums[e.Name] = e
+	}
+	for _, s := range services {
+		r.services[s.Name] = s
+	}
+	return nil
+}
+
+// FindFileByPath returns the file registered with the given name, such as
+// "foo/foo.proto", or nil if r has none.
+func (r *Registry) FindFileByPath(name string) *protobuf.FileDescriptorProto {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.files[name]
+}
+
+// FindMessageByName returns the message type with the given
+// fully-qualified name, or nil if r has none. A leading dot, as in the
+// type names of fields, is ignored.
+func (r *Registry) FindMessageByName(name string) *MessageDescriptor {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.messages[strings.TrimPrefix(name, ".")]
+}
+
+// FindEnumByName returns the enum type with the given fully-qualified
+// name, or nil if r has none.
+func (r *Registry) FindEnumByName(name string) *EnumDescriptor {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.enums[strings.TrimPrefix(name, ".")]
+}
+
+// FindServiceByName returns the service with the given fully-qualified
+// name, or nil if r has none.
+func (r *Registry) FindServiceByName(name string) *ServiceDescriptor {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.services[strings.TrimPrefix(name, ".")]
+}
+
+// RangeMessages calls f for each message type in r, in order of name,
+// until f returns false.
+func (r *Registry) RangeMessages(f func(*MessageDescriptor) bool) {
+	r.mu.RLock()
+	msgs := make([]*MessageDescriptor, 0, len(r.messages))
+	for _, m := range r.messages {
+		msgs = append(msgs, m)
+	}
+	r.mu.RUnlock()
+	sort.Sort(messagesByName(msgs))
+	for _, m := range msgs {
+		if !f(m) {
+			return
+		}
+	}
+}
+
+type messagesByName []*MessageDescriptor
+
+func (s messagesByName) Len() int           { return len(s) }
+func (s messagesByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s messagesByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// RangeServices calls f for each service in r, in order of name, until f
+// returns false.
+func (r *Registry) RangeServices(f func(*ServiceDescriptor) bool) {
+	r.mu.RLock()
+	services := make([]*ServiceDescriptor, 0, len(r.services))
+	for _, s := range r.services {
+		services = append(services, s)
+	}
+	r.mu.RUnlock()
+	sort.Sort(servicesByName(services))
+	for _, s := range services {
+		if !f(s) {
+			return
+		}
+	}
+}
+
+type servicesByName []*ServiceDescriptor
+
+func (s servicesByName) Len() int           { return len(s) }
+func (s servicesByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s servicesByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Resolve returns a new message of the generated type named by the part of
+// typeURL after its last slash, as in a google.protobuf.Any. It lets r
+// serve as a jsonpb.AnyResolver.
+func (r *Registry) Resolve(typeURL string) (proto.Message, error) {
+	name := typeURL
+	if slash := strings.LastIndex(name, "/"); slash >= 0 {
+		name = name[slash+1:]
+	}
+	m := r.FindMessageByName(name)
+	if m == nil {
+		return nil, fmt.Errorf("descriptor: unknown message type %q", name)
+	}
+	if m.GoType == nil {
+		return nil, fmt.Errorf("descriptor: message type %q has no Go type linked in", name)
+	}
+	return reflect.New(m.GoType.Elem()).Interface().(proto.Message), nil
+}
@@ -0,0 +1,143 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package descriptor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	tpb "github.com/golang/protobuf/proto/testdata"
+	protobuf "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+func TestGlobal(t *testing.T) {
+	r := descriptor.Global()
+	m := r.FindMessageByName("testdata.MyMessage")
+	if m == nil {
+		t.Fatal("testdata.MyMessage is not in the global registry")
+	}
+	if m.GoType != reflect.TypeOf(new(tpb.MyMessage)) || m.Proto.GetName() != "MyMessage" || m.File.GetName() != "test.proto" {
+		t.Errorf("FindMessageByName(testdata.MyMessage) = %+v", m)
+	}
+	if m := r.FindMessageByName(".testdata.MyMessage.SomeGroup"); m == nil || m.GoType != reflect.TypeOf(new(tpb.MyMessage_SomeGroup)) {
+		t.Errorf("FindMessageByName(.testdata.MyMessage.SomeGroup) = %+v", m)
+	}
+	if e := r.FindEnumByName("testdata.MyMessage.Color"); e == nil || len(e.Proto.GetValue()) != 3 {
+		t.Errorf("FindEnumByName(testdata.MyMessage.Color) = %+v", e)
+	}
+	if fd := r.FindFileByPath("google/protobuf/descriptor.proto"); fd == nil || fd.GetPackage() != "google.protobuf" {
+		t.Errorf("FindFileByPath(google/protobuf/descriptor.proto) = %v", fd)
+	}
+	if m := r.FindMessageByName("testdata.NoSuchMessage"); m != nil {
+		t.Errorf("FindMessageByName(testdata.NoSuchMessage) = %+v", m)
+	}
+	if r != descriptor.Global() {
+		t.Error("Global returned another registry")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	r := descriptor.Global()
+	m, err := r.Resolve("type.googleapis.com/testdata.MyMessage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.(*tpb.MyMessage); !ok {
+		t.Errorf("Resolve returned a %T", m)
+	}
+	if _, err := r.Resolve("type.googleapis.com/testdata.NoSuchMessage"); err == nil {
+		t.Error("Resolve of an unknown type succeeded")
+	}
+}
+
+func serviceFile(name string) *protobuf.FileDescriptorProto {
+	return &protobuf.FileDescriptorProto{
+		Name:        proto.String(name),
+		Package:     proto.String("svc"),
+		MessageType: []*protobuf.DescriptorProto{{Name: proto.String("Request")}},
+		Service: []*protobuf.ServiceDescriptorProto{
+			{Name: proto.String("Zebra")},
+			{Name: proto.String("Alpha")},
+		},
+	}
+}
+
+func TestRegisterFile(t *testing.T) {
+	r := descriptor.NewRegistry()
+	if err := r.RegisterFile(serviceFile("svc.proto")); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.RegisterFile(serviceFile("svc.proto")); err != nil {
+		t.Errorf("registering a file again: %v", err)
+	}
+	if err := r.RegisterFile(serviceFile("other.proto")); err == nil {
+		t.Error("registering a duplicate name succeeded")
+	}
+	if r.FindFileByPath("other.proto") != nil {
+		t.Error("a file that failed to register is in the registry")
+	}
+
+	var names []string
+	r.RangeServices(func(s *descriptor.ServiceDescriptor) bool {
+		names = append(names, s.Name)
+		return true
+	})
+	if want := []string{"svc.Alpha", "svc.Zebra"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("RangeServices visited %v, want %v", names, want)
+	}
+	names = nil
+	r.RangeServices(func(s *descriptor.ServiceDescriptor) bool {
+		names = append(names, s.Name)
+		return false
+	})
+	if len(names) != 1 {
+		t.Errorf("RangeServices went on after f returned false: %v", names)
+	}
+
+	m := r.FindMessageByName("svc.Request")
+	if m == nil || m.GoType != nil {
+		t.Errorf("FindMessageByName(svc.Request) = %+v", m)
+	}
+	if _, err := r.Resolve("svc.Request"); err == nil {
+		t.Error("Resolve of a type without a Go type succeeded")
+	}
+	n := 0
+	r.RangeMessages(func(*descriptor.MessageDescriptor) bool {
+		n++
+		return true
+	})
+	if n != 1 {
+		t.Errorf("RangeMessages visited %d messages, want 1", n)
+	}
+}
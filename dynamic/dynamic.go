@@ -0,0 +1,730 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package dynamic provides messages whose types are described by descriptors
+at run time, rather than generated by protoc-gen-go, so that gateways and
+other generic tools can handle messages they were not compiled with.
+
+A Registry holds the FileDescriptorProtos of a set of .proto files. A
+Message of one of the types they declare marshals and unmarshals as a
+generated message of the type would, in the wire format with proto.Marshal
+and proto.Unmarshal, and in JSON with package jsonpb. Its fields are read
+and written by name or number:
+
+	reg := dynamic.NewRegistry()
+	if err := reg.AddFile(fd); err != nil {
+		...
+	}
+	m, err := reg.NewMessage("foo.Request")
+	if err != nil {
+		...
+	}
+	if err := proto.Unmarshal(b, m); err != nil {
+		...
+	}
+	name, err := m.Get("name")
+
+Field values have these Go types:
+
+	double                     float64
+	float                      float32
+	int64, sint64, sfixed64    int64
+	uint64, fixed64            uint64
+	int32, sint32, sfixed32    int32
+	uint32, fixed32            uint32
+	bool                       bool
+	string                     string
+	bytes                      []byte
+	enum                       int32
+	message, group             *Message
+
+A repeated field holds a []interface{} of these, and a map field a
+map[interface{}]interface{}. Extensions are kept as unknown fields. The
+well-known types, such as google.protobuf.Timestamp, are written in JSON
+as ordinary messages. proto.Clone, proto.Equal and the text format do not
+support dynamic messages.
+*/
+package dynamic
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// A Registry holds the message and enum types declared in a set of files.
+// Adding files to it is not safe to do concurrently with anything else;
+// once they are added, it and its types may be used concurrently.
+type Registry struct {
+	files    map[string]*descpb.FileDescriptorProto
+	messages map[string]*MessageType                // By fully-qualified name, without the leading dot.
+	enums    map[string]*descpb.EnumDescriptorProto // Likewise.
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		files:    make(map[string]*descpb.FileDescriptorProto),
+		messages: make(map[string]*MessageType),
+		enums:    make(map[string]*descpb.EnumDescriptorProto),
+	}
+}
+
+// AddFile adds the types declared in fd to r. The files fd imports must
+// have been added first. Adding the same file again does nothing.
+func (r *Registry) AddFile(fd *descpb.FileDescriptorProto) error {
+	if _, ok := r.files[fd.GetName()]; ok {
+		return nil
+	}
+	for _, dep := range fd.GetDependency() {
+		if _, ok := r.files[dep]; !ok {
+			return fmt.Errorf("dynamic: %s imports %s, which has not been added", fd.GetName(), dep)
+		}
+	}
+
+	// Declare all the types first, as fields may refer to types declared
+	// after them, or to the message declaring them.
+	prefix := fd.GetPackage()
+	if prefix != "" {
+		prefix += "."
+	}
+	var added []*MessageType
+	var declare func(prefix string, msgs []*descpb.DescriptorProto, enums []*descpb.EnumDescriptorProto) error
+	declare = func(prefix string, msgs []*descpb.DescriptorProto, enums []*descpb.EnumDescriptorProto) error {
+		for _, e := range enums {
+			name := prefix + e.GetName()
+			if r.declared(name) {
+				return fmt.Errorf("dynamic: %s: duplicate type %s", fd.GetName(), name)
+			}
+			r.enums[name] = e
+		}
+		for _, d := range msgs {
+			name := prefix + d.GetName()
+			if r.declared(name) {
+				return fmt.Errorf("dynamic: %s: duplicate type %s", fd.GetName(), name)
+			}
+			t := &MessageType{
+				reg:    r,
+				name:   name,
+				desc:   d,
+				proto3: fd.GetSyntax() == "proto3",
+			}
+			r.messages[name] = t
+			added = append(added, t)
+			if err := declare(name+".", d.GetNestedType(), d.GetEnumType()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	err := declare(prefix, fd.GetMessageType(), fd.GetEnumType())
+	if err == nil {
+		for _, t := range added {
+			if err = t.init(); err != nil {
+				break
+			}
+		}
+	}
+	if err == nil {
+		// Map entry types are declared in the same file as their fields.
+		for _, t := range added {
+			for _, f := range t.fields {
+				if f.repeated && f.message != nil && f.message.desc.GetOptions().GetMapEntry() {
+					f.key, f.val = f.message.byNum[1], f.message.byNum[2]
+				}
+			}
+		}
+	}
+	if err != nil {
+		for _, t := range added {
+			delete(r.messages, t.name)
+		}
+		r.forgetEnums(prefix, fd.GetMessageType(), fd.GetEnumType())
+		return err
+	}
+	r.files[fd.GetName()] = fd
+	return nil
+}
+
+func (r *Registry) declared(name string) bool {
+	_, isMsg := r.messages[name]
+	_, isEnum := r.enums[name]
+	return isMsg || isEnum
+}
+
+// forgetEnums removes the enums declared by a file that failed to be added.
+func (r *Registry) forgetEnums(prefix string, msgs []*descpb.DescriptorProto, enums []*descpb.EnumDescriptorProto) {
+	for _, e := range enums {
+		if r.enums[prefix+e.GetName()] == e {
+			delete(r.enums, prefix+e.GetName())
+		}
+	}
+	for _, d := range msgs {
+		r.forgetEnums(prefix+d.GetName()+".", d.GetNestedType(), d.GetEnumType())
+	}
+}
+
+// AddRegisteredFile adds the file with the given name compiled into the
+// program, as proto.FileDescriptor returns it, together with the files it
+// imports.
+func (r *Registry) AddRegisteredFile(filename string) error {
+	if _, ok := r.files[filename]; ok {
+		return nil
+	}
+	gz := proto.FileDescriptor(filename)
+	if gz == nil {
+		return fmt.Errorf("dynamic: no file %s is registered", filename)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return fmt.Errorf("dynamic: %s: %v", filename, err)
+	}
+	b, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return fmt.Errorf("dynamic: %s: %v", filename, err)
+	}
+	fd := new(descpb.FileDescriptorProto)
+	if err := proto.Unmarshal(b, fd); err != nil {
+		return fmt.Errorf("dynamic: %s: malformed FileDescriptorProto: %v", filename, err)
+	}
+	for _, dep := range fd.GetDependency() {
+		if err := r.AddRegisteredFile(dep); err != nil {
+			return err
+		}
+	}
+	return r.AddFile(fd)
+}
+
+// AddFileFrom adds the file with the given name in files, together with
+// the files it imports. Unlike AddRegisteredFile, it can add files
+// registered at run time, which have no generated code.
+func (r *Registry) AddFileFrom(files *descriptor.Registry, filename string) error {
+	if _, ok := r.files[filename]; ok {
+		return nil
+	}
+	fd := files.FindFileByPath(filename)
+	if fd == nil {
+		return fmt.Errorf("dynamic: no file %s is in the registry", filename)
+	}
+	for _, dep := range fd.GetDependency() {
+		if err := r.AddFileFrom(files, dep); err != nil {
+			return err
+		}
+	}
+	return r.AddFile(fd)
+}
+
+// MessageType returns the message type with the given fully-qualified
+// name, such as "foo.Request", or nil if r has none.
+func (r *Registry) MessageType(name string) *MessageType {
+	return r.messages[strings.TrimPrefix(name, ".")]
+}
+
+// NewMessage returns an empty message of the type with the given
+// fully-qualified name.
+func (r *Registry) NewMessage(name string) (*Message, error) {
+	t := r.MessageType(name)
+	if t == nil {
+		return nil, fmt.Errorf("dynamic: unknown message type %q", name)
+	}
+	return t.New(), nil
+}
+
+// Resolve returns an empty message of the type named by the part of
+// typeURL after its last slash, as in a google.protobuf.Any. It lets r
+// serve as a jsonpb.AnyResolver, or, given a bare name, as the Dynamic
+// function of a jsonpb.Resolver, which prefers generated types.
+func (r *Registry) Resolve(typeURL string) (proto.Message, error) {
+	name := typeURL
+	if slash := strings.LastIndex(name, "/"); slash >= 0 {
+		name = name[slash+1:]
+	}
+	return r.NewMessage(name)
+}
+
+// A MessageType is a message type declared in a file of a Registry.
+type MessageType struct {
+	reg    *Registry
+	name   string
+	desc   *descpb.DescriptorProto
+	proto3 bool
+
+	fields   []*field // In order of declaration.
+	numbered []*field // In order of number.
+	byNum    map[int32]*field
+	byName   map[string]*field // By name and by JSON name.
+}
+
+// field is a field of a MessageType, with its types resolved.
+type field struct {
+	*descpb.FieldDescriptorProto
+	jsonName string
+	repeated bool
+	packed   bool
+	oneof    bool         // Whether it is in a oneof, whose index OneofIndex holds.
+	message  *MessageType // For message and group fields, and maps.
+	enum     *descpb.EnumDescriptorProto
+	key, val *field // For maps, the fields of the entry.
+}
+
+type byNumber []*field
+
+func (s byNumber) Len() int           { return len(s) }
+func (s byNumber) Less(i, j int) bool { return s[i].GetNumber() < s[j].GetNumber() }
+func (s byNumber) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// init resolves the types of the fields of t.
+func (t *MessageType) init() error {
+	t.byNum = make(map[int32]*field)
+	t.byName = make(map[string]*field)
+	for _, fd := range t.desc.GetField() {
+		f := &field{
+			FieldDescriptorProto: fd,
+			jsonName:             fd.GetJsonName(),
+			repeated:             fd.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED,
+			oneof:                fd.OneofIndex != nil,
+		}
+		if f.jsonName == "" {
+			f.jsonName = jsonCamelCase(fd.GetName())
+		}
+		switch fd.GetType() {
+		case descpb.FieldDescriptorProto_TYPE_MESSAGE, descpb.FieldDescriptorProto_TYPE_GROUP:
+			f.message = t.reg.MessageType(fd.GetTypeName())
+			if f.message == nil {
+				return fmt.Errorf("dynamic: %s.%s has unknown type %s", t.name, fd.GetName(), fd.GetTypeName())
+			}
+		case descpb.FieldDescriptorProto_TYPE_ENUM:
+			f.enum = t.reg.enums[strings.TrimPrefix(fd.GetTypeName(), ".")]
+			if f.enum == nil {
+				return fmt.Errorf("dynamic: %s.%s has unknown type %s", t.name, fd.GetName(), fd.GetTypeName())
+			}
+		case descpb.FieldDescriptorProto_TYPE_STRING, descpb.FieldDescriptorProto_TYPE_BYTES:
+		default:
+			if f.repeated {
+				if fd.GetOptions() != nil && fd.GetOptions().Packed != nil {
+					f.packed = fd.GetOptions().GetPacked()
+				} else {
+					f.packed = t.proto3
+				}
+			}
+		}
+		if _, ok := t.byNum[fd.GetNumber()]; ok {
+			return fmt.Errorf("dynamic: %s has two fields numbered %d", t.name, fd.GetNumber())
+		}
+		t.fields = append(t.fields, f)
+		t.byNum[fd.GetNumber()] = f
+		t.byName[fd.GetName()] = f
+		if fd.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP {
+			// A group is also known by the name of its type, which is
+			// what jsonpb writes with OrigName.
+			t.byName[f.message.desc.GetName()] = f
+		}
+		if _, ok := t.byName[f.jsonName]; !ok {
+			t.byName[f.jsonName] = f
+		}
+	}
+	t.numbered = append([]*field(nil), t.fields...)
+	sort.Sort(byNumber(t.numbered))
+	return nil
+}
+
+// jsonCamelCase returns the JSON name protoc gives the field with the
+// given name.
+func jsonCamelCase(name string) string {
+	var b []byte
+	upper := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_':
+			upper = true
+		case upper && 'a' <= c && c <= 'z':
+			b = append(b, c-'a'+'A')
+			upper = false
+		default:
+			b = append(b, c)
+			upper = false
+		}
+	}
+	return string(b)
+}
+
+// isMap reports whether f is a map field.
+func (f *field) isMap() bool {
+	return f.key != nil
+}
+
+// Name returns the fully-qualified name of t, such as "foo.Request".
+func (t *MessageType) Name() string {
+	return t.name
+}
+
+// Descriptor returns the descriptor of t.
+func (t *MessageType) Descriptor() *descpb.DescriptorProto {
+	return t.desc
+}
+
+// New returns an empty message of type t.
+func (t *MessageType) New() *Message {
+	return &Message{typ: t}
+}
+
+// A Message is a message of a type described by a MessageType. The zero
+// Message has no type and cannot be used; make one with MessageType.New.
+type Message struct {
+	typ     *MessageType
+	values  map[int32]interface{} // The fields that are set, by number.
+	unknown []byte                // The encoding of unknown fields.
+}
+
+// Type returns the type of m.
+func (m *Message) Type() *MessageType {
+	return m.typ
+}
+
+// Reset clears all the fields of m.
+func (m *Message) Reset() {
+	m.values = nil
+	m.unknown = nil
+}
+
+// String returns m in JSON, as jsonpb.Marshaler writes it by default.
+func (m *Message) String() string {
+	b, err := m.MarshalJSON()
+	if err != nil {
+		return fmt.Sprintf("<%s: %v>", m.typ.name, err)
+	}
+	return string(b)
+}
+
+// ProtoMessage marks m as a protocol buffer message.
+func (*Message) ProtoMessage() {}
+
+func (m *Message) fieldNamed(name string) (*field, error) {
+	if f := m.typ.byName[name]; f != nil {
+		return f, nil
+	}
+	return nil, fmt.Errorf("dynamic: %s has no field %q", m.typ.name, name)
+}
+
+func (m *Message) fieldNumbered(num int32) (*field, error) {
+	if f := m.typ.byNum[num]; f != nil {
+		return f, nil
+	}
+	return nil, fmt.Errorf("dynamic: %s has no field numbered %d", m.typ.name, num)
+}
+
+// Get returns the value of the field with the given name, as declared in
+// the .proto file or as written in JSON. A field that is not set has its
+// default value: nil for messages, repeated fields and maps.
+func (m *Message) Get(name string) (interface{}, error) {
+	f, err := m.fieldNamed(name)
+	if err != nil {
+		return nil, err
+	}
+	return m.get(f), nil
+}
+
+// GetByNumber returns the value of the field with the given number, as Get
+// does.
+func (m *Message) GetByNumber(num int32) (interface{}, error) {
+	f, err := m.fieldNumbered(num)
+	if err != nil {
+		return nil, err
+	}
+	return m.get(f), nil
+}
+
+// Set sets the field with the given name to v, which must have the Go type
+// of the field. It keeps v, so later changes to its slices or maps change
+// m. Setting a field of a oneof clears the others, setting a proto3 scalar
+// to zero or any field to nil clears it.
+func (m *Message) Set(name string, v interface{}) error {
+	f, err := m.fieldNamed(name)
+	if err != nil {
+		return err
+	}
+	return m.set(f, v)
+}
+
+// SetByNumber sets the field with the given number, as Set does.
+func (m *Message) SetByNumber(num int32, v interface{}) error {
+	f, err := m.fieldNumbered(num)
+	if err != nil {
+		return err
+	}
+	return m.set(f, v)
+}
+
+// Has reports whether the field with the given name is set. It returns
+// false if m has no such field.
+func (m *Message) Has(name string) bool {
+	f := m.typ.byName[name]
+	return f != nil && m.values[f.GetNumber()] != nil
+}
+
+// Clear clears the field with the given name.
+func (m *Message) Clear(name string) error {
+	f, err := m.fieldNamed(name)
+	if err != nil {
+		return err
+	}
+	delete(m.values, f.GetNumber())
+	return nil
+}
+
+func (m *Message) get(f *field) interface{} {
+	if v, ok := m.values[f.GetNumber()]; ok {
+		return v
+	}
+	switch {
+	case f.isMap():
+		return map[interface{}]interface{}(nil)
+	case f.repeated:
+		return []interface{}(nil)
+	case f.message != nil:
+		return (*Message)(nil)
+	}
+	return f.defaultValue()
+}
+
+func (m *Message) set(f *field, v interface{}) error {
+	if v == nil {
+		delete(m.values, f.GetNumber())
+		return nil
+	}
+	if err := f.check(v); err != nil {
+		return fmt.Errorf("dynamic: %s.%s: %v", m.typ.name, f.GetName(), err)
+	}
+	m.store(f, v)
+	return nil
+}
+
+// store sets f to v, which has the field's type.
+func (m *Message) store(f *field, v interface{}) {
+	if m.typ.proto3 && !f.oneof && !f.repeated && f.message == nil && isZero(v) {
+		delete(m.values, f.GetNumber())
+		return
+	}
+	if m.values == nil {
+		m.values = make(map[int32]interface{})
+	}
+	if f.oneof {
+		for _, g := range m.typ.fields {
+			if g.oneof && g.GetOneofIndex() == f.GetOneofIndex() {
+				delete(m.values, g.GetNumber())
+			}
+		}
+	}
+	m.values[f.GetNumber()] = v
+}
+
+// isZero reports whether v, a scalar value, is the zero of its type.
+func isZero(v interface{}) bool {
+	switch v := v.(type) {
+	case []byte:
+		return len(v) == 0
+	case float32:
+		return v == 0 && !math.Signbit(float64(v))
+	case float64:
+		return v == 0 && !math.Signbit(v)
+	}
+	return v == zeroValue(v)
+}
+
+func zeroValue(v interface{}) interface{} {
+	switch v.(type) {
+	case int32:
+		return int32(0)
+	case int64:
+		return int64(0)
+	case uint32:
+		return uint32(0)
+	case uint64:
+		return uint64(0)
+	case bool:
+		return false
+	case string:
+		return ""
+	}
+	return nil
+}
+
+// check returns an error if v does not have the type of f.
+func (f *field) check(v interface{}) error {
+	switch {
+	case f.isMap():
+		mv, ok := v.(map[interface{}]interface{})
+		if !ok {
+			return fmt.Errorf("got %T, want map[interface{}]interface{}", v)
+		}
+		for k, e := range mv {
+			if err := f.key.checkSingle(k); err != nil {
+				return fmt.Errorf("key: %v", err)
+			}
+			if err := f.val.checkSingle(e); err != nil {
+				return fmt.Errorf("value for %v: %v", k, err)
+			}
+		}
+		return nil
+	case f.repeated:
+		s, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("got %T, want []interface{}", v)
+		}
+		for i, e := range s {
+			if err := f.checkSingle(e); err != nil {
+				return fmt.Errorf("element %d: %v", i, err)
+			}
+		}
+		return nil
+	}
+	return f.checkSingle(v)
+}
+
+// checkSingle returns an error if v does not have the type of a single
+// value of f.
+func (f *field) checkSingle(v interface{}) error {
+	if f.message != nil {
+		mv, ok := v.(*Message)
+		if !ok || mv == nil {
+			return fmt.Errorf("got %T, want a non-nil *dynamic.Message", v)
+		}
+		if mv.typ != f.message {
+			return fmt.Errorf("got a %s, want a %s", mv.typ.name, f.message.name)
+		}
+		return nil
+	}
+	want := f.zero()
+	if reflect.TypeOf(v) != reflect.TypeOf(want) {
+		return fmt.Errorf("got %T, want %T", v, want)
+	}
+	return nil
+}
+
+// zero returns the zero value of the Go type of a single scalar value of f.
+func (f *field) zero() interface{} {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return float64(0)
+	case descpb.FieldDescriptorProto_TYPE_FLOAT:
+		return float32(0)
+	case descpb.FieldDescriptorProto_TYPE_INT64, descpb.FieldDescriptorProto_TYPE_SINT64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return int64(0)
+	case descpb.FieldDescriptorProto_TYPE_UINT64, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		return uint64(0)
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_SINT32, descpb.FieldDescriptorProto_TYPE_SFIXED32,
+		descpb.FieldDescriptorProto_TYPE_ENUM:
+		return int32(0)
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32:
+		return uint32(0)
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		return false
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+		return ""
+	case descpb.FieldDescriptorProto_TYPE_BYTES:
+		return []byte(nil)
+	}
+	return nil
+}
+
+// defaultValue returns the default value of a singular scalar field f:
+// the one declared in a proto2 file, or the zero value.
+func (f *field) defaultValue() interface{} {
+	s := f.GetDefaultValue()
+	if f.DefaultValue == nil {
+		if f.enum != nil && len(f.enum.GetValue()) > 0 {
+			// A proto2 enum defaults to its first value.
+			return f.enum.GetValue()[0].GetNumber()
+		}
+		return f.zero()
+	}
+	switch v := f.zero().(type) {
+	case float64, float32:
+		var x float64
+		switch s {
+		case "inf":
+			x = math.Inf(1)
+		case "-inf":
+			x = math.Inf(-1)
+		case "nan":
+			x = math.NaN()
+		default:
+			x, _ = strconv.ParseFloat(s, 64)
+		}
+		if _, ok := v.(float32); ok {
+			return float32(x)
+		}
+		return x
+	case int64:
+		x, _ := strconv.ParseInt(s, 10, 64)
+		return x
+	case uint64:
+		x, _ := strconv.ParseUint(s, 10, 64)
+		return x
+	case int32:
+		if f.enum != nil {
+			for _, ev := range f.enum.GetValue() {
+				if ev.GetName() == s {
+					return ev.GetNumber()
+				}
+			}
+			return int32(0)
+		}
+		x, _ := strconv.ParseInt(s, 10, 32)
+		return int32(x)
+	case uint32:
+		x, _ := strconv.ParseUint(s, 10, 32)
+		return uint32(x)
+	case bool:
+		return s == "true"
+	case string:
+		return s
+	case []byte:
+		// protoc escapes bytes defaults as C does, which Go unquotes alike.
+		if u, err := strconv.Unquote(`"` + s + `"`); err == nil {
+			return []byte(u)
+		}
+		return []byte(s)
+	}
+	return f.zero()
+}
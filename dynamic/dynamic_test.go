@@ -0,0 +1,612 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/jsonpb"
+	jpb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	anypb "github.com/golang/protobuf/ptypes/any"
+)
+
+func registry(t *testing.T) *Registry {
+	r := NewRegistry()
+	for _, name := range []string{"test.proto", "test_objects.proto"} {
+		if err := r.AddRegisteredFile(name); err != nil {
+			t.Fatalf("AddRegisteredFile(%q): %v", name, err)
+		}
+	}
+	return r
+}
+
+func newMessage(t *testing.T, r *Registry, name string) *Message {
+	m, err := r.NewMessage(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+var roundTripTests = []proto.Message{
+	&jpb.Simple{
+		OBool: proto.Bool(true), OInt32: proto.Int32(-32), OInt64: proto.Int64(-1 << 40),
+		OUint32: proto.Uint32(1 << 31), OUint64: proto.Uint64(1 << 63), OSint32: proto.Int32(-5),
+		OSint64: proto.Int64(math.MinInt64), OFloat: proto.Float32(2.5), ODouble: proto.Float64(-1e100),
+		OString: proto.String("héllo"), OBytes: []byte{0, 1, 0xff},
+	},
+	&jpb.Repeats{
+		RBool: []bool{true, false}, RInt32: []int32{-1, 2}, RInt64: []int64{1 << 50},
+		RUint32: []uint32{7}, RUint64: []uint64{1 << 63}, RSint32: []int32{-2, 2},
+		RSint64: []int64{math.MaxInt64}, RFloat: []float32{1.5}, RDouble: []float64{-0.25},
+		RString: []string{"a", ""}, RBytes: [][]byte{{1}, {}},
+	},
+	&jpb.Widget{
+		Color:    jpb.Widget_BLUE.Enum(),
+		RColor:   []jpb.Widget_Color{jpb.Widget_RED, jpb.Widget_GREEN},
+		Simple:   &jpb.Simple{OString: proto.String("s")},
+		RSimple:  []*jpb.Simple{{OInt32: proto.Int32(1)}, {}},
+		Repeats:  &jpb.Repeats{RString: []string{"x"}},
+		RRepeats: []*jpb.Repeats{{RInt64: []int64{3}}},
+	},
+	&jpb.Maps{
+		MInt64Str:   map[int64]string{1: "one", -2: "minus two"},
+		MBoolSimple: map[bool]*jpb.Simple{true: {OBool: proto.Bool(true)}, false: {}},
+	},
+	&jpb.MsgWithOneof{Union: &jpb.MsgWithOneof_Salary{Salary: 31000}},
+	&jpb.MsgWithOneof{Union: &jpb.MsgWithOneof_Country{Country: "Australia"}},
+	&pb.MyMessage{
+		Count:     proto.Int32(42),
+		Name:      proto.String("Dave"),
+		Pet:       []string{"bunny", "kitty"},
+		Inner:     &pb.InnerMessage{Host: proto.String("footrest.syd"), Port: proto.Int32(7001)},
+		Others:    []*pb.OtherMessage{{Key: proto.Int64(3), Value: []byte("v")}},
+		Bikeshed:  pb.MyMessage_BLUE.Enum(),
+		Somegroup: &pb.MyMessage_SomeGroup{GroupField: proto.Int32(8)},
+		RepBytes:  [][]byte{[]byte("wow")},
+	},
+	&pb.MoreRepeated{
+		Bools: []bool{true}, BoolsPacked: []bool{false, true}, Ints: []int32{1, -1},
+		IntsPacked: []int32{-3, 300}, Int64SPacked: []int64{1 << 40}, Strings: []string{"s"},
+		Fixeds: []uint32{5},
+	},
+}
+
+func TestWireRoundTrip(t *testing.T) {
+	r := registry(t)
+	for _, want := range roundTripTests {
+		b, err := proto.Marshal(want)
+		if err != nil {
+			t.Fatalf("Marshal(%T): %v", want, err)
+		}
+		m := newMessage(t, r, proto.MessageName(want))
+		if err := proto.Unmarshal(b, m); err != nil {
+			t.Errorf("Unmarshal into %s: %v", m.Type().Name(), err)
+			continue
+		}
+		b2, err := proto.Marshal(m)
+		if err != nil {
+			t.Errorf("Marshal(%s): %v", m.Type().Name(), err)
+			continue
+		}
+		got := reflect.New(reflect.TypeOf(want).Elem()).Interface().(proto.Message)
+		if err := proto.Unmarshal(b2, got); err != nil {
+			t.Errorf("Unmarshal(%T): %v", got, err)
+			continue
+		}
+		if !proto.Equal(got, want) {
+			t.Errorf("%s round trip:\n got %v\nwant %v", m.Type().Name(), got, want)
+		}
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	r := registry(t)
+	marshalers := []jsonpb.Marshaler{
+		{},
+		{OrigName: true},
+		{EnumsAsInts: true},
+		{Int64AsNumber: true},
+		{Indent: "  "},
+	}
+	for _, gen := range roundTripTests {
+		b, err := proto.Marshal(gen)
+		if err != nil {
+			t.Fatalf("Marshal(%T): %v", gen, err)
+		}
+		m := newMessage(t, r, proto.MessageName(gen))
+		if err := proto.Unmarshal(b, m); err != nil {
+			t.Fatalf("Unmarshal into %s: %v", m.Type().Name(), err)
+		}
+		for _, jm := range marshalers {
+			want, err := jm.MarshalToString(gen)
+			if err != nil {
+				t.Fatalf("%+v.MarshalToString(%T): %v", jm, gen, err)
+			}
+			got, err := jm.MarshalToString(m)
+			if err != nil {
+				t.Errorf("%+v.MarshalToString(%s): %v", jm, m.Type().Name(), err)
+				continue
+			}
+			if got != want {
+				t.Errorf("%+v.MarshalToString(%s):\n got %s\nwant %s", jm, m.Type().Name(), got, want)
+			}
+
+			u := newMessage(t, r, proto.MessageName(gen))
+			if err := jsonpb.UnmarshalString(got, u); err != nil {
+				t.Errorf("UnmarshalString(%s): %v", got, err)
+				continue
+			}
+			if b, err := proto.Marshal(u); err != nil {
+				t.Errorf("Marshal(%s): %v", u.Type().Name(), err)
+			} else if back := proto.Clone(gen); proto.Unmarshal(b, back) != nil || !proto.Equal(back, gen) {
+				t.Errorf("JSON round trip of %s: got %v, want %v", u.Type().Name(), back, gen)
+			}
+		}
+	}
+}
+
+func TestJSONEmitDefaults(t *testing.T) {
+	r := registry(t)
+	for _, jm := range []jsonpb.Marshaler{
+		{EmitDefaults: true},
+		{EmitDefaultsFor: []string{"jsonpb.Maps", "jsonpb.Widget.color"}},
+		{EmitDefaults: true, OmitDefaultsFor: []string{"jsonpb.MsgWithOneof", "jsonpb.Widget.r_color"}},
+	} {
+		for _, gen := range []proto.Message{new(jpb.Widget), new(jpb.MsgWithOneof), new(jpb.Maps)} {
+			want, err := jm.MarshalToString(gen)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := jm.MarshalToString(newMessage(t, r, proto.MessageName(gen)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("%+v for %T:\n got %s\nwant %s", jm, gen, got, want)
+			}
+		}
+	}
+}
+
+func TestJSONNonFinite(t *testing.T) {
+	r := registry(t)
+	m := newMessage(t, r, "jsonpb.NonFinites")
+	const js = `{"fNan":"NaN","fPinf":"Infinity","fNinf":"-Infinity","dNan":"NaN","dPinf":"Infinity","dNinf":"-Infinity"}`
+	if err := jsonpb.UnmarshalString(js, m); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := m.Get("f_ninf"); v != float32(math.Inf(-1)) {
+		t.Errorf("f_ninf = %v, want -Inf", v)
+	}
+	if got := m.String(); got != js {
+		t.Errorf("String() = %s, want %s", got, js)
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	r := registry(t)
+	for _, js := range []string{
+		`{"nothing":1}`,
+		`{"oString":1}`,
+		`{"oInt32":"x"}`,
+		`{"oInt32":4294967296}`,
+		`{"oBytes":"!"}`,
+		`[]`,
+	} {
+		if err := jsonpb.UnmarshalString(js, newMessage(t, r, "jsonpb.Simple")); err == nil {
+			t.Errorf("UnmarshalString(%s) succeeded", js)
+		}
+	}
+	ju := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := ju.Unmarshal(strings.NewReader(`{"nothing":1}`), newMessage(t, r, "jsonpb.Simple")); err != nil {
+		t.Errorf("AllowUnknownFields: %v", err)
+	}
+	if err := jsonpb.UnmarshalString(`{"color":"PURPLE"}`, newMessage(t, r, "jsonpb.Widget")); err == nil {
+		t.Error("unknown enum name accepted")
+	}
+}
+
+func TestGetSet(t *testing.T) {
+	r := registry(t)
+	m := newMessage(t, r, "jsonpb.Widget")
+	simple := newMessage(t, r, "jsonpb.Simple")
+	if err := simple.Set("o_int64", int64(7)); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Set("simple", simple); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetByNumber(1, int32(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Set("rColor", []interface{}{int32(1)}); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := m.Get("color"); err != nil || v != int32(2) {
+		t.Errorf("Get(color) = %v, %v; want 2", v, err)
+	}
+	if v, err := m.GetByNumber(10); err != nil || v != simple {
+		t.Errorf("GetByNumber(10) = %v, %v; want %v", v, err, simple)
+	}
+
+	gen := new(jpb.Widget)
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(b, gen); err != nil {
+		t.Fatal(err)
+	}
+	want := &jpb.Widget{Color: jpb.Widget_BLUE.Enum(), RColor: []jpb.Widget_Color{jpb.Widget_GREEN}, Simple: &jpb.Simple{OInt64: proto.Int64(7)}}
+	if !proto.Equal(gen, want) {
+		t.Errorf("got %v, want %v", gen, want)
+	}
+
+	if err := m.Clear("simple"); err != nil || m.Has("simple") {
+		t.Errorf("Clear(simple) = %v, Has = %v", err, m.Has("simple"))
+	}
+	if v, _ := m.Get("simple"); v.(*Message) != nil {
+		t.Errorf("Get of cleared message field = %v, want nil", v)
+	}
+	if v, _ := m.Get("r_simple"); v.([]interface{}) != nil {
+		t.Errorf("Get of unset repeated field = %v, want nil", v)
+	}
+
+	for _, c := range []struct {
+		name string
+		v    interface{}
+	}{
+		{"color", 2},
+		{"color", int64(2)},
+		{"simple", m},
+		{"simple", (*Message)(nil)},
+		{"r_color", []int32{1}},
+		{"r_color", []interface{}{"RED"}},
+		{"nothing", int32(1)},
+	} {
+		if err := m.Set(c.name, c.v); err == nil {
+			t.Errorf("Set(%q, %#v) succeeded", c.name, c.v)
+		}
+	}
+	if _, err := m.GetByNumber(99); err == nil {
+		t.Error("GetByNumber(99) succeeded")
+	}
+}
+
+func TestOneof(t *testing.T) {
+	r := registry(t)
+	m := newMessage(t, r, "jsonpb.MsgWithOneof")
+	if err := m.Set("title", "boss"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Set("salary", int64(0)); err != nil {
+		t.Fatal(err)
+	}
+	if m.Has("title") || !m.Has("salary") {
+		t.Errorf("after setting salary, Has(title) = %v, Has(salary) = %v", m.Has("title"), m.Has("salary"))
+	}
+	if got := m.String(); got != `{"salary":"0"}` {
+		t.Errorf("String() = %s", got)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	r := registry(t)
+	m := newMessage(t, r, "testdata.Defaults")
+	gen := new(pb.Defaults)
+	for name, want := range map[string]interface{}{
+		"F_Bool":    gen.GetF_Bool(),
+		"F_Int32":   gen.GetF_Int32(),
+		"F_Int64":   gen.GetF_Int64(),
+		"F_Fixed32": gen.GetF_Fixed32(),
+		"F_Fixed64": gen.GetF_Fixed64(),
+		"F_Uint32":  gen.GetF_Uint32(),
+		"F_Uint64":  gen.GetF_Uint64(),
+		"F_Float":   gen.GetF_Float(),
+		"F_Double":  gen.GetF_Double(),
+		"F_String":  gen.GetF_String(),
+		"F_Bytes":   gen.GetF_Bytes(),
+		"F_Sint32":  gen.GetF_Sint32(),
+		"F_Sint64":  gen.GetF_Sint64(),
+		"F_Enum":    int32(gen.GetF_Enum()),
+		"F_Pinf":    gen.GetF_Pinf(),
+		"F_Ninf":    gen.GetF_Ninf(),
+		"str_zero":  gen.GetStrZero(),
+	} {
+		got, err := m.Get(name)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("Get(%q) = %#v, %v; want %#v", name, got, err, want)
+		}
+	}
+	if got, _ := m.Get("F_Nan"); !math.IsNaN(float64(got.(float32))) {
+		t.Errorf("Get(F_Nan) = %v, want NaN", got)
+	}
+}
+
+func TestProto3Zero(t *testing.T) {
+	fd := &descpb.FileDescriptorProto{
+		Name:    proto.String("p3.proto"),
+		Package: proto.String("p3"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("M"),
+			Field: []*descpb.FieldDescriptorProto{{
+				Name:   proto.String("n"),
+				Number: proto.Int32(1),
+				Label:  descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:   descpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+			}, {
+				Name:   proto.String("ns"),
+				Number: proto.Int32(2),
+				Label:  descpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+				Type:   descpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+			}},
+		}},
+	}
+	r := NewRegistry()
+	if err := r.AddFile(fd); err != nil {
+		t.Fatal(err)
+	}
+	m := newMessage(t, r, ".p3.M")
+	if err := m.Set("n", int32(0)); err != nil || m.Has("n") {
+		t.Errorf("Set(n, 0) = %v, Has(n) = %v; want nil, false", err, m.Has("n"))
+	}
+	if err := m.Set("ns", []interface{}{int32(1), int32(2)}); err != nil {
+		t.Fatal(err)
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Repeated scalars are packed in proto3.
+	if want := []byte{2<<3 | proto.WireBytes, 2, 1, 2}; !reflect.DeepEqual(b, want) {
+		t.Errorf("Marshal = %x, want %x", b, want)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	r := registry(t)
+	b, err := proto.Marshal(&pb.NewMessage{Nested: &pb.NewMessage_Nested{Name: proto.String("n"), FoodGroup: proto.String("fruit")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := newMessage(t, r, "testdata.OldMessage")
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	b2, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := new(pb.NewMessage)
+	if err := proto.Unmarshal(b2, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.GetNested().GetFoodGroup() != "fruit" {
+		t.Errorf("unknown field lost: got %v", got)
+	}
+}
+
+func TestAddFileErrors(t *testing.T) {
+	r := NewRegistry()
+	fd := &descpb.FileDescriptorProto{
+		Name:       proto.String("a.proto"),
+		Package:    proto.String("a"),
+		Dependency: []string{"b.proto"},
+	}
+	if err := r.AddFile(fd); err == nil {
+		t.Error("AddFile with a missing import succeeded")
+	}
+
+	fd = &descpb.FileDescriptorProto{
+		Name:    proto.String("a.proto"),
+		Package: proto.String("a"),
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("M"),
+			Field: []*descpb.FieldDescriptorProto{{
+				Name:     proto.String("x"),
+				Number:   proto.Int32(1),
+				Label:    descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String(".a.Missing"),
+			}},
+		}},
+	}
+	if err := r.AddFile(fd); err == nil {
+		t.Error("AddFile with an unknown field type succeeded")
+	}
+	if r.MessageType("a.M") != nil {
+		t.Error("failed AddFile left its types behind")
+	}
+
+	fd.MessageType[0].Field[0].TypeName = proto.String(".a.M")
+	if err := r.AddFile(fd); err != nil {
+		t.Fatal(err)
+	}
+	fd2 := &descpb.FileDescriptorProto{
+		Name:        proto.String("a2.proto"),
+		Package:     proto.String("a"),
+		MessageType: []*descpb.DescriptorProto{{Name: proto.String("M")}},
+	}
+	if err := r.AddFile(fd2); err == nil {
+		t.Error("AddFile with a duplicate type succeeded")
+	}
+	if err := r.AddRegisteredFile("no/such.proto"); err == nil {
+		t.Error("AddRegisteredFile of an unregistered file succeeded")
+	}
+	if _, err := r.NewMessage("a.Nothing"); err == nil {
+		t.Error("NewMessage of an unknown type succeeded")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	r := registry(t)
+	m, err := r.Resolve("type.googleapis.com/jsonpb.Simple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dm, ok := m.(*Message); !ok || dm.Type().Name() != "jsonpb.Simple" {
+		t.Errorf("Resolve returned %T %v", m, m)
+	}
+}
+
+func TestAnyJSON(t *testing.T) {
+	// The files are registered at run time, so no Go types exist for them.
+	files := descriptor.NewRegistry()
+	for _, fd := range []*descpb.FileDescriptorProto{{
+		Name:    proto.String("runtime/event.proto"),
+		Package: proto.String("runtime"),
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("Event"),
+			Field: []*descpb.FieldDescriptorProto{
+				{Name: proto.String("name"), Number: proto.Int32(1), Label: descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(), Type: descpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+				{Name: proto.String("at"), Number: proto.Int32(2), Label: descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(), Type: descpb.FieldDescriptorProto_TYPE_INT64.Enum()},
+			},
+		}},
+	}, {
+		Name:       proto.String("runtime/batch.proto"),
+		Package:    proto.String("runtime"),
+		Dependency: []string{"runtime/event.proto"},
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("Batch"),
+			Field: []*descpb.FieldDescriptorProto{
+				{Name: proto.String("events"), Number: proto.Int32(1), Label: descpb.FieldDescriptorProto_LABEL_REPEATED.Enum(), Type: descpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(), TypeName: proto.String(".runtime.Event")},
+			},
+		}},
+	}} {
+		if err := files.RegisterFile(fd); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := NewRegistry()
+	if err := r.AddFileFrom(files, "runtime/batch.proto"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.AddFileFrom(files, "runtime/none.proto"); err == nil {
+		t.Error("AddFileFrom of a missing file succeeded")
+	}
+
+	ev := newMessage(t, r, "runtime.Event")
+	if err := ev.Set("name", "boot"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ev.Set("at", int64(7)); err != nil {
+		t.Fatal(err)
+	}
+	b, err := proto.Marshal(ev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	any := &anypb.Any{TypeUrl: "type.googleapis.com/runtime.Event", Value: b}
+	// jsonpb sorts the fields of messages that marshal themselves into an Any.
+	const js = `{"@type":"type.googleapis.com/runtime.Event","at":"7","name":"boot"}`
+
+	res := &jsonpb.Resolver{Dynamic: r.Resolve}
+	got, err := (&jsonpb.Marshaler{AnyResolver: res}).MarshalToString(any)
+	if err != nil || got != js {
+		t.Errorf("MarshalToString = %s, %v; want %s", got, err, js)
+	}
+	back := new(anypb.Any)
+	if err := (&jsonpb.Unmarshaler{AnyResolver: res}).Unmarshal(strings.NewReader(js), back); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(back, any) {
+		t.Errorf("JSON round trip = %v, want %v", back, any)
+	}
+}
+
+func TestUnmarshalMaxDepth(t *testing.T) {
+	r := NewRegistry()
+	fd := &descpb.FileDescriptorProto{
+		Name:    proto.String("node.proto"),
+		Package: proto.String("a"),
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("Node"),
+			Field: []*descpb.FieldDescriptorProto{{
+				Name:     proto.String("child"),
+				Number:   proto.Int32(1),
+				Label:    descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String(".a.Node"),
+			}},
+		}},
+	}
+	if err := r.AddFile(fd); err != nil {
+		t.Fatal(err)
+	}
+	// nest returns Nodes nested depth deep, counting the outermost.
+	nest := func(depth int) []byte {
+		var b []byte
+		for i := 1; i < depth; i++ {
+			b = append(append([]byte{1<<3 | proto.WireBytes}, proto.EncodeVarint(uint64(len(b)))...), b...)
+		}
+		return b
+	}
+	for _, test := range []struct {
+		b        []byte
+		maxDepth int
+		err      error
+	}{
+		{nest(proto.DefaultMaxDepth), 0, nil},
+		{nest(proto.DefaultMaxDepth + 1), 0, proto.ErrTooDeep},
+		{nest(3), 3, nil},
+		{nest(4), 3, proto.ErrTooDeep},
+	} {
+		err := proto.UnmarshalOptions{MaxDepth: test.maxDepth}.Unmarshal(test.b, newMessage(t, r, "a.Node"))
+		if err != test.err {
+			t.Errorf("Unmarshal of %d bytes with MaxDepth %d = %v, want %v", len(test.b), test.maxDepth, err, test.err)
+		}
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	r := registry(t)
+	for _, b := range [][]byte{
+		{1<<3 | proto.WireBytes, 5, 'a'},       // truncated
+		{1<<3 | proto.WireBytes, 1, 0},         // o_bool with the wrong wire type
+		{0x80},                                 // truncated key
+		{1<<3 | proto.WireEndGroup},            // stray end group
+		{11<<3 | proto.WireVarint, 0x80, 0x80}, // truncated varint
+	} {
+		if err := proto.Unmarshal(b, newMessage(t, r, "jsonpb.Simple")); err == nil {
+			t.Errorf("Unmarshal(%x) succeeded", b)
+		}
+	}
+}
@@ -0,0 +1,432 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+// Encoding and decoding dynamic messages in JSON, as package jsonpb does
+// generated messages.
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// MarshalJSON returns m in JSON, as jsonpb.Marshaler writes it by default.
+func (m *Message) MarshalJSON() ([]byte, error) {
+	return m.MarshalJSONPB(new(jsonpb.Marshaler))
+}
+
+// UnmarshalJSON sets m to the message in JSON in b, as jsonpb.Unmarshal
+// does.
+func (m *Message) UnmarshalJSON(b []byte) error {
+	return m.UnmarshalJSONPB(new(jsonpb.Unmarshaler), b)
+}
+
+// MarshalJSONPB returns m in JSON, following the options of jm and laid
+// out as jsonpb.Marshaler lays out generated messages. It makes m a
+// jsonpb.JSONPBMarshaler, so jsonpb.Marshaler marshals m with it.
+func (m *Message) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	w := &jsonWriter{jm: jm}
+	if err := w.message(m, ""); err != nil {
+		return nil, err
+	}
+	return w.buf.Bytes(), nil
+}
+
+// jsonWriter writes messages in JSON.
+type jsonWriter struct {
+	buf bytes.Buffer
+	jm  *jsonpb.Marshaler
+}
+
+// newline starts a new line indented by indent and n more levels, if the
+// output is indented.
+func (w *jsonWriter) newline(indent string, n int) {
+	if w.jm.Indent == "" {
+		return
+	}
+	w.buf.WriteString("\n")
+	w.indent(indent, n)
+}
+
+// indent writes indent and n more levels, if the output is indented.
+func (w *jsonWriter) indent(indent string, n int) {
+	if w.jm.Indent == "" {
+		return
+	}
+	w.buf.WriteString(indent)
+	for i := 0; i < n; i++ {
+		w.buf.WriteString(w.jm.Indent)
+	}
+}
+
+func (w *jsonWriter) message(m *Message, indent string) error {
+	w.buf.WriteByte('{')
+	w.newline("", 0)
+	first := true
+	for _, f := range m.typ.fields {
+		v, ok := m.values[f.GetNumber()]
+		if !ok {
+			if f.oneof || !w.jm.EmitsDefault(m.typ.name+"."+f.GetName()) {
+				continue
+			}
+			v = m.get(f)
+			if !m.typ.proto3 && !f.repeated {
+				// An unset proto2 field is a nil pointer in Go.
+				v = (*Message)(nil)
+			}
+		}
+		if !first {
+			w.buf.WriteByte(',')
+			w.newline("", 0)
+		}
+		first = false
+		w.indent(indent, 1)
+		name := f.jsonName
+		if w.jm.OrigName {
+			name = f.origName()
+		}
+		writeString(&w.buf, name)
+		w.colon()
+
+		var err error
+		switch {
+		case f.isMap():
+			err = w.mapValue(f, v.(map[interface{}]interface{}), indent)
+		case f.repeated:
+			w.buf.WriteByte('[')
+			for i, e := range v.([]interface{}) {
+				if i > 0 {
+					w.buf.WriteByte(',')
+				}
+				w.newline(indent, 2)
+				if err = w.value(f, e, indent+w.jm.Indent); err != nil {
+					break
+				}
+			}
+			w.newline(indent, 1)
+			w.buf.WriteByte(']')
+		default:
+			err = w.value(f, v, indent)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	w.newline(indent, 0)
+	w.buf.WriteByte('}')
+	return nil
+}
+
+// origName returns the name of f as declared, which for a group is the
+// name of its type.
+func (f *field) origName() string {
+	if f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP {
+		return f.message.desc.GetName()
+	}
+	return f.GetName()
+}
+
+func (w *jsonWriter) colon() {
+	w.buf.WriteByte(':')
+	if w.jm.Indent != "" {
+		w.buf.WriteByte(' ')
+	}
+}
+
+func (w *jsonWriter) mapValue(f *field, mv map[interface{}]interface{}, indent string) error {
+	keys := make(mapKeys, 0, len(mv))
+	for k := range mv {
+		keys = append(keys, k)
+	}
+	sort.Sort(keys)
+	w.buf.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			w.buf.WriteByte(',')
+		}
+		w.newline(indent, 2)
+		writeString(&w.buf, fmt.Sprint(k))
+		w.colon()
+		if err := w.value(f.val, mv[k], indent+w.jm.Indent); err != nil {
+			return err
+		}
+	}
+	w.newline(indent, 1)
+	w.buf.WriteByte('}')
+	return nil
+}
+
+// value writes a single value of f, in a message indented by indent.
+func (w *jsonWriter) value(f *field, v interface{}, indent string) error {
+	buf := &w.buf
+	switch v := v.(type) {
+	case *Message:
+		if v == nil {
+			buf.WriteString("null")
+			return nil
+		}
+		return w.message(v, indent+w.jm.Indent)
+	case int32:
+		if f.enum != nil && !w.jm.EnumsAsInts {
+			for _, ev := range f.enum.GetValue() {
+				if ev.GetNumber() == v {
+					writeString(buf, ev.GetName())
+					return nil
+				}
+			}
+		}
+		buf.WriteString(strconv.FormatInt(int64(v), 10))
+	case uint32:
+		buf.WriteString(strconv.FormatUint(uint64(v), 10))
+	case int64:
+		// 64-bit integers are quoted, as JavaScript cannot hold them,
+		// unless the Marshaler asks otherwise.
+		if w.jm.Int64AsNumber {
+			buf.WriteString(strconv.FormatInt(v, 10))
+		} else {
+			writeString(buf, strconv.FormatInt(v, 10))
+		}
+	case uint64:
+		if w.jm.Int64AsNumber {
+			buf.WriteString(strconv.FormatUint(v, 10))
+		} else {
+			writeString(buf, strconv.FormatUint(v, 10))
+		}
+	case float32:
+		writeFloat(buf, float64(v), 32)
+	case float64:
+		writeFloat(buf, v, 64)
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case string:
+		writeString(buf, v)
+	case []byte:
+		writeString(buf, base64.StdEncoding.EncodeToString(v))
+	default:
+		return fmt.Errorf("dynamic: field %s holds a %T", f.GetName(), v)
+	}
+	return nil
+}
+
+func writeFloat(buf *bytes.Buffer, x float64, bits int) {
+	switch {
+	case math.IsNaN(x):
+		buf.WriteString(`"NaN"`)
+	case math.IsInf(x, 1):
+		buf.WriteString(`"Infinity"`)
+	case math.IsInf(x, -1):
+		buf.WriteString(`"-Infinity"`)
+	default:
+		buf.WriteString(strconv.FormatFloat(x, 'g', -1, bits))
+	}
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	b, _ := json.Marshal(s)
+	buf.Write(b)
+}
+
+// UnmarshalJSONPB sets the fields of m named in the JSON object in b,
+// following the options of ju. It accepts the field names written with
+// and without jsonpb.Marshaler's OrigName, enums by name or number, and
+// 64-bit integers quoted or not. It makes m a jsonpb.JSONPBUnmarshaler, so
+// jsonpb.Unmarshaler unmarshals m with it.
+func (m *Message) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, b []byte) error {
+	if string(bytes.TrimSpace(b)) == "null" {
+		return nil
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return fmt.Errorf("dynamic: %s: %v", m.typ.name, err)
+	}
+	// Set the fields in a fixed order, so that of two members of a oneof
+	// the same one is kept each time.
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		raw := obj[name]
+		f := m.typ.byName[name]
+		if f == nil {
+			if ju.AllowUnknownFields {
+				continue
+			}
+			return fmt.Errorf("dynamic: %s has no field %q", m.typ.name, name)
+		}
+		if string(raw) == "null" {
+			delete(m.values, f.GetNumber())
+			continue
+		}
+		v, err := readField(ju, f, raw)
+		if err != nil {
+			return fmt.Errorf("dynamic: %s.%s: %v", m.typ.name, f.GetName(), err)
+		}
+		m.store(f, v)
+	}
+	return nil
+}
+
+func readField(ju *jsonpb.Unmarshaler, f *field, raw json.RawMessage) (interface{}, error) {
+	switch {
+	case f.isMap():
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return nil, err
+		}
+		mv := make(map[interface{}]interface{}, len(obj))
+		for ks, rv := range obj {
+			k, err := readValue(ju, f.key, json.RawMessage(strconv.Quote(ks)))
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %v", ks, err)
+			}
+			v, err := readValue(ju, f.val, rv)
+			if err != nil {
+				return nil, fmt.Errorf("value for %q: %v", ks, err)
+			}
+			mv[k] = v
+		}
+		return mv, nil
+	case f.repeated:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return nil, err
+		}
+		s := make([]interface{}, len(elems))
+		for i, e := range elems {
+			v, err := readValue(ju, f, e)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %v", i, err)
+			}
+			s[i] = v
+		}
+		return s, nil
+	}
+	return readValue(ju, f, raw)
+}
+
+// readValue decodes a single value of f.
+func readValue(ju *jsonpb.Unmarshaler, f *field, raw json.RawMessage) (interface{}, error) {
+	if f.message != nil {
+		sub := f.message.New()
+		if err := sub.UnmarshalJSONPB(ju, raw); err != nil {
+			return nil, err
+		}
+		return sub, nil
+	}
+
+	// Numbers may be quoted; strings and bytes must be.
+	s := string(raw)
+	quoted := false
+	if len(s) >= 2 && s[0] == '"' {
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		quoted = true
+	}
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+		if !quoted {
+			return nil, fmt.Errorf("got %s, want a string", raw)
+		}
+		return s, nil
+	case descpb.FieldDescriptorProto_TYPE_BYTES:
+		if !quoted {
+			return nil, fmt.Errorf("got %s, want a string", raw)
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			b, err = base64.URLEncoding.DecodeString(s)
+		}
+		return b, err
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		return strconv.ParseBool(s)
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		if quoted {
+			for _, ev := range f.enum.GetValue() {
+				if ev.GetName() == s {
+					return ev.GetNumber(), nil
+				}
+			}
+			if strings.TrimLeft(s, "-0123456789") != "" {
+				return nil, fmt.Errorf("unknown value %q of enum %s", s, f.GetTypeName())
+			}
+		}
+		x, err := strconv.ParseInt(s, 10, 32)
+		return int32(x), err
+	case descpb.FieldDescriptorProto_TYPE_DOUBLE, descpb.FieldDescriptorProto_TYPE_FLOAT:
+		bits := 64
+		if f.GetType() == descpb.FieldDescriptorProto_TYPE_FLOAT {
+			bits = 32
+		}
+		var x float64
+		var err error
+		switch s {
+		case "NaN":
+			x = math.NaN()
+		case "Infinity":
+			x = math.Inf(1)
+		case "-Infinity":
+			x = math.Inf(-1)
+		default:
+			x, err = strconv.ParseFloat(s, bits)
+		}
+		if bits == 32 {
+			return float32(x), err
+		}
+		return x, err
+	}
+
+	switch f.zero().(type) {
+	case int32:
+		x, err := strconv.ParseInt(s, 10, 32)
+		return int32(x), err
+	case int64:
+		return strconv.ParseInt(s, 10, 64)
+	case uint32:
+		x, err := strconv.ParseUint(s, 10, 32)
+		return uint32(x), err
+	case uint64:
+		return strconv.ParseUint(s, 10, 64)
+	}
+	return nil, fmt.Errorf("unsupported type %v", f.GetType())
+}
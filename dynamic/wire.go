@@ -0,0 +1,432 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+// Encoding and decoding dynamic messages in the wire format.
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protowire"
+)
+
+// Marshal returns the wire encoding of m. Fields are written in order of
+// number, map entries in order of key, and unknown fields last, so the
+// encoding is deterministic.
+func (m *Message) Marshal() ([]byte, error) {
+	return m.appendTo(nil)
+}
+
+func (m *Message) appendTo(b []byte) ([]byte, error) {
+	var err error
+	for _, f := range m.typ.numbered {
+		v, ok := m.values[f.GetNumber()]
+		if !ok {
+			continue
+		}
+		switch {
+		case f.isMap():
+			b, err = appendMap(b, f, v.(map[interface{}]interface{}))
+		case f.packed:
+			s := v.([]interface{})
+			if len(s) == 0 {
+				continue
+			}
+			var p []byte
+			for _, e := range s {
+				p, _ = appendValue(p, f, e)
+			}
+			b = appendVarint(b, uint64(f.GetNumber())<<3|proto.WireBytes)
+			b = appendVarint(b, uint64(len(p)))
+			b = append(b, p...)
+		case f.repeated:
+			for _, e := range v.([]interface{}) {
+				if b, err = appendField(b, f, e); err != nil {
+					break
+				}
+			}
+		default:
+			b, err = appendField(b, f, v)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return append(b, m.unknown...), nil
+}
+
+// appendField appends a single value of f, with its key.
+func appendField(b []byte, f *field, v interface{}) ([]byte, error) {
+	if f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP {
+		b = appendVarint(b, uint64(f.GetNumber())<<3|proto.WireStartGroup)
+		b, err := v.(*Message).appendTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return appendVarint(b, uint64(f.GetNumber())<<3|proto.WireEndGroup), nil
+	}
+	b = appendVarint(b, uint64(f.GetNumber())<<3|uint64(wireType(f)))
+	return appendValue(b, f, v)
+}
+
+// appendValue appends a single value of f, without its key.
+func appendValue(b []byte, f *field, v interface{}) ([]byte, error) {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return appendFixed64(b, math.Float64bits(v.(float64))), nil
+	case descpb.FieldDescriptorProto_TYPE_FLOAT:
+		return appendFixed32(b, math.Float32bits(v.(float32))), nil
+	case descpb.FieldDescriptorProto_TYPE_INT64:
+		return appendVarint(b, uint64(v.(int64))), nil
+	case descpb.FieldDescriptorProto_TYPE_UINT64:
+		return appendVarint(b, v.(uint64)), nil
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_ENUM:
+		return appendVarint(b, uint64(int64(v.(int32)))), nil
+	case descpb.FieldDescriptorProto_TYPE_FIXED64:
+		return appendFixed64(b, v.(uint64)), nil
+	case descpb.FieldDescriptorProto_TYPE_FIXED32:
+		return appendFixed32(b, v.(uint32)), nil
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		if v.(bool) {
+			return append(b, 1), nil
+		}
+		return append(b, 0), nil
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+		s := v.(string)
+		b = appendVarint(b, uint64(len(s)))
+		return append(b, s...), nil
+	case descpb.FieldDescriptorProto_TYPE_BYTES:
+		s := v.([]byte)
+		b = appendVarint(b, uint64(len(s)))
+		return append(b, s...), nil
+	case descpb.FieldDescriptorProto_TYPE_MESSAGE:
+		enc, err := v.(*Message).Marshal()
+		if err != nil {
+			return nil, err
+		}
+		b = appendVarint(b, uint64(len(enc)))
+		return append(b, enc...), nil
+	case descpb.FieldDescriptorProto_TYPE_UINT32:
+		return appendVarint(b, uint64(v.(uint32))), nil
+	case descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return appendFixed32(b, uint32(v.(int32))), nil
+	case descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return appendFixed64(b, uint64(v.(int64))), nil
+	case descpb.FieldDescriptorProto_TYPE_SINT32:
+		x := v.(int32)
+		return appendVarint(b, uint64(uint32(x<<1)^uint32(x>>31))), nil
+	case descpb.FieldDescriptorProto_TYPE_SINT64:
+		x := v.(int64)
+		return appendVarint(b, uint64(x<<1)^uint64(x>>63)), nil
+	}
+	return nil, fmt.Errorf("dynamic: field %s has unsupported type %v", f.GetName(), f.GetType())
+}
+
+// appendMap appends the entries of a map field, in order of key.
+func appendMap(b []byte, f *field, mv map[interface{}]interface{}) ([]byte, error) {
+	keys := make(mapKeys, 0, len(mv))
+	for k := range mv {
+		keys = append(keys, k)
+	}
+	sort.Sort(keys)
+	for _, k := range keys {
+		entry, _ := appendField(nil, f.key, k)
+		entry, err := appendField(entry, f.val, mv[k])
+		if err != nil {
+			return nil, err
+		}
+		b = appendVarint(b, uint64(f.GetNumber())<<3|proto.WireBytes)
+		b = appendVarint(b, uint64(len(entry)))
+		b = append(b, entry...)
+	}
+	return b, nil
+}
+
+// mapKeys sorts map keys, which all have the same type.
+type mapKeys []interface{}
+
+func (s mapKeys) Len() int      { return len(s) }
+func (s mapKeys) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s mapKeys) Less(i, j int) bool {
+	switch a := s[i].(type) {
+	case int32:
+		return a < s[j].(int32)
+	case int64:
+		return a < s[j].(int64)
+	case uint32:
+		return a < s[j].(uint32)
+	case uint64:
+		return a < s[j].(uint64)
+	case bool:
+		return !a && s[j].(bool)
+	case string:
+		return a < s[j].(string)
+	}
+	return false
+}
+
+// wireType returns the wire type of a single value of f.
+func wireType(f *field) int {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_DOUBLE, descpb.FieldDescriptorProto_TYPE_FIXED64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return proto.WireFixed64
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_FIXED32, descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return proto.WireFixed32
+	case descpb.FieldDescriptorProto_TYPE_STRING, descpb.FieldDescriptorProto_TYPE_BYTES, descpb.FieldDescriptorProto_TYPE_MESSAGE:
+		return proto.WireBytes
+	case descpb.FieldDescriptorProto_TYPE_GROUP:
+		return proto.WireStartGroup
+	}
+	return proto.WireVarint
+}
+
+func appendVarint(b []byte, x uint64) []byte {
+	for x >= 0x80 {
+		b = append(b, byte(x)|0x80)
+		x >>= 7
+	}
+	return append(b, byte(x))
+}
+
+func appendFixed32(b []byte, x uint32) []byte {
+	return append(b, byte(x), byte(x>>8), byte(x>>16), byte(x>>24))
+}
+
+func appendFixed64(b []byte, x uint64) []byte {
+	return append(b, byte(x), byte(x>>8), byte(x>>16), byte(x>>24),
+		byte(x>>32), byte(x>>40), byte(x>>48), byte(x>>56))
+}
+
+// Unmarshal merges the wire encoding in b into m, as proto.UnmarshalMerge
+// does; proto.Unmarshal resets m first. Fields m's type does not declare
+// are kept, and written again by Marshal. Messages nested more than
+// proto.DefaultMaxDepth deep fail with proto.ErrTooDeep.
+func (m *Message) Unmarshal(b []byte) error {
+	return m.XXX_UnmarshalDepth(b, proto.DefaultMaxDepth)
+}
+
+// XXX_UnmarshalDepth is Unmarshal with m and the messages nested in it at
+// most maxDepth deep, counting m. proto.UnmarshalOptions calls it to apply
+// its MaxDepth.
+func (m *Message) XXX_UnmarshalDepth(b []byte, maxDepth int) error {
+	if maxDepth <= 0 {
+		return proto.ErrTooDeep
+	}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		if typ == protowire.EndGroupType {
+			return fmt.Errorf("dynamic: %s: unexpected end group", m.typ.name)
+		}
+		f := m.typ.byNum[int32(num)]
+		if f == nil {
+			l := protowire.ConsumeFieldValue(num, typ, b[n:])
+			if l < 0 {
+				return protowire.ParseError(l)
+			}
+			m.unknown = append(m.unknown, b[:n+l]...)
+			b = b[n+l:]
+			continue
+		}
+		b = b[n:]
+		n, err := m.unmarshalField(f, int(typ), b, maxDepth)
+		if err != nil {
+			return err
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// unmarshalField decodes the value of f at the start of b, which has the
+// given wire type, and returns how many bytes it took. m may be maxDepth
+// deep.
+func (m *Message) unmarshalField(f *field, wire int, b []byte, maxDepth int) (int, error) {
+	want := wireType(f)
+	if f.repeated && want != proto.WireBytes && want != proto.WireStartGroup && wire == proto.WireBytes {
+		// A packed repeated scalar field, which may be read whether or
+		// not the field is declared packed.
+		p, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		s, _ := m.values[f.GetNumber()].([]interface{})
+		for len(p) > 0 {
+			v, l, err := consumeValue(f, p)
+			if err != nil {
+				return 0, err
+			}
+			s = append(s, v)
+			p = p[l:]
+		}
+		m.store(f, s)
+		return n, nil
+	}
+	if wire != want {
+		return 0, fmt.Errorf("dynamic: bad wiretype for field %s.%s: got wiretype %d, want %d", m.typ.name, f.GetName(), wire, want)
+	}
+
+	if f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP {
+		enc, n := protowire.ConsumeGroup(protowire.Number(f.GetNumber()), b)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		if err := m.mergeMessage(f, enc, maxDepth-1); err != nil {
+			return 0, err
+		}
+		return n, nil
+	}
+	if f.GetType() == descpb.FieldDescriptorProto_TYPE_MESSAGE {
+		enc, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		var err error
+		if f.isMap() {
+			err = m.unmarshalEntry(f, enc, maxDepth-1)
+		} else {
+			err = m.mergeMessage(f, enc, maxDepth-1)
+		}
+		return n, err
+	}
+
+	v, n, err := consumeValue(f, b)
+	if err != nil {
+		return 0, err
+	}
+	if f.repeated {
+		s, _ := m.values[f.GetNumber()].([]interface{})
+		v = append(s, v)
+	}
+	m.store(f, v)
+	return n, nil
+}
+
+// mergeMessage decodes a message value of f, at most maxDepth deep: a new
+// element of a repeated field, or merged into the singular field's message
+// if it is set.
+func (m *Message) mergeMessage(f *field, enc []byte, maxDepth int) error {
+	if !f.repeated {
+		if sub, ok := m.values[f.GetNumber()].(*Message); ok {
+			return sub.XXX_UnmarshalDepth(enc, maxDepth)
+		}
+	}
+	sub := f.message.New()
+	if err := sub.XXX_UnmarshalDepth(enc, maxDepth); err != nil {
+		return err
+	}
+	if f.repeated {
+		s, _ := m.values[f.GetNumber()].([]interface{})
+		m.store(f, append(s, sub))
+		return nil
+	}
+	m.store(f, sub)
+	return nil
+}
+
+// unmarshalEntry decodes an entry of the map field f, at most maxDepth
+// deep.
+func (m *Message) unmarshalEntry(f *field, enc []byte, maxDepth int) error {
+	entry := f.message.New()
+	if err := entry.XXX_UnmarshalDepth(enc, maxDepth); err != nil {
+		return err
+	}
+	k, v := entry.get(f.key), entry.get(f.val)
+	if sub, ok := v.(*Message); ok && sub == nil {
+		v = f.val.message.New()
+	}
+	mv, _ := m.values[f.GetNumber()].(map[interface{}]interface{})
+	if mv == nil {
+		mv = make(map[interface{}]interface{})
+	}
+	mv[k] = v
+	m.store(f, mv)
+	return nil
+}
+
+// consumeValue decodes a single scalar value of f at the start of b.
+func consumeValue(f *field, b []byte) (interface{}, int, error) {
+	var x uint64
+	var n int
+	switch wireType(f) {
+	case proto.WireVarint:
+		x, n = protowire.ConsumeVarint(b)
+	case proto.WireFixed64:
+		x, n = protowire.ConsumeFixed64(b)
+	case proto.WireFixed32:
+		var x32 uint32
+		x32, n = protowire.ConsumeFixed32(b)
+		x = uint64(x32)
+	case proto.WireBytes:
+		var s []byte
+		s, n = protowire.ConsumeBytes(b)
+		if n < 0 {
+			return nil, 0, protowire.ParseError(n)
+		}
+		if f.GetType() == descpb.FieldDescriptorProto_TYPE_STRING {
+			return string(s), n, nil
+		}
+		return append([]byte{}, s...), n, nil
+	}
+	if n < 0 {
+		return nil, 0, protowire.ParseError(n)
+	}
+
+	var v interface{}
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		v = math.Float64frombits(x)
+	case descpb.FieldDescriptorProto_TYPE_FLOAT:
+		v = math.Float32frombits(uint32(x))
+	case descpb.FieldDescriptorProto_TYPE_INT64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		v = int64(x)
+	case descpb.FieldDescriptorProto_TYPE_UINT64, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		v = x
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_SFIXED32, descpb.FieldDescriptorProto_TYPE_ENUM:
+		v = int32(x)
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32:
+		v = uint32(x)
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		v = x != 0
+	case descpb.FieldDescriptorProto_TYPE_SINT32:
+		v = int32(uint32(x)>>1) ^ -int32(x&1)
+	case descpb.FieldDescriptorProto_TYPE_SINT64:
+		v = int64(x>>1) ^ -int64(x&1)
+	}
+	return v, n, nil
+}
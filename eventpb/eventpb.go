@@ -0,0 +1,155 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package eventpb supports event-sourced aggregates built from generated
+protocol buffer types.
+
+An aggregate is a message whose state is the result of applying its events
+in order. The carno plugin generates an Apply method for each message with
+the (carno.events) option, which dispatches every event to a hand-written
+Apply<Event> method for its type.
+
+Events are stored in Envelopes, which add the aggregate's ID and a sequence
+number to each event. Wrap creates them, and Replay applies a stored
+history to an aggregate, checking that no event is missing or repeated.
+
+Envelopes also carry the events services publish, to Kafka topics and the
+like. For a message with the (carno.event) option,
+
+	message UserCreated {
+	  option (carno.event) = { topic: "users" version: 2 };
+	  string user_id = 1;
+	}
+
+the carno plugin generates the constants UserCreatedTopic,
+UserCreatedEventName and UserCreatedEventVersion, and
+NewUserCreatedEnvelope, which calls NewEnvelope to put an event in an
+envelope with the event's name and version, for consumers to check
+before they unwrap it.
+*/
+package eventpb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// An Aggregate is a message whose state is built by applying events.
+type Aggregate interface {
+	proto.Message
+	Apply(event proto.Message) error
+}
+
+// Wrap returns an envelope holding event as the seq'th event of the
+// aggregate with the given ID, recorded now.
+func Wrap(aggregateID string, seq uint64, event proto.Message) (*Envelope, error) {
+	any, err := ptypes.MarshalAny(event)
+	if err != nil {
+		return nil, err
+	}
+	return &Envelope{
+		AggregateId: aggregateID,
+		Sequence:    seq,
+		Time:        ptypes.TimestampNow(),
+		Event:       any,
+	}, nil
+}
+
+// NewEnvelope returns an envelope holding event, of the type with the
+// given name and schema version, which occurred at occurredAt.
+func NewEnvelope(name string, version uint32, occurredAt time.Time, event proto.Message) (*Envelope, error) {
+	any, err := ptypes.MarshalAny(event)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := ptypes.TimestampProto(occurredAt)
+	if err != nil {
+		return nil, err
+	}
+	return &Envelope{
+		Name:    name,
+		Version: version,
+		Time:    ts,
+		Event:   any,
+	}, nil
+}
+
+// Unwrap returns the event held in e. The event's type must be linked
+// into the program, as all generated types are.
+func (e *Envelope) Unwrap() (proto.Message, error) {
+	var x ptypes.DynamicAny
+	if err := ptypes.UnmarshalAny(e.GetEvent(), &x); err != nil {
+		return nil, err
+	}
+	return x.Message, nil
+}
+
+// A SequenceError reports an event that does not directly follow the
+// last one applied to an aggregate.
+type SequenceError struct {
+	AggregateID string
+	Want, Got   uint64
+}
+
+func (e *SequenceError) Error() string {
+	return fmt.Sprintf("eventpb: aggregate %q: got event %d, want %d", e.AggregateID, e.Got, e.Want)
+}
+
+// Replay applies the events in envs, in order, to a, whose last applied
+// event was number seq (0 for a new aggregate). It returns the sequence
+// number of the last event applied, which is seq if envs is empty.
+//
+// The events must all belong to the same aggregate and be numbered
+// consecutively from seq+1; otherwise Replay stops with an error, after
+// applying the events that came before the offending one.
+func Replay(a Aggregate, seq uint64, envs ...*Envelope) (uint64, error) {
+	for _, env := range envs {
+		if id := envs[0].GetAggregateId(); env.GetAggregateId() != id {
+			return seq, fmt.Errorf("eventpb: event %d belongs to aggregate %q, want %q", env.GetSequence(), env.GetAggregateId(), id)
+		}
+		if env.GetSequence() != seq+1 {
+			return seq, &SequenceError{AggregateID: env.GetAggregateId(), Want: seq + 1, Got: env.GetSequence()}
+		}
+		event, err := env.Unwrap()
+		if err != nil {
+			return seq, fmt.Errorf("eventpb: event %d: %v", env.GetSequence(), err)
+		}
+		if err := a.Apply(event); err != nil {
+			return seq, err
+		}
+		seq++
+	}
+	return seq, nil
+}
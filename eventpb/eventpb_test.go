@@ -0,0 +1,169 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eventpb
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// counter is an aggregate that sums the Int64Value events applied to it.
+type counter struct {
+	wpb.Int64Value
+}
+
+func (c *counter) Apply(event proto.Message) error {
+	switch e := event.(type) {
+	case *wpb.Int64Value:
+		c.Value += e.Value
+		return nil
+	}
+	return fmt.Errorf("counter: unexpected event %T", event)
+}
+
+func history(t *testing.T, id string, values ...int64) []*Envelope {
+	var envs []*Envelope
+	for i, v := range values {
+		env, err := Wrap(id, uint64(i+1), &wpb.Int64Value{Value: v})
+		if err != nil {
+			t.Fatalf("Wrap: %v", err)
+		}
+		envs = append(envs, env)
+	}
+	return envs
+}
+
+func TestReplay(t *testing.T) {
+	envs := history(t, "c1", 1, 2, 3, 4)
+
+	c := new(counter)
+	seq, err := Replay(c, 0, envs[:2]...)
+	if err != nil || seq != 2 || c.Value != 3 {
+		t.Fatalf("Replay(first two) = %d, %v with value %d; want 2, nil with value 3", seq, err, c.Value)
+	}
+	seq, err = Replay(c, seq, envs[2:]...)
+	if err != nil || seq != 4 || c.Value != 10 {
+		t.Fatalf("Replay(rest) = %d, %v with value %d; want 4, nil with value 10", seq, err, c.Value)
+	}
+	if seq, err = Replay(c, seq); err != nil || seq != 4 {
+		t.Errorf("Replay(none) = %d, %v; want 4, nil", seq, err)
+	}
+}
+
+func TestReplayErrors(t *testing.T) {
+	envs := history(t, "c1", 1, 2, 3)
+
+	// A missing event stops the replay after the events before it.
+	c := new(counter)
+	seq, err := Replay(c, 0, envs[0], envs[2])
+	if serr, ok := err.(*SequenceError); !ok || serr.Want != 2 || serr.Got != 3 {
+		t.Errorf("Replay with gap: got error %v, want SequenceError{Want: 2, Got: 3}", err)
+	}
+	if seq != 1 || c.Value != 1 {
+		t.Errorf("Replay with gap: got seq %d value %d, want 1 and 1", seq, c.Value)
+	}
+
+	// A repeated event is rejected too.
+	if _, err := Replay(new(counter), 1, envs...); err == nil {
+		t.Error("Replay of an already applied event succeeded, want error")
+	}
+
+	// So are events of another aggregate.
+	other := history(t, "c2", 1, 2)
+	if _, err := Replay(new(counter), 0, envs[0], other[1]); err == nil {
+		t.Error("Replay of another aggregate's event succeeded, want error")
+	}
+
+	// And events the aggregate does not handle.
+	env, err := Wrap("c1", 1, &wpb.StringValue{Value: "x"})
+	if err != nil {
+		t.Fatalf("Wrap: %v", err)
+	}
+	if _, err := Replay(new(counter), 0, env); err == nil {
+		t.Error("Replay of unhandled event succeeded, want error")
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	env, err := Wrap("c1", 7, &wpb.StringValue{Value: "hello"})
+	if err != nil {
+		t.Fatalf("Wrap: %v", err)
+	}
+	b, err := proto.Marshal(env)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	got := new(Envelope)
+	if err := proto.Unmarshal(b, got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	event, err := got.Unwrap()
+	if err != nil {
+		t.Fatalf("Unwrap: %v", err)
+	}
+	if want := (&wpb.StringValue{Value: "hello"}); !proto.Equal(event, want) {
+		t.Errorf("Unwrap() = %v, want %v", event, want)
+	}
+	if got.GetAggregateId() != "c1" || got.GetSequence() != 7 || got.GetTime() == nil {
+		t.Errorf("envelope = %v, want aggregate c1, sequence 7 and a time", got)
+	}
+}
+
+func TestNewEnvelope(t *testing.T) {
+	occurred := time.Date(2017, 7, 14, 2, 40, 0, 0, time.UTC)
+	env, err := NewEnvelope("counter.Added", 2, occurred, &wpb.Int64Value{Value: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.GetName() != "counter.Added" || env.GetVersion() != 2 || env.GetAggregateId() != "" {
+		t.Errorf("got envelope %v", env)
+	}
+	if got, err := ptypes.Timestamp(env.GetTime()); err != nil || !got.Equal(occurred) {
+		t.Errorf("occurred at %v, %v, want %v", got, err, occurred)
+	}
+	event, err := env.Unwrap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(event, &wpb.Int64Value{Value: 5}) {
+		t.Errorf("Unwrap() = %v, want 5", event)
+	}
+
+	if _, err := NewEnvelope("counter.Added", 2, time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC), &wpb.Int64Value{}); err == nil {
+		t.Error("NewEnvelope with a time before year 1 succeeded")
+	}
+}
@@ -0,0 +1,109 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package fanout calls a method of a sharded carno service on many servers
+at once and merges their responses, as search aggregation does with the
+partitions of an index. The carno plugin generates, for each service
+with the (carno.shardable) option,
+
+	type <Service>FanOut struct {
+		Client <Service>Client
+		Target func(target string) client.CallOption
+		<Method>Reduce func(acc, resp *<Response>)
+		...
+	}
+
+	func (f *<Service>FanOut) <Method>FanOut(ctx context.Context, in *<Request>, targets []string) (*<Response>, error)
+
+for its unary methods, which call the method with Client on each target,
+selected by the option Target returns, and merge the responses with the
+method's reducer, or proto.Merge if it has none.
+*/
+package fanout
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Call calls call once for each of targets, concurrently, and waits for
+// all the calls to return. It then passes the response of each call that
+// succeeded to merge, in the order of targets, and returns an *Error
+// listing the calls that failed, if any. A failed call does not cancel
+// the others, so that callers can use the responses they have.
+func Call(ctx context.Context, targets []string, call func(ctx context.Context, target string) (proto.Message, error), merge func(proto.Message)) error {
+	resps := make([]proto.Message, len(targets))
+	errs := make([]error, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target string) {
+			defer wg.Done()
+			resps[i], errs[i] = call(ctx, target)
+		}(i, target)
+	}
+	wg.Wait()
+
+	var e *Error
+	for i, err := range errs {
+		if err != nil {
+			if e == nil {
+				e = new(Error)
+			}
+			e.Targets = append(e.Targets, targets[i])
+			e.Errs = append(e.Errs, err)
+			continue
+		}
+		merge(resps[i])
+	}
+	if e != nil {
+		return e
+	}
+	return nil
+}
+
+// An Error reports the calls of a fan-out that failed.
+type Error struct {
+	Targets []string // targets whose calls failed, in the order given
+	Errs    []error  // the error of each call
+}
+
+func (e *Error) Error() string {
+	s := fmt.Sprintf("fanout: %s: %v", e.Targets[0], e.Errs[0])
+	if n := len(e.Targets) - 1; n > 0 {
+		s += fmt.Sprintf(" (and %d more)", n)
+	}
+	return s
+}
@@ -0,0 +1,85 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package fanout
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/proto3_proto"
+)
+
+// shard returns a response holding the number of the target, or fails
+// for targets that are not numbers.
+func shard(ctx context.Context, target string) (proto.Message, error) {
+	n, err := strconv.ParseUint(target, 10, 64)
+	if err != nil {
+		return nil, errors.New("no such shard")
+	}
+	return &pb.Message{Key: []uint64{n}}, nil
+}
+
+func TestCall(t *testing.T) {
+	out := new(pb.Message)
+	merge := func(m proto.Message) { proto.Merge(out, m) }
+	if err := Call(context.Background(), []string{"3", "1", "2"}, shard, merge); err != nil {
+		t.Fatal(err)
+	}
+	// Responses are merged in the order of the targets.
+	if want := []uint64{3, 1, 2}; !reflect.DeepEqual(out.Key, want) {
+		t.Errorf("merged keys = %v, want %v", out.Key, want)
+	}
+}
+
+func TestCallErrors(t *testing.T) {
+	out := new(pb.Message)
+	merge := func(m proto.Message) { proto.Merge(out, m) }
+	err := Call(context.Background(), []string{"x", "1", "y", "2"}, shard, merge)
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("err = %v, want an *Error", err)
+	}
+	if want := []string{"x", "y"}; !reflect.DeepEqual(e.Targets, want) {
+		t.Errorf("failed targets = %v, want %v", e.Targets, want)
+	}
+	if got, want := err.Error(), "fanout: x: no such shard (and 1 more)"; got != want {
+		t.Errorf("err = %q, want %q", got, want)
+	}
+	// The calls that succeeded are still merged.
+	if want := []uint64{1, 2}; !reflect.DeepEqual(out.Key, want) {
+		t.Errorf("merged keys = %v, want %v", out.Key, want)
+	}
+}
@@ -0,0 +1,96 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package hedge cuts the tail latency of idempotent calls by hedging them:
+if a call has not returned after a delay, a second, backup call is made,
+and the first response wins. The carno plugin generates, for each
+service with carno:hedge=true,
+
+	func New<Service>HedgingClient(c <Service>Client, delay time.Duration) <Service>Client
+
+which hedges the calls to the methods whose (idempotency_level) option
+is IDEMPOTENT or NO_SIDE_EFFECTS with Do, and calls the others once. The
+carno client picks an instance for each call, so the backup call
+usually goes to a different instance from the first.
+
+Pick a delay near a high percentile of the method's latency, such as
+the 95th: most calls then return before it, and the backup calls add
+about as much load as the percentile leaves out.
+*/
+package hedge
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+type result struct {
+	m   proto.Message
+	err error
+}
+
+// Do calls call, and if it has not returned after delay, calls it again,
+// concurrently. It returns the response of the first call to succeed,
+// and cancels the context of the other. If the first call fails before
+// the delay, Do returns its error without making the backup call, so
+// that Do does not retry; if both calls fail, it returns the last error.
+func Do(ctx context.Context, delay time.Duration, call func(ctx context.Context) (proto.Message, error)) (proto.Message, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan result, 2)
+	attempt := func() {
+		m, err := call(ctx)
+		results <- result{m, err}
+	}
+	go attempt()
+	pending := 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	backup := timer.C
+	for {
+		select {
+		case <-backup:
+			backup = nil
+			go attempt()
+			pending++
+		case r := <-results:
+			pending--
+			if r.err == nil || backup != nil || pending == 0 {
+				return r.m, r.err
+			}
+		}
+	}
+}
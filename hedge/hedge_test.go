@@ -0,0 +1,98 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package hedge
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/proto3_proto"
+)
+
+// attempts returns a call whose nth attempt takes delays[n] to return
+// a response naming the attempt, or errs[n] if it is set. An attempt
+// canceled before then returns the context's error. It counts the
+// attempts in n.
+func attempts(n *int32, delays []time.Duration, errs []error) func(context.Context) (proto.Message, error) {
+	return func(ctx context.Context) (proto.Message, error) {
+		i := atomic.AddInt32(n, 1) - 1
+		select {
+		case <-time.After(delays[i]):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if errs != nil && errs[i] != nil {
+			return nil, errs[i]
+		}
+		return &pb.Nested{Bunny: string('a' + byte(i))}, nil
+	}
+}
+
+func bunny(m proto.Message) string {
+	if m == nil {
+		return ""
+	}
+	return m.(*pb.Nested).Bunny
+}
+
+func TestDo(t *testing.T) {
+	ms := time.Millisecond
+	failed := errors.New("failed")
+	for _, test := range []struct {
+		name     string
+		delays   []time.Duration
+		errs     []error
+		want     string
+		wantErr  error
+		attempts int32
+	}{
+		{"fast", []time.Duration{0, 0}, nil, "a", nil, 1},
+		{"backup wins", []time.Duration{time.Second, 0}, nil, "b", nil, 2},
+		{"first wins after backup", []time.Duration{30 * ms, time.Second}, nil, "a", nil, 2},
+		{"fast failure", []time.Duration{0, 0}, []error{failed, nil}, "", failed, 1},
+		{"backup after failure", []time.Duration{20 * ms, 40 * ms}, []error{failed, nil}, "b", nil, 2},
+		{"both fail", []time.Duration{20 * ms, 40 * ms}, []error{failed, failed}, "", failed, 2},
+	} {
+		var n int32
+		m, err := Do(context.Background(), 10*ms, attempts(&n, test.delays, test.errs))
+		if got := bunny(m); got != test.want || err != test.wantErr {
+			t.Errorf("%s: Do = %q, %v; want %q, %v", test.name, got, err, test.want, test.wantErr)
+		}
+		if got := atomic.LoadInt32(&n); got != test.attempts {
+			t.Errorf("%s: made %d attempts, want %d", test.name, got, test.attempts)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package httprpc serves carno services over plain HTTP, in the style of
+Twirp, for clients that cannot use the carno transport, such as browsers
+and edge proxies. The carno plugin generates a New<Service>HTTPHandler
+for each service with carno:http=true, which builds a Handler from the
+service's <Service>Server implementation:
+
+	http.Handle(users.UserServiceHTTPPathPrefix, users.NewUserServiceHTTPHandler(srv))
+
+Each method is called with a POST to /<pkg>.<Service>/<Method>, whose body
+is the request in the binary format, with Content-Type
+application/protobuf, or in JSON, with Content-Type application/json. The
+response has the format of the request. Failed calls have an HTTP error
+status and a JSON body with a code and a message:
+
+	{"code": "bad_route", "msg": "no method Frob in demo.users.UserService"}
+
+Handlers can choose the code by returning an *Error. Request bodies
+over the Handler's MaxBodyBytes are rejected in either format.
+*/
+package httprpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// Content types of the request and response bodies.
+const (
+	ContentTypeProtobuf = "application/protobuf"
+	ContentTypeJSON     = "application/json"
+)
+
+// A Method is one method of a service served by a Handler.
+type Method struct {
+	// Info describes the method. If it is not nil, requests in the binary
+	// format are decoded with its UnmarshalRequest, which enforces the
+	// method's request limits, and JSON requests whose binary encoding
+	// would be over MaxRequestBytes are rejected.
+	Info *callinfo.CallInfo
+
+	// Call decodes the request with decode and calls the server's method
+	// with it. Generated code provides it.
+	Call func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error)
+}
+
+// DefaultMaxBodyBytes is the largest request body a Handler reads unless
+// its MaxBodyBytes says otherwise.
+const DefaultMaxBodyBytes = 4 << 20
+
+// A Handler serves the methods of one service over HTTP.
+type Handler struct {
+	// MaxBodyBytes is the largest request body, in bytes, the Handler
+	// reads, in either format. Longer bodies are rejected as Malformed
+	// without reading them in full. If it is zero, DefaultMaxBodyBytes is
+	// used.
+	MaxBodyBytes int64
+
+	prefix  string
+	methods map[string]Method
+}
+
+// NewHandler returns a Handler for the methods of the service with the
+// given full proto name, such as "demo.users.UserService", keyed by the
+// method names declared in the .proto file.
+func NewHandler(service string, methods map[string]Method) *Handler {
+	return &Handler{prefix: "/" + service + "/", methods: methods}
+}
+
+// PathPrefix returns the path under which h serves its methods,
+// "/<pkg>.<Service>/".
+func (h *Handler) PathPrefix() string { return h.prefix }
+
+// ServeHTTP calls the method named by the path of r.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeError(w, Errorf(BadRoute, "unsupported method %s, want POST", r.Method))
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, h.prefix)
+	m, ok := h.methods[name]
+	if !ok || !strings.HasPrefix(r.URL.Path, h.prefix) {
+		writeError(w, Errorf(BadRoute, "no method at %s", r.URL.Path))
+		return
+	}
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || (ct != ContentTypeProtobuf && ct != ContentTypeJSON) {
+		writeError(w, Errorf(BadRoute, "unsupported Content-Type %q, want %s or %s",
+			r.Header.Get("Content-Type"), ContentTypeProtobuf, ContentTypeJSON))
+		return
+	}
+
+	decode := func(in proto.Message) error {
+		max := h.MaxBodyBytes
+		if max <= 0 {
+			max = DefaultMaxBodyBytes
+		}
+		var body io.Reader = http.MaxBytesReader(w, r.Body, max)
+		if ct == ContentTypeProtobuf && m.Info != nil && m.Info.MaxRequestBytes > 0 {
+			// Read one byte over the limit, so that UnmarshalRequest
+			// rejects a longer body without reading all of it.
+			body = io.LimitReader(body, int64(m.Info.MaxRequestBytes)+1)
+		}
+		b, err := ioutil.ReadAll(body)
+		if err != nil {
+			return Errorf(Malformed, "reading request: %v", err)
+		}
+		switch {
+		case ct == ContentTypeJSON:
+			err = jsonpb.Unmarshal(bytes.NewReader(b), in)
+			if err == nil && m.Info != nil && m.Info.MaxRequestBytes > 0 && proto.Size(in) > m.Info.MaxRequestBytes {
+				err = proto.ErrInputTooLarge
+			}
+		case m.Info != nil:
+			err = m.Info.UnmarshalRequest(b, in)
+		default:
+			err = proto.Unmarshal(b, in)
+		}
+		if err != nil {
+			return Errorf(Malformed, "decoding request: %v", err)
+		}
+		return nil
+	}
+	out, err := m.Call(r.Context(), decode)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if ct == ContentTypeJSON {
+		err = (&jsonpb.Marshaler{OrigName: true, EmitDefaults: true}).Marshal(&buf, out)
+	} else {
+		var b []byte
+		b, err = proto.Marshal(out)
+		buf.Write(b)
+	}
+	if err != nil {
+		writeError(w, Errorf(Internal, "encoding response: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", ct)
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// Error codes, with the HTTP status each is sent with.
+const (
+	BadRoute           = "bad_route"           // 404; no such method, or wrong HTTP method or content type
+	Malformed          = "malformed"           // 400; the request could not be decoded
+	InvalidArgument    = "invalid_argument"    // 400
+	Unauthenticated    = "unauthenticated"     // 401
+	PermissionDenied   = "permission_denied"   // 403
+	NotFound           = "not_found"           // 404
+	AlreadyExists      = "already_exists"      // 409
+	FailedPrecondition = "failed_precondition" // 412
+	ResourceExhausted  = "resource_exhausted"  // 429
+	Internal           = "internal"            // 500
+	Unimplemented      = "unimplemented"       // 501
+	Unavailable        = "unavailable"         // 503
+)
+
+var httpStatus = map[string]int{
+	BadRoute:           http.StatusNotFound,
+	Malformed:          http.StatusBadRequest,
+	InvalidArgument:    http.StatusBadRequest,
+	Unauthenticated:    http.StatusUnauthorized,
+	PermissionDenied:   http.StatusForbidden,
+	NotFound:           http.StatusNotFound,
+	AlreadyExists:      http.StatusConflict,
+	FailedPrecondition: http.StatusPreconditionFailed,
+	ResourceExhausted:  http.StatusTooManyRequests,
+	Internal:           http.StatusInternalServerError,
+	Unimplemented:      http.StatusNotImplemented,
+	Unavailable:        http.StatusServiceUnavailable,
+}
+
+// An Error is a failed call, as sent to the client. Server methods can
+// return one to choose the code; any other error is sent as Internal.
+type Error struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// Errorf returns an *Error with the given code and formatted message.
+func Errorf(code, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (e *Error) Error() string { return "httprpc: " + e.Code + ": " + e.Msg }
+
+// HTTPStatus returns the HTTP status the error is sent with.
+func (e *Error) HTTPStatus() int {
+	if s, ok := httpStatus[e.Code]; ok {
+		return s
+	}
+	return http.StatusInternalServerError
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*Error)
+	if !ok {
+		e = &Error{Code: Internal, Msg: err.Error()}
+	}
+	b, _ := json.Marshal(e)
+	w.Header().Set("Content-Type", ContentTypeJSON)
+	w.WriteHeader(e.HTTPStatus())
+	w.Write(b)
+}
@@ -0,0 +1,170 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package httprpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/proto3_proto"
+)
+
+// echo returns its request, or fails as the request's bunny says.
+func echo(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+	in := new(pb.Nested)
+	if err := decode(in); err != nil {
+		return nil, err
+	}
+	switch in.Bunny {
+	case "missing":
+		return nil, Errorf(NotFound, "no bunny")
+	case "broken":
+		return nil, errors.New("broken bunny")
+	}
+	return in, nil
+}
+
+func newTestHandler() *Handler {
+	return NewHandler("test.Hutch", map[string]Method{
+		"Echo":    {Call: echo},
+		"Limited": {Info: &callinfo.CallInfo{MaxRequestBytes: 8}, Call: echo},
+	})
+}
+
+func call(t *testing.T, method, path, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	newTestHandler().ServeHTTP(w, req)
+	return w
+}
+
+func TestJSON(t *testing.T) {
+	w := call(t, "POST", "/test.Hutch/Echo", "application/json; charset=utf-8", `{"bunny": "Flopsy"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != ContentTypeJSON {
+		t.Errorf("Content-Type = %q, want %q", ct, ContentTypeJSON)
+	}
+	if got, want := w.Body.String(), `{"bunny":"Flopsy","cute":false}`; got != want {
+		t.Errorf("body = %s, want %s", got, want)
+	}
+}
+
+func TestProtobuf(t *testing.T) {
+	b, err := proto.Marshal(&pb.Nested{Bunny: "Mopsy", Cute: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := call(t, "POST", "/test.Hutch/Echo", ContentTypeProtobuf, string(b))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+	out := new(pb.Nested)
+	if err := proto.Unmarshal(w.Body.Bytes(), out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Bunny != "Mopsy" || !out.Cute {
+		t.Errorf("response = %v, want the request", out)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	long, err := proto.Marshal(&pb.Nested{Bunny: "Cottontail"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		desc, method, path, contentType, body string
+		status                                int
+		code                                  string
+	}{
+		{"GET", "GET", "/test.Hutch/Echo", ContentTypeJSON, `{}`, 404, BadRoute},
+		{"unknown method", "POST", "/test.Hutch/Frob", ContentTypeJSON, `{}`, 404, BadRoute},
+		{"other service", "POST", "/test.Burrow/Echo", ContentTypeJSON, `{}`, 404, BadRoute},
+		{"text body", "POST", "/test.Hutch/Echo", "text/plain", `{}`, 404, BadRoute},
+		{"bad JSON", "POST", "/test.Hutch/Echo", ContentTypeJSON, `{"bunny": 3}`, 400, Malformed},
+		{"bad protobuf", "POST", "/test.Hutch/Echo", ContentTypeProtobuf, "\x0a\x05ab", 400, Malformed},
+		{"over the limit", "POST", "/test.Hutch/Limited", ContentTypeProtobuf, string(long), 400, Malformed},
+		{"JSON over the limit", "POST", "/test.Hutch/Limited", ContentTypeJSON, `{"bunny": "Cottontail"}`, 400, Malformed},
+		{"Error", "POST", "/test.Hutch/Echo", ContentTypeJSON, `{"bunny": "missing"}`, 404, NotFound},
+		{"other error", "POST", "/test.Hutch/Echo", ContentTypeJSON, `{"bunny": "broken"}`, 500, Internal},
+	} {
+		w := call(t, test.method, test.path, test.contentType, test.body)
+		var e Error
+		if err := json.Unmarshal(w.Body.Bytes(), &e); err != nil {
+			t.Errorf("%s: body %q: %v", test.desc, w.Body, err)
+			continue
+		}
+		if w.Code != test.status || e.Code != test.code || e.Msg == "" {
+			t.Errorf("%s: %d %+v, want %d with code %s", test.desc, w.Code, e, test.status, test.code)
+		}
+	}
+}
+
+func TestMaxBodyBytes(t *testing.T) {
+	h := newTestHandler()
+	h.MaxBodyBytes = 16
+	b, err := proto.Marshal(&pb.Nested{Bunny: "Peter"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	long, err := proto.Marshal(&pb.Nested{Bunny: "Benjamin Bunny Jr."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		contentType, body string
+		status            int
+	}{
+		{ContentTypeProtobuf, string(b), 200},
+		{ContentTypeProtobuf, string(long), 400},
+		{ContentTypeJSON, `{"bunny":"Peter"}`, 400},
+		{ContentTypeJSON, `{}`, 200},
+	} {
+		req := httptest.NewRequest("POST", "/test.Hutch/Echo", strings.NewReader(test.body))
+		req.Header.Set("Content-Type", test.contentType)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != test.status {
+			t.Errorf("%s body of %d bytes: status %d, want %d", test.contentType, len(test.body), w.Code, test.status)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	stpb "github.com/golang/protobuf/ptypes/struct"
+)
+
+func TestCanonical(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		m    Marshaler
+		pb   proto.Message
+		want string
+	}{
+		{"sorted keys", Marshaler{Canonical: true},
+			&pb.Simple{OString: proto.String("s"), OBool: proto.Bool(true), OInt32: proto.Int32(-3)},
+			`{"oBool":true,"oInt32":-3,"oString":"s"}`},
+		{"indent ignored", Marshaler{Canonical: true, Indent: "  ", OrigName: true},
+			&pb.Simple{OInt64: proto.Int64(7), ODouble: proto.Float64(0.5)},
+			`{"o_double":0.5,"o_int64":"7"}`},
+		{"numbers", Marshaler{Canonical: true}, &pb.Repeats{RDouble: []float64{
+			1e21, 1e20, 1e-7, 1e-6, 123.456, -0.0, 1.5e300, 5e-324, 100,
+		}}, `{"rDouble":[1e+21,100000000000000000000,1e-7,0.000001,123.456,0,1.5e+300,5e-324,100]}`},
+		{"float32", Marshaler{Canonical: true}, &pb.Repeats{RFloat: []float32{1.1, 3e-9}}, `{"rFloat":[1.1,3e-9]}`},
+		{"non-finite", Marshaler{Canonical: true}, &pb.Simple{ODouble: proto.Float64(math.Inf(-1))}, `{"oDouble":"-Infinity"}`},
+		{"strings", Marshaler{Canonical: true}, &pb.Simple{OString: proto.String("<a&b>\u2028\"\\\x01\t\u00e9")},
+			`{"oString":"<a&b>` + "\u2028" + `\"\\\u0001\t` + "\u00e9" + `"}`},
+		{"map keys", Marshaler{Canonical: true}, &pb.Mappy{Nummy: map[int64]int32{10: 1, 9: 2, -1: 3}},
+			`{"nummy":{"-1":3,"10":1,"9":2}}`},
+		{"UTF-16 order", Marshaler{Canonical: true}, &pb.Mappy{Strry: map[string]string{"\U0001F600": "a", "\uFFFD": "b", "z": "c"}},
+			`{"strry":{"z":"c","` + "\U0001F600" + `":"a","` + "\uFFFD" + `":"b"}}`},
+		{"struct", Marshaler{Canonical: true}, &pb.KnownTypes{St: &stpb.Struct{Fields: map[string]*stpb.Value{
+			"b": {Kind: &stpb.Value_NumberValue{NumberValue: 2}},
+			"a": {Kind: &stpb.Value_ListValue{ListValue: &stpb.ListValue{}}},
+		}}}, `{"st":{"a":[],"b":2}}`},
+		{"defaults", Marshaler{Canonical: true, EmitDefaults: true}, &pb.Simple3{}, `{"dub":0}`},
+	} {
+		got, err := tt.m.MarshalToString(tt.pb)
+		if err != nil {
+			t.Errorf("%s: %v", tt.desc, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s:\n got %s\nwant %s", tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestCanonicalStable(t *testing.T) {
+	m := Marshaler{Canonical: true}
+	msg := &pb.Mappy{Strry: map[string]string{"one": "1", "two": "2", "three": "3", "four": "4"}}
+	var first bytes.Buffer
+	if err := m.Marshal(&first, msg); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 20; i++ {
+		var b bytes.Buffer
+		if err := m.Marshal(&b, msg); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b.Bytes(), first.Bytes()) {
+			t.Fatalf("Marshal gave %s, then %s", first.Bytes(), b.Bytes())
+		}
+	}
+
+	// The canonical form reads back as the message.
+	got := new(pb.Mappy)
+	if err := UnmarshalString(first.String(), got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, msg) {
+		t.Errorf("Unmarshal = %v, want %v", got, msg)
+	}
+}
@@ -40,6 +40,7 @@ package jsonpb
 
 import (
 	"bytes"
+	"encoding"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -65,6 +66,14 @@ type Marshaler struct {
 	// Whether to render fields with zero values.
 	EmitDefaults bool
 
+	// Messages and fields for which to render zero values, or not to,
+	// regardless of EmitDefaults. Each entry is the fully-qualified name
+	// of a message, such as "foo.Bar", covering all its fields, or of a
+	// field, such as "foo.Bar.baz", using the field's name in the .proto
+	// file. An entry for a field overrides one for its message, and an
+	// entry in OmitDefaultsFor one in EmitDefaultsFor.
+	EmitDefaultsFor, OmitDefaultsFor []string
+
 	// A string to indent each level by. The presence of this field will
 	// also cause a space to appear between the field separator and
 	// value, and for newlines to be appear between fields and array
@@ -74,11 +83,27 @@ type Marshaler struct {
 	// Whether to use the original (.proto) name for fields.
 	OrigName bool
 
+	// Whether to render 64-bit integers, including those in Int64Value
+	// and UInt64Value, as JSON numbers instead of strings. Map keys are
+	// strings regardless. Consumers that read JSON numbers as doubles, as
+	// JavaScript does, lose precision above 2^53.
+	Int64AsNumber bool
+
 	// A custom URL resolver to use when marshaling Any messages to JSON.
 	// If unset, the default resolution strategy is to extract the
 	// fully-qualified type name from the type URL and pass that to
 	// proto.MessageType(string).
 	AnyResolver AnyResolver
+
+	// Whether to write the value of each sensitive field, as proto.Redact
+	// describes, as the string proto.RedactedValue, whatever its type.
+	RedactSensitive bool
+
+	// Whether to write canonical JSON, as defined by RFC 8785, the JSON
+	// Canonicalization Scheme: object keys sorted, no white space, and
+	// numbers and strings in one fixed form, so that the output is stable
+	// enough to hash or sign. Indent is ignored; the other options apply.
+	Canonical bool
 }
 
 // AnyResolver takes a type URL, present in an Any message, and resolves it into
@@ -87,17 +112,60 @@ type AnyResolver interface {
 	Resolve(typeUrl string) (proto.Message, error)
 }
 
-func defaultResolveAny(typeUrl string) (proto.Message, error) {
-	// Only the part of typeUrl after the last slash is relevant.
-	mname := typeUrl
-	if slash := strings.LastIndex(mname, "/"); slash >= 0 {
-		mname = mname[slash+1:]
+// AnyResolverFunc lets an ordinary function serve as an AnyResolver.
+type AnyResolverFunc func(typeUrl string) (proto.Message, error)
+
+// Resolve returns f(typeUrl).
+func (f AnyResolverFunc) Resolve(typeUrl string) (proto.Message, error) {
+	return f(typeUrl)
+}
+
+// Resolver is an AnyResolver that resolves a type URL to a message of the
+// generated type it names, or, for types with no Go type linked in, to a
+// message Dynamic makes. The zero Resolver is what Marshaler and
+// Unmarshaler use when their AnyResolver is unset.
+//
+// To round-trip Any messages holding types known only at run time,
+// describe the types with a dynamic.Registry and use its Resolve method
+// as Dynamic.
+type Resolver struct {
+	// TypeName returns the fully-qualified name of the message type that
+	// typeUrl names. If it is nil, the name is the part of typeUrl after
+	// its last slash, as in "type.googleapis.com/foo.Bar". Programs that
+	// use their own type URL scheme, or accept only some prefixes, set it.
+	TypeName func(typeUrl string) (string, error)
+
+	// Dynamic, if set, returns an empty message of the type with the
+	// given fully-qualified name when no generated type has that name.
+	Dynamic func(name string) (proto.Message, error)
+}
+
+// Resolve returns an empty message of the type typeUrl names.
+func (r *Resolver) Resolve(typeUrl string) (proto.Message, error) {
+	var mname string
+	if r.TypeName != nil {
+		var err error
+		if mname, err = r.TypeName(typeUrl); err != nil {
+			return nil, err
+		}
+	} else {
+		// Only the part of typeUrl after the last slash is relevant.
+		mname = typeUrl
+		if slash := strings.LastIndex(mname, "/"); slash >= 0 {
+			mname = mname[slash+1:]
+		}
 	}
-	mt := proto.MessageType(mname)
-	if mt == nil {
-		return nil, fmt.Errorf("unknown message type %q", mname)
+	if mt := proto.MessageType(mname); mt != nil {
+		return reflect.New(mt.Elem()).Interface().(proto.Message), nil
 	}
-	return reflect.New(mt.Elem()).Interface().(proto.Message), nil
+	if r.Dynamic != nil {
+		return r.Dynamic(mname)
+	}
+	return nil, fmt.Errorf("unknown message type %q", mname)
+}
+
+func defaultResolveAny(typeUrl string) (proto.Message, error) {
+	return new(Resolver).Resolve(typeUrl)
 }
 
 // JSONPBMarshaler is implemented by protobuf messages that customize the
@@ -118,6 +186,9 @@ type JSONPBUnmarshaler interface {
 
 // Marshal marshals a protocol buffer into JSON.
 func (m *Marshaler) Marshal(out io.Writer, pb proto.Message) error {
+	if m.Canonical {
+		return m.marshalCanonical(out, pb)
+	}
 	writer := &errWriter{writer: out}
 	return m.marshalObject(writer, pb, "", "")
 }
@@ -175,6 +246,7 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 		return out.err
 	}
 
+	proto.DecodeLazy(v)
 	s := reflect.ValueOf(v).Elem()
 
 	// Handle well-known types.
@@ -238,6 +310,11 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 
 	firstField := true
 
+	var msgName string
+	if len(m.EmitDefaultsFor) > 0 || len(m.OmitDefaultsFor) > 0 {
+		msgName = proto.MessageName(v)
+	}
+
 	if typeURL != "" {
 		if err := m.marshalTypeURL(out, indent, typeURL); err != nil {
 			return err
@@ -260,7 +337,7 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 			}
 		}
 
-		if !m.EmitDefaults {
+		if !m.emitsDefault(msgName, valueField) {
 			switch value.Kind() {
 			case reflect.Bool:
 				if !value.Bool() {
@@ -351,6 +428,43 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 	return out.err
 }
 
+// EmitsDefault reports whether m renders the field with the given
+// fully-qualified name, such as "foo.Bar.baz", when it has its zero value.
+func (m *Marshaler) EmitsDefault(field string) bool {
+	msg := field[:strings.LastIndex(field, ".")+1]
+	msg = strings.TrimSuffix(msg, ".")
+	switch {
+	case hasName(m.OmitDefaultsFor, field):
+		return false
+	case hasName(m.EmitDefaultsFor, field):
+		return true
+	case hasName(m.OmitDefaultsFor, msg):
+		return false
+	case hasName(m.EmitDefaultsFor, msg):
+		return true
+	}
+	return m.EmitDefaults
+}
+
+// emitsDefault is EmitsDefault for the field f of the message with the
+// given name, which is empty unless m has per-message or per-field
+// settings.
+func (m *Marshaler) emitsDefault(msgName string, f reflect.StructField) bool {
+	if msgName == "" {
+		return m.EmitDefaults
+	}
+	return m.EmitsDefault(msgName + "." + jsonProperties(f, true).OrigName)
+}
+
+func hasName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *Marshaler) writeSep(out *errWriter) {
 	if m.Indent != "" {
 		out.write(",\n")
@@ -442,6 +556,10 @@ func (m *Marshaler) marshalField(out *errWriter, prop *proto.Properties, v refle
 	if m.Indent != "" {
 		out.write(" ")
 	}
+	if m.RedactSensitive && prop.Sensitive {
+		out.write(strconv.Quote(proto.RedactedValue))
+		return out.err
+	}
 	if err := m.marshalValue(out, prop, v, indent); err != nil {
 		return err
 	}
@@ -593,12 +711,19 @@ func (m *Marshaler) marshalValue(out *errWriter, prop *proto.Properties, v refle
 		}
 	}
 
+	// Enums generated with enum_helpers=true are encoding.TextMarshalers,
+	// which encoding/json would write by name; EnumsAsInts wants the number.
+	if _, ok := v.Interface().(encoding.TextMarshaler); ok && v.Kind() == reflect.Int32 {
+		out.write(strconv.FormatInt(v.Int(), 10))
+		return out.err
+	}
+
 	// Default handling defers to the encoding/json library.
 	b, err := json.Marshal(v.Interface())
 	if err != nil {
 		return err
 	}
-	needToQuote := string(b[0]) != `"` && (v.Kind() == reflect.Int64 || v.Kind() == reflect.Uint64)
+	needToQuote := !m.Int64AsNumber && string(b[0]) != `"` && (v.Kind() == reflect.Int64 || v.Kind() == reflect.Uint64)
 	if needToQuote {
 		out.write(`"`)
 	}
@@ -610,7 +735,9 @@ func (m *Marshaler) marshalValue(out *errWriter, prop *proto.Properties, v refle
 }
 
 // Unmarshaler is a configurable object for converting from a JSON
-// representation to a protocol buffer object.
+// representation to a protocol buffer object. It accepts 64-bit integers
+// both as strings and as numbers, so it reads the output of a Marshaler
+// with or without Int64AsNumber.
 type Unmarshaler struct {
 	// Whether to allow messages to contain unknown fields, as opposed to
 	// failing to unmarshal.
@@ -924,9 +1051,23 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 						continue
 					}
 					delete(jsonFields, name)
-					nv := reflect.New(reflect.TypeOf(ext.ExtensionType).Elem())
-					if err := u.unmarshalValue(nv.Elem(), raw, nil); err != nil {
-						return err
+					// The extension's properties give enum values by name.
+					var prop proto.Properties
+					prop.Parse(ext.Tag)
+					// Repeated and bytes extensions are slices; the
+					// others are pointers.
+					et := reflect.TypeOf(ext.ExtensionType)
+					var nv reflect.Value
+					if et.Kind() == reflect.Slice {
+						nv = reflect.New(et).Elem()
+						if err := u.unmarshalValue(nv, raw, &prop); err != nil {
+							return err
+						}
+					} else {
+						nv = reflect.New(et.Elem())
+						if err := u.unmarshalValue(nv.Elem(), raw, &prop); err != nil {
+							return err
+						}
 					}
 					if err := proto.SetExtension(ep, ext, nv.Interface()); err != nil {
 						return err
@@ -1019,6 +1160,17 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 		}
 	}
 
+	// Enums that are encoding.TextUnmarshalers may still appear as numbers,
+	// which encoding/json refuses to give to UnmarshalText.
+	if _, ok := target.Addr().Interface().(encoding.TextUnmarshaler); ok && targetType.Kind() == reflect.Int32 {
+		var n int32
+		if err := json.Unmarshal(inputValue, &n); err != nil {
+			return err
+		}
+		target.SetInt(int64(n))
+		return nil
+	}
+
 	// Use the encoding/json for parsing other value types.
 	return json.Unmarshal(inputValue, target.Addr().Interface())
 }
@@ -34,6 +34,7 @@ package jsonpb
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"math"
 	"reflect"
@@ -43,7 +44,9 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	lpb "github.com/golang/protobuf/logpb/logpb_test_proto"
 	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	testpb "github.com/golang/protobuf/proto/testdata"
 	"github.com/golang/protobuf/ptypes"
 	anypb "github.com/golang/protobuf/ptypes/any"
 	durpb "github.com/golang/protobuf/ptypes/duration"
@@ -373,6 +376,11 @@ var marshalingTests = []struct {
 	{"empty repeated emitted", Marshaler{EmitDefaults: true}, &pb.SimpleSlice3{}, `{"slices":[]}`},
 	{"empty map emitted", Marshaler{EmitDefaults: true}, &pb.SimpleMap3{}, `{"stringy":{}}`},
 	{"nested struct null", Marshaler{EmitDefaults: true}, &pb.SimpleNull3{}, `{"simple":null}`},
+	{"message emits defaults", Marshaler{EmitDefaultsFor: []string{"jsonpb.Simple3"}}, &pb.SimpleNull3{Simple: &pb.Simple3{}}, `{"simple":{"dub":0}}`},
+	{"field emits default", Marshaler{EmitDefaultsFor: []string{"jsonpb.Mappy.strry"}}, &pb.Mappy{}, `{"strry":{}}`},
+	{"field omits default", Marshaler{EmitDefaults: true, OmitDefaultsFor: []string{"jsonpb.SimpleNull3.simple"}}, &pb.SimpleNull3{}, `{}`},
+	{"field overrides message", Marshaler{EmitDefaultsFor: []string{"jsonpb.Simple3"}, OmitDefaultsFor: []string{"jsonpb.Simple3.dub"}}, &pb.Simple3{}, `{}`},
+	{"omit overrides emit", Marshaler{EmitDefaultsFor: []string{"jsonpb.Simple3"}, OmitDefaultsFor: []string{"jsonpb.Simple3"}}, &pb.Simple3{}, `{}`},
 	{"map<int64, int32>", marshaler, &pb.Mappy{Nummy: map[int64]int32{1: 2, 3: 4}}, `{"nummy":{"1":2,"3":4}}`},
 	{"map<int64, int32>", marshalerAllOptions, &pb.Mappy{Nummy: map[int64]int32{1: 2, 3: 4}}, nummyPrettyJSON},
 	{"map<string, string>", marshaler,
@@ -445,6 +453,13 @@ var marshalingTests = []struct {
 	{"FloatValue", marshaler, &pb.KnownTypes{Flt: &wpb.FloatValue{Value: 1.2}}, `{"flt":1.2}`},
 	{"Int64Value", marshaler, &pb.KnownTypes{I64: &wpb.Int64Value{Value: -3}}, `{"i64":"-3"}`},
 	{"UInt64Value", marshaler, &pb.KnownTypes{U64: &wpb.UInt64Value{Value: 3}}, `{"u64":"3"}`},
+	{"Int64AsNumber", Marshaler{Int64AsNumber: true},
+		&pb.Simple{OInt64: proto.Int64(-6400000000), OUint64: proto.Uint64(6400000000), OSint64: proto.Int64(-2600000000)},
+		`{"oInt64":-6400000000,"oUint64":6400000000,"oSint64":-2600000000}`},
+	{"repeated Int64AsNumber", Marshaler{Int64AsNumber: true}, &pb.Repeats{RInt64: []int64{-1, 2}, RUint64: []uint64{3}}, `{"rInt64":[-1,2],"rUint64":[3]}`},
+	{"map<int64, int32> Int64AsNumber", Marshaler{Int64AsNumber: true}, &pb.Mappy{Nummy: map[int64]int32{1: 2, 3: 4}}, `{"nummy":{"1":2,"3":4}}`},
+	{"Int64Value Int64AsNumber", Marshaler{Int64AsNumber: true}, &pb.KnownTypes{I64: &wpb.Int64Value{Value: -3}}, `{"i64":-3}`},
+	{"UInt64Value Int64AsNumber", Marshaler{Int64AsNumber: true}, &pb.KnownTypes{U64: &wpb.UInt64Value{Value: 3}}, `{"u64":3}`},
 	{"Int32Value", marshaler, &pb.KnownTypes{I32: &wpb.Int32Value{Value: -4}}, `{"i32":-4}`},
 	{"UInt32Value", marshaler, &pb.KnownTypes{U32: &wpb.UInt32Value{Value: 4}}, `{"u32":4}`},
 	{"BoolValue", marshaler, &pb.KnownTypes{Bool: &wpb.BoolValue{Value: true}}, `{"bool":true}`},
@@ -463,6 +478,21 @@ func TestMarshaling(t *testing.T) {
 	}
 }
 
+func TestMarshalRedactSensitive(t *testing.T) {
+	msg := &lpb.Login{
+		User:          "gopher",
+		Password:      "hunter2",
+		Credential:    &lpb.Login_Otp{Otp: "123456"},
+		RecoveryCodes: []string{"a1"},
+		Pin:           4321,
+	}
+	m := &Marshaler{RedactSensitive: true}
+	const want = `{"user":"gopher","password":"***","otp":"***","recoveryCodes":"***","pin":"***"}`
+	if got, err := m.MarshalToString(msg); err != nil || got != want {
+		t.Errorf("MarshalToString = %s, %v\nwant %s", got, err, want)
+	}
+}
+
 func TestMarshalJSONPBMarshaler(t *testing.T) {
 	rawJson := `{ "foo": "bar", "baz": [0, 1, 2, 3] }`
 	msg := dynamicMessage{rawJson: rawJson}
@@ -531,6 +561,8 @@ var unmarshalingTests = []struct {
 		}}},
 	{"unquoted int64 object", Unmarshaler{}, `{"oInt64":-314}`, &pb.Simple{OInt64: proto.Int64(-314)}},
 	{"unquoted uint64 object", Unmarshaler{}, `{"oUint64":123}`, &pb.Simple{OUint64: proto.Uint64(123)}},
+	{"unquoted repeated int64", Unmarshaler{}, `{"rInt64":[-1,"2"],"rUint64":[3]}`, &pb.Repeats{RInt64: []int64{-1, 2}, RUint64: []uint64{3}}},
+	{"unquoted Int64Value", Unmarshaler{}, `{"i64":-3,"u64":9223372036854775808}`, &pb.KnownTypes{I64: &wpb.Int64Value{Value: -3}, U64: &wpb.UInt64Value{Value: 1 << 63}}},
 	{"NaN", Unmarshaler{}, `{"oDouble":"NaN"}`, &pb.Simple{ODouble: proto.Float64(math.NaN())}},
 	{"Inf", Unmarshaler{}, `{"oFloat":"Infinity"}`, &pb.Simple{OFloat: proto.Float32(float32(math.Inf(1)))}},
 	{"-Inf", Unmarshaler{}, `{"oDouble":"-Infinity"}`, &pb.Simple{ODouble: proto.Float64(math.Inf(-1))}},
@@ -646,6 +678,43 @@ func TestUnmarshaling(t *testing.T) {
 	}
 }
 
+func TestExtensionsRoundTrip(t *testing.T) {
+	dm := &testpb.DefaultsMessage{}
+	if err := proto.SetExtension(dm, testpb.E_NoDefaultEnum, testpb.DefaultsMessage_TWO.Enum()); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(dm, testpb.E_NoDefaultBytes, []byte("b")); err != nil {
+		t.Fatal(err)
+	}
+	mm := &testpb.MyMessage{Count: proto.Int32(1)}
+	if err := proto.SetExtension(mm, testpb.E_Greeting, []string{"hi", "yo"}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		msg  proto.Message
+		json string
+	}{
+		{dm, `{"[testdata.no_default_bytes]":"Yg==","[testdata.no_default_enum]":"TWO"}`},
+		{mm, `{"count":1,"[testdata.greeting]":["hi","yo"]}`},
+	}
+	for _, tt := range tests {
+		got, err := (&Marshaler{}).MarshalToString(tt.msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.json {
+			t.Errorf("Marshal(%v) = %s, want %s", tt.msg, got, tt.json)
+		}
+		back := reflect.New(reflect.TypeOf(tt.msg).Elem()).Interface().(proto.Message)
+		if err := UnmarshalString(tt.json, back); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", tt.json, err)
+		}
+		if !proto.Equal(back, tt.msg) {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.json, back, tt.msg)
+		}
+	}
+}
+
 func TestUnmarshalNullArray(t *testing.T) {
 	var repeats pb.Repeats
 	if err := UnmarshalString(`{"rBool":null}`, &repeats); err != nil {
@@ -781,6 +850,84 @@ func TestAnyWithCustomResolver(t *testing.T) {
 	}
 }
 
+func TestAnyResolverFunc(t *testing.T) {
+	msgBytes, err := proto.Marshal(&pb.Simple{OString: proto.String("foobar")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	any := &anypb.Any{TypeUrl: "https://foobar.com/some.random.MessageKind", Value: msgBytes}
+	const js = `{"@type":"https://foobar.com/some.random.MessageKind","oString":"foobar"}`
+
+	var resolved []string
+	var resolver AnyResolver = AnyResolverFunc(func(turl string) (proto.Message, error) {
+		resolved = append(resolved, turl)
+		return new(pb.Simple), nil
+	})
+	if got, err := (&Marshaler{AnyResolver: resolver}).MarshalToString(any); err != nil || got != js {
+		t.Errorf("MarshalToString = %s, %v; want %s", got, err, js)
+	}
+	roundTrip := new(anypb.Any)
+	if err := (&Unmarshaler{AnyResolver: resolver}).Unmarshal(strings.NewReader(js), roundTrip); err != nil {
+		t.Errorf("Unmarshal: %v", err)
+	} else if !proto.Equal(roundTrip, any) {
+		t.Errorf("Unmarshal = %v, want %v", roundTrip, any)
+	}
+	if len(resolved) != 2 || resolved[0] != any.TypeUrl || resolved[1] != any.TypeUrl {
+		t.Errorf("function called with %q, want %q twice", resolved, any.TypeUrl)
+	}
+}
+
+func TestResolver(t *testing.T) {
+	msg := &pb.Simple{OString: proto.String("foobar")}
+	msgBytes, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	any := &anypb.Any{TypeUrl: "example.com/types/v2/jsonpb.Simple", Value: msgBytes}
+	const js = `{"@type":"example.com/types/v2/jsonpb.Simple","oString":"foobar"}`
+
+	r := &Resolver{
+		TypeName: func(turl string) (string, error) {
+			if !strings.HasPrefix(turl, "example.com/types/v2/") {
+				return "", fmt.Errorf("unsupported type URL %q", turl)
+			}
+			return strings.TrimPrefix(turl, "example.com/types/v2/"), nil
+		},
+	}
+	m := Marshaler{AnyResolver: r}
+	if got, err := m.MarshalToString(any); err != nil || got != js {
+		t.Errorf("MarshalToString = %s, %v; want %s", got, err, js)
+	}
+	roundTrip := new(anypb.Any)
+	if err := (&Unmarshaler{AnyResolver: r}).Unmarshal(strings.NewReader(js), roundTrip); err != nil {
+		t.Errorf("Unmarshal: %v", err)
+	} else if !proto.Equal(roundTrip, any) {
+		t.Errorf("Unmarshal = %v, want %v", roundTrip, any)
+	}
+	any.TypeUrl = "type.googleapis.com/jsonpb.Simple"
+	if _, err := m.MarshalToString(any); err == nil {
+		t.Error("MarshalToString of an Any with an unsupported type URL succeeded")
+	}
+
+	// Types with no Go type are made by Dynamic.
+	var names []string
+	r = &Resolver{Dynamic: func(name string) (proto.Message, error) {
+		names = append(names, name)
+		return new(pb.Simple), nil
+	}}
+	any.TypeUrl = "type.googleapis.com/some.Unlinked"
+	if got, err := (&Marshaler{AnyResolver: r}).MarshalToString(any); err != nil || got != `{"@type":"type.googleapis.com/some.Unlinked","oString":"foobar"}` {
+		t.Errorf("MarshalToString with Dynamic = %s, %v", got, err)
+	}
+	any.TypeUrl = "type.googleapis.com/jsonpb.Simple"
+	if _, err := (&Marshaler{AnyResolver: r}).MarshalToString(any); err != nil {
+		t.Error(err)
+	}
+	if len(names) != 1 || names[0] != "some.Unlinked" {
+		t.Errorf("Dynamic called with %q, want only some.Unlinked", names)
+	}
+}
+
 func TestUnmarshalJSONPBUnmarshaler(t *testing.T) {
 	rawJson := `{ "foo": "bar", "baz": [0, 1, 2, 3] }`
 	var msg dynamicMessage
@@ -3,14 +3,17 @@
 
 package jsonpb
 
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import google_protobuf "github.com/golang/protobuf/ptypes/any"
-import google_protobuf1 "github.com/golang/protobuf/ptypes/duration"
-import google_protobuf2 "github.com/golang/protobuf/ptypes/struct"
-import google_protobuf3 "github.com/golang/protobuf/ptypes/timestamp"
-import google_protobuf4 "github.com/golang/protobuf/ptypes/wrappers"
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	google_protobuf "github.com/golang/protobuf/ptypes/any"
+	google_protobuf1 "github.com/golang/protobuf/ptypes/duration"
+	google_protobuf2 "github.com/golang/protobuf/ptypes/struct"
+	google_protobuf3 "github.com/golang/protobuf/ptypes/timestamp"
+	google_protobuf4 "github.com/golang/protobuf/ptypes/wrappers"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -0,0 +1,185 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// A Decoder reads messages from a stream of JSON objects, such as
+// newline-delimited JSON, or from a JSON array of objects. It decodes one
+// message at a time, so the stream need not fit in memory.
+type Decoder struct {
+	// Unmarshaler holds the options for decoding each message.
+	Unmarshaler Unmarshaler
+
+	r     *bufio.Reader
+	dec   *json.Decoder
+	array bool // whether the stream is a JSON array
+	done  bool // whether the closing bracket of the array has been read
+}
+
+// NewDecoder returns a Decoder reading from r. The Decoder buffers its
+// input, and may read past the last message it decodes.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// Decode unmarshals the next message in the stream into pb, as
+// UnmarshalNext does. It returns io.EOF at the end of the stream.
+func (d *Decoder) Decode(pb proto.Message) error {
+	if d.dec == nil {
+		if err := d.start(); err != nil {
+			return err
+		}
+	}
+	if d.array {
+		if d.done {
+			return io.EOF
+		}
+		if !d.dec.More() {
+			if _, err := d.dec.Token(); err != nil {
+				return err
+			}
+			d.done = true
+			// Nothing but white space may follow the array.
+			if _, err := d.dec.Token(); err != io.EOF {
+				if err == nil {
+					err = errors.New("jsonpb: data after the end of the array of messages")
+				}
+				return err
+			}
+			return io.EOF
+		}
+	}
+	return d.Unmarshaler.UnmarshalNext(d.dec, pb)
+}
+
+// start looks at the first character of the stream to see whether it is
+// an array, and consumes its opening bracket if it is.
+func (d *Decoder) start() error {
+	for {
+		b, err := d.r.Peek(1)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if b[0] == ' ' || b[0] == '\t' || b[0] == '\r' || b[0] == '\n' {
+			d.r.ReadByte()
+			continue
+		}
+		d.array = b[0] == '['
+		break
+	}
+	d.dec = json.NewDecoder(d.r)
+	if d.array {
+		if _, err := d.dec.Token(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// An Encoder writes messages to a stream as JSON objects. By default it
+// writes newline-delimited JSON, one object per line; with Array set it
+// writes a JSON array of them instead, which Close ends.
+type Encoder struct {
+	// Marshaler holds the options for encoding each message. With Indent
+	// set, the objects span several lines, so the stream is no longer
+	// newline-delimited, though a Decoder still reads it.
+	Marshaler Marshaler
+
+	// Array makes the Encoder write a JSON array. It must be set before
+	// the first call to Encode.
+	Array bool
+
+	w   io.Writer
+	buf bytes.Buffer
+	n   int // number of messages written
+}
+
+// NewEncoder returns an Encoder writing to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes pb to the stream, with a single call to the Write method
+// of the underlying writer.
+func (e *Encoder) Encode(pb proto.Message) error {
+	e.buf.Reset()
+	if e.Array {
+		if e.n == 0 {
+			e.buf.WriteString("[")
+		} else {
+			e.buf.WriteString(",")
+		}
+		if e.Marshaler.Indent != "" {
+			e.buf.WriteString("\n")
+		}
+	}
+	if err := e.Marshaler.Marshal(&e.buf, pb); err != nil {
+		return err
+	}
+	if !e.Array {
+		e.buf.WriteString("\n")
+	}
+	if _, err := e.w.Write(e.buf.Bytes()); err != nil {
+		return err
+	}
+	e.n++
+	return nil
+}
+
+// Close ends the array of messages, if Array is set, writing an empty
+// array if no messages were written. It does not close the underlying
+// writer.
+func (e *Encoder) Close() error {
+	if !e.Array {
+		return nil
+	}
+	end := "]\n"
+	if e.n == 0 {
+		end = "[]\n"
+	} else if e.Marshaler.Indent != "" {
+		end = "\n]\n"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
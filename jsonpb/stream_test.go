@@ -0,0 +1,150 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+)
+
+var streamMessages = []*pb.Simple3{{Dub: 1}, {Dub: 2.5}, {}}
+
+var encoderTests = []struct {
+	desc  string
+	array bool
+	m     Marshaler
+	want  string
+}{
+	{"NDJSON", false, Marshaler{}, "{\"dub\":1}\n{\"dub\":2.5}\n{}\n"},
+	{"array", true, Marshaler{}, "[{\"dub\":1},{\"dub\":2.5},{}]\n"},
+	{"indented array", true, Marshaler{Indent: " "}, "[\n{\n \"dub\": 1\n},\n{\n \"dub\": 2.5\n},\n{\n\n}\n]\n"},
+}
+
+func TestEncoder(t *testing.T) {
+	for _, tt := range encoderTests {
+		var buf bytes.Buffer
+		e := NewEncoder(&buf)
+		e.Marshaler = tt.m
+		e.Array = tt.array
+		for _, m := range streamMessages {
+			if err := e.Encode(m); err != nil {
+				t.Fatalf("%s: %v", tt.desc, err)
+			}
+		}
+		if err := e.Close(); err != nil {
+			t.Fatalf("%s: %v", tt.desc, err)
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.desc, got, tt.want)
+		}
+		checkDecode(t, tt.desc, tt.want, streamMessages)
+	}
+}
+
+func TestEncoderEmptyArray(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	e.Array = true
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "[]\n" {
+		t.Errorf("got %q, want %q", got, "[]\n")
+	}
+	checkDecode(t, "empty array", buf.String(), nil)
+}
+
+func TestDecoder(t *testing.T) {
+	for _, in := range []string{
+		``,
+		"\n\t ",
+		`{"dub":1} {"dub":2.5} {}`,
+		` [ {"dub":1}, {"dub":2.5}, {} ] `,
+		"[]",
+	} {
+		var want []*pb.Simple3
+		if strings.Contains(in, "{") {
+			want = streamMessages
+		}
+		checkDecode(t, in, in, want)
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	for _, in := range []string{
+		`[{"dub":1}] {"dub":2}`,
+		`[{"dub":1}`,
+		`{"dub":1} {"dub":`,
+		`{"unknown":1}`,
+	} {
+		d := NewDecoder(strings.NewReader(in))
+		var err error
+		for err == nil {
+			err = d.Decode(new(pb.Simple3))
+		}
+		if err == io.EOF {
+			t.Errorf("Decode of %q read to the end without error", in)
+		}
+	}
+
+	d := NewDecoder(strings.NewReader(`[{"unknown":1}]`))
+	d.Unmarshaler.AllowUnknownFields = true
+	if err := d.Decode(new(pb.Simple3)); err != nil {
+		t.Errorf("Decode with AllowUnknownFields: %v", err)
+	}
+}
+
+// checkDecode checks that a Decoder reads want from in, then io.EOF.
+func checkDecode(t *testing.T, desc, in string, want []*pb.Simple3) {
+	d := NewDecoder(strings.NewReader(in))
+	for i, w := range want {
+		got := new(pb.Simple3)
+		if err := d.Decode(got); err != nil {
+			t.Errorf("%s: message %d: %v", desc, i, err)
+			return
+		}
+		if !proto.Equal(got, w) {
+			t.Errorf("%s: message %d = %v, want %v", desc, i, got, w)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if err := d.Decode(new(pb.Simple3)); err != io.EOF {
+			t.Errorf("%s: Decode at end = %v, want io.EOF", desc, err)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+//go:build go1.21
+// +build go1.21
+
+package logpb
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"time"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+)
+
+// A CallLogger logs the calls of carno services. The carno plugin, with
+// carno:log=true, generates New<Service>LoggingServer and
+// New<Service>LoggingClient, which wrap a server or client so that each
+// unary call is passed to a CallLogger when it returns, unless the
+// toggle.Logging feature is off for the method.
+type CallLogger interface {
+	LogCall(ctx context.Context, c *Call)
+}
+
+// A Call describes a call that has returned.
+type Call struct {
+	Info     *callinfo.CallInfo
+	Client   bool // logged by the client rather than the server
+	Start    time.Time
+	Duration time.Duration
+	Request  proto.Message
+	Response proto.Message // nil, or a nil pointer, if there is none
+	Err      error
+}
+
+// Status returns "ok" if the call succeeded and "error" if it failed.
+func (c *Call) Status() string {
+	if c.Err != nil {
+		return "error"
+	}
+	return "ok"
+}
+
+// Attrs returns the attributes describing c: the method's carno name,
+// whether it was logged by the client or the server, its duration and
+// status, its error, if any, and the encoded size and fields of the
+// request and response, summarized and redacted as Message does.
+func (o Options) Attrs(c *Call) []slog.Attr {
+	side := "server"
+	if c.Client {
+		side = "client"
+	}
+	attrs := []slog.Attr{
+		slog.String("method", c.Info.FullMethod()),
+		slog.String("side", side),
+		slog.Duration("duration", c.Duration),
+		slog.String("status", c.Status()),
+	}
+	if c.Err != nil {
+		attrs = append(attrs, slog.String("error", c.Err.Error()))
+	}
+	if present(c.Request) {
+		attrs = append(attrs,
+			slog.Int("request_size", proto.Size(c.Request)),
+			slog.Any("request", o.Value(c.Request)))
+	}
+	if present(c.Response) {
+		attrs = append(attrs,
+			slog.Int("response_size", proto.Size(c.Response)),
+			slog.Any("response", o.Value(c.Response)))
+	}
+	return attrs
+}
+
+// present reports whether m holds a message rather than nil.
+func present(m proto.Message) bool {
+	if m == nil {
+		return false
+	}
+	v := reflect.ValueOf(m)
+	return v.Kind() != reflect.Ptr || !v.IsNil()
+}
+
+// SlogLogger is a CallLogger that logs each call as a record with the
+// message "call" and the attributes returned by Options.Attrs, at
+// slog.LevelInfo if the call succeeded and slog.LevelError if it failed.
+type SlogLogger struct {
+	Logger  *slog.Logger // nil means slog.Default()
+	Options Options      // how payloads are summarized
+}
+
+// NewSlogLogger returns a SlogLogger for l with DefaultOptions.
+func NewSlogLogger(l *slog.Logger) *SlogLogger {
+	return &SlogLogger{Logger: l, Options: DefaultOptions}
+}
+
+// LogCall implements CallLogger.
+func (s *SlogLogger) LogCall(ctx context.Context, c *Call) {
+	l := s.Logger
+	if l == nil {
+		l = slog.Default()
+	}
+	level := slog.LevelInfo
+	if c.Err != nil {
+		level = slog.LevelError
+	}
+	l.LogAttrs(ctx, level, "call", s.Options.Attrs(c)...)
+}
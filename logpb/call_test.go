@@ -0,0 +1,103 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+//go:build go1.21
+// +build go1.21
+
+package logpb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	pb "github.com/golang/protobuf/logpb/logpb_test_proto"
+)
+
+var loginInfo = &callinfo.CallInfo{Service: "demo@Auth", Method: "Login"}
+
+// logCall logs c with a SlogLogger and returns the record as decoded JSON.
+func logCall(t *testing.T, c *Call) map[string]interface{} {
+	var buf bytes.Buffer
+	NewSlogLogger(slog.New(slog.NewJSONHandler(&buf, nil))).LogCall(context.Background(), c)
+	var rec map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("bad log output %q: %v", buf.String(), err)
+	}
+	delete(rec, "time")
+	return rec
+}
+
+func TestSlogLogger(t *testing.T) {
+	in := &pb.Login{User: "gopher", Password: "hunter2"}
+	out := &pb.Login{User: "gopher"}
+	got := logCall(t, &Call{Info: loginInfo, Duration: time.Millisecond, Request: in, Response: out})
+	want := map[string]interface{}{
+		"level":         "INFO",
+		"msg":           "call",
+		"method":        "demo@Auth/Login",
+		"side":          "server",
+		"duration":      1e6,
+		"status":        "ok",
+		"request_size":  17.0,
+		"request":       map[string]interface{}{"user": "gopher", "password": Redacted},
+		"response_size": 8.0,
+		"response":      map[string]interface{}{"user": "gopher"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("logged %v\nwant %v", got, want)
+	}
+}
+
+func TestSlogLoggerError(t *testing.T) {
+	var out *pb.Login
+	got := logCall(t, &Call{Info: loginInfo, Client: true, Request: &pb.Login{}, Response: out, Err: errors.New("denied")})
+	want := map[string]interface{}{
+		"level":        "ERROR",
+		"msg":          "call",
+		"method":       "demo@Auth/Login",
+		"side":         "client",
+		"duration":     0.0,
+		"status":       "error",
+		"error":        "denied",
+		"request_size": 0.0,
+		// slog leaves out the empty request, and there is no response.
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("logged %v\nwant %v", got, want)
+	}
+}
@@ -0,0 +1,346 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+//go:build go1.21
+// +build go1.21
+
+/*
+Package logpb logs protocol buffer messages with log/slog field by field,
+rather than as the single string their String method returns.
+
+Each set field of a message becomes an attribute named after the field in
+the .proto file; nested messages, repeated fields and maps become groups.
+Fields marked with the (carno.sensitive) option are logged as REDACTED, and
+long strings, long lists and deep nesting are cut short so a large message
+cannot flood the log.
+
+Wrap a message with Message to log it:
+
+	slog.Info("login", "req", logpb.Message(req))
+
+or set ReplaceAttr in the handler's options to log every message that way:
+
+	h := slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		ReplaceAttr: logpb.DefaultOptions.ReplaceAttr,
+	})
+
+For loggers that take plain strings, Text writes a message in the compact
+text format, with the same fields redacted:
+
+	log.Printf("login %s", logpb.Text(req))
+*/
+package logpb
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+)
+
+// Redacted is logged in place of the value of a sensitive field.
+const Redacted = "REDACTED"
+
+// Options control how messages are logged.
+type Options struct {
+	// MaxStringLen is the number of bytes of a string or bytes field that
+	// are logged; the rest is replaced by "...". Zero means no limit.
+	MaxStringLen int
+
+	// MaxElems is the number of elements of a repeated or map field that
+	// are logged; the number left out is logged under the key "more".
+	// Zero means no limit.
+	MaxElems int
+
+	// MaxDepth is how many levels of nested messages are logged; deeper
+	// messages are logged as "...". Zero means no limit.
+	MaxDepth int
+}
+
+// DefaultOptions are the options used by Message.
+var DefaultOptions = Options{
+	MaxStringLen: 256,
+	MaxElems:     32,
+	MaxDepth:     8,
+}
+
+// Message returns a slog.LogValuer that logs m field by field, using DefaultOptions.
+func Message(m proto.Message) slog.LogValuer { return DefaultOptions.Message(m) }
+
+// Message returns a slog.LogValuer that logs m field by field.
+func (o Options) Message(m proto.Message) slog.LogValuer { return valuer{o, m} }
+
+type valuer struct {
+	o Options
+	m proto.Message
+}
+
+func (v valuer) LogValue() slog.Value { return v.o.Value(v.m) }
+
+// Value returns m as a group of its set fields.
+func (o Options) Value(m proto.Message) slog.Value {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		// Not a generated message; fall back on its String method.
+		return slog.StringValue(m.String())
+	}
+	return o.message(v, 1)
+}
+
+// ReplaceAttr replaces each attribute holding a proto.Message with one
+// holding Value of the message. It is meant for slog.HandlerOptions.
+func (o Options) ReplaceAttr(groups []string, a slog.Attr) slog.Attr {
+	if a.Value.Kind() == slog.KindAny {
+		if m, ok := a.Value.Any().(proto.Message); ok {
+			a.Value = o.Value(m)
+		}
+	}
+	return a
+}
+
+// message returns the fields of v, a pointer to a generated message struct
+// at the given depth of nesting.
+func (o Options) message(v reflect.Value, depth int) slog.Value {
+	if v.IsNil() {
+		return slog.GroupValue()
+	}
+	if o.MaxDepth > 0 && depth > o.MaxDepth {
+		return slog.StringValue("...")
+	}
+	if m, ok := v.Interface().(proto.Message); ok {
+		proto.DecodeLazy(m)
+	}
+	sensitive := sensitiveFields(v)
+	sv := v.Elem()
+	st := sv.Type()
+	sprops := proto.GetProperties(st)
+
+	var attrs []slog.Attr
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		fv := sv.Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") || empty(fv) {
+			continue
+		}
+		name := sprops.Prop[i].OrigName
+		if f.Tag.Get("protobuf_oneof") != "" {
+			// The value is a pointer to a wrapper struct whose only
+			// field is the one that is set.
+			w := fv.Elem().Elem()
+			var p proto.Properties
+			p.Parse(w.Type().Field(0).Tag.Get("protobuf"))
+			name, fv = p.OrigName, w.Field(0)
+		}
+		if sensitive[name] {
+			attrs = append(attrs, slog.String(name, Redacted))
+			continue
+		}
+		attrs = append(attrs, slog.Attr{Key: name, Value: o.value(fv, depth)})
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// value returns v, the value of a field of a message at the given depth.
+func (o Options) value(v reflect.Value, depth int) slog.Value {
+	if s, ok := v.Interface().(fmt.Stringer); ok && v.Kind() == reflect.Int32 {
+		// An enum.
+		return slog.StringValue(s.String())
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.Elem().Kind() == reflect.Struct {
+			return o.message(v, depth+1)
+		}
+		return o.value(v.Elem(), depth) // proto2 scalar
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return o.bytes(v.Bytes())
+		}
+		return o.list(v, depth)
+	case reflect.Map:
+		return o.mapValue(v, depth)
+	case reflect.String:
+		return slog.StringValue(o.truncate(v.String()))
+	case reflect.Bool:
+		return slog.BoolValue(v.Bool())
+	case reflect.Int32, reflect.Int64:
+		return slog.Int64Value(v.Int())
+	case reflect.Uint32, reflect.Uint64:
+		return slog.Uint64Value(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return slog.Float64Value(v.Float())
+	}
+	return slog.AnyValue(v.Interface())
+}
+
+// list returns the elements of a repeated field as a group keyed by index.
+func (o Options) list(v reflect.Value, depth int) slog.Value {
+	n := v.Len()
+	if o.MaxElems > 0 && n > o.MaxElems {
+		n = o.MaxElems
+	}
+	attrs := make([]slog.Attr, 0, n+1)
+	for i := 0; i < n; i++ {
+		attrs = append(attrs, slog.Attr{Key: strconv.Itoa(i), Value: o.value(v.Index(i), depth)})
+	}
+	if n < v.Len() {
+		attrs = append(attrs, slog.Int("more", v.Len()-n))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// mapValue returns the entries of a map field as a group, sorted by key.
+func (o Options) mapValue(v reflect.Value, depth int) slog.Value {
+	keys := v.MapKeys()
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = fmt.Sprint(k.Interface())
+	}
+	sort.Sort(byName{names, keys})
+	n := len(keys)
+	if o.MaxElems > 0 && n > o.MaxElems {
+		n = o.MaxElems
+	}
+	attrs := make([]slog.Attr, 0, n+1)
+	for i := 0; i < n; i++ {
+		attrs = append(attrs, slog.Attr{Key: names[i], Value: o.value(v.MapIndex(keys[i]), depth)})
+	}
+	if n < len(keys) {
+		attrs = append(attrs, slog.Int("more", len(keys)-n))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+type byName struct {
+	names []string
+	keys  []reflect.Value
+}
+
+func (s byName) Len() int           { return len(s.names) }
+func (s byName) Less(i, j int) bool { return s.names[i] < s.names[j] }
+func (s byName) Swap(i, j int) {
+	s.names[i], s.names[j] = s.names[j], s.names[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+// bytes returns b base64-encoded, as in the JSON mapping of protocol buffers.
+func (o Options) bytes(b []byte) slog.Value {
+	if o.MaxStringLen > 0 && len(b) > o.MaxStringLen {
+		return slog.StringValue(base64.StdEncoding.EncodeToString(b[:o.MaxStringLen]) + "...")
+	}
+	return slog.StringValue(base64.StdEncoding.EncodeToString(b))
+}
+
+// truncate cuts s down to at most MaxStringLen bytes, without splitting a rune.
+func (o Options) truncate(s string) string {
+	if o.MaxStringLen <= 0 || len(s) <= o.MaxStringLen {
+		return s
+	}
+	n := o.MaxStringLen
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "..."
+}
+
+// empty reports whether v, a field of a message, is unset.
+func empty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+// TextMarshaler writes messages on one line, with Any messages of known types
+// expanded and sensitive fields redacted. It is used by Text.
+var TextMarshaler = proto.TextMarshaler{
+	Compact:   true,
+	ExpandAny: true,
+	Redact:    Sensitive,
+}
+
+// Text returns m in text format, as written by TextMarshaler.
+func Text(m proto.Message) string { return TextMarshaler.Text(m) }
+
+// Sensitive reports whether the field of m with the given name in the .proto
+// file is marked with the (carno.sensitive) option. It suits the Redact field
+// of proto.TextMarshaler.
+func Sensitive(m proto.Message, field string) bool {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Ptr {
+		return false
+	}
+	return sensitiveFields(v)[field]
+}
+
+var (
+	sensitiveMu sync.RWMutex
+	sensitive   = make(map[reflect.Type]map[string]bool)
+)
+
+// sensitiveFields returns the names of the fields of v, a pointer to a
+// message, that are marked with the (carno.sensitive) option.
+func sensitiveFields(v reflect.Value) map[string]bool {
+	sensitiveMu.RLock()
+	s, ok := sensitive[v.Type()]
+	sensitiveMu.RUnlock()
+	if ok {
+		return s
+	}
+
+	s = make(map[string]bool)
+	if m, ok := v.Interface().(descriptor.Message); ok {
+		_, md := descriptor.ForMessage(m)
+		for _, f := range md.Field {
+			if f.Options == nil {
+				continue
+			}
+			if on, err := proto.GetExtension(f.Options, options.E_Sensitive); err == nil && *on.(*bool) {
+				s[f.GetName()] = true
+			}
+		}
+	}
+
+	sensitiveMu.Lock()
+	sensitive[v.Type()] = s
+	sensitiveMu.Unlock()
+	return s
+}
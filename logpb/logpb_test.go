@@ -0,0 +1,137 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+//go:build go1.21
+// +build go1.21
+
+package logpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	pb "github.com/golang/protobuf/logpb/logpb_test_proto"
+)
+
+// logJSON logs v under the key "m" and returns the logged value as decoded JSON.
+func logJSON(t *testing.T, ho *slog.HandlerOptions, v interface{}) interface{} {
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, ho)).Info("test", "m", v)
+	var rec map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("bad log output %q: %v", buf.String(), err)
+	}
+	return rec["m"]
+}
+
+func TestMessage(t *testing.T) {
+	m := &pb.Login{
+		User:       "gopher",
+		Password:   "hunter2",
+		Method:     pb.Login_TOKEN,
+		Scopes:     []string{"read", "write"},
+		Quotas:     map[string]int32{"b": 2, "a": 1},
+		Avatar:     []byte{0, 1, 2},
+		Delegate:   &pb.Login{User: "admin"},
+		Credential: &pb.Login_Otp{Otp: "123456"},
+	}
+	got := logJSON(t, nil, Message(m))
+	want := map[string]interface{}{
+		"user":     "gopher",
+		"password": Redacted,
+		"method":   "TOKEN",
+		"scopes":   map[string]interface{}{"0": "read", "1": "write"},
+		"quotas":   map[string]interface{}{"a": 1.0, "b": 2.0},
+		"avatar":   "AAEC",
+		"delegate": map[string]interface{}{"user": "admin"},
+		"otp":      Redacted,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("logged %v\nwant %v", got, want)
+	}
+
+	// Oneof fields that are not sensitive are logged.
+	m = &pb.Login{Credential: &pb.Login_KeyId{KeyId: 42}}
+	got = logJSON(t, nil, Message(m))
+	want = map[string]interface{}{"key_id": 42.0}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("logged %v, want %v", got, want)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	o := Options{MaxStringLen: 4, MaxElems: 2, MaxDepth: 2}
+	m := &pb.Login{
+		User:     "gophér",
+		Scopes:   []string{"a", "b", "c", "d"},
+		Delegate: &pb.Login{User: "x", Delegate: &pb.Login{User: "y"}},
+	}
+	got := logJSON(t, nil, o.Message(m))
+	want := map[string]interface{}{
+		"user":     "goph...",
+		"scopes":   map[string]interface{}{"0": "a", "1": "b", "more": 2.0},
+		"delegate": map[string]interface{}{"user": "x", "delegate": "..."},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("logged %v\nwant %v", got, want)
+	}
+
+	// Strings are not cut inside a rune.
+	if got := (Options{MaxStringLen: 5}).truncate("gophér"); got != "goph..." {
+		t.Errorf("truncate = %q, want %q", got, "goph...")
+	}
+}
+
+func TestReplaceAttr(t *testing.T) {
+	m := &pb.Login{User: "gopher", Password: "hunter2"}
+	ho := &slog.HandlerOptions{ReplaceAttr: DefaultOptions.ReplaceAttr}
+	got := logJSON(t, ho, m)
+	want := map[string]interface{}{"user": "gopher", "password": Redacted}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("logged %v, want %v", got, want)
+	}
+}
+
+func TestText(t *testing.T) {
+	m := &pb.Login{
+		User:       "gopher",
+		Password:   "hunter2",
+		Delegate:   &pb.Login{User: "admin", Password: "root"},
+		Credential: &pb.Login_Otp{Otp: "123456"},
+	}
+	const want = `user:"gopher" password:*** delegate:<user:"admin" password:*** > otp:*** `
+	if got := Text(m); got != want {
+		t.Errorf("Text = %q\nwant %q", got, want)
+	}
+}
@@ -0,0 +1,270 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: test.proto
+
+/*
+Package logpb is a generated protocol buffer package.
+
+It is generated from these files:
+	test.proto
+
+It has these top-level messages:
+	Login
+*/
+package logpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Login_Method int32
+
+const (
+	Login_PASSWORD Login_Method = 0
+	Login_TOKEN    Login_Method = 1
+)
+
+var Login_Method_name = map[int32]string{
+	0: "PASSWORD",
+	1: "TOKEN",
+}
+var Login_Method_value = map[string]int32{
+	"PASSWORD": 0,
+	"TOKEN":    1,
+}
+
+func (x Login_Method) String() string {
+	return proto.EnumName(Login_Method_name, int32(x))
+}
+func (Login_Method) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+type Login struct {
+	User     string           `protobuf:"bytes,1,opt,name=user" json:"user,omitempty"`
+	Password string           `protobuf:"bytes,2,opt,name=password,sensitive" json:"password,omitempty"`
+	Method   Login_Method     `protobuf:"varint,3,opt,name=method,enum=logpb.Login_Method" json:"method,omitempty"`
+	Scopes   []string         `protobuf:"bytes,4,rep,name=scopes" json:"scopes,omitempty"`
+	Quotas   map[string]int32 `protobuf:"bytes,5,rep,name=quotas" json:"quotas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Avatar   []byte           `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Delegate *Login           `protobuf:"bytes,7,opt,name=delegate" json:"delegate,omitempty"`
+	// Types that are valid to be assigned to Credential:
+	//	*Login_Otp
+	//	*Login_KeyId
+	Credential    isLogin_Credential `protobuf_oneof:"credential"`
+	RecoveryCodes []string           `protobuf:"bytes,10,rep,name=recovery_codes,json=recoveryCodes,sensitive" json:"recovery_codes,omitempty"`
+	Pin           int32              `protobuf:"varint,11,opt,name=pin,sensitive" json:"pin,omitempty"`
+}
+
+func (m *Login) Reset()                    { *m = Login{} }
+func (m *Login) String() string            { return proto.CompactTextString(m) }
+func (*Login) ProtoMessage()               {}
+func (*Login) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type isLogin_Credential interface{ isLogin_Credential() }
+
+type Login_Otp struct {
+	Otp string `protobuf:"bytes,8,opt,name=otp,oneof,sensitive"`
+}
+type Login_KeyId struct {
+	KeyId int64 `protobuf:"varint,9,opt,name=key_id,json=keyId,oneof"`
+}
+
+func (*Login_Otp) isLogin_Credential()   {}
+func (*Login_KeyId) isLogin_Credential() {}
+
+func (m *Login) GetCredential() isLogin_Credential {
+	if m != nil {
+		return m.Credential
+	}
+	return nil
+}
+
+func (m *Login) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Login) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *Login) GetMethod() Login_Method {
+	if m != nil {
+		return m.Method
+	}
+	return Login_PASSWORD
+}
+
+func (m *Login) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *Login) GetQuotas() map[string]int32 {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+func (m *Login) GetAvatar() []byte {
+	if m != nil {
+		return m.Avatar
+	}
+	return nil
+}
+
+func (m *Login) GetDelegate() *Login {
+	if m != nil {
+		return m.Delegate
+	}
+	return nil
+}
+
+func (m *Login) GetOtp() string {
+	if x, ok := m.GetCredential().(*Login_Otp); ok {
+		return x.Otp
+	}
+	return ""
+}
+
+func (m *Login) GetKeyId() int64 {
+	if x, ok := m.GetCredential().(*Login_KeyId); ok {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (m *Login) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+func (m *Login) GetPin() int32 {
+	if m != nil {
+		return m.Pin
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Login) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Login_OneofMarshaler, _Login_OneofUnmarshaler, _Login_OneofSizer, []interface{}{
+		(*Login_Otp)(nil),
+		(*Login_KeyId)(nil),
+	}
+}
+
+func _Login_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Login)
+	// credential
+	switch x := m.Credential.(type) {
+	case *Login_Otp:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Otp)
+	case *Login_KeyId:
+		b.EncodeVarint(9<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.KeyId))
+	case nil:
+	default:
+		return fmt.Errorf("Login.Credential has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Login_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Login)
+	switch tag {
+	case 8: // credential.otp
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Credential = &Login_Otp{x}
+		return true, err
+	case 9: // credential.key_id
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Credential = &Login_KeyId{int64(x)}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Login_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Login)
+	// credential
+	switch x := m.Credential.(type) {
+	case *Login_Otp:
+		n += proto.SizeVarint(8<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Otp)))
+		n += len(x.Otp)
+	case *Login_KeyId:
+		n += proto.SizeVarint(9<<3 | proto.WireVarint)
+		n += proto.SizeVarint(uint64(x.KeyId))
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*Login)(nil), "logpb.Login")
+	proto.RegisterEnum("logpb.Login_Method", Login_Method_name, Login_Method_value)
+}
+
+func init() { proto.RegisterFile("test.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x5f, 0x6f, 0xd3, 0x30,
+	0x14, 0xc5, 0xeb, 0xb9, 0x0e, 0xc9, 0x4d, 0x98, 0x2a, 0x0f, 0x0d, 0xb3, 0x27, 0xb3, 0x27, 0x4b,
+	0x48, 0x01, 0x95, 0x17, 0xe0, 0x8d, 0xc1, 0xa4, 0x21, 0xfe, 0x0c, 0x3c, 0x24, 0x1e, 0x27, 0x37,
+	0xb9, 0x2a, 0x51, 0x43, 0x1c, 0x6c, 0xb7, 0x28, 0x6f, 0x7c, 0x26, 0x3e, 0x0e, 0x9f, 0x06, 0x25,
+	0x4d, 0x4b, 0xf7, 0x76, 0xef, 0xf9, 0xdd, 0x9c, 0xe8, 0x1c, 0x03, 0x04, 0xf4, 0x21, 0x6f, 0x9d,
+	0x0d, 0x96, 0xb3, 0xda, 0x2e, 0xdb, 0xc5, 0xd9, 0x49, 0x61, 0x5c, 0x63, 0x9f, 0xda, 0x36, 0x54,
+	0xb6, 0xf1, 0x5b, 0x76, 0xfe, 0x97, 0x02, 0xfb, 0x60, 0x97, 0x55, 0xc3, 0x39, 0x4c, 0xd7, 0x1e,
+	0x9d, 0x20, 0x92, 0xa8, 0x44, 0x0f, 0x33, 0x97, 0x10, 0xb7, 0xc6, 0xfb, 0x5f, 0xd6, 0x95, 0xe2,
+	0xa8, 0xd7, 0x2f, 0xa6, 0xbf, 0xff, 0x3c, 0x22, 0x7a, 0xaf, 0xf2, 0x27, 0x10, 0xfd, 0xc0, 0xf0,
+	0xdd, 0x96, 0x82, 0x4a, 0xa2, 0x8e, 0xe7, 0x27, 0xf9, 0xf0, 0xb3, 0x7c, 0xf0, 0xcc, 0x3f, 0x0e,
+	0x48, 0x8f, 0x27, 0xfc, 0x14, 0x22, 0x5f, 0xd8, 0x16, 0xbd, 0x98, 0x4a, 0xaa, 0x12, 0x3d, 0x6e,
+	0xfc, 0x19, 0x44, 0x3f, 0xd7, 0x36, 0x18, 0x2f, 0x98, 0xa4, 0x2a, 0x9d, 0x8b, 0x3b, 0x26, 0x5f,
+	0x06, 0x74, 0xd9, 0x04, 0xd7, 0xe9, 0xf1, 0xae, 0x77, 0x32, 0x1b, 0x13, 0x8c, 0x13, 0x91, 0x24,
+	0x2a, 0xd3, 0xe3, 0xc6, 0x15, 0xc4, 0x25, 0xd6, 0xb8, 0x34, 0x01, 0xc5, 0x3d, 0x49, 0x54, 0x3a,
+	0xcf, 0x0e, 0xbd, 0xf4, 0x9e, 0x72, 0x01, 0xd4, 0x86, 0x56, 0xc4, 0xff, 0x53, 0x5d, 0x4d, 0x74,
+	0x2f, 0xf1, 0x87, 0x10, 0xad, 0xb0, 0xbb, 0xad, 0x4a, 0x91, 0x48, 0xa2, 0xe8, 0xd5, 0x44, 0xb3,
+	0x15, 0x76, 0xef, 0xfa, 0xac, 0xc7, 0x0e, 0x0b, 0xbb, 0x41, 0xd7, 0xdd, 0x16, 0xb6, 0x44, 0x2f,
+	0x40, 0xd2, 0xdd, 0xd7, 0xfa, 0xfe, 0x8e, 0xbd, 0xe9, 0x11, 0x3f, 0x05, 0xda, 0x56, 0x8d, 0x48,
+	0x25, 0x51, 0x6c, 0xbc, 0xe8, 0x85, 0xb3, 0x97, 0x90, 0x1e, 0x04, 0xe2, 0x33, 0xa0, 0x2b, 0xec,
+	0xc6, 0xd2, 0xfb, 0x91, 0x3f, 0x00, 0xb6, 0x31, 0xf5, 0x1a, 0x87, 0xc2, 0x99, 0xde, 0x2e, 0xaf,
+	0x8e, 0x5e, 0x90, 0xf3, 0xc7, 0x10, 0x6d, 0x0b, 0xe5, 0x19, 0xc4, 0x9f, 0x5f, 0xdf, 0xdc, 0x7c,
+	0xbb, 0xd6, 0x6f, 0x67, 0x13, 0x9e, 0x00, 0xfb, 0x7a, 0xfd, 0xfe, 0xf2, 0xd3, 0x8c, 0x5c, 0x64,
+	0x00, 0x85, 0xc3, 0x12, 0x9b, 0x50, 0x99, 0x7a, 0x11, 0x0d, 0x6f, 0xfc, 0xfc, 0xdf, 0x00, 0xc1,
+	0xc5, 0x31, 0x44, 0x0d, 0x02, 0x00, 0x00,
+}
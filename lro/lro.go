@@ -0,0 +1,89 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package lro follows the long-running operations started by carno methods
+with the (carno.long_running) option, which return a message describing
+the operation at once and carry on with the work in the background. The
+carno plugin generates, for each service with such methods,
+
+	type <Service>Waiter struct {
+		Client <Service>Client
+	}
+
+	func (w *<Service>Waiter) <Method>AndWait(ctx context.Context, in *<Request>, pollInterval time.Duration, opts ...client.CallOption) (*<Result>, error)
+
+for them, which call the method, then call its poll method with Sleep
+between the calls until the operation is done, and return its result, or
+an *Error if it failed.
+*/
+package lro
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// DefaultInterval is the time Sleep waits when given no interval.
+const DefaultInterval = time.Second
+
+// Sleep waits for interval, or DefaultInterval if interval is not
+// positive, and returns nil, or the error of ctx if it is done first.
+func Sleep(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	t := time.NewTimer(interval)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// An Error reports an operation that is done but failed.
+type Error struct {
+	Method  string        // carno name of the method that started it, "pkg@Service/Method"
+	Message string        // the operation's error, if a string
+	Status  proto.Message // the operation's error, if a message
+}
+
+func (e *Error) Error() string {
+	msg := e.Message
+	if e.Status != nil {
+		msg = proto.CompactTextString(e.Status)
+	}
+	return "lro: operation of " + e.Method + " failed: " + msg
+}
@@ -0,0 +1,213 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package mmappb reads large files of encoded protocol buffers, such as
+streams of length-delimited messages and descriptor sets, through a
+read-only memory mapping instead of reading them into memory.
+
+Open maps a file. Its data can be split into messages with a Reader and
+their fields read with a FieldReader, neither of which copies: the messages
+and field values they return are slices of the mapping, so a tool can scan
+a multi-gigabyte dataset touching only the pages it needs.
+
+	f, err := mmappb.Open("events.bin")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	r := mmappb.NewReader(f.Bytes())
+	for {
+		msg, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		// Unmarshal msg, or read just the fields needed with a FieldReader.
+	}
+
+Messages unmarshaled from the data with proto.Unmarshal hold copies and can
+be used after the file is closed; slices of the data itself cannot.
+
+On systems without mmap, Open reads the whole file instead.
+*/
+package mmappb
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protowire"
+)
+
+// A File is a file mapped into memory for reading.
+type File struct {
+	data []byte
+}
+
+// Open maps the named file into memory, read-only.
+func Open(name string) (*File, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	data, err := mmap(f, fi.Size())
+	if err != nil {
+		return nil, &os.PathError{Op: "mmap", Path: name, Err: err}
+	}
+	return &File{data: data}, nil
+}
+
+// Bytes returns the contents of the file. They must not be modified, or
+// used after the file is closed.
+func (f *File) Bytes() []byte { return f.data }
+
+// Close unmaps the file.
+func (f *File) Close() error {
+	err := munmap(f.data)
+	f.data = nil
+	return err
+}
+
+// A Reader splits a stream of length-delimited messages, as written by
+// proto.Buffer's EncodeMessage, into the encoded messages.
+type Reader struct {
+	buf []byte
+}
+
+// NewReader returns a Reader for the messages in data.
+func NewReader(data []byte) *Reader {
+	return &Reader{buf: data}
+}
+
+// Next returns the next encoded message, which is a slice of the data given
+// to NewReader. It returns io.EOF when there are no more messages.
+func (r *Reader) Next() ([]byte, error) {
+	if len(r.buf) == 0 {
+		return nil, io.EOF
+	}
+	msg, n := protowire.ConsumeBytes(r.buf)
+	if n < 0 {
+		return nil, protowire.ParseError(n)
+	}
+	r.buf = r.buf[n:]
+	return msg, nil
+}
+
+// A Field is one field of an encoded message.
+type Field struct {
+	Number   int32
+	WireType int // proto.WireVarint, WireFixed64, WireBytes, WireStartGroup or WireFixed32.
+
+	// Value is the encoded value: the varint or fixed-size bytes, the
+	// contents of a length-delimited field, or the fields of a group.
+	// It is a slice of the message, not a copy.
+	Value []byte
+}
+
+// Uint64 returns the value of a varint or fixed-size field as raw bits.
+// Convert them to the field's type with int64 or int32, by undoing the
+// zigzag encoding of sint fields, or with math.Float64frombits or
+// math.Float32frombits.
+func (f Field) Uint64() (uint64, error) {
+	var x uint64
+	n := -1
+	switch f.WireType {
+	case proto.WireVarint:
+		x, n = protowire.ConsumeVarint(f.Value)
+	case proto.WireFixed64:
+		x, n = protowire.ConsumeFixed64(f.Value)
+	case proto.WireFixed32:
+		var x32 uint32
+		x32, n = protowire.ConsumeFixed32(f.Value)
+		x = uint64(x32)
+	default:
+		return 0, fmt.Errorf("mmappb: field %d has wire type %d, not a number", f.Number, f.WireType)
+	}
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	return x, nil
+}
+
+// A FieldReader reads the fields of an encoded message in order, without
+// copying them. Repeated fields appear once per element, or, if packed,
+// as a single length-delimited field.
+type FieldReader struct {
+	buf []byte
+}
+
+// NewFieldReader returns a FieldReader for the encoded message msg.
+func NewFieldReader(msg []byte) *FieldReader {
+	return &FieldReader{buf: msg}
+}
+
+// Next returns the next field. It returns io.EOF after the last field.
+// Groups nested too deeply to read without exhausting the stack are an
+// error.
+func (r *FieldReader) Next() (Field, error) {
+	if len(r.buf) == 0 {
+		return Field{}, io.EOF
+	}
+	num, typ, n := protowire.ConsumeTag(r.buf)
+	if n < 0 {
+		return Field{}, protowire.ParseError(n)
+	}
+	f := Field{Number: int32(num), WireType: int(typ)}
+	b := r.buf[n:]
+	switch typ {
+	case protowire.BytesType:
+		f.Value, n = protowire.ConsumeBytes(b)
+	case protowire.StartGroupType:
+		f.Value, n = protowire.ConsumeGroup(num, b)
+	case protowire.EndGroupType:
+		return Field{}, fmt.Errorf("mmappb: unexpected end of group %d", f.Number)
+	default:
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n >= 0 {
+			f.Value = b[:n]
+		}
+	}
+	if n < 0 {
+		return Field{}, protowire.ParseError(n)
+	}
+	r.buf = b[n:]
+	return f, nil
+}
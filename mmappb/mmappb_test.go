@@ -0,0 +1,195 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package mmappb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+func TestReadFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mmappb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var buf proto.Buffer
+	var want []*descriptor.FileDescriptorProto
+	for i := 0; i < 100; i++ {
+		fd := &descriptor.FileDescriptorProto{
+			Name:       proto.String(fmt.Sprintf("f%d.proto", i)),
+			Dependency: []string{"a.proto", "b.proto"},
+		}
+		want = append(want, fd)
+		if err := buf.EncodeMessage(fd); err != nil {
+			t.Fatal(err)
+		}
+	}
+	name := filepath.Join(dir, "files.bin")
+	if err := ioutil.WriteFile(name, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	data := f.Bytes()
+	r := NewReader(data)
+	for i := 0; ; i++ {
+		msg, err := r.Next()
+		if err == io.EOF {
+			if i != len(want) {
+				t.Errorf("read %d messages, want %d", i, len(want))
+			}
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := new(descriptor.FileDescriptorProto)
+		if err := proto.Unmarshal(msg, got); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(got, want[i]) {
+			t.Errorf("message %d = %v, want %v", i, got, want[i])
+		}
+
+		// Field values are slices of the mapping.
+		field, err := NewFieldReader(msg).Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(field.Value) != want[i].GetName() {
+			t.Errorf("message %d: first field = %q, want %q", i, field.Value, want[i].GetName())
+		}
+		if !within(field.Value, data) {
+			t.Errorf("message %d: field value is a copy", i)
+		}
+	}
+}
+
+// within reports whether b is a slice of data. Slices of data end at the
+// same capacity, so b starts cap(b) bytes before its end.
+func within(b, data []byte) bool {
+	data = data[:cap(data)]
+	return len(b) > 0 && cap(b) <= cap(data) && &b[0] == &data[cap(data)-cap(b)]
+}
+
+func TestEmptyFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "mmappb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	m, err := Open(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	if _, err := NewReader(m.Bytes()).Next(); err != io.EOF {
+		t.Errorf("Next = %v, want io.EOF", err)
+	}
+}
+
+func TestFieldReader(t *testing.T) {
+	b := []byte{
+		1<<3 | proto.WireVarint, 0x96, 0x01, // 150
+		2<<3 | proto.WireFixed64, 0, 0, 0, 0, 0, 0, 0xf8, 0x3f, // 1.5
+		3<<3 | proto.WireFixed32, 7, 0, 0, 0,
+		4<<3 | proto.WireStartGroup,
+		5<<3 | proto.WireBytes, 2, 'h', 'i',
+		4<<3 | proto.WireEndGroup,
+		6<<3 | proto.WireBytes, 0,
+	}
+	r := NewFieldReader(b)
+	var fields []Field
+	for {
+		f, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		fields = append(fields, f)
+	}
+	if len(fields) != 5 {
+		t.Fatalf("read %d fields, want 5: %v", len(fields), fields)
+	}
+	if x, err := fields[0].Uint64(); x != 150 || err != nil {
+		t.Errorf("varint = %d, %v; want 150", x, err)
+	}
+	if x, err := fields[1].Uint64(); math.Float64frombits(x) != 1.5 || err != nil {
+		t.Errorf("fixed64 = %v, %v; want 1.5", math.Float64frombits(x), err)
+	}
+	if x, err := fields[2].Uint64(); x != 7 || err != nil {
+		t.Errorf("fixed32 = %d, %v; want 7", x, err)
+	}
+	if f := fields[3]; f.Number != 4 || f.WireType != proto.WireStartGroup || string(f.Value) != "\x2a\x02hi" {
+		t.Errorf("group = %+v", f)
+	}
+	if f := fields[4]; f.Number != 6 || len(f.Value) != 0 {
+		t.Errorf("empty bytes = %+v", f)
+	}
+	if _, err := fields[4].Uint64(); err == nil {
+		t.Error("Uint64 of a bytes field succeeded")
+	}
+
+	for _, bad := range [][]byte{
+		{1<<3 | proto.WireBytes, 5, 'a'},
+		{1<<3 | proto.WireFixed32, 1},
+		{1<<3 | proto.WireVarint, 0x80},
+		{1<<3 | proto.WireStartGroup, 2<<3 | proto.WireEndGroup},
+		{1<<3 | proto.WireEndGroup},
+		{1<<3 | 6},
+		{0},
+		bytes.Repeat([]byte{1<<3 | proto.WireStartGroup}, 20000),
+	} {
+		if f, err := NewFieldReader(bad).Next(); err == nil {
+			t.Errorf("Next(%.20x) = %+v, want error", bad, f)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package oneway makes the calls of fire-and-forget methods, those with the
+(carno.oneway) option, without waiting for their responses, which are
+always empty. The generated client method of such a method calls Call,
+which queues the call and returns at once, and the call is made in the
+background, so telemetry and similar endpoints do not hold up their
+callers.
+
+A carno client that implements Caller, sending such calls without
+waiting itself, is left to do so.
+*/
+package oneway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+// MaxPending is the number of calls Call queues before it fails with
+// ErrQueueFull.
+const MaxPending = 1024
+
+// DefaultTimeout is the timeout of the calls Call makes in the background
+// for methods without a (carno.default_timeout), unless SetTimeout
+// changes it.
+const DefaultTimeout = 30 * time.Second
+
+// ErrQueueFull is returned by Call when MaxPending calls are already
+// queued, as when the servers are down or slow, rather than let them
+// pile up.
+var ErrQueueFull = errors.New("oneway: queue full")
+
+// A Caller is a carno client that sends oneway calls itself. CallOneway
+// must return once the call is queued, without waiting for the response.
+type Caller interface {
+	CallOneway(ctx context.Context, service, method string, in interface{}, opts ...client.CallOption) error
+}
+
+var pending = make(chan struct{}, MaxPending)
+
+var (
+	mu      sync.Mutex
+	onError = func(service, method string, err error) {
+		log.Printf("oneway: %s.%s: %v", service, method, err)
+	}
+	timeout = DefaultTimeout
+)
+
+// SetErrorHandler sets the function called with the errors of the calls
+// Call makes in the background. By default they are logged.
+func SetErrorHandler(f func(service, method string, err error)) {
+	mu.Lock()
+	onError = f
+	mu.Unlock()
+}
+
+// SetTimeout sets the timeout of the calls Call makes in the background
+// for methods without a (carno.default_timeout), so that calls to a
+// server that hangs do not keep their place in the queue forever.
+func SetTimeout(d time.Duration) {
+	mu.Lock()
+	timeout = d
+	mu.Unlock()
+}
+
+// Call calls the method with c, as c.Call does, but returns as soon as
+// the call is queued. It sends a copy of in, so the caller may reuse it.
+// The call has the values of ctx, such as its CallInfo, but not its
+// deadline, as the caller has moved on by the time it is made. It times
+// out instead after the DefaultTimeout of the method's CallInfo in ctx,
+// or the timeout set with SetTimeout if the method has none.
+func Call(ctx context.Context, c client.Client, service, method string, in proto.Message, opts ...client.CallOption) error {
+	if c, ok := c.(Caller); ok {
+		return c.CallOneway(ctx, service, method, in, opts...)
+	}
+	select {
+	case pending <- struct{}{}:
+	default:
+		return ErrQueueFull
+	}
+	in = proto.Clone(in)
+	mu.Lock()
+	d := timeout
+	mu.Unlock()
+	if info, ok := callinfo.FromContext(ctx); ok && info.DefaultTimeout > 0 {
+		d = info.DefaultTimeout
+	}
+	go func() {
+		defer func() { <-pending }()
+		ctx, cancel := context.WithTimeout(detached{ctx}, d)
+		defer cancel()
+		if err := c.Call(ctx, service, method, in, new(empty.Empty), opts...); err != nil {
+			mu.Lock()
+			f := onError
+			mu.Unlock()
+			f(service, method, err)
+		}
+	}()
+	return nil
+}
+
+// detached is a context with the values of ctx, but which is never done.
+type detached struct{ ctx context.Context }
+
+func (detached) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detached) Done() <-chan struct{}               { return nil }
+func (detached) Err() error                          { return nil }
+func (d detached) Value(key interface{}) interface{} { return d.ctx.Value(key) }
@@ -0,0 +1,217 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package oneway
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/callinfo"
+	pb "github.com/golang/protobuf/proto/proto3_proto"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+type key struct{}
+
+// call is a call a fakeClient received.
+type call struct {
+	ctx             context.Context
+	ctxErr          error // of ctx during the call
+	service, method string
+	in              *pb.Nested
+}
+
+// fakeClient stands in for the carno client. It sends each call to
+// calls once release is closed, and fails it with err.
+type fakeClient struct {
+	calls   chan call
+	release chan struct{}
+	err     error
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{calls: make(chan call, 1), release: make(chan struct{})}
+}
+
+func (c *fakeClient) Start() error { return nil }
+
+func (c *fakeClient) Call(ctx context.Context, service, method string, in, out interface{}, opts ...client.CallOption) error {
+	<-c.release
+	if _, ok := out.(*empty.Empty); !ok {
+		return errors.New("response is not empty")
+	}
+	c.calls <- call{ctx, ctx.Err(), service, method, in.(*pb.Nested)}
+	return c.err
+}
+
+func TestCall(t *testing.T) {
+	c := newFakeClient()
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "v"))
+	in := &pb.Nested{Bunny: "a"}
+	// Call returns before the call is made.
+	if err := Call(ctx, c, "Telemetry", "Report", in); err != nil {
+		t.Fatal(err)
+	}
+	in.Bunny = "b"
+	cancel()
+	close(c.release)
+
+	r := <-c.calls
+	if r.service != "Telemetry" || r.method != "Report" {
+		t.Errorf("called %s.%s, want Telemetry.Report", r.service, r.method)
+	}
+	if r.in.Bunny != "a" {
+		t.Errorf("request was changed to %q after Call returned", r.in.Bunny)
+	}
+	if r.ctx.Value(key{}) != "v" {
+		t.Error("the call lost the values of its context")
+	}
+	if r.ctxErr != nil {
+		t.Error("the call was canceled with the caller's context")
+	}
+	if _, ok := r.ctx.Deadline(); !ok {
+		t.Error("the call has no deadline")
+	}
+}
+
+// hangingClient stands in for a carno client whose server never answers:
+// its calls return only when their context is done.
+type hangingClient struct{ fakeClient }
+
+func (c *hangingClient) Call(ctx context.Context, service, method string, in, out interface{}, opts ...client.CallOption) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestCallTimeout(t *testing.T) {
+	// Let the calls of the other tests finish.
+	for len(pending) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	errs := make(chan error, 2)
+	SetErrorHandler(func(service, method string, err error) { errs <- err })
+	defer SetErrorHandler(func(service, method string, err error) {})
+	SetTimeout(10 * time.Millisecond)
+	defer SetTimeout(DefaultTimeout)
+
+	c := new(hangingClient)
+	if err := Call(context.Background(), c, "Telemetry", "Report", &pb.Nested{}); err != nil {
+		t.Fatal(err)
+	}
+	// The method's DefaultTimeout takes precedence.
+	SetTimeout(time.Hour)
+	ctx := callinfo.NewContext(context.Background(), &callinfo.CallInfo{DefaultTimeout: 10 * time.Millisecond})
+	if err := Call(ctx, c, "Telemetry", "Report", &pb.Nested{}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-errs:
+			if err != context.DeadlineExceeded {
+				t.Errorf("error handler got %v, want %v", err, context.DeadlineExceeded)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("the call did not time out")
+		}
+	}
+	// The calls gave up their places in the queue.
+	for len(pending) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCallErrors(t *testing.T) {
+	c := newFakeClient()
+	c.err = errors.New("unavailable")
+	errs := make(chan error, 1)
+	SetErrorHandler(func(service, method string, err error) { errs <- err })
+	defer SetErrorHandler(func(service, method string, err error) {})
+	close(c.release)
+
+	if err := Call(context.Background(), c, "Telemetry", "Report", &pb.Nested{}); err != nil {
+		t.Fatal(err)
+	}
+	<-c.calls
+	select {
+	case err := <-errs:
+		if err != c.err {
+			t.Errorf("error handler got %v, want %v", err, c.err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("error handler was not called")
+	}
+}
+
+func TestCallQueueFull(t *testing.T) {
+	// Let the calls of the other tests finish.
+	for len(pending) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	c := newFakeClient()
+	c.calls = make(chan call, MaxPending)
+	for i := 0; i < MaxPending; i++ {
+		if err := Call(context.Background(), c, "Telemetry", "Report", &pb.Nested{}); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+	if err := Call(context.Background(), c, "Telemetry", "Report", &pb.Nested{}); err != ErrQueueFull {
+		t.Errorf("call %d: got %v, want ErrQueueFull", MaxPending, err)
+	}
+	close(c.release)
+	for i := 0; i < MaxPending; i++ {
+		<-c.calls
+	}
+}
+
+// onewayClient sends oneway calls itself.
+type onewayClient struct {
+	*fakeClient
+	oneway int
+}
+
+func (c *onewayClient) CallOneway(ctx context.Context, service, method string, in interface{}, opts ...client.CallOption) error {
+	c.oneway++
+	return nil
+}
+
+func TestCaller(t *testing.T) {
+	c := &onewayClient{fakeClient: newFakeClient()}
+	if err := Call(context.Background(), c, "Telemetry", "Report", &pb.Nested{}); err != nil {
+		t.Fatal(err)
+	}
+	if c.oneway != 1 {
+		t.Errorf("CallOneway was called %d times, want 1", c.oneway)
+	}
+}
@@ -45,6 +45,7 @@ import (
 	"time"
 
 	. "github.com/golang/protobuf/proto"
+	pb3 "github.com/golang/protobuf/proto/proto3_proto"
 	. "github.com/golang/protobuf/proto/testdata"
 )
 
@@ -479,9 +480,131 @@ func TestMarshalerEncoding(t *testing.T) {
 			t.Errorf("%s: Marshal err = %q, but (*Buffer).Marshal returned %q",
 				test.name, fmt.Sprint(mErr), fmt.Sprint(err))
 		}
+
+		a, aErr := MarshalOptions{}.MarshalAppend([]byte{9}, test.m)
+		if !bytes.Equal(a, append([]byte{9}, m...)) || !reflect.DeepEqual(aErr, mErr) {
+			t.Errorf("%s: MarshalAppend = %v, %v; want 9 followed by %v, %v", test.name, a, aErr, m, mErr)
+		}
+	}
+}
+
+func TestMarshalAppend(t *testing.T) {
+	pb := initGoTest(true)
+	want, err := Marshal(pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 0, 2*len(want)+3)
+	buf = append(buf, "abc"...)
+	got, err := MarshalOptions{}.MarshalAppend(buf, pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got[:3]) != "abc" || !bytes.Equal(got[3:], want) {
+		t.Errorf("MarshalAppend = %v, want abc followed by %v", got, want)
+	}
+	if &got[0] != &buf[:1][0] {
+		t.Error("MarshalAppend allocated a new slice despite spare capacity")
+	}
+
+	// The Buffer comes from a pool, which may drop it, as it does under
+	// the race detector; the encoding itself allocates nothing.
+	allocs := testing.AllocsPerRun(100, func() {
+		MarshalOptions{}.MarshalAppend(buf[:0], pb)
+	})
+	if allocs > 1 {
+		t.Errorf("MarshalAppend made %v allocations, want at most 1", allocs)
+	}
+
+	if _, err := (MarshalOptions{}).Marshal(&GoTestField{}); reflect.TypeOf(err) != reflect.TypeOf(&RequiredNotSetError{}) {
+		t.Errorf("Marshal(&GoTestField{}) err = %v, want *RequiredNotSetError", err)
+	}
+	if got, err := (MarshalOptions{}).Marshal(&Empty{}); err != nil || got == nil {
+		t.Errorf("Marshal of an empty message = %v, %v; want an empty, non-nil slice", got, err)
+	}
+}
+
+func TestMarshalToSizedBuffer(t *testing.T) {
+	pb := initGoTest(true)
+	want, err := Marshal(pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Messages without the generated methods are marshaled and copied.
+	b := make([]byte, len(want)+3)
+	n, err := MarshalToSizedBuffer(b, pb)
+	if err != nil || n != len(want) || !bytes.Equal(b[3:], want) {
+		t.Errorf("MarshalToSizedBuffer = %d, %v, with %v; want %d, nil, with %v", n, err, b[3:], len(want), want)
+	}
+	if _, err := MarshalToSizedBuffer(b[:len(want)-1], pb); err == nil {
+		t.Error("MarshalToSizedBuffer into a short buffer succeeded")
+	}
+}
+
+func TestPrependVarint(t *testing.T) {
+	for _, x := range []uint64{0, 1, 127, 128, 300, 1<<63 - 1, 1<<64 - 1} {
+		b := make([]byte, 12)
+		i := PrependVarint(b, len(b)-1, x)
+		if want := EncodeVarint(x); !bytes.Equal(b[i:len(b)-1], want) || b[len(b)-1] != 0 {
+			t.Errorf("PrependVarint(%d) wrote %v, want %v", x, b[i:], want)
+		}
 	}
 }
 
+func TestConsume(t *testing.T) {
+	// A varint field, then a group holding a fixed32 and a nested empty
+	// group, then a bytes field.
+	b := []byte{0x08, 0x96, 0x01, 0x13, 0x1d, 1, 2, 3, 4, 0x23, 0x24, 0x14, 0x2a, 2, 'h', 'i'}
+	var nums []int32
+	for i := 0; i < len(b); {
+		num, wire, n, err := ConsumeTag(b[i:])
+		if err != nil {
+			t.Fatalf("ConsumeTag at %d: %v", i, err)
+		}
+		i += n
+		if n, err = ConsumeField(b[i:], wire); err != nil {
+			t.Fatalf("ConsumeField of field %d: %v", num, err)
+		}
+		i += n
+		nums = append(nums, num)
+	}
+	if want := []int32{1, 2, 5}; !reflect.DeepEqual(nums, want) {
+		t.Errorf("consumed fields %v, want %v", nums, want)
+	}
+	if s, n, err := ConsumeBytes(b[13:]); string(s) != "hi" || n != 3 || err != nil {
+		t.Errorf("ConsumeBytes = %q, %d, %v; want \"hi\", 3, nil", s, n, err)
+	}
+
+	deep := bytes.Repeat([]byte{0x0b}, DefaultMaxDepth+1)
+	if _, err := ConsumeField(deep, WireStartGroup); err != ErrTooDeep {
+		t.Errorf("ConsumeField of groups nested too deeply = %v, want %v", err, ErrTooDeep)
+	}
+
+	for _, test := range []struct {
+		name string
+		err  error
+	}{
+		{"truncated varint", consumeErr(ConsumeVarint([]byte{0x80}))},
+		{"overflowing varint", consumeErr(ConsumeVarint(bytes.Repeat([]byte{0xff}, 11)))},
+		{"truncated fixed32", consumeErr32(ConsumeFixed32([]byte{1, 2, 3}))},
+		{"truncated fixed64", consumeErr(ConsumeFixed64([]byte{1, 2, 3, 4, 5, 6, 7}))},
+		{"truncated bytes", consumeErrBytes(ConsumeBytes([]byte{3, 'h', 'i'}))},
+		{"field number 0", consumeErrTag(ConsumeTag([]byte{0x02}))},
+		{"unterminated group", consumeErrInt(ConsumeField([]byte{0x08, 1}, WireStartGroup))},
+		{"end group", consumeErrInt(ConsumeField(nil, WireEndGroup))},
+	} {
+		if test.err == nil {
+			t.Errorf("%s: no error", test.name)
+		}
+	}
+}
+
+func consumeErr(x uint64, n int, err error) error           { return err }
+func consumeErr32(x uint32, n int, err error) error         { return err }
+func consumeErrBytes(b []byte, n int, err error) error      { return err }
+func consumeErrTag(num int32, wire, n int, err error) error { return err }
+func consumeErrInt(n int, err error) error                  { return err }
+
 // Simple tests for bytes
 func TestBytesPrimitives(t *testing.T) {
 	o := old()
@@ -1077,6 +1200,140 @@ func TestSubmessageUnrecognizedFields(t *testing.T) {
 	}
 }
 
+func TestUnmarshalDiscardUnknown(t *testing.T) {
+	nm := &NewMessage{
+		Nested: &NewMessage_Nested{
+			Name:      String("Nigel"),
+			FoodGroup: String("carbs"),
+		},
+	}
+	b, err := Marshal(nm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	om := new(OldMessage)
+	if err := (UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, om); err != nil {
+		t.Fatal(err)
+	}
+	exp := &OldMessage{Nested: &OldMessage_Nested{Name: String("Nigel")}}
+	if !Equal(om, exp) || om.Nested.XXX_unrecognized != nil {
+		t.Errorf("om = %v, want %v", om, exp)
+	}
+
+	// Messages in oneofs are decoded by generated code, with the same options.
+	f, err := Marshal(&GoTestField{Label: String("l"), Type: String("t")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f = append(f, 0xc0, 0x3e, 0x01) // field 1000, varint 1
+	b = append([]byte{15<<3 | WireBytes, byte(len(f))}, f...)
+	for _, discard := range []bool{false, true} {
+		m := new(Oneof)
+		if err := (UnmarshalOptions{DiscardUnknown: discard}).Unmarshal(b, m); err != nil {
+			t.Fatal(err)
+		}
+		unrec := m.GetF_Message().XXX_unrecognized
+		if discard != (unrec == nil) {
+			t.Errorf("DiscardUnknown: %v: oneof message has unrecognized fields %x", discard, unrec)
+		}
+	}
+}
+
+func TestUnmarshalMaxDepth(t *testing.T) {
+	// Messages nested 10 deep, counting the outermost.
+	m := &pb3.Message{Name: "1"}
+	for i := 0; i < 9; i++ {
+		m = &pb3.Message{Submessage: m}
+	}
+	msgs, err := Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Groups nested 5 deep in an unrecognized field of a message.
+	groups := EncodeVarint(uint64(1<<3 | WireBytes))
+	groups = append(groups, 1, 'l', 2<<3|WireBytes, 1, 't')
+	for i := 0; i < 5; i++ {
+		groups = append(groups, EncodeVarint(uint64(1000<<3|WireStartGroup))...)
+	}
+	for i := 0; i < 5; i++ {
+		groups = append(groups, EncodeVarint(uint64(1000<<3|WireEndGroup))...)
+	}
+
+	for _, test := range []struct {
+		b        []byte
+		pb       Message
+		maxDepth int
+		err      error
+	}{
+		{msgs, new(pb3.Message), 0, nil},
+		{msgs, new(pb3.Message), 10, nil},
+		{msgs, new(pb3.Message), 9, ErrTooDeep},
+		{msgs, new(pb3.Message), 1, ErrTooDeep},
+		{groups, new(GoTestField), 0, nil},
+		{groups, new(GoTestField), 6, nil},
+		{groups, new(GoTestField), 5, ErrTooDeep},
+		{bytes.Repeat(EncodeVarint(uint64(1000<<3|WireStartGroup)), DefaultMaxDepth), new(GoTestField), 0, ErrTooDeep},
+	} {
+		err := UnmarshalOptions{MaxDepth: test.maxDepth}.Unmarshal(test.b, test.pb)
+		if err != test.err {
+			t.Errorf("%T with MaxDepth %d: got error %v, want %v", test.pb, test.maxDepth, err, test.err)
+		}
+	}
+	got := new(pb3.Message)
+	if err := (UnmarshalOptions{MaxDepth: 10}).Unmarshal(msgs, got); err != nil || !Equal(got, m) {
+		t.Errorf("Unmarshal with MaxDepth 10 = %v, %v; want %v", got, err, m)
+	}
+}
+
+func TestUnmarshalWithLimit(t *testing.T) {
+	// Ten fields, one in each of ten nested messages.
+	m := &pb3.Message{Name: "1"}
+	for i := 0; i < 9; i++ {
+		m = &pb3.Message{Submessage: m}
+	}
+	msgs, err := Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Three fields, two of them in a message in a oneof.
+	oneof, err := Marshal(&Communique{Union: &Communique_Msg{Msg: &Strings{StringField: String("s"), BytesField: []byte("b")}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Three fields, two of them in an unrecognized group.
+	groups := EncodeVarint(uint64(1000<<3 | WireStartGroup))
+	groups = append(groups, 1<<3|WireVarint, 1, 2<<3|WireVarint, 2)
+	groups = append(groups, EncodeVarint(uint64(1000<<3|WireEndGroup))...)
+
+	for _, test := range []struct {
+		b                   []byte
+		pb                  Message
+		maxBytes, maxFields int
+		err                 error
+	}{
+		{msgs, new(pb3.Message), 0, 0, nil},
+		{msgs, new(pb3.Message), len(msgs), 10, nil},
+		{msgs, new(pb3.Message), len(msgs) - 1, 0, ErrInputTooLarge},
+		{msgs, new(pb3.Message), 0, 9, ErrTooManyFields},
+		{oneof, new(Communique), 0, 3, nil},
+		{oneof, new(Communique), 0, 2, ErrTooManyFields},
+		{groups, new(GoTestField), 0, 3, nil},
+		{groups, new(GoTestField), 0, 2, ErrTooManyFields},
+	} {
+		err := UnmarshalWithLimit(test.b, test.pb, test.maxBytes, test.maxFields)
+		if _, ok := err.(*RequiredNotSetError); ok {
+			err = nil
+		}
+		if err != test.err {
+			t.Errorf("%T with limits %d, %d: got error %v, want %v", test.pb, test.maxBytes, test.maxFields, err, test.err)
+		}
+	}
+	got := new(pb3.Message)
+	if err := UnmarshalWithLimit(msgs, got, len(msgs), 10); err != nil || !Equal(got, m) {
+		t.Errorf("UnmarshalWithLimit = %v, %v; want %v", got, err, m)
+	}
+}
+
 // Check that an int32 field can be upgraded to an int64 field.
 func TestNegativeInt32(t *testing.T) {
 	om := &OldMessage{
@@ -2218,6 +2475,15 @@ func BenchmarkBufferMarshal(b *testing.B) {
 	benchmarkBufferMarshal(b, testMsg())
 }
 
+func BenchmarkMarshalAppend(b *testing.B) {
+	var buf []byte
+	benchmarkMarshal(b, testMsg(), func(pb Message) ([]byte, error) {
+		var err error
+		buf, err = MarshalOptions{}.MarshalAppend(buf[:0], pb)
+		return buf, err
+	})
+}
+
 func BenchmarkSize(b *testing.B) {
 	benchmarkSize(b, testMsg())
 }
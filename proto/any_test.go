@@ -279,7 +279,7 @@ func TestUnmarshalOverwriteAny(t *testing.T) {
     bunny: "Rabbit of Caerbannog"
   >
 	`, pb)
-	want := `line 7: Any message unpacked multiple times, or "type_url" already set`
+	want := `line 7.2: Any message unpacked multiple times, or "type_url" already set`
 	if err.Error() != want {
 		t.Errorf("incorrect error.\nHave: %v\nWant: %v", err.Error(), want)
 	}
@@ -293,7 +293,7 @@ func TestUnmarshalAnyMixAndMatch(t *testing.T) {
     bunny: "Rabbit of Caerbannog"
   >
 	`, pb)
-	want := `line 5: Any message unpacked multiple times, or "value" already set`
+	want := `line 5.2: Any message unpacked multiple times, or "value" already set`
 	if err.Error() != want {
 		t.Errorf("incorrect error.\nHave: %v\nWant: %v", err.Error(), want)
 	}
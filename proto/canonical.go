@@ -0,0 +1,93 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Canonical encoding of messages, for hashing.
+
+package proto
+
+import (
+	"fmt"
+	"hash"
+)
+
+// A Canonicalizer marshals messages so that equal messages always give
+// the same bytes: fields in field number order, map entries and extensions
+// sorted by key, and, unless IncludeUnknown is set, no unrecognized fields.
+// Features that key on message contents, such as caches, deduplication and
+// idempotency keys, should all use it so that they agree.
+//
+// The encoding is canonical within a version of this package. Messages
+// generated with plugins=fastpath are marshaled by reflection; other
+// messages nested in a message that implement Marshaler marshal
+// themselves, and their encoding is only as stable as their Marshal
+// method.
+type Canonicalizer struct {
+	// IncludeUnknown keeps unrecognized fields, so that messages that
+	// differ only in fields unknown to this binary hash differently.
+	IncludeUnknown bool
+}
+
+// Marshal returns the canonical encoding of pb. It is an error for pb to
+// implement Marshaler, unless it was generated with plugins=fastpath.
+func (c *Canonicalizer) Marshal(pb Message) ([]byte, error) {
+	if _, ok := pb.(Marshaler); ok {
+		if _, ok := pb.(fastpathMessage); !ok {
+			return nil, fmt.Errorf("proto: %T implements Marshaler and has no canonical encoding", pb)
+		}
+	}
+	p := NewBuffer(nil)
+	p.deterministic = true
+	p.discardUnknown = !c.IncludeUnknown
+	if err := p.Marshal(pb); err != nil {
+		return nil, err
+	}
+	if p.buf == nil {
+		return []byte{}, nil
+	}
+	return p.buf, nil
+}
+
+// Hash writes the canonical encoding of pb to h and returns h.Sum(nil).
+// To reuse h for another message, reset it first.
+func (c *Canonicalizer) Hash(pb Message, h hash.Hash) ([]byte, error) {
+	b, err := c.Marshal(pb)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(b)
+	return h.Sum(nil), nil
+}
+
+// HashCanonical writes the canonical encoding of pb, without unrecognized
+// fields, to h and returns h.Sum(nil). See Canonicalizer.
+func HashCanonical(pb Message, h hash.Hash) ([]byte, error) {
+	return new(Canonicalizer).Hash(pb, h)
+}
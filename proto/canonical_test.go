@@ -0,0 +1,164 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	. "github.com/golang/protobuf/proto/testdata"
+)
+
+// unknown is an encoded field 1000 of type varint, value 1, which none of
+// the test messages declares.
+var unknown = []byte{0xc0, 0x3e, 0x01}
+
+func TestCanonicalMapOrder(t *testing.T) {
+	m := &MessageWithMap{NameMapping: map[int32]string{2: "b", 1: "a", -1: "z"}}
+	want := []byte{
+		0x0a, 0x0e, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x01, 'z',
+		0x0a, 0x05, 0x08, 0x01, 0x12, 0x01, 'a',
+		0x0a, 0x05, 0x08, 0x02, 0x12, 0x01, 'b',
+	}
+	got, err := new(Canonicalizer).Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Marshal = %x, want %x", got, want)
+	}
+	buf := NewBuffer(nil)
+	buf.SetDeterministic(true)
+	if err := buf.Marshal(m); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("deterministic Buffer.Marshal = %x, want %x", buf.Bytes(), want)
+	}
+	got, err = MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("deterministic MarshalOptions.Marshal = %x, want %x", got, want)
+	}
+
+	// Map iteration order is random; the encoding must not be.
+	big := &MessageWithMap{StrToStr: make(map[string]string)}
+	for _, k := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		big.StrToStr[k] = k + k
+	}
+	first, err := new(Canonicalizer).Marshal(big)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 20; i++ {
+		b, err := new(Canonicalizer).Marshal(big)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b, first) {
+			t.Fatalf("encodings differ:\n%x\n%x", b, first)
+		}
+		b, err = MarshalOptions{Deterministic: true}.MarshalAppend(b[:0], big)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b, first) {
+			t.Fatalf("deterministic MarshalAppend differs:\n%x\n%x", b, first)
+		}
+	}
+}
+
+func TestCanonicalUnknownFields(t *testing.T) {
+	plain := &MyMessage{Count: Int32(1), Inner: &InnerMessage{Host: String("h")}}
+	withUnknown := Clone(plain).(*MyMessage)
+	withUnknown.XXX_unrecognized = unknown
+	withUnknown.Inner.XXX_unrecognized = unknown
+
+	h1, err := HashCanonical(plain, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, err := HashCanonical(withUnknown, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(h1, h2) {
+		t.Error("unknown fields changed the hash")
+	}
+
+	c := &Canonicalizer{IncludeUnknown: true}
+	h3, err := c.Hash(withUnknown, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(h1, h3) {
+		t.Error("unknown fields did not change the hash with IncludeUnknown")
+	}
+}
+
+func TestCanonicalExtensions(t *testing.T) {
+	m := &MyMessage{Count: Int32(1)}
+	if err := SetExtension(m, E_Ext_More, &Ext{Data: String("x"), XXX_unrecognized: unknown}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetExtension(m, E_Ext_Number, Int32(7)); err != nil {
+		t.Fatal(err)
+	}
+	plain := &MyMessage{Count: Int32(1)}
+	SetExtension(plain, E_Ext_Number, Int32(7))
+	SetExtension(plain, E_Ext_More, &Ext{Data: String("x")})
+
+	got, err := new(Canonicalizer).Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := Marshal(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Marshal = %x, want %x", got, want)
+	}
+
+	// Ordinary marshaling still keeps the unknown fields of extensions.
+	b, err := Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(b, unknown) {
+		t.Errorf("Marshal after canonical marshaling = %x, lost unknown field %x", b, unknown)
+	}
+}
@@ -41,11 +41,30 @@ import (
 	"io"
 	"os"
 	"reflect"
+
+	"github.com/golang/protobuf/protowire"
 )
 
 // errOverflow is returned when an integer is too large to be represented.
 var errOverflow = errors.New("proto: integer overflow")
 
+// ErrTooDeep is returned when a message is nested more deeply than the
+// MaxDepth of its UnmarshalOptions, or DefaultMaxDepth, allows.
+var ErrTooDeep = errors.New("proto: message nested too deeply")
+
+// DefaultMaxDepth is the deepest nesting of messages and groups that
+// unmarshaling allows when no MaxDepth is set, so that maliciously deep
+// input fails with ErrTooDeep instead of exhausting the stack.
+const DefaultMaxDepth = 10000
+
+// ErrInputTooLarge is returned when the input is longer than the
+// MaxBytes of its UnmarshalOptions allows.
+var ErrInputTooLarge = errors.New("proto: input too large to unmarshal")
+
+// ErrTooManyFields is returned when the input holds more fields than the
+// MaxFields of its UnmarshalOptions allows.
+var ErrTooManyFields = errors.New("proto: message has too many fields")
+
 // ErrInternalBadWireType is returned by generated code when an incorrect
 // wire type is encountered. It does not get returned to user code.
 var ErrInternalBadWireType = errors.New("proto: internal error: bad wiretype for oneof")
@@ -77,6 +96,91 @@ func DecodeVarint(buf []byte) (x uint64, n int) {
 	return 0, 0
 }
 
+// The Consume functions decode a value at the start of a slice, returning
+// it and the number of bytes consumed. Code generated with plugins=fastpath
+// unmarshals messages with them. They parse with package protowire.
+
+// wireError returns the error for n, a negative length returned by a
+// protowire Consume function.
+func wireError(n int) error {
+	if err := protowire.ParseError(n); err != protowire.ErrTooDeep {
+		return err
+	}
+	return ErrTooDeep
+}
+
+// ConsumeVarint decodes a varint-encoded integer.
+func ConsumeVarint(buf []byte) (x uint64, n int, err error) {
+	if x, n = protowire.ConsumeVarint(buf); n < 0 {
+		return 0, 0, wireError(n)
+	}
+	return x, n, nil
+}
+
+// ConsumeTag decodes the key of a field: its number and wire type.
+func ConsumeTag(buf []byte) (num int32, wire int, n int, err error) {
+	tag, typ, n := protowire.ConsumeTag(buf)
+	if n < 0 {
+		return 0, 0, 0, wireError(n)
+	}
+	return int32(tag), int(typ), n, nil
+}
+
+// ConsumeFixed32 decodes a 32-bit little-endian integer.
+func ConsumeFixed32(buf []byte) (x uint32, n int, err error) {
+	if x, n = protowire.ConsumeFixed32(buf); n < 0 {
+		return 0, 0, wireError(n)
+	}
+	return x, n, nil
+}
+
+// ConsumeFixed64 decodes a 64-bit little-endian integer.
+func ConsumeFixed64(buf []byte) (x uint64, n int, err error) {
+	if x, n = protowire.ConsumeFixed64(buf); n < 0 {
+		return 0, 0, wireError(n)
+	}
+	return x, n, nil
+}
+
+// ConsumeBytes decodes a count-delimited byte buffer, returning a
+// subslice of buf.
+func ConsumeBytes(buf []byte) (b []byte, n int, err error) {
+	if b, n = protowire.ConsumeBytes(buf); n < 0 {
+		return nil, 0, wireError(n)
+	}
+	return b, n, nil
+}
+
+// ConsumeField skips the value of a field with the given wire type,
+// returning the number of bytes it took. A group is skipped up to and
+// including its end-group key; groups nested too deeply to skip without
+// exhausting the stack fail with ErrTooDeep.
+func ConsumeField(buf []byte, wire int) (n int, err error) {
+	if wire != WireStartGroup {
+		// Only groups need the field number, to match their end.
+		if n = protowire.ConsumeFieldValue(0, protowire.Type(wire), buf); n < 0 {
+			return 0, wireError(n)
+		}
+		return n, nil
+	}
+	// The group's number is in its start key, before buf; protowire
+	// matches those of the groups nested in it.
+	for {
+		num, typ, m := protowire.ConsumeTag(buf[n:])
+		if m < 0 {
+			return 0, wireError(m)
+		}
+		n += m
+		if typ == protowire.EndGroupType {
+			return n, nil
+		}
+		if m = protowire.ConsumeFieldValue(num, typ, buf[n:]); m < 0 {
+			return 0, wireError(m)
+		}
+		n += m
+	}
+}
+
 func (p *Buffer) decodeVarintSlow() (x uint64, err error) {
 	i := p.index
 	l := len(p.buf)
@@ -322,7 +426,7 @@ func (o *Buffer) skipAndSave(t reflect.Type, tag, wire int, base structPointer,
 		return err
 	}
 
-	if !unrecField.IsValid() {
+	if !unrecField.IsValid() || o.discardUnknown {
 		return nil
 	}
 
@@ -356,6 +460,11 @@ func (o *Buffer) skip(t reflect.Type, tag, wire int) error {
 	case WireFixed32:
 		_, err = o.DecodeFixed32()
 	case WireStartGroup:
+		if o.depth >= o.depthLimit() {
+			return ErrTooDeep
+		}
+		o.depth++
+		defer func() { o.depth-- }()
 		for {
 			u, err = o.DecodeVarint()
 			if err != nil {
@@ -365,6 +474,9 @@ func (o *Buffer) skip(t reflect.Type, tag, wire int) error {
 			if fwire == WireEndGroup {
 				break
 			}
+			if err = o.countField(); err != nil {
+				break
+			}
 			ftag := int(u >> 3)
 			err = o.skip(t, ftag, fwire)
 			if err != nil {
@@ -379,13 +491,40 @@ func (o *Buffer) skip(t reflect.Type, tag, wire int) error {
 
 // Unmarshaler is the interface representing objects that can
 // unmarshal themselves.  The method should reset the receiver before
-// decoding starts.  The argument points to data that may be
-// overwritten, so implementations should not keep references to the
-// buffer.
+// decoding starts, or else merge into it, as those generated with
+// plugins=fastpath do; Unmarshal resets the receiver itself before
+// calling the method, so only UnmarshalMerge tells them apart.  The
+// argument points to data that may be overwritten, so implementations
+// should not keep references to the buffer.
 type Unmarshaler interface {
 	Unmarshal([]byte) error
 }
 
+// depthUnmarshaler is implemented by messages generated with
+// plugins=fastpath, and by dynamic messages, which unmarshal themselves and
+// the messages nested in them with no more than maxDepth levels of nesting,
+// counting themselves.
+type depthUnmarshaler interface {
+	XXX_UnmarshalDepth(buf []byte, maxDepth int) error
+}
+
+// depthLimit returns the deepest nesting the Buffer unmarshals.
+func (o *Buffer) depthLimit() int {
+	if o.maxDepth > 0 {
+		return o.maxDepth
+	}
+	return DefaultMaxDepth
+}
+
+// unmarshalSelf lets u, nested in the message being unmarshaled from the
+// Buffer, unmarshal itself from buf, within the nesting left.
+func (o *Buffer) unmarshalSelf(u Unmarshaler, buf []byte) error {
+	if d, ok := u.(depthUnmarshaler); ok {
+		return d.XXX_UnmarshalDepth(buf, o.depthLimit()-o.depth)
+	}
+	return u.Unmarshal(buf)
+}
+
 // Unmarshal parses the protocol buffer representation in buf and places the
 // decoded result in pb.  If the struct underlying pb does not match
 // the data in buf, the results can be unpredictable.
@@ -398,6 +537,77 @@ func Unmarshal(buf []byte, pb Message) error {
 	return UnmarshalMerge(buf, pb)
 }
 
+// UnmarshalOptions configures an unmarshaler. The zero value unmarshals
+// as Unmarshal does. Messages that implement Unmarshaler, at the top level
+// or nested, unmarshal themselves regardless of the options other than
+// MaxBytes and, for those generated with plugins=fastpath, MaxDepth; and
+// extensions are decoded without them when GetExtension first reads them.
+type UnmarshalOptions struct {
+	// DiscardUnknown drops fields the message does not declare instead of
+	// keeping them in XXX_unrecognized.
+	DiscardUnknown bool
+
+	// MaxDepth, if positive, is the deepest nesting of messages and groups
+	// allowed, counting the message being unmarshaled as 1; otherwise it
+	// is DefaultMaxDepth. Deeper input fails with ErrTooDeep, so that
+	// servers can reject maliciously deep messages before decoding them
+	// exhausts the stack.
+	MaxDepth int
+
+	// MaxBytes, if positive, is the longest input allowed. Longer input
+	// fails with ErrInputTooLarge before any of it is decoded.
+	MaxBytes int
+
+	// MaxFields, if positive, is the most fields allowed in the input,
+	// counting each field of the message and of every message nested in
+	// it, and each element of a repeated field that is not packed. Input
+	// with more fails with ErrTooManyFields, so that a small request
+	// cannot make a server allocate a huge message.
+	MaxFields int
+}
+
+// Unmarshal is like the package's Unmarshal, with the options o.
+func (o UnmarshalOptions) Unmarshal(buf []byte, pb Message) error {
+	pb.Reset()
+	return o.UnmarshalMerge(buf, pb)
+}
+
+// UnmarshalMerge is like the package's UnmarshalMerge, with the options o.
+func (o UnmarshalOptions) UnmarshalMerge(buf []byte, pb Message) error {
+	if o.MaxBytes > 0 && len(buf) > o.MaxBytes {
+		return ErrInputTooLarge
+	}
+	p := NewBuffer(buf)
+	p.discardUnknown = o.DiscardUnknown
+	p.maxDepth = o.MaxDepth
+	p.maxFields = o.MaxFields
+	return p.Unmarshal(pb)
+}
+
+// UnmarshalMergeDepth is like UnmarshalMerge, but fails with ErrTooDeep if
+// pb and the messages and groups nested in it are more than maxDepth deep,
+// counting pb as 1. Code generated with plugins=fastpath unmarshals nested
+// messages with it.
+func UnmarshalMergeDepth(buf []byte, pb Message, maxDepth int) error {
+	if maxDepth <= 0 {
+		return ErrTooDeep
+	}
+	p := NewBuffer(buf)
+	p.maxDepth = maxDepth
+	return p.Unmarshal(pb)
+}
+
+// UnmarshalWithLimit is like Unmarshal, but fails with ErrInputTooLarge if buf
+// is longer than maxBytes, and with ErrTooManyFields if it holds more than
+// maxFields fields; see UnmarshalOptions. A limit that is not positive is
+// not enforced.
+//
+// Messages that implement Unmarshaler get only the byte limit, since they
+// decode their fields themselves.
+func UnmarshalWithLimit(buf []byte, pb Message, maxBytes, maxFields int) error {
+	return UnmarshalOptions{MaxBytes: maxBytes, MaxFields: maxFields}.Unmarshal(buf, pb)
+}
+
 // UnmarshalMerge parses the protocol buffer representation in buf and
 // writes the decoded result to pb.  If the struct underlying pb does not match
 // the data in buf, the results can be unpredictable.
@@ -418,7 +628,27 @@ func (p *Buffer) DecodeMessage(pb Message) error {
 	if err != nil {
 		return err
 	}
-	return NewBuffer(enc).Unmarshal(pb)
+	// Generated code decodes messages in oneofs this way; they are
+	// unmarshaled with the same options as the message holding them.
+	q := NewBuffer(enc)
+	q.discardUnknown = p.discardUnknown
+	q.depth, q.maxDepth = p.depth, p.maxDepth
+	q.fields, q.maxFields = p.fields, p.maxFields
+	err = q.Unmarshal(pb)
+	p.fields = q.fields
+	return err
+}
+
+// countField counts a field read from the Buffer against its limit.
+func (o *Buffer) countField() error {
+	if o.maxFields <= 0 {
+		return nil
+	}
+	o.fields++
+	if o.fields > o.maxFields {
+		return ErrTooManyFields
+	}
+	return nil
 }
 
 // DecodeGroup reads a tag-delimited group from the Buffer.
@@ -439,7 +669,7 @@ func (p *Buffer) DecodeGroup(pb Message) error {
 func (p *Buffer) Unmarshal(pb Message) error {
 	// If the object can unmarshal itself, let it.
 	if u, ok := pb.(Unmarshaler); ok {
-		err := u.Unmarshal(p.buf[p.index:])
+		err := p.unmarshalSelf(u, p.buf[p.index:])
 		p.index = len(p.buf)
 		return err
 	}
@@ -460,6 +690,11 @@ func (p *Buffer) Unmarshal(pb Message) error {
 
 // unmarshalType does the work of unmarshaling a structure.
 func (o *Buffer) unmarshalType(st reflect.Type, prop *StructProperties, is_group bool, base structPointer) error {
+	if o.depth >= o.depthLimit() {
+		return ErrTooDeep
+	}
+	o.depth++
+	defer func() { o.depth-- }()
 	var state errorState
 	required, reqFields := prop.reqCount, uint64(0)
 
@@ -483,6 +718,9 @@ func (o *Buffer) unmarshalType(st reflect.Type, prop *StructProperties, is_group
 			}
 			return fmt.Errorf("proto: %s: wiretype end group for non-group", st)
 		}
+		if err = o.countField(); err != nil {
+			break
+		}
 		tag := int(u >> 3)
 		if tag <= 0 {
 			return fmt.Errorf("proto: %s: illegal tag %d (wire type %d)", st, tag, wire)
@@ -898,7 +1136,11 @@ func (o *Buffer) dec_struct_message(p *Properties, base structPointer) (err erro
 	if e != nil {
 		return e
 	}
+	return o.dec_struct_message_bytes(p, base, raw)
+}
 
+// Decode raw, the contents of an embedded message, merging it into the field.
+func (o *Buffer) dec_struct_message_bytes(p *Properties, base structPointer, raw []byte) (err error) {
 	bas := structPointer_GetStructPointer(base, p.field)
 	if structPointer_IsNil(bas) {
 		// allocate new nested message
@@ -909,7 +1151,7 @@ func (o *Buffer) dec_struct_message(p *Properties, base structPointer) (err erro
 	// If the object can unmarshal itself, let it.
 	if p.isUnmarshaler {
 		iv := structPointer_Interface(bas, p.stype)
-		return iv.(Unmarshaler).Unmarshal(raw)
+		return o.unmarshalSelf(iv.(Unmarshaler), raw)
 	}
 
 	obuf := o.buf
@@ -953,7 +1195,7 @@ func (o *Buffer) dec_slice_struct(p *Properties, is_group bool, base structPoint
 	// If the object can unmarshal itself, let it.
 	if p.isUnmarshaler {
 		iv := v.Interface()
-		return iv.(Unmarshaler).Unmarshal(raw)
+		return o.unmarshalSelf(iv.(Unmarshaler), raw)
 	}
 
 	obuf := o.buf
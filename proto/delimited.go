@@ -0,0 +1,207 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Routines for reading and writing streams of length-delimited messages.
+ */
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// A stream of delimited messages is a sequence of records, each the
+// varint-encoded length of a message followed by the message, as written
+// by Buffer's EncodeMessage. Log files and pipe protocols use it to carry
+// back-to-back messages, which are not otherwise self-delimiting.
+
+// errDelimitedOverflow is returned when the length of a record does not
+// fit in a varint.
+var errDelimitedOverflow = errors.New("proto: delimited message length overflows a varint")
+
+// WriteDelimited writes m to w as one length-delimited record, in a
+// single call to w's Write method.
+func WriteDelimited(w io.Writer, m Message) error {
+	b, err := Marshal(m)
+	if err != nil {
+		return err
+	}
+	p := NewBuffer(make([]byte, 0, SizeVarint(uint64(len(b)))+len(b)))
+	p.EncodeVarint(uint64(len(b)))
+	p.buf = append(p.buf, b...)
+	_, err = w.Write(p.buf)
+	return err
+}
+
+// ReadDelimited reads one length-delimited record from r and unmarshals
+// it into m. If r is not an io.ByteReader, ReadDelimited reads the length
+// a byte at a time, so that it never reads past the end of the record.
+// It returns io.EOF if r is at its end before the record starts, and
+// io.ErrUnexpectedEOF if r ends in the middle of it. Records longer than
+// DefaultMaxDelimitedSize are rejected, and the buffer for a record grows
+// as its bytes arrive, so that a corrupt length cannot make ReadDelimited
+// allocate more than r holds.
+//
+// ReadDelimited allocates a buffer for each record; a Decoder reuses one.
+func ReadDelimited(r io.Reader, m Message) error {
+	n, err := readLength(byteReader(r), DefaultMaxDelimitedSize)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, r, int64(n)); err != nil {
+		return unexpected(err)
+	}
+	return Unmarshal(buf.Bytes(), m)
+}
+
+// DefaultMaxDelimitedSize is the largest record ReadDelimited reads, and
+// the largest a Decoder reads unless its MaxSize says otherwise.
+const DefaultMaxDelimitedSize = 64 << 20
+
+// A Decoder reads a stream of length-delimited messages, reusing one
+// buffer for all of them. It reads ahead of the record it returns, so r
+// must not be read by anything else once the Decoder is created.
+type Decoder struct {
+	// MaxSize is the largest record, in bytes, the Decoder reads. Records
+	// declaring a greater length are rejected before anything is
+	// allocated for them, protecting readers of untrusted or corrupt
+	// streams. If it is zero, DefaultMaxDelimitedSize is used.
+	MaxSize int
+
+	r   *bufio.Reader
+	buf []byte
+}
+
+// NewDecoder returns a Decoder reading the records in r.
+func NewDecoder(r io.Reader) *Decoder {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &Decoder{r: br}
+}
+
+// Next returns the encoding of the next message in the stream. The slice
+// is valid only until the following call to Next or Decode. Next returns
+// io.EOF at the end of the stream, and io.ErrUnexpectedEOF if the stream
+// ends in the middle of a record.
+func (d *Decoder) Next() ([]byte, error) {
+	max := d.MaxSize
+	if max <= 0 {
+		max = DefaultMaxDelimitedSize
+	}
+	n, err := readLength(d.r, max)
+	if err != nil {
+		return nil, err
+	}
+	if cap(d.buf) < n {
+		d.buf = make([]byte, n)
+	}
+	d.buf = d.buf[:n]
+	if _, err := io.ReadFull(d.r, d.buf); err != nil {
+		return nil, unexpected(err)
+	}
+	return d.buf, nil
+}
+
+// Decode reads the next message in the stream and unmarshals it into m,
+// as Unmarshal does. It returns io.EOF at the end of the stream.
+func (d *Decoder) Decode(m Message) error {
+	b, err := d.Next()
+	if err != nil {
+		return err
+	}
+	return Unmarshal(b, m)
+}
+
+// readLength reads the varint length of a record from r and checks it
+// against max. It returns io.EOF if r ends before the first byte.
+func readLength(r io.ByteReader, max int) (int, error) {
+	var x uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		c, err := r.ReadByte()
+		if err != nil {
+			if shift > 0 {
+				err = unexpected(err)
+			}
+			return 0, err
+		}
+		x |= uint64(c&0x7F) << shift
+		if c < 0x80 {
+			if x > uint64(max) {
+				return 0, fmt.Errorf("proto: delimited message of %d bytes exceeds the limit of %d", x, max)
+			}
+			return int(x), nil
+		}
+	}
+	return 0, errDelimitedOverflow
+}
+
+// unexpected turns io.EOF into io.ErrUnexpectedEOF, for reads that start
+// in the middle of a record.
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// byteReader returns r as an io.ByteReader, reading a byte at a time if it
+// is not one already.
+func byteReader(r io.Reader) io.ByteReader {
+	if br, ok := r.(io.ByteReader); ok {
+		return br
+	}
+	return &singleByteReader{r: r}
+}
+
+type singleByteReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+func (r *singleByteReader) ReadByte() (byte, error) {
+	for {
+		n, err := r.r.Read(r.buf[:])
+		if n == 1 {
+			return r.buf[0], nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
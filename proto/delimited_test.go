@@ -0,0 +1,174 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	. "github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+var delimitedMessages = []*pb.InnerMessage{
+	{Host: String("a")},
+	{Host: String("b"), Port: Int32(80)},
+	{Host: String("")},
+	{Host: String(string(make([]byte, 300))), Connected: Bool(true)},
+}
+
+func writeDelimited(t *testing.T) []byte {
+	var buf bytes.Buffer
+	for _, m := range delimitedMessages {
+		if err := WriteDelimited(&buf, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The stream is what EncodeMessage writes.
+	p := NewBuffer(nil)
+	for _, m := range delimitedMessages {
+		if err := p.EncodeMessage(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(buf.Bytes(), p.Bytes()) {
+		t.Fatalf("WriteDelimited = %x, EncodeMessage = %x", buf.Bytes(), p.Bytes())
+	}
+	return buf.Bytes()
+}
+
+func TestReadDelimited(t *testing.T) {
+	data := writeDelimited(t)
+	// A one-byte reader is not an io.ByteReader, and must not be read
+	// past the end of each record.
+	r := iotest.OneByteReader(bytes.NewReader(data))
+	for i, want := range delimitedMessages {
+		got := new(pb.InnerMessage)
+		if err := ReadDelimited(r, got); err != nil {
+			t.Fatalf("message %d: %v", i, err)
+		}
+		if !Equal(got, want) {
+			t.Errorf("message %d = %v, want %v", i, got, want)
+		}
+	}
+	if err := ReadDelimited(r, new(pb.InnerMessage)); err != io.EOF {
+		t.Errorf("ReadDelimited at end = %v, want io.EOF", err)
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	data := writeDelimited(t)
+	d := NewDecoder(bytes.NewReader(data))
+	for i, want := range delimitedMessages {
+		got := new(pb.InnerMessage)
+		if err := d.Decode(got); err != nil {
+			t.Fatalf("message %d: %v", i, err)
+		}
+		if !Equal(got, want) {
+			t.Errorf("message %d = %v, want %v", i, got, want)
+		}
+	}
+	if err := d.Decode(new(pb.InnerMessage)); err != io.EOF {
+		t.Errorf("Decode at end = %v, want io.EOF", err)
+	}
+}
+
+func TestDecoderTruncated(t *testing.T) {
+	data := writeDelimited(t)
+	// Cut the stream inside the two-byte length of the last record, then
+	// inside its body.
+	size := Size(delimitedMessages[len(delimitedMessages)-1])
+	last := len(data) - SizeVarint(uint64(size)) - size
+	for _, n := range []int{last + 1, len(data) - 1} {
+		d := NewDecoder(bytes.NewReader(data[:n]))
+		var err error
+		for err == nil {
+			err = d.Decode(new(pb.InnerMessage))
+		}
+		if err != io.ErrUnexpectedEOF {
+			t.Errorf("Decode of %d bytes = %v, want io.ErrUnexpectedEOF", n, err)
+		}
+		if err := ReadDelimited(bytes.NewReader(data[last:n]), new(pb.InnerMessage)); err != io.ErrUnexpectedEOF {
+			t.Errorf("ReadDelimited of %d bytes = %v, want io.ErrUnexpectedEOF", n, err)
+		}
+	}
+}
+
+func TestDecoderMaxSize(t *testing.T) {
+	data := writeDelimited(t)
+	d := NewDecoder(bytes.NewReader(data))
+	d.MaxSize = 100
+	for i := 0; i < 3; i++ {
+		if err := d.Decode(new(pb.InnerMessage)); err != nil {
+			t.Fatalf("message %d: %v", i, err)
+		}
+	}
+	if err := d.Decode(new(pb.InnerMessage)); err == nil || err == io.EOF {
+		t.Errorf("Decode of a record over MaxSize = %v, want an error", err)
+	}
+}
+
+func TestReadDelimitedMaxSize(t *testing.T) {
+	// A record over DefaultMaxDelimitedSize is rejected from its length.
+	p := NewBuffer(nil)
+	p.EncodeVarint(DefaultMaxDelimitedSize + 1)
+	if err := ReadDelimited(bytes.NewReader(p.Bytes()), new(pb.InnerMessage)); err == nil || err == io.ErrUnexpectedEOF {
+		t.Errorf("ReadDelimited of a record over DefaultMaxDelimitedSize = %v, want an error", err)
+	}
+	// A length within the limit but beyond the end of the stream fails
+	// when the stream ends.
+	p = NewBuffer(nil)
+	p.EncodeVarint(DefaultMaxDelimitedSize)
+	p.EncodeRawBytes([]byte("short"))
+	if err := ReadDelimited(bytes.NewReader(p.Bytes()), new(pb.InnerMessage)); err != io.ErrUnexpectedEOF {
+		t.Errorf("ReadDelimited of a truncated record = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func BenchmarkDecoder(b *testing.B) {
+	var buf bytes.Buffer
+	m := &pb.InnerMessage{Host: String("localhost"), Port: Int32(8080), Connected: Bool(true)}
+	for i := 0; i < 1000; i++ {
+		WriteDelimited(&buf, m)
+	}
+	data := buf.Bytes()
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d := NewDecoder(bytes.NewReader(data))
+		for d.Decode(m) == nil {
+		}
+	}
+}
@@ -40,6 +40,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"sync"
 )
 
 // RequiredNotSetError is the error returned if Marshal is called with
@@ -122,6 +123,20 @@ func SizeVarint(x uint64) int {
 	return sizeVarint(x)
 }
 
+// PrependVarint writes the varint encoding of x to b so that it ends just
+// before b[i], and returns the index of its first byte. Code generated
+// with plugins=fastpath uses it to marshal messages from back to front.
+func PrependVarint(b []byte, i int, x uint64) int {
+	i -= sizeVarint(x)
+	j := i
+	for ; x > 127; j++ {
+		b[j] = 0x80 | uint8(x&0x7F)
+		x >>= 7
+	}
+	b[j] = uint8(x)
+	return i
+}
+
 func sizeVarint(x uint64) (n int) {
 	for {
 		n++
@@ -225,6 +240,43 @@ type Marshaler interface {
 	Marshal() ([]byte, error)
 }
 
+// Sizer is the interface representing objects that can compute their own
+// encoded size, as messages generated with plugins=fastpath do.
+type Sizer interface {
+	Size() int
+}
+
+// SizedMarshaler is the interface representing objects that can marshal
+// themselves into a buffer sized with their Size method, as messages
+// generated with plugins=fastpath do. MarshalToSizedBuffer writes the
+// encoding at the end of b, which must be at least Size bytes long, and
+// returns the number of bytes written. Marshaling nested messages this
+// way, rather than with Marshal, allocates no slice per message.
+type SizedMarshaler interface {
+	Sizer
+	MarshalToSizedBuffer(b []byte) (int, error)
+}
+
+// fastpathMessage is implemented by messages generated with
+// plugins=fastpath. Their methods always keep unrecognized fields and
+// marshal nested messages without options, so a Buffer that is
+// deterministic or discards unrecognized fields marshals them by
+// reflection instead, as it can any generated message.
+type fastpathMessage interface {
+	SizedMarshaler
+	depthUnmarshaler
+}
+
+// selfMarshals reports whether v, which implements Marshaler, should
+// marshal itself into the Buffer.
+func (o *Buffer) selfMarshals(v interface{}) bool {
+	if o.deterministic || o.discardUnknown {
+		_, ok := v.(fastpathMessage)
+		return !ok
+	}
+	return true
+}
+
 // Marshal takes the protocol buffer
 // and encodes it into the wire format, returning the data.
 func Marshal(pb Message) ([]byte, error) {
@@ -241,6 +293,86 @@ func Marshal(pb Message) ([]byte, error) {
 	return p.buf, err
 }
 
+// MarshalOptions configures a marshaler. The zero value marshals as
+// Marshal does.
+type MarshalOptions struct {
+	// Deterministic sorts the entries of map fields, so that equal
+	// messages always marshal to the same bytes in the same binary, as
+	// content hashes, cache keys and signatures need. Messages generated
+	// with plugins=fastpath are then marshaled by reflection, so that the
+	// messages nested in them are deterministic too. See
+	// Buffer.SetDeterministic for its limits.
+	Deterministic bool
+}
+
+// Buffers reused by MarshalAppend.
+var marshalBufferPool = sync.Pool{
+	New: func() interface{} { return new(Buffer) },
+}
+
+// Marshal returns the wire-format encoding of pb.
+func (o MarshalOptions) Marshal(pb Message) ([]byte, error) {
+	return o.MarshalAppend(nil, pb)
+}
+
+// MarshalAppend appends the wire-format encoding of pb to b and returns
+// the extended slice. If b has enough spare capacity, the encoding
+// allocates no new slice, so servers can reuse buffers, say from a
+// sync.Pool, rather than allocate one per message. As with Marshal, if
+// required fields are missing the message is encoded anyway and a
+// *RequiredNotSetError is returned with it.
+func (o MarshalOptions) MarshalAppend(b []byte, pb Message) ([]byte, error) {
+	p := marshalBufferPool.Get().(*Buffer)
+	p.buf = b
+	p.deterministic = o.Deterministic
+	err := p.Marshal(pb)
+	b = p.buf
+	p.buf = nil
+	marshalBufferPool.Put(p)
+	if b == nil && err == nil {
+		// Return a non-nil slice on success.
+		return []byte{}, nil
+	}
+	return b, err
+}
+
+// appendSized appends the encoding of m, which is n bytes long, to b.
+func appendSized(b []byte, m SizedMarshaler, n int) ([]byte, error) {
+	l := len(b)
+	if cap(b)-l < n {
+		nb := make([]byte, l, l+n)
+		copy(nb, b)
+		b = nb
+	}
+	b = b[:l+n]
+	_, err := m.MarshalToSizedBuffer(b[l:])
+	return b, err
+}
+
+// MarshalToSizedBuffer writes the encoding of pb at the end of b, which
+// must have room for Size(pb) bytes, and returns the number of bytes
+// written. It is for use by code generated with plugins=fastpath, which
+// marshals nested messages with it: those that implement SizedMarshaler
+// marshal themselves in place, and others are marshaled and copied.
+func MarshalToSizedBuffer(b []byte, pb Message) (int, error) {
+	if m, ok := pb.(SizedMarshaler); ok {
+		return m.MarshalToSizedBuffer(b)
+	}
+	data, err := Marshal(pb)
+	if err != nil {
+		return 0, err
+	}
+	if len(data) > len(b) {
+		return 0, errShortBuffer
+	}
+	copy(b[len(b)-len(data):], data)
+	return len(data), nil
+}
+
+// errShortBuffer is returned by MarshalToSizedBuffer when a message grows
+// between being sized and being marshaled.
+var errShortBuffer = errors.New("proto: message changed size while being marshaled")
+
 // EncodeMessage writes the protocol buffer to the Buffer,
 // prefixed by a varint-encoded length.
 func (p *Buffer) EncodeMessage(pb Message) error {
@@ -260,7 +392,12 @@ func (p *Buffer) EncodeMessage(pb Message) error {
 // Buffer.
 func (p *Buffer) Marshal(pb Message) error {
 	// Can the object marshal itself?
-	if m, ok := pb.(Marshaler); ok {
+	if m, ok := pb.(SizedMarshaler); ok && p.selfMarshals(m) {
+		var err error
+		p.buf, err = appendSized(p.buf, m, m.Size())
+		return err
+	}
+	if m, ok := pb.(Marshaler); ok && p.selfMarshals(m) {
 		data, err := m.Marshal()
 		p.buf = append(p.buf, data...)
 		return err
@@ -286,8 +423,11 @@ func (p *Buffer) Marshal(pb Message) error {
 
 // Size returns the encoded size of a protocol buffer.
 func Size(pb Message) (n int) {
+	// Can the object size itself?
+	if m, ok := pb.(Sizer); ok {
+		return m.Size()
+	}
 	// Can the object marshal itself?  If so, Size is slow.
-	// TODO: add Size to Marshaler, or add a Sizer interface.
 	if m, ok := pb.(Marshaler); ok {
 		b, _ := m.Marshal()
 		return len(b)
@@ -549,14 +689,13 @@ func (o *Buffer) enc_struct_message(p *Properties, base structPointer) error {
 
 	// Can the object marshal itself?
 	if p.isMarshaler {
-		m := structPointer_Interface(structp, p.stype).(Marshaler)
-		data, err := m.Marshal()
-		if err != nil && !state.shouldContinue(err, nil) {
-			return err
+		if v := structPointer_Interface(structp, p.stype); o.selfMarshals(v) {
+			o.buf = append(o.buf, p.tagcode...)
+			if err := o.enc_marshaler(v, &state); err != nil {
+				return err
+			}
+			return state.err
 		}
-		o.buf = append(o.buf, p.tagcode...)
-		o.EncodeRawBytes(data)
-		return state.err
 	}
 
 	o.buf = append(o.buf, p.tagcode...)
@@ -571,10 +710,8 @@ func size_struct_message(p *Properties, base structPointer) int {
 
 	// Can the object marshal itself?
 	if p.isMarshaler {
-		m := structPointer_Interface(structp, p.stype).(Marshaler)
-		data, _ := m.Marshal()
 		n0 := len(p.tagcode)
-		n1 := sizeRawBytes(data)
+		n1 := size_marshaler(structPointer_Interface(structp, p.stype))
 		return n0 + n1
 	}
 
@@ -584,6 +721,37 @@ func size_struct_message(p *Properties, base structPointer) int {
 	return n0 + n1 + n2
 }
 
+// Encode a message that marshals itself, prefixed by its length.
+// A SizedMarshaler is marshaled in place, without an intermediate slice.
+func (o *Buffer) enc_marshaler(v interface{}, state *errorState) error {
+	if m, ok := v.(SizedMarshaler); ok {
+		n := m.Size()
+		o.EncodeVarint(uint64(n))
+		var err error
+		o.buf, err = appendSized(o.buf, m, n)
+		if err != nil && !state.shouldContinue(err, nil) {
+			return err
+		}
+		return nil
+	}
+	data, err := v.(Marshaler).Marshal()
+	if err != nil && !state.shouldContinue(err, nil) {
+		return err
+	}
+	o.EncodeRawBytes(data)
+	return nil
+}
+
+// Size of a message that marshals itself, prefixed by its length.
+func size_marshaler(v interface{}) int {
+	if m, ok := v.(Sizer); ok {
+		n := m.Size()
+		return sizeVarint(uint64(n)) + n
+	}
+	data, _ := v.(Marshaler).Marshal()
+	return sizeRawBytes(data)
+}
+
 // Encode a group struct.
 func (o *Buffer) enc_struct_group(p *Properties, base structPointer) error {
 	var state errorState
@@ -971,14 +1139,13 @@ func (o *Buffer) enc_slice_struct_message(p *Properties, base structPointer) err
 
 		// Can the object marshal itself?
 		if p.isMarshaler {
-			m := structPointer_Interface(structp, p.stype).(Marshaler)
-			data, err := m.Marshal()
-			if err != nil && !state.shouldContinue(err, nil) {
-				return err
+			if v := structPointer_Interface(structp, p.stype); o.selfMarshals(v) {
+				o.buf = append(o.buf, p.tagcode...)
+				if err := o.enc_marshaler(v, &state); err != nil {
+					return err
+				}
+				continue
 			}
-			o.buf = append(o.buf, p.tagcode...)
-			o.EncodeRawBytes(data)
-			continue
 		}
 
 		o.buf = append(o.buf, p.tagcode...)
@@ -1005,9 +1172,7 @@ func size_slice_struct_message(p *Properties, base structPointer) (n int) {
 
 		// Can the object marshal itself?
 		if p.isMarshaler {
-			m := structPointer_Interface(structp, p.stype).(Marshaler)
-			data, _ := m.Marshal()
-			n += sizeRawBytes(data)
+			n += size_marshaler(structPointer_Interface(structp, p.stype))
 			continue
 		}
 
@@ -1066,6 +1231,9 @@ func size_slice_struct_group(p *Properties, base structPointer) (n int) {
 // Encode an extension map.
 func (o *Buffer) enc_map(p *Properties, base structPointer) error {
 	exts := structPointer_ExtMap(base, p.field)
+	if o.deterministic || o.discardUnknown {
+		return o.enc_exts_fresh(*exts)
+	}
 	if err := encodeExtensionsMap(*exts); err != nil {
 		return err
 	}
@@ -1083,6 +1251,9 @@ func (o *Buffer) enc_exts(p *Properties, base structPointer) error {
 
 	mu.Lock()
 	defer mu.Unlock()
+	if o.deterministic || o.discardUnknown {
+		return o.enc_exts_fresh(v)
+	}
 	if err := encodeExtensionsMap(v); err != nil {
 		return err
 	}
@@ -1090,6 +1261,30 @@ func (o *Buffer) enc_exts(p *Properties, base structPointer) error {
 	return o.enc_map_body(v)
 }
 
+// enc_exts_fresh encodes the extensions in v in order of field number,
+// encoding each value with o's settings instead of using the cached
+// encoding, which is left alone. Extensions that are only in their
+// encoded form are copied as they are.
+func (o *Buffer) enc_exts_fresh(v map[int32]Extension) error {
+	keys := make([]int, 0, len(v))
+	for k := range v {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+
+	for _, k := range keys {
+		e := v[int32(k)]
+		if e.value == nil || e.desc == nil {
+			o.buf = append(o.buf, e.enc...)
+			continue
+		}
+		if err := encodeExtension(o, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (o *Buffer) enc_map_body(v map[int32]Extension) error {
 	// Fast-path for common cases: zero or one extensions.
 	if len(v) <= 1 {
@@ -1154,8 +1349,13 @@ func (o *Buffer) enc_new_map(p *Properties, base structPointer) error {
 		return nil
 	}
 
-	// Don't sort map keys. It is not required by the spec, and C++ doesn't do it.
-	for _, key := range v.MapKeys() {
+	// Don't sort map keys unless asked to. It is not required by the spec,
+	// and C++ doesn't do it.
+	keys := v.MapKeys()
+	if o.deterministic {
+		sort.Sort(mapKeys(keys))
+	}
+	for _, key := range keys {
 		val := v.MapIndex(key)
 
 		keycopy.Set(key)
@@ -1262,7 +1462,7 @@ func (o *Buffer) enc_struct(prop *StructProperties, base structPointer) error {
 	}
 
 	// Add unrecognized fields at the end.
-	if prop.unrecField.IsValid() {
+	if prop.unrecField.IsValid() && !o.discardUnknown {
 		v := *structPointer_Bytes(base, prop.unrecField)
 		if len(o.buf)+len(v) > maxMarshalSize {
 			return ErrTooLarge
@@ -35,6 +35,7 @@ package proto
 
 import (
 	"bytes"
+	"fmt"
 	"log"
 	"reflect"
 	"strings"
@@ -70,6 +71,72 @@ Equality is defined in this way:
 The return value is undefined if a and b are not protocol buffers.
 */
 func Equal(a, b Message) bool {
+	return equal(a, b, nil)
+}
+
+// An EqualOption changes how EqualWithOptions compares messages.
+type EqualOption func(*equalOptions)
+
+// equalOptions holds the settings of the options given to EqualWithOptions.
+type equalOptions struct {
+	ignored       map[reflect.Type]map[int]bool // struct field numbers to skip, by message struct type
+	ignoreUnknown bool                          // whether to skip XXX_unrecognized
+}
+
+// EqualWithOptions is like Equal, but compares the messages as the options
+// say, for example to leave out fields that change on every update:
+//
+//	proto.EqualWithOptions(a, b, proto.IgnoreFields(&pb.Job{}, "updated_at"))
+//
+// The options apply to messages nested at any depth, including those in
+// extensions and in fields of type Message.
+func EqualWithOptions(a, b Message, opts ...EqualOption) bool {
+	o := new(equalOptions)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return equal(a, b, o)
+}
+
+// IgnoreFields leaves the fields of messages of the same type as m that have
+// the given names, as declared in the .proto file, out of the comparison.
+// The name of a oneof leaves out the whole oneof. IgnoreFields panics if m
+// has no field or oneof of one of the names.
+func IgnoreFields(m Message, names ...string) EqualOption {
+	t := reflect.TypeOf(m).Elem()
+	sprop := GetProperties(t)
+	fields := make(map[int]bool)
+	for _, name := range names {
+		i, ok := sprop.decoderOrigNames[name]
+		if !ok {
+			panic(fmt.Sprintf("proto: IgnoreFields: %v has no field %q", t, name))
+		}
+		fields[i] = true
+	}
+	return func(o *equalOptions) {
+		if o.ignored == nil {
+			o.ignored = make(map[reflect.Type]map[int]bool)
+		}
+		if o.ignored[t] == nil {
+			o.ignored[t] = make(map[int]bool)
+		}
+		for i := range fields {
+			o.ignored[t][i] = true
+		}
+	}
+}
+
+// IgnoreUnknown leaves unrecognized fields out of the comparison, so that
+// messages decoded by binaries that know different versions of a message
+// can be compared.
+func IgnoreUnknown() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreUnknown = true
+	}
+}
+
+// equal compares a and b as Equal does, with the options o, which may be nil.
+func equal(a, b Message, o *equalOptions) bool {
 	if a == nil || b == nil {
 		return a == b
 	}
@@ -89,15 +156,22 @@ func Equal(a, b Message) bool {
 	if v1.Kind() != reflect.Struct {
 		return false
 	}
-	return equalStruct(v1, v2)
+	return equalStruct(v1, v2, o)
 }
 
 // v1 and v2 are known to have the same type.
-func equalStruct(v1, v2 reflect.Value) bool {
+// o may be nil.
+func equalStruct(v1, v2 reflect.Value, o *equalOptions) bool {
+	decodeLazyStruct(v1)
+	decodeLazyStruct(v2)
 	sprop := GetProperties(v1.Type())
+	var ignored map[int]bool
+	if o != nil {
+		ignored = o.ignored[v1.Type()]
+	}
 	for i := 0; i < v1.NumField(); i++ {
 		f := v1.Type().Field(i)
-		if strings.HasPrefix(f.Name, "XXX_") {
+		if strings.HasPrefix(f.Name, "XXX_") || ignored[i] {
 			continue
 		}
 		f1, f2 := v1.Field(i), v2.Field(i)
@@ -120,27 +194,27 @@ func equalStruct(v1, v2 reflect.Value) bool {
 			}
 			f1, f2 = f1.Elem(), f2.Elem()
 		}
-		if !equalAny(f1, f2, sprop.Prop[i]) {
+		if !equalAny(f1, f2, sprop.Prop[i], o) {
 			return false
 		}
 	}
 
 	if em1 := v1.FieldByName("XXX_InternalExtensions"); em1.IsValid() {
 		em2 := v2.FieldByName("XXX_InternalExtensions")
-		if !equalExtensions(v1.Type(), em1.Interface().(XXX_InternalExtensions), em2.Interface().(XXX_InternalExtensions)) {
+		if !equalExtensions(v1.Type(), em1.Interface().(XXX_InternalExtensions), em2.Interface().(XXX_InternalExtensions), o) {
 			return false
 		}
 	}
 
 	if em1 := v1.FieldByName("XXX_extensions"); em1.IsValid() {
 		em2 := v2.FieldByName("XXX_extensions")
-		if !equalExtMap(v1.Type(), em1.Interface().(map[int32]Extension), em2.Interface().(map[int32]Extension)) {
+		if !equalExtMap(v1.Type(), em1.Interface().(map[int32]Extension), em2.Interface().(map[int32]Extension), o) {
 			return false
 		}
 	}
 
 	uf := v1.FieldByName("XXX_unrecognized")
-	if !uf.IsValid() {
+	if !uf.IsValid() || o != nil && o.ignoreUnknown {
 		return true
 	}
 
@@ -154,12 +228,12 @@ func equalStruct(v1, v2 reflect.Value) bool {
 }
 
 // v1 and v2 are known to have the same type.
-// prop may be nil.
-func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
+// prop and o may be nil.
+func equalAny(v1, v2 reflect.Value, prop *Properties, o *equalOptions) bool {
 	if v1.Type() == protoMessageType {
 		m1, _ := v1.Interface().(Message)
 		m2, _ := v2.Interface().(Message)
-		return Equal(m1, m2)
+		return equal(m1, m2, o)
 	}
 	switch v1.Kind() {
 	case reflect.Bool:
@@ -178,7 +252,7 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 		if e1.Type() != e2.Type() {
 			return false
 		}
-		return equalAny(e1, e2, nil)
+		return equalAny(e1, e2, nil, o)
 	case reflect.Map:
 		if v1.Len() != v2.Len() {
 			return false
@@ -189,7 +263,7 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 				// This key was not found in the second map.
 				return false
 			}
-			if !equalAny(v1.MapIndex(key), val2, nil) {
+			if !equalAny(v1.MapIndex(key), val2, nil, o) {
 				return false
 			}
 		}
@@ -202,7 +276,7 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 		if v1.IsNil() != v2.IsNil() {
 			return false
 		}
-		return equalAny(v1.Elem(), v2.Elem(), prop)
+		return equalAny(v1.Elem(), v2.Elem(), prop, o)
 	case reflect.Slice:
 		if v1.Type().Elem().Kind() == reflect.Uint8 {
 			// short circuit: []byte
@@ -222,7 +296,7 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 			return false
 		}
 		for i := 0; i < v1.Len(); i++ {
-			if !equalAny(v1.Index(i), v2.Index(i), prop) {
+			if !equalAny(v1.Index(i), v2.Index(i), prop, o) {
 				return false
 			}
 		}
@@ -230,7 +304,7 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 	case reflect.String:
 		return v1.Interface().(string) == v2.Interface().(string)
 	case reflect.Struct:
-		return equalStruct(v1, v2)
+		return equalStruct(v1, v2, o)
 	case reflect.Uint32, reflect.Uint64:
 		return v1.Uint() == v2.Uint()
 	}
@@ -242,13 +316,13 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 
 // base is the struct type that the extensions are based on.
 // x1 and x2 are InternalExtensions.
-func equalExtensions(base reflect.Type, x1, x2 XXX_InternalExtensions) bool {
+func equalExtensions(base reflect.Type, x1, x2 XXX_InternalExtensions, o *equalOptions) bool {
 	em1, _ := x1.extensionsRead()
 	em2, _ := x2.extensionsRead()
-	return equalExtMap(base, em1, em2)
+	return equalExtMap(base, em1, em2, o)
 }
 
-func equalExtMap(base reflect.Type, em1, em2 map[int32]Extension) bool {
+func equalExtMap(base reflect.Type, em1, em2 map[int32]Extension, o *equalOptions) bool {
 	if len(em1) != len(em2) {
 		return false
 	}
@@ -263,7 +337,7 @@ func equalExtMap(base reflect.Type, em1, em2 map[int32]Extension) bool {
 
 		if m1 != nil && m2 != nil {
 			// Both are unencoded.
-			if !equalAny(reflect.ValueOf(m1), reflect.ValueOf(m2), nil) {
+			if !equalAny(reflect.ValueOf(m1), reflect.ValueOf(m2), nil, o) {
 				return false
 			}
 			continue
@@ -291,7 +365,7 @@ func equalExtMap(base reflect.Type, em1, em2 map[int32]Extension) bool {
 			log.Printf("proto: badly encoded extension %d of %v: %v", extNum, base, err)
 			return false
 		}
-		if !equalAny(reflect.ValueOf(m1), reflect.ValueOf(m2), nil) {
+		if !equalAny(reflect.ValueOf(m1), reflect.ValueOf(m2), nil, o) {
 			return false
 		}
 	}
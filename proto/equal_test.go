@@ -222,3 +222,104 @@ func TestEqual(t *testing.T) {
 		}
 	}
 }
+
+var EqualWithOptionsTests = []struct {
+	desc string
+	a, b Message
+	opts []EqualOption
+	exp  bool
+}{
+	{
+		"ignored field differs",
+		&pb.MyMessage{Count: Int32(1), Name: String("a")},
+		&pb.MyMessage{Count: Int32(1), Name: String("b")},
+		[]EqualOption{IgnoreFields(&pb.MyMessage{}, "name")},
+		true,
+	},
+	{
+		"ignored field set in one",
+		&pb.MyMessage{Count: Int32(1), Name: String("a")},
+		&pb.MyMessage{Count: Int32(1)},
+		[]EqualOption{IgnoreFields(&pb.MyMessage{}, "name")},
+		true,
+	},
+	{
+		"other field differs",
+		&pb.MyMessage{Count: Int32(1), Name: String("a")},
+		&pb.MyMessage{Count: Int32(2), Name: String("b")},
+		[]EqualOption{IgnoreFields(&pb.MyMessage{}, "name")},
+		false,
+	},
+	{
+		"ignored nested field",
+		&pb.MyMessage{Count: Int32(1), Others: []*pb.OtherMessage{{Key: Int64(1), Value: []byte("x")}}},
+		&pb.MyMessage{Count: Int32(1), Others: []*pb.OtherMessage{{Key: Int64(1), Value: []byte("y")}}},
+		[]EqualOption{IgnoreFields((*pb.OtherMessage)(nil), "value")},
+		true,
+	},
+	{
+		"field of another type not ignored",
+		&pb.MyMessage{Count: Int32(1), Name: String("a")},
+		&pb.MyMessage{Count: Int32(1), Name: String("b")},
+		[]EqualOption{IgnoreFields(&pb.Strings{}, "string_field")},
+		false,
+	},
+	{
+		"options combine",
+		&pb.MyMessage{Count: Int32(1), Name: String("a"), Quote: String("q")},
+		&pb.MyMessage{Count: Int32(1), Name: String("b")},
+		[]EqualOption{IgnoreFields(&pb.MyMessage{}, "name"), IgnoreFields(&pb.MyMessage{}, "quote")},
+		true,
+	},
+	{
+		"ignored oneof",
+		&pb.Communique{Union: &pb.Communique_Number{41}},
+		&pb.Communique{Union: &pb.Communique_Name{"Bobby Tables"}},
+		[]EqualOption{IgnoreFields(&pb.Communique{}, "union")},
+		true,
+	},
+	{
+		"unknown fields differ",
+		&pb.MyMessage{Count: Int32(1), XXX_unrecognized: []byte{0x98, 0x06, 0x01}},
+		&pb.MyMessage{Count: Int32(1)},
+		nil,
+		false,
+	},
+	{
+		"unknown fields ignored",
+		&pb.MyMessage{Count: Int32(1), XXX_unrecognized: []byte{0x98, 0x06, 0x01}},
+		&pb.MyMessage{Count: Int32(1)},
+		[]EqualOption{IgnoreUnknown()},
+		true,
+	},
+	{
+		"unknown fields ignored, known differ",
+		&pb.MyMessage{Count: Int32(1), XXX_unrecognized: []byte{0x98, 0x06, 0x01}},
+		&pb.MyMessage{Count: Int32(2)},
+		[]EqualOption{IgnoreUnknown()},
+		false,
+	},
+}
+
+func TestEqualWithOptions(t *testing.T) {
+	for _, tc := range EqualWithOptionsTests {
+		if res := EqualWithOptions(tc.a, tc.b, tc.opts...); res != tc.exp {
+			t.Errorf("%v: EqualWithOptions(%v, %v) = %v, want %v", tc.desc, tc.a, tc.b, res, tc.exp)
+		}
+	}
+	// Without options, it is Equal.
+	for _, tc := range EqualTests {
+		if res := EqualWithOptions(tc.a, tc.b); res != tc.exp {
+			t.Errorf("%v: EqualWithOptions(%v, %v) = %v, want %v", tc.desc, tc.a, tc.b, res, tc.exp)
+		}
+	}
+}
+
+func TestIgnoreFieldsUnknownName(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("IgnoreFields with an unknown name did not panic")
+		}
+	}()
+	IgnoreFields(&pb.MyMessage{}, "no_such_field")
+}
@@ -39,6 +39,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"sync"
 )
@@ -273,15 +274,8 @@ func encodeExtensionsMap(m map[int32]Extension) error {
 		// because the extension value may have been mutated after
 		// the last time this function was called.
 
-		et := reflect.TypeOf(e.desc.ExtensionType)
-		props := extensionProperties(e.desc)
-
 		p := NewBuffer(nil)
-		// If e.value has type T, the encoder expects a *struct{ X T }.
-		// Pass a *T with a zero field and hope it all works out.
-		x := reflect.New(et)
-		x.Elem().Set(reflect.ValueOf(e.value))
-		if err := props.enc(p, props, toStructPointer(x)); err != nil {
+		if err := encodeExtension(p, e); err != nil {
 			return err
 		}
 		e.enc = p.buf
@@ -290,6 +284,19 @@ func encodeExtensionsMap(m map[int32]Extension) error {
 	return nil
 }
 
+// encodeExtension appends the encoding of the value of e, which must be
+// set, to p.
+func encodeExtension(p *Buffer, e Extension) error {
+	et := reflect.TypeOf(e.desc.ExtensionType)
+	props := extensionProperties(e.desc)
+
+	// If e.value has type T, the encoder expects a *struct{ X T }.
+	// Pass a *T with a zero field and hope it all works out.
+	x := reflect.New(et)
+	x.Elem().Set(reflect.ValueOf(e.value))
+	return props.enc(p, props, toStructPointer(x))
+}
+
 func extensionsSize(e *XXX_InternalExtensions) (n int) {
 	m, mu := e.extensionsRead()
 	if m == nil {
@@ -521,6 +528,46 @@ func ExtensionDescs(pb Message) ([]*ExtensionDesc, error) {
 	return extensions, nil
 }
 
+// RangeExtensions calls f with the descriptor and value of each extension
+// present in pb, in order of field number, until f returns false. For
+// extensions that are not registered, f gets an incomplete descriptor, as
+// from ExtensionDescs, and the extension's encoded bytes.
+func RangeExtensions(pb Message, f func(desc *ExtensionDesc, value interface{}) bool) error {
+	descs, err := ExtensionDescs(pb)
+	if err != nil {
+		return err
+	}
+	sort.Sort(extensionsByField(descs))
+	for _, desc := range descs {
+		var v interface{}
+		if desc.ExtensionType == nil {
+			v = rawExtension(pb, desc.Field)
+		} else if v, err = GetExtension(pb, desc); err != nil {
+			return err
+		}
+		if !f(desc, v) {
+			break
+		}
+	}
+	return nil
+}
+
+// rawExtension returns the encoded bytes of the extension of pb with the
+// given field number.
+func rawExtension(pb Message, field int32) []byte {
+	epb, _ := extendable(pb)
+	emap, mu := epb.extensionsRead()
+	mu.Lock()
+	defer mu.Unlock()
+	return emap[field].enc
+}
+
+type extensionsByField []*ExtensionDesc
+
+func (s extensionsByField) Len() int           { return len(s) }
+func (s extensionsByField) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s extensionsByField) Less(i, j int) bool { return s[i].Field < s[j].Field }
+
 // SetExtension sets the specified extension of pb to the specified value.
 func SetExtension(pb Message, extension *ExtensionDesc, value interface{}) error {
 	epb, ok := extendable(pb)
@@ -585,3 +632,25 @@ func RegisterExtension(desc *ExtensionDesc) {
 func RegisteredExtensions(pb Message) map[int32]*ExtensionDesc {
 	return extensionMaps[reflect.TypeOf(pb).Elem()]
 }
+
+// RegisteredExtensionByName returns the extension of pb's message type
+// registered with the fully-qualified name, such as "my.pkg.ext", or nil
+// if there is none. The argument pb may be a nil pointer to the struct type.
+func RegisteredExtensionByName(pb Message, name string) *ExtensionDesc {
+	for _, desc := range RegisteredExtensions(pb) {
+		if desc.Name == name {
+			return desc
+		}
+	}
+	return nil
+}
+
+// GetExtensionByName is like GetExtension, for the extension of pb
+// registered with the fully-qualified name, such as "my.pkg.ext".
+func GetExtensionByName(pb Message, name string) (interface{}, error) {
+	desc := RegisteredExtensionByName(pb, name)
+	if desc == nil {
+		return nil, fmt.Errorf("proto: no extension %q registered for %T", name, pb)
+	}
+	return GetExtension(pb, desc)
+}
@@ -106,6 +106,83 @@ func TestExtensionDescsWithMissingExtensions(t *testing.T) {
 	}
 }
 
+func TestRangeExtensions(t *testing.T) {
+	msg := &pb.MyMessage{Count: proto.Int32(0)}
+	if err := proto.SetExtension(msg, pb.E_Greeting, []string{"hi"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(msg, pb.E_Ext_Number, proto.Int32(7)); err != nil {
+		t.Fatal(err)
+	}
+	// An extension that is not registered is seen as its encoded bytes.
+	unregistered := &proto.ExtensionDesc{
+		ExtendedType:  (*pb.MyMessage)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         123456789,
+		Name:          "a.b",
+		Tag:           "varint,123456789,opt",
+	}
+	if err := proto.SetExtension(msg, unregistered, proto.Bool(true)); err != nil {
+		t.Fatal(err)
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(b, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	var fields []int32
+	var values []interface{}
+	err = proto.RangeExtensions(msg, func(desc *proto.ExtensionDesc, v interface{}) bool {
+		fields = append(fields, desc.Field)
+		values = append(values, v)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantFields := []int32{105, 106, 123456789}
+	raw := append(proto.EncodeVarint(123456789<<3|proto.WireVarint), 1)
+	wantValues := []interface{}{proto.Int32(7), []string{"hi"}, raw}
+	if !reflect.DeepEqual(fields, wantFields) || !reflect.DeepEqual(values, wantValues) {
+		t.Errorf("RangeExtensions: got %v %v, want %v %v", fields, values, wantFields, wantValues)
+	}
+
+	n := 0
+	proto.RangeExtensions(msg, func(*proto.ExtensionDesc, interface{}) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("RangeExtensions went on after false: %d calls", n)
+	}
+	if err := proto.RangeExtensions(&pb.GoTest{}, nil); err == nil {
+		t.Error("RangeExtensions of a message without extensions succeeded")
+	}
+}
+
+func TestGetExtensionByName(t *testing.T) {
+	msg := &pb.MyMessage{Count: proto.Int32(0)}
+	if err := proto.SetExtension(msg, pb.E_Greeting, []string{"hi"}); err != nil {
+		t.Fatal(err)
+	}
+	v, err := proto.GetExtensionByName(msg, "testdata.greeting")
+	if err != nil || !reflect.DeepEqual(v, []string{"hi"}) {
+		t.Errorf("GetExtensionByName(greeting) = %v, %v", v, err)
+	}
+	if _, err := proto.GetExtensionByName(msg, "testdata.Ext.number"); err != proto.ErrMissingExtension {
+		t.Errorf("GetExtensionByName(unset) error = %v, want ErrMissingExtension", err)
+	}
+	if _, err := proto.GetExtensionByName(msg, "testdata.nope"); err == nil {
+		t.Error("GetExtensionByName(unregistered) succeeded")
+	}
+	if d := proto.RegisteredExtensionByName((*pb.MyMessage)(nil), "testdata.Ext.more"); d != pb.E_Ext_More {
+		t.Errorf("RegisteredExtensionByName(testdata.Ext.more) = %v", d)
+	}
+}
+
 type ExtensionDescSlice []*proto.ExtensionDesc
 
 func (s ExtensionDescSlice) Len() int           { return len(s) }
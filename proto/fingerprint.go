@@ -0,0 +1,134 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Fingerprints of messages: hashes of their canonical encoding, computed
+// without building it.
+
+package proto
+
+// FingerprintBasis is the fingerprint of no bytes, the offset basis of
+// 64-bit FNV-1a.
+const FingerprintBasis uint64 = 14695981039346656037
+
+const fnvPrime64 = 1099511628211
+
+// The Fingerprint functions add an encoded value to h, the FNV-1a hash of
+// the bytes encoded so far, and return the new hash. The Fingerprint
+// methods generated by the fingerprint plugin call them field by field, so
+// that m.Fingerprint() is binary.BigEndian.Uint64 of
+// HashCanonical(m, fnv.New64a()), but no encoding is built.
+
+// FingerprintVarint adds x encoded as a varint to h.
+func FingerprintVarint(h, x uint64) uint64 {
+	for x >= 1<<7 {
+		h ^= x&0x7f | 0x80
+		h *= fnvPrime64
+		x >>= 7
+	}
+	h ^= x
+	h *= fnvPrime64
+	return h
+}
+
+// FingerprintBool adds b encoded as a varint to h.
+func FingerprintBool(h uint64, b bool) uint64 {
+	if b {
+		return FingerprintVarint(h, 1)
+	}
+	return FingerprintVarint(h, 0)
+}
+
+// FingerprintFixed32 adds the four bytes of x, little-endian, to h.
+func FingerprintFixed32(h uint64, x uint32) uint64 {
+	for i := 0; i < 4; i++ {
+		h ^= uint64(x & 0xff)
+		h *= fnvPrime64
+		x >>= 8
+	}
+	return h
+}
+
+// FingerprintFixed64 adds the eight bytes of x, little-endian, to h.
+func FingerprintFixed64(h, x uint64) uint64 {
+	for i := 0; i < 8; i++ {
+		h ^= x & 0xff
+		h *= fnvPrime64
+		x >>= 8
+	}
+	return h
+}
+
+// FingerprintBytes adds b, preceded by its length, to h.
+func FingerprintBytes(h uint64, b []byte) uint64 {
+	h = FingerprintVarint(h, uint64(len(b)))
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// FingerprintString adds s, preceded by its length, to h.
+func FingerprintString(h uint64, s string) uint64 {
+	h = FingerprintVarint(h, uint64(len(s)))
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// FingerprintMessage adds the canonical encoding of pb, preceded by its
+// length, to h. It is for messages without generated Fingerprint methods,
+// and marshals pb to do so.
+func FingerprintMessage(h uint64, pb Message) uint64 {
+	return FingerprintBytes(h, canonicalEncoding(pb))
+}
+
+// CanonicalSize returns the size of the canonical encoding of pb, without
+// unrecognized fields. It is for messages without generated Fingerprint
+// methods, and marshals pb to find it.
+func CanonicalSize(pb Message) int {
+	return len(canonicalEncoding(pb))
+}
+
+// canonicalEncoding returns the canonical encoding of pb, without
+// unrecognized fields. A fingerprint cannot fail, so unlike
+// Canonicalizer.Marshal it does not reject messages that marshal
+// themselves, and it keeps whatever was encoded before an error, such as
+// a missing required field.
+func canonicalEncoding(pb Message) []byte {
+	p := NewBuffer(nil)
+	p.deterministic = true
+	p.discardUnknown = true
+	p.Marshal(pb)
+	return p.buf
+}
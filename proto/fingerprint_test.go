@@ -0,0 +1,107 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	. "github.com/golang/protobuf/proto/testdata"
+)
+
+// fnvSum returns the 64-bit FNV-1a hash of b.
+func fnvSum(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+func TestFingerprintFunctions(t *testing.T) {
+	for _, x := range []uint64{0, 1, 127, 128, 300, math.MaxUint64} {
+		b := NewBuffer(nil)
+		b.EncodeVarint(x)
+		if got, want := FingerprintVarint(FingerprintBasis, x), fnvSum(b.Bytes()); got != want {
+			t.Errorf("FingerprintVarint(%d) = %#x, want %#x", x, got, want)
+		}
+		b.Reset()
+		b.EncodeFixed64(x)
+		if got, want := FingerprintFixed64(FingerprintBasis, x), fnvSum(b.Bytes()); got != want {
+			t.Errorf("FingerprintFixed64(%d) = %#x, want %#x", x, got, want)
+		}
+		b.Reset()
+		b.EncodeFixed32(x)
+		if got, want := FingerprintFixed32(FingerprintBasis, uint32(x)), fnvSum(b.Bytes()); got != want {
+			t.Errorf("FingerprintFixed32(%d) = %#x, want %#x", uint32(x), got, want)
+		}
+	}
+	for _, s := range []string{"", "a", string(make([]byte, 200))} {
+		b := NewBuffer(nil)
+		b.EncodeStringBytes(s)
+		want := fnvSum(b.Bytes())
+		if got := FingerprintString(FingerprintBasis, s); got != want {
+			t.Errorf("FingerprintString(%q) = %#x, want %#x", s, got, want)
+		}
+		if got := FingerprintBytes(FingerprintBasis, []byte(s)); got != want {
+			t.Errorf("FingerprintBytes(%q) = %#x, want %#x", s, got, want)
+		}
+	}
+}
+
+func TestFingerprintMessage(t *testing.T) {
+	m := &GoTestField{
+		Label:            String("label"),
+		Type:             String("type"),
+		XXX_unrecognized: []byte{0xf8, 0x07, 1},
+	}
+	enc, err := new(Canonicalizer).Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := CanonicalSize(m), len(enc); got != want {
+		t.Errorf("CanonicalSize = %d, want %d", got, want)
+	}
+	b := NewBuffer(nil)
+	b.EncodeRawBytes(enc)
+	if got, want := FingerprintMessage(FingerprintBasis, m), fnvSum(b.Bytes()); got != want {
+		t.Errorf("FingerprintMessage = %#x, want %#x", got, want)
+	}
+	sum, err := HashCanonical(m, fnv.New64a())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := binary.BigEndian.Uint64(sum), fnvSum(enc); got != want {
+		t.Errorf("HashCanonical = %#x, want %#x", got, want)
+	}
+}
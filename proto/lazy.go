@@ -0,0 +1,265 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Types and routines for decoding message fields lazily.
+ */
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// XXX_LazyFields is an internal representation of the message fields of a
+// message that have not been decoded yet.
+//
+// protoc-gen-go run with lazy_unmarshal=true marks singular message fields
+// declared with [lazy = true] with the "lazy" tag option, and embeds an
+// anonymous XXX_LazyFields in messages that have such fields. Unmarshal
+// then keeps the bytes of each such field here instead of decoding them,
+// and the field's getter decodes them on first use. Until then the field
+// itself is nil, so code must read it through its getter. Setting the
+// field directly discards the bytes kept for it.
+//
+// Decoding is logically a read, so getters may be called concurrently.
+type XXX_LazyFields struct {
+	// The struct must be indirect so that if a user inadvertently copies a
+	// generated message and its embedded XXX_LazyFields, they avoid the
+	// mayhem of a copied mutex.
+	//
+	// The mutex serializes decoding. Unmarshaling into the message writes
+	// p.fields without it, as it is not safe to do concurrently with
+	// anything else.
+	p *struct {
+		mu     sync.Mutex
+		fields map[int32]lazyField
+	}
+}
+
+// lazyField holds the bytes of a message field not decoded yet, and the
+// options of the unmarshal that found them.
+type lazyField struct {
+	enc             []byte
+	discardUnknown  bool
+	depth, maxDepth int
+}
+
+// lazyFields returns e. Generated messages gain it by embedding XXX_LazyFields.
+func (e *XXX_LazyFields) lazyFields() *XXX_LazyFields {
+	return e
+}
+
+// lazyMessage is implemented by generated messages with lazy fields.
+type lazyMessage interface {
+	lazyFields() *XXX_LazyFields
+}
+
+var lazyMessageType = reflect.TypeOf((*lazyMessage)(nil)).Elem()
+
+// DecodeLazyField decodes the field with the given number of pb, if it is a
+// lazy field that has not been decoded yet, and returns any error decoding
+// it. A field that fails to decode is left as far as it was decoded, and
+// its bytes are dropped. Generated getters of lazy fields call it and
+// ignore the error; call it or DecodeLazy first to see it.
+func DecodeLazyField(pb Message, num int32) error {
+	lm, ok := pb.(lazyMessage)
+	if !ok {
+		return nil
+	}
+	l := lm.lazyFields()
+	if l.p == nil {
+		return nil
+	}
+	l.p.mu.Lock()
+	defer l.p.mu.Unlock()
+	if _, ok := l.p.fields[num]; !ok {
+		return nil
+	}
+	t, base, err := getbase(pb)
+	if err != nil {
+		return err
+	}
+	sprop := GetProperties(t.Elem())
+	i, ok := sprop.decoderTags.get(int(num))
+	if !ok {
+		return nil
+	}
+	return l.decodeLocked(sprop.Prop[i], base)
+}
+
+// DecodeLazy decodes all the lazy fields of pb that have not been decoded
+// yet, but not those of the messages in them, and returns the first error
+// decoding them. Functions that read messages field by field, such as
+// Equal, Merge, MarshalText and package jsonpb's Marshal, call it first
+// and ignore the error.
+func DecodeLazy(pb Message) error {
+	lm, ok := pb.(lazyMessage)
+	if !ok {
+		return nil
+	}
+	l := lm.lazyFields()
+	if l.p == nil {
+		return nil
+	}
+	l.p.mu.Lock()
+	defer l.p.mu.Unlock()
+	if len(l.p.fields) == 0 {
+		return nil
+	}
+	t, base, err := getbase(pb)
+	if err != nil {
+		return err
+	}
+	sprop := GetProperties(t.Elem())
+	nums := make([]int, 0, len(l.p.fields))
+	for num := range l.p.fields {
+		nums = append(nums, int(num))
+	}
+	sort.Ints(nums)
+	for _, num := range nums {
+		if i, ok := sprop.decoderTags.get(num); ok {
+			if e := l.decodeLocked(sprop.Prop[i], base); e != nil && err == nil {
+				err = e
+			}
+		}
+	}
+	return err
+}
+
+// decodeLazyStruct decodes the lazy fields of v, a generated message
+// struct, if it is addressable.
+func decodeLazyStruct(v reflect.Value) {
+	if v.CanAddr() && reflect.PtrTo(v.Type()).Implements(lazyMessageType) {
+		DecodeLazy(v.Addr().Interface().(Message))
+	}
+}
+
+// lazyWrite returns the fields not decoded yet, creating the map on first use.
+func (e *XXX_LazyFields) lazyWrite() map[int32]lazyField {
+	if e.p == nil {
+		e.p = new(struct {
+			mu     sync.Mutex
+			fields map[int32]lazyField
+		})
+		e.p.fields = make(map[int32]lazyField)
+	}
+	return e.p.fields
+}
+
+// pending returns the bytes kept for the field with properties p in the
+// message at base, if the field is still nil and has not been decoded.
+func (e *XXX_LazyFields) pending(p *Properties, base structPointer) ([]byte, bool) {
+	if e.p == nil {
+		return nil, false
+	}
+	e.p.mu.Lock()
+	defer e.p.mu.Unlock()
+	f, ok := e.p.fields[int32(p.Tag)]
+	if !ok || !structPointer_IsNil(structPointer_GetStructPointer(base, p.field)) {
+		return nil, false
+	}
+	return f.enc, true
+}
+
+// decode decodes the field with properties p of the message at base, if
+// it has not been decoded yet.
+func (e *XXX_LazyFields) decode(p *Properties, base structPointer) error {
+	if e.p == nil {
+		return nil
+	}
+	e.p.mu.Lock()
+	defer e.p.mu.Unlock()
+	return e.decodeLocked(p, base)
+}
+
+// decodeLocked is decode for callers that hold e.p.mu.
+func (e *XXX_LazyFields) decodeLocked(p *Properties, base structPointer) error {
+	f, ok := e.p.fields[int32(p.Tag)]
+	if !ok {
+		return nil
+	}
+	delete(e.p.fields, int32(p.Tag))
+	if !structPointer_IsNil(structPointer_GetStructPointer(base, p.field)) {
+		// The field was set after the bytes were kept; it wins.
+		return nil
+	}
+	o := NewBuffer(nil)
+	o.discardUnknown = f.discardUnknown
+	o.depth, o.maxDepth = f.depth, f.maxDepth
+	return o.dec_struct_message_bytes(p, base, f.enc)
+}
+
+// Decode a lazy embedded message. If the field is already set, the message
+// is merged into it as usual; otherwise its bytes are kept for its getter.
+func (o *Buffer) dec_lazy_message(p *Properties, base structPointer) error {
+	raw, err := o.DecodeRawBytes(false)
+	if err != nil {
+		return err
+	}
+	if !structPointer_IsNil(structPointer_GetStructPointer(base, p.field)) {
+		return o.dec_struct_message_bytes(p, base, raw)
+	}
+	fields := structPointer_LazyFields(base, p.lazyField).lazyWrite()
+	f := fields[int32(p.Tag)] // may be missing
+	// A message repeated on the wire merges; so do its concatenated bytes.
+	f.enc = append(f.enc, raw...)
+	f.discardUnknown = o.discardUnknown
+	f.depth, f.maxDepth = o.depth, o.maxDepth
+	fields[int32(p.Tag)] = f
+	return nil
+}
+
+// Encode a lazy embedded message, copying its bytes if it has not been
+// decoded. Deterministic marshaling and marshaling without unrecognized
+// fields decode it first, as the bytes may have neither property.
+func (o *Buffer) enc_lazy_message(p *Properties, base structPointer) error {
+	l := structPointer_LazyFields(base, p.lazyField)
+	if o.deterministic || o.discardUnknown {
+		if err := l.decode(p, base); err != nil {
+			return err
+		}
+	} else if enc, ok := l.pending(p, base); ok {
+		o.buf = append(o.buf, p.tagcode...)
+		o.EncodeRawBytes(enc)
+		return nil
+	}
+	return o.enc_struct_message(p, base)
+}
+
+func size_lazy_message(p *Properties, base structPointer) int {
+	if enc, ok := structPointer_LazyFields(base, p.lazyField).pending(p, base); ok {
+		return len(p.tagcode) + sizeRawBytes(enc)
+	}
+	return size_struct_message(p, base)
+}
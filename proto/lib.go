@@ -319,6 +319,17 @@ type Buffer struct {
 	int64s   []int64
 	float32s []float32
 	float64s []float64
+
+	deterministic  bool // Sort map keys when marshaling; see SetDeterministic.
+	discardUnknown bool // Leave out unrecognized fields when marshaling and unmarshaling.
+
+	// Nesting depth of the message being unmarshaled, and its limit, if
+	// positive, or else DefaultMaxDepth; see UnmarshalOptions.MaxDepth.
+	depth, maxDepth int
+
+	// Number of fields unmarshaled, and their limit, if positive; see
+	// UnmarshalOptions.MaxFields.
+	fields, maxFields int
 }
 
 // NewBuffer allocates a new Buffer and initializes its internal data to
@@ -343,6 +354,16 @@ func (p *Buffer) SetBuf(s []byte) {
 // Bytes returns the contents of the Buffer.
 func (p *Buffer) Bytes() []byte { return p.buf }
 
+// SetDeterministic sets whether Marshal sorts the keys of map fields, so
+// that equal messages always marshal to the same bytes in the same binary.
+// Messages that implement Marshaler marshal themselves regardless, except
+// those generated with plugins=fastpath, which are marshaled by reflection.
+// Deterministic output is not canonical across languages or versions of
+// this package, and should not be relied upon to compare messages.
+func (p *Buffer) SetDeterministic(deterministic bool) {
+	p.deterministic = deterministic
+}
+
 /*
  * Helper routines for simplifying the creation of optional fields of basic type.
  */
@@ -441,6 +462,20 @@ func UnmarshalJSONEnum(m map[string]int32, data []byte, enumName string) (int32,
 	return val, nil
 }
 
+// ParseEnum is a helper function for the generated Parse<Enum> functions.
+// Given a map from the enum's symbolic names to its int values, it returns
+// the value of s, which may be either a name or a decimal number, as
+// written by the enum's String method for values without a name.
+func ParseEnum(m map[string]int32, s string, enumName string) (int32, error) {
+	if val, ok := m[s]; ok {
+		return val, nil
+	}
+	if val, err := strconv.ParseInt(s, 10, 32); err == nil {
+		return int32(val), nil
+	}
+	return 0, fmt.Errorf("unrecognized enum %s value %q", enumName, s)
+}
+
 // DebugPrint dumps the encoded data in b in a debugging format with a header
 // including the string s. Used in testing but made available for general debugging.
 func (p *Buffer) DebugPrint(s string, b []byte) {
@@ -0,0 +1,187 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+// Field masks: applying the paths of a google.protobuf.FieldMask.
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ValidateMask checks that each of paths, as in a google.protobuf.FieldMask,
+// names a field of m: a field's name as declared in the .proto file,
+// preceded by the names of the singular message fields holding it,
+// separated by dots. A field in a oneof, or the name of a oneof, may only
+// come last.
+func ValidateMask(m Message, paths []string) error {
+	t := reflect.TypeOf(m)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("proto: ValidateMask: %T is not a generated message", m)
+	}
+	for _, path := range paths {
+		if err := validatePath(t.Elem(), path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePath(t reflect.Type, path string) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		sprop := GetProperties(t)
+		last := i == len(names)-1
+		if _, ok := sprop.OneofTypes[name]; ok {
+			if !last {
+				return fmt.Errorf("proto: field mask path %q: %s is in a oneof, so it must come last", path, name)
+			}
+			return nil
+		}
+		fi, ok := sprop.decoderOrigNames[name]
+		if !ok {
+			return fmt.Errorf("proto: field mask path %q: %v has no field %q", path, t, name)
+		}
+		if last {
+			return nil
+		}
+		f := t.Field(fi)
+		if f.Type.Kind() != reflect.Ptr || f.Type.Elem().Kind() != reflect.Struct {
+			return fmt.Errorf("proto: field mask path %q: %s is not a singular message field", path, name)
+		}
+		t = f.Type.Elem()
+	}
+	return nil
+}
+
+// MergeWithMask merges the fields of src named by paths into dst, as the
+// documentation of google.protobuf.FieldMask describes for updates: a
+// message field is merged into the one in dst, a repeated field is
+// appended to the one in dst, map entries replace those with the same
+// keys, and other fields are copied, so that a field unset in src is
+// cleared in dst. Fields not named are left alone.
+//
+// It returns the error ValidateMask returns for paths, if any, before
+// changing dst. It panics if src and dst are not the same type, or if dst
+// is nil.
+func MergeWithMask(dst, src Message, paths []string) error {
+	return applyMask(dst, src, paths, false)
+}
+
+// UpdateWithMask is like MergeWithMask, but sets each field of dst named by
+// paths to a copy of the field in src, as partial update RPCs usually want:
+// messages, repeated fields and maps are replaced rather than merged.
+func UpdateWithMask(dst, src Message, paths []string) error {
+	return applyMask(dst, src, paths, true)
+}
+
+func applyMask(dst, src Message, paths []string, replace bool) error {
+	in := reflect.ValueOf(src)
+	out := reflect.ValueOf(dst)
+	if out.IsNil() {
+		panic("proto: nil destination")
+	}
+	if in.Type() != out.Type() {
+		panic("proto: type mismatch")
+	}
+	if err := ValidateMask(dst, paths); err != nil {
+		return err
+	}
+	for _, path := range paths {
+		maskPath(out, in, strings.Split(path, "."), replace)
+	}
+	return nil
+}
+
+// maskPath applies the path names to out, a non-nil pointer to a message,
+// from in, a pointer to a message of the same type that may be nil.
+func maskPath(out, in reflect.Value, names []string, replace bool) {
+	decodeLazyStruct(out.Elem())
+	if !in.IsNil() {
+		decodeLazyStruct(in.Elem())
+	}
+	sprop := GetProperties(out.Elem().Type())
+	if oop, ok := sprop.OneofTypes[names[0]]; ok {
+		// Only this field of the oneof: copy it if src has it set,
+		// and otherwise clear the oneof if dst has it set.
+		dv := out.Elem().Field(oop.Field)
+		if !in.IsNil() {
+			if sv := in.Elem().Field(oop.Field); !sv.IsNil() && sv.Elem().Type() == oop.Type {
+				if replace {
+					dv.Set(reflect.Zero(dv.Type()))
+				}
+				mergeAny(dv, sv, false, nil)
+				return
+			}
+		}
+		if !dv.IsNil() && dv.Elem().Type() == oop.Type {
+			dv.Set(reflect.Zero(dv.Type()))
+		}
+		return
+	}
+
+	i := sprop.decoderOrigNames[names[0]]
+	dv := out.Elem().Field(i)
+	sv := reflect.Zero(dv.Type())
+	if !in.IsNil() {
+		sv = in.Elem().Field(i)
+	}
+
+	if len(names) > 1 {
+		// A singular message field, to look into.
+		if sv.IsNil() && dv.IsNil() {
+			return
+		}
+		if dv.IsNil() {
+			dv.Set(reflect.New(dv.Type().Elem()))
+		}
+		maskPath(dv, sv, names[1:], replace)
+		return
+	}
+
+	merge := false
+	if !replace {
+		switch t := dv.Type(); t.Kind() {
+		case reflect.Ptr:
+			merge = t.Elem().Kind() == reflect.Struct
+		case reflect.Slice:
+			merge = t.Elem().Kind() != reflect.Uint8
+		case reflect.Map:
+			merge = true
+		}
+	}
+	if !merge {
+		dv.Set(reflect.Zero(dv.Type()))
+	}
+	mergeAny(dv, sv, false, sprop.Prop[i])
+}
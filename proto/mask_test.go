@@ -0,0 +1,246 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+func maskDst() *pb.MyMessage {
+	return &pb.MyMessage{
+		Count:  Int32(1),
+		Name:   String("old"),
+		Quote:  String("keep"),
+		Pet:    []string{"cat"},
+		Inner:  &pb.InnerMessage{Host: String("h1"), Port: Int32(1)},
+		Others: []*pb.OtherMessage{{Key: Int64(1)}},
+	}
+}
+
+func maskSrc() *pb.MyMessage {
+	return &pb.MyMessage{
+		Count:  Int32(2),
+		Name:   String("new"),
+		Quote:  String("ignored"),
+		Pet:    []string{"dog"},
+		Inner:  &pb.InnerMessage{Host: String("h2")},
+		Others: []*pb.OtherMessage{{Key: Int64(2)}},
+	}
+}
+
+var maskTests = []struct {
+	desc          string
+	paths         []string
+	merge, update *pb.MyMessage // dst after MergeWithMask and UpdateWithMask
+}{
+	{
+		"scalar",
+		[]string{"name"},
+		&pb.MyMessage{Count: Int32(1), Name: String("new"), Quote: String("keep"), Pet: []string{"cat"},
+			Inner: &pb.InnerMessage{Host: String("h1"), Port: Int32(1)}, Others: []*pb.OtherMessage{{Key: Int64(1)}}},
+		&pb.MyMessage{Count: Int32(1), Name: String("new"), Quote: String("keep"), Pet: []string{"cat"},
+			Inner: &pb.InnerMessage{Host: String("h1"), Port: Int32(1)}, Others: []*pb.OtherMessage{{Key: Int64(1)}}},
+	},
+	{
+		"message and repeated",
+		[]string{"inner", "pet", "others"},
+		&pb.MyMessage{Count: Int32(1), Name: String("old"), Quote: String("keep"), Pet: []string{"cat", "dog"},
+			Inner: &pb.InnerMessage{Host: String("h2"), Port: Int32(1)}, Others: []*pb.OtherMessage{{Key: Int64(1)}, {Key: Int64(2)}}},
+		&pb.MyMessage{Count: Int32(1), Name: String("old"), Quote: String("keep"), Pet: []string{"dog"},
+			Inner: &pb.InnerMessage{Host: String("h2")}, Others: []*pb.OtherMessage{{Key: Int64(2)}}},
+	},
+	{
+		"nested field",
+		[]string{"inner.port"},
+		&pb.MyMessage{Count: Int32(1), Name: String("old"), Quote: String("keep"), Pet: []string{"cat"},
+			Inner: &pb.InnerMessage{Host: String("h1")}, Others: []*pb.OtherMessage{{Key: Int64(1)}}},
+		&pb.MyMessage{Count: Int32(1), Name: String("old"), Quote: String("keep"), Pet: []string{"cat"},
+			Inner: &pb.InnerMessage{Host: String("h1")}, Others: []*pb.OtherMessage{{Key: Int64(1)}}},
+	},
+}
+
+func TestMergeWithMask(t *testing.T) {
+	for _, tc := range maskTests {
+		dst := maskDst()
+		if err := MergeWithMask(dst, maskSrc(), tc.paths); err != nil {
+			t.Errorf("%s: MergeWithMask: %v", tc.desc, err)
+		} else if !Equal(dst, tc.merge) {
+			t.Errorf("%s: MergeWithMask gave %v, want %v", tc.desc, dst, tc.merge)
+		}
+
+		dst = maskDst()
+		if err := UpdateWithMask(dst, maskSrc(), tc.paths); err != nil {
+			t.Errorf("%s: UpdateWithMask: %v", tc.desc, err)
+		} else if !Equal(dst, tc.update) {
+			t.Errorf("%s: UpdateWithMask gave %v, want %v", tc.desc, dst, tc.update)
+		}
+	}
+}
+
+func TestMaskCopies(t *testing.T) {
+	dst, src := maskDst(), maskSrc()
+	if err := UpdateWithMask(dst, src, []string{"inner", "others"}); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Inner == src.Inner || dst.Others[0] == src.Others[0] {
+		t.Error("UpdateWithMask shares messages with src")
+	}
+}
+
+func TestMaskUnsetPath(t *testing.T) {
+	// A path through a message unset in both leaves it unset.
+	dst := &pb.MyMessage{Count: Int32(1)}
+	if err := MergeWithMask(dst, &pb.MyMessage{}, []string{"inner.host"}); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Inner != nil {
+		t.Errorf("Inner = %v, want nil", dst.Inner)
+	}
+
+	// A path through a message unset in src clears the field in dst.
+	dst = maskDst()
+	if err := MergeWithMask(dst, &pb.MyMessage{}, []string{"inner.host"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := (&pb.InnerMessage{Port: Int32(1)}); !Equal(dst.Inner, want) {
+		t.Errorf("Inner = %v, want %v", dst.Inner, want)
+	}
+
+	// A path through a message unset in dst creates it.
+	dst = &pb.MyMessage{Count: Int32(1)}
+	if err := MergeWithMask(dst, maskSrc(), []string{"inner.host"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := (&pb.InnerMessage{Host: String("h2")}); !Equal(dst.Inner, want) {
+		t.Errorf("Inner = %v, want %v", dst.Inner, want)
+	}
+}
+
+func TestMaskProto3(t *testing.T) {
+	dst := &proto3pb.Message{Name: "old", ResultCount: 7, Terrain: map[string]*proto3pb.Nested{"a": {Bunny: "a"}}}
+	src := &proto3pb.Message{Terrain: map[string]*proto3pb.Nested{"b": {Bunny: "b"}}}
+	if err := MergeWithMask(dst, src, []string{"name", "terrain"}); err != nil {
+		t.Fatal(err)
+	}
+	want := &proto3pb.Message{ResultCount: 7, Terrain: map[string]*proto3pb.Nested{"a": {Bunny: "a"}, "b": {Bunny: "b"}}}
+	if !Equal(dst, want) {
+		t.Errorf("MergeWithMask gave %v, want %v", dst, want)
+	}
+	if err := UpdateWithMask(dst, src, []string{"terrain"}); err != nil {
+		t.Fatal(err)
+	}
+	want.Terrain = src.Terrain
+	if !Equal(dst, want) {
+		t.Errorf("UpdateWithMask gave %v, want %v", dst, want)
+	}
+}
+
+func TestMaskOneof(t *testing.T) {
+	src := &pb.Communique{Union: &pb.Communique_Number{41}}
+
+	dst := &pb.Communique{Union: &pb.Communique_Name{"Bobby Tables"}}
+	if err := MergeWithMask(dst, src, []string{"number"}); err != nil {
+		t.Fatal(err)
+	}
+	if !Equal(dst, src) {
+		t.Errorf("MergeWithMask number gave %v, want %v", dst, src)
+	}
+
+	// A oneof field not set in src is cleared only if set in dst.
+	dst = &pb.Communique{Union: &pb.Communique_Name{"Bobby Tables"}}
+	if err := MergeWithMask(dst, src, []string{"name"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := (&pb.Communique{}); !Equal(dst, want) {
+		t.Errorf("MergeWithMask name gave %v, want %v", dst, want)
+	}
+	dst = &pb.Communique{Union: &pb.Communique_TempC{3}}
+	if err := MergeWithMask(dst, src, []string{"name"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := (&pb.Communique{Union: &pb.Communique_TempC{3}}); !Equal(dst, want) {
+		t.Errorf("MergeWithMask name gave %v, want %v", dst, want)
+	}
+
+	// The oneof's own name copies whichever field is set.
+	dst = &pb.Communique{Union: &pb.Communique_Name{"Bobby Tables"}}
+	if err := MergeWithMask(dst, src, []string{"union"}); err != nil {
+		t.Fatal(err)
+	}
+	if !Equal(dst, src) {
+		t.Errorf("MergeWithMask union gave %v, want %v", dst, src)
+	}
+}
+
+func TestValidateMask(t *testing.T) {
+	good := [][]string{
+		nil,
+		{"name"},
+		{"inner.host", "inner"},
+		{"others", "we_must_go_deeper.leo_finally_won_an_oscar.host"},
+	}
+	for _, paths := range good {
+		if err := ValidateMask(&pb.MyMessage{}, paths); err != nil {
+			t.Errorf("ValidateMask(%q) = %v, want nil", paths, err)
+		}
+	}
+	bad := [][]string{
+		{""},
+		{"nope"},
+		{"Name"},
+		{"inner."},
+		{"name.x"},
+		{"others.key"},
+		{"pet.x"},
+	}
+	for _, paths := range bad {
+		if err := ValidateMask(&pb.MyMessage{}, paths); err == nil {
+			t.Errorf("ValidateMask(%q) = nil, want an error", paths)
+		}
+	}
+	if err := ValidateMask(&pb.Communique{}, []string{"msg.string_field"}); err == nil {
+		t.Error("ValidateMask through a oneof = nil, want an error")
+	}
+
+	// An invalid mask leaves dst alone.
+	dst := maskDst()
+	if err := MergeWithMask(dst, maskSrc(), []string{"name", "nope"}); err == nil {
+		t.Error("MergeWithMask with a bad path succeeded")
+	}
+	if !Equal(dst, maskDst()) {
+		t.Errorf("MergeWithMask with a bad path changed dst to %v", dst)
+	}
+}
@@ -149,6 +149,11 @@ func structPointer_ExtMap(p structPointer, f field) *map[int32]Extension {
 	return structPointer_ifield(p, f).(*map[int32]Extension)
 }
 
+// LazyFields returns the address of the lazy fields in the struct.
+func structPointer_LazyFields(p structPointer, f field) *XXX_LazyFields {
+	return structPointer_ifield(p, f).(*XXX_LazyFields)
+}
+
 // NewAt returns the reflect.Value for a pointer to a field in the struct.
 func structPointer_NewAt(p structPointer, f field, typ reflect.Type) reflect.Value {
 	return structPointer_field(p, f).Addr()
@@ -134,6 +134,11 @@ func structPointer_ExtMap(p structPointer, f field) *map[int32]Extension {
 	return (*map[int32]Extension)(unsafe.Pointer(uintptr(p) + uintptr(f)))
 }
 
+// LazyFields returns the address of the lazy fields in the struct.
+func structPointer_LazyFields(p structPointer, f field) *XXX_LazyFields {
+	return (*XXX_LazyFields)(unsafe.Pointer(uintptr(p) + uintptr(f)))
+}
+
 // NewAt returns the reflect.Value for a pointer to a field in the struct.
 func structPointer_NewAt(p structPointer, f field, typ reflect.Type) reflect.Value {
 	return reflect.NewAt(typ, unsafe.Pointer(uintptr(p)+uintptr(f)))
@@ -184,6 +184,9 @@ type Properties struct {
 	Enum     string // set for enum types only
 	proto3   bool   // whether this is known to be a proto3 field; set for []byte only
 	oneof    bool   // whether this is a oneof field
+	lazy     bool   // whether this message field is decoded on first use
+
+	Sensitive bool // whether the value must not be logged; see Redact
 
 	Default    string // default value
 	HasDefault bool   // whether an explicit default was provided
@@ -199,6 +202,8 @@ type Properties struct {
 	isMarshaler   bool
 	isUnmarshaler bool
 
+	lazyField field // field id of the message's XXX_LazyFields; set for lazy fields only
+
 	mtype    reflect.Type // set for map types only
 	mkeyprop *Properties  // set for map types only
 	mvalprop *Properties  // set for map types only
@@ -240,6 +245,12 @@ func (p *Properties) String() string {
 	if p.oneof {
 		s += ",oneof"
 	}
+	if p.lazy {
+		s += ",lazy"
+	}
+	if p.Sensitive {
+		s += ",sensitive"
+	}
 	if len(p.Enum) > 0 {
 		s += ",enum=" + p.Enum
 	}
@@ -320,6 +331,10 @@ func (p *Properties) Parse(s string) {
 			p.proto3 = true
 		case f == "oneof":
 			p.oneof = true
+		case f == "lazy":
+			p.lazy = true
+		case f == "sensitive":
+			p.Sensitive = true
 		case strings.HasPrefix(f, "def="):
 			p.HasDefault = true
 			p.Default = f[4:] // rest of string
@@ -720,6 +735,19 @@ func getPropertiesLocked(t reflect.Type) *StructProperties {
 		}
 	}
 
+	// Lazy message fields keep their bytes in the XXX_LazyFields of the
+	// message; without one, they are decoded as usual.
+	if lf, ok := t.FieldByName("XXX_LazyFields"); ok {
+		for _, p := range prop.Prop {
+			if p.lazy && p.Wire == "bytes" && p.stype != nil && !p.Repeated {
+				p.lazyField = toField(&lf)
+				p.enc = (*Buffer).enc_lazy_message
+				p.dec = (*Buffer).dec_lazy_message
+				p.size = size_lazy_message
+			}
+		}
+	}
+
 	// Re-order prop.order.
 	sort.Sort(prop)
 
@@ -870,3 +898,30 @@ func RegisterFile(filename string, fileDescriptor []byte) {
 
 // FileDescriptor returns the compressed FileDescriptorProto for a .proto file.
 func FileDescriptor(filename string) []byte { return protoFiles[filename] }
+
+// RegisteredFiles returns the names of the registered .proto files, sorted.
+func RegisteredFiles() []string {
+	names := make([]string, 0, len(protoFiles))
+	for name := range protoFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// A registry of the FileDescriptorSets embedded by protoc-gen-go with
+// descriptor_set=true.
+var (
+	protoFileSets = make(map[string][]byte) // file name => fileDescriptorSet
+)
+
+// RegisterFileDescriptorSet is called from code generated with
+// descriptor_set=true and maps from the full file name of a .proto file to
+// a compressed FileDescriptorSet holding it and the files it imports.
+func RegisterFileDescriptorSet(filename string, fileDescriptorSet []byte) {
+	protoFileSets[filename] = fileDescriptorSet
+}
+
+// FileDescriptorSet returns the compressed FileDescriptorSet for a .proto
+// file, or nil if its code was not generated with descriptor_set=true.
+func FileDescriptorSet(filename string) []byte { return protoFileSets[filename] }
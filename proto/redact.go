@@ -0,0 +1,133 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+// Redaction: withholding the values of sensitive fields.
+
+import (
+	"reflect"
+	"strings"
+)
+
+// RedactedValue replaces the value of a sensitive field in the output of
+// Redact and of the marshalers that redact.
+const RedactedValue = "***"
+
+// Redact replaces, in place, the value of each sensitive field of pb and of
+// the messages it holds: strings and bytes become RedactedValue, and fields
+// of other types are cleared. A field is sensitive if its struct tag says
+// so, as it does for fields with the (carno.sensitive) option in code
+// generated with plugins=carno. Extensions are left as they are.
+//
+// To log a message without changing it, redact a Clone, or write it with
+// CompactTextString, which redacts by itself.
+func Redact(pb Message) {
+	v := reflect.ValueOf(pb)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	redactStruct(v.Elem())
+}
+
+func redactStruct(sv reflect.Value) {
+	decodeLazyStruct(sv)
+	st := sv.Type()
+	sprops := GetProperties(st)
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		fv := sv.Field(i)
+		if f.Tag.Get("protobuf_oneof") != "" {
+			if fv.IsNil() {
+				continue
+			}
+			// The value is a pointer to a wrapper struct whose only
+			// field is the one that is set.
+			w := fv.Elem().Elem()
+			var props Properties
+			props.Parse(w.Type().Field(0).Tag.Get("protobuf"))
+			redactField(w.Field(0), props.Sensitive)
+			continue
+		}
+		redactField(fv, sprops.Prop[i].Sensitive)
+	}
+}
+
+// redactField withholds the value of v, a field of a message, if it is
+// sensitive, and otherwise redacts the messages it holds.
+func redactField(v reflect.Value, sensitive bool) {
+	if !sensitive {
+		switch v.Kind() {
+		case reflect.Ptr:
+			if !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+				redactStruct(v.Elem())
+			}
+		case reflect.Slice:
+			if v.Type().Elem().Kind() == reflect.Ptr {
+				for i := 0; i < v.Len(); i++ {
+					redactField(v.Index(i), false)
+				}
+			}
+		case reflect.Map:
+			if v.Type().Elem().Kind() == reflect.Ptr {
+				for _, k := range v.MapKeys() {
+					redactField(v.MapIndex(k), false)
+				}
+			}
+		}
+		return
+	}
+
+	switch t := v.Type(); {
+	case t.Kind() == reflect.String:
+		if v.Len() > 0 {
+			v.SetString(RedactedValue)
+		}
+	case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.String:
+		if !v.IsNil() {
+			v.Set(reflect.ValueOf(String(RedactedValue)))
+		}
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		if v.Len() > 0 {
+			v.SetBytes([]byte(RedactedValue))
+		}
+	case t.Kind() == reflect.Slice && (t.Elem().Kind() == reflect.String || t.Elem().Kind() == reflect.Slice):
+		// Repeated strings or bytes.
+		for i := 0; i < v.Len(); i++ {
+			redactField(v.Index(i), true)
+		}
+	default:
+		v.Set(reflect.Zero(t))
+	}
+}
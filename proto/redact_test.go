@@ -0,0 +1,92 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"fmt"
+	"testing"
+
+	pb "github.com/golang/protobuf/logpb/logpb_test_proto"
+	"github.com/golang/protobuf/proto"
+)
+
+func newLogin() *pb.Login {
+	return &pb.Login{
+		User:          "gopher",
+		Password:      "hunter2",
+		Scopes:        []string{"read"},
+		Delegate:      &pb.Login{User: "admin", Pin: 1234},
+		Credential:    &pb.Login_Otp{Otp: "123456"},
+		RecoveryCodes: []string{"a1", "b2"},
+		Pin:           4321,
+	}
+}
+
+func TestRedact(t *testing.T) {
+	m := newLogin()
+	proto.Redact(m)
+	want := &pb.Login{
+		User:          "gopher",
+		Password:      "***",
+		Scopes:        []string{"read"},
+		Delegate:      &pb.Login{User: "admin"},
+		Credential:    &pb.Login_Otp{Otp: "***"},
+		RecoveryCodes: []string{"***", "***"},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("Redact gave %v\nwant %v", m, want)
+	}
+
+	// Unset sensitive fields stay unset.
+	m = &pb.Login{User: "gopher"}
+	proto.Redact(m)
+	if !proto.Equal(m, &pb.Login{User: "gopher"}) {
+		t.Errorf("Redact set fields of %v", m)
+	}
+}
+
+func TestStringRedactsSensitive(t *testing.T) {
+	m := newLogin()
+	const want = `user:"gopher" password:*** scopes:"read" delegate:<user:"admin" pin:*** > otp:*** recovery_codes:*** pin:*** `
+	if got := fmt.Sprintf("%v", m); got != want {
+		t.Errorf("%%v = %q\nwant %q", got, want)
+	}
+
+	// The full text format keeps sensitive fields, so that it can be read back.
+	got := new(pb.Login)
+	if err := proto.UnmarshalText(proto.MarshalTextString(m), got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, m) {
+		t.Errorf("round trip gave %v, want %v", got, m)
+	}
+}
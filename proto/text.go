@@ -70,8 +70,9 @@ type writer interface {
 // textWriter is an io.Writer that tracks its indentation level.
 type textWriter struct {
 	ind      int
-	complete bool // if the current position is a complete line
-	compact  bool // whether to write out as a one-liner
+	complete bool   // if the current position is a complete line
+	compact  bool   // whether to write out as a one-liner
+	indStr   string // written once per level; two spaces if empty
 	w        writer
 }
 
@@ -195,14 +196,31 @@ func requiresQuotes(u string) bool {
 }
 
 // isAny reports whether sv is a google.protobuf.Any message
+// wkt is the interface satisfied by the well-known types.
+type wkt interface {
+	XXX_WellKnownType() string
+}
+
 func isAny(sv reflect.Value) bool {
-	type wkt interface {
-		XXX_WellKnownType() string
-	}
 	t, ok := sv.Addr().Interface().(wkt)
 	return ok && t.XXX_WellKnownType() == "Any"
 }
 
+// isWrapper reports whether sv is one of the wrapper messages, such as
+// google.protobuf.Int32Value, whose only field is its value.
+func isWrapper(sv reflect.Value) bool {
+	t, ok := sv.Addr().Interface().(wkt)
+	if !ok {
+		return false
+	}
+	switch t.XXX_WellKnownType() {
+	case "DoubleValue", "FloatValue", "Int64Value", "UInt64Value",
+		"Int32Value", "UInt32Value", "BoolValue", "StringValue", "BytesValue":
+		return true
+	}
+	return false
+}
+
 // writeProto3Any writes an expanded google.protobuf.Any message.
 //
 // It returns (false, nil) if sv value can't be unmarshaled (e.g. because
@@ -262,6 +280,7 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 			return err
 		}
 	}
+	decodeLazyStruct(sv)
 	st := sv.Type()
 	sprops := GetProperties(st)
 	for i := 0; i < sv.NumField(); i++ {
@@ -293,6 +312,26 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 			continue
 		}
 
+		if fv.Kind() != reflect.Interface && tm.redacted(sv, props) {
+			switch fv.Kind() {
+			case reflect.Slice, reflect.Map:
+				if fv.Len() == 0 {
+					continue
+				}
+			case reflect.Ptr:
+				// Set; nil pointers were skipped above.
+			default:
+				// proto3 non-repeated scalar field; skip if zero value
+				if isProto3Zero(fv) {
+					continue
+				}
+			}
+			if err := writeRedacted(w, props); err != nil {
+				return err
+			}
+			continue
+		}
+
 		if props.Repeated && fv.Kind() == reflect.Slice {
 			// Repeated field.
 			for j := 0; j < fv.Len(); j++ {
@@ -425,6 +464,12 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 					msg := errors.New("/* nil */")
 					fv = reflect.ValueOf(&msg).Elem()
 				}
+				if tm.redacted(sv, props) {
+					if err := writeRedacted(w, props); err != nil {
+						return err
+					}
+					continue
+				}
 			}
 		}
 
@@ -464,6 +509,35 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 	return nil
 }
 
+// redacted reports whether the field of sv described by props is to be
+// written as RedactedValue.
+func (tm *TextMarshaler) redacted(sv reflect.Value, props *Properties) bool {
+	if tm.RedactSensitive && props.Sensitive {
+		return true
+	}
+	if tm.Redact == nil || !sv.CanAddr() {
+		return false
+	}
+	pb, ok := sv.Addr().Interface().(Message)
+	return ok && tm.Redact(pb, props.OrigName)
+}
+
+// writeRedacted writes a field whose value is withheld.
+func writeRedacted(w *textWriter, props *Properties) error {
+	if _, err := w.WriteString(props.OrigName + ":"); err != nil {
+		return err
+	}
+	if !w.compact {
+		if err := w.WriteByte(' '); err != nil {
+			return err
+		}
+	}
+	if _, err := w.WriteString(RedactedValue); err != nil {
+		return err
+	}
+	return w.WriteByte('\n')
+}
+
 // writeRaw writes an uninterpreted raw message.
 func writeRaw(w *textWriter, b []byte) error {
 	if err := w.WriteByte('<'); err != nil {
@@ -521,6 +595,9 @@ func (tm *TextMarshaler) writeAny(w *textWriter, v reflect.Value, props *Propert
 			return err
 		}
 	case reflect.Struct:
+		if tm.InlineWrappers && isWrapper(v) {
+			return tm.writeAny(w, v.Field(0), GetProperties(v.Type()).Prop[0])
+		}
 		// Required/optional group/message.
 		var bra, ket byte = '<', '>'
 		if props != nil && props.Wire == "group" {
@@ -764,6 +841,13 @@ func (w *textWriter) writeIndent() {
 	if !w.complete {
 		return
 	}
+	if w.indStr != "" {
+		for i := 0; i < w.ind; i++ {
+			io.WriteString(w.w, w.indStr)
+		}
+		w.complete = false
+		return
+	}
 	remain := w.ind * 2
 	for remain > 0 {
 		n := remain
@@ -780,6 +864,28 @@ func (w *textWriter) writeIndent() {
 type TextMarshaler struct {
 	Compact   bool // use compact text format (one line).
 	ExpandAny bool // expand google.protobuf.Any messages of known types
+
+	// InlineWrappers writes fields whose type is a wrapper message, such
+	// as google.protobuf.Int32Value, as their value alone, as jsonpb
+	// does: "n: 3" rather than "n: <value: 3>". The text parser accepts
+	// both forms.
+	InlineWrappers bool
+
+	// Indent is written once for each level of nesting. It defaults to
+	// two spaces, and is ignored by the compact format.
+	Indent string
+
+	// RedactSensitive writes the value of each field that is sensitive,
+	// as Redact describes, as RedactedValue.
+	RedactSensitive bool
+
+	// Redact, if not nil, is asked about each other set field of each
+	// message written, by the field's name in the .proto file. The value of
+	// a field it reports is written as RedactedValue.
+	//
+	// The text parser rejects RedactedValue, so redacted output is for
+	// logs rather than for reading back.
+	Redact func(pb Message, field string) bool
 }
 
 // Marshal writes a given protocol buffer in text format.
@@ -800,6 +906,7 @@ func (tm *TextMarshaler) Marshal(w io.Writer, pb Message) error {
 		w:        ww,
 		complete: true,
 		compact:  tm.Compact,
+		indStr:   tm.Indent,
 	}
 
 	if etm, ok := pb.(encoding.TextMarshaler); ok {
@@ -835,7 +942,7 @@ func (tm *TextMarshaler) Text(pb Message) string {
 
 var (
 	defaultTextMarshaler = TextMarshaler{}
-	compactTextMarshaler = TextMarshaler{Compact: true}
+	compactTextMarshaler = TextMarshaler{Compact: true, RedactSensitive: true}
 )
 
 // TODO: consider removing some of the Marshal functions below.
@@ -848,6 +955,8 @@ func MarshalText(w io.Writer, pb Message) error { return defaultTextMarshaler.Ma
 func MarshalTextString(pb Message) string { return defaultTextMarshaler.Text(pb) }
 
 // CompactText writes a given protocol buffer in compact text format (one line).
+// Generated String methods use it, so it redacts sensitive fields, as Redact
+// describes, to keep them out of logs that format messages with %v.
 func CompactText(w io.Writer, pb Message) error { return compactTextMarshaler.Marshal(w, pb) }
 
 // CompactTextString is the same as CompactText, but returns the string directly.
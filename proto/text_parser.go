@@ -47,10 +47,12 @@ import (
 // Error string emitted when deserializing Any and fields are already set
 const anyRepeatedlyUnpacked = "Any message unpacked multiple times, or %q already set"
 
+// A ParseError reports malformed text format input and where it was found.
 type ParseError struct {
 	Message string
 	Line    int // 1-based line number
 	Offset  int // 0-based byte offset from start of input
+	Column  int // 1-based column, in runes, within Line
 }
 
 func (p *ParseError) Error() string {
@@ -58,7 +60,7 @@ func (p *ParseError) Error() string {
 		// show offset only for first line
 		return fmt.Sprintf("line 1.%d: %v", p.Offset, p.Message)
 	}
-	return fmt.Sprintf("line %d: %v", p.Line, p.Message)
+	return fmt.Sprintf("line %d.%d: %v", p.Line, p.Column-1, p.Message)
 }
 
 type token struct {
@@ -77,15 +79,18 @@ func (t *token) String() string {
 }
 
 type textParser struct {
+	src          string // whole input, for error columns
 	s            string // remaining input
 	done         bool   // whether the parsing is finished (success or error)
 	backed       bool   // whether back() was called
 	offset, line int
 	cur          token
+	opts         TextUnmarshalOptions
 }
 
 func newTextParser(s string) *textParser {
 	p := new(textParser)
+	p.src = s
 	p.s = s
 	p.line = 1
 	p.cur.line = 1
@@ -93,12 +98,19 @@ func newTextParser(s string) *textParser {
 }
 
 func (p *textParser) errorf(format string, a ...interface{}) *ParseError {
-	pe := &ParseError{fmt.Sprintf(format, a...), p.cur.line, p.cur.offset}
+	pe := p.parseError(fmt.Sprintf(format, a...), p.cur.line, p.cur.offset)
 	p.cur.err = pe
 	p.done = true
 	return pe
 }
 
+// parseError returns a ParseError for msg at the given position of the input.
+func (p *textParser) parseError(msg string, line, offset int) *ParseError {
+	start := strings.LastIndex(p.src[:offset], "\n") + 1
+	col := utf8.RuneCountInString(p.src[start:offset]) + 1
+	return &ParseError{Message: msg, Line: line, Offset: offset, Column: col}
+}
+
 // Numbers and identifiers are matched by [-+._A-Za-z0-9]
 func isIdentOrNumberChar(c byte) bool {
 	switch {
@@ -114,6 +126,11 @@ func isIdentOrNumberChar(c byte) bool {
 	return false
 }
 
+// Identifiers, unlike numbers, start with [_A-Za-z]
+func isIdentStart(c byte) bool {
+	return c == '_' || 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z'
+}
+
 func isWhitespace(c byte) bool {
 	switch c {
 	case ' ', '\t', '\n', '\r':
@@ -481,6 +498,12 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 				messageName := extName[s+1:]
 				mt := MessageType(messageName)
 				if mt == nil {
+					if p.opts.AllowUnknownFields {
+						if err := p.skipField(); err != nil {
+							return err
+						}
+						continue
+					}
 					return p.errorf("unrecognized message %q in google.protobuf.Any", messageName)
 				}
 				tok = p.next()
@@ -534,6 +557,12 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 				}
 			}
 			if desc == nil {
+				if p.opts.AllowUnknownFields {
+					if err := p.skipField(); err != nil {
+						return err
+					}
+					continue
+				}
 				return p.errorf("unrecognized extension %q", extName)
 			}
 
@@ -555,14 +584,19 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 			} else {
 				ext = reflect.New(typ.Elem()).Elem()
 			}
-			if err := p.readAny(ext, props); err != nil {
+			skip := false
+			if err := p.readAny(ext, props); err == errUnknownEnumName {
+				skip = true
+			} else if err != nil {
 				if _, ok := err.(*RequiredNotSetError); !ok {
 					return err
 				}
 				reqFieldErr = err
 			}
 			ep := sv.Addr().Interface().(Message)
-			if !rep {
+			if skip {
+				// Leave the extension unset.
+			} else if !rep {
 				SetExtension(ep, desc, ext.Interface())
 			} else {
 				old, err := GetExtension(ep, desc)
@@ -599,6 +633,12 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 			field.Set(nv)
 		}
 		if !dst.IsValid() {
+			if p.opts.AllowUnknownFields {
+				if err := p.skipField(); err != nil {
+					return err
+				}
+				continue
+			}
 			return p.errorf("unknown field name %q in %v", name, st)
 		}
 
@@ -654,7 +694,10 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 					if err := p.checkForColon(props.mvalprop, dst.Type().Elem()); err != nil {
 						return err
 					}
-					if err := p.readAny(val, props.mvalprop); err != nil {
+					if err := p.readAny(val, props.mvalprop); err == errUnknownEnumName {
+						// Drop the entry rather than map the key to the zero value.
+						val = reflect.Value{}
+					} else if err != nil {
 						return err
 					}
 					if err := p.consumeOptionalSeparator(); err != nil {
@@ -666,7 +709,9 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 				}
 			}
 
-			dst.SetMapIndex(key, val)
+			if val.IsValid() {
+				dst.SetMapIndex(key, val)
+			}
 			continue
 		}
 
@@ -681,7 +726,19 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 
 		// Parse into the field.
 		fieldSet[name] = true
-		if err := p.readAny(dst, props); err != nil {
+		if err := p.readAny(dst, props); err == errUnknownEnumName {
+			// The field stays unset, as it would for an unknown field.
+			if oop, ok := sprops.OneofTypes[name]; ok {
+				sv.Field(oop.Field).Set(reflect.Zero(sv.Field(oop.Field).Type()))
+			}
+			if !props.Repeated {
+				fieldSet[name] = false
+			}
+			if err := p.consumeOptionalSeparator(); err != nil {
+				return err
+			}
+			continue
+		} else if err != nil {
 			if _, ok := err.(*RequiredNotSetError); !ok {
 				return err
 			}
@@ -728,6 +785,9 @@ func (p *textParser) consumeExtName() (string, error) {
 		if tok.err != nil {
 			return "", p.errorf("unrecognized type_url or extension name: %s", tok.err)
 		}
+		if p.done && tok.value != "]" {
+			return "", p.errorf("unclosed type_url or extension name")
+		}
 	}
 	return strings.Join(parts, ""), nil
 }
@@ -745,6 +805,97 @@ func (p *textParser) consumeOptionalSeparator() error {
 	return nil
 }
 
+// skipField consumes the value of a field the message does not declare,
+// after its name has been read. The value is checked only for being
+// well-formed text format, since there is no type to check it against.
+func (p *textParser) skipField() error {
+	tok := p.next()
+	if tok.err != nil {
+		return tok.err
+	}
+	if tok.value == ":" {
+		if err := p.skipValue(); err != nil {
+			return err
+		}
+	} else if tok.value == "{" || tok.value == "<" {
+		// The colon is optional before a message.
+		p.back()
+		if err := p.skipValue(); err != nil {
+			return err
+		}
+	} else {
+		return p.errorf("expected ':', found %q", tok.value)
+	}
+	return p.consumeOptionalSeparator()
+}
+
+// skipValue consumes a scalar, a message or a list of either.
+func (p *textParser) skipValue() error {
+	tok := p.next()
+	if tok.err != nil {
+		return tok.err
+	}
+	switch tok.value {
+	case "":
+		return p.errorf("unexpected EOF")
+	case "{", "<":
+		terminator := "}"
+		if tok.value == "<" {
+			terminator = ">"
+		}
+		for {
+			tok := p.next()
+			if tok.err != nil {
+				return tok.err
+			}
+			if tok.value == terminator {
+				return nil
+			}
+			if tok.value == "" {
+				return p.errorf("unexpected EOF")
+			}
+			if tok.value == "[" {
+				if _, err := p.consumeExtName(); err != nil {
+					return err
+				}
+			} else if !isIdentOrNumberChar(tok.value[0]) {
+				return p.errorf("expected field name, found %q", tok.value)
+			}
+			if err := p.skipField(); err != nil {
+				return err
+			}
+		}
+	case "[":
+		tok := p.next()
+		if tok.err != nil {
+			return tok.err
+		}
+		if tok.value == "]" {
+			return nil
+		}
+		p.back()
+		for {
+			if err := p.skipValue(); err != nil {
+				return err
+			}
+			tok := p.next()
+			if tok.err != nil {
+				return tok.err
+			}
+			if tok.value == "]" {
+				return nil
+			}
+			if tok.value != "," {
+				return p.errorf("Expected ']' or ',' found %q", tok.value)
+			}
+		}
+	}
+	if !isQuote(tok.value[0]) && !isIdentOrNumberChar(tok.value[0]) {
+		return p.errorf("expected value, found %q", tok.value)
+	}
+	return nil
+}
+
 func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 	tok := p.next()
 	if tok.err != nil {
@@ -775,7 +926,9 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 			for {
 				fv.Set(reflect.Append(fv, reflect.New(at.Elem()).Elem()))
 				err := p.readAny(fv.Index(fv.Len()-1), props)
-				if err != nil {
+				if err == errUnknownEnumName {
+					fv.Set(fv.Slice(0, fv.Len()-1))
+				} else if err != nil {
 					return err
 				}
 				tok := p.next()
@@ -794,7 +947,11 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 		// One value of the repeated field.
 		p.back()
 		fv.Set(reflect.Append(fv, reflect.New(at.Elem()).Elem()))
-		return p.readAny(fv.Index(fv.Len()-1), props)
+		err := p.readAny(fv.Index(fv.Len()-1), props)
+		if err == errUnknownEnumName {
+			fv.Set(fv.Slice(0, fv.Len()-1))
+		}
+		return err
 	case reflect.Bool:
 		// true/1/t/True or false/f/0/False.
 		switch tok.value {
@@ -831,6 +988,9 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 		}
 		x, ok := m[tok.value]
 		if !ok {
+			if p.opts.AllowUnknownEnumNames && isIdentStart(tok.value[0]) {
+				return errUnknownEnumName
+			}
 			break
 		}
 		fv.SetInt(int64(x))
@@ -845,7 +1005,11 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 		// A basic field (indirected through pointer), or a repeated message/group
 		p.back()
 		fv.Set(reflect.New(fv.Type().Elem()))
-		return p.readAny(fv.Elem(), props)
+		err := p.readAny(fv.Elem(), props)
+		if err == errUnknownEnumName {
+			fv.Set(reflect.Zero(fv.Type()))
+		}
+		return err
 	case reflect.String:
 		if tok.value[0] == '"' || tok.value[0] == '\'' {
 			fv.SetString(tok.unquoted)
@@ -859,6 +1023,11 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 		case "<":
 			terminator = ">"
 		default:
+			if isWrapper(fv) {
+				// A wrapper message written as its value alone.
+				p.back()
+				return p.readAny(fv.Field(0), GetProperties(fv.Type()).Prop[0])
+			}
 			return p.errorf("expected '{' or '<', found %q", tok.value)
 		}
 		// TODO: Handle nested messages which implement encoding.TextUnmarshaler.
@@ -882,13 +1051,47 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 // If a required field is not set and no other error occurs,
 // UnmarshalText returns *RequiredNotSetError.
 func UnmarshalText(s string, pb Message) error {
+	return TextUnmarshalOptions{}.Unmarshal(s, pb)
+}
+
+// TextUnmarshalOptions configures a text format parser. The zero value
+// parses as UnmarshalText does. The options let configuration files written
+// against a newer schema still load in older binaries.
+type TextUnmarshalOptions struct {
+	// AllowUnknownFields skips fields, extensions and expanded Any types
+	// the message does not declare, along with their values, instead of
+	// failing with "unknown field name".
+	AllowUnknownFields bool
+
+	// AllowUnknownEnumNames leaves an enum field unset, or omits the
+	// element of a repeated field or the entry of a map, when its value
+	// names no member of the enum.
+	AllowUnknownEnumNames bool
+
+	// MaxSize, if positive, is the largest input in bytes that is parsed.
+	// Longer input fails with a *ParseError before any of it is parsed.
+	MaxSize int
+}
+
+// errUnknownEnumName is returned by readAny when AllowUnknownEnumNames
+// is set and the value should be left unset. It never leaves readStruct.
+var errUnknownEnumName = errors.New("proto: unknown enum name")
+
+// Unmarshal is like UnmarshalText, with the options o.
+func (o TextUnmarshalOptions) Unmarshal(s string, pb Message) error {
 	if um, ok := pb.(encoding.TextUnmarshaler); ok {
 		err := um.UnmarshalText([]byte(s))
 		return err
 	}
+	p := newTextParser(s)
+	p.opts = o
+	if o.MaxSize > 0 && len(s) > o.MaxSize {
+		line := 1 + strings.Count(s[:o.MaxSize], "\n")
+		return p.parseError(fmt.Sprintf("input of %d bytes exceeds maximum size of %d", len(s), o.MaxSize), line, o.MaxSize)
+	}
 	pb.Reset()
 	v := reflect.ValueOf(pb)
-	if pe := newTextParser(s).readStruct(v.Elem(), ""); pe != nil {
+	if pe := p.readStruct(v.Elem(), ""); pe != nil {
 		return pe
 	}
 	return nil
@@ -352,6 +352,12 @@ var unMarshalTextTests = []UnmarshalTextTest{
 		err: `line 1.12: non-repeated field "name" was repeated`,
 	},
 
+	// Unclosed extension name
+	{
+		in:  `count: 42 [testdata.greeting`,
+		err: `line 1.11: unclosed type_url or extension name`,
+	},
+
 	// Group
 	{
 		in: `count: 17 SomeGroup { group_field: 12 }`,
@@ -648,6 +654,110 @@ func TestOneofParsing(t *testing.T) {
 
 }
 
+func TestTextUnmarshalOptions(t *testing.T) {
+	const in = `count: 42
+new_scalar: -3.5e2
+new_list: [1, "two", {x: 3}]
+new_message <
+  deeper { a: "b" [ext.name]: 1 }
+  list: []
+>
+[ext.unknown]: { y: 1 }
+bikeshed: PURPLE
+name: "Pierre"
+`
+	opts := TextUnmarshalOptions{AllowUnknownFields: true, AllowUnknownEnumNames: true}
+	m := new(MyMessage)
+	if err := opts.Unmarshal(in, m); err != nil {
+		t.Fatal(err)
+	}
+	want := &MyMessage{Count: Int32(42), Name: String("Pierre")}
+	if !Equal(m, want) {
+		t.Errorf("\n got %v\nwant %v", m, want)
+	}
+
+	// Without the options, the same input still fails.
+	const wantErr = `line 2.0: unknown field name "new_scalar" in testdata.MyMessage`
+	if err := UnmarshalText(in, new(MyMessage)); err == nil || err.Error() != wantErr {
+		t.Errorf("UnmarshalText error = %v, want %v", err, wantErr)
+	}
+	opts = TextUnmarshalOptions{AllowUnknownFields: true}
+	if err := opts.Unmarshal(in, new(MyMessage)); err == nil || err.Error() != "line 9.10: invalid testdata.MyMessage_Color: PURPLE" {
+		t.Errorf("Unmarshal without AllowUnknownEnumNames error = %v", err)
+	}
+}
+
+func TestTextUnmarshalOptionsMalformedUnknown(t *testing.T) {
+	opts := TextUnmarshalOptions{AllowUnknownFields: true}
+	for _, in := range []string{
+		`count: 1 unknown`,
+		`count: 1 unknown: `,
+		`count: 1 unknown: [1, 2`,
+		`count: 1 unknown { a: 1 `,
+		`count: 1 unknown: }`,
+	} {
+		if err := opts.Unmarshal(in, new(MyMessage)); err == nil {
+			t.Errorf("Unmarshal(%q) succeeded, want error", in)
+		}
+	}
+}
+
+func TestTextUnmarshalOptionsUnknownEnumNames(t *testing.T) {
+	opts := TextUnmarshalOptions{AllowUnknownEnumNames: true}
+
+	r := new(RepeatedEnum)
+	if err := opts.Unmarshal("color: RED color: MAUVE color: [MAUVE, RED]", r); err != nil {
+		t.Fatal(err)
+	}
+	if want := []RepeatedEnum_Color{RepeatedEnum_RED, RepeatedEnum_RED}; !reflect.DeepEqual(r.Color, want) {
+		t.Errorf("Color = %v, want %v", r.Color, want)
+	}
+
+	// A later, known value may still set the field.
+	m := new(MyMessage)
+	if err := opts.Unmarshal("count: 1 bikeshed: MAUVE bikeshed: BLUE", m); err != nil {
+		t.Fatal(err)
+	}
+	if m.GetBikeshed() != MyMessage_BLUE {
+		t.Errorf("Bikeshed = %v, want BLUE", m.GetBikeshed())
+	}
+
+	// Numbers out of range are still errors.
+	if err := opts.Unmarshal("count: 1 bikeshed: 1e3", new(MyMessage)); err == nil {
+		t.Error("Unmarshal of invalid enum number succeeded")
+	}
+}
+
+func TestTextUnmarshalOptionsMaxSize(t *testing.T) {
+	opts := TextUnmarshalOptions{MaxSize: 12}
+	if err := opts.Unmarshal("count: 42", new(MyMessage)); err != nil {
+		t.Fatal(err)
+	}
+	err := opts.Unmarshal("count: 42\nname: \"Pierre\"", new(MyMessage))
+	pe, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("Unmarshal error = %v, want *ParseError", err)
+	}
+	if pe.Line != 2 || pe.Column != 3 || pe.Offset != 12 {
+		t.Errorf("error at line %d, column %d, offset %d; want 2, 3, 12", pe.Line, pe.Column, pe.Offset)
+	}
+}
+
+func TestParseErrorColumn(t *testing.T) {
+	err := UnmarshalText("count: 1\nname: \"\u00e9\" pet: x", new(MyMessage))
+	pe, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("UnmarshalText error = %v, want *ParseError", err)
+	}
+	// The é before the bad value counts as one column but two bytes.
+	if pe.Line != 2 || pe.Column != 16 || pe.Offset != 25 {
+		t.Errorf("error at line %d, column %d, offset %d; want 2, 16, 25", pe.Line, pe.Column, pe.Offset)
+	}
+	if want := "line 2.15: invalid string: x"; pe.Error() != want {
+		t.Errorf("Error() = %q, want %q", pe.Error(), want)
+	}
+}
+
 var benchInput string
 
 func init() {
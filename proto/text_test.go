@@ -303,6 +303,53 @@ func TestCompactText(t *testing.T) {
 	}
 }
 
+func TestTextIndent(t *testing.T) {
+	m := &pb.MyMessage{
+		Count: proto.Int32(1),
+		Inner: &pb.InnerMessage{Host: proto.String("cauchy"), Port: proto.Int32(4000)},
+	}
+	tm := proto.TextMarshaler{Indent: "\t"}
+	const want = "count: 1\ninner: <\n\thost: \"cauchy\"\n\tport: 4000\n>\n"
+	if got := tm.Text(m); got != want {
+		t.Errorf("Text = %q, want %q", got, want)
+	}
+	tm.Compact = true
+	if got, want := tm.Text(m), `count:1 inner:<host:"cauchy" port:4000 > `; got != want {
+		t.Errorf("compact Text = %q, want %q", got, want)
+	}
+}
+
+func TestTextRedact(t *testing.T) {
+	m := &pb.MyMessage{
+		Count:  proto.Int32(1),
+		Name:   proto.String("Dave"),
+		Pet:    []string{"bunny", "kitty"},
+		Inner:  &pb.InnerMessage{Host: proto.String("cauchy"), Port: proto.Int32(4000)},
+		Others: []*pb.OtherMessage{{Key: proto.Int64(1)}},
+	}
+	tm := proto.TextMarshaler{
+		Compact: true,
+		Redact: func(pb proto.Message, field string) bool {
+			switch field {
+			case "name", "pet", "port", "quote":
+				return true
+			}
+			return false
+		},
+	}
+	const want = `count:1 name:*** pet:*** inner:<host:"cauchy" port:*** > others:<key:1 > `
+	if got := tm.Text(m); got != want {
+		t.Errorf("Text = %q\nwant %q", got, want)
+	}
+
+	// Unset fields are left out, even if they would be redacted.
+	m3 := &proto3pb.Message{Name: "Dave", Hilarity: proto3pb.Message_PUNS}
+	tm.Redact = func(pb proto.Message, field string) bool { return field == "hilarity" || field == "terrain" }
+	if got, want := tm.Text(m3), `name:"Dave" hilarity:*** `; got != want {
+		t.Errorf("Text = %q, want %q", got, want)
+	}
+}
+
 func TestStringEscaping(t *testing.T) {
 	testCases := []struct {
 		in  *pb.Strings
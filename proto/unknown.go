@@ -0,0 +1,153 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+// Unknown fields: inspecting and removing the fields a message keeps in
+// its XXX_unrecognized field.
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// UnknownField is a field that a message's Go type does not declare, kept
+// in its XXX_unrecognized field when the message was unmarshaled.
+type UnknownField struct {
+	Tag      int32  // The field number.
+	WireType int    // The wire type, one of WireVarint, WireFixed64, WireBytes, WireStartGroup or WireFixed32.
+	Raw      []byte // The complete encoding of the field, key included.
+}
+
+// unrecognized returns a pointer to the XXX_unrecognized field of pb, or
+// nil if its type has none, as proto3 messages do not.
+func unrecognized(pb Message, fn string) (*[]byte, error) {
+	t := reflect.TypeOf(pb)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("proto: %s: %T is not a generated message", fn, pb)
+	}
+	if reflect.ValueOf(pb).IsNil() {
+		return nil, ErrNil
+	}
+	unrec := GetProperties(t.Elem()).unrecField
+	if !unrec.IsValid() {
+		return nil, nil
+	}
+	return structPointer_Bytes(toStructPointer(reflect.ValueOf(pb)), unrec), nil
+}
+
+// UnknownFields returns the unknown fields of pb, in the order they were
+// unmarshaled. The Raw slices share memory with pb. Unknown fields in
+// extension ranges are kept as extensions, and those of messages nested in
+// pb belong to those messages, so neither is returned.
+func UnknownFields(pb Message) ([]UnknownField, error) {
+	p, err := unrecognized(pb, "UnknownFields")
+	if p == nil {
+		return nil, err
+	}
+	return parseUnknown(*p)
+}
+
+// parseUnknown splits b into the fields it holds.
+func parseUnknown(b []byte) ([]UnknownField, error) {
+	var fields []UnknownField
+	for len(b) > 0 {
+		tag, wire, n, err := ConsumeTag(b)
+		if err != nil {
+			return nil, err
+		}
+		if wire == WireEndGroup {
+			return nil, fmt.Errorf("proto: unknown field %d: wiretype end group outside a group", tag)
+		}
+		m, err := ConsumeField(b[n:], wire)
+		if err != nil {
+			return nil, err
+		}
+		n += m
+		fields = append(fields, UnknownField{Tag: tag, WireType: wire, Raw: b[:n:n]})
+		b = b[n:]
+	}
+	return fields, nil
+}
+
+// SetUnknownFields replaces the unknown fields of pb with fields, which
+// are marshaled in the order given. It copies the Raw slices, but does not
+// check that they are well formed. It returns an error if fields is not
+// empty and the type of pb has no XXX_unrecognized field.
+func SetUnknownFields(pb Message, fields []UnknownField) error {
+	p, err := unrecognized(pb, "SetUnknownFields")
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		if len(fields) > 0 {
+			return fmt.Errorf("proto: SetUnknownFields: %T cannot hold unknown fields", pb)
+		}
+		return nil
+	}
+	var b []byte
+	for _, f := range fields {
+		b = append(b, f.Raw...)
+	}
+	*p = b
+	return nil
+}
+
+// RemoveUnknownFields removes each unknown field of pb whose number is one
+// of tags, and returns how many it removed. The other unknown fields are
+// kept in order, so marshaling pb writes them as before.
+func RemoveUnknownFields(pb Message, tags ...int32) (int, error) {
+	p, err := unrecognized(pb, "RemoveUnknownFields")
+	if p == nil || len(*p) == 0 {
+		return 0, err
+	}
+	fields, err := parseUnknown(*p)
+	if err != nil {
+		return 0, err
+	}
+	remove := make(map[int32]bool, len(tags))
+	for _, tag := range tags {
+		remove[tag] = true
+	}
+	var b []byte
+	n := 0
+	for _, f := range fields {
+		if remove[f.Tag] {
+			n++
+			continue
+		}
+		b = append(b, f.Raw...)
+	}
+	if n > 0 {
+		*p = b
+	}
+	return n, nil
+}
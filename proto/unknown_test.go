@@ -0,0 +1,187 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/golang/protobuf/proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+// unknownInner returns an InnerMessage with the given host, followed by
+// unknown fields 10 to 14, and 10 again.
+func unknownInner() []byte {
+	b := NewBuffer(nil)
+	b.EncodeVarint(1<<3 | WireBytes)
+	b.EncodeStringBytes("host")
+	b.EncodeVarint(10<<3 | WireVarint)
+	b.EncodeVarint(150)
+	b.EncodeVarint(11<<3 | WireBytes)
+	b.EncodeStringBytes("eleven")
+	b.EncodeVarint(12<<3 | WireStartGroup)
+	b.EncodeVarint(1<<3 | WireFixed32)
+	b.EncodeFixed32(7)
+	b.EncodeVarint(12<<3 | WireEndGroup)
+	b.EncodeVarint(13<<3 | WireFixed32)
+	b.EncodeFixed32(13)
+	b.EncodeVarint(14<<3 | WireFixed64)
+	b.EncodeFixed64(14)
+	b.EncodeVarint(10<<3 | WireVarint)
+	b.EncodeVarint(1)
+	return b.Bytes()
+}
+
+func unknownTags(fields []UnknownField) []int32 {
+	var tags []int32
+	for _, f := range fields {
+		tags = append(tags, f.Tag)
+	}
+	return tags
+}
+
+func TestUnknownFields(t *testing.T) {
+	data := unknownInner()
+	m := new(pb.InnerMessage)
+	if err := Unmarshal(data, m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	fields, err := UnknownFields(m)
+	if err != nil {
+		t.Fatalf("UnknownFields: %v", err)
+	}
+	want := []struct {
+		tag  int32
+		wire int
+		raw  []byte
+	}{
+		{10, WireVarint, []byte{10<<3 | WireVarint, 0x96, 0x01}},
+		{11, WireBytes, append([]byte{11<<3 | WireBytes, 6}, "eleven"...)},
+		{12, WireStartGroup, []byte{12<<3 | WireStartGroup, 1<<3 | WireFixed32, 7, 0, 0, 0, 12<<3 | WireEndGroup}},
+		{13, WireFixed32, []byte{13<<3 | WireFixed32, 13, 0, 0, 0}},
+		{14, WireFixed64, []byte{14<<3 | WireFixed64, 14, 0, 0, 0, 0, 0, 0, 0}},
+		{10, WireVarint, []byte{10<<3 | WireVarint, 1}},
+	}
+	if len(fields) != len(want) {
+		t.Fatalf("UnknownFields returned tags %v, want %d fields", unknownTags(fields), len(want))
+	}
+	for i, w := range want {
+		f := fields[i]
+		if f.Tag != w.tag || f.WireType != w.wire || !bytes.Equal(f.Raw, w.raw) {
+			t.Errorf("field %d = {%d, %d, %x}, want {%d, %d, %x}", i, f.Tag, f.WireType, f.Raw, w.tag, w.wire, w.raw)
+		}
+	}
+}
+
+func TestRemoveUnknownFields(t *testing.T) {
+	m := new(pb.InnerMessage)
+	if err := Unmarshal(unknownInner(), m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	n, err := RemoveUnknownFields(m, 10, 12, 99)
+	if err != nil || n != 3 {
+		t.Fatalf("RemoveUnknownFields = %d, %v; want 3, nil", n, err)
+	}
+	b, err := Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	got := new(pb.InnerMessage)
+	if err := Unmarshal(b, got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got.GetHost() != "host" {
+		t.Errorf("host = %q after removal, want %q", got.GetHost(), "host")
+	}
+	fields, err := UnknownFields(got)
+	if err != nil {
+		t.Fatalf("UnknownFields: %v", err)
+	}
+	if tags := unknownTags(fields); len(tags) != 3 || tags[0] != 11 || tags[1] != 13 || tags[2] != 14 {
+		t.Errorf("unknown tags after removal = %v, want [11 13 14]", tags)
+	}
+
+	if n, err := RemoveUnknownFields(m, 10); err != nil || n != 0 {
+		t.Errorf("removing again = %d, %v; want 0, nil", n, err)
+	}
+}
+
+func TestSetUnknownFields(t *testing.T) {
+	m := new(pb.InnerMessage)
+	if err := Unmarshal(unknownInner(), m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	fields, err := UnknownFields(m)
+	if err != nil {
+		t.Fatalf("UnknownFields: %v", err)
+	}
+	// Keep fields 14 and 11, in that order.
+	if err := SetUnknownFields(m, []UnknownField{fields[4], fields[1]}); err != nil {
+		t.Fatalf("SetUnknownFields: %v", err)
+	}
+	want := append(append([]byte{}, fields[4].Raw...), fields[1].Raw...)
+	if !bytes.Equal(m.XXX_unrecognized, want) {
+		t.Errorf("XXX_unrecognized = %x, want %x", m.XXX_unrecognized, want)
+	}
+	if err := SetUnknownFields(m, nil); err != nil || m.XXX_unrecognized != nil {
+		t.Errorf("SetUnknownFields(nil) = %v, left %x", err, m.XXX_unrecognized)
+	}
+}
+
+func TestUnknownFieldsErrors(t *testing.T) {
+	m := &pb.InnerMessage{XXX_unrecognized: []byte{10<<3 | WireBytes, 5, 'a'}}
+	if _, err := UnknownFields(m); err == nil {
+		t.Error("UnknownFields of truncated field succeeded")
+	}
+	if _, err := RemoveUnknownFields(m, 10); err == nil {
+		t.Error("RemoveUnknownFields of truncated field succeeded")
+	}
+	m.XXX_unrecognized = []byte{10<<3 | WireEndGroup}
+	if _, err := UnknownFields(m); err == nil {
+		t.Error("UnknownFields of stray end group succeeded")
+	}
+
+	if _, err := UnknownFields((*pb.InnerMessage)(nil)); err != ErrNil {
+		t.Errorf("UnknownFields(nil) = %v, want ErrNil", err)
+	}
+
+	// Proto3 messages do not keep unknown fields.
+	p3 := new(proto3pb.Message)
+	if fields, err := UnknownFields(p3); fields != nil || err != nil {
+		t.Errorf("UnknownFields(proto3) = %v, %v; want nil, nil", fields, err)
+	}
+	if err := SetUnknownFields(p3, []UnknownField{{Tag: 10, WireType: WireVarint, Raw: []byte{80, 1}}}); err == nil {
+		t.Error("SetUnknownFields(proto3) succeeded")
+	}
+}
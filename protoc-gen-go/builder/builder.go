@@ -0,0 +1,301 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package builder outputs a builder type for each message, with chained
+// setters, for constructing deeply nested messages in tests and tools
+// without large struct literals. It runs as a plugin for the Go protocol
+// buffer compiler plugin, enabled with plugins=builder. It is linked in to
+// protoc-gen-go.
+//
+// For a message Foo, it generates
+//
+//	type FooBuilder struct{ ... }
+//	func NewFooBuilder() *FooBuilder
+//	func (b *FooBuilder) SetBar(v T) *FooBuilder     // for each field bar
+//	func (b *FooBuilder) AddBar(v ...T) *FooBuilder  // for repeated fields
+//	func (b *FooBuilder) PutBar(k K, v V) *FooBuilder // for map fields
+//	func (b *FooBuilder) Msg() *Foo                  // the Foo, unchecked
+//	func (b *FooBuilder) Build() (*Foo, error)       // the Foo, checked
+//
+// Build reports an unset required field of the message, and then calls
+// the message's Validate method, if it has one written by hand. Fields in
+// oneofs are set through their own setters, which replace whichever field
+// of the oneof was set; extensions have none.
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// New returns a new instance of the builder plugin, for a generator created
+// with generator.NewWithPlugins.
+func New() generator.Plugin { return new(builder) }
+
+// builder is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates message builders.
+type builder struct {
+	gen       *generator.Generator
+	errorsPkg string // The name under which the current file imports errors.
+	used      map[string]bool
+}
+
+// Name returns the name of this plugin, "builder".
+func (g *builder) Name() string {
+	return "builder"
+}
+
+// SetParam rejects all parameters; the builder plugin has none.
+func (g *builder) SetParam(key, value string) error {
+	return fmt.Errorf("unknown parameter %q", key)
+}
+
+// Init initializes the plugin.
+func (g *builder) Init(gen *generator.Generator) {
+	g.gen = gen
+}
+
+// P forwards to g.gen.P.
+func (g *builder) P(args ...interface{}) { g.gen.P(args...) }
+
+// Generate generates the builders for the messages in the given file.
+func (g *builder) Generate(file *generator.FileDescriptor) {
+	msgs := g.messages(file)
+	if len(msgs) == 0 {
+		return
+	}
+	g.errorsPkg = ""
+
+	// The Go names of the file's types, which the builders must not take.
+	g.used = make(map[string]bool)
+	for _, msg := range msgs {
+		g.used[generator.CamelCaseSlice(msg.TypeName())] = true
+		for _, e := range msg.EnumType {
+			g.used[generator.CamelCaseSlice(append(msg.TypeName(), e.GetName()))] = true
+		}
+	}
+	for _, e := range file.EnumType {
+		g.used[generator.CamelCase(e.GetName())] = true
+	}
+
+	for _, msg := range msgs {
+		g.generateBuilder(msg.Descriptor, msg.path)
+	}
+}
+
+// GenerateImports does nothing; Generate adds its imports with AddImport.
+func (g *builder) GenerateImports(file *generator.FileDescriptor) {}
+
+// message is a message and its source path.
+type message struct {
+	*generator.Descriptor
+	path string
+}
+
+// messages returns the messages of file, nested ones after the message
+// holding them, but not map entries.
+func (g *builder) messages(file *generator.FileDescriptor) []message {
+	prefix := "."
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix += pkg + "."
+	}
+	var msgs []message
+	var walk func(name, path string, msg *pb.DescriptorProto)
+	walk = func(name, path string, msg *pb.DescriptorProto) {
+		if msg.GetOptions().GetMapEntry() {
+			return
+		}
+		if d, ok := g.gen.ObjectNamed(name).(*generator.Descriptor); ok {
+			msgs = append(msgs, message{d, path})
+		}
+		for i, nested := range msg.NestedType {
+			walk(name+"."+nested.GetName(), fmt.Sprintf("%s,3,%d", path, i), nested) // 3 means nested message.
+		}
+	}
+	for i, msg := range file.MessageType {
+		walk(prefix+msg.GetName(), fmt.Sprintf("4,%d", i), msg) // 4 means message.
+	}
+	return msgs
+}
+
+// mapEntry returns the map entry message of the field, or nil if the
+// field is not a map.
+func (g *builder) mapEntry(field *pb.FieldDescriptorProto) *generator.Descriptor {
+	if field.GetType() != pb.FieldDescriptorProto_TYPE_MESSAGE {
+		return nil
+	}
+	if d, ok := g.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor); ok && d.GetOptions().GetMapEntry() {
+		return d
+	}
+	return nil
+}
+
+// isMessage reports whether the field holds messages or groups.
+func isMessage(field *pb.FieldDescriptorProto) bool {
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		return true
+	}
+	return false
+}
+
+// generateBuilder generates the builder of a message and its methods.
+func (g *builder) generateBuilder(d *generator.Descriptor, path string) {
+	typeName := generator.CamelCaseSlice(d.TypeName())
+	bname, newName := typeName+"Builder", "New"+typeName+"Builder"
+	for _, n := range []string{bname, newName} {
+		if g.used[n] {
+			g.gen.Errorf(path, "builder: the name %s, needed for the builder of %s, is taken by a type", n, typeName)
+			return
+		}
+	}
+	fullName := strings.Join(d.TypeName(), ".")
+	if pkg := d.File().GetPackage(); pkg != "" {
+		fullName = pkg + "." + fullName
+	}
+
+	g.P("// ", bname, " builds a ", typeName, " with chained setters. Start one with")
+	g.P("// ", newName, ", and finish it with Build.")
+	g.P("type ", bname, " struct {")
+	g.P("m *", typeName)
+	g.P("}")
+	g.P()
+	g.P("// ", newName, " returns a builder for a new, empty ", typeName, ".")
+	g.P("func ", newName, "() *", bname, " {")
+	g.P("return &", bname, "{m: new(", typeName, ")}")
+	g.P("}")
+	g.P()
+
+	for _, field := range d.Field {
+		g.generateSetters(d, bname, field)
+	}
+
+	g.P("// Msg returns the ", typeName, " built so far, without the checks of Build.")
+	g.P("// Later calls to the setters of b change it.")
+	g.P("func (b *", bname, ") Msg() *", typeName, " {")
+	g.P("return b.m")
+	g.P("}")
+	g.P()
+	g.P("// Build returns the ", typeName, " built by b, or an error if a required")
+	g.P("// field of it is unset or if its Validate method, if it has one, fails.")
+	g.P("// Later calls to the setters of b change the message returned.")
+	g.P("func (b *", bname, ") Build() (*", typeName, ", error) {")
+	for _, field := range d.Field {
+		if field.GetLabel() != pb.FieldDescriptorProto_LABEL_REQUIRED {
+			continue
+		}
+		if g.errorsPkg == "" {
+			g.errorsPkg = g.gen.AddImport("errors")
+		}
+		g.P("if b.m.", d.GoFieldName(field), " == nil {")
+		g.P("return nil, ", g.errorsPkg, ".New(", fmt.Sprintf("%q", "required field "+fullName+"."+field.GetName()+" not set"), ")")
+		g.P("}")
+	}
+	g.P("if v, ok := interface{}(b.m).(interface{ Validate() error }); ok {")
+	g.P("if err := v.Validate(); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("}")
+	g.P("return b.m, nil")
+	g.P("}")
+	g.P()
+}
+
+// generateSetters generates the setters of a field of d.
+func (g *builder) generateSetters(d *generator.Descriptor, bname string, field *pb.FieldDescriptorProto) {
+	name := d.GoFieldName(field)
+	typ, _ := g.gen.GoType(d, field)
+	g.gen.RecordTypeUse(field.GetTypeName())
+	recv := "func (b *" + bname + ") "
+
+	switch {
+	case field.OneofIndex != nil:
+		g.P("// Set", name, " sets the field ", field.GetName(), ", replacing any other field of its oneof.")
+		g.P(recv, "Set", name, "(v ", typ, ") *", bname, " {")
+		g.P("b.m.", d.GoOneofName(*field.OneofIndex), " = &", d.GoOneofTypeName(field), "{v}")
+		g.P("return b")
+		g.P("}")
+	case g.mapEntry(field) != nil:
+		entry := g.mapEntry(field)
+		keyField, valField := entry.Field[0], entry.Field[1]
+		keyType, _ := g.gen.GoType(entry, keyField)
+		valType, _ := g.gen.GoType(entry, valField)
+		keyType = strings.TrimPrefix(keyType, "*")
+		if !isMessage(valField) {
+			valType = strings.TrimPrefix(valType, "*")
+		}
+		g.gen.RecordTypeUse(valField.GetTypeName())
+		mapType := "map[" + keyType + "]" + valType
+		g.P("// Set", name, " sets the field ", field.GetName(), ".")
+		g.P(recv, "Set", name, "(v ", mapType, ") *", bname, " {")
+		g.P("b.m.", name, " = v")
+		g.P("return b")
+		g.P("}")
+		g.P()
+		g.P("// Put", name, " adds an entry to the field ", field.GetName(), ".")
+		g.P(recv, "Put", name, "(k ", keyType, ", v ", valType, ") *", bname, " {")
+		g.P("if b.m.", name, " == nil {")
+		g.P("b.m.", name, " = make(", mapType, ")")
+		g.P("}")
+		g.P("b.m.", name, "[k] = v")
+		g.P("return b")
+		g.P("}")
+	case field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED:
+		g.P("// Set", name, " sets the field ", field.GetName(), ".")
+		g.P(recv, "Set", name, "(v ", typ, ") *", bname, " {")
+		g.P("b.m.", name, " = v")
+		g.P("return b")
+		g.P("}")
+		g.P()
+		g.P("// Add", name, " appends to the field ", field.GetName(), ".")
+		g.P(recv, "Add", name, "(v ...", strings.TrimPrefix(typ, "[]"), ") *", bname, " {")
+		g.P("b.m.", name, " = append(b.m.", name, ", v...)")
+		g.P("return b")
+		g.P("}")
+	case strings.HasPrefix(typ, "*") && !isMessage(field):
+		// A proto2 scalar, held by pointer.
+		g.P("// Set", name, " sets the field ", field.GetName(), ".")
+		g.P(recv, "Set", name, "(v ", typ[1:], ") *", bname, " {")
+		g.P("b.m.", name, " = &v")
+		g.P("return b")
+		g.P("}")
+	default:
+		g.P("// Set", name, " sets the field ", field.GetName(), ".")
+		g.P(recv, "Set", name, "(v ", typ, ") *", bname, " {")
+		g.P("b.m.", name, " = v")
+		g.P("return b")
+		g.P("}")
+	}
+	g.P()
+}
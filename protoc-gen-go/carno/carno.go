@@ -35,13 +35,43 @@
 package carno
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"sync"
+	"text/template"
+	"time"
+	"unicode"
 
-	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
 	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
-	"sync"
+	"github.com/ccsnake/protobuf/protoc-gen-go/plugingen"
+	"github.com/ccsnake/protobuf/protoc-gen-go/pool"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Import paths of the packages used by the generated code.
+const (
+	carnoPkgPath     = "github.com/ccsnake/carno"
+	clientPkgPath    = "github.com/ccsnake/carno/client"
+	muxPkgPath       = "github.com/ccsnake/carno/mux"
+	callinfoPkgPath  = "github.com/ccsnake/protobuf/callinfo"
+	grpcPkgPath      = "google.golang.org/grpc"
+	httprpcPkgPath   = "github.com/ccsnake/protobuf/httprpc"
+	queuerpcPkgPath  = "github.com/ccsnake/protobuf/queuerpc"
+	fanoutPkgPath    = "github.com/ccsnake/protobuf/fanout"
+	logpbPkgPath     = "github.com/ccsnake/protobuf/logpb"
+	togglePkgPath    = "github.com/ccsnake/protobuf/toggle"
+	ratelimitPkgPath = "github.com/ccsnake/protobuf/ratelimit"
+	hedgePkgPath     = "github.com/ccsnake/protobuf/hedge"
+	onewayPkgPath    = "github.com/ccsnake/protobuf/oneway"
+	dedupePkgPath    = "github.com/ccsnake/protobuf/dedupe"
+	lroPkgPath       = "github.com/ccsnake/protobuf/lro"
+	carnotestPkgPath = "github.com/ccsnake/protobuf/carnotest"
+	sqlpbPkgPath     = "github.com/ccsnake/protobuf/sqlpb"
+	eventpbPkgPath   = "github.com/ccsnake/protobuf/eventpb"
 )
 
 // generatedCodeVersion indicates a version of the generated code.
@@ -50,19 +80,84 @@ import (
 // a constant, carno.SupportPackageIsVersionN (where N is generatedCodeVersion).
 const generatedCodeVersion = 4
 
-func init() {
-	generator.RegisterPlugin(newCarno())
-}
+// New returns a new instance of the carno plugin, for a generator created
+// with generator.NewWithPlugins.
+func New() generator.Plugin { return &carno{} }
 
 // carno is an implementation of the Go protocol buffer compiler's
 // plugin architecture.  It generates bindings for carno support.
 type carno struct {
+	*plugingen.Printer
 	gen           *generator.Generator
-	serverBuilder func()
-}
+	serverBuilder func(*generator.FileDescriptor)
+
+	// lazyAggregate defers starting the package client, and wiring each
+	// service client, until a method is first called through New<Pkg>.
+	// It is set by the carno:lazy_aggregate=true parameter.
+	lazyAggregate bool
+
+	// strict reports questionable uses of carno options as errors, not just
+	// the ones that would produce broken code.
+	// It is set by the carno:strict=true parameter.
+	strict bool
+
+	// report is the name of the cleanup report to write, if any.
+	// It is set by the carno:report=<file> parameter.
+	report string
+
+	// examples adds an example test file for each service generated.
+	// It is set by the carno:examples=true parameter.
+	examples bool
+
+	// pool makes generated clients take responses from the message pools
+	// of the pool plugin, and servers return requests to them.
+	// It is set by the carno:pool=true parameter.
+	pool bool
+
+	// grpc adds a Register<Service>ServerAsGrpc function for each service,
+	// which serves a carno server implementation with a gRPC server.
+	// It is set by the carno:grpc=true parameter.
+	grpc bool
+
+	// http adds a New<Service>HTTPHandler function for each service, which
+	// serves a carno server implementation over HTTP with package httprpc.
+	// It is set by the carno:http=true parameter.
+	http bool
 
-func newCarno() *carno {
-	return &carno{}
+	// queue adds publish/subscribe bindings for each service, which carry
+	// its calls over a message broker with package queuerpc.
+	// It is set by the carno:queue=true parameter.
+	queue bool
+
+	// log adds New<Service>LoggingServer and New<Service>LoggingClient
+	// functions for each service, which log its calls with a
+	// logpb.CallLogger. It is set by the carno:log=true parameter.
+	log bool
+
+	// hedge adds a New<Service>HedgingClient function for each service,
+	// which hedges the calls to its idempotent methods with package hedge.
+	// It is set by the carno:hedge=true parameter.
+	hedge bool
+
+	// manifest adds a JSON manifest of the services of each file generated,
+	// for clients and gateways that do not use Go. It is set by the
+	// carno:manifest=true parameter.
+	manifest bool
+
+	// testServer adds a New<Service>TestServer function for each service,
+	// which returns a client calling a server implementation in memory
+	// with package carnotest. It is set by the carno:testserver=true
+	// parameter.
+	testServer bool
+
+	// The names under which the current file imports the packages used by
+	// the generated code. They are set by generateServices.
+	carnoPkg, clientPkg, muxPkg, contextPkg, syncPkg, callinfoPkg string
+	grpcPkg, httpPkg, httprpcPkg, queuerpcPkg, fanoutPkg          string
+	logpbPkg, togglePkg, timePkg, ratelimitPkg, hedgePkg          string
+	onewayPkg, dedupePkg, protoPkg, lroPkg, carnotestPkg          string
+
+	messages map[string]map[string]*pb.DescriptorProto // see messageNames
 }
 
 // Name returns the name of this plugin, "carno".
@@ -70,26 +165,100 @@ func (g *carno) Name() string {
 	return "carno"
 }
 
+// SetParam sets a carno:key=value parameter.
+func (g *carno) SetParam(key, value string) error {
+	switch key {
+	case "lazy_aggregate":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.lazyAggregate = b
+	case "strict":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.strict = b
+	case "report":
+		if value == "" {
+			return fmt.Errorf("report needs a file name")
+		}
+		g.report = value
+	case "examples":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.examples = b
+	case "pool":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.pool = b
+	case "grpc":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.grpc = b
+	case "http":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.http = b
+	case "queue":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.queue = b
+	case "log":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.log = b
+	case "hedge":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.hedge = b
+	case "manifest":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.manifest = b
+	case "testserver":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		g.testServer = b
+	default:
+		return fmt.Errorf("unknown parameter %q", key)
+	}
+	return nil
+}
+
 // Init initializes the plugin.
 func (g *carno) Init(gen *generator.Generator) {
 	g.gen = gen
-
-	pkgService := make(map[string][]string)
-	for _, file := range gen.Request.ProtoFile {
-		for _, service := range file.Service {
-			pkgService[file.GetPackage()] = append(pkgService[file.GetPackage()], service.GetName())
-		}
+	g.Printer = &plugingen.Printer{Gen: gen, Reserved: reservedClientName}
+	g.overrideJSONNames()
+	g.markSensitive()
+	g.addGoTags()
+	if g.report != "" {
+		g.generateReport()
 	}
 
 	var once sync.Once
-
-	g.serverBuilder = func() {
-		once.Do(func() {
-			for pkg, services := range pkgService {
-				g.generateServerPackage(pkg, services...)
-				g.generateInit(pkg)
-			}
-		})
+	g.serverBuilder = func(file *generator.FileDescriptor) {
+		once.Do(func() { g.generatePackageFiles(file) })
 	}
 }
 
@@ -97,30 +266,86 @@ func (g *carno) generateInit(pkg string) {
 	pkgQ := strconv.Quote(pkg)
 	g.P("var ServerName = ", pkgQ)
 
-	g.P("func InitCarno(opts ...carno.Option) error{")
-	g.P("return carno.Init(", pkgQ, ", opts...)")
+	g.P("func InitCarno(opts ...", g.carnoPkg, ".Option) error{")
+	g.P("return ", g.carnoPkg, ".Init(", pkgQ, ", opts...)")
 	g.P("}")
 }
 
-// Given a type name defined in a .proto, return its object.
-// Also record that we're using it, to guarantee the associated import.
-func (g *carno) objectNamed(name string) generator.Object {
-	g.gen.RecordTypeUse(name)
-	return g.gen.ObjectNamed(name)
-}
-
-// Given a type name defined in a .proto, return its name as we will print it.
-func (g *carno) typeName(str string) string {
-	return g.gen.TypeName(g.objectNamed(str))
+// Generate generates code for the services and event-sourced aggregates in the given file.
+func (g *carno) Generate(file *generator.FileDescriptor) {
+	g.validateMethodOptions(file)
+	g.validateJSONNames(file)
+	g.validateGoTags(file)
+	g.validateIdempotencyKeys(file)
+	if len(file.FileDescriptorProto.Service) > 0 {
+		g.generateServices(file)
+		if g.examples {
+			g.generateExamples(file)
+		}
+		if g.manifest {
+			g.generateManifest(file)
+		}
+	}
+	g.generateAggregates(file)
+	g.generateEntities(file)
+	g.generateEvents(file)
 }
 
-// P forwards to g.gen.P.
-func (g *carno) P(args ...interface{}) { g.gen.P(args...) }
-
-// Generate generates code for the services in the given file.
-func (g *carno) Generate(file *generator.FileDescriptor) {
-	if len(file.FileDescriptorProto.Service) == 0 {
-		return
+// generateServices generates code for the services in the given file.
+func (g *carno) generateServices(file *generator.FileDescriptor) {
+	g.carnoPkg = g.gen.AddImport(carnoPkgPath)
+	g.clientPkg = g.gen.AddImport(clientPkgPath)
+	g.muxPkg = g.gen.AddImport(muxPkgPath)
+	g.contextPkg = g.gen.AddImport("context")
+	g.callinfoPkg = g.gen.AddImport(callinfoPkgPath)
+	if g.lazyAggregate {
+		g.syncPkg = g.gen.AddImport("sync")
+	}
+	if g.grpc {
+		g.grpcPkg = g.gen.AddImport(grpcPkgPath)
+	}
+	if g.http {
+		g.httpPkg = g.gen.AddImport("net/http")
+		g.httprpcPkg = g.gen.AddImport(httprpcPkgPath)
+	}
+	if g.queue {
+		g.queuerpcPkg = g.gen.AddImport(queuerpcPkgPath)
+	}
+	if g.log {
+		g.logpbPkg = g.gen.AddImport(logpbPkgPath)
+		g.togglePkg = g.gen.AddImport(togglePkgPath)
+		g.timePkg = g.gen.AddImport("time")
+	}
+	if g.hedge {
+		g.hedgePkg = g.gen.AddImport(hedgePkgPath)
+		g.timePkg = g.gen.AddImport("time")
+	}
+	if g.testServer {
+		g.carnotestPkg = g.gen.AddImport(carnotestPkgPath)
+	}
+	for _, service := range file.FileDescriptorProto.Service {
+		if g.shardable(service) {
+			g.fanoutPkg = g.gen.AddImport(fanoutPkgPath)
+			break
+		}
+	}
+	for _, service := range file.FileDescriptorProto.Service {
+		for _, method := range service.Method {
+			if g.rateLimit(method) != nil && !plugingen.Streaming(method) {
+				g.ratelimitPkg = g.gen.AddImport(ratelimitPkgPath)
+			}
+			if g.oneway(method) {
+				g.onewayPkg = g.gen.AddImport(onewayPkgPath)
+			}
+			if op, _ := g.operation(service, method); op != nil {
+				g.lroPkg = g.gen.AddImport(lroPkgPath)
+				g.timePkg = g.gen.AddImport("time")
+			}
+			if _, key := g.idempotencyKey(method); key != nil && !plugingen.Streaming(method) {
+				g.dedupePkg = g.gen.AddImport(dedupePkgPath)
+				g.protoPkg = g.gen.AddImport("github.com/golang/protobuf/proto")
+			}
+		}
 	}
 
 	g.P("// Reference imports to suppress errors if they are not otherwise used.")
@@ -131,39 +356,26 @@ func (g *carno) Generate(file *generator.FileDescriptor) {
 	g.P("// is compatible with the carno package it is being compiled against.")
 	g.P()
 
-	g.serverBuilder()
+	if g.generating(file) {
+		g.serverBuilder(file)
+	}
 
 	for i, service := range file.FileDescriptorProto.Service {
 		g.generateService(file, service, i)
 	}
 }
 
-// GenerateImports generates the import declaration for this file.
-func (g *carno) GenerateImports(file *generator.FileDescriptor) {
-	if len(file.FileDescriptorProto.Service) == 0 {
-		return
-	}
-
-	g.P("import (")
-	g.P(strconv.Quote("github.com/ccsnake/carno"))
-	g.P(strconv.Quote("github.com/ccsnake/carno/client"))
-	g.P(strconv.Quote("github.com/ccsnake/carno/mux"))
-	g.P(strconv.Quote("context"))
-	g.P(")")
-	g.P()
-
-}
+// GenerateImports does nothing; generateServices adds its imports with AddImport.
+func (g *carno) GenerateImports(file *generator.FileDescriptor) {}
 
 // reservedClientName records whether a client name is reserved on the client side.
 var reservedClientName = map[string]bool{
 	// TODO: do we need any in carno?
 }
 
-func unexport(s string) string { return strings.ToLower(s[:1]) + s[1:] }
-
 // generateService generates all the code for the named service.
 func (g *carno) generateService(file *generator.FileDescriptor, service *pb.ServiceDescriptorProto, index int) {
-	path := fmt.Sprintf("6,%d", index) // 6 means service.
+	path := plugingen.ServicePath(index)
 
 	origServName := service.GetName()
 	fullServName := origServName
@@ -176,69 +388,149 @@ func (g *carno) generateService(file *generator.FileDescriptor, service *pb.Serv
 	g.P()
 	g.P("// Client API for ", servName, " service")
 
-	// Client interface.
-	g.P("type ", servName, "Client interface {")
+	// Client interface, split into one interface per (carno.group).
+	var groups []string
+	groupMethods := make(map[string][]int)
 	for i, method := range service.Method {
-		g.gen.PrintComments(fmt.Sprintf("%s,2,%d", path, i)) // 2 means method in a service.
-		g.P(g.generateClientSignature(servName, method))
+		group := g.methodGroup(method)
+		if _, ok := groupMethods[group]; !ok && group != "" {
+			groups = append(groups, group)
+		}
+		groupMethods[group] = append(groupMethods[group], i)
 	}
-	g.P("}")
-	g.P()
+	if len(groups) > 0 {
+		g.P()
+	}
+	clientMethods := func(group string) []plugingen.Method {
+		var methods []plugingen.Method
+		for _, i := range groupMethods[group] {
+			methods = append(methods, plugingen.Method{
+				Path: plugingen.MethodPath(path, i),
+				Sig:  g.clientSignature(servName, service.Method[i]),
+			})
+		}
+		return methods
+	}
+	var embeds []string
+	for _, group := range groups {
+		g.P("// ", servName, group, "Client is the ", group, " group of ", servName, "Client.")
+		g.PrintInterface(file, servName+group+"Client", "", nil, clientMethods(group))
+		embeds = append(embeds, servName+group+"Client")
+	}
+	if len(groups) == 0 && g.HasComments(path) {
+		g.P("//")
+	}
+	g.PrintComments(path)
+	g.PrintInterface(file, servName+"Client", path, embeds, clientMethods(""))
 
 	// Client structure.
-	g.P("type ", unexport(servName), "Client struct {")
-	g.P("client.Client")
+	g.P("type ", plugingen.Unexport(servName), "Client struct {")
+	g.P(g.clientPkg, ".Client")
 	g.P("}")
 	g.P()
 
 	// NewClient factory.
-	g.P("func New", servName, "Client (opts ...client.Option) (", servName, "Client, error) {")
-	g.P(`	c,err := carno.NewClient(`, strconv.Quote(file.GetPackage()), `,opts...)`)
+	g.P("// New", servName, "Client creates and starts a client for the ", servName, " service.")
+	g.P("// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.")
+	g.P("func New", servName, "Client (opts ...", g.clientPkg, ".Option) (", servName, "Client, error) {")
+	g.P(`	c,err := `, g.carnoPkg, `.NewClient(`, strconv.Quote(file.GetPackage()), `,opts...)`)
 	g.P("if err!=nil{")
 	g.P("return nil,err")
 	g.P("}")
 
-	g.P("rv := &", unexport(servName), "Client{Client: c}")
+	g.P("rv := &", plugingen.Unexport(servName), "Client{Client: c}")
 
 	g.P("return rv, c.Start()")
 	g.P("}")
 	g.P()
 
-	var methodIndex int
-	serviceDescVar := "_" + servName + "_serviceDesc"
+	serviceDescVar := plugingen.Var(servName, "serviceDesc")
+	callInfoVar := g.generateCallInfo(file, servName, fullServName, g.gen.ServiceViews(file)[index])
 	// Client method implementations.
-	for _, method := range service.Method {
-		descExpr := fmt.Sprintf("&%s.Methods[%d]", serviceDescVar, methodIndex)
-		methodIndex++
-		g.generateClientMethod(file.GetPackage(), origServName, fullServName, serviceDescVar, method, descExpr)
+	for i, method := range service.Method {
+		infoExpr := fmt.Sprintf("%s[%d]", callInfoVar, i)
+		g.generateClientMethod(origServName, method, infoExpr)
+	}
+
+	if g.lazyAggregate {
+		g.generateLazyClient(file.GetPackage(), servName, service)
+	}
+	g.generateAggregateClient(file.GetPackage(), servName)
+	if g.shardable(service) {
+		g.generateFanOut(servName, service)
+	}
+	g.generatePager(servName, service)
+	g.generateWaiter(servName, fullServName, service)
+	if g.hedge {
+		g.generateHedgingClient(servName, service)
 	}
 
 	g.P("// Server API for ", servName, " service")
+	if g.HasComments(path) {
+		g.P("//")
+	}
+	g.PrintComments(path)
 	// Server interface.
 	serverType := servName + "Server"
-	g.P("type ", serverType, " interface {")
+	var serverMethods []plugingen.Method
 	for i, method := range service.Method {
-		g.gen.PrintComments(fmt.Sprintf("%s,2,%d", path, i)) // 2 means method in a service.
-		g.P(g.generateServerSignature(servName, method))
+		serverMethods = append(serverMethods, plugingen.Method{
+			Path: plugingen.MethodPath(path, i),
+			Sig:  g.ServerSignature(method, g.contextPkg+".Context"),
+		})
 	}
-	g.P("}")
-	g.P()
+	g.PrintInterface(file, serverType, path, nil, serverMethods)
 
-	g.generateServerSetting(file)
+	g.generateServerSetting(file, path)
 	g.P()
 
+	srv := "srv"
+	// Duplicates are answered only after the checks of the other wrappers.
+	if dedupeType := g.generateDedupeServer(servName, callInfoVar, service); dedupeType != "" {
+		srv = dedupeType + "{" + srv + "}"
+	}
+	if authzType := g.generateAuthzServer(servName, fullServName, service); authzType != "" {
+		srv = authzType + "{" + srv + "}"
+	}
+	if rateLimitType := g.generateRateLimitServer(servName, callInfoVar, service); rateLimitType != "" {
+		srv = rateLimitType + "{" + srv + "}"
+	}
+	if deadlineType := g.generateDeadlineServer(servName, callInfoVar, service); deadlineType != "" {
+		srv = deadlineType + "{" + srv + "}"
+	}
+	if g.pool {
+		srv = g.generatePoolServer(servName, service) + "{" + srv + "}"
+	}
+
 	g.P("func Register", servName, "Server(srv ", serverType, ") {")
-	g.P("carno.HandleService(&", serviceDescVar, `, srv)`)
+	g.P(g.callinfoPkg, ".RegisterServer(", strconv.Quote(fullServName), ")")
+	g.P(g.carnoPkg, ".HandleService(&", serviceDescVar, `, `, srv, `)`)
 	g.P("}")
 	g.P()
 
+	if g.grpc {
+		g.generateGrpcAdapter(file, servName, srv, service)
+	}
+	if g.http {
+		g.generateHTTPHandler(file, servName, srv, callInfoVar, service)
+	}
+	if g.queue {
+		g.generateQueueBindings(file, path, servName, fullServName, srv, callInfoVar, service)
+	}
+	if g.testServer {
+		g.generateTestServer(origServName, servName, srv, callInfoVar, service)
+	}
+	if g.log {
+		g.generateLogging(servName, fullServName, callInfoVar, service)
+	}
+
 	// Service descriptor.
 
-	g.P("var ", serviceDescVar, " = ", "mux.ServiceDesc {")
+	g.P("var ", serviceDescVar, " = ", g.muxPkg, ".ServiceDesc {")
 	g.P("ServiceName: ", strconv.Quote(origServName), ",")
 	g.P("Methods: []", "string{")
 	for _, method := range service.Method {
-		if method.GetServerStreaming() || method.GetClientStreaming() {
+		if plugingen.Streaming(method) {
 			continue
 		}
 		g.P(strconv.Quote(method.GetName()), ",")
@@ -249,32 +541,133 @@ func (g *carno) generateService(file *generator.FileDescriptor, service *pb.Serv
 
 }
 
-// generateClientSignature returns the client-side signature for a method.
-func (g *carno) generateClientSignature(servName string, method *pb.MethodDescriptorProto) string {
-	origMethName := method.GetName()
-	methName := generator.CamelCase(origMethName)
-	if reservedClientName[methName] {
-		methName += "_"
+// clientSignature returns the client-side signature for a method.
+func (g *carno) clientSignature(servName string, method *pb.MethodDescriptorProto) string {
+	return g.ClientSignature(servName, method, g.contextPkg+".Context", g.clientPkg+".CallOption")
+}
+
+// callInfoTemplate generates the table of callinfo.CallInfos for the
+// methods of a service, in declaration order, and registers it.
+var callInfoTemplate = template.Must(template.New("callinfo").Funcs(generator.TemplateFuncs()).Parse(`
+{{- $callinfo := import "` + callinfoPkgPath + `" -}}
+var {{.Var}} = []*{{$callinfo}}.CallInfo{
+{{- range .Service.Methods}}
+	{
+		Service: {{quote $.Name}},
+		Method: {{quote .Name}},
+		RequestType: {{quote .InputName}},
+		ResponseType: {{quote .OutputName}},
+		{{- if .ClientStreaming}}
+		ClientStreaming: true,
+		{{- end}}
+		{{- if .ServerStreaming}}
+		ServerStreaming: true,
+		{{- end}}
+		File: {{quote $.File}},
+		{{- with index $.Limits .Desc}}
+		{{- if .Bytes}}
+		MaxRequestBytes: {{.Bytes}},
+		{{- end}}
+		{{- if .Fields}}
+		MaxRequestFields: {{.Fields}},
+		{{- end}}
+		{{- end}}
+		{{- with index $.RateLimits .Desc}}
+		RateLimit: {{.GetRps}},
+		{{- if .GetBurst}}
+		RateBurst: {{.GetBurst}},
+		{{- end}}
+		{{- end}}
+		{{- with index $.Timeouts .Desc}}
+		DefaultTimeout: {{.Nanoseconds}}, // {{.}}
+		{{- end}}
+		{{- if index $.Oneway .Desc}}
+		Oneway: true,
+		{{- end}}
+		{{- with index $.Keys .Desc}}
+		IdempotencyKey: {{quote .}},
+		{{- end}}
+	},
+{{- end}}
+}
+
+func init() {
+	{{$callinfo}}.Register({{.Var}}...)
+}
+
+`))
+
+// generateCallInfo generates the callinfo table of a service, named by
+// its carno name fullServName, and returns the name of the table.
+func (g *carno) generateCallInfo(file *generator.FileDescriptor, servName, fullServName string, service *generator.ServiceView) string {
+	callInfoVar := plugingen.Var(servName, "callInfo")
+	limits := make(map[*pb.MethodDescriptorProto]*requestLimits)
+	for _, method := range service.Desc.Method {
+		if l := g.requestLimits(method); l.Bytes > 0 || l.Fields > 0 {
+			limits[method] = &l
+		}
 	}
-	reqArg := ", in *" + g.typeName(method.GetInputType())
-	if method.GetClientStreaming() {
-		reqArg = ""
+	rateLimits := make(map[*pb.MethodDescriptorProto]*options.RateLimit)
+	for _, method := range service.Desc.Method {
+		if r := g.rateLimit(method); r != nil {
+			rateLimits[method] = r
+		}
+	}
+	timeouts := make(map[*pb.MethodDescriptorProto]time.Duration)
+	for _, method := range service.Desc.Method {
+		if d := g.defaultTimeout(method); d > 0 {
+			timeouts[method] = d
+		}
 	}
-	respName := "*" + g.typeName(method.GetOutputType())
-	if method.GetServerStreaming() || method.GetClientStreaming() {
-		respName = servName + "_" + generator.CamelCase(origMethName) + "Client"
+	oneway := make(map[*pb.MethodDescriptorProto]bool)
+	for _, method := range service.Desc.Method {
+		oneway[method] = g.oneway(method)
 	}
-	return fmt.Sprintf("%s(ctx context.Context%s, opts ...client.CallOption) (%s, error)", methName, reqArg, respName)
-
+	keys := make(map[*pb.MethodDescriptorProto]string)
+	for _, method := range service.Desc.Method {
+		if _, key := g.idempotencyKey(method); key != nil {
+			keys[method] = key.GetName()
+		}
+	}
+	err := g.gen.ExecuteTemplate(callInfoTemplate, struct {
+		Var, Name, File string
+		Service         *generator.ServiceView
+		Limits          map[*pb.MethodDescriptorProto]*requestLimits
+		RateLimits      map[*pb.MethodDescriptorProto]*options.RateLimit
+		Timeouts        map[*pb.MethodDescriptorProto]time.Duration
+		Oneway          map[*pb.MethodDescriptorProto]bool
+		Keys            map[*pb.MethodDescriptorProto]string
+	}{callInfoVar, fullServName, file.GetName(), service, limits, rateLimits, timeouts, oneway, keys})
+	if err != nil {
+		g.gen.Error(err, "executing callinfo template")
+	}
+	return callInfoVar
 }
 
-func (g *carno) generateClientMethod(pkgName, servName, fullServName, serviceDescVar string, method *pb.MethodDescriptorProto, descExpr string) {
-	outType := g.typeName(method.GetOutputType())
+// generateClientMethod generates the client implementation of a method.
+// infoExpr is the method's entry in the service's callinfo table, which
+// the call carries in its context.
+func (g *carno) generateClientMethod(servName string, method *pb.MethodDescriptorProto, infoExpr string) {
+	outType := g.TypeName(method.GetOutputType())
 
-	g.P("func (c *", unexport(servName), "Client) ", g.generateClientSignature(servName, method), "{")
-	g.P("out := new(", outType, ")")
+	g.P("func (c *", plugingen.Unexport(servName), "Client) ", g.clientSignature(servName, method), "{")
+	if g.pool {
+		get, _ := pool.Names(g.gen, method.GetOutputType())
+		g.P("out := ", get, "()")
+	} else {
+		g.P("out := new(", outType, ")")
+	}
 
 	// invoke
+	g.P("ctx = ", g.callinfoPkg, ".NewContext(ctx, ", infoExpr, ")")
+	if g.oneway(method) {
+		// The response is empty, so there is nothing to wait for.
+		g.P(`err:=`, g.onewayPkg, `.Call(ctx, c.Client, `, strconv.Quote(servName), ",", strconv.Quote(method.GetName()), `, in, opts...)`)
+		g.P("return out, err")
+		g.P("}")
+		g.P()
+		return
+	}
 	g.P(`err:=c.Client.Call(ctx, `, strconv.Quote(servName), ",", strconv.Quote(method.GetName()), `, in, out, opts...)`)
 	g.P("return out, err")
 	g.P("}")
@@ -282,53 +675,1708 @@ func (g *carno) generateClientMethod(pkgName, servName, fullServName, serviceDes
 	return
 }
 
-// generateServerSignature returns the server-side signature for a method.
-func (g *carno) generateServerSignature(servName string, method *pb.MethodDescriptorProto) string {
-	origMethName := method.GetName()
-	methName := generator.CamelCase(origMethName)
-	if reservedClientName[methName] {
-		methName += "_"
+// methodGroup returns the CamelCased (carno.group) of the method, or "" if it has none.
+func (g *carno) methodGroup(method *pb.MethodDescriptorProto) string {
+	v := g.gen.MethodOption(method, options.E_Group)
+	if v == nil {
+		return ""
+	}
+	return generator.CamelCase(*v.(*string))
+}
+
+// shardable reports whether the service has the (carno.shardable) option.
+func (g *carno) shardable(service *pb.ServiceDescriptorProto) bool {
+	v := g.gen.ServiceOption(service, options.E_Shardable)
+	return v != nil && *v.(*bool)
+}
+
+// requiredRoles returns the roles listed in the method's (carno.require_roles) option.
+func (g *carno) requiredRoles(method *pb.MethodDescriptorProto) []string {
+	v := g.gen.MethodOption(method, options.E_RequireRoles)
+	if v == nil {
+		return nil
+	}
+	return v.([]string)
+}
+
+// requestLimits holds the (carno.max_request_bytes) and
+// (carno.max_request_fields) options of a method; zero means no limit.
+type requestLimits struct {
+	Bytes, Fields uint32
+}
+
+// requestLimits returns the request limits of the method.
+func (g *carno) requestLimits(method *pb.MethodDescriptorProto) requestLimits {
+	var l requestLimits
+	if v := g.gen.MethodOption(method, options.E_MaxRequestBytes); v != nil {
+		l.Bytes = *v.(*uint32)
+	}
+	if v := g.gen.MethodOption(method, options.E_MaxRequestFields); v != nil {
+		l.Fields = *v.(*uint32)
+	}
+	return l
+}
+
+// rateLimit returns the method's (carno.rate_limit) option, or nil.
+func (g *carno) rateLimit(method *pb.MethodDescriptorProto) *options.RateLimit {
+	v := g.gen.MethodOption(method, options.E_RateLimit)
+	if v == nil {
+		return nil
 	}
+	return v.(*options.RateLimit)
+}
 
-	var reqArgs []string
-	ret := "error"
-	reqArgs = append(reqArgs, "context.Context", "*"+g.typeName(method.GetInputType()))
-	ret = "(*" + g.typeName(method.GetOutputType()) + ", error)"
+// defaultTimeout returns the duration of the method's
+// (carno.default_timeout) option, or zero if it has none or it does not
+// parse; validateMethodOptions reports the latter.
+func (g *carno) defaultTimeout(method *pb.MethodDescriptorProto) time.Duration {
+	v := g.gen.MethodOption(method, options.E_DefaultTimeout)
+	if v == nil {
+		return 0
+	}
+	d, err := time.ParseDuration(*v.(*string))
+	if err != nil {
+		return 0
+	}
+	return d
+}
 
-	return methName + "(" + strings.Join(reqArgs, ", ") + ") " + ret
+// oneway reports whether the method has the (carno.oneway) option.
+func (g *carno) oneway(method *pb.MethodDescriptorProto) bool {
+	v := g.gen.MethodOption(method, options.E_Oneway)
+	return v != nil && *v.(*bool)
 }
 
-func (g *carno) generateServerSetting(file *generator.FileDescriptor) {
-	pkg := file.GetPackage()
-	if pkg == "" {
-		panic("empty package")
+// idempotencyKey returns the request message of the method and its field
+// with the (carno.idempotency_key) option, or a nil field if it has none.
+func (g *carno) idempotencyKey(method *pb.MethodDescriptorProto) (*generator.Descriptor, *pb.FieldDescriptorProto) {
+	msg, ok := g.gen.ObjectNamed(method.GetInputType()).(*generator.Descriptor)
+	if !ok {
+		return nil, nil
 	}
+	for _, field := range msg.Field {
+		if v := g.gen.FieldOption(field, options.E_IdempotencyKey); v != nil && *v.(*bool) {
+			return msg, field
+		}
+	}
+	return msg, nil
 }
 
-func (g *carno) generateServerPackage(pkg string, services ...string) {
-	camelCasePkgName := generator.CamelCase(strings.Replace(pkg, ".", "_", -1))
-	g.P("type ", camelCasePkgName, " struct{")
-	for _, service := range services {
-		g.P(generator.CamelCase(service), "Client")
+// generateDedupeServer generates a wrapper around the service's server
+// implementation that answers calls repeating the idempotency key of an
+// earlier one with its response, with package dedupe. It returns the name
+// of the wrapper type, or "" if no method's request has a key.
+func (g *carno) generateDedupeServer(servName, callInfoVar string, service *pb.ServiceDescriptorProto) string {
+	var keyed []int
+	for i, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		if _, key := g.idempotencyKey(method); key != nil {
+			keyed = append(keyed, i)
+		}
+	}
+	if len(keyed) == 0 {
+		return ""
 	}
+
+	dedupeType := plugingen.Var(servName, "dedupeServer")
+	serverType := servName + "Server"
+	g.P("// ", dedupeType, " answers calls whose idempotency key was seen before")
+	g.P("// with the stored response, and stores the responses of new ones.")
+	g.P("type ", dedupeType, " struct {")
+	g.P(serverType)
 	g.P("}")
-	g.P("")
+	g.P()
+	for _, i := range keyed {
+		method := service.Method[i]
+		methName := g.MethodName(method)
+		inType, outType := g.TypeName(method.GetInputType()), g.TypeName(method.GetOutputType())
+		msg, key := g.idempotencyKey(method)
+		keyExpr := "in." + msg.GoGetterName(key) + "()"
+		if key.GetType() == pb.FieldDescriptorProto_TYPE_BYTES {
+			keyExpr = "string(" + keyExpr + ")"
+		}
+		g.P("func (s ", dedupeType, ") ", methName, "(ctx ", g.contextPkg, ".Context, in *", inType, ") (*", outType, ", error) {")
+		g.P("out, err := ", g.dedupePkg, ".Do(ctx, ", callInfoVar, "[", i, "], ", keyExpr, ", in, new(", outType, "), func(ctx ", g.contextPkg, ".Context) (", g.protoPkg, ".Message, error) {")
+		g.P("return s.", serverType, ".", methName, "(ctx, in)")
+		g.P("})")
+		g.P("resp, _ := out.(*", outType, ")")
+		g.P("return resp, err")
+		g.P("}")
+		g.P()
+	}
+	return dedupeType
+}
 
-	g.P("func New", camelCasePkgName, "(opts ...client.Option) (*", camelCasePkgName, ",error){")
-	g.P(`	c,err := carno.NewClient(`, strconv.Quote(pkg), `,opts...)`)
-	g.P("if err!=nil{")
-	g.P("return nil,err")
+// generateDeadlineServer generates a wrapper around the service's server
+// implementation that calls each method with a (carno.default_timeout)
+// under its CallInfo's DefaultTimeout if the call's context has no
+// deadline. It returns the name of the wrapper type, or "" if no method
+// has a default timeout.
+func (g *carno) generateDeadlineServer(servName, callInfoVar string, service *pb.ServiceDescriptorProto) string {
+	var timed []int
+	for i, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		if g.defaultTimeout(method) > 0 {
+			timed = append(timed, i)
+		}
+	}
+	if len(timed) == 0 {
+		return ""
+	}
+
+	deadlineType := plugingen.Var(servName, "deadlineServer")
+	serverType := servName + "Server"
+	g.P("// ", deadlineType, " applies the DefaultTimeout of each method in")
+	g.P("// ", callInfoVar, " to calls without a deadline before calling the wrapped server.")
+	g.P("type ", deadlineType, " struct {")
+	g.P(serverType)
 	g.P("}")
+	g.P()
+	for _, i := range timed {
+		method := service.Method[i]
+		methName := g.MethodName(method)
+		g.P("func (s ", deadlineType, ") ", methName, "(ctx ", g.contextPkg, ".Context, in *", g.TypeName(method.GetInputType()), ") (*", g.TypeName(method.GetOutputType()), ", error) {")
+		g.P("ctx, cancel := ", callInfoVar, "[", i, "].WithDefaultTimeout(ctx)")
+		g.P("defer cancel()")
+		g.P("return s.", serverType, ".", methName, "(ctx, in)")
+		g.P("}")
+		g.P()
+	}
+	return deadlineType
+}
 
-	g.P("if err:=c.Start();err!=nil{")
-	g.P("return nil,err")
+// generateRateLimitServer generates a wrapper around the service's server
+// implementation that checks the (carno.rate_limit) of each limited method
+// with package ratelimit before calling it. It returns the name of the
+// wrapper type, or "" if no method has a rate limit.
+func (g *carno) generateRateLimitServer(servName, callInfoVar string, service *pb.ServiceDescriptorProto) string {
+	var limited []int
+	for i, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		if g.rateLimit(method) != nil {
+			limited = append(limited, i)
+		}
+	}
+	if len(limited) == 0 {
+		return ""
+	}
+
+	rateLimitType := plugingen.Var(servName, "rateLimitServer")
+	serverType := servName + "Server"
+	g.P("// ", rateLimitType, " rejects calls over the RateLimit of their method")
+	g.P("// in ", callInfoVar, " before calling the wrapped server.")
+	g.P("type ", rateLimitType, " struct {")
+	g.P(serverType)
 	g.P("}")
+	g.P()
+	for _, i := range limited {
+		method := service.Method[i]
+		methName := g.MethodName(method)
+		g.P("func (s ", rateLimitType, ") ", methName, "(ctx ", g.contextPkg, ".Context, in *", g.TypeName(method.GetInputType()), ") (*", g.TypeName(method.GetOutputType()), ", error) {")
+		g.P("if err := ", g.ratelimitPkg, ".Check(ctx, ", callInfoVar, "[", i, "]); err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("return s.", serverType, ".", methName, "(ctx, in)")
+		g.P("}")
+		g.P()
+	}
+	return rateLimitType
+}
+
+// generateAuthzServer generates a wrapper around the service's server
+// implementation that checks (carno.require_roles) before each guarded method.
+// It returns the name of the wrapper type, or "" if no method requires roles.
+func (g *carno) generateAuthzServer(servName, fullServName string, service *pb.ServiceDescriptorProto) string {
+	var guarded []*pb.MethodDescriptorProto
+	for _, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		if len(g.requiredRoles(method)) > 0 {
+			guarded = append(guarded, method)
+		}
+	}
+	if len(guarded) == 0 {
+		return ""
+	}
 
-	g.P("return &", camelCasePkgName, "{")
-	for _, service := range services {
-		g.P(generator.CamelCase(service), "Client: &", unexport(service), "Client{Client:c},")
+	rolesVar := plugingen.Var(servName, "methodRoles")
+	g.P("var ", rolesVar, " = map[string][]string{")
+	for _, method := range guarded {
+		var roles []string
+		for _, r := range g.requiredRoles(method) {
+			roles = append(roles, strconv.Quote(r))
+		}
+		g.P(strconv.Quote(method.GetName()), ": {", strings.Join(roles, ", "), "},")
 	}
-	g.P("},nil")
 	g.P("}")
-	g.P("")
+	g.P()
+
+	authzType := plugingen.Var(servName, "authzServer")
+	serverType := servName + "Server"
+	g.P("// ", authzType, " checks the roles in ", rolesVar, " with the registered")
+	g.P("// carno.Authorizer before calling the wrapped server. It fails closed:")
+	g.P("// if no authorizer is registered, guarded methods are rejected.")
+	g.P("type ", authzType, " struct {")
+	g.P(serverType)
+	g.P("}")
+	g.P()
+	for _, method := range guarded {
+		methName := g.MethodName(method)
+		fullMethName := strconv.Quote(fullServName + "/" + method.GetName())
+		g.P("func (s ", authzType, ") ", methName, "(ctx ", g.contextPkg, ".Context, in *", g.TypeName(method.GetInputType()), ") (*", g.TypeName(method.GetOutputType()), ", error) {")
+		g.P("authz := ", g.carnoPkg, ".GetAuthorizer()")
+		g.P("if authz == nil {")
+		g.P("return nil, ", g.gen.Pkg["fmt"], `.Errorf("carno: no authorizer registered, denying %s", `, fullMethName, ")")
+		g.P("}")
+		g.P("if err := authz.Authorize(ctx, ", fullMethName, ", ", rolesVar, "[", strconv.Quote(method.GetName()), "]); err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("return s.", serverType, ".", methName, "(ctx, in)")
+		g.P("}")
+		g.P()
+	}
+	return authzType
+}
+
+// generatePoolServer generates a wrapper around the service's server
+// implementation that returns each request to its pool once the method
+// has returned, and returns the name of the wrapper type.
+func (g *carno) generatePoolServer(servName string, service *pb.ServiceDescriptorProto) string {
+	poolType := plugingen.Var(servName, "poolServer")
+	serverType := servName + "Server"
+	g.P("// ", poolType, " returns each request to its pool once the wrapped server")
+	g.P("// has handled it, so handlers must not keep requests after returning.")
+	g.P("// A request returned as the response is left alone.")
+	g.P("type ", poolType, " struct {")
+	g.P(serverType)
+	g.P("}")
+	g.P()
+	for _, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		methName := g.MethodName(method)
+		inType, outType := g.TypeName(method.GetInputType()), g.TypeName(method.GetOutputType())
+		_, put := pool.Names(g.gen, method.GetInputType())
+		g.P("func (s ", poolType, ") ", methName, "(ctx ", g.contextPkg, ".Context, in *", inType, ") (*", outType, ", error) {")
+		if method.GetInputType() == method.GetOutputType() {
+			g.P("out, err := s.", serverType, ".", methName, "(ctx, in)")
+			g.P("if out != in {")
+			g.P(put, "(in)")
+			g.P("}")
+			g.P("return out, err")
+		} else {
+			g.P("defer ", put, "(in)")
+			g.P("return s.", serverType, ".", methName, "(ctx, in)")
+		}
+		g.P("}")
+		g.P()
+	}
+	return poolType
+}
+
+// generateGrpcAdapter generates Register<Service>ServerAsGrpc, which
+// registers srv, the server implementation as wrapped for carno, with a
+// gRPC server, and the gRPC service description and method handlers it
+// uses. The service has its gRPC name, the full name of its proto
+// definition, and serves the unary methods only, as carno does.
+func (g *carno) generateGrpcAdapter(file *generator.FileDescriptor, servName, srv string, service *pb.ServiceDescriptorProto) {
+	grpcServName := service.GetName()
+	if pkg := file.GetPackage(); pkg != "" {
+		grpcServName = pkg + "." + grpcServName
+	}
+	serverType := servName + "Server"
+	descVar := plugingen.Var(servName, "grpcServiceDesc")
+
+	g.P("// Register", servName, "ServerAsGrpc registers srv with s as the gRPC service")
+	g.P("// ", grpcServName, ", so that gRPC clients can call it. Requests are checked as")
+	g.P("// with Register", servName, "Server; streaming methods are not served.")
+	g.P("func Register", servName, "ServerAsGrpc(s *", g.grpcPkg, ".Server, srv ", serverType, ") {")
+	g.P("s.RegisterService(&", descVar, ", ", srv, ")")
+	g.P("}")
+	g.P()
+
+	var methods []*pb.MethodDescriptorProto
+	for _, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		methods = append(methods, method)
+
+		methName := g.MethodName(method)
+		inType := g.TypeName(method.GetInputType())
+		newIn := "new(" + inType + ")"
+		if g.pool {
+			newIn, _ = pool.Names(g.gen, method.GetInputType())
+			newIn += "()"
+		}
+		g.P("func ", plugingen.Var(servName, methName+"_GrpcHandler"), "(srv interface{}, ctx ", g.contextPkg, ".Context, dec func(interface{}) error, interceptor ", g.grpcPkg, ".UnaryServerInterceptor) (interface{}, error) {")
+		g.P("in := ", newIn)
+		g.P("if err := dec(in); err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("if interceptor == nil {")
+		g.P("return srv.(", serverType, ").", methName, "(ctx, in)")
+		g.P("}")
+		g.P("info := &", g.grpcPkg, ".UnaryServerInfo{")
+		g.P("Server: srv,")
+		g.P("FullMethod: ", strconv.Quote("/"+grpcServName+"/"+method.GetName()), ",")
+		g.P("}")
+		g.P("handler := func(ctx ", g.contextPkg, ".Context, req interface{}) (interface{}, error) {")
+		g.P("return srv.(", serverType, ").", methName, "(ctx, req.(*", inType, "))")
+		g.P("}")
+		g.P("return interceptor(ctx, in, info, handler)")
+		g.P("}")
+		g.P()
+	}
+
+	g.P("var ", descVar, " = ", g.grpcPkg, ".ServiceDesc{")
+	g.P("ServiceName: ", strconv.Quote(grpcServName), ",")
+	g.P("HandlerType: (*", serverType, ")(nil),")
+	g.P("Methods: []", g.grpcPkg, ".MethodDesc{")
+	for _, method := range methods {
+		g.P("{")
+		g.P("MethodName: ", strconv.Quote(method.GetName()), ",")
+		g.P("Handler: ", plugingen.Var(servName, g.MethodName(method)+"_GrpcHandler"), ",")
+		g.P("},")
+	}
+	g.P("},")
+	g.P("Streams: []", g.grpcPkg, ".StreamDesc{},")
+	g.P("Metadata: ", strconv.Quote(file.GetName()), ",")
+	g.P("}")
+	g.P()
+}
+
+// generateHTTPHandler generates New<Service>HTTPHandler, which serves srv,
+// the server implementation as wrapped for carno, over HTTP with package
+// httprpc, and <Service>HTTPPathPrefix, the path it serves. Like the gRPC
+// adapter, it names the service by its full proto name and serves the
+// unary methods only.
+func (g *carno) generateHTTPHandler(file *generator.FileDescriptor, servName, srv, callInfoVar string, service *pb.ServiceDescriptorProto) {
+	httpServName := service.GetName()
+	if pkg := file.GetPackage(); pkg != "" {
+		httpServName = pkg + "." + httpServName
+	}
+	serverType := servName + "Server"
+
+	g.P("// ", servName, "HTTPPathPrefix is the path under which New", servName, "HTTPHandler")
+	g.P("// serves each method of ", servName, ", as POST ", servName, "HTTPPathPrefix+<Method>.")
+	g.P("const ", servName, "HTTPPathPrefix = ", strconv.Quote("/"+httpServName+"/"))
+	g.P()
+	g.P("// New", servName, "HTTPHandler returns a handler serving srv over HTTP, with")
+	g.P("// requests and responses in the binary format or in JSON, for clients that")
+	g.P("// cannot use the carno transport; see package httprpc. Requests are checked")
+	g.P("// as with Register", servName, "Server; streaming methods are not served.")
+	g.P("func New", servName, "HTTPHandler(srv ", serverType, ") ", g.httpPkg, ".Handler {")
+	if srv != "srv" {
+		g.P("srv = ", srv)
+	}
+	g.P("return ", g.httprpcPkg, ".NewHandler(", strconv.Quote(httpServName), ", map[string]", g.httprpcPkg, ".Method{")
+	g.printMethods(callInfoVar, service)
+	g.P("})")
+	g.P("}")
+	g.P()
+}
+
+// generateTestServer generates New<Service>TestServer, which returns a
+// client calling srv in memory, through the same encoding and wrappers as
+// a carno server.
+func (g *carno) generateTestServer(origServName, servName, srv, callInfoVar string, service *pb.ServiceDescriptorProto) {
+	g.P("// New", servName, "TestServer returns a ", servName, "Client that calls srv in")
+	g.P("// memory, for testing srv without a network. Calls go through the same")
+	g.P("// encoding and decoding as with a carno client and server, and requests")
+	g.P("// are checked as with Register", servName, "Server; see package carnotest.")
+	g.P("// Streaming methods are not served.")
+	g.P("func New", servName, "TestServer(srv ", servName, "Server) ", servName, "Client {")
+	if srv != "srv" {
+		g.P("srv = ", srv)
+	}
+	g.P("c := ", g.carnotestPkg, ".NewClient(", strconv.Quote(origServName), ", map[string]", g.carnotestPkg, ".Method{")
+	g.printMethods(callInfoVar, service)
+	g.P("})")
+	g.P("return &", plugingen.Unexport(servName), "Client{Client: c}")
+	g.P("}")
+	g.P()
+}
+
+// printMethods prints the entries of a map literal of the Method type of
+// httprpc, queuerpc or carnotest, one for each unary method of service,
+// keyed by the method's name. Each calls the method of srv, a <Service>Server in the
+// enclosing scope, with a request that it decodes, taken from its pool if
+// carno:pool is set.
+func (g *carno) printMethods(callInfoVar string, service *pb.ServiceDescriptorProto) {
+	for i, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		newIn := "new(" + g.TypeName(method.GetInputType()) + ")"
+		if g.pool {
+			newIn, _ = pool.Names(g.gen, method.GetInputType())
+			newIn += "()"
+		}
+		g.P(strconv.Quote(method.GetName()), ": {")
+		g.P("Info: ", callInfoVar, "[", i, "],")
+		g.P("Call: func(ctx ", g.contextPkg, ".Context, decode func(", g.gen.Pkg["proto"], ".Message) error) (", g.gen.Pkg["proto"], ".Message, error) {")
+		g.P("in := ", newIn)
+		g.P("if err := decode(in); err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("return srv.", g.MethodName(method), "(ctx, in)")
+		g.P("},")
+		g.P("},")
+	}
+}
+
+// generateLogging generates New<Service>LoggingServer and
+// New<Service>LoggingClient, which wrap a server or client of the service
+// so that each unary call is passed to a logpb.CallLogger when it returns,
+// unless the toggle.Logging feature is off for the method. The wrappers
+// embed what they wrap, so streaming methods are passed through unlogged.
+func (g *carno) generateLogging(servName, fullServName, callInfoVar string, service *pb.ServiceDescriptorProto) {
+	sides := []struct {
+		kind, arg, recv, typ string
+		client               bool
+	}{
+		{"Server", "srv", "s", plugingen.Var(servName, "logServer"), false},
+		{"Client", "c", "c", plugingen.Var(servName, "logClient"), true},
+	}
+	for _, side := range sides {
+		iface := servName + side.kind
+		g.P("// New", servName, "Logging", side.kind, " returns a ", iface, " that logs each call")
+		g.P("// of ", side.arg, " with l, unless the toggle.Logging feature is off for its method.")
+		g.P("// Streaming methods are not logged.")
+		g.P("func New", servName, "Logging", side.kind, "(", side.arg, " ", iface, ", l ", g.logpbPkg, ".CallLogger) ", iface, " {")
+		g.P("return ", side.typ, "{", side.arg, ", l}")
+		g.P("}")
+		g.P()
+		g.P("type ", side.typ, " struct {")
+		g.P(iface)
+		g.P("l ", g.logpbPkg, ".CallLogger")
+		g.P("}")
+		g.P()
+		for i, method := range service.Method {
+			if plugingen.Streaming(method) {
+				continue
+			}
+			methName := g.MethodName(method)
+			call := side.recv + "." + iface + "." + methName + "(ctx, in)"
+			if side.client {
+				g.P("func (c ", side.typ, ") ", g.clientSignature(servName, method), " {")
+				call = side.recv + "." + iface + "." + methName + "(ctx, in, opts...)"
+			} else {
+				g.P("func (s ", side.typ, ") ", methName, "(ctx ", g.contextPkg, ".Context, in *", g.TypeName(method.GetInputType()), ") (*", g.TypeName(method.GetOutputType()), ", error) {")
+			}
+			g.P("if !", g.togglePkg, ".Enabled(", g.togglePkg, ".Logging, ", strconv.Quote(fullServName+"/"+method.GetName()), ") {")
+			g.P("return ", call)
+			g.P("}")
+			g.P("start := ", g.timePkg, ".Now()")
+			g.P("out, err := ", call)
+			g.P(side.recv, ".l.LogCall(ctx, &", g.logpbPkg, ".Call{")
+			g.P("Info: ", callInfoVar, "[", i, "],")
+			if side.client {
+				g.P("Client: true,")
+			}
+			g.P("Start: start,")
+			g.P("Duration: ", g.timePkg, ".Since(start),")
+			g.P("Request: in,")
+			g.P("Response: out,")
+			g.P("Err: err,")
+			g.P("})")
+			g.P("return out, err")
+			g.P("}")
+			g.P()
+		}
+	}
+}
+
+// idempotent reports whether the method's (idempotency_level) option
+// marks it as safe to call twice, as callinfo.CallInfo.Idempotent does.
+func idempotent(method *pb.MethodDescriptorProto) bool {
+	switch method.GetOptions().GetIdempotencyLevel() {
+	case pb.MethodOptions_IDEMPOTENT, pb.MethodOptions_NO_SIDE_EFFECTS:
+		return true
+	}
+	return false
+}
+
+// generateHedgingClient generates New<Service>HedgingClient, which wraps
+// a client of the service so that the calls to its idempotent unary
+// methods are hedged with package hedge. The wrapper embeds the client,
+// so the other methods are called once.
+func (g *carno) generateHedgingClient(servName string, service *pb.ServiceDescriptorProto) {
+	hedgeType := plugingen.Var(servName, "hedgingClient")
+	clientType := servName + "Client"
+	g.P("// New", servName, "HedgingClient returns a ", clientType, " that hedges the calls of c")
+	g.P("// to idempotent methods: if a call has not returned after delay, it makes a")
+	g.P("// backup call, returns the first response and cancels the other call.")
+	g.P("// Calls to the remaining methods are made once. See package hedge.")
+	g.P("func New", servName, "HedgingClient(c ", clientType, ", delay ", g.timePkg, ".Duration) ", clientType, " {")
+	g.P("return ", hedgeType, "{c, delay}")
+	g.P("}")
+	g.P()
+	g.P("type ", hedgeType, " struct {")
+	g.P(clientType)
+	g.P("delay ", g.timePkg, ".Duration")
+	g.P("}")
+	g.P()
+	for _, method := range service.Method {
+		if plugingen.Streaming(method) || !idempotent(method) {
+			continue
+		}
+		methName := g.MethodName(method)
+		outType := g.TypeName(method.GetOutputType())
+		g.P("func (c ", hedgeType, ") ", g.clientSignature(servName, method), " {")
+		g.P("out, err := ", g.hedgePkg, ".Do(ctx, c.delay, func(ctx ", g.contextPkg, ".Context) (", g.gen.Pkg["proto"], ".Message, error) {")
+		g.P("return c.", clientType, ".", methName, "(ctx, in, opts...)")
+		g.P("})")
+		g.P("resp, _ := out.(*", outType, ")")
+		g.P("return resp, err")
+		g.P("}")
+		g.P()
+	}
+}
+
+// generateFanOut generates <Service>FanOut, whose <Method>FanOut methods
+// call a unary method of a shardable service on many servers at once with
+// package fanout and merge the responses.
+func (g *carno) generateFanOut(servName string, service *pb.ServiceDescriptorProto) {
+	fanOutType := servName + "FanOut"
+	protoPkg := g.gen.Pkg["proto"]
+
+	g.P("// ", fanOutType, " calls the methods of ", servName, " on many servers at once,")
+	g.P("// each holding a shard of its data, and merges their responses.")
+	g.P("// Client and Target must be set; a nil reducer merges the responses")
+	g.P("// of its method with proto.Merge.")
+	g.P("type ", fanOutType, " struct {")
+	g.P("// Client makes the calls.")
+	g.P("Client ", servName, "Client")
+	g.P()
+	g.P("// Target returns the call option that sends a call to target.")
+	g.P("Target func(target string) ", g.clientPkg, ".CallOption")
+	for _, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		methName := g.MethodName(method)
+		g.P()
+		g.P("// ", methName, "Reduce merges a response of ", methName, " into acc.")
+		g.P(methName, "Reduce func(acc, resp *", g.TypeName(method.GetOutputType()), ")")
+	}
+	g.P("}")
+	g.P()
+
+	for _, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		methName := g.MethodName(method)
+		inType := g.TypeName(method.GetInputType())
+		outType := g.TypeName(method.GetOutputType())
+		g.P("// ", methName, "FanOut calls ", methName, " with in on each of targets concurrently and")
+		g.P("// merges the responses, in the order of targets, into a new response.")
+		g.P("// If any calls fail, it returns the merged responses of the others and")
+		g.P("// a *fanout.Error.")
+		g.P("func (f *", fanOutType, ") ", methName, "FanOut(ctx ", g.contextPkg, ".Context, in *", inType, ", targets []string) (*", outType, ", error) {")
+		g.P("out := new(", outType, ")")
+		g.P("err := ", g.fanoutPkg, ".Call(ctx, targets, func(ctx ", g.contextPkg, ".Context, target string) (", protoPkg, ".Message, error) {")
+		g.P("return f.Client.", methName, "(ctx, in, f.Target(target))")
+		g.P("}, func(resp ", protoPkg, ".Message) {")
+		g.P("if f.", methName, "Reduce != nil {")
+		g.P("f.", methName, "Reduce(out, resp.(*", outType, "))")
+		g.P("} else {")
+		g.P(protoPkg, ".Merge(out, resp)")
+		g.P("}")
+		g.P("})")
+		g.P("return out, err")
+		g.P("}")
+		g.P()
+	}
+}
+
+// pages describes the fields with which a list method pages through its
+// results.
+type pages struct {
+	in, out *generator.Descriptor    // request and response messages
+	token   *pb.FieldDescriptorProto // page token of the request
+	next    *pb.FieldDescriptorProto // next page token of the response
+	items   *pb.FieldDescriptorProto // results, or nil if not known
+}
+
+// pages returns the paging fields of the method, or nil if it does not
+// page through its results. It returns an error if the method's
+// (carno.pagination) option names fields its messages do not have.
+func (g *carno) pages(method *pb.MethodDescriptorProto) (*pages, error) {
+	if plugingen.Streaming(method) {
+		return nil, nil
+	}
+	in, ok := g.gen.ObjectNamed(method.GetInputType()).(*generator.Descriptor)
+	if !ok {
+		return nil, nil
+	}
+	out, ok := g.gen.ObjectNamed(method.GetOutputType()).(*generator.Descriptor)
+	if !ok {
+		return nil, nil
+	}
+	opt := new(options.Pagination)
+	v := g.gen.MethodOption(method, options.E_Pagination)
+	if v != nil {
+		opt = v.(*options.Pagination)
+	}
+	token := findField(in, opt.GetPageToken(), "page_token")
+	next := findField(out, opt.GetNextPageToken(), "next_page_token")
+	if v == nil && (!isToken(token) || !isToken(next)) {
+		return nil, nil
+	}
+	switch {
+	case !isToken(token):
+		return nil, fmt.Errorf("request %s has no string field %s", in.GetName(), orDefault(opt.GetPageToken(), "page_token"))
+	case !isToken(next):
+		return nil, fmt.Errorf("response %s has no string field %s", out.GetName(), orDefault(opt.GetNextPageToken(), "next_page_token"))
+	}
+	p := &pages{in: in, out: out, token: token, next: next}
+	if opt.Items != nil {
+		p.items = findField(out, opt.GetItems(), "")
+		if p.items == nil || p.items.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED {
+			return nil, fmt.Errorf("response %s has no repeated field %s", out.GetName(), opt.GetItems())
+		}
+		return p, nil
+	}
+	for _, field := range out.Field {
+		if field.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED || g.isMap(field) {
+			continue
+		}
+		if p.items != nil {
+			// Which of them holds the results is anyone's guess.
+			p.items = nil
+			break
+		}
+		p.items = field
+	}
+	return p, nil
+}
+
+// isMap reports whether field is a map field.
+func (g *carno) isMap(field *pb.FieldDescriptorProto) bool {
+	if field.GetType() != pb.FieldDescriptorProto_TYPE_MESSAGE {
+		return false
+	}
+	d, ok := g.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor)
+	return ok && d.GetOptions().GetMapEntry()
+}
+
+// findField returns the field of msg with the given name, or if it is
+// empty with the name def, or nil if there is none.
+func findField(msg *generator.Descriptor, field, def string) *pb.FieldDescriptorProto {
+	for _, f := range msg.Field {
+		if f.GetName() == orDefault(field, def) {
+			return f
+		}
+	}
+	return nil
+}
+
+// orDefault returns name, or def if it is empty.
+func orDefault(name, def string) string {
+	if name == "" {
+		return def
+	}
+	return name
+}
+
+// isToken reports whether field can hold a page token: it is a singular
+// string field outside any oneof.
+func isToken(field *pb.FieldDescriptorProto) bool {
+	return singular(field, pb.FieldDescriptorProto_TYPE_STRING)
+}
+
+// singular reports whether field is a singular field of type typ outside
+// any oneof.
+func singular(field *pb.FieldDescriptorProto, typ pb.FieldDescriptorProto_Type) bool {
+	return field != nil && field.GetType() == typ &&
+		field.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED && field.OneofIndex == nil
+}
+
+// generatePager generates <Service>Pager, with a method returning an
+// iterator over the pages of each of the service's list methods, if it
+// has any.
+func (g *carno) generatePager(servName string, service *pb.ServiceDescriptorProto) {
+	var listed []*pb.MethodDescriptorProto
+	for _, method := range service.Method {
+		if p, _ := g.pages(method); p != nil {
+			listed = append(listed, method)
+		}
+	}
+	if len(listed) == 0 {
+		return
+	}
+	pagerType := servName + "Pager"
+	protoPkg, fmtPkg := g.gen.Pkg["proto"], g.gen.Pkg["fmt"]
+
+	g.P("// ", pagerType, " walks the pages of the results of the list methods of")
+	g.P("// ", servName, ". Client must be set.")
+	g.P("type ", pagerType, " struct {")
+	g.P("// Client makes the calls.")
+	g.P("Client ", servName, "Client")
+	g.P("}")
+	g.P()
+
+	for _, method := range listed {
+		p, _ := g.pages(method)
+		methName := g.MethodName(method)
+		inType := g.TypeName(method.GetInputType())
+		outType := g.TypeName(method.GetOutputType())
+		pagesType := servName + "_" + methName + "Pages"
+		tokenField := "p.in." + p.in.GoFieldName(p.token)
+		oldToken := "p.in." + p.in.GoGetterName(p.token) + "()"
+		nextToken := "page." + p.out.GoGetterName(p.next) + "()"
+		newToken := "token"
+		if p.in.File().GetSyntax() != "proto3" {
+			newToken = protoPkg + ".String(token)"
+		}
+
+		g.P("// ", methName, "Pages returns an iterator over the pages of the responses")
+		g.P("// of ", methName, " to in, from the page its ", p.token.GetName(), " selects to the last.")
+		g.P("// in is not modified.")
+		g.P("func (p *", pagerType, ") ", methName, "Pages(ctx ", g.contextPkg, ".Context, in *", inType, ", opts ...", g.clientPkg, ".CallOption) *", pagesType, " {")
+		g.P("return &", pagesType, "{c: p.Client, ctx: ctx, in: ", protoPkg, ".Clone(in).(*", inType, "), opts: opts}")
+		g.P("}")
+		g.P()
+
+		g.P("// ", pagesType, " iterates over the pages of the responses of ", methName, ".")
+		g.P("type ", pagesType, " struct {")
+		g.P("c    ", servName, "Client")
+		g.P("ctx  ", g.contextPkg, ".Context")
+		g.P("in   *", inType, " // request for the next page")
+		g.P("opts []", g.clientPkg, ".CallOption")
+		g.P("page *", outType)
+		g.P("err  error")
+		g.P("done bool")
+		g.P("}")
+		g.P()
+
+		g.P("// Next calls ", methName, " for the next page and reports whether it got")
+		g.P("// one, which Page then returns. It returns false after the last page,")
+		g.P("// whose ", p.next.GetName(), " is empty, or once a call fails; Err returns")
+		g.P("// the error.")
+		g.P("func (p *", pagesType, ") Next() bool {")
+		g.P("if p.done {")
+		g.P("return false")
+		g.P("}")
+		g.P("page, err := p.c.", methName, "(p.ctx, p.in, p.opts...)")
+		g.P("if err != nil {")
+		g.P("p.err, p.done = err, true")
+		g.P("return false")
+		g.P("}")
+		g.P("p.page = page")
+		g.P("switch token := ", nextToken, "; token {")
+		g.P(`case "":`)
+		g.P("p.done = true")
+		g.P("case ", oldToken, ":")
+		g.P("// Asking for the same page again would never end.")
+		g.P("p.err, p.done = ", fmtPkg, `.Errorf("`, servName, ".", methName, ` returned its page token %q as the next one", token), true`)
+		g.P("default:")
+		g.P(tokenField, " = ", newToken)
+		g.P("}")
+		g.P("return true")
+		g.P("}")
+		g.P()
+
+		g.P("// Page returns the page the last call to Next got.")
+		g.P("func (p *", pagesType, ") Page() *", outType, " {")
+		g.P("return p.page")
+		g.P("}")
+		g.P()
+
+		g.P("// Err returns the error of the call that stopped Next, if any.")
+		g.P("func (p *", pagesType, ") Err() error {")
+		g.P("return p.err")
+		g.P("}")
+		g.P()
+
+		if p.items == nil {
+			continue
+		}
+		itemsType, _ := g.gen.GoType(p.out, p.items)
+		g.gen.RecordTypeUse(p.items.GetTypeName())
+		g.P("// All calls Next until the last page and returns the ", p.items.GetName(), " of the")
+		g.P("// pages it got, with the error of the call that failed, if any.")
+		g.P("func (p *", pagesType, ") All() (", itemsType, ", error) {")
+		g.P("var all ", itemsType)
+		g.P("for p.Next() {")
+		g.P("all = append(all, p.page.", p.out.GoFieldName(p.items), "...)")
+		g.P("}")
+		g.P("return all, p.err")
+		g.P("}")
+		g.P()
+	}
+}
+
+// operation describes the long-running operation a method starts.
+type operation struct {
+	op     *generator.Descriptor     // the operation, the method's response
+	poll   *pb.MethodDescriptorProto // method returning its current state
+	pollIn *generator.Descriptor     // request of poll
+	name   *pb.FieldDescriptorProto  // name of the operation
+	done   *pb.FieldDescriptorProto  // set once it is done
+	result *pb.FieldDescriptorProto  // its result, or nil if it is the operation
+	err    *pb.FieldDescriptorProto  // its error, or nil if it has none
+	inName *pb.FieldDescriptorProto  // name of the operation in pollIn
+}
+
+// operation returns the long-running operation the method of service
+// starts, or nil if it has no (carno.long_running) option. It returns an
+// error if the option names a method or fields that are not there, or
+// not of the right kinds.
+func (g *carno) operation(service *pb.ServiceDescriptorProto, method *pb.MethodDescriptorProto) (*operation, error) {
+	v := g.gen.MethodOption(method, options.E_LongRunning)
+	if v == nil {
+		return nil, nil
+	}
+	opt := v.(*options.LongRunning)
+	if plugingen.Streaming(method) {
+		return nil, errors.New("methods starting operations must not stream")
+	}
+	op, ok := g.gen.ObjectNamed(method.GetOutputType()).(*generator.Descriptor)
+	if !ok {
+		return nil, nil
+	}
+	o := &operation{op: op}
+	for _, m := range service.Method {
+		if m.GetName() == opt.GetPollMethod() {
+			o.poll = m
+		}
+	}
+	switch {
+	case o.poll == nil:
+		return nil, fmt.Errorf("service %s has no poll method %q", service.GetName(), opt.GetPollMethod())
+	case plugingen.Streaming(o.poll):
+		return nil, fmt.Errorf("poll method %s must not stream", o.poll.GetName())
+	case o.poll.GetOutputType() != method.GetOutputType():
+		return nil, fmt.Errorf("poll method %s must return %s", o.poll.GetName(), op.GetName())
+	}
+	if o.pollIn, ok = g.gen.ObjectNamed(o.poll.GetInputType()).(*generator.Descriptor); !ok {
+		return nil, nil
+	}
+
+	o.name = findField(op, opt.GetName(), "name")
+	o.done = findField(op, opt.GetDone(), "done")
+	switch {
+	case !singular(o.name, pb.FieldDescriptorProto_TYPE_STRING):
+		return nil, fmt.Errorf("operation %s has no string field %s", op.GetName(), orDefault(opt.GetName(), "name"))
+	case !singular(o.done, pb.FieldDescriptorProto_TYPE_BOOL):
+		return nil, fmt.Errorf("operation %s has no bool field %s", op.GetName(), orDefault(opt.GetDone(), "done"))
+	}
+	if o.inName = findField(o.pollIn, o.name.GetName(), ""); !singular(o.inName, pb.FieldDescriptorProto_TYPE_STRING) {
+		return nil, fmt.Errorf("request %s of poll method %s has no string field %s", o.pollIn.GetName(), o.poll.GetName(), o.name.GetName())
+	}
+
+	// The result and the error may be in a oneof, as they often are, but
+	// the result must be a message, so that there is a nil one to return
+	// with an error.
+	isResult := func(f *pb.FieldDescriptorProto) bool {
+		return f != nil && f.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE &&
+			f.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED && !g.isMap(f)
+	}
+	isError := func(f *pb.FieldDescriptorProto) bool {
+		return isResult(f) || f != nil && f.GetType() == pb.FieldDescriptorProto_TYPE_STRING &&
+			f.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED
+	}
+	o.result = findField(op, opt.GetResult(), "response")
+	if !isResult(o.result) {
+		if opt.Result != nil {
+			return nil, fmt.Errorf("operation %s has no message field %s", op.GetName(), opt.GetResult())
+		}
+		o.result = nil
+	}
+	o.err = findField(op, opt.GetError(), "error")
+	if !isError(o.err) {
+		if opt.Error != nil {
+			return nil, fmt.Errorf("operation %s has no string or message field %s", op.GetName(), opt.GetError())
+		}
+		o.err = nil
+	}
+	return o, nil
+}
+
+// generateWaiter generates <Service>Waiter, with a method for each of
+// the service's methods starting a long-running operation, which waits
+// for the operation to be done, if it has any.
+func (g *carno) generateWaiter(servName, fullServName string, service *pb.ServiceDescriptorProto) {
+	var started []*pb.MethodDescriptorProto
+	for _, method := range service.Method {
+		if o, _ := g.operation(service, method); o != nil {
+			started = append(started, method)
+		}
+	}
+	if len(started) == 0 {
+		return
+	}
+	waiterType := servName + "Waiter"
+
+	g.P("// ", waiterType, " waits for the long-running operations the methods of")
+	g.P("// ", servName, " start. Client must be set.")
+	g.P("type ", waiterType, " struct {")
+	g.P("// Client makes the calls.")
+	g.P("Client ", servName, "Client")
+	g.P("}")
+	g.P()
+
+	for _, method := range started {
+		o, _ := g.operation(service, method)
+		methName := g.MethodName(method)
+		pollName := g.MethodName(o.poll)
+		inType := g.TypeName(method.GetInputType())
+		resultType := "*" + g.TypeName(method.GetOutputType())
+		result, what := "op", "the operation"
+		if o.result != nil {
+			resultType, _ = g.gen.GoType(o.op, o.result)
+			g.gen.RecordTypeUse(o.result.GetTypeName())
+			result, what = "op."+o.op.GoGetterName(o.result)+"()", "its "+o.result.GetName()
+		}
+		name := "op." + o.op.GoGetterName(o.name) + "()"
+		if o.pollIn.File().GetSyntax() != "proto3" {
+			name = g.gen.Pkg["proto"] + ".String(" + name + ")"
+		}
+
+		g.P("// ", methName, "AndWait calls ", methName, " with in, then ", pollName, " every")
+		g.P("// pollInterval until the operation it started is done, and returns ", what, ".")
+		g.P("// It returns an *", g.lroPkg, ".Error if the operation failed, and the error of")
+		g.P("// ctx if ctx is done first; the operation may still be running then.")
+		g.P("func (w *", waiterType, ") ", methName, "AndWait(ctx ", g.contextPkg, ".Context, in *", inType, ", pollInterval ", g.timePkg, ".Duration, opts ...", g.clientPkg, ".CallOption) (", resultType, ", error) {")
+		g.P("op, err := w.Client.", methName, "(ctx, in, opts...)")
+		g.P("if err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("for !op.", o.op.GoGetterName(o.done), "() {")
+		g.P("if err := ", g.lroPkg, ".Sleep(ctx, pollInterval); err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("op, err = w.Client.", pollName, "(ctx, &", g.TypeName(o.poll.GetInputType()), "{", o.pollIn.GoFieldName(o.inName), ": ", name, "}, opts...)")
+		g.P("if err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("}")
+		if o.err != nil {
+			errMsg := "op." + o.op.GoGetterName(o.err) + "()"
+			fullMethName := strconv.Quote(fullServName + "/" + method.GetName())
+			if o.err.GetType() == pb.FieldDescriptorProto_TYPE_STRING {
+				g.P("if e := ", errMsg, `; e != "" {`)
+				g.P("return nil, &", g.lroPkg, ".Error{Method: ", fullMethName, ", Message: e}")
+			} else {
+				g.P("if e := ", errMsg, "; e != nil {")
+				g.P("return nil, &", g.lroPkg, ".Error{Method: ", fullMethName, ", Status: e}")
+			}
+			g.P("}")
+		}
+		g.P("return ", result, ", nil")
+		g.P("}")
+		g.P()
+	}
+}
+
+// generateQueueBindings generates the bindings of the service to a
+// queuerpc.Broker: New<Service>QueueClient, which returns a
+// <Service>Client making requests through the broker, New<Service>Publisher,
+// which returns a <Service>Publisher sending requests without waiting for
+// their responses, and Subscribe<Service>Server, which subscribes srv, the
+// server implementation as wrapped for carno, to the requests. The subject
+// of each method is its carno name, fullServName/Method.
+func (g *carno) generateQueueBindings(file *generator.FileDescriptor, path, servName, fullServName, srv, callInfoVar string, service *pb.ServiceDescriptorProto) {
+	clientType := plugingen.Var(servName, "queueClient")
+	pubType := plugingen.Var(servName, "publisher")
+	subject := func(method *pb.MethodDescriptorProto) string {
+		return strconv.Quote(fullServName + "/" + method.GetName())
+	}
+
+	g.P("// New", servName, "QueueClient returns a ", servName, "Client that sends each call")
+	g.P("// as a request to the method's subject with b, ", strconv.Quote(fullServName+"/<Method>"), ",")
+	g.P("// and waits for the reply. Call options are ignored.")
+	g.P("func New", servName, "QueueClient(b ", g.queuerpcPkg, ".Broker) ", servName, "Client {")
+	g.P("return &", clientType, "{b}")
+	g.P("}")
+	g.P()
+	g.P("type ", clientType, " struct {")
+	g.P("b ", g.queuerpcPkg, ".Broker")
+	g.P("}")
+	g.P()
+	for i, method := range service.Method {
+		g.P("func (c *", clientType, ") ", g.clientSignature(servName, method), " {")
+		if plugingen.Streaming(method) {
+			g.P("return nil, ", g.queuerpcPkg, ".ErrStreaming")
+			g.P("}")
+			g.P()
+			continue
+		}
+		if g.pool {
+			get, _ := pool.Names(g.gen, method.GetOutputType())
+			g.P("out := ", get, "()")
+		} else {
+			g.P("out := new(", g.TypeName(method.GetOutputType()), ")")
+		}
+		g.P("ctx = ", g.callinfoPkg, ".NewContext(ctx, ", callInfoVar, "[", i, "])")
+		g.P("err := ", g.queuerpcPkg, ".Call(ctx, c.b, ", subject(method), ", in, out)")
+		g.P("return out, err")
+		g.P("}")
+		g.P()
+	}
+
+	var pubMethods []plugingen.Method
+	for i, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		pubMethods = append(pubMethods, plugingen.Method{
+			Path: plugingen.MethodPath(path, i),
+			Sig:  fmt.Sprintf("%s(ctx %s.Context, in *%s) error", g.MethodName(method), g.contextPkg, g.TypeName(method.GetInputType())),
+		})
+	}
+	g.P("// ", servName, "Publisher sends requests to the methods of ", servName, " for")
+	g.P("// asynchronous processing: each method publishes its request to the")
+	g.P("// method's subject and returns without waiting for it to be handled.")
+	g.PrintInterface(file, servName+"Publisher", "", nil, pubMethods)
+	g.P("// New", servName, "Publisher returns a ", servName, "Publisher that publishes with b.")
+	g.P("func New", servName, "Publisher(b ", g.queuerpcPkg, ".Broker) ", servName, "Publisher {")
+	g.P("return ", pubType, "{b}")
+	g.P("}")
+	g.P()
+	g.P("type ", pubType, " struct {")
+	g.P("b ", g.queuerpcPkg, ".Broker")
+	g.P("}")
+	g.P()
+	for _, method := range service.Method {
+		if plugingen.Streaming(method) {
+			continue
+		}
+		g.P("func (p ", pubType, ") ", g.MethodName(method), "(ctx ", g.contextPkg, ".Context, in *", g.TypeName(method.GetInputType()), ") error {")
+		g.P("return ", g.queuerpcPkg, ".Publish(ctx, p.b, ", subject(method), ", in)")
+		g.P("}")
+		g.P()
+	}
+
+	g.P("// Subscribe", servName, "Server subscribes srv to the subject of each method of")
+	g.P("// ", servName, " with b, in the queue group ", strconv.Quote(fullServName), ", so that each request")
+	g.P("// is handled by one subscriber. Requests are checked as with")
+	g.P("// Register", servName, "Server; streaming methods are not served. Unsubscribing")
+	g.P("// the result stops the server.")
+	g.P("func Subscribe", servName, "Server(b ", g.queuerpcPkg, ".Broker, srv ", servName, "Server) (", g.queuerpcPkg, ".Subscription, error) {")
+	if srv != "srv" {
+		g.P("srv = ", srv)
+	}
+	g.P("return ", g.queuerpcPkg, ".Subscribe(b, ", strconv.Quote(fullServName), ", map[string]", g.queuerpcPkg, ".Method{")
+	g.printMethods(callInfoVar, service)
+	g.P("})")
+	g.P("}")
+	g.P()
+}
+
+func (g *carno) generateServerSetting(file *generator.FileDescriptor, path string) {
+	if file.GetPackage() == "" {
+		g.gen.Errorf(path, "carno: services need a package declaration, which names the server")
+	}
+}
+
+// pkgTypeName returns the name of the aggregate client type for a proto package.
+func pkgTypeName(pkg string) string {
+	return generator.CamelCase(strings.Replace(pkg, ".", "_", -1))
+}
+
+// generateServerPackage generates the aggregate client type of package
+// pkg and New<Pkg>. They do not depend on the package's services, each of
+// which adds a method to the aggregate in the file declaring it, so that
+// every run of protoc writes the same package file.
+func (g *carno) generateServerPackage(pkg string) {
+	camelCasePkgName := pkgTypeName(pkg)
+	g.P("// ", camelCasePkgName, " holds the client shared by the services of package ", pkg, ".")
+	g.P("// For each service Foo, its FooClient method returns a client using it.")
+	g.P("// It is safe for concurrent use by multiple goroutines.")
+	g.P("type ", camelCasePkgName, " struct{")
+	if g.lazyAggregate {
+		g.P("conn *", plugingen.Var(camelCasePkgName, "lazyConn"))
+	} else {
+		g.P("c ", g.clientPkg, ".Client")
+	}
+	g.P("}")
+	g.P("")
+
+	if g.lazyAggregate {
+		g.generateLazyPackage(pkg)
+		return
+	}
+
+	g.P("// New", camelCasePkgName, " creates and starts the client shared by the services of package ", pkg, ".")
+	g.P("func New", camelCasePkgName, "(opts ...", g.clientPkg, ".Option) (*", camelCasePkgName, ",error){")
+	g.P(`	c,err := `, g.carnoPkg, `.NewClient(`, strconv.Quote(pkg), `,opts...)`)
+	g.P("if err!=nil{")
+	g.P("return nil,err")
+	g.P("}")
+
+	g.P("if err:=c.Start();err!=nil{")
+	g.P("return nil,err")
+	g.P("}")
+
+	g.P("return &", camelCasePkgName, "{c: c},nil")
+	g.P("}")
+	g.P("")
+}
+
+// generateAggregateClient generates the method of the aggregate client
+// type of package pkg that returns a client of the service.
+func (g *carno) generateAggregateClient(pkg, servName string) {
+	aggType := pkgTypeName(pkg)
+	g.P("// ", servName, "Client returns a ", servName, "Client using the client shared by the services")
+	g.P("// of package ", pkg, ".")
+	g.P("func (a *", aggType, ") ", servName, "Client() ", servName, "Client {")
+	if g.lazyAggregate {
+		g.P("return &", plugingen.Var(servName, "lazyClient"), "{conn: a.conn}")
+	} else {
+		g.P("return &", plugingen.Unexport(servName), "Client{Client: a.c}")
+	}
+	g.P("}")
+	g.P()
+}
+
+// generateLazyPackage generates a New<Pkg> that returns immediately. The shared
+// client is created and started by the first call made through any of the
+// aggregated service clients, and each service client is wired on first use.
+func (g *carno) generateLazyPackage(pkg string) {
+	camelCasePkgName := pkgTypeName(pkg)
+	connType := plugingen.Var(camelCasePkgName, "lazyConn")
+
+	g.P("// ", connType, " creates and starts the client shared by ", camelCasePkgName, " on first use.")
+	g.P("// It is safe for concurrent use: one client is started at a time, and")
+	g.P("// one that fails to start is closed, so that the next call tries again.")
+	g.P("type ", connType, " struct {")
+	g.P("opts []", g.clientPkg, ".Option")
+	g.P("newClient func(pkg string, opts ...", g.clientPkg, ".Option) (", g.clientPkg, ".Client, error) // ", g.carnoPkg, ".NewClient, but for tests")
+	g.P("mu ", g.syncPkg, ".Mutex")
+	g.P("c ", g.clientPkg, ".Client")
+	g.P("}")
+	g.P()
+	g.P("func (l *", connType, ") get() (", g.clientPkg, ".Client, error) {")
+	g.P("l.mu.Lock()")
+	g.P("defer l.mu.Unlock()")
+	g.P("if l.c != nil {")
+	g.P("return l.c, nil")
+	g.P("}")
+	g.P("c, err := l.newClient(", strconv.Quote(pkg), ", l.opts...)")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("if err := c.Start(); err != nil {")
+	g.P("if closer, ok := c.(interface{ Close() error }); ok {")
+	g.P("closer.Close()")
+	g.P("}")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("l.c = c")
+	g.P("return c, nil")
+	g.P("}")
+	g.P()
+
+	g.P("// New", camelCasePkgName, " returns at once. The client shared by the services of package")
+	g.P("// ", pkg, " is created and started by the first call through any of them,")
+	g.P("// once even if calls are concurrent. If that fails, the call returns the")
+	g.P("// error and the next call tries again.")
+	g.P("func New", camelCasePkgName, "(opts ...", g.clientPkg, ".Option) (*", camelCasePkgName, ", error) {")
+	g.P("return &", camelCasePkgName, "{conn: &", connType, "{opts: opts, newClient: ", g.carnoPkg, ".NewClient}}, nil")
+	g.P("}")
+	g.P()
+}
+
+// generateLazyClient generates the service client used by a lazy New<Pkg>.
+// It wires the concrete client on the first method call.
+func (g *carno) generateLazyClient(pkg, servName string, service *pb.ServiceDescriptorProto) {
+	lazyType := plugingen.Var(servName, "lazyClient")
+	g.P("// ", lazyType, " wires a ", servName, "Client on its first call.")
+	g.P("// It is safe for concurrent use: the client is wired once, after the")
+	g.P("// shared client has started.")
+	g.P("type ", lazyType, " struct {")
+	g.P("conn *", plugingen.Var(pkgTypeName(pkg), "lazyConn"))
+	g.P("mu ", g.syncPkg, ".Mutex")
+	g.P("c ", servName, "Client")
+	g.P("}")
+	g.P()
+	g.P("func (l *", lazyType, ") get() (", servName, "Client, error) {")
+	g.P("l.mu.Lock()")
+	g.P("defer l.mu.Unlock()")
+	g.P("if l.c == nil {")
+	g.P("c, err := l.conn.get()")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("l.c = &", plugingen.Unexport(servName), "Client{Client: c}")
+	g.P("}")
+	g.P("return l.c, nil")
+	g.P("}")
+	g.P()
+	for _, method := range service.Method {
+		methName := g.MethodName(method)
+		args := "ctx, in, opts..."
+		if method.GetClientStreaming() {
+			args = "ctx, opts..."
+		}
+		g.P("func (l *", lazyType, ") ", g.clientSignature(servName, method), " {")
+		g.P("c, err := l.get()")
+		g.P("if err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("return c.", methName, "(", args, ")")
+		g.P("}")
+		g.P()
+	}
+}
+
+// generateAggregates generates an Apply method for each message in the file
+// with the (carno.events) option.
+func (g *carno) generateAggregates(file *generator.FileDescriptor) {
+	eachMessage(file, func(msg *pb.DescriptorProto, fullName, path string) {
+		if v := g.gen.MessageOption(msg, options.E_Events); v != nil && len(v.([]string)) > 0 {
+			g.generateApply(file, path, fullName, v.([]string))
+		}
+	})
+}
+
+// eachMessage calls f for each message declared in file, nested ones
+// after their parents, with its fully-qualified name and its path in the
+// file.
+func eachMessage(file *generator.FileDescriptor, f func(msg *pb.DescriptorProto, fullName, path string)) {
+	prefix := ""
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix = pkg + "."
+	}
+	var walk func(prefix, path string, msgs []*pb.DescriptorProto)
+	walk = func(prefix, path string, msgs []*pb.DescriptorProto) {
+		for i, msg := range msgs {
+			fullName := prefix + msg.GetName()
+			msgPath := fmt.Sprintf("%s%d", path, i)
+			f(msg, fullName, msgPath)
+			walk(fullName+".", msgPath+",3,", msg.NestedType) // 3 means nested message.
+		}
+	}
+	walk(prefix, "4,", file.MessageType) // 4 means message.
+}
+
+// generateApply generates the Apply method of the aggregate with the given
+// full name and source path, dispatching to a hand-written Apply<Event>
+// method per event.
+func (g *carno) generateApply(file *generator.FileDescriptor, path, fullName string, events []string) {
+	typeName := g.TypeName("." + fullName)
+	protoPkg := g.gen.Pkg["proto"]
+
+	g.P()
+	g.P("// Apply applies event to m by calling the method for its type, one of")
+	methods := make(map[string]string)
+	var cases [][2]string
+	for _, event := range events {
+		eventName := g.resolveEvent(file, event)
+		if eventName == "" {
+			g.gen.Errorf(path, "carno: %s: unknown event type %q; is its file imported?", fullName, event)
+			continue
+		}
+		if g.strict && eventName == "."+fullName {
+			g.gen.Errorf(path, "carno: %s: lists itself as an event", fullName)
+			continue
+		}
+		method := "Apply" + generator.CamelCase(eventName[strings.LastIndex(eventName, ".")+1:])
+		if other, ok := methods[method]; ok && other == eventName {
+			g.gen.Errorf(path, "carno: %s: event %s is listed twice", fullName, eventName[1:])
+			continue
+		} else if ok {
+			g.gen.Errorf(path, "carno: %s: events %s and %s both need a method named %s", fullName, other[1:], eventName[1:], method)
+			continue
+		}
+		methods[method] = eventName
+		cases = append(cases, [2]string{g.TypeName(eventName), method})
+		g.P("//\t", method, "(*", cases[len(cases)-1][0], ") error")
+	}
+	g.P("// which must be defined by hand. It fails for events of other types.")
+	g.P("func (m *", typeName, ") Apply(event ", protoPkg, ".Message) error {")
+	g.P("switch e := event.(type) {")
+	for _, c := range cases {
+		g.P("case *", c[0], ":")
+		g.P("return m.", c[1], "(e)")
+	}
+	g.P("}")
+	g.P("return ", g.gen.Pkg["fmt"], ".Errorf(", strconv.Quote(fullName+": unexpected event %T"), ", event)")
+	g.P("}")
+}
+
+// resolveEvent returns the fully-qualified name, with a leading dot, of the
+// event message named in a (carno.events) option of file, or "" if neither
+// file nor its imports define such a message.
+func (g *carno) resolveEvent(file *generator.FileDescriptor, name string) string {
+	candidates := []string{name}
+	if !strings.HasPrefix(name, ".") {
+		candidates = []string{"." + name}
+		if pkg := file.GetPackage(); pkg != "" {
+			candidates = []string{"." + pkg + "." + name, "." + name}
+		}
+	}
+	files := append([]string{file.GetName()}, file.Dependency...)
+	for _, c := range candidates {
+		for _, f := range files {
+			if g.messageNames()[f][c] != nil {
+				return c
+			}
+		}
+	}
+	return ""
+}
+
+// messageNames returns the messages in each file of the request, keyed by
+// file name and then by fully-qualified name, with a leading dot.
+func (g *carno) messageNames() map[string]map[string]*pb.DescriptorProto {
+	if g.messages != nil {
+		return g.messages
+	}
+	g.messages = make(map[string]map[string]*pb.DescriptorProto)
+	for _, f := range g.gen.Request.ProtoFile {
+		names := make(map[string]*pb.DescriptorProto)
+		var walk func(prefix string, msgs []*pb.DescriptorProto)
+		walk = func(prefix string, msgs []*pb.DescriptorProto) {
+			for _, msg := range msgs {
+				name := prefix + msg.GetName()
+				names[name] = msg
+				walk(name+".", msg.NestedType)
+			}
+		}
+		prefix := "."
+		if pkg := f.GetPackage(); pkg != "" {
+			prefix += pkg + "."
+		}
+		walk(prefix, f.MessageType)
+		g.messages[f.GetName()] = names
+	}
+	return g.messages
+}
+
+// eachField calls f for each field of each message in the request.
+func (g *carno) eachField(f func(field *pb.FieldDescriptorProto)) {
+	var walk func(msgs []*pb.DescriptorProto)
+	walk = func(msgs []*pb.DescriptorProto) {
+		for _, msg := range msgs {
+			for _, field := range msg.Field {
+				f(field)
+			}
+			walk(msg.NestedType)
+		}
+	}
+	for _, file := range g.gen.Request.ProtoFile {
+		walk(file.MessageType)
+	}
+}
+
+// markSensitive marks each field in the request with a (carno.sensitive)
+// option sensitive in its struct tag, for proto.Redact.
+func (g *carno) markSensitive() {
+	g.eachField(func(field *pb.FieldDescriptorProto) {
+		if v := g.gen.FieldOption(field, options.E_Sensitive); v != nil && *v.(*bool) {
+			g.gen.SetSensitive(field)
+		}
+	})
+}
+
+// addGoTags adds the tags of each (carno.go_tag) option in the request to
+// its field's struct tag. validateGoTags checks them.
+func (g *carno) addGoTags() {
+	g.eachField(func(field *pb.FieldDescriptorProto) {
+		if v := g.gen.FieldOption(field, options.E_GoTag); v != nil {
+			if tags := strings.TrimSpace(*v.(*string)); tags != "" {
+				g.gen.AddFieldTags(field, tags)
+			}
+		}
+	})
+}
+
+// overrideJSONNames sets the JSON name of each field in the request with a
+// (carno.json_name_override) option to the one the option gives. The
+// generator writes JSON names into struct tags and embedded descriptors
+// when it generates each message, after Init, so jsonpb and the tools that
+// read descriptors at run time both see the override.
+func (g *carno) overrideJSONNames() {
+	g.eachField(func(field *pb.FieldDescriptorProto) {
+		if v := g.gen.FieldOption(field, options.E_JsonNameOverride); v != nil {
+			name := *v.(*string)
+			field.JsonName = &name
+		}
+	})
+}
+
+// validateJSONNames reports (carno.json_name_override) options in file that
+// jsonpb cannot honor.
+func (g *carno) validateJSONNames(file *generator.FileDescriptor) {
+	prefix := ""
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix = pkg + "."
+	}
+	var walk func(prefix, path string, msgs []*pb.DescriptorProto)
+	walk = func(prefix, path string, msgs []*pb.DescriptorProto) {
+		for i, msg := range msgs {
+			fullName := prefix + msg.GetName()
+			msgPath := fmt.Sprintf("%s%d", path, i)
+			for j, field := range msg.Field {
+				if g.gen.FieldOption(field, options.E_JsonNameOverride) == nil {
+					continue
+				}
+				fieldPath := fmt.Sprintf("%s,2,%d", msgPath, j) // 2 means field.
+				name := field.GetJsonName()
+				switch {
+				case name == "":
+					g.gen.Errorf(fieldPath, "carno: %s.%s: empty (carno.json_name_override)", fullName, field.GetName())
+					continue
+				case strings.Contains(name, ","):
+					// The name goes in the field's struct tag, whose
+					// options are separated by commas.
+					g.gen.Errorf(fieldPath, "carno: %s.%s: (carno.json_name_override) %q contains a comma", fullName, field.GetName(), name)
+					continue
+				}
+				for _, other := range msg.Field {
+					if other != field && (other.GetName() == name || other.GetJsonName() == name) {
+						g.gen.Errorf(fieldPath, "carno: %s.%s: (carno.json_name_override) %q is also the name of field %s", fullName, field.GetName(), name, other.GetName())
+					}
+				}
+			}
+			walk(fullName+".", msgPath+",3,", msg.NestedType) // 3 means nested message.
+		}
+	}
+	walk(prefix, "4,", file.MessageType) // 4 means message.
+}
+
+// goTagKeys are the struct tag keys protoc-gen-go writes itself, which a
+// (carno.go_tag) option may not repeat.
+var goTagKeys = map[string]bool{
+	"protobuf":       true,
+	"protobuf_key":   true,
+	"protobuf_val":   true,
+	"protobuf_oneof": true,
+	"json":           true,
+}
+
+// validateGoTags reports (carno.go_tag) options in file that do not make
+// a well-formed struct tag.
+func (g *carno) validateGoTags(file *generator.FileDescriptor) {
+	prefix := ""
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix = pkg + "."
+	}
+	var walk func(prefix, path string, msgs []*pb.DescriptorProto)
+	walk = func(prefix, path string, msgs []*pb.DescriptorProto) {
+		for i, msg := range msgs {
+			fullName := prefix + msg.GetName()
+			msgPath := fmt.Sprintf("%s%d", path, i)
+			for j, field := range msg.Field {
+				v := g.gen.FieldOption(field, options.E_GoTag)
+				if v == nil {
+					continue
+				}
+				fieldPath := fmt.Sprintf("%s,2,%d", msgPath, j) // 2 means field.
+				if err := checkGoTag(*v.(*string)); err != nil {
+					g.gen.Errorf(fieldPath, "carno: %s.%s: (carno.go_tag) %v", fullName, field.GetName(), err)
+				}
+			}
+			walk(fullName+".", msgPath+",3,", msg.NestedType) // 3 means nested message.
+		}
+	}
+	walk(prefix, "4,", file.MessageType) // 4 means message.
+}
+
+// validateIdempotencyKeys reports (carno.idempotency_key) options on
+// fields that cannot hold a key, and messages with more than one.
+func (g *carno) validateIdempotencyKeys(file *generator.FileDescriptor) {
+	prefix := ""
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix = pkg + "."
+	}
+	var walk func(prefix, path string, msgs []*pb.DescriptorProto)
+	walk = func(prefix, path string, msgs []*pb.DescriptorProto) {
+		for i, msg := range msgs {
+			fullName := prefix + msg.GetName()
+			msgPath := fmt.Sprintf("%s%d", path, i)
+			var keys []string
+			for j, field := range msg.Field {
+				v := g.gen.FieldOption(field, options.E_IdempotencyKey)
+				if v == nil || !*v.(*bool) {
+					continue
+				}
+				fieldPath := fmt.Sprintf("%s,2,%d", msgPath, j) // 2 means field.
+				switch {
+				case field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED:
+					g.gen.Errorf(fieldPath, "carno: %s.%s: (carno.idempotency_key) field must not be repeated", fullName, field.GetName())
+				case field.GetType() != pb.FieldDescriptorProto_TYPE_STRING && field.GetType() != pb.FieldDescriptorProto_TYPE_BYTES:
+					g.gen.Errorf(fieldPath, "carno: %s.%s: (carno.idempotency_key) field must be a string or bytes", fullName, field.GetName())
+				}
+				keys = append(keys, field.GetName())
+				if len(keys) == 2 {
+					g.gen.Errorf(fieldPath, "carno: %s: (carno.idempotency_key) is set on both %s and %s", fullName, keys[0], keys[1])
+				}
+			}
+			walk(fullName+".", msgPath+",3,", msg.NestedType) // 3 means nested message.
+		}
+	}
+	walk(prefix, "4,", file.MessageType) // 4 means message.
+}
+
+// checkGoTag checks that tag is a sequence of key:"value" pairs, as
+// reflect.StructTag expects, with keys that are neither repeated nor among
+// goTagKeys, and that it can go in the raw string literal of a struct tag.
+func checkGoTag(tag string) error {
+	if strings.ContainsAny(tag, "`\n") {
+		return fmt.Errorf("%q contains a backquote or newline", tag)
+	}
+	seen := make(map[string]bool)
+	for rest := strings.TrimLeft(tag, " "); rest != ""; rest = strings.TrimLeft(rest, " ") {
+		i := 0
+		for i < len(rest) && rest[i] > ' ' && rest[i] != ':' && rest[i] != '"' && rest[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(rest) || rest[i] != ':' || rest[i+1] != '"' {
+			return fmt.Errorf("%q is not of the form key:\"value\"", tag)
+		}
+		key := rest[:i]
+		rest = rest[i+1:]
+		i = 1
+		for i < len(rest) && rest[i] != '"' {
+			if rest[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(rest) {
+			return fmt.Errorf("%q has an unterminated value", tag)
+		}
+		if _, err := strconv.Unquote(rest[:i+1]); err != nil {
+			return fmt.Errorf("%q has a badly quoted value for %s", tag, key)
+		}
+		rest = rest[i+1:]
+		switch {
+		case goTagKeys[key]:
+			return fmt.Errorf("%q sets %s, which protoc-gen-go writes itself", tag, key)
+		case seen[key]:
+			return fmt.Errorf("%q sets %s more than once", tag, key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// validateMethodOptions reports problems with the carno options of the
+// methods in file. Problems that would produce broken code are always
+// reported; the rest only in strict mode.
+func (g *carno) validateMethodOptions(file *generator.FileDescriptor) {
+	for i, service := range file.Service {
+		groups := make(map[string]string) // as spelled, by interface name
+		for j, method := range service.Method {
+			path := plugingen.MethodPath(plugingen.ServicePath(i), j)
+			name := service.GetName() + "." + method.GetName()
+			if pkg := file.GetPackage(); pkg != "" {
+				name = pkg + "." + name
+			}
+
+			if v := g.gen.MethodOption(method, options.E_Group); v != nil {
+				group := *v.(*string)
+				cc := generator.CamelCase(group)
+				switch {
+				case group == "":
+					if g.strict {
+						g.gen.Errorf(path, "carno: %s: empty (carno.group)", name)
+					}
+				case !isIdent(cc):
+					g.gen.Errorf(path, "carno: %s: (carno.group) %q does not make a Go identifier", name, group)
+				case g.strict && groups[cc] != "" && groups[cc] != group:
+					g.gen.Errorf(path, "carno: %s: (carno.group) %q is the same group as %q; spell it one way", name, group, groups[cc])
+				default:
+					groups[cc] = group
+				}
+			}
+
+			// The limits become ints in the generated code, and no message
+			// encodes to 2 GB anyway.
+			if l := g.requestLimits(method); l.Bytes > math.MaxInt32 || l.Fields > math.MaxInt32 {
+				g.gen.Errorf(path, "carno: %s: request limits must be under 2^31", name)
+			}
+			if r := g.rateLimit(method); r != nil {
+				// The negated test also catches NaN.
+				if !(r.GetRps() > 0) || math.IsInf(r.GetRps(), 1) {
+					g.gen.Errorf(path, "carno: %s: (carno.rate_limit) rps must be positive and finite", name)
+				}
+				if r.GetBurst() > math.MaxInt32 {
+					g.gen.Errorf(path, "carno: %s: (carno.rate_limit) burst must be under 2^31", name)
+				}
+			}
+			if v := g.gen.MethodOption(method, options.E_DefaultTimeout); v != nil {
+				if d, err := time.ParseDuration(*v.(*string)); err != nil {
+					g.gen.Errorf(path, "carno: %s: (carno.default_timeout): %v", name, err)
+				} else if d <= 0 {
+					g.gen.Errorf(path, "carno: %s: (carno.default_timeout) %q must be positive", name, *v.(*string))
+				}
+			}
+
+			if _, err := g.pages(method); err != nil {
+				g.gen.Errorf(path, "carno: %s: (carno.pagination): %v", name, err)
+			}
+			if _, err := g.operation(service, method); err != nil {
+				g.gen.Errorf(path, "carno: %s: (carno.long_running): %v", name, err)
+			}
+			if g.oneway(method) {
+				// The client does not wait for the response, so there must
+				// be nothing in it, and only one request.
+				if plugingen.Streaming(method) {
+					g.gen.Errorf(path, "carno: %s: (carno.oneway) methods must not stream", name)
+				}
+				if method.GetOutputType() != ".google.protobuf.Empty" {
+					g.gen.Errorf(path, "carno: %s: (carno.oneway) methods must return google.protobuf.Empty", name)
+				}
+			}
+			if plugingen.Streaming(method) {
+				// The server wrappers enforcing these skip streaming
+				// methods, so the options would be dropped, and a missing
+				// role check would fail open.
+				if len(g.requiredRoles(method)) > 0 {
+					g.gen.Errorf(path, "carno: %s: (carno.require_roles) is not enforced on streaming methods", name)
+				}
+				if g.rateLimit(method) != nil {
+					g.gen.Errorf(path, "carno: %s: (carno.rate_limit) is not enforced on streaming methods", name)
+				}
+				if g.gen.MethodOption(method, options.E_DefaultTimeout) != nil {
+					g.gen.Errorf(path, "carno: %s: (carno.default_timeout) is not enforced on streaming methods", name)
+				}
+				if _, key := g.idempotencyKey(method); key != nil {
+					g.gen.Errorf(path, "carno: %s: (carno.idempotency_key) of its request is not enforced on streaming methods", name)
+				}
+			}
+
+			if !g.strict {
+				continue
+			}
+			seen := make(map[string]bool)
+			for _, role := range g.requiredRoles(method) {
+				switch {
+				case strings.TrimSpace(role) == "":
+					g.gen.Errorf(path, "carno: %s: empty role in (carno.require_roles)", name)
+				case role != strings.TrimSpace(role):
+					g.gen.Errorf(path, "carno: %s: role %q in (carno.require_roles) has surrounding space", name, role)
+				case seen[role]:
+					g.gen.Errorf(path, "carno: %s: role %q is listed twice in (carno.require_roles)", name, role)
+				}
+				seen[role] = true
+			}
+		}
+	}
+}
+
+// isIdent reports whether s is a Go identifier.
+func isIdent(s string) bool {
+	for i, r := range s {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return s != ""
 }
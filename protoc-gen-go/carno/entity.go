@@ -0,0 +1,181 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carno
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// generateEntities generates the database helpers of the messages in file
+// with the (carno.entity) option, and Value and Scan methods for the
+// enums declared in file that their columns hold.
+func (g *carno) generateEntities(file *generator.FileDescriptor) {
+	var enums []*generator.EnumDescriptor
+	seen := make(map[*generator.EnumDescriptor]bool)
+	eachMessage(file, func(msg *pb.DescriptorProto, fullName, path string) {
+		v := g.gen.MessageOption(msg, options.E_Entity)
+		if v == nil {
+			return
+		}
+		for _, e := range g.generateEntity(file, path, fullName, v.(*options.Entity)) {
+			if !seen[e] {
+				seen[e] = true
+				enums = append(enums, e)
+			}
+		}
+	})
+	for _, e := range enums {
+		g.generateEnumValuer(e)
+	}
+}
+
+// generateEntity generates the table name, primary key and columns of
+// the message with the given fully-qualified name, and its ScanRow and
+// RowValues methods. It returns the enums declared in file that its
+// columns hold.
+func (g *carno) generateEntity(file *generator.FileDescriptor, path, fullName string, entity *options.Entity) []*generator.EnumDescriptor {
+	msg, ok := g.gen.ObjectNamed("." + fullName).(*generator.Descriptor)
+	if !ok {
+		return nil
+	}
+	if entity.GetTable() == "" {
+		g.gen.Errorf(path, "carno: %s: empty table in (carno.entity)", fullName)
+		return nil
+	}
+	var (
+		columns, scan, values []string
+		enums                 []*generator.EnumDescriptor
+		hasPK                 bool
+	)
+	sqlpbPkg := g.gen.AddImport(sqlpbPkgPath)
+	for _, field := range msg.Field {
+		name := msg.GoFieldName(field)
+		if name == "ScanRow" || name == "RowValues" {
+			g.gen.Errorf(path, "carno: %s: field %s has the name of the generated method %s", fullName, field.GetName(), name)
+			return nil
+		}
+		if !g.isColumn(field) {
+			continue
+		}
+		columns = append(columns, strconv.Quote(field.GetName()))
+		hasPK = hasPK || field.GetName() == entity.GetPk()
+		v := "m." + name
+		switch {
+		case field.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE:
+			v = sqlpbPkg + ".Timestamp(&m." + name + ")"
+			scan = append(scan, v)
+		case field.GetType() == pb.FieldDescriptorProto_TYPE_ENUM:
+			e, ok := g.gen.ObjectNamed(field.GetTypeName()).(*generator.EnumDescriptor)
+			if ok && e.File() == file.FileDescriptorProto {
+				enums = append(enums, e)
+				scan = append(scan, "&m."+name)
+				break
+			}
+			v = sqlpbPkg + ".Enum(&m." + name + ", " + g.TypeName(field.GetTypeName()) + "_value)"
+			scan = append(scan, v)
+		default:
+			scan = append(scan, "&m."+name)
+		}
+		values = append(values, v)
+	}
+	if !hasPK {
+		g.gen.Errorf(path, "carno: %s: (carno.entity) pk %q is not one of its columns", fullName, entity.GetPk())
+		return nil
+	}
+
+	typeName := g.TypeName("." + fullName)
+	g.P()
+	g.P("// ", typeName, "Table is the database table of ", typeName, ", from its (carno.entity) option.")
+	g.P("const ", typeName, "Table = ", strconv.Quote(entity.GetTable()))
+	g.P()
+	g.P("// ", typeName, "PrimaryKey is the column of ", typeName, "Table holding the primary key.")
+	g.P("const ", typeName, "PrimaryKey = ", strconv.Quote(entity.GetPk()))
+	g.P()
+	g.P("// ", typeName, "Columns are the columns of ", typeName, "Table, in the order of the")
+	g.P("// fields ScanRow sets and RowValues returns.")
+	g.P("var ", typeName, "Columns = []string{", strings.Join(columns, ", "), "}")
+	g.P()
+	g.P("// ScanRow sets the fields of m stored in ", typeName, "Table from row, whose")
+	g.P("// columns must be ", typeName, "Columns.")
+	g.P("func (m *", typeName, ") ScanRow(row ", sqlpbPkg, ".Row) error {")
+	g.P("return row.Scan(", strings.Join(scan, ", "), ")")
+	g.P("}")
+	g.P()
+	g.P("// RowValues returns the values of the fields of m stored in ", typeName, "Table,")
+	g.P("// in the order of ", typeName, "Columns.")
+	g.P("func (m *", typeName, ") RowValues() []interface{} {")
+	g.P("return []interface{}{", strings.Join(values, ", "), "}")
+	g.P("}")
+	return enums
+}
+
+// isColumn reports whether field is stored in a column of the table of
+// an entity: it is a singular scalar, enum or google.protobuf.Timestamp
+// field outside any oneof, and not lazy.
+func (g *carno) isColumn(field *pb.FieldDescriptorProto) bool {
+	if field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED || field.OneofIndex != nil || field.GetOptions().GetLazy() {
+		return false
+	}
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_GROUP:
+		return false
+	case pb.FieldDescriptorProto_TYPE_MESSAGE:
+		return field.GetTypeName() == ".google.protobuf.Timestamp"
+	}
+	return true
+}
+
+// generateEnumValuer generates the Value and Scan methods of an enum,
+// which store it in a database column as its number.
+func (g *carno) generateEnumValuer(e *generator.EnumDescriptor) {
+	typeName := generator.CamelCaseSlice(e.TypeName())
+	g.P()
+	g.P("// Value returns the number of x, as it is stored in a database.")
+	g.P("func (x ", typeName, ") Value() (", g.gen.AddImport("database/sql/driver"), ".Value, error) {")
+	g.P("return int64(x), nil")
+	g.P("}")
+	g.P()
+	g.P("// Scan sets x from a database column holding its number or name.")
+	g.P("func (x *", typeName, ") Scan(src interface{}) error {")
+	g.P("n, err := ", g.gen.AddImport(sqlpbPkgPath), ".EnumNumber(src, ", typeName, "_value)")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("*x = ", typeName, "(n)")
+	g.P("return nil")
+	g.P("}")
+}
@@ -0,0 +1,117 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carno
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/ccsnake/protobuf/protoparse"
+)
+
+// entityErrorTests are .proto files with invalid (carno.entity) options,
+// and the errors the plugin reports for them.
+var entityErrorTests = []struct {
+	body, err string
+}{
+	{
+		`message User { option (carno.entity) = { pk: "id" }; string id = 1; }`,
+		"entity.proto:3:1: carno: entity.User: empty table in (carno.entity)",
+	},
+	{
+		`message User { option (carno.entity) = { table: "users" }; string id = 1; }`,
+		`entity.proto:3:1: carno: entity.User: (carno.entity) pk "" is not one of its columns`,
+	},
+	{
+		`message User { option (carno.entity) = { table: "users" pk: "tags" }; repeated string tags = 1; }`,
+		`entity.proto:3:1: carno: entity.User: (carno.entity) pk "tags" is not one of its columns`,
+	},
+	{
+		`message User { option (carno.entity) = { table: "users" pk: "id" }; string id = 1; string scan_row = 2; }`,
+		"entity.proto:3:1: carno: entity.User: field scan_row has the name of the generated method ScanRow",
+	},
+}
+
+func TestEntityErrors(t *testing.T) {
+	dir := optionsDir(t)
+	defer os.RemoveAll(dir)
+	for _, test := range entityErrorTests {
+		src := "syntax = \"proto3\";\npackage entity;\n" + test.body + "\nimport \"carno/options.proto\";\n"
+		if got := generateError(t, dir, "entity.proto", src); !strings.Contains(got, test.err) {
+			t.Errorf("%s: got error %q, want %s", test.body, got, test.err)
+		}
+	}
+}
+
+// optionsDir returns a new temporary directory holding
+// carno/options.proto, in which .proto files using the options can be
+// compiled.
+func optionsDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "carno-options")
+	if err != nil {
+		t.Fatal(err)
+	}
+	options, err := ioutil.ReadFile(filepath.Join("options", "options.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "carno"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "carno", "options.proto"), options, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// generateError writes src to the named file in dir, generates code for
+// it with the carno plugin, and returns the error in the response.
+func generateError(t *testing.T, dir, name, src string) string {
+	if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p := &protoparse.Parser{ImportPaths: []string{dir}}
+	req, err := p.NewRequest("plugins=carno", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := generator.NewWithPlugins(New)
+	g.Request = req
+	if err := g.Run(); err != nil {
+		t.Fatal(err)
+	}
+	return g.Response.GetError()
+}
@@ -0,0 +1,90 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carno
+
+import (
+	"strconv"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// generateEvents generates the topic, name and version constants and the
+// New<Message>Envelope function of each message in file with the
+// (carno.event) option.
+func (g *carno) generateEvents(file *generator.FileDescriptor) {
+	eachMessage(file, func(msg *pb.DescriptorProto, fullName, path string) {
+		if v := g.gen.MessageOption(msg, options.E_Event); v != nil {
+			g.generateEvent(path, fullName, v.(*options.Event))
+		}
+	})
+}
+
+// generateEvent generates the code of the event message with the given
+// fully-qualified name.
+func (g *carno) generateEvent(path, fullName string, event *options.Event) {
+	if event.GetTopic() == "" {
+		g.gen.Errorf(path, "carno: %s: empty topic in (carno.event)", fullName)
+		return
+	}
+	version := uint32(1)
+	if event.Version != nil {
+		version = event.GetVersion()
+	}
+	if version == 0 {
+		g.gen.Errorf(path, "carno: %s: (carno.event) version must be positive", fullName)
+		return
+	}
+	name := event.GetName()
+	if name == "" {
+		name = fullName
+	}
+
+	typeName := g.TypeName("." + fullName)
+	g.P()
+	g.P("// ", typeName, "Topic is the topic ", typeName, " events are published to, from its (carno.event) option.")
+	g.P("const ", typeName, "Topic = ", strconv.Quote(event.GetTopic()))
+	g.P()
+	g.P("// ", typeName, "EventName is the name of ", typeName, " events in their envelopes.")
+	g.P("const ", typeName, "EventName = ", strconv.Quote(name))
+	g.P()
+	g.P("// ", typeName, "EventVersion is the version of the schema of ", typeName, " events.")
+	g.P("const ", typeName, "EventVersion = ", strconv.FormatUint(uint64(version), 10))
+	g.P()
+	eventpbPkg := g.gen.AddImport(eventpbPkgPath)
+	g.P("// New", typeName, "Envelope returns an envelope holding e, which occurred at")
+	g.P("// occurredAt, to publish to ", typeName, "Topic.")
+	g.P("func New", typeName, "Envelope(e *", typeName, ", occurredAt ", g.gen.AddImport("time"), ".Time) (*", eventpbPkg, ".Envelope, error) {")
+	g.P("return ", eventpbPkg, ".NewEnvelope(", typeName, "EventName, ", typeName, "EventVersion, occurredAt, e)")
+	g.P("}")
+}
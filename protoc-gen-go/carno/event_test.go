@@ -0,0 +1,64 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carno
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// eventErrorTests are .proto files with invalid (carno.event) options,
+// and the errors the plugin reports for them.
+var eventErrorTests = []struct {
+	body, err string
+}{
+	{
+		`message Created { option (carno.event) = { name: "created" }; string id = 1; }`,
+		"event.proto:3:1: carno: event.Created: empty topic in (carno.event)",
+	},
+	{
+		`message Created { option (carno.event) = { topic: "t" version: 0 }; string id = 1; }`,
+		"event.proto:3:1: carno: event.Created: (carno.event) version must be positive",
+	},
+}
+
+func TestEventErrors(t *testing.T) {
+	dir := optionsDir(t)
+	defer os.RemoveAll(dir)
+	for _, test := range eventErrorTests {
+		src := "syntax = \"proto3\";\npackage event;\n" + test.body + "\nimport \"carno/options.proto\";\n"
+		if got := generateError(t, dir, "event.proto", src); !strings.Contains(got, test.err) {
+			t.Errorf("%s: got error %q, want %s", test.body, got, test.err)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carno
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/ccsnake/protobuf/protoc-gen-go/plugingen"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+// generateExamples adds an example test file for each service in file to
+// the response, next to the file's generated code. The examples show how
+// to register a server, create a client and call each method with a
+// fixture request. They have no output comments, so go test compiles them
+// but does not run them: they need a running carno service.
+func (g *carno) generateExamples(file *generator.FileDescriptor) {
+	if !g.generating(file) {
+		return
+	}
+	base := strings.TrimSuffix(g.gen.GoOutputName(file), ".pb.go")
+	for _, service := range file.Service {
+		servName := generator.CamelCase(service.GetName())
+		name := base + "_" + strings.ToLower(servName) + "_example_test.go"
+		src, err := format.Source(g.example(file, service))
+		if err != nil {
+			g.gen.Error(err, "formatting examples for service", service.GetName())
+		}
+		g.gen.Response.File = append(g.gen.Response.File, &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(name),
+			Content: proto.String(string(src)),
+		})
+	}
+}
+
+// example returns the source of the example test file for service.
+// It is in the package of the generated code, so it names the
+// generated identifiers the way the documentation shows them.
+func (g *carno) example(file *generator.FileDescriptor, service *pb.ServiceDescriptorProto) []byte {
+	servName := generator.CamelCase(service.GetName())
+	imports := map[string]string{
+		"context": "context",
+		"fmt":     "fmt",
+		"log":     "log",
+	}
+	var body bytes.Buffer
+	p := func(args ...interface{}) {
+		fmt.Fprint(&body, args...)
+		body.WriteByte('\n')
+	}
+	typeName := func(name string) string {
+		obj := g.gen.ObjectNamed(name)
+		if pkg := strings.TrimSuffix(g.gen.DefaultPackageName(obj), "."); pkg != "" {
+			imports[pkg] = g.gen.GoImportPath(g.gen.FileOf(obj.File()))
+		}
+		return g.TypeName(name)
+	}
+
+	serverType := "example" + servName + "Server"
+	p("// ", serverType, " implements ", servName, "Server, answering every call")
+	p("// with an empty response.")
+	p("type ", serverType, " struct{}")
+	p()
+	for _, method := range service.Method {
+		p("func (", serverType, ") ", g.MethodName(method), "(ctx context.Context, in *",
+			typeName(method.GetInputType()), ") (*", typeName(method.GetOutputType()), ", error) {")
+		p("return &", typeName(method.GetOutputType()), "{}, nil")
+		p("}")
+		p()
+	}
+
+	p("func ExampleRegister", servName, "Server() {")
+	p("Register", servName, "Server(", serverType, "{})")
+	p("}")
+	p()
+
+	newClient := func() {
+		p("c, err := New", servName, "Client()")
+		p("if err != nil {")
+		p("log.Fatal(err)")
+		p("}")
+	}
+	p("func ExampleNew", servName, "Client() {")
+	newClient()
+	p("_ = c")
+	p("}")
+	p()
+
+	for _, method := range service.Method {
+		if plugingen.Streaming(method) {
+			// carno has no client for streaming methods.
+			continue
+		}
+		methName := g.MethodName(method)
+		p("func Example", servName, "Client_", methName, "() {")
+		newClient()
+		p("out, err := c.", methName, "(context.Background(), &", typeName(method.GetInputType()), "{")
+		for _, f := range g.fixture(method.GetInputType(), imports) {
+			p(f, ",")
+		}
+		p("})")
+		p("if err != nil {")
+		p("log.Fatal(err)")
+		p("}")
+		p("fmt.Println(out)")
+		p("}")
+		p()
+	}
+
+	// Standard library imports first, as goimports groups them.
+	var std, names []string
+	for name, path := range imports {
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			names = append(names, name)
+		} else {
+			std = append(std, name)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(names)
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by protoc-gen-go. DO NOT EDIT.")
+	fmt.Fprintln(&buf, "// source:", file.GetName())
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package", file.PackageName())
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "import (")
+	for _, name := range std {
+		fmt.Fprintf(&buf, "%q\n", imports[name])
+	}
+	if len(names) > 0 {
+		fmt.Fprintln(&buf)
+	}
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s %q\n", name, imports[name])
+	}
+	fmt.Fprintln(&buf, ")")
+	fmt.Fprintln(&buf)
+	buf.Write(body.Bytes())
+	return buf.Bytes()
+}
+
+// fixture returns the field initializers for a sample value of the named
+// message: each singular scalar field is set to a value derived from its
+// name; fields in oneofs are left unset. The proto package is added to
+// imports if the initializers use it.
+func (g *carno) fixture(name string, imports map[string]string) []string {
+	msg, ok := g.gen.ObjectNamed(name).(*generator.Descriptor)
+	if !ok {
+		return nil
+	}
+	proto3 := msg.File().GetSyntax() == "proto3"
+	var inits []string
+	for _, field := range msg.Field {
+		if field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED || field.OneofIndex != nil {
+			continue
+		}
+		value, helper := fixtureValue(field)
+		if value == "" {
+			continue
+		}
+		if !proto3 && helper != "" {
+			imports["proto"] = "github.com/golang/protobuf/proto"
+			value = "proto." + helper + "(" + value + ")"
+		}
+		inits = append(inits, msg.GoFieldName(field)+": "+value)
+	}
+	return inits
+}
+
+// fixtureValue returns the sample value of a scalar field, and the proto
+// helper, such as String, that makes a pointer to it for proto2.
+// It returns "" for other fields.
+func fixtureValue(field *pb.FieldDescriptorProto) (value, helper string) {
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return strconv.Quote(field.GetName()), "String"
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		return "[]byte(" + strconv.Quote(field.GetName()) + ")", ""
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return "true", "Bool"
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SFIXED32:
+		return "1", "Int32"
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		return "1", "Int64"
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32:
+		return "1", "Uint32"
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return "1", "Uint64"
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		return "1", "Float32"
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return "1", "Float64"
+	}
+	return "", ""
+}
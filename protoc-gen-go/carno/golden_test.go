@@ -0,0 +1,206 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carno
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files with the generated code")
+
+// goldenTests are the cases of TestGolden. Each directory under testdata
+// has the .proto files of a case, and descriptor_set.pb, the descriptors
+// of the files and their imports, with source info, as written by
+//
+//	protoc --include_imports --include_source_info --descriptor_set_out=...
+//
+// (see testdata/Makefile). The generated files are compared with the
+// golden files next to them, named after them with .golden added, or, if
+// generation fails, its error with error.golden.
+var goldenTests = []struct {
+	dir   string
+	param string
+	files []string // to generate, in the order protoc would pass them
+}{
+	{"streaming", "plugins=carno,carno:manifest=true", []string{"streaming/streaming.proto"}},
+	{"multiservice", "plugins=carno", []string{"multiservice/multiservice.proto"}},
+	{"multifile", "plugins=carno", []string{"multifile/types.proto", "multifile/service.proto"}},
+	{"annotated", "plugins=carno,carno:manifest=true", []string{"annotated/annotated.proto"}},
+	{
+		"params",
+		"plugins=carno,carno:grpc=true,carno:http=true,carno:queue=true,carno:log=true," +
+			"carno:hedge=true,carno:testserver=true,carno:lazy_aggregate=true,carno:examples=true",
+		[]string{"params/params.proto"},
+	},
+	{"invalid", "plugins=carno,carno:strict=true", []string{"invalid/invalid.proto"}},
+	{"report", "plugins=carno,carno:report=report/cleanup.txt", []string{"report/report.proto"}},
+}
+
+// generate runs the generator on the files of the case in dir.
+func generate(t *testing.T, dir, param string, files []string) *plugin.CodeGeneratorResponse {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", dir, "descriptor_set.pb"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := new(pb.FileDescriptorSet)
+	if err := proto.Unmarshal(data, set); err != nil {
+		t.Fatal(err)
+	}
+	g := generator.NewWithPlugins(New)
+	g.Request = &plugin.CodeGeneratorRequest{
+		FileToGenerate: files,
+		Parameter:      proto.String(param),
+		ProtoFile:      set.File,
+	}
+	if err := g.Run(); err != nil {
+		t.Fatal(err)
+	}
+	return g.Response
+}
+
+// fileDescriptorRE matches the compressed descriptors in generated code,
+// which depend on the version of compress/gzip as well as the input.
+var fileDescriptorRE = regexp.MustCompile(`(?m)^(var fileDescriptor\w* = \[\]byte\{)\n(?:.*\n)*?\}\n`)
+
+// stripDescriptors replaces the compressed descriptors in content with a
+// comment.
+func stripDescriptors(content string) string {
+	return fileDescriptorRE.ReplaceAllString(content, "$1\n\t// elided\n}\n")
+}
+
+func TestGolden(t *testing.T) {
+	for _, test := range goldenTests {
+		resp := generate(t, test.dir, test.param, test.files)
+		got := make(map[string]string) // golden file name to content
+		if resp.Error != nil {
+			got[filepath.Join(test.dir, "error.golden")] = resp.GetError() + "\n"
+		}
+		for _, f := range resp.File {
+			got[f.GetName()+".golden"] = stripDescriptors(f.GetContent())
+		}
+
+		goldens, err := filepath.Glob(filepath.Join("testdata", test.dir, "*.golden"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, path := range goldens {
+			name, _ := filepath.Rel("testdata", path)
+			if _, ok := got[filepath.ToSlash(name)]; ok {
+				continue
+			}
+			if *update {
+				if err := os.Remove(path); err != nil {
+					t.Error(err)
+				}
+				continue
+			}
+			t.Errorf("%s: %s was not generated", test.dir, name)
+		}
+		for name, content := range got {
+			path := filepath.Join("testdata", filepath.FromSlash(name))
+			if *update {
+				if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Error(err)
+				}
+				continue
+			}
+			want, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Errorf("%s: %v; run go test -update to create it", test.dir, err)
+				continue
+			}
+			if content != string(want) {
+				t.Errorf("%s: generated code differs from %s; run go test -update and check the diff\n%s",
+					test.dir, name, firstDiff(string(want), content))
+			}
+		}
+	}
+}
+
+// Runs of protoc on any of the files of a package with services write
+// the same package file.
+func TestPackageFileIncremental(t *testing.T) {
+	const name = "multifile/multifile_carno_package.pb.go"
+	var want string
+	for _, files := range [][]string{
+		{"multifile/types.proto", "multifile/service.proto"},
+		{"multifile/service.proto", "multifile/types.proto"},
+		{"multifile/service.proto"},
+	} {
+		resp := generate(t, "multifile", "plugins=carno", files)
+		var got string
+		for _, f := range resp.File {
+			if f.GetName() == name {
+				got = f.GetContent()
+			}
+		}
+		if got == "" {
+			t.Errorf("generating %v wrote no %s", files, name)
+			continue
+		}
+		if want == "" {
+			want = got
+		} else if got != want {
+			t.Errorf("generating %v wrote a different %s:\n%s", files, name, firstDiff(want, got))
+		}
+	}
+}
+
+// firstDiff describes the first line where got differs from want.
+func firstDiff(want, got string) string {
+	wl, gl := strings.Split(want, "\n"), strings.Split(got, "\n")
+	for i := 0; i < len(wl) || i < len(gl); i++ {
+		var w, g string
+		if i < len(wl) {
+			w = wl[i]
+		}
+		if i < len(gl) {
+			g = gl[i]
+		}
+		if w != g {
+			return "line " + strconv.Itoa(i+1) + ":\n\twant: " + w + "\n\tgot:  " + g
+		}
+	}
+	return ""
+}
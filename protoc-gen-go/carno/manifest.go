@@ -0,0 +1,146 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carno
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+// manifest is the service manifest of a .proto file, which describes its
+// services for clients and gateways that do not use the generated Go code.
+// Services and methods have the names and kinds Protobuf-ES gives them,
+// with their carno names beside them.
+type manifest struct {
+	File     string            `json:"file"`              // "demo/users.proto"
+	Package  string            `json:"package,omitempty"` // "demo.users"
+	Services []manifestService `json:"services"`
+}
+
+type manifestService struct {
+	TypeName  string           `json:"typeName"`  // "demo.users.UserService"
+	CarnoName string           `json:"carnoName"` // "demo.users@UserService"
+	Options   json.RawMessage  `json:"options,omitempty"`
+	Methods   []manifestMethod `json:"methods"`
+}
+
+type manifestMethod struct {
+	Name       string          `json:"name"`       // "GetUser"
+	LocalName  string          `json:"localName"`  // "getUser"
+	FullMethod string          `json:"fullMethod"` // "demo.users@UserService/GetUser", as callinfo.CallInfo.FullMethod
+	Kind       string          `json:"kind"`       // "unary", "server_streaming", "client_streaming" or "bidi_streaming"
+	Input      string          `json:"input"`      // "demo.users.GetUserRequest"
+	Output     string          `json:"output"`     // "demo.users.User"
+	Options    json.RawMessage `json:"options,omitempty"`
+}
+
+// generateManifest adds the service manifest of file to the response, as
+// JSON next to the file's generated code, named after it with .carno.json
+// in place of .pb.go. The options of the services and methods are in the
+// JSON form of ServiceOptions and MethodOptions, the carno ones under
+// names such as "[carno.default_timeout]"; options of extensions not
+// linked into protoc-gen-go are left out.
+func (g *carno) generateManifest(file *generator.FileDescriptor) {
+	if !g.generating(file) {
+		return
+	}
+	m := manifest{File: file.GetName(), Package: file.GetPackage()}
+	for _, service := range file.Service {
+		s := manifestService{
+			TypeName:  service.GetName(),
+			CarnoName: service.GetName(),
+		}
+		if service.Options != nil {
+			s.Options = g.manifestOptions(service.Options)
+		}
+		if pkg := file.GetPackage(); pkg != "" {
+			s.TypeName = pkg + "." + s.TypeName
+			s.CarnoName = pkg + "@" + s.CarnoName
+		}
+		for _, method := range service.Method {
+			name := method.GetName()
+			meth := manifestMethod{
+				Name:       name,
+				LocalName:  strings.ToLower(name[:1]) + name[1:],
+				FullMethod: s.CarnoName + "/" + name,
+				Kind:       methodKind(method),
+				Input:      strings.TrimPrefix(method.GetInputType(), "."),
+				Output:     strings.TrimPrefix(method.GetOutputType(), "."),
+			}
+			if method.Options != nil {
+				meth.Options = g.manifestOptions(method.Options)
+			}
+			s.Methods = append(s.Methods, meth)
+		}
+		m.Services = append(m.Services, s)
+	}
+	b, err := json.MarshalIndent(&m, "", "  ")
+	if err != nil {
+		g.gen.Error(err, "writing the service manifest of", file.GetName())
+	}
+	g.gen.Response.File = append(g.gen.Response.File, &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(strings.TrimSuffix(g.gen.GoOutputName(file), ".pb.go") + ".carno.json"),
+		Content: proto.String(string(b) + "\n"),
+	})
+}
+
+// manifestOptions returns opts in JSON, or nil if it sets nothing.
+func (g *carno) manifestOptions(opts proto.Message) json.RawMessage {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, opts); err != nil {
+		g.gen.Error(err, "writing options to the service manifest")
+	}
+	if buf.String() == "{}" {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// methodKind returns the Protobuf-ES kind of method.
+func methodKind(method *pb.MethodDescriptorProto) string {
+	switch {
+	case method.GetClientStreaming() && method.GetServerStreaming():
+		return "bidi_streaming"
+	case method.GetClientStreaming():
+		return "client_streaming"
+	case method.GetServerStreaming():
+		return "server_streaming"
+	}
+	return "unary"
+}
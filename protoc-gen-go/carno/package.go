@@ -0,0 +1,134 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carno
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/proto"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+// generating reports whether file is one of the files to generate, rather
+// than one of their imports.
+func (g *carno) generating(file *generator.FileDescriptor) bool {
+	for _, name := range g.gen.Request.FileToGenerate {
+		if name == file.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// generatePackageFiles adds a file to the response for each proto package
+// of the files to generate that has services, next to file's generated
+// code. It has the code shared by the services of the package: the
+// aggregate client type, New<Pkg>, ServerName and InitCarno.
+//
+// The code is in a file of its own, named for the package, so that running
+// protoc once for each file of a package writes the same file again rather
+// than declaring its symbols in every output. It does not depend on which
+// files of the package are generated: each file adds a <Service>Client
+// method to the aggregate for each of its services, so runs can be made
+// on any subset of the files, in any order.
+func (g *carno) generatePackageFiles(file *generator.FileDescriptor) {
+	importPath := g.gen.GoImportPath(file)
+	var pkgs []string
+	hasPkg := make(map[string]bool)
+	for _, fd := range g.gen.Request.ProtoFile {
+		f, pkg := g.gen.FileOf(fd), fd.GetPackage()
+		if len(fd.Service) == 0 || pkg == "" || hasPkg[pkg] || !g.generating(f) || g.gen.GoImportPath(f) != importPath {
+			continue
+		}
+		hasPkg[pkg] = true
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+
+	dir := path.Dir(g.gen.GoOutputName(file))
+	for _, pkg := range pkgs {
+		name := path.Join(dir, strings.Replace(pkg, ".", "_", -1)+"_carno_package.pb.go")
+		src, err := format.Source(g.packageFile(file, pkg))
+		if err != nil {
+			g.gen.Error(err, "formatting package file for", pkg)
+		}
+		g.gen.Response.File = append(g.gen.Response.File, &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(name),
+			Content: proto.String(string(src)),
+		})
+	}
+}
+
+// packageFile returns the source of the package file for pkg. The code is
+// printed with the generator, into a buffer of its own, so it uses the
+// package names that generateServices chose for the imports.
+func (g *carno) packageFile(file *generator.FileDescriptor, pkg string) []byte {
+	saved := g.gen.Buffer
+	g.gen.Buffer = new(bytes.Buffer)
+	g.generateServerPackage(pkg)
+	g.generateInit(pkg)
+	body := g.gen.Buffer
+	g.gen.Buffer = saved
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by protoc-gen-go. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package", file.PackageName())
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "import (")
+	if g.lazyAggregate {
+		printImport(&buf, g.syncPkg, "sync")
+		fmt.Fprintln(&buf)
+	}
+	printImport(&buf, g.carnoPkg, carnoPkgPath)
+	printImport(&buf, g.clientPkg, clientPkgPath)
+	fmt.Fprintln(&buf, ")")
+	fmt.Fprintln(&buf)
+	buf.Write(body.Bytes())
+	return buf.Bytes()
+}
+
+// printImport prints an import spec, naming the package only if name is
+// not the last element of its import path.
+func printImport(buf *bytes.Buffer, name, importPath string) {
+	if name == path.Base(importPath) {
+		fmt.Fprintf(buf, "%q\n", importPath)
+	} else {
+		fmt.Fprintf(buf, "%s %q\n", name, importPath)
+	}
+}
@@ -0,0 +1,261 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package carno
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/ccsnake/protobuf/protoc-gen-go/plugingen"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+// generateReport adds the file named by the carno:report parameter to the
+// response. It is a cleanup worklist for the files being generated: the
+// deprecated fields and reserved field names that carno options and keys
+// still refer to, the deprecated messages and methods still in use, and
+// the methods no handler is registered for. Each line has the form
+// file:line:col: message, like compiler output.
+func (g *carno) generateReport() {
+	generate := make(map[string]bool)
+	for _, name := range g.gen.Request.FileToGenerate {
+		generate[name] = true
+	}
+	var buf bytes.Buffer
+	for _, fd := range g.gen.Request.ProtoFile {
+		if !generate[fd.GetName()] {
+			continue
+		}
+		file := g.gen.FileOf(fd)
+		report := func(path, format string, args ...interface{}) {
+			fmt.Fprintf(&buf, "%s: %s\n", file.Position(path), fmt.Sprintf(format, args...))
+		}
+		g.reportMessages(file, report)
+		g.reportServices(file, report)
+	}
+	g.gen.Response.File = append(g.gen.Response.File, &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(g.report),
+		Content: proto.String(buf.String()),
+	})
+}
+
+// reportMessages reports the deprecated fields of the messages in file that
+// are idempotency keys, the deprecated or reserved fields their
+// (carno.entity) options name as primary keys, and the deprecated events
+// their aggregates are still built from.
+func (g *carno) reportMessages(file *generator.FileDescriptor, report func(path, format string, args ...interface{})) {
+	var walk func(prefix, path string, msgs []*pb.DescriptorProto)
+	walk = func(prefix, path string, msgs []*pb.DescriptorProto) {
+		for i, msg := range msgs {
+			fullName := prefix + msg.GetName()
+			msgPath := fmt.Sprintf("%s%d", path, i)
+			for j, field := range msg.Field {
+				v := g.gen.FieldOption(field, options.E_IdempotencyKey)
+				if v != nil && *v.(*bool) && field.GetOptions().GetDeprecated() {
+					fieldPath := fmt.Sprintf("%s,2,%d", msgPath, j) // 2 means field.
+					report(fieldPath, "field %s.%s is deprecated but is still the idempotency key", fullName, field.GetName())
+				}
+			}
+			if v := g.gen.MessageOption(msg, options.E_Entity); v != nil {
+				reportField(report, msgPath, "primary key of entity "+fullName, fullName, msg, v.(*options.Entity).GetPk())
+			}
+			if v := g.gen.MessageOption(msg, options.E_Events); v != nil {
+				for _, event := range v.([]string) {
+					if name := g.resolveEvent(file, event); name != "" && g.deprecated(name) {
+						report(msgPath, "aggregate %s is built from deprecated event %s", fullName, name[1:])
+					}
+				}
+			}
+			walk(fullName+".", msgPath+",3,", msg.NestedType) // 3 means nested message.
+		}
+	}
+	prefix := ""
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix = pkg + "."
+	}
+	walk(prefix, "4,", file.MessageType) // 4 means message.
+}
+
+// reportServices reports the deprecated methods of the services in file,
+// the methods that use deprecated messages, the deprecated or reserved
+// fields that their (carno.pagination) and (carno.long_running) options
+// name, whether by name or by default, and the methods no handler is
+// registered for: streaming methods, which the generated service
+// descriptor leaves out, and methods it lists under a name that is not
+// that of their server method.
+func (g *carno) reportServices(file *generator.FileDescriptor, report func(path, format string, args ...interface{})) {
+	for i, service := range file.Service {
+		for j, method := range service.Method {
+			path := plugingen.MethodPath(plugingen.ServicePath(i), j)
+			name := service.GetName() + "." + method.GetName()
+			if pkg := file.GetPackage(); pkg != "" {
+				name = pkg + "." + name
+			}
+			if method.GetOptions().GetDeprecated() {
+				report(path, "method %s is deprecated", name)
+			}
+			if g.deprecated(method.GetInputType()) {
+				report(path, "method %s takes deprecated message %s", name, method.GetInputType()[1:])
+			}
+			if g.deprecated(method.GetOutputType()) {
+				report(path, "method %s returns deprecated message %s", name, method.GetOutputType()[1:])
+			}
+			g.reportPagination(path, name, method, report)
+			g.reportLongRunning(path, name, service, method, report)
+			switch {
+			case plugingen.Streaming(method):
+				report(path, "method %s is streaming; carno registers no handler for it", name)
+			case g.MethodName(method) != method.GetName():
+				report(path, "method %s is registered as %q, not as its server method %s, so no handler is found for it", name, method.GetName(), g.MethodName(method))
+			}
+		}
+	}
+}
+
+// reportPagination reports the deprecated or reserved fields named by the
+// (carno.pagination) option of method, or by its defaults, if the method
+// pages through its results.
+func (g *carno) reportPagination(path, name string, method *pb.MethodDescriptorProto, report func(path, format string, args ...interface{})) {
+	if plugingen.Streaming(method) {
+		return
+	}
+	in, out := g.message(method.GetInputType()), g.message(method.GetOutputType())
+	if in == nil || out == nil {
+		return
+	}
+	opt := new(options.Pagination)
+	v := g.gen.MethodOption(method, options.E_Pagination)
+	if v != nil {
+		opt = v.(*options.Pagination)
+	}
+	token := orDefault(opt.GetPageToken(), "page_token")
+	next := orDefault(opt.GetNextPageToken(), "next_page_token")
+	if v == nil && (!hasField(in, token) || !hasField(out, next)) {
+		return
+	}
+	inName, outName := method.GetInputType()[1:], method.GetOutputType()[1:]
+	reportField(report, path, "page token of method "+name, inName, in, token)
+	reportField(report, path, "next page token of method "+name, outName, out, next)
+	items := opt.GetItems()
+	if opt.Items == nil {
+		// By default, the results are the only repeated field.
+		for _, field := range out.Field {
+			if field.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED || g.message(field.GetTypeName()).GetOptions().GetMapEntry() {
+				continue
+			}
+			if items != "" {
+				return
+			}
+			items = field.GetName()
+		}
+	}
+	reportField(report, path, "results of method "+name, outName, out, items)
+}
+
+// reportLongRunning reports the deprecated or reserved fields named by the
+// (carno.long_running) option of method, or by its defaults, including the
+// field of the poll method's request naming the operation.
+func (g *carno) reportLongRunning(path, name string, service *pb.ServiceDescriptorProto, method *pb.MethodDescriptorProto, report func(path, format string, args ...interface{})) {
+	v := g.gen.MethodOption(method, options.E_LongRunning)
+	if v == nil {
+		return
+	}
+	opt := v.(*options.LongRunning)
+	opName := method.GetOutputType()[1:]
+	op := g.message(method.GetOutputType())
+	if op == nil {
+		return
+	}
+	what := "operation started by method " + name
+	reportField(report, path, "name of "+what, opName, op, orDefault(opt.GetName(), "name"))
+	reportField(report, path, "done field of "+what, opName, op, orDefault(opt.GetDone(), "done"))
+	reportField(report, path, "result of "+what, opName, op, orDefault(opt.GetResult(), "response"))
+	reportField(report, path, "error of "+what, opName, op, orDefault(opt.GetError(), "error"))
+	for _, poll := range service.Method {
+		if poll.GetName() != opt.GetPollMethod() {
+			continue
+		}
+		if in := g.message(poll.GetInputType()); in != nil {
+			reportField(report, path, "operation name in the request of poll method "+poll.GetName(), poll.GetInputType()[1:], in, orDefault(opt.GetName(), "name"))
+		}
+	}
+}
+
+// reportField reports the field of msg, whose full name is msgName, that
+// an option or key refers to by name as what, if the field is deprecated
+// or its name is reserved.
+func reportField(report func(path, format string, args ...interface{}), path, what, msgName string, msg *pb.DescriptorProto, name string) {
+	if name == "" {
+		return
+	}
+	for _, field := range msg.Field {
+		if field.GetName() == name && field.GetOptions().GetDeprecated() {
+			report(path, "%s is deprecated field %s.%s", what, msgName, name)
+		}
+	}
+	for _, reserved := range msg.ReservedName {
+		if reserved == name {
+			report(path, "%s is reserved field name %s.%s", what, msgName, name)
+		}
+	}
+}
+
+// hasField reports whether msg has a field with the given name.
+func hasField(msg *pb.DescriptorProto, name string) bool {
+	for _, field := range msg.Field {
+		if field.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// message returns the message with the given fully-qualified name, with a
+// leading dot, or nil if the request has none.
+func (g *carno) message(name string) *pb.DescriptorProto {
+	for _, names := range g.messageNames() {
+		if msg := names[name]; msg != nil {
+			return msg
+		}
+	}
+	return nil
+}
+
+// deprecated reports whether the message with the given fully-qualified
+// name, with a leading dot, is marked deprecated.
+func (g *carno) deprecated(name string) bool {
+	return g.message(name).GetOptions().GetDeprecated()
+}
@@ -0,0 +1,306 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package clone outputs a CloneMessage method for messages, which returns
+// a deep copy of the message without the reflection proto.Clone uses. It
+// runs as a plugin for the Go protocol buffer compiler plugin, enabled
+// with plugins=clone. It is linked in to protoc-gen-go.
+//
+// CloneMessage copies messages of types declared in the same file with
+// their own CloneMessage methods, and others with proto.Clone. Messages
+// with extension ranges, and those with a field named clone_message, get
+// no method; the others copy their lazy fields, decoding them first, and
+// their unrecognized fields. Unlike proto.Clone, CloneMessage keeps the
+// difference between nil and empty bytes fields.
+package clone
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// New returns a new instance of the clone plugin, for a generator created
+// with generator.NewWithPlugins.
+func New() generator.Plugin { return new(clone) }
+
+// clone is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates deep-copy methods.
+type clone struct {
+	gen      *generator.Generator
+	file     *generator.FileDescriptor // The file being generated.
+	protoPkg string                    // The name under which the file imports proto.
+}
+
+// Name returns the name of this plugin, "clone".
+func (g *clone) Name() string {
+	return "clone"
+}
+
+// SetParam rejects all parameters; the clone plugin has none.
+func (g *clone) SetParam(key, value string) error {
+	return fmt.Errorf("unknown parameter %q", key)
+}
+
+// Init initializes the plugin.
+func (g *clone) Init(gen *generator.Generator) {
+	g.gen = gen
+}
+
+// P forwards to g.gen.P.
+func (g *clone) P(args ...interface{}) { g.gen.P(args...) }
+
+// Generate generates the methods for the messages in the given file.
+func (g *clone) Generate(file *generator.FileDescriptor) {
+	g.file = file
+	g.protoPkg = g.gen.AddImport("github.com/golang/protobuf/proto")
+
+	prefix := "."
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix += pkg + "."
+	}
+	for _, msg := range file.MessageType {
+		g.generateMessages(prefix+msg.GetName(), msg)
+	}
+}
+
+// GenerateImports does nothing; Generate adds its imports with AddImport.
+func (g *clone) GenerateImports(file *generator.FileDescriptor) {}
+
+// generateMessages generates the method for the message with the given
+// fully-qualified name, and for the messages nested in it.
+func (g *clone) generateMessages(name string, msg *pb.DescriptorProto) {
+	if d, ok := g.gen.ObjectNamed(name).(*generator.Descriptor); ok && g.supported(d) {
+		g.generateMessage(d)
+	}
+	for _, nested := range msg.NestedType {
+		g.generateMessages(name+"."+nested.GetName(), nested)
+	}
+}
+
+// supported reports whether the method can be generated for msg.
+func (g *clone) supported(msg *generator.Descriptor) bool {
+	if msg.GetOptions().GetMapEntry() || len(msg.ExtensionRange) > 0 {
+		return false
+	}
+	for _, field := range msg.Field {
+		if msg.GoFieldName(field) == "CloneMessage" {
+			return false
+		}
+	}
+	return true
+}
+
+// hasMethod reports whether the message with the given fully-qualified
+// name gets a CloneMessage method generated along with the current file's.
+func (g *clone) hasMethod(typeName string) bool {
+	d, ok := g.gen.ObjectNamed(typeName).(*generator.Descriptor)
+	return ok && d.File() == g.file.FileDescriptorProto && g.supported(d)
+}
+
+// generateMessage generates the method for msg.
+func (g *clone) generateMessage(msg *generator.Descriptor) {
+	typeName := g.gen.TypeName(msg)
+	g.P("// CloneMessage returns a deep copy of m.")
+	g.P("func (m *", typeName, ") CloneMessage() *", typeName, " {")
+	g.P("if m == nil {")
+	g.P("return nil")
+	g.P("}")
+	for _, field := range msg.Field {
+		if g.gen.IsLazy(field) {
+			g.P(g.protoPkg, ".DecodeLazy(m)")
+			break
+		}
+	}
+	g.P("c := new(", typeName, ")")
+	for i, field := range msg.Field {
+		if field.OneofIndex != nil {
+			// Each oneof is copied where its first field is declared.
+			if first := firstOfOneof(msg, *field.OneofIndex); first == i {
+				g.generateOneof(msg, *field.OneofIndex)
+			}
+			continue
+		}
+		g.generateField(msg, field)
+	}
+	if msg.File().GetSyntax() != "proto3" {
+		g.P("if m.XXX_unrecognized != nil {")
+		g.P("c.XXX_unrecognized = append([]byte{}, m.XXX_unrecognized...)")
+		g.P("}")
+	}
+	g.P("return c")
+	g.P("}")
+	g.P()
+}
+
+// firstOfOneof returns the index in msg.Field of the first field of the
+// oneof with the given index.
+func firstOfOneof(msg *generator.Descriptor, index int32) int {
+	for i, field := range msg.Field {
+		if field.OneofIndex != nil && *field.OneofIndex == index {
+			return i
+		}
+	}
+	return -1
+}
+
+// isMessage reports whether the field holds messages or groups.
+func isMessage(field *pb.FieldDescriptorProto) bool {
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		return true
+	}
+	return false
+}
+
+// mapEntry returns the map entry message of the field, or nil if the
+// field is not a map.
+func (g *clone) mapEntry(field *pb.FieldDescriptorProto) *generator.Descriptor {
+	if field.GetType() != pb.FieldDescriptorProto_TYPE_MESSAGE {
+		return nil
+	}
+	if d, ok := g.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor); ok && d.GetOptions().GetMapEntry() {
+		return d
+	}
+	return nil
+}
+
+// copyMessage returns an expression for a deep copy of v, a possibly nil
+// pointer to a message of the type of field.
+func (g *clone) copyMessage(field *pb.FieldDescriptorProto, v string) string {
+	if g.hasMethod(field.GetTypeName()) {
+		return v + ".CloneMessage()"
+	}
+	g.gen.RecordTypeUse(field.GetTypeName())
+	typ := g.gen.TypeName(g.gen.ObjectNamed(field.GetTypeName()))
+	return g.protoPkg + ".Clone(" + v + ").(*" + typ + ")"
+}
+
+// generateField generates the copying of a field that is not in a oneof.
+func (g *clone) generateField(msg *generator.Descriptor, field *pb.FieldDescriptorProto) {
+	name := msg.GoFieldName(field)
+	src, dst := "m."+name, "c."+name
+	typ, _ := g.gen.GoType(msg, field)
+	repeated := field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED
+	bytes := field.GetType() == pb.FieldDescriptorProto_TYPE_BYTES
+
+	switch {
+	case g.mapEntry(field) != nil:
+		entry := g.mapEntry(field)
+		keyField, valField := entry.Field[0], entry.Field[1]
+		keyType, _ := g.gen.GoType(entry, keyField)
+		valType, _ := g.gen.GoType(entry, valField)
+		keyType = strings.TrimPrefix(keyType, "*")
+		if !isMessage(valField) {
+			valType = strings.TrimPrefix(valType, "*")
+		}
+		g.gen.RecordTypeUse(valField.GetTypeName())
+		g.P("if ", src, " != nil {")
+		g.P(dst, " = make(map[", keyType, "]", valType, ", len(", src, "))")
+		g.P("for k, v := range ", src, " {")
+		switch {
+		case isMessage(valField):
+			g.P(dst, "[k] = ", g.copyMessage(valField, "v"))
+		case valField.GetType() == pb.FieldDescriptorProto_TYPE_BYTES:
+			g.P("if v != nil {")
+			g.P("v = append([]byte{}, v...)")
+			g.P("}")
+			g.P(dst, "[k] = v")
+		default:
+			g.P(dst, "[k] = v")
+		}
+		g.P("}")
+		g.P("}")
+	case repeated && isMessage(field):
+		g.P("if ", src, " != nil {")
+		g.P(dst, " = make(", typ, ", len(", src, "))")
+		g.P("for i, x := range ", src, " {")
+		g.P(dst, "[i] = ", g.copyMessage(field, "x"))
+		g.P("}")
+		g.P("}")
+	case repeated && bytes:
+		g.P("if ", src, " != nil {")
+		g.P(dst, " = make([][]byte, len(", src, "))")
+		g.P("for i, b := range ", src, " {")
+		g.P("if b != nil {")
+		g.P(dst, "[i] = append([]byte{}, b...)")
+		g.P("}")
+		g.P("}")
+		g.P("}")
+	case repeated:
+		g.P("if ", src, " != nil {")
+		g.P(dst, " = make(", typ, ", len(", src, "))")
+		g.P("copy(", dst, ", ", src, ")")
+		g.P("}")
+	case isMessage(field):
+		g.P(dst, " = ", g.copyMessage(field, src))
+	case bytes:
+		g.P("if ", src, " != nil {")
+		g.P(dst, " = append([]byte{}, ", src, "...)")
+		g.P("}")
+	case strings.HasPrefix(typ, "*"):
+		g.P("if ", src, " != nil {")
+		g.P("v := *", src)
+		g.P(dst, " = &v")
+		g.P("}")
+	default:
+		g.P(dst, " = ", src)
+	}
+}
+
+// generateOneof generates the copying of the oneof with the given index.
+func (g *clone) generateOneof(msg *generator.Descriptor, index int32) {
+	oneof := msg.GoOneofName(index)
+	g.P("switch x := m.", oneof, ".(type) {")
+	for _, field := range msg.Field {
+		if field.OneofIndex == nil || *field.OneofIndex != index {
+			continue
+		}
+		wrapper := msg.GoOneofTypeName(field)
+		v := "x." + msg.GoFieldName(field)
+		g.P("case *", wrapper, ":")
+		switch {
+		case isMessage(field):
+			v = g.copyMessage(field, v)
+		case field.GetType() == pb.FieldDescriptorProto_TYPE_BYTES:
+			g.P("w := new(", wrapper, ")")
+			g.P("if ", v, " != nil {")
+			g.P("w.", msg.GoFieldName(field), " = append([]byte{}, ", v, "...)")
+			g.P("}")
+			g.P("c.", oneof, " = w")
+			continue
+		}
+		g.P("c.", oneof, " = &", wrapper, "{", v, "}")
+	}
+	g.P("}")
+}
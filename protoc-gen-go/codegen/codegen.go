@@ -0,0 +1,115 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package codegen runs the Go code generator of protoc-gen-go in-process,
+// for build tools that embed it rather than run the plugin under protoc:
+//
+//	resp, err := codegen.Run(req, codegen.Options{})
+//
+// The request is the one protoc sends its plugins, which the protoparse
+// package makes from .proto files without protoc. The plugins of this
+// repository that protoc-gen-go links in are available here too, enabled
+// with the plugins parameter of the request as usual, or Options.Plugins
+// can list others.
+//
+// Each call to Run has a generator and plugins of its own, so calls may be
+// made concurrently. This API is stable; new options will only be added as
+// fields of Options whose zero values keep today's behavior.
+package codegen
+
+import (
+	"github.com/ccsnake/protobuf/protoc-gen-go/builder"
+	"github.com/ccsnake/protobuf/protoc-gen-go/carno"
+	"github.com/ccsnake/protobuf/protoc-gen-go/clone"
+	"github.com/ccsnake/protobuf/protoc-gen-go/equal"
+	"github.com/ccsnake/protobuf/protoc-gen-go/fastpath"
+	"github.com/ccsnake/protobuf/protoc-gen-go/fingerprint"
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/ccsnake/protobuf/protoc-gen-go/jsonschema"
+	"github.com/ccsnake/protobuf/protoc-gen-go/pool"
+	"github.com/golang/protobuf/proto"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+// DefaultPlugins returns the plugins of this repository that protoc-gen-go
+// links in, which Run uses unless Options.Plugins says otherwise. (The grpc
+// plugin is built on the generator of github.com/golang/protobuf, so it
+// cannot run here.)
+func DefaultPlugins() []func() generator.Plugin {
+	return []func() generator.Plugin{
+		builder.New,
+		carno.New,
+		clone.New,
+		equal.New,
+		fastpath.New,
+		fingerprint.New,
+		jsonschema.New,
+		pool.New,
+	}
+}
+
+// Options control a call to Run. The zero value generates what
+// protoc-gen-go does.
+type Options struct {
+	// PluginOutput, if not empty, makes Run generate only the code of the
+	// plugins, to go alongside the output of a stock protoc-gen-go: the
+	// code for x.proto goes in x_<PluginOutput>.pb.go. See the field of
+	// the same name of generator.Generator.
+	PluginOutput string
+
+	// Plugins, if not nil, make the plugins available to the generator
+	// in place of DefaultPlugins; each call to Run makes new instances.
+	// To add to the default plugins, append to what DefaultPlugins returns.
+	Plugins []func() generator.Plugin
+}
+
+// Run generates the Go code for the files named in req.FileToGenerate, as
+// protoc-gen-go does when protoc sends it req, and returns the response
+// protoc would receive. req is not modified.
+//
+// Problems with the .proto files, such as invalid options, are reported in
+// the Error field of the response, as they are to protoc. Problems that
+// would make protoc-gen-go exit, such as an unknown parameter, are returned
+// as an error.
+func Run(req *plugin.CodeGeneratorRequest, opts Options) (*plugin.CodeGeneratorResponse, error) {
+	plugins := opts.Plugins
+	if plugins == nil {
+		plugins = DefaultPlugins()
+	}
+	g := generator.NewWithPlugins(plugins...)
+	// Plugins may change the descriptors, as carno does JSON names.
+	g.Request = proto.Clone(req).(*plugin.CodeGeneratorRequest)
+	g.PluginOutput = opts.PluginOutput
+	if err := g.Run(); err != nil {
+		return nil, err
+	}
+	return g.Response, nil
+}
@@ -0,0 +1,177 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package codegen
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/carno"
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/proto"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"github.com/golang/protobuf/protoparse"
+)
+
+// request compiles the multiservice case of the carno golden tests.
+func request(t *testing.T) *plugin.CodeGeneratorRequest {
+	p := &protoparse.Parser{ImportPaths: []string{"../carno/testdata"}}
+	req, err := p.NewRequest("plugins=carno", "multiservice/multiservice.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+// files returns the generated files of resp by name.
+func files(t *testing.T, resp *plugin.CodeGeneratorResponse) map[string]string {
+	if resp.Error != nil {
+		t.Fatal(resp.GetError())
+	}
+	out := make(map[string]string)
+	for _, f := range resp.File {
+		out[f.GetName()] = f.GetContent()
+	}
+	return out
+}
+
+// fileDescriptorRE matches the compressed descriptors in generated code,
+// which the golden files elide; see carno/golden_test.go.
+var fileDescriptorRE = regexp.MustCompile(`(?m)^(var fileDescriptor\w* = \[\]byte\{)\n(?:.*\n)*?\}\n`)
+
+func TestRun(t *testing.T) {
+	req := request(t)
+	orig := proto.Clone(req)
+	resp, err := Run(req, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(req, orig) {
+		t.Error("Run modified the request")
+	}
+	got := files(t, resp)
+	goldens, err := filepath.Glob("../carno/testdata/multiservice/*.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(goldens) {
+		t.Errorf("got %d files, want %d", len(got), len(goldens))
+	}
+	for _, path := range goldens {
+		want, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		name := "multiservice/" + strings.TrimSuffix(filepath.Base(path), ".golden")
+		content := fileDescriptorRE.ReplaceAllString(got[name], "$1\n\t// elided\n}\n")
+		if content != string(want) {
+			t.Errorf("%s differs from %s", name, path)
+		}
+	}
+}
+
+func TestRunConcurrent(t *testing.T) {
+	req := request(t)
+	resp, err := Run(req, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := files(t, resp)
+
+	var wg sync.WaitGroup
+	results := make([]map[string]string, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := Run(req, Options{})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			results[i] = files(t, resp)
+		}(i)
+	}
+	wg.Wait()
+	for i, got := range results {
+		if got != nil && !reflect.DeepEqual(got, want) {
+			t.Errorf("run %d differs from the first", i)
+		}
+	}
+}
+
+func TestRunPluginOutput(t *testing.T) {
+	resp, err := Run(request(t), Options{PluginOutput: "carno"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := files(t, resp)
+	if _, ok := got["multiservice/multiservice_carno.pb.go"]; !ok {
+		t.Error("no multiservice_carno.pb.go")
+	}
+	if _, ok := got["multiservice/multiservice.pb.go"]; ok {
+		t.Error("stock output multiservice.pb.go generated")
+	}
+}
+
+func TestRunPlugins(t *testing.T) {
+	// Without carno, plugins=carno enables nothing.
+	resp, err := Run(request(t), Options{Plugins: []func() generator.Plugin{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := files(t, resp)
+	if len(got) != 1 || strings.Contains(got["multiservice/multiservice.pb.go"], "StoreClient") {
+		t.Errorf("got files %v, want just multiservice.pb.go without carno code", got)
+	}
+
+	resp, err = Run(request(t), Options{Plugins: []func() generator.Plugin{carno.New}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := files(t, resp); !strings.Contains(got["multiservice/multiservice.pb.go"], "StoreClient") {
+		t.Errorf("no carno code in %v", got)
+	}
+}
+
+func TestRunError(t *testing.T) {
+	req := request(t)
+	req.Parameter = proto.String("plugins=carno,paths=bogus")
+	if _, err := Run(req, Options{}); err == nil || !strings.Contains(err.Error(), "bogus") {
+		t.Errorf("got error %v, want one about paths=bogus", err)
+	}
+}
@@ -0,0 +1,284 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package equal outputs an EqualMessage method for messages, which reports
+// whether two messages of the same type are equal as proto.Equal defines it,
+// without the reflection proto.Equal uses. It runs as a plugin for the Go
+// protocol buffer compiler plugin, enabled with plugins=equal. It is linked
+// in to protoc-gen-go.
+//
+// EqualMessage compares messages of types declared in the same file with
+// their own EqualMessage methods, and others with proto.Equal. Messages
+// with extension ranges, and those with a field or oneof named
+// equal_message, get no method; the others decode their lazy fields
+// first, and compare their unrecognized fields. Like proto.Equal,
+// EqualMessage treats nil and empty proto3 bytes fields as equal, but not
+// nil and empty proto2 bytes fields or bytes map values.
+package equal
+
+import (
+	"fmt"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// New returns a new instance of the equal plugin, for a generator created
+// with generator.NewWithPlugins.
+func New() generator.Plugin { return new(equal) }
+
+// equal is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates comparison methods.
+type equal struct {
+	gen      *generator.Generator
+	file     *generator.FileDescriptor // The file being generated.
+	protoPkg string                    // The name under which the file imports proto.
+}
+
+// Name returns the name of this plugin, "equal".
+func (g *equal) Name() string {
+	return "equal"
+}
+
+// SetParam rejects all parameters; the equal plugin has none.
+func (g *equal) SetParam(key, value string) error {
+	return fmt.Errorf("unknown parameter %q", key)
+}
+
+// Init initializes the plugin.
+func (g *equal) Init(gen *generator.Generator) {
+	g.gen = gen
+}
+
+// P forwards to g.gen.P.
+func (g *equal) P(args ...interface{}) { g.gen.P(args...) }
+
+// Generate generates the methods for the messages in the given file.
+func (g *equal) Generate(file *generator.FileDescriptor) {
+	g.file = file
+	g.protoPkg = g.gen.AddImport("github.com/golang/protobuf/proto")
+
+	prefix := "."
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix += pkg + "."
+	}
+	for _, msg := range file.MessageType {
+		g.generateMessages(prefix+msg.GetName(), msg)
+	}
+}
+
+// GenerateImports does nothing; Generate adds its imports with AddImport.
+func (g *equal) GenerateImports(file *generator.FileDescriptor) {}
+
+// generateMessages generates the method for the message with the given
+// fully-qualified name, and for the messages nested in it.
+func (g *equal) generateMessages(name string, msg *pb.DescriptorProto) {
+	if d, ok := g.gen.ObjectNamed(name).(*generator.Descriptor); ok && g.supported(d) {
+		g.generateMessage(d)
+	}
+	for _, nested := range msg.NestedType {
+		g.generateMessages(name+"."+nested.GetName(), nested)
+	}
+}
+
+// supported reports whether the method can be generated for msg.
+func (g *equal) supported(msg *generator.Descriptor) bool {
+	if msg.GetOptions().GetMapEntry() || len(msg.ExtensionRange) > 0 {
+		return false
+	}
+	for _, field := range msg.Field {
+		if msg.GoFieldName(field) == "EqualMessage" {
+			return false
+		}
+	}
+	for i := range msg.OneofDecl {
+		if msg.GoOneofName(int32(i)) == "EqualMessage" {
+			return false
+		}
+	}
+	return true
+}
+
+// hasMethod reports whether the message with the given fully-qualified
+// name gets an EqualMessage method generated along with the current file's.
+func (g *equal) hasMethod(typeName string) bool {
+	d, ok := g.gen.ObjectNamed(typeName).(*generator.Descriptor)
+	return ok && d.File() == g.file.FileDescriptorProto && g.supported(d)
+}
+
+// generateMessage generates the method for msg.
+func (g *equal) generateMessage(msg *generator.Descriptor) {
+	typeName := g.gen.TypeName(msg)
+	g.P("// EqualMessage reports whether m and o are equal, as proto.Equal defines it.")
+	g.P("func (m *", typeName, ") EqualMessage(o *", typeName, ") bool {")
+	g.P("if m == nil || o == nil {")
+	g.P("return m == o")
+	g.P("}")
+	for _, field := range msg.Field {
+		if g.gen.IsLazy(field) {
+			g.P(g.protoPkg, ".DecodeLazy(m)")
+			g.P(g.protoPkg, ".DecodeLazy(o)")
+			break
+		}
+	}
+	for i, field := range msg.Field {
+		if field.OneofIndex != nil {
+			// Each oneof is compared where its first field is declared.
+			if first := firstOfOneof(msg, *field.OneofIndex); first == i {
+				g.generateOneof(msg, *field.OneofIndex)
+			}
+			continue
+		}
+		g.generateField(msg, field)
+	}
+	if msg.File().GetSyntax() != "proto3" {
+		// bytes.Equal would need an import; comparing the conversions to
+		// string does not copy.
+		g.P("if string(m.XXX_unrecognized) != string(o.XXX_unrecognized) {")
+		g.P("return false")
+		g.P("}")
+	}
+	g.P("return true")
+	g.P("}")
+	g.P()
+}
+
+// firstOfOneof returns the index in msg.Field of the first field of the
+// oneof with the given index.
+func firstOfOneof(msg *generator.Descriptor, index int32) int {
+	for i, field := range msg.Field {
+		if field.OneofIndex != nil && *field.OneofIndex == index {
+			return i
+		}
+	}
+	return -1
+}
+
+// isMessage reports whether the field holds messages or groups.
+func isMessage(field *pb.FieldDescriptorProto) bool {
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		return true
+	}
+	return false
+}
+
+// mapEntry returns the map entry message of the field, or nil if the
+// field is not a map.
+func (g *equal) mapEntry(field *pb.FieldDescriptorProto) *generator.Descriptor {
+	if field.GetType() != pb.FieldDescriptorProto_TYPE_MESSAGE {
+		return nil
+	}
+	if d, ok := g.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor); ok && d.GetOptions().GetMapEntry() {
+		return d
+	}
+	return nil
+}
+
+// differ returns an expression reporting whether x and y, two values of
+// the type of field, differ. emptyBytes is whether nil and empty bytes
+// values count as equal, as proto.Equal has it for proto3 fields.
+func (g *equal) differ(field *pb.FieldDescriptorProto, x, y string, emptyBytes bool) string {
+	switch {
+	case isMessage(field) && g.hasMethod(field.GetTypeName()):
+		return "!" + x + ".EqualMessage(" + y + ")"
+	case isMessage(field):
+		return "!" + g.protoPkg + ".Equal(" + x + ", " + y + ")"
+	case field.GetType() == pb.FieldDescriptorProto_TYPE_BYTES && emptyBytes:
+		return "string(" + x + ") != string(" + y + ")"
+	case field.GetType() == pb.FieldDescriptorProto_TYPE_BYTES:
+		return "(" + x + " == nil) != (" + y + " == nil) || string(" + x + ") != string(" + y + ")"
+	}
+	return x + " != " + y
+}
+
+// generateField generates the comparison of a field that is not in a oneof.
+func (g *equal) generateField(msg *generator.Descriptor, field *pb.FieldDescriptorProto) {
+	name := msg.GoFieldName(field)
+	x, y := "m."+name, "o."+name
+	typ, _ := g.gen.GoType(msg, field)
+	repeated := field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED
+	proto3 := msg.File().GetSyntax() == "proto3"
+
+	switch {
+	case g.mapEntry(field) != nil:
+		// proto.Equal tells nil from empty bytes map values even in proto3.
+		valField := g.mapEntry(field).Field[1]
+		g.P("if len(", x, ") != len(", y, ") {")
+		g.P("return false")
+		g.P("}")
+		g.P("for k, v := range ", x, " {")
+		g.P("if w, ok := ", y, "[k]; !ok || ", g.differ(valField, "v", "w", false), " {")
+		g.P("return false")
+		g.P("}")
+		g.P("}")
+	case repeated:
+		g.P("if len(", x, ") != len(", y, ") {")
+		g.P("return false")
+		g.P("}")
+		g.P("for i, v := range ", x, " {")
+		g.P("if ", g.differ(field, "v", y+"[i]", proto3), " {")
+		g.P("return false")
+		g.P("}")
+		g.P("}")
+	case !isMessage(field) && typ[0] == '*':
+		g.P("if (", x, " == nil) != (", y, " == nil) || ", x, " != nil && *", x, " != *", y, " {")
+		g.P("return false")
+		g.P("}")
+	default:
+		g.P("if ", g.differ(field, x, y, proto3), " {")
+		g.P("return false")
+		g.P("}")
+	}
+}
+
+// generateOneof generates the comparison of the oneof with the given index.
+func (g *equal) generateOneof(msg *generator.Descriptor, index int32) {
+	oneof := msg.GoOneofName(index)
+	proto3 := msg.File().GetSyntax() == "proto3"
+	g.P("switch x := m.", oneof, ".(type) {")
+	g.P("case nil:")
+	g.P("if o.", oneof, " != nil {")
+	g.P("return false")
+	g.P("}")
+	for _, field := range msg.Field {
+		if field.OneofIndex == nil || *field.OneofIndex != index {
+			continue
+		}
+		wrapper := msg.GoOneofTypeName(field)
+		name := msg.GoFieldName(field)
+		g.P("case *", wrapper, ":")
+		g.P("if y, ok := o.", oneof, ".(*", wrapper, "); !ok || ", g.differ(field, "x."+name, "y."+name, proto3), " {")
+		g.P("return false")
+		g.P("}")
+	}
+	g.P("}")
+}
@@ -0,0 +1,667 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package fastpath outputs Size, Marshal, MarshalToSizedBuffer and
+// Unmarshal methods for messages, so that they are encoded and decoded
+// without reflection. It runs as a plugin for the Go protocol buffer
+// compiler plugin, enabled with plugins=fastpath. It is linked in to
+// protoc-gen-go.
+//
+// The proto package prefers the methods wherever it meets the messages,
+// at the top level or nested in other messages. The encoding is the same
+// as that of the proto package. Unmarshal merges into the message, as
+// UnmarshalMerge does, and keeps unknown fields of proto2 messages.
+// Unmarshal fails with proto.ErrTooDeep on messages nested more than
+// proto.DefaultMaxDepth deep; XXX_UnmarshalDepth, which the proto package
+// calls, takes the limit instead, so that UnmarshalOptions.MaxDepth holds
+// for the messages too. Groups in unknown fields are skipped with
+// proto.ConsumeField, which limits their nesting as package protowire
+// does, whatever the MaxDepth.
+//
+// Messages with oneofs, maps, groups, required fields, extension ranges or
+// lazy fields are left to the proto package, as are those with a field
+// named size or marshal_to_sized_buffer, whose Go names the methods would
+// take.
+// Messages nested in the others are marshaled through the proto package,
+// so a message gets the methods whatever its fields refer to. An error
+// from a nested message, even a missing required field, fails the whole
+// marshal. The methods keep unknown fields and do not sort map entries, so
+// deterministic and canonical marshaling encode the messages by reflection.
+package fastpath
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Paths for packages used by code generated in this file.
+const (
+	binaryPkgPath = "encoding/binary"
+	fmtPkgPath    = "fmt"
+	mathPkgPath   = "math"
+	protoPkgPath  = "github.com/golang/protobuf/proto"
+)
+
+// New returns a new instance of the fastpath plugin, for a generator created
+// with generator.NewWithPlugins.
+func New() generator.Plugin { return new(fastpath) }
+
+// fastpath is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates reflection-free encoding methods.
+type fastpath struct {
+	gen *generator.Generator
+
+	// The names under which the current file imports the packages used
+	// by the generated code. binaryPkg is set by binary, on first use.
+	binaryPkg, fmtPkg, mathPkg, protoPkg string
+}
+
+// Name returns the name of this plugin, "fastpath".
+func (g *fastpath) Name() string {
+	return "fastpath"
+}
+
+// SetParam rejects all parameters; the fastpath plugin has none.
+func (g *fastpath) SetParam(key, value string) error {
+	return fmt.Errorf("unknown parameter %q", key)
+}
+
+// Init initializes the plugin.
+func (g *fastpath) Init(gen *generator.Generator) {
+	g.gen = gen
+}
+
+// P forwards to g.gen.P.
+func (g *fastpath) P(args ...interface{}) { g.gen.P(args...) }
+
+// Generate generates the methods for the messages in the given file.
+func (g *fastpath) Generate(file *generator.FileDescriptor) {
+	g.binaryPkg = ""
+	g.fmtPkg = g.gen.AddImport(fmtPkgPath)
+	g.mathPkg = g.gen.AddImport(mathPkgPath)
+	g.protoPkg = g.gen.AddImport(protoPkgPath)
+
+	prefix := "."
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix += pkg + "."
+	}
+	for _, msg := range file.MessageType {
+		g.generateMessages(prefix+msg.GetName(), msg)
+	}
+}
+
+// GenerateImports does nothing; Generate adds its imports with AddImport.
+func (g *fastpath) GenerateImports(file *generator.FileDescriptor) {}
+
+// generateMessages generates the methods for the message with the given
+// fully-qualified name, and for the messages nested in it.
+func (g *fastpath) generateMessages(name string, msg *pb.DescriptorProto) {
+	if d, ok := g.gen.ObjectNamed(name).(*generator.Descriptor); ok && g.supported(d) {
+		g.generateMessage(d)
+	}
+	for _, nested := range msg.NestedType {
+		g.generateMessages(name+"."+nested.GetName(), nested)
+	}
+}
+
+// supported reports whether the methods can be generated for msg.
+func (g *fastpath) supported(msg *generator.Descriptor) bool {
+	if msg.GetOptions().GetMapEntry() || len(msg.ExtensionRange) > 0 || len(msg.OneofDecl) > 0 {
+		return false
+	}
+	for _, field := range msg.Field {
+		switch msg.GoFieldName(field) {
+		case "Size", "MarshalToSizedBuffer":
+			return false
+		}
+		if field.GetLabel() == pb.FieldDescriptorProto_LABEL_REQUIRED ||
+			field.GetType() == pb.FieldDescriptorProto_TYPE_GROUP ||
+			field.GetOptions().GetWeak() || g.gen.IsLazy(field) {
+			return false
+		}
+		if field.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE {
+			if d, ok := g.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor); ok && d.GetOptions().GetMapEntry() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// binary returns the name under which the current file imports
+// encoding/binary, importing it on first use.
+func (g *fastpath) binary() string {
+	if g.binaryPkg == "" {
+		g.binaryPkg = g.gen.AddImport(binaryPkgPath)
+	}
+	return g.binaryPkg
+}
+
+// fieldInfo is what the generated code needs to know about a field.
+type fieldInfo struct {
+	*pb.FieldDescriptorProto
+	name     string // The field of the struct, such as "m.Name".
+	typ      string // The Go type of an element, without any * or [].
+	proto3   bool   // The field has no pointer to mark presence.
+	repeated bool
+	packed   bool
+	wire     int // The wire type of an element.
+}
+
+// fields returns the fields of msg in the order they are encoded.
+func (g *fastpath) fields(msg *generator.Descriptor) []*fieldInfo {
+	proto3 := msg.File().GetSyntax() == "proto3"
+	var fields []*fieldInfo
+	for _, field := range msg.Field {
+		typ, _ := g.gen.GoType(msg, field)
+		f := &fieldInfo{
+			FieldDescriptorProto: field,
+			name:                 "m." + msg.GoFieldName(field),
+			typ:                  strings.TrimLeft(typ, "*[]"),
+			proto3:               proto3,
+			repeated:             field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED,
+			wire:                 wireType(field.GetType()),
+		}
+		// Packed as the generator tags it; see its goTag.
+		f.packed = f.repeated && f.wire != proto.WireBytes &&
+			(field.GetOptions().GetPacked() || proto3 && (field.Options == nil || field.Options.Packed == nil))
+		fields = append(fields, f)
+		g.gen.RecordTypeUse(field.GetTypeName())
+	}
+	sort.Sort(byNumber(fields))
+	return fields
+}
+
+// byNumber sorts fields by field number.
+type byNumber []*fieldInfo
+
+func (s byNumber) Len() int           { return len(s) }
+func (s byNumber) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byNumber) Less(i, j int) bool { return s[i].GetNumber() < s[j].GetNumber() }
+
+// wireType returns the wire type of a value of the given type.
+func wireType(typ pb.FieldDescriptorProto_Type) int {
+	switch typ {
+	case pb.FieldDescriptorProto_TYPE_FIXED32,
+		pb.FieldDescriptorProto_TYPE_SFIXED32,
+		pb.FieldDescriptorProto_TYPE_FLOAT:
+		return proto.WireFixed32
+	case pb.FieldDescriptorProto_TYPE_FIXED64,
+		pb.FieldDescriptorProto_TYPE_SFIXED64,
+		pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return proto.WireFixed64
+	case pb.FieldDescriptorProto_TYPE_STRING,
+		pb.FieldDescriptorProto_TYPE_BYTES,
+		pb.FieldDescriptorProto_TYPE_MESSAGE:
+		return proto.WireBytes
+	}
+	return proto.WireVarint
+}
+
+// key returns the encoded key of f's elements, or of its packed run.
+func (f *fieldInfo) key() []byte {
+	wire := f.wire
+	if f.packed {
+		wire = proto.WireBytes
+	}
+	return proto.EncodeVarint(uint64(f.GetNumber())<<3 | uint64(wire))
+}
+
+// generateMessage generates the methods for msg.
+func (g *fastpath) generateMessage(msg *generator.Descriptor) {
+	typeName := g.gen.TypeName(msg)
+	fields := g.fields(msg)
+	unrecognized := msg.File().GetSyntax() != "proto3"
+
+	g.P("func (m *", typeName, ") Size() (n int) {")
+	g.P("if m == nil {")
+	g.P("return 0")
+	g.P("}")
+	for _, f := range fields {
+		g.generateSize(f)
+	}
+	if unrecognized {
+		g.P("n += len(m.XXX_unrecognized)")
+	}
+	g.P("return n")
+	g.P("}")
+	g.P()
+
+	g.P("func (m *", typeName, ") Marshal() ([]byte, error) {")
+	g.P("if m == nil {")
+	g.P("return nil, ", g.protoPkg, ".ErrNil")
+	g.P("}")
+	g.P("dAtA := make([]byte, m.Size())")
+	g.P("n, err := m.MarshalToSizedBuffer(dAtA)")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("return dAtA[len(dAtA)-n:], nil")
+	g.P("}")
+	g.P()
+
+	g.P("func (m *", typeName, ") MarshalToSizedBuffer(dAtA []byte) (int, error) {")
+	g.P("if m == nil {")
+	g.P("return 0, ", g.protoPkg, ".ErrNil")
+	g.P("}")
+	g.P("i := len(dAtA)")
+	if unrecognized {
+		g.P("if m.XXX_unrecognized != nil {")
+		g.P("i -= len(m.XXX_unrecognized)")
+		g.P("copy(dAtA[i:], m.XXX_unrecognized)")
+		g.P("}")
+	}
+	for j := len(fields) - 1; j >= 0; j-- {
+		g.generateMarshal(fields[j])
+	}
+	g.P("return len(dAtA) - i, nil")
+	g.P("}")
+	g.P()
+
+	g.P("func (m *", typeName, ") Unmarshal(dAtA []byte) error {")
+	g.P("return m.XXX_UnmarshalDepth(dAtA, ", g.protoPkg, ".DefaultMaxDepth)")
+	g.P("}")
+	g.P()
+
+	g.P("func (m *", typeName, ") XXX_UnmarshalDepth(dAtA []byte, maxDepth int) error {")
+	g.P("if maxDepth <= 0 {")
+	g.P("return ", g.protoPkg, ".ErrTooDeep")
+	g.P("}")
+	g.P("for i := 0; i < len(dAtA); {")
+	if unrecognized {
+		g.P("start := i")
+	}
+	if len(fields) == 0 {
+		g.P("_, wire, n, err := ", g.protoPkg, ".ConsumeTag(dAtA[i:])")
+	} else {
+		g.P("num, wire, n, err := ", g.protoPkg, ".ConsumeTag(dAtA[i:])")
+	}
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("i += n")
+	if len(fields) > 0 {
+		g.P("switch num {")
+		for _, f := range fields {
+			g.P("case ", f.Number, ":")
+			g.generateUnmarshal(typeName, f)
+		}
+		g.P("default:")
+	}
+	g.P("n, err = ", g.protoPkg, ".ConsumeField(dAtA[i:], wire)")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("i += n")
+	if unrecognized {
+		g.P("m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[start:i]...)")
+	}
+	if len(fields) > 0 {
+		g.P("}")
+	}
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+// present returns the condition under which a singular field is encoded:
+// a proto2 field when it is set, a proto3 one when it is not zero.
+func (g *fastpath) present(f *fieldInfo) string {
+	if !f.proto3 || f.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE {
+		return f.name + " != nil"
+	}
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return f.name
+	case pb.FieldDescriptorProto_TYPE_STRING, pb.FieldDescriptorProto_TYPE_BYTES:
+		return "len(" + f.name + ") > 0"
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		// Compare the bits, so that -0 is encoded as the proto package does.
+		return g.mathPkg + ".Float32bits(" + f.name + ") != 0"
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return g.mathPkg + ".Float64bits(" + f.name + ") != 0"
+	}
+	return f.name + " != 0"
+}
+
+// value returns the expression for the value of a singular field.
+func (f *fieldInfo) value() string {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_BYTES, pb.FieldDescriptorProto_TYPE_MESSAGE:
+	default:
+		if !f.proto3 {
+			return "*" + f.name
+		}
+	}
+	return f.name
+}
+
+// varint returns the uint64 to encode as a varint for the element v.
+func (f *fieldInfo) varint(v string) string {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_UINT64:
+		return v
+	case pb.FieldDescriptorProto_TYPE_SINT32:
+		return "uint64((uint32(" + v + ") << 1) ^ uint32(" + v + ">>31))"
+	case pb.FieldDescriptorProto_TYPE_SINT64:
+		return "(uint64(" + v + ") << 1) ^ uint64(" + v + ">>63)"
+	}
+	return "uint64(" + v + ")"
+}
+
+// bits returns the integer to encode little-endian for the element v of
+// a fixed-size field.
+func (g *fastpath) bits(f *fieldInfo, v string) string {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		return g.mathPkg + ".Float32bits(" + v + ")"
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return g.mathPkg + ".Float64bits(" + v + ")"
+	case pb.FieldDescriptorProto_TYPE_SFIXED32:
+		return "uint32(" + v + ")"
+	case pb.FieldDescriptorProto_TYPE_SFIXED64:
+		return "uint64(" + v + ")"
+	}
+	return v
+}
+
+// elemSize returns the expression for the encoded size of the element v,
+// without its key.
+func (g *fastpath) elemSize(f *fieldInfo, v string) string {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return "1"
+	case pb.FieldDescriptorProto_TYPE_MESSAGE:
+		return g.protoPkg + ".SizeVarint(uint64(" + g.protoPkg + ".Size(" + v + "))) + " + g.protoPkg + ".Size(" + v + ")"
+	case pb.FieldDescriptorProto_TYPE_STRING, pb.FieldDescriptorProto_TYPE_BYTES:
+		return g.protoPkg + ".SizeVarint(uint64(len(" + v + "))) + len(" + v + ")"
+	}
+	switch f.wire {
+	case proto.WireFixed32:
+		return "4"
+	case proto.WireFixed64:
+		return "8"
+	}
+	return g.protoPkg + ".SizeVarint(" + f.varint(v) + ")"
+}
+
+// generateSize generates the statements adding the size of f to n.
+func (g *fastpath) generateSize(f *fieldInfo) {
+	keySize := len(f.key())
+	switch {
+	case f.packed:
+		g.P("if len(", f.name, ") > 0 {")
+		switch {
+		case f.GetType() == pb.FieldDescriptorProto_TYPE_BOOL:
+			g.P("l := len(", f.name, ")")
+		case f.wire == proto.WireFixed32:
+			g.P("l := len(", f.name, ") * 4")
+		case f.wire == proto.WireFixed64:
+			g.P("l := len(", f.name, ") * 8")
+		default:
+			g.P("l := 0")
+			g.P("for _, e := range ", f.name, " {")
+			g.P("l += ", g.elemSize(f, "e"))
+			g.P("}")
+		}
+		g.P("n += ", keySize, " + ", g.protoPkg, ".SizeVarint(uint64(l)) + l")
+		g.P("}")
+	case f.repeated:
+		switch f.GetType() {
+		case pb.FieldDescriptorProto_TYPE_MESSAGE:
+			g.P("for _, e := range ", f.name, " {")
+			g.P("l := ", g.protoPkg, ".Size(e)")
+			g.P("n += ", keySize, " + ", g.protoPkg, ".SizeVarint(uint64(l)) + l")
+			g.P("}")
+		case pb.FieldDescriptorProto_TYPE_BOOL:
+			g.P("n += ", keySize+1, " * len(", f.name, ")")
+		default:
+			switch f.wire {
+			case proto.WireFixed32:
+				g.P("n += ", keySize+4, " * len(", f.name, ")")
+			case proto.WireFixed64:
+				g.P("n += ", keySize+8, " * len(", f.name, ")")
+			default:
+				g.P("for _, e := range ", f.name, " {")
+				g.P("n += ", keySize, " + ", g.elemSize(f, "e"))
+				g.P("}")
+			}
+		}
+	default:
+		g.P("if ", g.present(f), " {")
+		if f.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE {
+			g.P("l := ", g.protoPkg, ".Size(", f.name, ")")
+			g.P("n += ", keySize, " + ", g.protoPkg, ".SizeVarint(uint64(l)) + l")
+		} else {
+			g.P("n += ", keySize, " + ", g.elemSize(f, f.value()))
+		}
+		g.P("}")
+	}
+}
+
+// generateKey generates the statements writing the key of f before dAtA[i].
+func (g *fastpath) generateKey(f *fieldInfo) {
+	key := f.key()
+	if len(key) == 1 {
+		g.P("i--")
+	} else {
+		g.P("i -= ", len(key))
+	}
+	for j, b := range key {
+		index := "i"
+		if j > 0 {
+			index = fmt.Sprintf("i+%d", j)
+		}
+		g.P(fmt.Sprintf("dAtA[%s] = %#x", index, b))
+	}
+}
+
+// generateElem generates the statements writing the element v of f, but
+// not its key, before dAtA[i].
+func (g *fastpath) generateElem(f *fieldInfo, v string) {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		g.P("i--")
+		g.P("if ", v, " {")
+		g.P("dAtA[i] = 1")
+		g.P("} else {")
+		g.P("dAtA[i] = 0")
+		g.P("}")
+		return
+	case pb.FieldDescriptorProto_TYPE_MESSAGE:
+		g.P("n, err := ", g.protoPkg, ".MarshalToSizedBuffer(dAtA[:i], ", v, ")")
+		g.P("if err != nil {")
+		g.P("return 0, err")
+		g.P("}")
+		g.P("i -= n")
+		g.P("i = ", g.protoPkg, ".PrependVarint(dAtA, i, uint64(n))")
+		return
+	case pb.FieldDescriptorProto_TYPE_STRING, pb.FieldDescriptorProto_TYPE_BYTES:
+		g.P("i -= len(", v, ")")
+		g.P("copy(dAtA[i:], ", v, ")")
+		g.P("i = ", g.protoPkg, ".PrependVarint(dAtA, i, uint64(len(", v, ")))")
+		return
+	}
+	switch f.wire {
+	case proto.WireFixed32:
+		g.P("i -= 4")
+		g.P(g.binary(), ".LittleEndian.PutUint32(dAtA[i:], ", g.bits(f, v), ")")
+	case proto.WireFixed64:
+		g.P("i -= 8")
+		g.P(g.binary(), ".LittleEndian.PutUint64(dAtA[i:], ", g.bits(f, v), ")")
+	default:
+		g.P("i = ", g.protoPkg, ".PrependVarint(dAtA, i, ", f.varint(v), ")")
+	}
+}
+
+// generateMarshal generates the statements writing f before dAtA[i].
+func (g *fastpath) generateMarshal(f *fieldInfo) {
+	switch {
+	case f.packed:
+		g.P("if len(", f.name, ") > 0 {")
+		g.P("end := i")
+		g.P("for j := len(", f.name, ") - 1; j >= 0; j-- {")
+		g.generateElem(f, f.name+"[j]")
+		g.P("}")
+		g.P("i = ", g.protoPkg, ".PrependVarint(dAtA, i, uint64(end-i))")
+		g.generateKey(f)
+		g.P("}")
+	case f.repeated:
+		g.P("for j := len(", f.name, ") - 1; j >= 0; j-- {")
+		g.generateElem(f, f.name+"[j]")
+		g.generateKey(f)
+		g.P("}")
+	default:
+		g.P("if ", g.present(f), " {")
+		g.generateElem(f, f.value())
+		g.generateKey(f)
+		g.P("}")
+	}
+}
+
+// decoded returns the expression converting v, as returned by the
+// proto.Consume function for f's wire type, to an element of f.
+func (g *fastpath) decoded(f *fieldInfo, v string) string {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED32, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return v
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return v + " != 0"
+	case pb.FieldDescriptorProto_TYPE_SINT32:
+		return "int32(uint32(" + v + ")>>1) ^ -int32(" + v + "&1)"
+	case pb.FieldDescriptorProto_TYPE_SINT64:
+		return "int64(" + v + ">>1) ^ -int64(" + v + "&1)"
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		return g.mathPkg + ".Float32frombits(" + v + ")"
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return g.mathPkg + ".Float64frombits(" + v + ")"
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		return "append([]byte{}, " + v + "...)"
+	}
+	return f.typ + "(" + v + ")"
+}
+
+// consume returns the proto function decoding an element of f.
+func (g *fastpath) consume(f *fieldInfo) string {
+	switch f.wire {
+	case proto.WireFixed32:
+		return g.protoPkg + ".ConsumeFixed32"
+	case proto.WireFixed64:
+		return g.protoPkg + ".ConsumeFixed64"
+	case proto.WireBytes:
+		return g.protoPkg + ".ConsumeBytes"
+	}
+	return g.protoPkg + ".ConsumeVarint"
+}
+
+// wireNames are the names of the proto package's wire type constants.
+var wireNames = map[int]string{
+	proto.WireVarint:  "WireVarint",
+	proto.WireFixed64: "WireFixed64",
+	proto.WireBytes:   "WireBytes",
+	proto.WireFixed32: "WireFixed32",
+}
+
+// generateWireError generates the statement reporting a wire type that
+// f cannot have.
+func (g *fastpath) generateWireError(typeName string, f *fieldInfo) {
+	g.P("return ", g.fmtPkg, `.Errorf("proto: bad wiretype for field `, typeName, ".", f.name[len("m."):],
+		`: got wiretype %d, want `, f.wire, `", wire)`)
+}
+
+// generateConsume generates the statements decoding an element of f from
+// the start of buf, advancing the index i past it, and setting v to it.
+func (g *fastpath) generateConsume(f *fieldInfo, buf, i string) {
+	g.P("v, n, err := ", g.consume(f), "(", buf, ")")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P(i)
+}
+
+// generateUnmarshal generates the body of the case decoding f from
+// dAtA[i:], given the wire type wire of the key.
+func (g *fastpath) generateUnmarshal(typeName string, f *fieldInfo) {
+	if f.repeated && f.wire != proto.WireBytes {
+		// Accept elements packed or not, as the proto package does.
+		g.P("switch wire {")
+		g.P("case ", g.protoPkg, ".", wireNames[f.wire], ":")
+		g.generateConsume(f, "dAtA[i:]", "i += n")
+		g.P(f.name, " = append(", f.name, ", ", g.decoded(f, "v"), ")")
+		g.P("case ", g.protoPkg, ".WireBytes:")
+		g.P("b, n, err := ", g.protoPkg, ".ConsumeBytes(dAtA[i:])")
+		g.P("if err != nil {")
+		g.P("return err")
+		g.P("}")
+		g.P("i += n")
+		g.P("for len(b) > 0 {")
+		g.generateConsume(f, "b", "b = b[n:]")
+		g.P(f.name, " = append(", f.name, ", ", g.decoded(f, "v"), ")")
+		g.P("}")
+		g.P("default:")
+		g.generateWireError(typeName, f)
+		g.P("}")
+		return
+	}
+
+	g.P("if wire != ", g.protoPkg, ".", wireNames[f.wire], " {")
+	g.generateWireError(typeName, f)
+	g.P("}")
+	g.generateConsume(f, "dAtA[i:]", "i += n")
+	switch {
+	case f.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE && f.repeated:
+		g.P("e := &", f.typ, "{}")
+		g.P("if err := ", g.protoPkg, ".UnmarshalMergeDepth(v, e, maxDepth-1); err != nil {")
+		g.P("return err")
+		g.P("}")
+		g.P(f.name, " = append(", f.name, ", e)")
+	case f.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE:
+		g.P("if ", f.name, " == nil {")
+		g.P(f.name, " = &", f.typ, "{}")
+		g.P("}")
+		g.P("if err := ", g.protoPkg, ".UnmarshalMergeDepth(v, ", f.name, ", maxDepth-1); err != nil {")
+		g.P("return err")
+		g.P("}")
+	case f.repeated:
+		g.P(f.name, " = append(", f.name, ", ", g.decoded(f, "v"), ")")
+	case f.proto3 || f.GetType() == pb.FieldDescriptorProto_TYPE_BYTES:
+		g.P(f.name, " = ", g.decoded(f, "v"))
+	default:
+		g.P("x := ", g.decoded(f, "v"))
+		g.P(f.name, " = &x")
+	}
+}
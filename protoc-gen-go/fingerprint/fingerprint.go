@@ -0,0 +1,685 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package fingerprint outputs a Fingerprint method for messages, which
+// returns the 64-bit FNV-1a hash of the message's canonical encoding, so
+// that messages can serve as cache keys and deduplication tokens without
+// being marshaled first. It runs as a plugin for the Go protocol buffer
+// compiler plugin, enabled with plugins=fingerprint. It is linked in to
+// protoc-gen-go.
+//
+// m.Fingerprint() is the number proto.HashCanonical(m, fnv.New64a())
+// returns, read big-endian: fields in field number order, oneofs after
+// them, map entries sorted by key, and no unrecognized fields. The
+// generated code writes the encoding into the hash as it goes, and sizes
+// nested messages for their length prefixes with generated methods too.
+// Messages of types declared in other files are marshaled for their part.
+//
+// Messages with extension ranges, and those with a field or oneof named
+// fingerprint, get no method. Lazy fields are decoded first. Where
+// HashCanonical fails, Fingerprint hashes what the encoding would be: a
+// missing required field is left out, and a nil element of a repeated
+// message field counts as an empty message. Messages that marshal
+// themselves, such as those generated with the fastpath plugin, are
+// hashed as if they did not.
+package fingerprint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// New returns a new instance of the fingerprint plugin, for a generator created
+// with generator.NewWithPlugins.
+func New() generator.Plugin { return new(fingerprint) }
+
+// fingerprint is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates hashing methods.
+type fingerprint struct {
+	gen      *generator.Generator
+	file     *generator.FileDescriptor // The file being generated.
+	mathPkg  string                    // The name under which the file imports math.
+	protoPkg string                    // The name under which the file imports proto.
+}
+
+// Name returns the name of this plugin, "fingerprint".
+func (g *fingerprint) Name() string {
+	return "fingerprint"
+}
+
+// SetParam rejects all parameters; the fingerprint plugin has none.
+func (g *fingerprint) SetParam(key, value string) error {
+	return fmt.Errorf("unknown parameter %q", key)
+}
+
+// Init initializes the plugin.
+func (g *fingerprint) Init(gen *generator.Generator) {
+	g.gen = gen
+}
+
+// P forwards to g.gen.P.
+func (g *fingerprint) P(args ...interface{}) { g.gen.P(args...) }
+
+// Generate generates the methods for the messages in the given file.
+func (g *fingerprint) Generate(file *generator.FileDescriptor) {
+	g.file = file
+	g.mathPkg = g.gen.AddImport("math")
+	g.protoPkg = g.gen.AddImport("github.com/golang/protobuf/proto")
+
+	prefix := "."
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix += pkg + "."
+	}
+	for _, msg := range file.MessageType {
+		g.generateMessages(prefix+msg.GetName(), msg)
+	}
+}
+
+// GenerateImports does nothing; Generate adds its imports with AddImport.
+func (g *fingerprint) GenerateImports(file *generator.FileDescriptor) {}
+
+// generateMessages generates the methods for the message with the given
+// fully-qualified name, and for the messages nested in it.
+func (g *fingerprint) generateMessages(name string, msg *pb.DescriptorProto) {
+	if d, ok := g.gen.ObjectNamed(name).(*generator.Descriptor); ok && g.supported(d) {
+		g.generateMessage(d)
+	}
+	for _, nested := range msg.NestedType {
+		g.generateMessages(name+"."+nested.GetName(), nested)
+	}
+}
+
+// supported reports whether the methods can be generated for msg.
+func (g *fingerprint) supported(msg *generator.Descriptor) bool {
+	if msg.GetOptions().GetMapEntry() || len(msg.ExtensionRange) > 0 {
+		return false
+	}
+	for _, field := range msg.Field {
+		if msg.GoFieldName(field) == "Fingerprint" {
+			return false
+		}
+		// A group has no length prefix, so it cannot be marshaled on
+		// its own; it needs the methods too.
+		if field.GetType() == pb.FieldDescriptorProto_TYPE_GROUP && !g.hasMethods(field.GetTypeName()) {
+			return false
+		}
+	}
+	for i := range msg.OneofDecl {
+		if msg.GoOneofName(int32(i)) == "Fingerprint" {
+			return false
+		}
+	}
+	return true
+}
+
+// hasMethods reports whether the message with the given fully-qualified
+// name gets the methods generated along with the current file's.
+func (g *fingerprint) hasMethods(typeName string) bool {
+	d, ok := g.gen.ObjectNamed(typeName).(*generator.Descriptor)
+	return ok && d.File() == g.file.FileDescriptorProto && g.supported(d)
+}
+
+// fieldInfo is what the generated code needs to know about a field, or
+// about the key or value of a map entry.
+type fieldInfo struct {
+	*pb.FieldDescriptorProto
+	name     string // The field of the struct, such as "m.Name".
+	proto3   bool   // The field has no pointer to mark presence.
+	repeated bool
+	packed   bool
+	wire     int // The wire type of an element.
+}
+
+// newFieldInfo returns the fieldInfo of field, one of the fields of msg.
+func (g *fingerprint) newFieldInfo(msg *generator.Descriptor, field *pb.FieldDescriptorProto) *fieldInfo {
+	proto3 := msg.File().GetSyntax() == "proto3"
+	f := &fieldInfo{
+		FieldDescriptorProto: field,
+		name:                 "m." + msg.GoFieldName(field),
+		proto3:               proto3,
+		repeated:             field.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED,
+		wire:                 wireType(field.GetType()),
+	}
+	// Packed as the generator tags it: proto3 scalars unless
+	// [packed = false], proto2 ones only with [packed = true].
+	if f.repeated && f.wire != proto.WireBytes && f.wire != proto.WireStartGroup {
+		f.packed = field.GetOptions().GetPacked() || proto3 && (field.Options == nil || field.Options.Packed == nil)
+	}
+	return f
+}
+
+// byNumber sorts fields by field number.
+type byNumber []*fieldInfo
+
+func (s byNumber) Len() int           { return len(s) }
+func (s byNumber) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byNumber) Less(i, j int) bool { return s[i].GetNumber() < s[j].GetNumber() }
+
+// wireType returns the wire type of a value of the given type.
+func wireType(typ pb.FieldDescriptorProto_Type) int {
+	switch typ {
+	case pb.FieldDescriptorProto_TYPE_FIXED32,
+		pb.FieldDescriptorProto_TYPE_SFIXED32,
+		pb.FieldDescriptorProto_TYPE_FLOAT:
+		return proto.WireFixed32
+	case pb.FieldDescriptorProto_TYPE_FIXED64,
+		pb.FieldDescriptorProto_TYPE_SFIXED64,
+		pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return proto.WireFixed64
+	case pb.FieldDescriptorProto_TYPE_STRING,
+		pb.FieldDescriptorProto_TYPE_BYTES,
+		pb.FieldDescriptorProto_TYPE_MESSAGE:
+		return proto.WireBytes
+	case pb.FieldDescriptorProto_TYPE_GROUP:
+		return proto.WireStartGroup
+	}
+	return proto.WireVarint
+}
+
+// wireNames are the names of the wire type constants of package proto.
+var wireNames = map[int]string{
+	proto.WireVarint:     "WireVarint",
+	proto.WireFixed64:    "WireFixed64",
+	proto.WireBytes:      "WireBytes",
+	proto.WireStartGroup: "WireStartGroup",
+	proto.WireEndGroup:   "WireEndGroup",
+	proto.WireFixed32:    "WireFixed32",
+}
+
+// key returns the expression for the key of field number num with the
+// given wire type, and the size of its encoding.
+func (g *fingerprint) key(num int32, wire int) (string, int) {
+	return fmt.Sprintf("%d<<3|%s.%s", num, g.protoPkg, wireNames[wire]), proto.SizeVarint(uint64(num)<<3 | uint64(wire))
+}
+
+// elemKey returns the key of an element of f, or of its packed run.
+func (g *fingerprint) elemKey(f *fieldInfo) (string, int) {
+	if f.packed {
+		return g.key(f.GetNumber(), proto.WireBytes)
+	}
+	return g.key(f.GetNumber(), f.wire)
+}
+
+// generateMessage generates the methods for msg.
+func (g *fingerprint) generateMessage(msg *generator.Descriptor) {
+	typeName := g.gen.TypeName(msg)
+	var fields []*fieldInfo
+	for _, field := range msg.Field {
+		if field.OneofIndex == nil {
+			fields = append(fields, g.newFieldInfo(msg, field))
+		}
+		g.gen.RecordTypeUse(field.GetTypeName())
+	}
+	sort.Sort(byNumber(fields))
+	lazy := false
+	for _, field := range msg.Field {
+		lazy = lazy || g.gen.IsLazy(field)
+	}
+
+	g.P("// Fingerprint returns the FNV-1a hash of the canonical encoding of m,")
+	g.P("// the number proto.HashCanonical(m, fnv.New64a()) returns read big-endian,")
+	g.P("// without marshaling m.")
+	g.P("func (m *", typeName, ") Fingerprint() uint64 {")
+	g.P("return m.fingerprintTo(", g.protoPkg, ".FingerprintBasis)")
+	g.P("}")
+	g.P()
+
+	g.P("// fingerprintTo adds the canonical encoding of m to the fingerprint h.")
+	g.P("func (m *", typeName, ") fingerprintTo(h uint64) uint64 {")
+	g.P("if m == nil {")
+	g.P("return h")
+	g.P("}")
+	if lazy {
+		g.P(g.protoPkg, ".DecodeLazy(m)")
+	}
+	for _, f := range fields {
+		g.generateFieldHash(f)
+	}
+	for i := range msg.OneofDecl {
+		g.generateOneofHash(msg, int32(i))
+	}
+	g.P("return h")
+	g.P("}")
+	g.P()
+
+	g.P("// canonicalSize returns the size of the canonical encoding of m.")
+	g.P("func (m *", typeName, ") canonicalSize() (n int) {")
+	g.P("if m == nil {")
+	g.P("return 0")
+	g.P("}")
+	if lazy {
+		g.P(g.protoPkg, ".DecodeLazy(m)")
+	}
+	for _, f := range fields {
+		g.generateFieldSize(f)
+	}
+	for i := range msg.OneofDecl {
+		g.generateOneofSize(msg, int32(i))
+	}
+	g.P("return n")
+	g.P("}")
+	g.P()
+}
+
+// isMessage reports whether the field holds messages, not groups.
+func isMessage(field *pb.FieldDescriptorProto) bool {
+	return field.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE
+}
+
+// isGroup reports whether the field holds groups.
+func isGroup(field *pb.FieldDescriptorProto) bool {
+	return field.GetType() == pb.FieldDescriptorProto_TYPE_GROUP
+}
+
+// mapEntry returns the map entry message of the field, or nil if the
+// field is not a map.
+func (g *fingerprint) mapEntry(field *pb.FieldDescriptorProto) *generator.Descriptor {
+	if !isMessage(field) {
+		return nil
+	}
+	if d, ok := g.gen.ObjectNamed(field.GetTypeName()).(*generator.Descriptor); ok && d.GetOptions().GetMapEntry() {
+		return d
+	}
+	return nil
+}
+
+// present returns the condition under which a singular field is encoded:
+// a proto2 field when it is set, a proto3 one when it is not zero.
+func (g *fingerprint) present(f *fieldInfo) string {
+	if !f.proto3 || isMessage(f.FieldDescriptorProto) {
+		return f.name + " != nil"
+	}
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return f.name
+	case pb.FieldDescriptorProto_TYPE_STRING, pb.FieldDescriptorProto_TYPE_BYTES:
+		return "len(" + f.name + ") > 0"
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		// Compare the bits, so that -0 is encoded as the proto package does.
+		return g.mathPkg + ".Float32bits(" + f.name + ") != 0"
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return g.mathPkg + ".Float64bits(" + f.name + ") != 0"
+	}
+	return f.name + " != 0"
+}
+
+// value returns the expression for the value of a singular field.
+func (f *fieldInfo) value() string {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_BYTES, pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+	default:
+		if !f.proto3 {
+			return "*" + f.name
+		}
+	}
+	return f.name
+}
+
+// varint returns the uint64 to encode as a varint for the element v.
+func (f *fieldInfo) varint(v string) string {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_UINT64:
+		return v
+	case pb.FieldDescriptorProto_TYPE_SINT32:
+		return "uint64((uint32(" + v + ") << 1) ^ uint32(" + v + ">>31))"
+	case pb.FieldDescriptorProto_TYPE_SINT64:
+		return "(uint64(" + v + ") << 1) ^ uint64(" + v + ">>63)"
+	}
+	return "uint64(" + v + ")"
+}
+
+// bits returns the integer to encode little-endian for the element v of
+// a fixed-size field.
+func (g *fingerprint) bits(f *fieldInfo, v string) string {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		return g.mathPkg + ".Float32bits(" + v + ")"
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return g.mathPkg + ".Float64bits(" + v + ")"
+	case pb.FieldDescriptorProto_TYPE_SFIXED32:
+		return "uint32(" + v + ")"
+	case pb.FieldDescriptorProto_TYPE_SFIXED64:
+		return "uint64(" + v + ")"
+	}
+	return v
+}
+
+// generateElemHash generates the statements adding the element v of f,
+// without its key, to the fingerprint h. A group element is added
+// without its end key.
+func (g *fingerprint) generateElemHash(f *fieldInfo, v string) {
+	p := g.protoPkg
+	switch {
+	case f.GetType() == pb.FieldDescriptorProto_TYPE_BOOL:
+		g.P("h = ", p, ".FingerprintBool(h, ", v, ")")
+	case f.GetType() == pb.FieldDescriptorProto_TYPE_STRING:
+		g.P("h = ", p, ".FingerprintString(h, ", v, ")")
+	case f.GetType() == pb.FieldDescriptorProto_TYPE_BYTES:
+		g.P("h = ", p, ".FingerprintBytes(h, ", v, ")")
+	case isGroup(f.FieldDescriptorProto):
+		g.P("h = ", v, ".fingerprintTo(h)")
+	case isMessage(f.FieldDescriptorProto) && g.hasMethods(f.GetTypeName()):
+		g.P("h = ", p, ".FingerprintVarint(h, uint64(", v, ".canonicalSize()))")
+		g.P("h = ", v, ".fingerprintTo(h)")
+	case isMessage(f.FieldDescriptorProto):
+		g.P("h = ", p, ".FingerprintMessage(h, ", v, ")")
+	case f.wire == proto.WireFixed32:
+		g.P("h = ", p, ".FingerprintFixed32(h, ", g.bits(f, v), ")")
+	case f.wire == proto.WireFixed64:
+		g.P("h = ", p, ".FingerprintFixed64(h, ", g.bits(f, v), ")")
+	default:
+		g.P("h = ", p, ".FingerprintVarint(h, ", f.varint(v), ")")
+	}
+}
+
+// elemSize returns the expression for the encoded size of the element v
+// of f, without its key, or "" if f holds messages, whose size
+// generateElemSize computes with a statement of its own. The size of a
+// group element leaves out the end key.
+func (g *fingerprint) elemSize(f *fieldInfo, v string) string {
+	p := g.protoPkg
+	switch {
+	case f.GetType() == pb.FieldDescriptorProto_TYPE_BOOL:
+		return "1"
+	case f.GetType() == pb.FieldDescriptorProto_TYPE_STRING, f.GetType() == pb.FieldDescriptorProto_TYPE_BYTES:
+		return p + ".SizeVarint(uint64(len(" + v + "))) + len(" + v + ")"
+	case isGroup(f.FieldDescriptorProto):
+		return v + ".canonicalSize()"
+	case isMessage(f.FieldDescriptorProto):
+		return ""
+	case f.wire == proto.WireFixed32:
+		return "4"
+	case f.wire == proto.WireFixed64:
+		return "8"
+	}
+	return p + ".SizeVarint(" + f.varint(v) + ")"
+}
+
+// generateElemSize generates the statements adding keySize and the size
+// of the element v of f, without its key, to the variable n. Each call
+// must be in a block of its own, as it may declare l.
+func (g *fingerprint) generateElemSize(f *fieldInfo, v, n string, keySize int) {
+	if size := g.elemSize(f, v); size != "" {
+		g.P(n, " += ", keySize, " + ", size)
+		return
+	}
+	if g.hasMethods(f.GetTypeName()) {
+		g.P("l := ", v, ".canonicalSize()")
+	} else {
+		g.P("l := ", g.protoPkg, ".CanonicalSize(", v, ")")
+	}
+	g.P(n, " += ", keySize, " + ", g.protoPkg, ".SizeVarint(uint64(l)) + l")
+}
+
+// generateFieldHash generates the statements adding f, a field that is
+// not in a oneof, to the fingerprint h.
+func (g *fingerprint) generateFieldHash(f *fieldInfo) {
+	p := g.protoPkg
+	key, _ := g.elemKey(f)
+	endKey, _ := g.key(f.GetNumber(), proto.WireEndGroup)
+	switch {
+	case g.mapEntry(f.FieldDescriptorProto) != nil:
+		g.generateMapHash(f)
+	case f.packed:
+		g.P("if len(", f.name, ") > 0 {")
+		g.generatePackedSize(f)
+		g.P("h = ", p, ".FingerprintVarint(h, ", key, ")")
+		g.P("h = ", p, ".FingerprintVarint(h, uint64(l))")
+		g.P("for _, v := range ", f.name, " {")
+		g.generateElemHash(f, "v")
+		g.P("}")
+		g.P("}")
+	case f.repeated:
+		g.P("for _, v := range ", f.name, " {")
+		g.P("h = ", p, ".FingerprintVarint(h, ", key, ")")
+		g.generateElemHash(f, "v")
+		if isGroup(f.FieldDescriptorProto) {
+			g.P("h = ", p, ".FingerprintVarint(h, ", endKey, ")")
+		}
+		g.P("}")
+	default:
+		g.P("if ", g.present(f), " {")
+		g.P("h = ", p, ".FingerprintVarint(h, ", key, ")")
+		g.generateElemHash(f, f.value())
+		if isGroup(f.FieldDescriptorProto) {
+			g.P("h = ", p, ".FingerprintVarint(h, ", endKey, ")")
+		}
+		g.P("}")
+	}
+}
+
+// generateFieldSize generates the statements adding the size of f, a
+// field that is not in a oneof, to n.
+func (g *fingerprint) generateFieldSize(f *fieldInfo) {
+	p := g.protoPkg
+	_, keySize := g.elemKey(f)
+	if isGroup(f.FieldDescriptorProto) {
+		_, endSize := g.key(f.GetNumber(), proto.WireEndGroup)
+		keySize += endSize
+	}
+	switch {
+	case g.mapEntry(f.FieldDescriptorProto) != nil:
+		g.generateMapSize(f)
+	case f.packed:
+		g.P("if len(", f.name, ") > 0 {")
+		g.generatePackedSize(f)
+		g.P("n += ", keySize, " + ", p, ".SizeVarint(uint64(l)) + l")
+		g.P("}")
+	case f.repeated:
+		g.P("for _, v := range ", f.name, " {")
+		g.generateElemSize(f, "v", "n", keySize)
+		g.P("}")
+	default:
+		g.P("if ", g.present(f), " {")
+		g.generateElemSize(f, f.value(), "n", keySize)
+		g.P("}")
+	}
+}
+
+// fixedSize reports whether the elements of f all have the same size.
+func (g *fingerprint) fixedSize(f *fieldInfo) bool {
+	return f.GetType() == pb.FieldDescriptorProto_TYPE_BOOL || f.wire == proto.WireFixed32 || f.wire == proto.WireFixed64
+}
+
+// generatePackedSize generates the statements setting l to the size of
+// the elements of f, a packed field.
+func (g *fingerprint) generatePackedSize(f *fieldInfo) {
+	switch {
+	case f.GetType() == pb.FieldDescriptorProto_TYPE_BOOL:
+		g.P("l := len(", f.name, ")")
+	case f.wire == proto.WireFixed32:
+		g.P("l := len(", f.name, ") * 4")
+	case f.wire == proto.WireFixed64:
+		g.P("l := len(", f.name, ") * 8")
+	default:
+		g.P("l := 0")
+		g.P("for _, v := range ", f.name, " {")
+		g.P("l += ", g.elemSize(f, "v"))
+		g.P("}")
+	}
+}
+
+// mapFields returns the fieldInfos of the key and value of the map field
+// f, as the entry's variables k and v.
+func (g *fingerprint) mapFields(f *fieldInfo) (key, val *fieldInfo) {
+	entry := g.mapEntry(f.FieldDescriptorProto)
+	key, val = g.newFieldInfo(entry, entry.Field[0]), g.newFieldInfo(entry, entry.Field[1])
+	key.name, val.name = "k", "v"
+	// Keys and values are encoded even when they are zero, except for a
+	// nil message value or, as in fields, an empty proto3 bytes value.
+	key.proto3 = false
+	if val.GetType() != pb.FieldDescriptorProto_TYPE_BYTES {
+		val.proto3 = false
+	}
+	return key, val
+}
+
+// generateEntrySize generates the statements setting e to the size of the
+// map entry holding k and v.
+func (g *fingerprint) generateEntrySize(key, val *fieldInfo) {
+	_, keySize := g.key(1, key.wire)
+	_, valSize := g.key(2, val.wire)
+	g.P("e := ", keySize, " + ", g.elemSize(key, "k"))
+	switch val.GetType() {
+	case pb.FieldDescriptorProto_TYPE_BYTES, pb.FieldDescriptorProto_TYPE_MESSAGE:
+		g.P("if ", g.present(val), " {")
+		g.generateElemSize(val, "v", "e", valSize)
+		g.P("}")
+	default:
+		g.generateElemSize(val, "v", "e", valSize)
+	}
+}
+
+// generateMapHash generates the statements adding the entries of the map
+// field f to the fingerprint h, in order of key.
+func (g *fingerprint) generateMapHash(f *fieldInfo) {
+	p := g.protoPkg
+	key, val := g.mapFields(f)
+	entryKey, _ := g.elemKey(f)
+	keyKey, _ := g.key(1, key.wire)
+	valKey, _ := g.key(2, val.wire)
+	entry := g.mapEntry(f.FieldDescriptorProto)
+	keyType, _ := g.gen.GoType(entry, entry.Field[0])
+	keyType = strings.TrimPrefix(keyType, "*")
+
+	if keyType == "bool" {
+		g.P("for _, k := range []bool{false, true} {")
+		g.P("v, ok := ", f.name, "[k]")
+		g.P("if !ok {")
+		g.P("continue")
+		g.P("}")
+	} else {
+		g.P("if len(", f.name, ") > 0 {")
+		g.P("keys := make([]", keyType, ", 0, len(", f.name, "))")
+		g.P("for k := range ", f.name, " {")
+		g.P("keys = append(keys, k)")
+		g.P("}")
+		g.P(p, ".Sort", generator.CamelCase(keyType), "Keys(keys)")
+		g.P("for _, k := range keys {")
+		g.P("v := ", f.name, "[k]")
+	}
+	g.generateEntrySize(key, val)
+	g.P("h = ", p, ".FingerprintVarint(h, ", entryKey, ")")
+	g.P("h = ", p, ".FingerprintVarint(h, uint64(e))")
+	g.P("h = ", p, ".FingerprintVarint(h, ", keyKey, ")")
+	g.generateElemHash(key, "k")
+	switch val.GetType() {
+	case pb.FieldDescriptorProto_TYPE_BYTES, pb.FieldDescriptorProto_TYPE_MESSAGE:
+		g.P("if ", g.present(val), " {")
+		g.P("h = ", p, ".FingerprintVarint(h, ", valKey, ")")
+		g.generateElemHash(val, "v")
+		g.P("}")
+	default:
+		g.P("h = ", p, ".FingerprintVarint(h, ", valKey, ")")
+		g.generateElemHash(val, "v")
+	}
+	g.P("}")
+	if keyType != "bool" {
+		g.P("}")
+	}
+}
+
+// generateMapSize generates the statements adding the size of the map
+// field f to n.
+func (g *fingerprint) generateMapSize(f *fieldInfo) {
+	key, val := g.mapFields(f)
+	_, entryKeySize := g.elemKey(f)
+	// The size of a fixed-size key or value does not depend on it.
+	switch {
+	case g.fixedSize(key) && g.fixedSize(val):
+		g.P("for range ", f.name, " {")
+	case g.fixedSize(val):
+		g.P("for k := range ", f.name, " {")
+	case g.fixedSize(key):
+		g.P("for _, v := range ", f.name, " {")
+	default:
+		g.P("for k, v := range ", f.name, " {")
+	}
+	g.generateEntrySize(key, val)
+	g.P("n += ", entryKeySize, " + ", g.protoPkg, ".SizeVarint(uint64(e)) + e")
+	g.P("}")
+}
+
+// oneofFields returns the fieldInfos of the fields in the oneof of msg
+// with the given index, as the variable x of a type switch.
+func (g *fingerprint) oneofFields(msg *generator.Descriptor, index int32) []*fieldInfo {
+	var fields []*fieldInfo
+	for _, field := range msg.Field {
+		if field.OneofIndex == nil || *field.OneofIndex != index {
+			continue
+		}
+		f := g.newFieldInfo(msg, field)
+		f.name = "x." + msg.GoFieldName(field)
+		f.proto3 = true // The value is not a pointer.
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// generateOneofHash generates the statements adding the oneof of msg with
+// the given index to the fingerprint h. The field that is set is encoded
+// whatever its value, as the generated oneof marshaler does.
+func (g *fingerprint) generateOneofHash(msg *generator.Descriptor, index int32) {
+	p := g.protoPkg
+	g.P("switch x := m.", msg.GoOneofName(index), ".(type) {")
+	for _, f := range g.oneofFields(msg, index) {
+		key, _ := g.key(f.GetNumber(), f.wire)
+		g.P("case *", msg.GoOneofTypeName(f.FieldDescriptorProto), ":")
+		g.P("h = ", p, ".FingerprintVarint(h, ", key, ")")
+		g.generateElemHash(f, f.name)
+		if isGroup(f.FieldDescriptorProto) {
+			endKey, _ := g.key(f.GetNumber(), proto.WireEndGroup)
+			g.P("h = ", p, ".FingerprintVarint(h, ", endKey, ")")
+		}
+	}
+	g.P("}")
+}
+
+// generateOneofSize generates the statements adding the size of the oneof
+// of msg with the given index to n.
+func (g *fingerprint) generateOneofSize(msg *generator.Descriptor, index int32) {
+	g.P("switch x := m.", msg.GoOneofName(index), ".(type) {")
+	for _, f := range g.oneofFields(msg, index) {
+		_, keySize := g.key(f.GetNumber(), f.wire)
+		if isGroup(f.FieldDescriptorProto) {
+			_, endSize := g.key(f.GetNumber(), proto.WireEndGroup)
+			keySize += endSize
+		}
+		g.P("case *", msg.GoOneofTypeName(f.FieldDescriptorProto), ":")
+		g.generateElemSize(f, f.name, "n", keySize)
+	}
+	g.P("}")
+}
@@ -0,0 +1,219 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+// The output cache, enabled with cache_dir=dir, keeps the files generated
+// for each input file under a hash of everything the output depends on:
+// the generator binary, the parameter, the input file and the files it
+// imports, directly or not, and the names and Go packages of the other
+// files generated with it. A file whose hash is in the cache is not
+// generated again; its output is read from the cache instead, so that
+// regenerating a large tree only does the work for the files that changed
+// or whose imports did.
+//
+// The files the plugins add to the response for a package, rather than
+// for one input file, are generated on every run. Entries are never
+// removed; the directory can be deleted at any time.
+
+// cacheKeys returns the cache key of each file to generate, or nil if the
+// generator binary cannot be found to be hashed into them.
+func (g *Generator) cacheKeys() map[*FileDescriptor]string {
+	if g.cacheID == "" {
+		id, err := executableHash()
+		if err != nil {
+			log.Printf("protoc-gen-go: WARNING: not using the output cache: %v", err)
+			return nil
+		}
+		g.cacheID = id
+	}
+
+	// What the output of a file takes from the others generated with it.
+	siblings := sha256.New()
+	for _, file := range g.genFiles {
+		impPath, pkg, _ := file.goPackageOption()
+		writeStrings(siblings, file.GetName(), file.GetPackage(), impPath, pkg)
+	}
+
+	var params []string
+	for k, v := range g.Param {
+		params = append(params, k, v)
+	}
+	sort.Sort(paramPairs(params))
+
+	keys := make(map[*FileDescriptor]string)
+	for _, file := range g.genFiles {
+		h := sha256.New()
+		writeStrings(h, g.cacheID)
+		writeStrings(h, params...)
+		h.Write(siblings.Sum(nil))
+		for _, dep := range g.cacheDeps(file) {
+			b, err := proto.Marshal(dep.FileDescriptorProto)
+			if err != nil {
+				return nil
+			}
+			writeStrings(h, dep.GetName(), string(b))
+		}
+		keys[file] = hex.EncodeToString(h.Sum(nil))
+	}
+	return keys
+}
+
+// cacheDeps returns file and the files it imports, directly or not, in
+// order of name.
+func (g *Generator) cacheDeps(file *FileDescriptor) []*FileDescriptor {
+	seen := make(map[string]bool)
+	var names []string
+	var visit func(name string)
+	visit = func(name string) {
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+		names = append(names, name)
+		for _, dep := range g.fileByName(name).Dependency {
+			visit(dep)
+		}
+	}
+	visit(file.GetName())
+	sort.Strings(names)
+	deps := make([]*FileDescriptor, len(names))
+	for i, name := range names {
+		deps[i] = g.fileByName(name)
+	}
+	return deps
+}
+
+// paramPairs sorts a list of parameter names and values by name.
+type paramPairs []string
+
+func (p paramPairs) Len() int           { return len(p) / 2 }
+func (p paramPairs) Less(i, j int) bool { return p[2*i] < p[2*j] }
+func (p paramPairs) Swap(i, j int) {
+	p[2*i], p[2*j] = p[2*j], p[2*i]
+	p[2*i+1], p[2*j+1] = p[2*j+1], p[2*i+1]
+}
+
+// writeStrings writes each of ss to w, preceded by its length, so that
+// the strings cannot run together.
+func writeStrings(w io.Writer, ss ...string) {
+	for _, s := range ss {
+		var n [8]byte
+		for i := range n {
+			n[i] = byte(uint64(len(s)) >> uint(8*i))
+		}
+		w.Write(n[:])
+		io.WriteString(w, s)
+	}
+}
+
+// executableHash returns a hash of the running program, so that a new
+// build of the generator or its plugins does not use outputs cached by an
+// old one.
+func executableHash() (string, error) {
+	path, err := exec.LookPath(os.Args[0])
+	if err != nil {
+		return "", err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// loadCached returns the files cached under key, if any.
+func (g *Generator) loadCached(key string) []*plugin.CodeGeneratorResponse_File {
+	b, err := ioutil.ReadFile(filepath.Join(g.cacheDir, key))
+	if err != nil {
+		return nil
+	}
+	resp := new(plugin.CodeGeneratorResponse)
+	if err := proto.Unmarshal(b, resp); err != nil || len(resp.File) == 0 {
+		return nil
+	}
+	return resp.File
+}
+
+// storeCached caches files under key. A failure only costs the next run
+// the time to generate them again, so it is logged and otherwise ignored.
+func (g *Generator) storeCached(key string, files []*plugin.CodeGeneratorResponse_File) {
+	b, err := proto.Marshal(&plugin.CodeGeneratorResponse{File: files})
+	if err == nil {
+		err = writeFileAtomic(filepath.Join(g.cacheDir, key), b)
+	}
+	if err != nil {
+		log.Printf("protoc-gen-go: WARNING: caching output: %v", err)
+	}
+}
+
+// writeFileAtomic writes b to name through a temporary file, so that
+// generators running at once never read a partly written entry.
+func writeFileAtomic(name string, b []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(name), ".tmp-")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), name)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
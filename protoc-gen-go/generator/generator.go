@@ -40,15 +40,21 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/format"
 	"go/parser"
-	"go/printer"
+	"go/scanner"
 	"go/token"
 	"log"
 	"os"
 	"path"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 	"unicode/utf8"
 
@@ -69,23 +75,30 @@ const generatedCodeVersion = 2
 type Plugin interface {
 	// Name identifies the plugin.
 	Name() string
+	// SetParam is called, before Init, for each parameter given to the
+	// plugin as name:key=value, where name is the plugin's Name.
+	// An error aborts generation.
+	SetParam(key, value string) error
 	// Init is called once after data structures are built but before
 	// code generation begins.
 	Init(g *Generator)
 	// Generate produces the code generated by the plugin for this file,
 	// except for the imports, by calling the generator's methods P, In, and Out.
+	// Packages it refers to should be imported with the generator's AddImport.
 	Generate(file *FileDescriptor)
-	// GenerateImports produces the import declarations for this file.
-	// It is called after Generate.
+	// GenerateImports produces any further import declarations for this file.
+	// It is called after Generate. Plugins that use AddImport need none.
 	GenerateImports(file *FileDescriptor)
 }
 
-var plugins []Plugin
-
-// RegisterPlugin installs a (second-order) plugin to be run when the Go output is generated.
-// It is typically called during initialization.
-func RegisterPlugin(p Plugin) {
-	plugins = append(plugins, p)
+// An OrderedPlugin is a Plugin that must run after certain other plugins.
+// Plugins always run after the generator has produced the message code
+// for a file, so only constraints between plugins need declaring.
+type OrderedPlugin interface {
+	Plugin
+	// RunAfter returns the names of the plugins that must run before this one.
+	// Names of plugins that are not enabled are ignored.
+	RunAfter() []string
 }
 
 // Each type we import as a protocol buffer (other than FileDescriptorProto) needs
@@ -96,11 +109,12 @@ func RegisterPlugin(p Plugin) {
 
 // The file and package name method are common to messages and enums.
 type common struct {
-	file *descriptor.FileDescriptorProto // File this object comes from.
+	file  *descriptor.FileDescriptorProto // File this object comes from.
+	names packageNames                    // The generator's package names.
 }
 
 // PackageName is name in the package clause in the generated file.
-func (c *common) PackageName() string { return uniquePackageOf(c.file) }
+func (c *common) PackageName() string { return c.names.of(c.file) }
 
 func (c *common) File() *descriptor.FileDescriptorProto { return c.file }
 
@@ -122,6 +136,7 @@ type Descriptor struct {
 	index    int                    // The index into the container, whether the file or another message.
 	path     string                 // The SourceCodeInfo path as comma-separated integers.
 	group    bool
+	names    *goNames // Cached Go names of the fields; see goNames.
 }
 
 // TypeName returns the elements of the dotted type name.
@@ -248,9 +263,13 @@ type FileDescriptor struct {
 	ext  []*ExtensionDescriptor // All the top-level extensions defined in this file.
 	imp  []*ImportedDescriptor  // All types defined in files publicly imported by this file.
 
-	// Comments, stored as a map of path (comma-separated integers) to the comment.
+	// Comments, stored as a map of path (comma-separated integers) to the
+	// location holding them. Locations without comments are left out.
 	comments map[string]*descriptor.SourceCodeInfo_Location
 
+	// Source spans of all elements, keyed by path like comments.
+	spans map[string][]int32
+
 	// The full list of symbols that are exported,
 	// as a map from the exported object to its symbols.
 	// This is used for supporting public imports.
@@ -259,16 +278,34 @@ type FileDescriptor struct {
 	index int // The index of this file in the list of files to generate code for
 
 	proto3 bool // whether to generate proto3 code for this file
+
+	names packageNames // The generator's package names.
 }
 
 // PackageName is the package name we'll use in the generated code to refer to this file.
-func (d *FileDescriptor) PackageName() string { return uniquePackageOf(d.FileDescriptorProto) }
+func (d *FileDescriptor) PackageName() string { return d.names.of(d.FileDescriptorProto) }
+
+// Position returns the position of the element at path in the file, as
+// file:line:col, or just the file name if the position is unknown. The
+// path is a comma-separated list of integers, as for PrintComments.
+func (d *FileDescriptor) Position(path string) string {
+	pos := d.GetName()
+	if span := d.spans[path]; len(span) >= 2 {
+		pos += fmt.Sprintf(":%d:%d", span[0]+1, span[1]+1)
+	}
+	return pos
+}
 
 // VarName is the variable name we'll use in the generated code to refer
 // to the compressed bytes of this descriptor. It is not exported, so
 // it is only valid inside the generated package.
 func (d *FileDescriptor) VarName() string { return fmt.Sprintf("fileDescriptor%d", d.index) }
 
+// SetVarName is the variable name used in the generated code for the
+// compressed FileDescriptorSet of this file and its imports, which is
+// generated with descriptor_set=true. Like VarName, it is not exported.
+func (d *FileDescriptor) SetVarName() string { return fmt.Sprintf("fileDescriptorSet%d", d.index) }
+
 // goPackageOption interprets the file's go_package option.
 // If there is no go_package, it returns ("", "", false).
 // If there's a simple name, it returns ("", pkg, true).
@@ -314,13 +351,19 @@ func (d *FileDescriptor) goPackageName() (name string, explicit bool) {
 }
 
 // goFileName returns the output name for the generated Go file.
-func (d *FileDescriptor) goFileName() string {
+// With pathTypeSourceRelative the name is derived from the .proto file name
+// alone; otherwise the go_package import path, if any, decides the directory.
+func (d *FileDescriptor) goFileName(pathType pathType) string {
 	name := *d.Name
 	if ext := path.Ext(name); ext == ".proto" || ext == ".protodevel" {
 		name = name[:len(name)-len(ext)]
 	}
 	name += ".pb.go"
 
+	if pathType == pathTypeSourceRelative {
+		return name
+	}
+
 	// Does the file have a "go_package" option?
 	// If it does, it may override the filename.
 	if impPath, _, ok := d.goPackageOption(); ok && impPath != "" {
@@ -538,16 +581,28 @@ type Object interface {
 
 // Each package name we generate must be unique. The package we're generating
 // gets its own name but every other package must have a unique name that does
-// not conflict in the code we generate.  These names are chosen globally (although
-// they don't have to be, it simplifies things to do them globally).
-func uniquePackageOf(fd *descriptor.FileDescriptorProto) string {
-	s, ok := uniquePackageName[fd]
+// not conflict in the code we generate.  These names are chosen for the whole
+// run of a Generator (although they don't have to be, it simplifies things).
+//
+// For each input file, the unique package name to use, underscored.
+type packageNames map[*descriptor.FileDescriptorProto]string
+
+func (names packageNames) of(fd *descriptor.FileDescriptorProto) string {
+	s, ok := names[fd]
 	if !ok {
 		log.Fatal("internal error: no package name defined for " + fd.GetName())
 	}
 	return s
 }
 
+// pathType says how output file names are derived.
+type pathType int
+
+const (
+	pathTypeImport         pathType = iota // directory from the go_package import path (default)
+	pathTypeSourceRelative                 // next to the .proto file
+)
+
 // Generator is the type whose methods generate the output, stored in the associated response structure.
 type Generator struct {
 	*bytes.Buffer
@@ -562,41 +617,169 @@ type Generator struct {
 
 	Pkg map[string]string // The names under which we import support packages
 
+	// PluginOutput, if not empty, makes the generator write only the code
+	// its plugins generate, to go alongside the output of a stock
+	// protoc-gen-go: the code for x.proto goes in x_<PluginOutput>.pb.go,
+	// and files the plugins generate nothing for are left out. All the
+	// generator's plugins are enabled unless the plugins parameter says
+	// otherwise.
+	PluginOutput string
+
+	pathType     pathType // How output file names are derived; set by paths=.
+	module       string   // Import path prefix trimmed from output file names; set by module=.
+	annotateCode bool     // Whether to write .meta files; set by annotate_code=true.
+	goimports    bool     // Whether to remove unused imports; set by format=goimports.
+	separate     bool     // Whether each plugin's code gets its own file; set by separate_files=true.
+	lazy         bool     // Whether [lazy = true] fields are decoded on first use; set by lazy_unmarshal=true.
+	oneofCase    bool     // Whether to generate Which<Oneof> methods and wrapper getters; set by oneof_case=true.
+	enumHelpers  bool     // Whether to generate Parse<Enum>, <Enum>Values and text methods; set by enum_helpers=true.
+	fieldConsts  bool     // Whether to generate field number constants and name maps; set by field_constants=true.
+	descSet      bool     // Whether to embed a FileDescriptorSet of each file and its imports; set by descriptor_set=true.
+	mapHelpers   bool     // Whether to generate sorted iteration and defaulting getters for map fields; set by map_helpers=true.
+	cacheDir     string   // Where to cache the output of each file; set by cache_dir=dir. See cache.go.
+	cacheID      string   // Hash of the generator binary, for the cache keys.
+
+	newPlugins        []func() Plugin // Make the plugins for each run; see NewWithPlugins.
+	plugins           []Plugin        // The plugins, enabled by the plugins parameter.
+	uniquePackageName packageNames    // See RegisterUniquePackageName.
+	pkgNamesInUse     map[string]bool // Package names already registered.
+	inRun             bool            // Whether Error and Fail panic with a failure, for Run.
+
 	packageName      string                     // What we're calling ourselves.
 	allFiles         []*FileDescriptor          // All files in the tree
 	allFilesByName   map[string]*FileDescriptor // All files by filename.
 	genFiles         []*FileDescriptor          // Those files we will generate output for.
 	file             *FileDescriptor            // The file we are compiling now.
 	usedPackages     map[string]bool            // Names of packages used in current file.
+	addedImports     map[string]bool            // Import paths added with AddImport for the current file.
+	importNames      map[string]string          // Names chosen by AddImport, by import path.
 	typeNameToObject map[string]Object          // Key is a fully-qualified name in input syntax.
 	init             []string                   // Lines to emit in the init function.
 	indent           string
 	writeOutput      bool
+	part             outputPart // The part of the current file's output being generated.
+	diagnostics      []string   // Problems reported with Errorf.
+
+	// Annotations of the current file, with offsets into g.Buffer.
+	annotations []*descriptor.GeneratedCodeInfo_Annotation
+
+	// Fields marked with SetSensitive.
+	sensitive map[*descriptor.FieldDescriptorProto]bool
+	// Struct tags added with AddFieldTags.
+	fieldTags map[*descriptor.FieldDescriptorProto]string
 }
 
-// New creates a new generator and allocates the request and response protobufs.
+// New creates a new generator without plugins and allocates the request and response protobufs.
 func New() *Generator {
+	return NewWithPlugins()
+}
+
+// NewWithPlugins is like New, but the generator has the plugins made by
+// newPlugins, such as carno.New, which the plugins parameter selects among.
+// There is no registry of plugins shared by generators: each one makes its
+// own instances, so generators with the same plugins can run concurrently.
+func NewWithPlugins(newPlugins ...func() Plugin) *Generator {
 	g := new(Generator)
-	g.Buffer = new(bytes.Buffer)
 	g.Request = new(plugin.CodeGeneratorRequest)
-	g.Response = new(plugin.CodeGeneratorResponse)
+	g.newPlugins = append([]func() Plugin(nil), newPlugins...)
+	g.reset()
 	return g
 }
 
+// reset readies g to generate code, with new instances of its plugins and
+// none of the state of an earlier run. It keeps the request and PluginOutput.
+func (g *Generator) reset() {
+	*g = Generator{
+		Buffer:            new(bytes.Buffer),
+		Request:           g.Request,
+		Response:          new(plugin.CodeGeneratorResponse),
+		PluginOutput:      g.PluginOutput,
+		newPlugins:        g.newPlugins,
+		uniquePackageName: make(packageNames),
+		pkgNamesInUse:     make(map[string]bool),
+	}
+	for _, newPlugin := range g.newPlugins {
+		g.plugins = append(g.plugins, newPlugin())
+	}
+}
+
 // Error reports a problem, including an error, and exits the program.
+// Within Run, it makes Run return the problem instead.
 func (g *Generator) Error(err error, msgs ...string) {
-	s := strings.Join(msgs, " ") + ":" + err.Error()
-	log.Print("protoc-gen-go: error:", s)
-	os.Exit(1)
+	g.fail(strings.Join(msgs, " ") + ":" + err.Error())
 }
 
 // Fail reports a problem and exits the program.
+// Within Run, it makes Run return the problem instead.
 func (g *Generator) Fail(msgs ...string) {
-	s := strings.Join(msgs, " ")
+	g.fail(strings.Join(msgs, " "))
+}
+
+// A failure is a problem reported with Error or Fail within Run.
+type failure string
+
+func (f failure) Error() string { return "protoc-gen-go: " + string(f) }
+
+func (g *Generator) fail(s string) {
+	if g.inRun {
+		panic(failure(s))
+	}
 	log.Print("protoc-gen-go: error:", s)
 	os.Exit(1)
 }
 
+// Run generates the code for g.Request into g.Response, as protoc-gen-go does:
+// it calls CommandLineParameters with the request's parameter, then WrapTypes,
+// SetPackageNames, BuildTypeNameMap and GenerateAllFiles. Problems with the
+// input are reported in g.Response.Error, as for protoc. If the generator
+// would otherwise exit, as on a bad parameter, Run returns the error instead.
+//
+// Each run starts afresh, with a new g.Response and new instances of the
+// plugins, so a generator can be run again for another request. Only
+// g.Request and g.PluginOutput carry over. A generator must not be used by
+// more than one goroutine at a time, but generators can run concurrently.
+func (g *Generator) Run() (err error) {
+	g.reset()
+	g.inRun = true
+	defer func() {
+		g.inRun = false
+		if e := recover(); e != nil {
+			f, ok := e.(failure)
+			if !ok {
+				panic(e)
+			}
+			err = f
+		}
+	}()
+	g.CommandLineParameters(g.Request.GetParameter())
+	g.WrapTypes()
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	g.GenerateAllFiles()
+	return nil
+}
+
+// Errorf records a problem with the element at path in the current file,
+// such as an invalid option, and carries on so that every problem is found
+// in one run. The path is a comma-separated list of integers, as for
+// PrintComments, or "" for the file as a whole.
+//
+// Once all files are generated, the problems are returned to protoc
+// together, each prefixed with its file, line and column, and no files are
+// written. Problems in files that are only imported are not reported.
+func (g *Generator) Errorf(path, format string, args ...interface{}) {
+	if !g.writeOutput {
+		return
+	}
+	msg := g.file.Position(path) + ": " + fmt.Sprintf(format, args...)
+	for _, d := range g.diagnostics {
+		if d == msg {
+			return
+		}
+	}
+	g.diagnostics = append(g.diagnostics, msg)
+}
+
 // CommandLineParameters breaks the comma-separated list of key=value pairs
 // in the parameter (a member of the request protobuf) into a key/value map.
 // It then sets file name mappings defined by those entries.
@@ -612,7 +795,17 @@ func (g *Generator) CommandLineParameters(parameter string) {
 
 	g.ImportMap = make(map[string]string)
 	pluginList := "none" // Default list of plugin names to enable (empty means all).
-	for k, v := range g.Param {
+	if g.PluginOutput != "" {
+		pluginList = ""
+	}
+	// In key order, so that of several bad parameters the same one is reported each run.
+	var keys []string
+	for k := range g.Param {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := g.Param[k]
 		switch k {
 		case "import_prefix":
 			g.ImportPrefix = v
@@ -620,12 +813,113 @@ func (g *Generator) CommandLineParameters(parameter string) {
 			g.PackageImportPath = v
 		case "plugins":
 			pluginList = v
+		case "paths":
+			switch v {
+			case "import":
+				g.pathType = pathTypeImport
+			case "source_relative":
+				g.pathType = pathTypeSourceRelative
+			default:
+				g.Fail(fmt.Sprintf(`unknown path type %q: want "import" or "source_relative"`, v))
+			}
+		case "module":
+			g.module = v
+		case "annotate_code":
+			switch v {
+			case "true":
+				g.annotateCode = true
+			case "false":
+				g.annotateCode = false
+			default:
+				g.Fail(fmt.Sprintf(`bad value for annotate_code %q: want "true" or "false"`, v))
+			}
+		case "separate_files":
+			switch v {
+			case "true":
+				g.separate = true
+			case "false":
+				g.separate = false
+			default:
+				g.Fail(fmt.Sprintf(`bad value for separate_files %q: want "true" or "false"`, v))
+			}
+		case "lazy_unmarshal":
+			switch v {
+			case "true":
+				g.lazy = true
+			case "false":
+				g.lazy = false
+			default:
+				g.Fail(fmt.Sprintf(`bad value for lazy_unmarshal %q: want "true" or "false"`, v))
+			}
+		case "oneof_case":
+			switch v {
+			case "true":
+				g.oneofCase = true
+			case "false":
+				g.oneofCase = false
+			default:
+				g.Fail(fmt.Sprintf(`bad value for oneof_case %q: want "true" or "false"`, v))
+			}
+		case "enum_helpers":
+			switch v {
+			case "true":
+				g.enumHelpers = true
+			case "false":
+				g.enumHelpers = false
+			default:
+				g.Fail(fmt.Sprintf(`bad value for enum_helpers %q: want "true" or "false"`, v))
+			}
+		case "field_constants":
+			switch v {
+			case "true":
+				g.fieldConsts = true
+			case "false":
+				g.fieldConsts = false
+			default:
+				g.Fail(fmt.Sprintf(`bad value for field_constants %q: want "true" or "false"`, v))
+			}
+		case "descriptor_set":
+			switch v {
+			case "true":
+				g.descSet = true
+			case "false":
+				g.descSet = false
+			default:
+				g.Fail(fmt.Sprintf(`bad value for descriptor_set %q: want "true" or "false"`, v))
+			}
+		case "map_helpers":
+			switch v {
+			case "true":
+				g.mapHelpers = true
+			case "false":
+				g.mapHelpers = false
+			default:
+				g.Fail(fmt.Sprintf(`bad value for map_helpers %q: want "true" or "false"`, v))
+			}
+		case "cache_dir":
+			g.cacheDir = v
+		case "format":
+			switch v {
+			case "gofmt":
+				g.goimports = false
+			case "goimports":
+				g.goimports = true
+			default:
+				g.Fail(fmt.Sprintf(`unknown format %q: want "gofmt" or "goimports"`, v))
+			}
 		default:
+			if i := strings.Index(k, ":"); i > 0 {
+				// Namespaced parameter for a single plugin; delivered below.
+				continue
+			}
 			if len(k) > 0 && k[0] == 'M' {
 				g.ImportMap[k[1:]] = v
 			}
 		}
 	}
+	if g.module != "" && g.pathType == pathTypeSourceRelative {
+		g.Fail("module= cannot be used with paths=source_relative")
+	}
 	if pluginList != "" {
 		// Amend the set of plugins.
 		enabled := make(map[string]bool)
@@ -633,12 +927,122 @@ func (g *Generator) CommandLineParameters(parameter string) {
 			enabled[name] = true
 		}
 		var nplugins []Plugin
-		for _, p := range plugins {
+		for _, p := range g.plugins {
 			if enabled[p.Name()] {
 				nplugins = append(nplugins, p)
 			}
 		}
-		plugins = nplugins
+		g.plugins = nplugins
+	}
+	ordered, err := orderPlugins(g.plugins)
+	if err != nil {
+		g.Fail(err.Error())
+	}
+	g.plugins = ordered
+	g.setPluginParams()
+}
+
+// orderPlugins sorts the plugins so that each one runs after the plugins
+// named by its RunAfter method. Among plugins that are ready to run, the
+// one with the smallest name goes first, so the order never depends on
+// registration order. It returns an error if the constraints form a cycle.
+func orderPlugins(ps []Plugin) ([]Plugin, error) {
+	enabled := make(map[string]bool)
+	for _, p := range ps {
+		enabled[p.Name()] = true
+	}
+	after := make(map[string][]string)
+	for _, p := range ps {
+		op, ok := p.(OrderedPlugin)
+		if !ok {
+			continue
+		}
+		for _, name := range op.RunAfter() {
+			if enabled[name] {
+				after[p.Name()] = append(after[p.Name()], name)
+			}
+		}
+	}
+
+	done := make(map[string]bool)
+	ready := func(p Plugin) bool {
+		for _, name := range after[p.Name()] {
+			if !done[name] {
+				return false
+			}
+		}
+		return true
+	}
+	var out []Plugin
+	for len(out) < len(ps) {
+		var next Plugin
+		for _, p := range ps {
+			if done[p.Name()] || !ready(p) {
+				continue
+			}
+			if next == nil || p.Name() < next.Name() {
+				next = p
+			}
+		}
+		if next == nil {
+			return nil, pluginCycle(ps, after, done)
+		}
+		done[next.Name()] = true
+		out = append(out, next)
+	}
+	return out, nil
+}
+
+// pluginCycle describes a cycle among the plugins that orderPlugins could not place.
+func pluginCycle(ps []Plugin, after map[string][]string, done map[string]bool) error {
+	// Every unplaced plugin waits on another unplaced plugin,
+	// so following those edges must eventually revisit a plugin.
+	var name string
+	for _, p := range ps {
+		if !done[p.Name()] {
+			name = p.Name()
+			break
+		}
+	}
+	seen := make(map[string]int)
+	var path []string
+	for {
+		if i, ok := seen[name]; ok {
+			path = append(path[i:], name)
+			return fmt.Errorf("plugin ordering cycle: %s", strings.Join(path, " -> "))
+		}
+		seen[name] = len(path)
+		path = append(path, name)
+		for _, dep := range after[name] {
+			if !done[dep] {
+				name = dep
+				break
+			}
+		}
+	}
+}
+
+// setPluginParams hands each enabled plugin the parameters namespaced to it,
+// in key order. Parameters for plugins that are not enabled are ignored.
+func (g *Generator) setPluginParams() {
+	var keys []string
+	for k := range g.Param {
+		if strings.Index(k, ":") > 0 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		i := strings.Index(k, ":")
+		name, key := k[:i], k[i+1:]
+		for _, p := range g.plugins {
+			if p.Name() != name {
+				continue
+			}
+			if err := p.SetParam(key, g.Param[k]); err != nil {
+				g.Error(err, "plugin", name, "parameter", key)
+			}
+		}
 	}
 }
 
@@ -650,31 +1054,41 @@ func (g *Generator) DefaultPackageName(obj Object) string {
 	if pkg == g.packageName {
 		return ""
 	}
+	// An M parameter may put the file defining obj in our own Go package.
+	if g.file != nil {
+		if fd := g.fileByName(obj.File().GetName()); fd != nil && g.GoImportPath(fd) == g.GoImportPath(g.file) {
+			return ""
+		}
+	}
 	return pkg + "."
 }
 
-// For each input file, the unique package name to use, underscored.
-var uniquePackageName = make(map[*descriptor.FileDescriptorProto]string)
-
-// Package names already registered.  Key is the name from the .proto file;
-// value is the name that appears in the generated code.
-var pkgNamesInUse = make(map[string]bool)
+// GoImportPath returns the import path of the Go package holding the code
+// generated for fd, as other generated files import it: the path given by
+// an M parameter, or else the directory of its output file.
+func (g *Generator) GoImportPath(fd *FileDescriptor) string {
+	importPath, ok := g.ImportMap[fd.GetName()]
+	if !ok {
+		importPath = path.Dir(fd.goFileName(pathTypeImport))
+	}
+	return g.ImportPrefix + importPath
+}
 
-// Create and remember a guaranteed unique package name for this file descriptor.
+// RegisterUniquePackageName creates and remembers a guaranteed unique package name for this file descriptor.
 // Pkg is the candidate name.  If f is nil, it's a builtin package like "proto" and
 // has no file descriptor.
-func RegisterUniquePackageName(pkg string, f *FileDescriptor) string {
+func (g *Generator) RegisterUniquePackageName(pkg string, f *FileDescriptor) string {
 	// Convert dots to underscores before finding a unique alias.
 	pkg = strings.Map(badToUnderscore, pkg)
 
-	for i, orig := 1, pkg; pkgNamesInUse[pkg]; i++ {
+	for i, orig := 1, pkg; g.pkgNamesInUse[pkg]; i++ {
 		// It's a duplicate; must rename.
 		pkg = orig + strconv.Itoa(i)
 	}
 	// Install it.
-	pkgNamesInUse[pkg] = true
+	g.pkgNamesInUse[pkg] = true
 	if f != nil {
-		uniquePackageName[f.FileDescriptorProto] = pkg
+		g.uniquePackageName[f.FileDescriptorProto] = pkg
 	}
 	return pkg
 }
@@ -772,14 +1186,14 @@ func (g *Generator) SetPackageNames() {
 		}
 	}
 
-	g.packageName = RegisterUniquePackageName(pkg, g.genFiles[0])
+	g.packageName = g.RegisterUniquePackageName(pkg, g.genFiles[0])
 
 	// Register the support package names. They might collide with the
 	// name of a package we import.
 	g.Pkg = map[string]string{
-		"fmt":   RegisterUniquePackageName("fmt", nil),
-		"math":  RegisterUniquePackageName("math", nil),
-		"proto": RegisterUniquePackageName("proto", nil),
+		"fmt":   g.RegisterUniquePackageName("fmt", nil),
+		"math":  g.RegisterUniquePackageName("math", nil),
+		"proto": g.RegisterUniquePackageName("proto", nil),
 	}
 
 AllFiles:
@@ -787,7 +1201,7 @@ AllFiles:
 		for _, genf := range g.genFiles {
 			if f == genf {
 				// In this package already.
-				uniquePackageName[f.FileDescriptorProto] = g.packageName
+				g.uniquePackageName[f.FileDescriptorProto] = g.packageName
 				continue AllFiles
 			}
 		}
@@ -797,7 +1211,7 @@ AllFiles:
 		if pkg == "" {
 			pkg = baseName(*f.Name)
 		}
-		RegisterUniquePackageName(pkg, f)
+		g.RegisterUniquePackageName(pkg, f)
 	}
 }
 
@@ -809,11 +1223,11 @@ func (g *Generator) WrapTypes() {
 	g.allFilesByName = make(map[string]*FileDescriptor, len(g.allFiles))
 	for _, f := range g.Request.ProtoFile {
 		// We must wrap the descriptors before we wrap the enums
-		descs := wrapDescriptors(f)
+		descs := g.wrapDescriptors(f)
 		g.buildNestedDescriptors(descs)
-		enums := wrapEnumDescriptors(f, descs)
+		enums := g.wrapEnumDescriptors(f, descs)
 		g.buildNestedEnums(descs, enums)
-		exts := wrapExtensions(f)
+		exts := g.wrapExtensions(f)
 		fd := &FileDescriptor{
 			FileDescriptorProto: f,
 			desc:                descs,
@@ -821,13 +1235,14 @@ func (g *Generator) WrapTypes() {
 			ext:                 exts,
 			exported:            make(map[Object][]symbol),
 			proto3:              fileIsProto3(f),
+			names:               g.uniquePackageName,
 		}
 		extractComments(fd)
 		g.allFiles = append(g.allFiles, fd)
 		g.allFilesByName[f.GetName()] = fd
 	}
 	for _, fd := range g.allFiles {
-		fd.imp = wrapImported(fd.FileDescriptorProto, g)
+		fd.imp = g.wrapImported(fd.FileDescriptorProto)
 	}
 
 	g.genFiles = make([]*FileDescriptor, 0, len(g.Request.FileToGenerate))
@@ -873,9 +1288,9 @@ func (g *Generator) buildNestedEnums(descs []*Descriptor, enums []*EnumDescripto
 }
 
 // Construct the Descriptor
-func newDescriptor(desc *descriptor.DescriptorProto, parent *Descriptor, file *descriptor.FileDescriptorProto, index int) *Descriptor {
+func (g *Generator) newDescriptor(desc *descriptor.DescriptorProto, parent *Descriptor, file *descriptor.FileDescriptorProto, index int) *Descriptor {
 	d := &Descriptor{
-		common:          common{file},
+		common:          common{file, g.uniquePackageName},
 		DescriptorProto: desc,
 		parent:          parent,
 		index:           index,
@@ -903,35 +1318,35 @@ func newDescriptor(desc *descriptor.DescriptorProto, parent *Descriptor, file *d
 	}
 
 	for _, field := range desc.Extension {
-		d.ext = append(d.ext, &ExtensionDescriptor{common{file}, field, d})
+		d.ext = append(d.ext, &ExtensionDescriptor{common{file, g.uniquePackageName}, field, d})
 	}
 
 	return d
 }
 
 // Return a slice of all the Descriptors defined within this file
-func wrapDescriptors(file *descriptor.FileDescriptorProto) []*Descriptor {
+func (g *Generator) wrapDescriptors(file *descriptor.FileDescriptorProto) []*Descriptor {
 	sl := make([]*Descriptor, 0, len(file.MessageType)+10)
 	for i, desc := range file.MessageType {
-		sl = wrapThisDescriptor(sl, desc, nil, file, i)
+		sl = g.wrapThisDescriptor(sl, desc, nil, file, i)
 	}
 	return sl
 }
 
 // Wrap this Descriptor, recursively
-func wrapThisDescriptor(sl []*Descriptor, desc *descriptor.DescriptorProto, parent *Descriptor, file *descriptor.FileDescriptorProto, index int) []*Descriptor {
-	sl = append(sl, newDescriptor(desc, parent, file, index))
+func (g *Generator) wrapThisDescriptor(sl []*Descriptor, desc *descriptor.DescriptorProto, parent *Descriptor, file *descriptor.FileDescriptorProto, index int) []*Descriptor {
+	sl = append(sl, g.newDescriptor(desc, parent, file, index))
 	me := sl[len(sl)-1]
 	for i, nested := range desc.NestedType {
-		sl = wrapThisDescriptor(sl, nested, me, file, i)
+		sl = g.wrapThisDescriptor(sl, nested, me, file, i)
 	}
 	return sl
 }
 
 // Construct the EnumDescriptor
-func newEnumDescriptor(desc *descriptor.EnumDescriptorProto, parent *Descriptor, file *descriptor.FileDescriptorProto, index int) *EnumDescriptor {
+func (g *Generator) newEnumDescriptor(desc *descriptor.EnumDescriptorProto, parent *Descriptor, file *descriptor.FileDescriptorProto, index int) *EnumDescriptor {
 	ed := &EnumDescriptor{
-		common:              common{file},
+		common:              common{file, g.uniquePackageName},
 		EnumDescriptorProto: desc,
 		parent:              parent,
 		index:               index,
@@ -945,45 +1360,45 @@ func newEnumDescriptor(desc *descriptor.EnumDescriptorProto, parent *Descriptor,
 }
 
 // Return a slice of all the EnumDescriptors defined within this file
-func wrapEnumDescriptors(file *descriptor.FileDescriptorProto, descs []*Descriptor) []*EnumDescriptor {
+func (g *Generator) wrapEnumDescriptors(file *descriptor.FileDescriptorProto, descs []*Descriptor) []*EnumDescriptor {
 	sl := make([]*EnumDescriptor, 0, len(file.EnumType)+10)
 	// Top-level enums.
 	for i, enum := range file.EnumType {
-		sl = append(sl, newEnumDescriptor(enum, nil, file, i))
+		sl = append(sl, g.newEnumDescriptor(enum, nil, file, i))
 	}
 	// Enums within messages. Enums within embedded messages appear in the outer-most message.
 	for _, nested := range descs {
 		for i, enum := range nested.EnumType {
-			sl = append(sl, newEnumDescriptor(enum, nested, file, i))
+			sl = append(sl, g.newEnumDescriptor(enum, nested, file, i))
 		}
 	}
 	return sl
 }
 
 // Return a slice of all the top-level ExtensionDescriptors defined within this file.
-func wrapExtensions(file *descriptor.FileDescriptorProto) []*ExtensionDescriptor {
+func (g *Generator) wrapExtensions(file *descriptor.FileDescriptorProto) []*ExtensionDescriptor {
 	var sl []*ExtensionDescriptor
 	for _, field := range file.Extension {
-		sl = append(sl, &ExtensionDescriptor{common{file}, field, nil})
+		sl = append(sl, &ExtensionDescriptor{common{file, g.uniquePackageName}, field, nil})
 	}
 	return sl
 }
 
 // Return a slice of all the types that are publicly imported into this file.
-func wrapImported(file *descriptor.FileDescriptorProto, g *Generator) (sl []*ImportedDescriptor) {
+func (g *Generator) wrapImported(file *descriptor.FileDescriptorProto) (sl []*ImportedDescriptor) {
 	for _, index := range file.PublicDependency {
 		df := g.fileByName(file.Dependency[index])
 		for _, d := range df.desc {
 			if d.GetOptions().GetMapEntry() {
 				continue
 			}
-			sl = append(sl, &ImportedDescriptor{common{file}, d})
+			sl = append(sl, &ImportedDescriptor{common{file, g.uniquePackageName}, d})
 		}
 		for _, e := range df.enum {
-			sl = append(sl, &ImportedDescriptor{common{file}, e})
+			sl = append(sl, &ImportedDescriptor{common{file, g.uniquePackageName}, e})
 		}
 		for _, ext := range df.ext {
-			sl = append(sl, &ImportedDescriptor{common{file}, ext})
+			sl = append(sl, &ImportedDescriptor{common{file, g.uniquePackageName}, ext})
 		}
 	}
 	return
@@ -991,15 +1406,20 @@ func wrapImported(file *descriptor.FileDescriptorProto, g *Generator) (sl []*Imp
 
 func extractComments(file *FileDescriptor) {
 	file.comments = make(map[string]*descriptor.SourceCodeInfo_Location)
+	file.spans = make(map[string][]int32)
 	for _, loc := range file.GetSourceCodeInfo().GetLocation() {
-		if loc.LeadingComments == nil {
-			continue
-		}
 		var p []string
 		for _, n := range loc.Path {
 			p = append(p, strconv.Itoa(int(n)))
 		}
-		file.comments[strings.Join(p, ",")] = loc
+		path := strings.Join(p, ",")
+		if _, ok := file.spans[path]; !ok {
+			file.spans[path] = loc.Span
+		}
+		if loc.LeadingComments == nil && loc.TrailingComments == nil && len(loc.LeadingDetachedComments) == 0 {
+			continue
+		}
+		file.comments[path] = loc
 	}
 }
 
@@ -1027,6 +1447,60 @@ func (g *Generator) BuildTypeNameMap() {
 	}
 }
 
+// MethodOption returns the value of the extension ext in the method's options,
+// such as a custom option declared by a plugin. If the option is not set it
+// returns the extension's default value, or nil if it has none.
+// ext must extend google.protobuf.MethodOptions.
+func (g *Generator) MethodOption(method *descriptor.MethodDescriptorProto, ext *proto.ExtensionDesc) interface{} {
+	opts := method.Options
+	if opts == nil {
+		opts = new(descriptor.MethodOptions)
+	}
+	return g.option(opts, ext)
+}
+
+// ServiceOption is like MethodOption for service options.
+// ext must extend google.protobuf.ServiceOptions.
+func (g *Generator) ServiceOption(service *descriptor.ServiceDescriptorProto, ext *proto.ExtensionDesc) interface{} {
+	opts := service.Options
+	if opts == nil {
+		opts = new(descriptor.ServiceOptions)
+	}
+	return g.option(opts, ext)
+}
+
+// MessageOption is like MethodOption for message options.
+// ext must extend google.protobuf.MessageOptions.
+func (g *Generator) MessageOption(message *descriptor.DescriptorProto, ext *proto.ExtensionDesc) interface{} {
+	opts := message.Options
+	if opts == nil {
+		opts = new(descriptor.MessageOptions)
+	}
+	return g.option(opts, ext)
+}
+
+// FieldOption is like MethodOption for field options.
+// ext must extend google.protobuf.FieldOptions.
+func (g *Generator) FieldOption(field *descriptor.FieldDescriptorProto, ext *proto.ExtensionDesc) interface{} {
+	opts := field.Options
+	if opts == nil {
+		opts = new(descriptor.FieldOptions)
+	}
+	return g.option(opts, ext)
+}
+
+// option returns the value of ext in opts.
+func (g *Generator) option(opts proto.Message, ext *proto.ExtensionDesc) interface{} {
+	v, err := proto.GetExtension(opts, ext)
+	if err == proto.ErrMissingExtension {
+		return nil
+	}
+	if err != nil {
+		g.Error(err, "reading option", ext.Name)
+	}
+	return v
+}
+
 // ObjectNamed, given a fully-qualified input type name as it appears in the input data,
 // returns the descriptor for the message or enum with that name.
 func (g *Generator) ObjectNamed(typeName string) Object {
@@ -1078,32 +1552,128 @@ func (g *Generator) P(str ...interface{}) {
 	}
 	g.WriteString(g.indent)
 	for _, v := range str {
-		switch s := v.(type) {
-		case string:
-			g.WriteString(s)
-		case *string:
-			g.WriteString(*s)
-		case bool:
-			fmt.Fprintf(g, "%t", s)
-		case *bool:
-			fmt.Fprintf(g, "%t", *s)
-		case int:
-			fmt.Fprintf(g, "%d", s)
-		case *int32:
-			fmt.Fprintf(g, "%d", *s)
-		case *int64:
-			fmt.Fprintf(g, "%d", *s)
-		case float64:
-			fmt.Fprintf(g, "%g", s)
-		case *float64:
-			fmt.Fprintf(g, "%g", *s)
-		default:
-			g.Fail(fmt.Sprintf("unknown type in printer: %T", v))
-		}
+		g.printAtom(v)
 	}
 	g.WriteByte('\n')
 }
 
+// printAtom prints a single argument of P.
+func (g *Generator) printAtom(v interface{}) {
+	switch s := v.(type) {
+	case string:
+		g.WriteString(s)
+	case *string:
+		g.WriteString(*s)
+	case bool:
+		fmt.Fprintf(g, "%t", s)
+	case *bool:
+		fmt.Fprintf(g, "%t", *s)
+	case int:
+		fmt.Fprintf(g, "%d", s)
+	case *int32:
+		fmt.Fprintf(g, "%d", *s)
+	case *int64:
+		fmt.Fprintf(g, "%d", *s)
+	case float64:
+		fmt.Fprintf(g, "%g", s)
+	case *float64:
+		fmt.Fprintf(g, "%g", *s)
+	case *AnnotatedAtoms:
+		begin := g.Len()
+		for _, a := range s.atoms {
+			g.printAtom(a)
+		}
+		if g.annotateCode {
+			g.annotations = append(g.annotations, &descriptor.GeneratedCodeInfo_Annotation{
+				SourceFile: proto.String(s.source),
+				Path:       s.path,
+				Begin:      proto.Int32(int32(begin)),
+				End:        proto.Int32(int32(g.Len())),
+			})
+		}
+	default:
+		g.Fail(fmt.Sprintf("unknown type in printer: %T", v))
+	}
+}
+
+// AnnotatedAtoms is a list of arguments to P whose output is linked to an
+// element of a .proto file. See Annotate.
+type AnnotatedAtoms struct {
+	source string
+	path   []int32
+	atoms  []interface{}
+}
+
+// Annotate wraps atoms, arguments for P, so that when the annotate_code
+// parameter is set, the .meta file written alongside the generated code
+// links their text to the element at path in file. The path is a
+// comma-separated list of integers, as for PrintComments. The text should
+// begin with an identifier, such as the name of a type or method.
+func Annotate(file *FileDescriptor, path string, atoms ...interface{}) *AnnotatedAtoms {
+	var p []int32
+	for _, n := range strings.Split(path, ",") {
+		i, err := strconv.Atoi(n)
+		if err != nil {
+			panic(fmt.Sprintf("bad annotation path %q", path))
+		}
+		p = append(p, int32(i))
+	}
+	return &AnnotatedAtoms{source: file.GetName(), path: p, atoms: atoms}
+}
+
+// shiftAnnotations moves the annotations at or after offset off in g.Buffer
+// by n bytes, after n bytes have been inserted there.
+func (g *Generator) shiftAnnotations(off, n int) {
+	for _, a := range g.annotations {
+		if int(a.GetBegin()) >= off {
+			a.Begin = proto.Int32(a.GetBegin() + int32(n))
+			a.End = proto.Int32(a.GetEnd() + int32(n))
+		}
+	}
+}
+
+// remapAnnotations moves annotations from offsets in original to the
+// corresponding offsets in formatted, a reformatted copy of it. Formatting
+// changes white space and comments but keeps the identifiers, and each
+// annotation begins with one, so the nth identifier in original is the
+// nth in formatted.
+func (g *Generator) remapAnnotations(annotations []*descriptor.GeneratedCodeInfo_Annotation, original, formatted []byte) {
+	from, to := identOffsets(original), identOffsets(formatted)
+	if len(from) != len(to) {
+		g.Fail("cannot annotate code: formatting changed its identifiers")
+	}
+	index := make(map[int]int, len(from))
+	for i, off := range from {
+		index[off] = i
+	}
+	for _, a := range annotations {
+		i, ok := index[int(a.GetBegin())]
+		if !ok {
+			g.Fail(fmt.Sprintf("annotation of %s path %v does not begin with an identifier", a.GetSourceFile(), a.Path))
+		}
+		a.End = proto.Int32(int32(to[i]) + a.GetEnd() - a.GetBegin())
+		a.Begin = proto.Int32(int32(to[i]))
+	}
+}
+
+// identOffsets returns the offsets of the identifiers in the Go source src.
+func identOffsets(src []byte) []int {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	s.Init(file, src, nil, 0)
+	var offs []int
+	for {
+		pos, tok, _ := s.Scan()
+		if tok == token.EOF {
+			return offs
+		}
+		if tok == token.IDENT {
+			offs = append(offs, file.Offset(pos))
+		}
+	}
+}
+
 // addInitf stores the given statement to be printed inside the file's init function.
 // The statement is given as a format specifier and arguments.
 func (g *Generator) addInitf(stmt string, a ...interface{}) {
@@ -1123,7 +1693,7 @@ func (g *Generator) Out() {
 // GenerateAllFiles generates the output for all the files we're outputting.
 func (g *Generator) GenerateAllFiles() {
 	// Initialize the plugins
-	for _, p := range plugins {
+	for _, p := range g.plugins {
 		p.Init(g)
 	}
 	// Generate the output. The generator runs for every file, even the files
@@ -1133,23 +1703,319 @@ func (g *Generator) GenerateAllFiles() {
 	for _, file := range g.genFiles {
 		genFileMap[file] = true
 	}
+	// The files whose output is cached are generated only as imports are.
+	var keys map[*FileDescriptor]string
+	cached := make(map[*FileDescriptor][]*plugin.CodeGeneratorResponse_File)
+	if g.cacheDir != "" {
+		keys = g.cacheKeys()
+		for file, key := range keys {
+			if files := g.loadCached(key); files != nil {
+				cached[file] = files
+			}
+		}
+	}
+	var outputs []*generatedFile
+	for _, file := range g.allFiles {
+		for _, part := range g.outputParts() {
+			g.Reset()
+			g.writeOutput = genFileMap[file] && cached[file] == nil
+			g.part = part
+			g.generate(file)
+			if !g.writeOutput {
+				continue
+			}
+			outputs = append(outputs, &generatedFile{
+				file:        file,
+				name:        g.GoOutputName(file),
+				raw:         append([]byte(nil), g.Bytes()...),
+				annotations: g.annotations,
+			})
+		}
+	}
+	g.part = outputPart{}
+	if len(g.diagnostics) > 0 {
+		// The output is discarded, and code generated from
+		// invalid input may not even parse.
+		g.Response.File = nil
+		g.Response.Error = proto.String(strings.Join(g.diagnostics, "\n"))
+		return
+	}
+	g.formatFiles(outputs)
+	var errs []string
+	for _, f := range outputs {
+		if f.err != nil {
+			errs = append(errs, f.err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		g.Response.File = nil
+		g.Response.Error = proto.String(strings.Join(errs, "\n"))
+		return
+	}
+	generated := make(map[*FileDescriptor][]*plugin.CodeGeneratorResponse_File)
+	for _, f := range outputs {
+		generated[f.file] = append(generated[f.file], &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(f.name),
+			Content: proto.String(string(f.content)),
+		})
+		if g.annotateCode {
+			generated[f.file] = append(generated[f.file], &plugin.CodeGeneratorResponse_File{
+				Name:    proto.String(f.name + ".meta"),
+				Content: proto.String(proto.CompactTextString(&descriptor.GeneratedCodeInfo{Annotation: f.annotations})),
+			})
+		}
+	}
 	for _, file := range g.allFiles {
-		g.Reset()
-		g.writeOutput = genFileMap[file]
-		g.generate(file)
-		if !g.writeOutput {
+		if files, ok := cached[file]; ok {
+			g.Response.File = append(g.Response.File, files...)
+			continue
+		}
+		if key, ok := keys[file]; ok {
+			g.storeCached(key, generated[file])
+		}
+		g.Response.File = append(g.Response.File, generated[file]...)
+	}
+}
+
+// An outputPart is one of the files generated for each input file: the
+// stock output, with the messages and so on, or a file with just the
+// code of some plugins.
+type outputPart struct {
+	suffix  string   // Added to the output file name, as in x_<suffix>.pb.go.
+	stock   bool     // Whether the part has the messages, enums and so on.
+	plugins []Plugin // The plugins run for the part.
+}
+
+// outputParts returns the parts of the output for each file. The stock
+// output has the code of all the plugins, unless PluginOutput leaves it
+// out or separate_files=true gives each plugin its own file.
+func (g *Generator) outputParts() []outputPart {
+	switch {
+	case g.PluginOutput != "":
+		return []outputPart{{suffix: g.PluginOutput, plugins: g.plugins}}
+	case g.separate:
+		parts := []outputPart{{stock: true}}
+		for _, p := range g.plugins {
+			parts = append(parts, outputPart{suffix: p.Name(), plugins: []Plugin{p}})
+		}
+		return parts
+	}
+	return []outputPart{{stock: true, plugins: g.plugins}}
+}
+
+// A generatedFile is the output for one file. Each is formatted on its own,
+// so that files can be formatted concurrently.
+type generatedFile struct {
+	file        *FileDescriptor
+	name        string // Name in the response.
+	raw         []byte // Go source as generated.
+	content     []byte // Go source after formatting.
+	err         error  // Why the source could not be formatted.
+	annotations []*descriptor.GeneratedCodeInfo_Annotation
+}
+
+// formatFiles formats the generated files concurrently. Parsing and printing
+// the Go source takes most of the generator's time on large requests, and
+// each file is independent of the others; the files stay in their order.
+func (g *Generator) formatFiles(files []*generatedFile) {
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(files) {
+		workers = len(files)
+	}
+	work := make(chan *generatedFile)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range work {
+				g.formatFile(f)
+			}
+		}()
+	}
+	for _, f := range files {
+		work <- f
+	}
+	close(work)
+	wg.Wait()
+}
+
+// formatFile formats the generated code of f with go/format, first
+// removing unused imports if format=goimports, and moves its annotations to
+// match. It must not change g, since files are formatted concurrently.
+func (g *Generator) formatFile(f *generatedFile) {
+	if g.goimports {
+		f.pruneImports()
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, f.name, f.raw, parser.ParseComments)
+	if err != nil {
+		// This should never happen in practice, but it can while changing
+		// generated code, so show the code around the error.
+		f.err = sourceError(f.raw, err)
+		return
+	}
+	var out bytes.Buffer
+	if err := format.Node(&out, fset, file); err != nil {
+		f.err = fmt.Errorf("%s: generated Go source code could not be reformatted: %v", f.name, err)
+		return
+	}
+	f.content = out.Bytes()
+	if g.annotateCode {
+		g.remapAnnotations(f.annotations, f.raw, f.content)
+	}
+}
+
+// sourceErrorContext is the number of lines shown on each side of the
+// line of a syntax error in generated code.
+const sourceErrorContext = 5
+
+// sourceError describes a syntax error in the generated Go source src,
+// showing the lines around it with their numbers.
+func sourceError(src []byte, err error) error {
+	line := 0
+	if list, ok := err.(scanner.ErrorList); ok && len(list) > 0 {
+		err, line = list[0], list[0].Pos.Line
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "bad Go source code was generated: %v", err)
+	s := bufio.NewScanner(bytes.NewReader(src))
+	for n := 1; line > 0 && n <= line+sourceErrorContext && s.Scan(); n++ {
+		if n < line-sourceErrorContext {
 			continue
 		}
-		g.Response.File = append(g.Response.File, &plugin.CodeGeneratorResponse_File{
-			Name:    proto.String(file.goFileName()),
-			Content: proto.String(g.String()),
+		mark := " "
+		if n == line {
+			mark = ">"
+		}
+		fmt.Fprintf(&b, "\n%s%5d\t%s", mark, n, s.Bytes())
+	}
+	return errors.New(b.String())
+}
+
+// pruneImports removes the declarations that only reference imports to
+// keep them used, such as "var _ = fmt.Errorf", and then the imports that
+// are not used, as goimports would. It edits the source of f before
+// formatting, so that the annotations can be moved by the bytes removed.
+// Source that does not parse is left for formatFile to report.
+func (f *generatedFile) pruneImports() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, f.name, f.raw, parser.ParseComments)
+	if err != nil {
+		return
+	}
+	tf := fset.File(file.Pos())
+	// cut returns the range of whole lines from begin to end.
+	cut := func(begin, end token.Pos) [2]int {
+		b, e := tf.Offset(begin), tf.Offset(end)
+		for b > 0 && f.raw[b-1] != '\n' {
+			b--
+		}
+		for e < len(f.raw) && f.raw[e-1] != '\n' {
+			e++
+		}
+		return [2]int{b, e}
+	}
+
+	imports := make(map[string]*ast.ImportSpec) // by name
+	for _, imp := range file.Imports {
+		if imp.Name != nil && imp.Name.Name != "_" && imp.Name.Name != "." {
+			imports[imp.Name.Name] = imp
+		}
+	}
+	// pkgRef reports the import that e, a selector's operand, refers to.
+	pkgRef := func(e ast.Expr) string {
+		if sel, ok := e.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil && imports[id.Name] != nil {
+				return id.Name
+			}
+		}
+		return ""
+	}
+	var cuts [][2]int
+	used := make(map[string]bool)
+	for _, decl := range file.Decls {
+		if d, ok := decl.(*ast.GenDecl); ok && d.Tok == token.VAR && len(d.Specs) == 1 {
+			spec := d.Specs[0].(*ast.ValueSpec)
+			if len(spec.Names) == 1 && spec.Names[0].Name == "_" &&
+				(len(spec.Values) == 0 && pkgRef(spec.Type) != "" ||
+					len(spec.Values) == 1 && spec.Type == nil && pkgRef(spec.Values[0]) != "") {
+				begin := d.Pos()
+				if d.Doc != nil {
+					begin = d.Doc.Pos()
+				}
+				cuts = append(cuts, cut(begin, d.End()))
+				continue
+			}
+		}
+		ast.Inspect(decl, func(n ast.Node) bool {
+			if e, ok := n.(ast.Expr); ok {
+				if name := pkgRef(e); name != "" {
+					used[name] = true
+				}
+			}
+			return true
 		})
 	}
+	for _, imp := range file.Imports {
+		if imp.Name != nil && imports[imp.Name.Name] == imp && !used[imp.Name.Name] {
+			cuts = append(cuts, cut(imp.Pos(), imp.End()))
+		}
+	}
+	if len(cuts) == 0 {
+		return
+	}
+	sort.Sort(byOffset(cuts))
+
+	var raw []byte
+	last := 0
+	for _, c := range cuts {
+		raw = append(raw, f.raw[last:c[0]]...)
+		last = c[1]
+	}
+	raw = append(raw, f.raw[last:]...)
+	for _, a := range f.annotations {
+		removed := 0
+		for _, c := range cuts {
+			if c[1] <= int(a.GetBegin()) {
+				removed += c[1] - c[0]
+			}
+		}
+		a.Begin = proto.Int32(a.GetBegin() - int32(removed))
+		a.End = proto.Int32(a.GetEnd() - int32(removed))
+	}
+	f.raw = raw
+}
+
+// byOffset sorts byte ranges by their start.
+type byOffset [][2]int
+
+func (s byOffset) Len() int           { return len(s) }
+func (s byOffset) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byOffset) Less(i, j int) bool { return s[i][0] < s[j][0] }
+
+// GoOutputName returns the name under which the file's generated Go code is
+// written to the response, honoring the paths= and module= parameters.
+// While a plugin generates code that goes in a file of its own, because
+// of PluginOutput or separate_files=true, it is the name of that file.
+func (g *Generator) GoOutputName(file *FileDescriptor) string {
+	name := file.goFileName(g.pathType)
+	if g.part.suffix != "" {
+		name = strings.TrimSuffix(name, ".pb.go") + "_" + g.part.suffix + ".pb.go"
+	}
+	if g.module == "" {
+		return name
+	}
+	prefix := strings.TrimSuffix(g.module, "/") + "/"
+	if !strings.HasPrefix(name, prefix) {
+		g.Fail("output file", name, "does not start with module prefix", prefix)
+	}
+	return name[len(prefix):]
 }
 
 // Run all the plugins associated with the file.
 func (g *Generator) runPlugins(file *FileDescriptor) {
-	for _, p := range plugins {
+	for _, p := range g.part.plugins {
 		p.Generate(file)
 	}
 }
@@ -1170,7 +2036,13 @@ func (g *Generator) FileOf(fd *descriptor.FileDescriptorProto) *FileDescriptor {
 func (g *Generator) generate(file *FileDescriptor) {
 	g.file = g.FileOf(file.FileDescriptorProto)
 	g.usedPackages = make(map[string]bool)
+	g.addedImports = make(map[string]bool)
+	g.annotations = nil
 
+	if !g.part.stock {
+		g.generatePluginsOnly(file)
+		return
+	}
 	if g.file.index == 0 {
 		// For one file in the package, assert version compatibility.
 		g.P("// This is a compile-time assertion to ensure that this generated file")
@@ -1202,6 +2074,9 @@ func (g *Generator) generate(file *FileDescriptor) {
 	g.runPlugins(file)
 
 	g.generateFileDescriptor(file)
+	if g.descSet {
+		g.generateFileDescriptorSet(file)
+	}
 
 	// Generate header and imports last, though they appear first in the output.
 	rem := g.Buffer
@@ -1211,28 +2086,29 @@ func (g *Generator) generate(file *FileDescriptor) {
 	if !g.writeOutput {
 		return
 	}
+	g.shiftAnnotations(0, g.Len())
 	g.Write(rem.Bytes())
+}
 
-	// Reformat generated code.
-	fset := token.NewFileSet()
-	raw := g.Bytes()
-	ast, err := parser.ParseFile(fset, "", g, parser.ParseComments)
-	if err != nil {
-		// Print out the bad code with line numbers.
-		// This should never happen in practice, but it can while changing generated code,
-		// so consider this a debugging aid.
-		var src bytes.Buffer
-		s := bufio.NewScanner(bytes.NewReader(raw))
-		for line := 1; s.Scan(); line++ {
-			fmt.Fprintf(&src, "%5d\t%s\n", line, s.Bytes())
-		}
-		g.Fail("bad Go source code was generated:", err.Error(), "\n"+src.String())
-	}
-	g.Reset()
-	err = (&printer.Config{Mode: printer.TabIndent | printer.UseSpaces, Tabwidth: 8}).Fprint(g, fset, ast)
-	if err != nil {
-		g.Fail("generated Go source code could not be reformatted:", err.Error())
+// generatePluginsOnly is generate for a part of the output with just the
+// code of plugins. The messages, enums, extensions and file descriptor
+// are left to the stock output.
+func (g *Generator) generatePluginsOnly(file *FileDescriptor) {
+	g.runPlugins(file)
+	if g.Len() == 0 {
+		// Nothing to add to the stock output.
+		g.writeOutput = false
+		return
+	}
+	rem := g.Buffer
+	g.Buffer = new(bytes.Buffer)
+	g.generateHeader()
+	g.generateImports()
+	if !g.writeOutput {
+		return
 	}
+	g.shiftAnnotations(0, g.Len())
+	g.Write(rem.Bytes())
 }
 
 // Generate the header, including package definition
@@ -1243,12 +2119,12 @@ func (g *Generator) generateHeader() {
 
 	name := g.file.PackageName()
 
-	if g.file.index == 0 {
+	if g.file.index == 0 && g.part.stock {
 		// Generate package docs for the first file in the package.
 		g.P("/*")
 		g.P("Package ", name, " is a generated protocol buffer package.")
 		g.P()
-		if loc, ok := g.file.comments[strconv.Itoa(packagePath)]; ok {
+		if loc, ok := g.file.comments[strconv.Itoa(packagePath)]; ok && loc.LeadingComments != nil {
 			// not using g.PrintComments because this is a /* */ comment block.
 			text := strings.TrimSuffix(loc.GetLeadingComments(), "\n")
 			for _, line := range strings.Split(text, "\n") {
@@ -1282,7 +2158,7 @@ func (g *Generator) generateHeader() {
 	g.P()
 }
 
-// PrintComments prints any comments from the source .proto file.
+// PrintComments prints any leading comments from the source .proto file.
 // The path is a comma-separated list of integers.
 // It returns an indication of whether any comments were printed.
 // See descriptor.proto for its format.
@@ -1290,16 +2166,57 @@ func (g *Generator) PrintComments(path string) bool {
 	if !g.writeOutput {
 		return false
 	}
-	if loc, ok := g.file.comments[path]; ok {
-		text := strings.TrimSuffix(loc.GetLeadingComments(), "\n")
-		for _, line := range strings.Split(text, "\n") {
-			g.P("// ", strings.TrimPrefix(line, " "))
-		}
+	if loc, ok := g.file.comments[path]; ok && loc.LeadingComments != nil {
+		g.PrintCommentText(loc.GetLeadingComments())
 		return true
 	}
 	return false
 }
 
+// Comments holds the comments attached to an element of a .proto file.
+// Each is as protoc reports it: without the comment markers, but with
+// any space after them and the final newline. See SourceCodeInfo in
+// descriptor.proto for which comments are attached where.
+type Comments struct {
+	// Comments before the leading comment, separated from it and from
+	// each other by blank lines.
+	LeadingDetached []string
+	// The comment directly before the element.
+	Leading string
+	// The comment directly after the element, on the same or next line.
+	Trailing string
+}
+
+// Comments returns the comments attached to the element at path in the
+// current file. The path is a comma-separated list of integers, as for
+// PrintComments.
+func (g *Generator) Comments(path string) Comments {
+	return g.file.Comments(path)
+}
+
+// Comments returns the comments attached to the element at path in d,
+// as Generator.Comments does for the current file.
+func (d *FileDescriptor) Comments(path string) Comments {
+	loc, ok := d.comments[path]
+	if !ok {
+		return Comments{}
+	}
+	return Comments{
+		LeadingDetached: loc.LeadingDetachedComments,
+		Leading:         loc.GetLeadingComments(),
+		Trailing:        loc.GetTrailingComments(),
+	}
+}
+
+// PrintCommentText prints text, a comment as held in Comments, as a
+// block of // comments.
+func (g *Generator) PrintCommentText(text string) {
+	text = strings.TrimSuffix(text, "\n")
+	for _, line := range strings.Split(text, "\n") {
+		g.P("// ", strings.TrimPrefix(line, " "))
+	}
+}
+
 func (g *Generator) fileByName(filename string) *FileDescriptor {
 	return g.allFilesByName[filename]
 }
@@ -1314,44 +2231,136 @@ func (g *Generator) weak(i int32) bool {
 	return false
 }
 
-// Generate the imports
+// protoPkgPath is the import path of the proto support package.
+const protoPkgPath = "github.com/golang/protobuf/proto"
+
+// An importSpec is a single import of a generated file.
+type importSpec struct {
+	name, path string
+}
+
+// byImportGroup sorts imports by path, standard library packages first,
+// and then by name, so that the order never depends on the order in which
+// they were added.
+type byImportGroup []importSpec
+
+func (s byImportGroup) Len() int      { return len(s) }
+func (s byImportGroup) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byImportGroup) Less(i, j int) bool {
+	if a, b := isStdImport(s[i].path), isStdImport(s[j].path); a != b {
+		return a
+	}
+	if s[i].path != s[j].path {
+		return s[i].path < s[j].path
+	}
+	return s[i].name < s[j].name
+}
+
+// isStdImport reports whether the import path names a standard library
+// package, using the same rule as goimports: the first element of the path
+// of any other package contains a dot.
+func isStdImport(importPath string) bool {
+	return !strings.Contains(strings.SplitN(importPath, "/", 2)[0], ".")
+}
+
+// dependencyImport returns the import of the i'th dependency of the current
+// file. It returns false if the dependency is in the package being generated.
+func (g *Generator) dependencyImport(i int) (importSpec, bool) {
+	fd := g.fileByName(g.file.Dependency[i])
+	importPath := g.GoImportPath(fd)
+	// Do not import our own package.
+	if fd.PackageName() == g.packageName || importPath == g.GoImportPath(g.file) {
+		return importSpec{}, false
+	}
+	return importSpec{fd.PackageName(), importPath}, true
+}
+
+// AddImport adds the package with the given import path to the imports of
+// the file being generated, and returns the name to refer to it by.
+// Plugins should use it instead of printing their own import declarations.
+// The name is the last element of the path, with a number appended if that
+// is already taken by another package. Adding the same path again, or one
+// the file imports anyway, returns the same name. The path is used as
+// given; callers apply ImportPrefix if they need to.
+func (g *Generator) AddImport(importPath string) string {
+	switch importPath {
+	case "fmt", "math":
+		return g.Pkg[importPath]
+	case protoPkgPath:
+		return g.Pkg["proto"]
+	}
+	for i := range g.file.Dependency {
+		if imp, ok := g.dependencyImport(i); ok && !g.weak(int32(i)) && imp.path == importPath {
+			g.usedPackages[imp.name] = true
+			return imp.name
+		}
+	}
+	name, ok := g.importNames[importPath]
+	if !ok {
+		if g.importNames == nil {
+			g.importNames = make(map[string]string)
+		}
+		name = g.RegisterUniquePackageName(path.Base(importPath), nil)
+		g.importNames[importPath] = name
+	}
+	g.addedImports[importPath] = true
+	return name
+}
+
+// Generate the imports, as a single import declaration with the standard
+// library packages first, the way goimports arranges them.
 func (g *Generator) generateImports() {
 	// We almost always need a proto import.  Rather than computing when we
 	// do, which is tricky when there's a plugin, just import it and
 	// reference it later. The same argument applies to the fmt and math packages.
-	g.P("import " + g.Pkg["proto"] + " " + strconv.Quote("github.com/golang/protobuf/proto"))
-	g.P("import " + g.Pkg["fmt"] + ` "fmt"`)
-	g.P("import " + g.Pkg["math"] + ` "math"`)
-	for i, s := range g.file.Dependency {
-		fd := g.fileByName(s)
-		// Do not import our own package.
-		if fd.PackageName() == g.packageName {
+	imports := []importSpec{
+		{g.Pkg["fmt"], "fmt"},
+		{g.Pkg["math"], "math"},
+		{g.Pkg["proto"], protoPkgPath},
+	}
+	seen := make(map[string]int) // Index in imports, by path.
+	for i := range g.file.Dependency {
+		imp, ok := g.dependencyImport(i)
+		if !ok {
 			continue
 		}
-		filename := fd.goFileName()
-		// By default, import path is the dirname of the Go filename.
-		importPath := path.Dir(filename)
-		if substitution, ok := g.ImportMap[s]; ok {
-			importPath = substitution
-		}
-		importPath = g.ImportPrefix + importPath
 		// Skip weak imports.
 		if g.weak(int32(i)) {
-			g.P("// skipping weak import ", fd.PackageName(), " ", strconv.Quote(importPath))
+			g.P("// skipping weak import ", imp.name, " ", strconv.Quote(imp.path))
 			continue
 		}
 		// We need to import all the dependencies, even if we don't reference them,
 		// because other code and tools depend on having the full transitive closure
 		// of protocol buffer types in the binary.
-		pname := fd.PackageName()
-		if _, ok := g.usedPackages[pname]; !ok {
-			pname = "_"
+		if !g.usedPackages[imp.name] {
+			imp.name = "_"
+		}
+		// Several dependencies can be in the same Go package.
+		if j, ok := seen[imp.path]; ok {
+			if imp.name != "_" {
+				imports[j] = imp
+			}
+			continue
+		}
+		seen[imp.path] = len(imports)
+		imports = append(imports, imp)
+	}
+	for importPath := range g.addedImports {
+		imports = append(imports, importSpec{g.importNames[importPath], importPath})
+	}
+	sort.Sort(byImportGroup(imports))
+
+	g.P("import (")
+	for i, imp := range imports {
+		if i > 0 && isStdImport(imp.path) != isStdImport(imports[i-1].path) {
+			g.P()
 		}
-		g.P("import ", pname, " ", strconv.Quote(importPath))
+		g.P(imp.name, " ", strconv.Quote(imp.path))
 	}
+	g.P(")")
 	g.P()
-	// TODO: may need to worry about uniqueness across plugins
-	for _, p := range plugins {
+	// Plugins should use AddImport, but may still print their own imports.
+	for _, p := range g.part.plugins {
 		p.GenerateImports(g.file)
 		g.P()
 	}
@@ -1397,15 +2406,16 @@ func (g *Generator) generateEnum(enum *EnumDescriptor) {
 	ccPrefix := enum.prefix()
 
 	g.PrintComments(enum.path)
-	g.P("type ", ccTypeName, " int32")
+	g.P("type ", Annotate(g.file, enum.path, ccTypeName), " int32")
 	g.file.addExport(enum, enumSymbol{ccTypeName, enum.proto3()})
 	g.P("const (")
 	g.In()
 	for i, e := range enum.Value {
-		g.PrintComments(fmt.Sprintf("%s,%d,%d", enum.path, enumValuePath, i))
+		valuePath := fmt.Sprintf("%s,%d,%d", enum.path, enumValuePath, i)
+		g.PrintComments(valuePath)
 
 		name := ccPrefix + *e.Name
-		g.P(name, " ", ccTypeName, " = ", e.Number)
+		g.P(Annotate(g.file, valuePath, name), " ", ccTypeName, " = ", e.Number)
 		g.file.addExport(enum, constOrVarSymbol{name, "const", ccTypeName})
 	}
 	g.Out()
@@ -1462,6 +2472,10 @@ func (g *Generator) generateEnum(enum *EnumDescriptor) {
 		g.P("}")
 	}
 
+	if g.enumHelpers {
+		g.generateEnumHelpers(enum, ccTypeName)
+	}
+
 	var indexes []string
 	for m := enum.parent; m != nil; m = m.parent {
 		// XXX: skip groups?
@@ -1486,6 +2500,8 @@ func (g *Generator) generateEnum(enum *EnumDescriptor) {
 //	name= the original declared name
 //	enum= the name of the enum type if it is an enum-typed field.
 //	proto3 if this field is in a proto3 message
+//	lazy if this field is decoded on first use (see IsLazy)
+//	sensitive if this field's value must not be logged (see SetSensitive)
 //	def= string representation of the default value, if any.
 // The default value must be in a representation that can be used at run-time
 // to generate the default value. Thus bools become 0 and 1, for instance.
@@ -1585,7 +2601,15 @@ func (g *Generator) goTag(message *Descriptor, field *descriptor.FieldDescriptor
 	if field.OneofIndex != nil {
 		oneof = ",oneof"
 	}
-	return strconv.Quote(fmt.Sprintf("%s,%d,%s%s%s%s%s%s",
+	lazy := ""
+	if g.IsLazy(field) {
+		lazy = ",lazy"
+	}
+	sensitive := ""
+	if g.sensitive[field] {
+		sensitive = ",sensitive"
+	}
+	return strconv.Quote(fmt.Sprintf("%s,%d,%s%s%s%s%s%s%s%s",
 		wiretype,
 		field.GetNumber(),
 		optrepreq,
@@ -1593,9 +2617,45 @@ func (g *Generator) goTag(message *Descriptor, field *descriptor.FieldDescriptor
 		name,
 		enum,
 		oneof,
+		lazy,
+		sensitive,
 		defaultValue))
 }
 
+// SetSensitive marks field sensitive in its struct tag, so that proto.Redact
+// and the redacting marshalers withhold its value. Plugins call it from Init,
+// before the generator writes the messages.
+func (g *Generator) SetSensitive(field *descriptor.FieldDescriptorProto) {
+	if g.sensitive == nil {
+		g.sensitive = make(map[*descriptor.FieldDescriptorProto]bool)
+	}
+	g.sensitive[field] = true
+}
+
+// AddFieldTags appends tags, written as in a struct tag, such as
+// `db:"id"`, to the tags the generator writes for field in its message
+// struct. Plugins call it from Init, before the generator writes the
+// messages; it is up to them to check that tags is well-formed.
+func (g *Generator) AddFieldTags(field *descriptor.FieldDescriptorProto, tags string) {
+	if g.fieldTags == nil {
+		g.fieldTags = make(map[*descriptor.FieldDescriptorProto]string)
+	}
+	if t := g.fieldTags[field]; t != "" {
+		tags = t + " " + tags
+	}
+	g.fieldTags[field] = tags
+}
+
+// IsLazy reports whether the field is decoded on first use: it is a
+// singular message field declared with [lazy = true], and the generator
+// was run with lazy_unmarshal=true. Its message embeds a
+// proto.XXX_LazyFields to hold its bytes until then.
+func (g *Generator) IsLazy(field *descriptor.FieldDescriptorProto) bool {
+	return g.lazy && field.GetOptions().GetLazy() &&
+		*field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE &&
+		!isRepeated(field) && field.OneofIndex == nil
+}
+
 func needsStar(typ descriptor.FieldDescriptorProto_Type) bool {
 	switch typ {
 	case descriptor.FieldDescriptorProto_TYPE_GROUP:
@@ -1725,31 +2785,25 @@ var wellKnownTypes = map[string]bool{
 	"BytesValue":  true,
 }
 
-// Generate the type and default constant definitions for this Descriptor.
-func (g *Generator) generateMessage(message *Descriptor) {
-	// The full type name
-	typeName := message.TypeName()
-	// The full type name, CamelCased.
-	ccTypeName := CamelCaseSlice(typeName)
+// goNames holds the names of the Go struct fields and getters generated
+// for the fields of a message.
+type goNames struct {
+	fields, getters map[*descriptor.FieldDescriptorProto]string
+	oneofs          map[int32]string                            // Struct fields of the oneofs, by oneof index.
+	oneofTypes      map[*descriptor.FieldDescriptorProto]string // Wrapper types of the fields in oneofs.
+}
 
+// goNames returns the Go names for the fields of the message. A name that
+// would collide with a generated method, or with an earlier name, gets
+// underscores appended.
+func (d *Descriptor) goNames() *goNames {
+	if d.names != nil {
+		return d.names
+	}
 	usedNames := make(map[string]bool)
 	for _, n := range methodNames {
 		usedNames[n] = true
 	}
-	fieldNames := make(map[*descriptor.FieldDescriptorProto]string)
-	fieldGetterNames := make(map[*descriptor.FieldDescriptorProto]string)
-	fieldTypes := make(map[*descriptor.FieldDescriptorProto]string)
-	mapFieldTypes := make(map[*descriptor.FieldDescriptorProto]string)
-
-	oneofFieldName := make(map[int32]string)                           // indexed by oneof_index field of FieldDescriptorProto
-	oneofDisc := make(map[int32]string)                                // name of discriminator method
-	oneofTypeName := make(map[*descriptor.FieldDescriptorProto]string) // without star
-	oneofInsertPoints := make(map[int32]int)                           // oneof_index => offset of g.Buffer
-
-	g.PrintComments(message.path)
-	g.P("type ", ccTypeName, " struct {")
-	g.In()
-
 	// allocNames finds a conflict-free variation of the given strings,
 	// consistently mutating their suffixes.
 	// It returns the same number of strings.
@@ -1771,7 +2825,13 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		}
 	}
 
-	for i, field := range message.Field {
+	names := &goNames{
+		fields:     make(map[*descriptor.FieldDescriptorProto]string),
+		getters:    make(map[*descriptor.FieldDescriptorProto]string),
+		oneofs:     make(map[int32]string),
+		oneofTypes: make(map[*descriptor.FieldDescriptorProto]string),
+	}
+	for _, field := range d.Field {
 		// Allocate the getter and the field at the same time so name
 		// collisions create field/method consistent names.
 		// TODO: This allocation occurs based on the order of the fields
@@ -1779,7 +2839,331 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		// ordering can change generated Method/Field names.
 		base := CamelCase(*field.Name)
 		ns := allocNames(base, "Get"+base)
-		fieldName, fieldGetterName := ns[0], ns[1]
+		names.fields[field], names.getters[field] = ns[0], ns[1]
+
+		if field.OneofIndex != nil {
+			if _, ok := names.oneofs[*field.OneofIndex]; !ok {
+				odp := d.OneofDecl[int(*field.OneofIndex)]
+				names.oneofs[*field.OneofIndex] = allocNames(CamelCase(odp.GetName()))[0]
+			}
+			names.oneofTypes[field] = d.oneofTypeName(names.fields[field])
+		}
+	}
+	d.names = names
+	return names
+}
+
+// hasGoName reports whether one of the struct fields or getters generated
+// for the message is called name.
+func (d *Descriptor) hasGoName(name string) bool {
+	names := d.goNames()
+	for _, field := range d.Field {
+		if names.fields[field] == name || names.getters[field] == name {
+			return true
+		}
+	}
+	for _, n := range names.oneofs {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// oneofTypeName returns the name of the wrapper type of the oneof field
+// whose struct field is fieldName.
+func (d *Descriptor) oneofTypeName(fieldName string) string {
+	tname := CamelCaseSlice(d.TypeName()) + "_" + fieldName
+	// It is possible for this to collide with a message or enum
+	// nested in this message. Check for collisions.
+	for {
+		ok := true
+		for _, desc := range d.nested {
+			if CamelCaseSlice(desc.TypeName()) == tname {
+				ok = false
+				break
+			}
+		}
+		for _, enum := range d.enums {
+			if CamelCaseSlice(enum.TypeName()) == tname {
+				ok = false
+				break
+			}
+		}
+		if !ok {
+			tname += "_"
+			continue
+		}
+		break
+	}
+	return tname
+}
+
+// GoFieldName returns the name of the Go struct field generated for field,
+// one of the message's fields. For a field in a oneof, it is the field of
+// the oneof's wrapper type, and GoOneofName names the field of d.
+func (d *Descriptor) GoFieldName(field *descriptor.FieldDescriptorProto) string {
+	return d.goNames().fields[field]
+}
+
+// GoGetterName returns the name of the getter method generated for field,
+// one of the message's fields.
+func (d *Descriptor) GoGetterName(field *descriptor.FieldDescriptorProto) string {
+	return d.goNames().getters[field]
+}
+
+// GoOneofName returns the name of the Go struct field generated for the
+// message's oneof with the given index.
+func (d *Descriptor) GoOneofName(index int32) string {
+	return d.goNames().oneofs[index]
+}
+
+// GoOneofTypeName returns the name of the wrapper type generated for field,
+// a field in one of the message's oneofs, in the message's package.
+func (d *Descriptor) GoOneofTypeName(field *descriptor.FieldDescriptorProto) string {
+	return d.goNames().oneofTypes[field]
+}
+
+// generateMapHelpers generates, for each map field of message, a
+// Range<Field>Sorted method calling a function for each entry in order of
+// key, and a Get<Field>Or method returning the value for a key or a
+// default. mapFieldTypes is the map generateMessage builds.
+func (g *Generator) generateMapHelpers(message *Descriptor, mapFieldTypes map[*descriptor.FieldDescriptorProto]string) {
+	ccTypeName := CamelCaseSlice(message.TypeName())
+	names := message.goNames()
+	used := make(map[string]bool)
+	for _, n := range methodNames {
+		used[n] = true
+	}
+	for _, field := range message.Field {
+		used[names.fields[field]], used[names.getters[field]] = true, true
+	}
+	for _, n := range names.oneofs {
+		used[n], used["Get"+n] = true, true
+	}
+	alloc := func(name string) string {
+		for used[name] {
+			name += "_"
+		}
+		used[name] = true
+		return name
+	}
+
+	for _, field := range message.Field {
+		typ, ok := mapFieldTypes[field]
+		if !ok {
+			continue
+		}
+		// typ is map[K]V, and K is a scalar type.
+		i := strings.Index(typ, "]")
+		keyType, valType := typ[len("map["):i], typ[i+1:]
+		fname, getter := names.fields[field], names.getters[field]
+		rangeName := alloc("Range" + fname + "Sorted")
+		orName := alloc("Get" + fname + "Or")
+
+		g.P("// ", rangeName, " calls f for each entry of ", field.GetName(), ", in order of key, as")
+		g.P("// the text format and the deterministic encoding order them.")
+		g.P("func (m *", ccTypeName, ") ", rangeName, "(f func(k ", keyType, ", v ", valType, ")) {")
+		g.P("entries := m.", getter, "()")
+		if keyType == "bool" {
+			g.P("for _, k := range []bool{false, true} {")
+			g.P("if v, ok := entries[k]; ok {")
+			g.P("f(k, v)")
+			g.P("}")
+			g.P("}")
+		} else {
+			g.P("keys := make([]", keyType, ", 0, len(entries))")
+			g.P("for k := range entries {")
+			g.P("keys = append(keys, k)")
+			g.P("}")
+			g.P(g.Pkg["proto"], ".Sort", CamelCase(keyType), "Keys(keys)")
+			g.P("for _, k := range keys {")
+			g.P("f(k, entries[k])")
+			g.P("}")
+		}
+		g.P("}")
+		g.P()
+		g.P("// ", orName, " returns the value for k in ", field.GetName(), ", or def if it has none.")
+		g.P("func (m *", ccTypeName, ") ", orName, "(k ", keyType, ", def ", valType, ") ", valType, " {")
+		g.P("if v, ok := m.", getter, "()[k]; ok {")
+		g.P("return v")
+		g.P("}")
+		g.P("return def")
+		g.P("}")
+		g.P()
+	}
+}
+
+// generateOneofVisit generates the Visit<Oneof> method of the oneof with
+// index oi of message, whose struct field is fname. It takes a function for
+// each field of the oneof, in order of declaration, and one for when none
+// is set, so adding a field to the oneof breaks the callers until they
+// handle it. used holds the method names taken; the maps are those
+// generateMessage builds.
+func (g *Generator) generateOneofVisit(message *Descriptor, oi int32, fname string, used map[string]bool,
+	fieldNames, fieldTypes map[*descriptor.FieldDescriptorProto]string, oneofTypeName map[*descriptor.FieldDescriptorProto]string) {
+	visit := "Visit" + fname
+	for used[visit] {
+		visit += "_"
+	}
+	used[visit] = true
+
+	var fields []*descriptor.FieldDescriptorProto
+	params := make(map[*descriptor.FieldDescriptorProto]string)
+	var sig []string
+	taken := make(map[string]bool)
+	for _, field := range message.Field {
+		if field.OneofIndex != nil && *field.OneofIndex == oi {
+			name := fieldNames[field]
+			params[field] = strings.ToLower(name[:1]) + name[1:] + "Case"
+			taken[params[field]] = true
+			fields = append(fields, field)
+			sig = append(sig, params[field]+" func("+fieldTypes[field]+")")
+		}
+	}
+	notSet := "defaultCase"
+	for taken[notSet] {
+		notSet += "_"
+	}
+	sig = append(sig, notSet+" func()")
+
+	decl := message.OneofDecl[oi]
+	g.P("// ", visit, " calls the function for the field of the oneof ", decl.GetName(), " that is set,")
+	g.P("// with its value, or ", notSet, " if none is. A nil function ignores its case.")
+	g.P("// Adding a field to the oneof adds a parameter, so callers fail to compile")
+	g.P("// until they handle it.")
+	g.P("func (m *", CamelCaseSlice(message.TypeName()), ") ", visit, "(", strings.Join(sig, ", "), ") {")
+	g.P("switch x := m.Get", fname, "().(type) {")
+	for _, field := range fields {
+		g.P("case *", oneofTypeName[field], ":")
+		g.P("if ", params[field], " != nil {")
+		g.P(params[field], "(x.", fieldNames[field], ")")
+		g.P("}")
+	}
+	g.P("default:")
+	g.P("if ", notSet, " != nil {")
+	g.P(notSet, "()")
+	g.P("}")
+	g.P("}")
+	g.P("}")
+	g.P()
+}
+
+// generateOneofCases generates, for each oneof of message, an enumerated
+// type naming its fields by number, a Which<Oneof> method returning the
+// one that is set and a Visit<Oneof> method dispatching on it, and for
+// each field in a oneof, a nil-safe getter on its wrapper type. The maps
+// are those generateMessage builds.
+func (g *Generator) generateOneofCases(message *Descriptor, fieldNames, fieldTypes map[*descriptor.FieldDescriptorProto]string,
+	oneofFieldName map[int32]string, oneofTypeName map[*descriptor.FieldDescriptorProto]string) {
+	ccTypeName := message.TypeName()
+	names := message.goNames()
+	used := make(map[string]bool)
+	for _, n := range methodNames {
+		used[n] = true
+	}
+	for _, field := range message.Field {
+		used[names.fields[field]], used[names.getters[field]] = true, true
+	}
+	for _, n := range names.oneofs {
+		used[n], used["Get"+n] = true, true
+	}
+	wrappers := make(map[string]bool)
+	for _, t := range oneofTypeName {
+		wrappers[t] = true
+	}
+
+	for oi, decl := range message.OneofDecl {
+		fname := oneofFieldName[int32(oi)]
+		// The type may also collide with the wrapper type of a field named
+		// <oneof>_case.
+		ctype := message.oneofTypeName(fname + "Case")
+		for wrappers[ctype] {
+			ctype += "_"
+		}
+		which := "Which" + fname
+		for used[which] {
+			which += "_"
+		}
+		used[which] = true
+
+		g.P("// ", ctype, " identifies the field set in the oneof ", decl.GetName(), " of ", CamelCaseSlice(ccTypeName), ",")
+		g.P("// by its field number.")
+		g.P("type ", ctype, " int32")
+		g.P()
+		g.P("const (")
+		g.P(ctype, "_NOT_SET ", ctype, " = 0")
+		for _, field := range message.Field {
+			if field.OneofIndex != nil && *field.OneofIndex == int32(oi) {
+				g.P(ctype, "_", fieldNames[field], " ", ctype, " = ", strconv.Itoa(int(field.GetNumber())))
+			}
+		}
+		g.P(")")
+		g.P()
+		g.P("// ", which, " returns which field of the oneof ", decl.GetName(), " is set.")
+		g.P("func (m *", CamelCaseSlice(ccTypeName), ") ", which, "() ", ctype, " {")
+		g.P("switch m.Get", fname, "().(type) {")
+		for _, field := range message.Field {
+			if field.OneofIndex != nil && *field.OneofIndex == int32(oi) {
+				g.P("case *", oneofTypeName[field], ":")
+				g.P("return ", ctype, "_", fieldNames[field])
+			}
+		}
+		g.P("}")
+		g.P("return ", ctype, "_NOT_SET")
+		g.P("}")
+		g.P()
+
+		g.generateOneofVisit(message, int32(oi), fname, used, fieldNames, fieldTypes, oneofTypeName)
+	}
+
+	for _, field := range message.Field {
+		if field.OneofIndex == nil {
+			continue
+		}
+		typ := fieldTypes[field]
+		zero := "0"
+		switch {
+		case typ == "string":
+			zero = `""`
+		case typ == "bool":
+			zero = "false"
+		case strings.HasPrefix(typ, "*"), strings.HasPrefix(typ, "[]"):
+			zero = "nil"
+		}
+		g.P("func (m *", oneofTypeName[field], ") Get", fieldNames[field], "() ", typ, " {")
+		g.P("if m != nil { return m.", fieldNames[field], " }")
+		g.P("return ", zero)
+		g.P("}")
+		g.P()
+	}
+}
+
+// Generate the type and default constant definitions for this Descriptor.
+func (g *Generator) generateMessage(message *Descriptor) {
+	// The full type name
+	typeName := message.TypeName()
+	// The full type name, CamelCased.
+	ccTypeName := CamelCaseSlice(typeName)
+
+	names := message.goNames()
+	fieldNames := make(map[*descriptor.FieldDescriptorProto]string)
+	fieldGetterNames := make(map[*descriptor.FieldDescriptorProto]string)
+	fieldTypes := make(map[*descriptor.FieldDescriptorProto]string)
+	mapFieldTypes := make(map[*descriptor.FieldDescriptorProto]string)
+
+	oneofFieldName := make(map[int32]string)                           // indexed by oneof_index field of FieldDescriptorProto
+	oneofDisc := make(map[int32]string)                                // name of discriminator method
+	oneofTypeName := make(map[*descriptor.FieldDescriptorProto]string) // without star
+	oneofInsertPoints := make(map[int32]int)                           // oneof_index => offset of g.Buffer
+	hasLazy := false                                                   // whether any field is lazy
+
+	g.PrintComments(message.path)
+	g.P("type ", Annotate(g.file, message.path, ccTypeName), " struct {")
+	g.In()
+
+	for i, field := range message.Field {
+		fieldName, fieldGetterName := names.fields[field], names.getters[field]
 		typename, wiretype := g.GoType(message, field)
 		jsonName := *field.Name
 		tag := fmt.Sprintf("protobuf:%s json:%q", g.goTag(message, field, wiretype), jsonName+",omitempty")
@@ -1789,12 +3173,12 @@ func (g *Generator) generateMessage(message *Descriptor) {
 
 		oneof := field.OneofIndex != nil
 		if oneof && oneofFieldName[*field.OneofIndex] == "" {
-			odp := message.OneofDecl[int(*field.OneofIndex)]
-			fname := allocNames(CamelCase(odp.GetName()))[0]
+			fname := names.oneofs[*field.OneofIndex]
 
 			// This is the first field of a oneof we haven't seen before.
 			// Generate the union field.
-			com := g.PrintComments(fmt.Sprintf("%s,%d,%d", message.path, messageOneofPath, *field.OneofIndex))
+			oneofPath := fmt.Sprintf("%s,%d,%d", message.path, messageOneofPath, *field.OneofIndex)
+			com := g.PrintComments(oneofPath)
 			if com {
 				g.P("//")
 			}
@@ -1806,8 +3190,8 @@ func (g *Generator) generateMessage(message *Descriptor) {
 			dname := "is" + ccTypeName + "_" + fname
 			oneofFieldName[*field.OneofIndex] = fname
 			oneofDisc[*field.OneofIndex] = dname
-			tag := `protobuf_oneof:"` + odp.GetName() + `"`
-			g.P(fname, " ", dname, " `", tag, "`")
+			tag := `protobuf_oneof:"` + message.OneofDecl[int(*field.OneofIndex)].GetName() + `"`
+			g.P(Annotate(g.file, oneofPath, fname), " ", dname, " `", tag, "`")
 		}
 
 		if *field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
@@ -1839,45 +3223,35 @@ func (g *Generator) generateMessage(message *Descriptor) {
 				tag += fmt.Sprintf(" protobuf_key:%s protobuf_val:%s", keyTag, valTag)
 			}
 		}
+		if extra := g.fieldTags[field]; extra != "" {
+			tag += " " + extra
+		}
 
 		fieldTypes[field] = typename
 
 		if oneof {
-			tname := ccTypeName + "_" + fieldName
-			// It is possible for this to collide with a message or enum
-			// nested in this message. Check for collisions.
-			for {
-				ok := true
-				for _, desc := range message.nested {
-					if CamelCaseSlice(desc.TypeName()) == tname {
-						ok = false
-						break
-					}
-				}
-				for _, enum := range message.enums {
-					if CamelCaseSlice(enum.TypeName()) == tname {
-						ok = false
-						break
-					}
-				}
-				if !ok {
-					tname += "_"
-					continue
-				}
-				break
-			}
-
-			oneofTypeName[field] = tname
+			oneofTypeName[field] = names.oneofTypes[field]
 			continue
 		}
 
-		g.PrintComments(fmt.Sprintf("%s,%d,%d", message.path, messageFieldPath, i))
-		g.P(fieldName, "\t", typename, "\t`", tag, "`")
+		fieldPath := fmt.Sprintf("%s,%d,%d", message.path, messageFieldPath, i)
+		com := g.PrintComments(fieldPath)
+		if g.IsLazy(field) {
+			if com {
+				g.P("//")
+			}
+			g.P("// ", fieldName, " is decoded on first use; read it with ", fieldGetterName, ".")
+			hasLazy = true
+		}
+		g.P(Annotate(g.file, fieldPath, fieldName), "\t", typename, "\t`", tag, "`")
 		g.RecordTypeUse(field.GetTypeName())
 	}
 	if len(message.ExtensionRange) > 0 {
 		g.P(g.Pkg["proto"], ".XXX_InternalExtensions `json:\"-\"`")
 	}
+	if hasLazy {
+		g.P(g.Pkg["proto"], ".XXX_LazyFields `json:\"-\"`")
+	}
 	if !message.proto3() {
 		g.P("XXX_unrecognized\t[]byte `json:\"-\"`")
 	}
@@ -1898,6 +3272,7 @@ func (g *Generator) generateMessage(message *Descriptor) {
 			}
 			g.P("//\t*", oneofTypeName[field])
 		}
+		g.shiftAnnotations(ip, g.Buffer.Len()-ip)
 		g.Buffer.Write(rem)
 	}
 
@@ -1910,6 +3285,9 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		indexes = append([]string{strconv.Itoa(m.index)}, indexes...)
 	}
 	g.P("func (*", ccTypeName, ") Descriptor() ([]byte, []int) { return ", g.file.VarName(), ", []int{", strings.Join(indexes, ", "), "} }")
+	if g.descSet && !message.hasGoName("FileDescriptor") {
+		g.P("func (*", ccTypeName, ") FileDescriptor() []byte { return ", g.file.SetVarName(), " }")
+	}
 	// TODO: Revisit the decision to use a XXX_WellKnownType method
 	// if we change proto.MessageName to work with multiple equivalents.
 	if message.file.GetPackage() == "google.protobuf" && wellKnownTypes[message.GetName()] {
@@ -1965,6 +3343,10 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		g.P("}")
 	}
 
+	if g.fieldConsts && len(message.Field) > 0 {
+		g.generateFieldConstants(message, fieldNames, oneofTypeName)
+	}
+
 	// Default constants
 	defNames := make(map[*descriptor.FieldDescriptorProto]string)
 	for _, field := range message.Field {
@@ -2038,6 +3420,9 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		}
 		_, wiretype := g.GoType(message, field)
 		tag := "protobuf:" + g.goTag(message, field, wiretype)
+		if extra := g.fieldTags[field]; extra != "" {
+			tag += " " + extra
+		}
 		g.P("type ", oneofTypeName[field], " struct{ ", fieldNames[field], " ", fieldTypes[field], " `", tag, "` }")
 		g.RecordTypeUse(field.GetTypeName())
 	}
@@ -2057,6 +3442,12 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		g.P("}")
 	}
 	g.P()
+	if g.oneofCase && len(message.OneofDecl) > 0 {
+		g.generateOneofCases(message, fieldNames, fieldTypes, oneofFieldName, oneofTypeName)
+	}
+	if g.mapHelpers && len(mapFieldTypes) > 0 {
+		g.generateMapHelpers(message, mapFieldTypes)
+	}
 
 	// Field getters
 	var getters []getterSymbol
@@ -2123,6 +3514,9 @@ func (g *Generator) generateMessage(message *Descriptor) {
 			// as does a message or group field, or a repeated field.
 			g.P("if m != nil {")
 			g.In()
+			if g.IsLazy(field) {
+				g.P(g.Pkg["proto"], ".DecodeLazyField(m, ", strconv.Itoa(int(field.GetNumber())), ")")
+			}
 			g.P("return m." + fname)
 			g.Out()
 			g.P("}")
@@ -2666,18 +4060,62 @@ func (g *Generator) generateFileDescriptor(file *FileDescriptor) {
 		g.Fail(err.Error())
 	}
 
+	v := file.VarName()
+	g.P()
+	g.P("func init() { ", g.Pkg["proto"], ".RegisterFile(", strconv.Quote(*file.Name), ", ", v, ") }")
+	g.generateGzippedBytes(v, "FileDescriptorProto", b)
+}
+
+// generateFileDescriptorSet writes the compressed FileDescriptorSet of file
+// and the files it imports, directly or not, with each file after its
+// imports, as protoc --include_imports writes them, and registers it with
+// proto.RegisterFileDescriptorSet. Code holding a message of the file can
+// then get its schema, even when the code of the imports is not linked in.
+func (g *Generator) generateFileDescriptorSet(file *FileDescriptor) {
+	set := new(descriptor.FileDescriptorSet)
+	seen := make(map[string]bool)
+	var add func(fd *FileDescriptor)
+	add = func(fd *FileDescriptor) {
+		if seen[fd.GetName()] {
+			return
+		}
+		seen[fd.GetName()] = true
+		for _, dep := range fd.Dependency {
+			dfd := g.fileByName(dep)
+			if dfd == nil {
+				g.Fail("can't find dependency", dep, "of", fd.GetName())
+			}
+			add(dfd)
+		}
+		pb := proto.Clone(fd.FileDescriptorProto).(*descriptor.FileDescriptorProto)
+		pb.SourceCodeInfo = nil
+		set.File = append(set.File, pb)
+	}
+	add(file)
+
+	b, err := proto.Marshal(set)
+	if err != nil {
+		g.Fail(err.Error())
+	}
+
+	v := file.SetVarName()
+	g.P()
+	g.P("func init() { ", g.Pkg["proto"], ".RegisterFileDescriptorSet(", strconv.Quote(*file.Name), ", ", v, ") }")
+	g.generateGzippedBytes(v, "FileDescriptorSet", b)
+}
+
+// generateGzippedBytes writes a variable v holding b, compressed with gzip,
+// with a comment saying that it is a message of type what.
+func (g *Generator) generateGzippedBytes(v, what string, b []byte) {
 	var buf bytes.Buffer
 	w, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
 	w.Write(b)
 	w.Close()
 	b = buf.Bytes()
 
-	v := file.VarName()
-	g.P()
-	g.P("func init() { ", g.Pkg["proto"], ".RegisterFile(", strconv.Quote(*file.Name), ", ", v, ") }")
 	g.P("var ", v, " = []byte{")
 	g.In()
-	g.P("// ", len(b), " bytes of a gzipped FileDescriptorProto")
+	g.P("// ", len(b), " bytes of a gzipped ", what)
 	for len(b) > 0 {
 		n := 16
 		if n > len(b) {
@@ -2696,6 +4134,117 @@ func (g *Generator) generateFileDescriptor(file *FileDescriptor) {
 	g.P("}")
 }
 
+// generateFieldConstants writes a <Message>_Field<Field> constant holding
+// the number of each field of message, and a <Message>FieldNames map from
+// those numbers to the fields' names.
+func (g *Generator) generateFieldConstants(message *Descriptor, fieldNames map[*descriptor.FieldDescriptorProto]string,
+	oneofTypeName map[*descriptor.FieldDescriptorProto]string) {
+	ccTypeName := CamelCaseSlice(message.TypeName())
+	// The constants share the message's prefix with its nested types, the
+	// values of its nested enums and its oneof wrapper types.
+	used := make(map[string]bool)
+	for _, desc := range message.nested {
+		used[CamelCaseSlice(desc.TypeName())] = true
+	}
+	for _, enum := range message.enums {
+		used[CamelCaseSlice(enum.TypeName())] = true
+		for _, e := range enum.Value {
+			used[enum.prefix()+e.GetName()] = true
+		}
+	}
+	for _, t := range oneofTypeName {
+		used[t] = true
+	}
+	// The map is package-level, like the types of the file.
+	for _, e := range g.file.enum {
+		used[CamelCaseSlice(e.TypeName())] = true
+	}
+	for _, d := range g.file.desc {
+		used[CamelCaseSlice(d.TypeName())] = true
+	}
+
+	g.P("// Field numbers of ", ccTypeName, ".")
+	g.P("const (")
+	for _, field := range message.Field {
+		name := ccTypeName + "_Field" + fieldNames[field]
+		for used[name] {
+			name += "_"
+		}
+		used[name] = true
+		g.P(name, " = ", strconv.Itoa(int(field.GetNumber())))
+	}
+	g.P(")")
+	g.P()
+	names := ccTypeName + "FieldNames"
+	for used[names] {
+		names += "_"
+	}
+	g.P("// ", names, " maps the field numbers of ", ccTypeName, " to the fields' names.")
+	g.P("var ", names, " = map[int32]string{")
+	for _, field := range message.Field {
+		g.P(strconv.Itoa(int(field.GetNumber())), ": ", strconv.Quote(field.GetName()), ",")
+	}
+	g.P("}")
+	g.P()
+}
+
+// generateEnumHelpers writes Parse<Enum> and <Enum>Values, and makes the
+// enum an encoding.TextMarshaler and encoding.TextUnmarshaler, so that it
+// can be used directly in flags and config files.
+func (g *Generator) generateEnumHelpers(enum *EnumDescriptor, ccTypeName string) {
+	// The functions are package-level, so they must not collide with the
+	// types of the file.
+	used := make(map[string]bool)
+	for _, e := range g.file.enum {
+		used[CamelCaseSlice(e.TypeName())] = true
+	}
+	for _, d := range g.file.desc {
+		used[CamelCaseSlice(d.TypeName())] = true
+	}
+	parse, values := "Parse"+ccTypeName, ccTypeName+"Values"
+	for used[parse] {
+		parse += "_"
+	}
+	for used[values] {
+		values += "_"
+	}
+
+	g.P("// ", parse, " returns the ", ccTypeName, " named s; s may also be a number.")
+	g.P("func ", parse, "(s string) (", ccTypeName, ", error) {")
+	g.P("value, err := ", g.Pkg["proto"], ".ParseEnum(", ccTypeName, `_value, s, "`, ccTypeName, `")`)
+	g.P("return ", ccTypeName, "(value), err")
+	g.P("}")
+	g.P()
+	g.P("// ", values, " returns the values of ", ccTypeName, " in declaration order, without aliases.")
+	g.P("func ", values, "() []", ccTypeName, " {")
+	g.P("return []", ccTypeName, "{")
+	ccPrefix := enum.prefix()
+	generated := make(map[int32]bool)
+	for _, e := range enum.Value {
+		if generated[e.GetNumber()] {
+			continue
+		}
+		generated[e.GetNumber()] = true
+		g.P(ccPrefix, e.GetName(), ",")
+	}
+	g.P("}")
+	g.P("}")
+	g.P()
+	g.P("func (x ", ccTypeName, ") MarshalText() ([]byte, error) {")
+	g.P("return []byte(x.String()), nil")
+	g.P("}")
+	g.P()
+	g.P("func (x *", ccTypeName, ") UnmarshalText(text []byte) error {")
+	g.P("value, err := ", parse, "(string(text))")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("*x = value")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
 func (g *Generator) generateEnumRegistration(enum *EnumDescriptor) {
 	// // We always print the full (proto-world) package name here.
 	pkg := enum.File().GetPackage()
@@ -0,0 +1,839 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+// recordingPlugin is a Plugin that records the parameters it is given.
+type recordingPlugin struct {
+	name   string
+	params map[string]string
+}
+
+func (p *recordingPlugin) Name() string { return p.name }
+func (p *recordingPlugin) SetParam(key, value string) error {
+	if p.params == nil {
+		p.params = make(map[string]string)
+	}
+	p.params[key] = value
+	return nil
+}
+func (p *recordingPlugin) Init(g *Generator)                    {}
+func (p *recordingPlugin) Generate(file *FileDescriptor)        {}
+func (p *recordingPlugin) GenerateImports(file *FileDescriptor) {}
+
+func TestPluginParams(t *testing.T) {
+	a, b, c := &recordingPlugin{name: "a"}, &recordingPlugin{name: "b"}, &recordingPlugin{name: "c"}
+
+	g := New()
+	g.plugins = []Plugin{a, b, c}
+	g.CommandLineParameters("plugins=a+b,a:x=1,a:y=,b:x=2,c:x=3,import_path=foo/bar")
+
+	if want := map[string]string{"x": "1", "y": ""}; !reflect.DeepEqual(a.params, want) {
+		t.Errorf("plugin a got %v, want %v", a.params, want)
+	}
+	if want := map[string]string{"x": "2"}; !reflect.DeepEqual(b.params, want) {
+		t.Errorf("plugin b got %v, want %v", b.params, want)
+	}
+	if c.params != nil {
+		t.Errorf("disabled plugin c got %v, want none", c.params)
+	}
+	if g.PackageImportPath != "foo/bar" {
+		t.Errorf("import_path = %q, want %q", g.PackageImportPath, "foo/bar")
+	}
+	if len(g.ImportMap) != 0 {
+		t.Errorf("namespaced parameters leaked into ImportMap: %v", g.ImportMap)
+	}
+}
+
+// orderedPlugin is a Plugin with ordering constraints.
+type orderedPlugin struct {
+	recordingPlugin
+	after []string
+}
+
+func (p *orderedPlugin) RunAfter() []string { return p.after }
+
+func pluginNames(ps []Plugin) []string {
+	var names []string
+	for _, p := range ps {
+		names = append(names, p.Name())
+	}
+	return names
+}
+
+func TestOrderPlugins(t *testing.T) {
+	newPlugin := func(name string, after ...string) Plugin {
+		return &orderedPlugin{recordingPlugin{name: name}, after}
+	}
+	tests := []struct {
+		in   []Plugin
+		want []string
+	}{
+		{nil, nil},
+		{[]Plugin{newPlugin("b"), newPlugin("a")}, []string{"a", "b"}},
+		{[]Plugin{newPlugin("metrics", "carno"), newPlugin("carno")}, []string{"carno", "metrics"}},
+		{[]Plugin{newPlugin("a", "c"), newPlugin("b"), newPlugin("c", "b")}, []string{"b", "c", "a"}},
+		// Constraints on plugins that are not enabled are ignored.
+		{[]Plugin{newPlugin("b", "zzz"), newPlugin("a", "b")}, []string{"b", "a"}},
+		{[]Plugin{&recordingPlugin{name: "y"}, newPlugin("x", "y")}, []string{"y", "x"}},
+	}
+	for _, tc := range tests {
+		got, err := orderPlugins(tc.in)
+		if err != nil {
+			t.Errorf("orderPlugins(%v): %v", pluginNames(tc.in), err)
+			continue
+		}
+		if !reflect.DeepEqual(pluginNames(got), tc.want) {
+			t.Errorf("orderPlugins(%v) = %v, want %v", pluginNames(tc.in), pluginNames(got), tc.want)
+		}
+	}
+
+	cyclic := []Plugin{newPlugin("a", "b"), newPlugin("b", "c"), newPlugin("c", "b"), newPlugin("d")}
+	_, err := orderPlugins(cyclic)
+	if want := "plugin ordering cycle: b -> c -> b"; err == nil || err.Error() != want {
+		t.Errorf("orderPlugins(%v) error = %v, want %q", pluginNames(cyclic), err, want)
+	}
+}
+
+var (
+	eTimeout = &proto.ExtensionDesc{
+		ExtendedType:  (*descriptor.MethodOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         50000,
+		Name:          "test.timeout",
+		Tag:           "bytes,50000,opt,name=timeout",
+	}
+	eRetries = &proto.ExtensionDesc{
+		ExtendedType:  (*descriptor.MethodOptions)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         50001,
+		Name:          "test.retries",
+		Tag:           "varint,50001,opt,name=retries,def=3",
+	}
+)
+
+func TestMethodOption(t *testing.T) {
+	g := New()
+	method := &descriptor.MethodDescriptorProto{Name: proto.String("Get")}
+	if v := g.MethodOption(method, eTimeout); v != nil {
+		t.Errorf("unset option without options: got %v, want nil", v)
+	}
+	if v := g.MethodOption(method, eRetries); v == nil || *v.(*int32) != 3 {
+		t.Errorf("unset option with default: got %v, want 3", v)
+	}
+
+	method.Options = new(descriptor.MethodOptions)
+	if err := proto.SetExtension(method.Options, eTimeout, proto.String("5s")); err != nil {
+		t.Fatal(err)
+	}
+	if v := g.MethodOption(method, eTimeout); v == nil || *v.(*string) != "5s" {
+		t.Errorf("set option: got %v, want 5s", v)
+	}
+	if v := g.MethodOption(method, eRetries); v == nil || *v.(*int32) != 3 {
+		t.Errorf("unset option with default: got %v, want 3", v)
+	}
+}
+
+func TestComments(t *testing.T) {
+	file := &FileDescriptor{
+		FileDescriptorProto: &descriptor.FileDescriptorProto{
+			SourceCodeInfo: &descriptor.SourceCodeInfo{
+				Location: []*descriptor.SourceCodeInfo_Location{
+					{Path: []int32{4, 0}, LeadingComments: proto.String(" A message.\n")},
+					{
+						Path:                    []int32{4, 0, 2, 0},
+						LeadingDetachedComments: []string{" Section one.\n"},
+						TrailingComments:        proto.String(" A field.\n"),
+					},
+					{Path: []int32{4, 0, 2, 1}},
+				},
+			},
+		},
+	}
+	extractComments(file)
+	g := New()
+	g.file = file
+
+	tests := []struct {
+		path string
+		want Comments
+	}{
+		{"4,0", Comments{Leading: " A message.\n"}},
+		{"4,0,2,0", Comments{LeadingDetached: []string{" Section one.\n"}, Trailing: " A field.\n"}},
+		{"4,0,2,1", Comments{}},
+		{"4,1", Comments{}},
+	}
+	for _, tc := range tests {
+		if got := g.Comments(tc.path); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Comments(%q) = %+v, want %+v", tc.path, got, tc.want)
+		}
+	}
+
+	// PrintComments prints only leading comments.
+	g.writeOutput = true
+	if !g.PrintComments("4,0") {
+		t.Error("PrintComments(4,0) = false, want true")
+	}
+	if g.PrintComments("4,0,2,0") {
+		t.Error("PrintComments(4,0,2,0) = true, want false")
+	}
+	if got, want := g.String(), "// A message.\n"; got != want {
+		t.Errorf("printed %q, want %q", got, want)
+	}
+}
+
+func TestErrorf(t *testing.T) {
+	file := &FileDescriptor{
+		FileDescriptorProto: &descriptor.FileDescriptorProto{
+			Name: proto.String("a.proto"),
+			SourceCodeInfo: &descriptor.SourceCodeInfo{
+				Location: []*descriptor.SourceCodeInfo_Location{
+					{Path: []int32{6, 0}, Span: []int32{9, 0, 12, 1}},
+				},
+			},
+		},
+	}
+	extractComments(file)
+	g := New()
+	g.file = file
+
+	// Problems in files that are not being generated are ignored.
+	g.Errorf("6,0", "ignored")
+	if len(g.diagnostics) != 0 {
+		t.Fatalf("diagnostics = %q, want none", g.diagnostics)
+	}
+
+	g.writeOutput = true
+	g.Errorf("6,0", "bad service %s", "S")
+	g.Errorf("6,0", "bad service %s", "S") // reported once
+	g.Errorf("", "bad file")
+	want := []string{
+		"a.proto:10:1: bad service S",
+		"a.proto: bad file",
+	}
+	if !reflect.DeepEqual(g.diagnostics, want) {
+		t.Errorf("diagnostics = %q, want %q", g.diagnostics, want)
+	}
+}
+
+func TestAnnotations(t *testing.T) {
+	file := &FileDescriptor{FileDescriptorProto: &descriptor.FileDescriptorProto{Name: proto.String("a.proto")}}
+	g := New()
+	g.writeOutput = true
+	g.annotateCode = true
+	g.P("type ", Annotate(file, "4,0", "Foo"), "   struct {")
+	g.P(Annotate(file, "4,0,2,0", "Bar", "Baz"), "   int32")
+	g.P("}")
+
+	// Prepend a header, as generate does.
+	const header = "package p\n\n"
+	g.shiftAnnotations(0, len(header))
+	raw := []byte(header + g.String())
+	formatted, err := format.Source(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.remapAnnotations(g.annotations, raw, formatted)
+
+	want := []struct {
+		path []int32
+		text string
+	}{
+		{[]int32{4, 0}, "Foo"},
+		{[]int32{4, 0, 2, 0}, "BarBaz"},
+	}
+	if len(g.annotations) != len(want) {
+		t.Fatalf("got %d annotations, want %d", len(g.annotations), len(want))
+	}
+	for i, a := range g.annotations {
+		if got := string(formatted[a.GetBegin():a.GetEnd()]); got != want[i].text || !reflect.DeepEqual(a.Path, want[i].path) || a.GetSourceFile() != "a.proto" {
+			t.Errorf("annotation %d: %s %v %q, want a.proto %v %q", i, a.GetSourceFile(), a.Path, got, want[i].path, want[i].text)
+		}
+	}
+}
+
+func TestAddImport(t *testing.T) {
+	g := New()
+	g.file = &FileDescriptor{FileDescriptorProto: &descriptor.FileDescriptorProto{Name: proto.String("a.proto")}}
+	g.Pkg = map[string]string{"fmt": "fmt", "math": "math", "proto": "proto"}
+	g.usedPackages = make(map[string]bool)
+	g.addedImports = make(map[string]bool)
+	g.writeOutput = true
+
+	// A proto package already uses the name.
+	g.RegisterUniquePackageName("addimporttest", nil)
+	for _, test := range []struct {
+		path, want string
+	}{
+		{"example.com/x/addimporttest", "addimporttest1"},
+		{"context", "context"},
+		{"example.com/x/addimporttest", "addimporttest1"},
+		{"example.com/y/addimporttest", "addimporttest2"},
+		{"github.com/golang/protobuf/proto", "proto"},
+		{"fmt", "fmt"},
+	} {
+		if got := g.AddImport(test.path); got != test.want {
+			t.Errorf("AddImport(%q) = %q, want %q", test.path, got, test.want)
+		}
+	}
+
+	g.generateImports()
+	// Before gofmt, so without indentation.
+	want := `import (
+context "context"
+fmt "fmt"
+math "math"
+
+addimporttest1 "example.com/x/addimporttest"
+addimporttest2 "example.com/y/addimporttest"
+proto "github.com/golang/protobuf/proto"
+)
+`
+	if got := g.String(); !strings.HasPrefix(got, want) {
+		t.Errorf("imports:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestImportOrder(t *testing.T) {
+	want := []importSpec{
+		{"context", "context"},
+		{"fmt", "fmt"},
+		{"a", "example.com/a"},
+		{"_", "example.com/b"},
+		{"b", "example.com/b"},
+	}
+	// Every starting order sorts the same.
+	for i := range want {
+		imports := append(append([]importSpec(nil), want[i:]...), want[:i]...)
+		for j, k := 0, len(imports)-1; j < k; j, k = j+1, k-1 {
+			imports[j], imports[k] = imports[k], imports[j]
+		}
+		sort.Sort(byImportGroup(imports))
+		if !reflect.DeepEqual(imports, want) {
+			t.Errorf("sorted imports = %v, want %v", imports, want)
+		}
+	}
+}
+
+var testTemplate = template.Must(template.New("test").Funcs(TemplateFuncs()).Parse(`
+{{- comments .Path}}
+type {{annotate .Path (camelCase .Name)}} {{import "example.com/x/tmpltest"}}.Base
+const name = {{quote .Name}}
+`))
+
+func TestExecuteTemplate(t *testing.T) {
+	file := &FileDescriptor{
+		FileDescriptorProto: &descriptor.FileDescriptorProto{
+			Name: proto.String("a.proto"),
+			SourceCodeInfo: &descriptor.SourceCodeInfo{
+				Location: []*descriptor.SourceCodeInfo_Location{
+					{Path: []int32{6, 0}, LeadingComments: proto.String(" A service.\n Of tests.\n")},
+				},
+			},
+		},
+	}
+	extractComments(file)
+	g := New()
+	g.file = file
+	g.usedPackages = make(map[string]bool)
+	g.addedImports = make(map[string]bool)
+	g.writeOutput = true
+	g.annotateCode = true
+
+	g.P("// header")
+	data := struct{ Path, Name string }{"6,0", "my_service"}
+	if err := g.ExecuteTemplate(testTemplate, data); err != nil {
+		t.Fatal(err)
+	}
+	want := `// header
+// A service.
+// Of tests.
+type MyService tmpltest.Base
+const name = "my_service"
+`
+	if got := g.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	if !g.addedImports["example.com/x/tmpltest"] {
+		t.Error("template import was not added")
+	}
+	if len(g.annotations) != 1 {
+		t.Fatalf("got %d annotations, want 1", len(g.annotations))
+	}
+	a := g.annotations[0]
+	if got := g.String()[a.GetBegin():a.GetEnd()]; got != "MyService" || !reflect.DeepEqual(a.Path, []int32{6, 0}) {
+		t.Errorf("annotation %v covers %q, want [6 0] MyService", a.Path, got)
+	}
+
+	// Nothing is written for files that are not generated.
+	g.Reset()
+	g.writeOutput = false
+	if err := g.ExecuteTemplate(testTemplate, data); err != nil {
+		t.Fatal(err)
+	}
+	if g.Len() != 0 {
+		t.Errorf("wrote %q for a file not being generated", g.String())
+	}
+}
+
+func TestImportMap(t *testing.T) {
+	common := &descriptor.FileDescriptorProto{
+		Name:        proto.String("common.proto"),
+		Package:     proto.String("common"),
+		MessageType: []*descriptor.DescriptorProto{{Name: proto.String("Ref")}},
+	}
+	svc := &descriptor.FileDescriptorProto{
+		Name:       proto.String("svc.proto"),
+		Package:    proto.String("demo"),
+		Dependency: []string{"common.proto"},
+		Options:    &descriptor.FileOptions{GoPackage: proto.String("example.com/demo")},
+		MessageType: []*descriptor.DescriptorProto{{
+			Name: proto.String("Req"),
+			Field: []*descriptor.FieldDescriptorProto{{
+				Name:     proto.String("ref"),
+				Number:   proto.Int32(1),
+				Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptor.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String(".common.Ref"),
+			}},
+		}},
+	}
+	for _, test := range []struct {
+		param       string
+		field       string // the getter of the field
+		import_, no string // a line that must, or must not, be generated
+	}{
+		{
+			param:   "Mcommon.proto=example.com/shared/commonpb",
+			field:   "GetRef() *common.Ref",
+			import_: `common "example.com/shared/commonpb"`,
+		},
+		{
+			// The M parameter puts common.proto in our own package.
+			param: "Mcommon.proto=example.com/demo",
+			field: "GetRef() *Ref",
+			no:    `"example.com/demo"`,
+		},
+	} {
+		g := New()
+		g.Request.FileToGenerate = []string{"svc.proto"}
+		g.Request.ProtoFile = []*descriptor.FileDescriptorProto{common, svc}
+		g.CommandLineParameters(test.param)
+		g.WrapTypes()
+		g.SetPackageNames()
+		g.BuildTypeNameMap()
+		g.GenerateAllFiles()
+		if g.Response.Error != nil {
+			t.Fatalf("%s: %s", test.param, g.Response.GetError())
+		}
+		got := g.Response.File[0].GetContent()
+		if !strings.Contains(got, test.field) {
+			t.Errorf("%s: no getter %q in\n%s", test.param, test.field, got)
+		}
+		if test.import_ != "" && !strings.Contains(got, test.import_) {
+			t.Errorf("%s: no import %s in\n%s", test.param, test.import_, got)
+		}
+		if test.no != "" && strings.Contains(got, test.no) {
+			t.Errorf("%s: unexpected %s in\n%s", test.param, test.no, got)
+		}
+	}
+}
+
+func TestFormatFiles(t *testing.T) {
+	g := New()
+	var files []*generatedFile
+	for i := 0; i < 20; i++ {
+		files = append(files, &generatedFile{
+			name: fmt.Sprintf("f%d.pb.go", i),
+			raw:  []byte(fmt.Sprintf("package p\nconst   C%d =   %d\n", i, i)),
+		})
+	}
+	g.formatFiles(files)
+	for i, f := range files {
+		want, err := format.Source(f.raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.name != fmt.Sprintf("f%d.pb.go", i) || !bytes.Equal(f.content, want) {
+			t.Errorf("file %d: %s =\n%s\nwant:\n%s", i, f.name, f.content, want)
+		}
+	}
+}
+
+func TestFormatFileSyntaxError(t *testing.T) {
+	g := New()
+	f := &generatedFile{
+		name: "a.pb.go",
+		raw:  []byte("package p\n\nvar a = 1\nvar b = )\nvar c = 3\n"),
+	}
+	g.formatFile(f)
+	if f.err == nil {
+		t.Fatal("formatFile succeeded, want error")
+	}
+	for _, want := range []string{"a.pb.go:4:", "\n     3\tvar a = 1", "\n>    4\tvar b = )"} {
+		if !strings.Contains(f.err.Error(), want) {
+			t.Errorf("error %q does not contain %q", f.err, want)
+		}
+	}
+}
+
+func TestPruneImports(t *testing.T) {
+	const src = `package p
+
+import (
+	fmt "fmt"
+	math "math"
+
+	_ "example.com/dep"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type Foo struct{}
+
+func (m *Foo) String() string { return proto.CompactTextString(m) }
+`
+	const want = `package p
+
+import (
+	_ "example.com/dep"
+	proto "github.com/golang/protobuf/proto"
+)
+
+type Foo struct{}
+
+func (m *Foo) String() string { return proto.CompactTextString(m) }
+`
+	g := New()
+	g.goimports = true
+	g.annotateCode = true
+	foo := strings.Index(src, "Foo")
+	f := &generatedFile{
+		name: "a.pb.go",
+		raw:  []byte(src),
+		annotations: []*descriptor.GeneratedCodeInfo_Annotation{
+			{Begin: proto.Int32(int32(foo)), End: proto.Int32(int32(foo + 3))},
+		},
+	}
+	g.formatFile(f)
+	if f.err != nil {
+		t.Fatal(f.err)
+	}
+	if string(f.content) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", f.content, want)
+	}
+	a := f.annotations[0]
+	if got := string(f.content[a.GetBegin():a.GetEnd()]); got != "Foo" {
+		t.Errorf("annotation covers %q, want Foo", got)
+	}
+}
+
+// serviceNamesPlugin is a Plugin that declares a constant for each service.
+type serviceNamesPlugin struct {
+	recordingPlugin
+	g *Generator
+}
+
+func (p *serviceNamesPlugin) Init(g *Generator) { p.g = g }
+func (p *serviceNamesPlugin) Generate(file *FileDescriptor) {
+	for _, s := range file.Service {
+		p.g.P("const ", s.GetName(), "Name = ", strconv.Quote(s.GetName()))
+	}
+}
+
+func newServiceNamesPlugin() Plugin {
+	return &serviceNamesPlugin{recordingPlugin: recordingPlugin{name: "names"}}
+}
+
+// namesRequest returns a request, with param, for two files: msgs.proto,
+// which has a message, and svc.proto, which has a service.
+func namesRequest(param string) *plugin.CodeGeneratorRequest {
+	msgs := &descriptor.FileDescriptorProto{
+		Name:        proto.String("msgs.proto"),
+		Package:     proto.String("demo"),
+		MessageType: []*descriptor.DescriptorProto{{Name: proto.String("Req")}},
+	}
+	svc := &descriptor.FileDescriptorProto{
+		Name:       proto.String("svc.proto"),
+		Package:    proto.String("demo"),
+		Dependency: []string{"msgs.proto"},
+		Service:    []*descriptor.ServiceDescriptorProto{{Name: proto.String("Echo")}},
+	}
+	return &plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{"msgs.proto", "svc.proto"},
+		Parameter:      proto.String(param),
+		ProtoFile:      []*descriptor.FileDescriptorProto{msgs, svc},
+	}
+}
+
+// runNames runs g, or a new generator with just serviceNamesPlugin if g is
+// nil, for namesRequest(param). It returns the generated files by name.
+func runNames(t *testing.T, g *Generator, pluginOutput, param string) map[string]string {
+	if g == nil {
+		g = NewWithPlugins(newServiceNamesPlugin)
+	}
+	g.PluginOutput = pluginOutput
+	g.Request = namesRequest(param)
+	if err := g.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if g.Response.Error != nil {
+		t.Fatal(g.Response.GetError())
+	}
+	files := make(map[string]string)
+	for _, f := range g.Response.File {
+		files[f.GetName()] = f.GetContent()
+	}
+	return files
+}
+
+// checkNamesOnly checks that content has the code of serviceNamesPlugin
+// and none of the stock output.
+func checkNamesOnly(t *testing.T, content string) {
+	if !strings.Contains(content, `const EchoName = "Echo"`) {
+		t.Errorf("no plugin output in\n%s", content)
+	}
+	for _, no := range []string{"type Req struct", "RegisterType", "fileDescriptor", "Package demo is"} {
+		if strings.Contains(content, no) {
+			t.Errorf("unexpected %q in\n%s", no, content)
+		}
+	}
+}
+
+func TestPluginOutput(t *testing.T) {
+	files := runNames(t, nil, "names", "")
+	// msgs.proto has no services, so it gets no file.
+	if len(files) != 1 {
+		t.Fatalf("got files %v, want just svc_names.pb.go", files)
+	}
+	content, ok := files["svc_names.pb.go"]
+	if !ok {
+		t.Fatalf("no svc_names.pb.go in %v", files)
+	}
+	checkNamesOnly(t, content)
+}
+
+func TestSeparateFiles(t *testing.T) {
+	files := runNames(t, nil, "", "plugins=names,separate_files=true")
+	if len(files) != 3 {
+		t.Errorf("got %d files, want msgs.pb.go, svc.pb.go and svc_names.pb.go", len(files))
+	}
+	checkNamesOnly(t, files["svc_names.pb.go"])
+	if !strings.Contains(files["msgs.pb.go"], "type Req struct") {
+		t.Errorf("no message in msgs.pb.go:\n%s", files["msgs.pb.go"])
+	}
+	if strings.Contains(files["svc.pb.go"], "EchoName") {
+		t.Errorf("plugin output in svc.pb.go:\n%s", files["svc.pb.go"])
+	}
+
+	// Without separate_files, the plugin's code is in the stock output.
+	files = runNames(t, nil, "", "plugins=names")
+	if len(files) != 2 || !strings.Contains(files["svc.pb.go"], "EchoName") {
+		t.Errorf("got files %v, want msgs.pb.go and svc.pb.go with the plugin output", files)
+	}
+}
+
+func TestRun(t *testing.T) {
+	// Each generator chooses its package names afresh.
+	first := runNames(t, nil, "", "plugins=names")
+	if again := runNames(t, nil, "", "plugins=names"); !reflect.DeepEqual(again, first) {
+		t.Errorf("second run differs: got %v, want %v", again, first)
+	}
+	if !strings.Contains(first["svc.pb.go"], "package demo\n") {
+		t.Errorf("wrong package clause in\n%s", first["svc.pb.go"])
+	}
+
+	g := New()
+	g.Request.Parameter = proto.String("paths=bogus")
+	err := g.Run()
+	if err == nil || !strings.Contains(err.Error(), "bogus") {
+		t.Errorf("Run with paths=bogus: got error %v, want one about bogus", err)
+	}
+}
+
+func TestRunAgain(t *testing.T) {
+	// A run takes nothing from the last one on the same generator: not
+	// the plugins the plugins parameter left out, nor the package names.
+	g := NewWithPlugins(newServiceNamesPlugin)
+	files := runNames(t, g, "", "plugins=other")
+	if strings.Contains(files["svc.pb.go"], "EchoName") {
+		t.Errorf("plugin output with plugins=other:\n%s", files["svc.pb.go"])
+	}
+	files = runNames(t, g, "", "plugins=names")
+	if !strings.Contains(files["svc.pb.go"], "EchoName") {
+		t.Errorf("no plugin output in second run:\n%s", files["svc.pb.go"])
+	}
+	if want := runNames(t, nil, "", "plugins=names"); !reflect.DeepEqual(files, want) {
+		t.Errorf("second run differs from a new generator's: got %v, want %v", files, want)
+	}
+}
+
+// runCached runs the generator with cache_dir=dir and param for files,
+// and returns the generated files by name.
+func runCached(t *testing.T, dir, param string, files ...*descriptor.FileDescriptorProto) map[string]string {
+	g := New()
+	for _, f := range files {
+		g.Request.FileToGenerate = append(g.Request.FileToGenerate, f.GetName())
+	}
+	g.Request.ProtoFile = files
+	g.CommandLineParameters("cache_dir=" + dir + param)
+	g.WrapTypes()
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	g.GenerateAllFiles()
+	if g.Response.Error != nil {
+		t.Fatal(g.Response.GetError())
+	}
+	out := make(map[string]string)
+	for _, f := range g.Response.File {
+		out[f.GetName()] = f.GetContent()
+	}
+	return out
+}
+
+// markCached replaces the cached content of the output file name with a
+// marker, to tell whether the next run reads it from the cache.
+func markCached(t *testing.T, dir, name string) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		path := filepath.Join(dir, e.Name())
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := new(plugin.CodeGeneratorResponse)
+		if err := proto.Unmarshal(b, resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.File[0].GetName() != name {
+			continue
+		}
+		resp.File[0].Content = proto.String("cached")
+		if b, err = proto.Marshal(resp); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, b, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	t.Fatalf("%s is not cached", name)
+}
+
+func TestCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generator-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	msgs := &descriptor.FileDescriptorProto{
+		Name:        proto.String("msgs.proto"),
+		Package:     proto.String("demo"),
+		MessageType: []*descriptor.DescriptorProto{{Name: proto.String("Req")}},
+	}
+	svc := &descriptor.FileDescriptorProto{
+		Name:        proto.String("svc.proto"),
+		Package:     proto.String("demo"),
+		Dependency:  []string{"msgs.proto"},
+		MessageType: []*descriptor.DescriptorProto{{Name: proto.String("Resp")}},
+	}
+	other := &descriptor.FileDescriptorProto{
+		Name:        proto.String("other.proto"),
+		Package:     proto.String("demo"),
+		MessageType: []*descriptor.DescriptorProto{{Name: proto.String("Other")}},
+	}
+
+	first := runCached(t, dir, "", msgs, svc, other)
+	if len(first) != 3 {
+		t.Fatalf("got files %v, want three", first)
+	}
+	if got := runCached(t, dir, "", msgs, svc, other); !reflect.DeepEqual(got, first) {
+		t.Errorf("cached output differs:\n%v\nwant\n%v", got, first)
+	}
+
+	markCached(t, dir, "msgs.pb.go")
+	markCached(t, dir, "svc.pb.go")
+	markCached(t, dir, "other.pb.go")
+	got := runCached(t, dir, "", msgs, svc, other)
+	for name, content := range got {
+		if content != "cached" {
+			t.Errorf("%s was generated again", name)
+		}
+	}
+
+	// A change to msgs.proto affects svc.proto, which imports it, but not
+	// other.proto.
+	msgs = proto.Clone(msgs).(*descriptor.FileDescriptorProto)
+	msgs.MessageType = append(msgs.MessageType, &descriptor.DescriptorProto{Name: proto.String("Extra")})
+	got = runCached(t, dir, "", msgs, svc, other)
+	if !strings.Contains(got["msgs.pb.go"], "type Extra struct") {
+		t.Errorf("msgs.pb.go was not generated again:\n%s", got["msgs.pb.go"])
+	}
+	if got["svc.pb.go"] == "cached" {
+		t.Errorf("svc.pb.go was not generated again")
+	}
+	if got["other.pb.go"] != "cached" {
+		t.Errorf("other.pb.go was generated again")
+	}
+
+	// So does a change of parameter to all files.
+	got = runCached(t, dir, ",paths=source_relative", msgs, svc, other)
+	if got["other.pb.go"] == "cached" {
+		t.Errorf("other.pb.go was not generated again")
+	}
+}
@@ -84,6 +84,37 @@ func TestGoPackageOption(t *testing.T) {
 	}
 }
 
+func TestGoOutputName(t *testing.T) {
+	tests := []struct {
+		name, goPkg string
+		param       string
+		want        string
+	}{
+		{"dir/foo.proto", "", "", "dir/foo.pb.go"},
+		{"dir/foo.proto", "github.com/golang/bar", "", "github.com/golang/bar/foo.pb.go"},
+		{"dir/foo.proto", "github.com/golang/bar", "paths=import", "github.com/golang/bar/foo.pb.go"},
+		{"dir/foo.proto", "github.com/golang/bar", "paths=source_relative", "dir/foo.pb.go"},
+		{"dir/foo.proto", "github.com/golang/bar;baz", "paths=source_relative", "dir/foo.pb.go"},
+		{"dir/foo.proto", "github.com/golang/bar", "module=github.com/golang", "bar/foo.pb.go"},
+		{"dir/foo.proto", "github.com/golang/bar", "module=github.com/golang/", "bar/foo.pb.go"},
+	}
+	for _, tc := range tests {
+		d := &FileDescriptor{
+			FileDescriptorProto: &descriptor.FileDescriptorProto{
+				Name: &tc.name,
+				Options: &descriptor.FileOptions{
+					GoPackage: &tc.goPkg,
+				},
+			},
+		}
+		g := New()
+		g.CommandLineParameters(tc.param)
+		if got := g.GoOutputName(d); got != tc.want {
+			t.Errorf("%q with go_package %q, %q => %q, want %q", tc.name, tc.goPkg, tc.param, got, tc.want)
+		}
+	}
+}
+
 func TestUnescape(t *testing.T) {
 	tests := []struct {
 		in   string
@@ -0,0 +1,198 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// A ServiceView describes a service of the current file to templates
+// run by ExecuteTemplate. See ServiceViews.
+type ServiceView struct {
+	Desc     *descriptor.ServiceDescriptorProto
+	Path     string // source path, for annotate and comments
+	Name     string // as declared in the .proto file
+	FullName string // qualified by the package of the file, if any
+	GoName   string // CamelCased
+	Methods  []*MethodView
+}
+
+// A MethodView describes a method of a service to templates.
+type MethodView struct {
+	Service *ServiceView
+	Desc    *descriptor.MethodDescriptorProto
+	Path    string // source path, for annotate and comments
+	Name    string // as declared in the .proto file
+	GoName  string // CamelCased
+
+	// Go names of the request and response types, as the current file
+	// refers to them. Their uses are recorded, so the packages defining
+	// them are imported.
+	InputType, OutputType string
+
+	// Fully-qualified names of the request and response types, without
+	// the leading dot.
+	InputName, OutputName string
+
+	ClientStreaming, ServerStreaming bool
+}
+
+// ServiceViews returns views of the services of file, the file being
+// generated, for use as template data.
+func (g *Generator) ServiceViews(file *FileDescriptor) []*ServiceView {
+	var views []*ServiceView
+	for i, sd := range file.Service {
+		sv := &ServiceView{
+			Desc:     sd,
+			Path:     fmt.Sprintf("6,%d", i), // 6 means service.
+			Name:     sd.GetName(),
+			FullName: sd.GetName(),
+			GoName:   CamelCase(sd.GetName()),
+		}
+		if pkg := file.GetPackage(); pkg != "" {
+			sv.FullName = pkg + "." + sv.FullName
+		}
+		for j, md := range sd.Method {
+			sv.Methods = append(sv.Methods, &MethodView{
+				Service:         sv,
+				Desc:            md,
+				Path:            fmt.Sprintf("%s,2,%d", sv.Path, j), // 2 means method in a service.
+				Name:            md.GetName(),
+				GoName:          CamelCase(md.GetName()),
+				InputType:       g.templateTypeName(md.GetInputType()),
+				OutputType:      g.templateTypeName(md.GetOutputType()),
+				InputName:       strings.TrimPrefix(md.GetInputType(), "."),
+				OutputName:      strings.TrimPrefix(md.GetOutputType(), "."),
+				ClientStreaming: md.GetClientStreaming(),
+				ServerStreaming: md.GetServerStreaming(),
+			})
+		}
+		views = append(views, sv)
+	}
+	return views
+}
+
+// templateTypeName returns the Go name of the type with the given
+// fully-qualified name, and records its use.
+func (g *Generator) templateTypeName(name string) string {
+	g.RecordTypeUse(name)
+	return g.TypeName(g.ObjectNamed(name))
+}
+
+// TemplateFuncs returns the functions that ExecuteTemplate makes available
+// to templates. They must be added to a template before it is parsed:
+//
+//	var clientTemplate = template.Must(template.New("client").
+//		Funcs(generator.TemplateFuncs()).Parse(clientText))
+//
+// The functions are:
+//
+//	annotate path text  text, linked to the element at path for annotate_code.
+//	                    It must be the last command of its action.
+//	camelCase s         CamelCase(s).
+//	comments path       the leading comments of the element at path, as // lines.
+//	import path         the name under which the file imports path (AddImport).
+//	quote s             s as a Go string literal.
+//	typeName name       the Go name of the fully-qualified type name, whose
+//	                    use is recorded.
+func TemplateFuncs() template.FuncMap {
+	return (*Generator)(nil).templateFuncs()
+}
+
+func (g *Generator) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"annotate":  func(path, text string) string { return g.annotateTemplateText(path, text) },
+		"camelCase": CamelCase,
+		"comments":  func(path string) string { return commentLines(g.Comments(path).Leading) },
+		"import":    func(path string) string { return g.AddImport(path) },
+		"quote":     strconv.Quote,
+		"typeName":  func(name string) string { return g.templateTypeName(name) },
+	}
+}
+
+// annotateTemplateText records an annotation for text, which the template
+// being executed is about to write at the end of g.Buffer.
+func (g *Generator) annotateTemplateText(path, text string) string {
+	if g.writeOutput && g.annotateCode {
+		begin := g.Len()
+		a := Annotate(g.file, path)
+		g.annotations = append(g.annotations, &descriptor.GeneratedCodeInfo_Annotation{
+			SourceFile: proto.String(a.source),
+			Path:       a.path,
+			Begin:      proto.Int32(int32(begin)),
+			End:        proto.Int32(int32(begin + len(text))),
+		})
+	}
+	return text
+}
+
+// commentLines returns text, a comment as held in Comments, as a block of
+// // comments without a final newline.
+func commentLines(text string) string {
+	if text == "" {
+		return ""
+	}
+	var buf bytes.Buffer
+	for i, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+		buf.WriteString("// " + strings.TrimPrefix(line, " "))
+	}
+	return buf.String()
+}
+
+// ExecuteTemplate writes the output of t, applied to data, to the generated
+// output, as P would. The template may call the functions listed under
+// TemplateFuncs; indentation and spacing do not matter, since the output is
+// formatted afterwards. A plugin usually passes an error to Fail.
+func (g *Generator) ExecuteTemplate(t *template.Template, data interface{}) error {
+	t, err := t.Clone()
+	if err != nil {
+		return err
+	}
+	t.Funcs(g.templateFuncs())
+	var w io.Writer = g.Buffer
+	if !g.writeOutput {
+		w = ioutil.Discard
+	}
+	return t.Execute(w, data)
+}
@@ -30,8 +30,8 @@
 // OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
 
 // Package grpc outputs gRPC service descriptions in Go code.
-// It runs as a plugin for the Go protocol buffer compiler plugin.
-// It is linked in to protoc-gen-go.
+// It runs as a plugin for the Go protocol buffer compiler plugin, added
+// to a generator of github.com/golang/protobuf with NewWithPlugins(New).
 package grpc
 
 import (
@@ -57,14 +57,20 @@ const (
 	grpcPkgPath    = "google.golang.org/grpc"
 )
 
-func init() {
-	generator.RegisterPlugin(new(grpc))
-}
+// New returns a new instance of the grpc plugin, for a generator created
+// with generator.NewWithPlugins.
+func New() generator.Plugin { return new(grpc) }
 
 // grpc is an implementation of the Go protocol buffer compiler's
 // plugin architecture.  It generates bindings for gRPC support.
 type grpc struct {
 	gen *generator.Generator
+
+	// The names for packages imported in the generated code.
+	// They may vary from the final path component of the import path
+	// if the name is used by other packages.
+	contextPkg string
+	grpcPkg    string
 }
 
 // Name returns the name of this plugin, "grpc".
@@ -72,19 +78,14 @@ func (g *grpc) Name() string {
 	return "grpc"
 }
 
-// The names for packages imported in the generated code.
-// They may vary from the final path component of the import path
-// if the name is used by other packages.
-var (
-	contextPkg string
-	grpcPkg    string
-)
+// SetParam rejects all parameters; the grpc plugin has none.
+func (g *grpc) SetParam(key, value string) error {
+	return fmt.Errorf("unknown parameter %q", key)
+}
 
 // Init initializes the plugin.
 func (g *grpc) Init(gen *generator.Generator) {
 	g.gen = gen
-	contextPkg = generator.RegisterUniquePackageName("context", nil)
-	grpcPkg = generator.RegisterUniquePackageName("grpc", nil)
 }
 
 // Given a type name defined in a .proto, return its object.
@@ -107,16 +108,18 @@ func (g *grpc) Generate(file *generator.FileDescriptor) {
 	if len(file.FileDescriptorProto.Service) == 0 {
 		return
 	}
+	g.contextPkg = g.gen.AddImport(path.Join(g.gen.ImportPrefix, contextPkgPath))
+	g.grpcPkg = g.gen.AddImport(path.Join(g.gen.ImportPrefix, grpcPkgPath))
 
 	g.P("// Reference imports to suppress errors if they are not otherwise used.")
-	g.P("var _ ", contextPkg, ".Context")
-	g.P("var _ ", grpcPkg, ".ClientConn")
+	g.P("var _ ", g.contextPkg, ".Context")
+	g.P("var _ ", g.grpcPkg, ".ClientConn")
 	g.P()
 
 	// Assert version compatibility.
 	g.P("// This is a compile-time assertion to ensure that this generated file")
 	g.P("// is compatible with the grpc package it is being compiled against.")
-	g.P("const _ = ", grpcPkg, ".SupportPackageIsVersion", generatedCodeVersion)
+	g.P("const _ = ", g.grpcPkg, ".SupportPackageIsVersion", generatedCodeVersion)
 	g.P()
 
 	for i, service := range file.FileDescriptorProto.Service {
@@ -124,17 +127,8 @@ func (g *grpc) Generate(file *generator.FileDescriptor) {
 	}
 }
 
-// GenerateImports generates the import declaration for this file.
-func (g *grpc) GenerateImports(file *generator.FileDescriptor) {
-	if len(file.FileDescriptorProto.Service) == 0 {
-		return
-	}
-	g.P("import (")
-	g.P(contextPkg, " ", strconv.Quote(path.Join(g.gen.ImportPrefix, contextPkgPath)))
-	g.P(grpcPkg, " ", strconv.Quote(path.Join(g.gen.ImportPrefix, grpcPkgPath)))
-	g.P(")")
-	g.P()
-}
+// GenerateImports does nothing; Generate adds its imports with AddImport.
+func (g *grpc) GenerateImports(file *generator.FileDescriptor) {}
 
 // reservedClientName records whether a client name is reserved on the client side.
 var reservedClientName = map[string]bool{
@@ -169,12 +163,12 @@ func (g *grpc) generateService(file *generator.FileDescriptor, service *pb.Servi
 
 	// Client structure.
 	g.P("type ", unexport(servName), "Client struct {")
-	g.P("cc *", grpcPkg, ".ClientConn")
+	g.P("cc *", g.grpcPkg, ".ClientConn")
 	g.P("}")
 	g.P()
 
 	// NewClient factory.
-	g.P("func New", servName, "Client (cc *", grpcPkg, ".ClientConn) ", servName, "Client {")
+	g.P("func New", servName, "Client (cc *", g.grpcPkg, ".ClientConn) ", servName, "Client {")
 	g.P("return &", unexport(servName), "Client{cc}")
 	g.P("}")
 	g.P()
@@ -210,7 +204,7 @@ func (g *grpc) generateService(file *generator.FileDescriptor, service *pb.Servi
 	g.P()
 
 	// Server registration.
-	g.P("func Register", servName, "Server(s *", grpcPkg, ".Server, srv ", serverType, ") {")
+	g.P("func Register", servName, "Server(s *", g.grpcPkg, ".Server, srv ", serverType, ") {")
 	g.P("s.RegisterService(&", serviceDescVar, `, srv)`)
 	g.P("}")
 	g.P()
@@ -223,10 +217,10 @@ func (g *grpc) generateService(file *generator.FileDescriptor, service *pb.Servi
 	}
 
 	// Service descriptor.
-	g.P("var ", serviceDescVar, " = ", grpcPkg, ".ServiceDesc {")
+	g.P("var ", serviceDescVar, " = ", g.grpcPkg, ".ServiceDesc {")
 	g.P("ServiceName: ", strconv.Quote(fullServName), ",")
 	g.P("HandlerType: (*", serverType, ")(nil),")
-	g.P("Methods: []", grpcPkg, ".MethodDesc{")
+	g.P("Methods: []", g.grpcPkg, ".MethodDesc{")
 	for i, method := range service.Method {
 		if method.GetServerStreaming() || method.GetClientStreaming() {
 			continue
@@ -237,7 +231,7 @@ func (g *grpc) generateService(file *generator.FileDescriptor, service *pb.Servi
 		g.P("},")
 	}
 	g.P("},")
-	g.P("Streams: []", grpcPkg, ".StreamDesc{")
+	g.P("Streams: []", g.grpcPkg, ".StreamDesc{")
 	for i, method := range service.Method {
 		if !method.GetServerStreaming() && !method.GetClientStreaming() {
 			continue
@@ -274,7 +268,7 @@ func (g *grpc) generateClientSignature(servName string, method *pb.MethodDescrip
 	if method.GetServerStreaming() || method.GetClientStreaming() {
 		respName = servName + "_" + generator.CamelCase(origMethName) + "Client"
 	}
-	return fmt.Sprintf("%s(ctx %s.Context%s, opts ...%s.CallOption) (%s, error)", methName, contextPkg, reqArg, grpcPkg, respName)
+	return fmt.Sprintf("%s(ctx %s.Context%s, opts ...%s.CallOption) (%s, error)", methName, g.contextPkg, reqArg, g.grpcPkg, respName)
 }
 
 func (g *grpc) generateClientMethod(servName, fullServName, serviceDescVar string, method *pb.MethodDescriptorProto, descExpr string) {
@@ -287,7 +281,7 @@ func (g *grpc) generateClientMethod(servName, fullServName, serviceDescVar strin
 	if !method.GetServerStreaming() && !method.GetClientStreaming() {
 		g.P("out := new(", outType, ")")
 		// TODO: Pass descExpr to Invoke.
-		g.P("err := ", grpcPkg, `.Invoke(ctx, "`, sname, `", in, out, c.cc, opts...)`)
+		g.P("err := ", g.grpcPkg, `.Invoke(ctx, "`, sname, `", in, out, c.cc, opts...)`)
 		g.P("if err != nil { return nil, err }")
 		g.P("return out, nil")
 		g.P("}")
@@ -295,7 +289,7 @@ func (g *grpc) generateClientMethod(servName, fullServName, serviceDescVar strin
 		return
 	}
 	streamType := unexport(servName) + methName + "Client"
-	g.P("stream, err := ", grpcPkg, ".NewClientStream(ctx, ", descExpr, `, c.cc, "`, sname, `", opts...)`)
+	g.P("stream, err := ", g.grpcPkg, ".NewClientStream(ctx, ", descExpr, `, c.cc, "`, sname, `", opts...)`)
 	g.P("if err != nil { return nil, err }")
 	g.P("x := &", streamType, "{stream}")
 	if !method.GetClientStreaming() {
@@ -321,12 +315,12 @@ func (g *grpc) generateClientMethod(servName, fullServName, serviceDescVar strin
 	if genCloseAndRecv {
 		g.P("CloseAndRecv() (*", outType, ", error)")
 	}
-	g.P(grpcPkg, ".ClientStream")
+	g.P(g.grpcPkg, ".ClientStream")
 	g.P("}")
 	g.P()
 
 	g.P("type ", streamType, " struct {")
-	g.P(grpcPkg, ".ClientStream")
+	g.P(g.grpcPkg, ".ClientStream")
 	g.P("}")
 	g.P()
 
@@ -366,7 +360,7 @@ func (g *grpc) generateServerSignature(servName string, method *pb.MethodDescrip
 	var reqArgs []string
 	ret := "error"
 	if !method.GetServerStreaming() && !method.GetClientStreaming() {
-		reqArgs = append(reqArgs, contextPkg+".Context")
+		reqArgs = append(reqArgs, g.contextPkg+".Context")
 		ret = "(*" + g.typeName(method.GetOutputType()) + ", error)"
 	}
 	if !method.GetClientStreaming() {
@@ -386,15 +380,15 @@ func (g *grpc) generateServerMethod(servName, fullServName string, method *pb.Me
 	outType := g.typeName(method.GetOutputType())
 
 	if !method.GetServerStreaming() && !method.GetClientStreaming() {
-		g.P("func ", hname, "(srv interface{}, ctx ", contextPkg, ".Context, dec func(interface{}) error, interceptor ", grpcPkg, ".UnaryServerInterceptor) (interface{}, error) {")
+		g.P("func ", hname, "(srv interface{}, ctx ", g.contextPkg, ".Context, dec func(interface{}) error, interceptor ", g.grpcPkg, ".UnaryServerInterceptor) (interface{}, error) {")
 		g.P("in := new(", inType, ")")
 		g.P("if err := dec(in); err != nil { return nil, err }")
 		g.P("if interceptor == nil { return srv.(", servName, "Server).", methName, "(ctx, in) }")
-		g.P("info := &", grpcPkg, ".UnaryServerInfo{")
+		g.P("info := &", g.grpcPkg, ".UnaryServerInfo{")
 		g.P("Server: srv,")
 		g.P("FullMethod: ", strconv.Quote(fmt.Sprintf("/%s/%s", fullServName, methName)), ",")
 		g.P("}")
-		g.P("handler := func(ctx ", contextPkg, ".Context, req interface{}) (interface{}, error) {")
+		g.P("handler := func(ctx ", g.contextPkg, ".Context, req interface{}) (interface{}, error) {")
 		g.P("return srv.(", servName, "Server).", methName, "(ctx, req.(*", inType, "))")
 		g.P("}")
 		g.P("return interceptor(ctx, in, info, handler)")
@@ -403,7 +397,7 @@ func (g *grpc) generateServerMethod(servName, fullServName string, method *pb.Me
 		return hname
 	}
 	streamType := unexport(servName) + methName + "Server"
-	g.P("func ", hname, "(srv interface{}, stream ", grpcPkg, ".ServerStream) error {")
+	g.P("func ", hname, "(srv interface{}, stream ", g.grpcPkg, ".ServerStream) error {")
 	if !method.GetClientStreaming() {
 		g.P("m := new(", inType, ")")
 		g.P("if err := stream.RecvMsg(m); err != nil { return err }")
@@ -429,12 +423,12 @@ func (g *grpc) generateServerMethod(servName, fullServName string, method *pb.Me
 	if genRecv {
 		g.P("Recv() (*", inType, ", error)")
 	}
-	g.P(grpcPkg, ".ServerStream")
+	g.P(g.grpcPkg, ".ServerStream")
 	g.P("}")
 	g.P()
 
 	g.P("type ", streamType, " struct {")
-	g.P(grpcPkg, ".ServerStream")
+	g.P(g.grpcPkg, ".ServerStream")
 	g.P("}")
 	g.P()
 
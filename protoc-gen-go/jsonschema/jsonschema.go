@@ -0,0 +1,529 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package jsonschema outputs a JSON Schema (draft 2020-12) for each
+// message, describing the message as package jsonpb marshals it, for
+// clients of a JSON API, such as one served by package httprpc, to
+// validate payloads with. It runs as a plugin for the Go protocol buffer
+// compiler plugin, enabled with plugins=jsonschema. It is linked in to
+// protoc-gen-go.
+//
+// For a message foo.Bar in foo/bar.proto, it writes foo/foo.Bar.schema.json
+// next to the generated Go code. Each schema is self-contained: the
+// messages its fields refer to are in its $defs. Properties are named for
+// the JSON names of the fields, as set by json_name, and by
+// (carno.json_name_override) when the carno plugin runs too; the
+// well-known types have the schemas of their JSON forms, such as a
+// date-time string for Timestamp; 64-bit integers may be numbers or
+// strings, and message fields null, as jsonpb accepts. Fields with the
+// proto2 label required, or the (carno.rules) option with required set,
+// are required, and the other rules of the option constrain their values.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+// New returns a new instance of the jsonschema plugin, for a generator
+// created with generator.NewWithPlugins.
+func New() generator.Plugin { return new(jsonschema) }
+
+// jsonschema is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates JSON Schemas.
+type jsonschema struct {
+	gen      *generator.Generator
+	messages map[string]message                 // By fully-qualified name, with a leading dot.
+	enums    map[string]*pb.EnumDescriptorProto // Likewise.
+}
+
+// message is a message, the file declaring it and its source path.
+type message struct {
+	*pb.DescriptorProto
+	file *generator.FileDescriptor
+	path string
+}
+
+// Name returns the name of this plugin, "jsonschema".
+func (g *jsonschema) Name() string {
+	return "jsonschema"
+}
+
+// SetParam rejects all parameters; the jsonschema plugin has none.
+func (g *jsonschema) SetParam(key, value string) error {
+	return fmt.Errorf("unknown parameter %q", key)
+}
+
+// Init initializes the plugin.
+func (g *jsonschema) Init(gen *generator.Generator) {
+	g.gen = gen
+	g.messages = make(map[string]message)
+	g.enums = make(map[string]*pb.EnumDescriptorProto)
+	for _, fd := range gen.Request.ProtoFile {
+		file := gen.FileOf(fd)
+		prefix := "."
+		if pkg := fd.GetPackage(); pkg != "" {
+			prefix += pkg + "."
+		}
+		var walk func(name, path string, msg *pb.DescriptorProto)
+		walk = func(name, path string, msg *pb.DescriptorProto) {
+			g.messages[name] = message{msg, file, path}
+			for _, enum := range msg.EnumType {
+				g.enums[name+"."+enum.GetName()] = enum
+			}
+			for i, nested := range msg.NestedType {
+				walk(name+"."+nested.GetName(), fmt.Sprintf("%s,3,%d", path, i), nested) // 3 means nested message.
+			}
+		}
+		for i, msg := range fd.MessageType {
+			walk(prefix+msg.GetName(), fmt.Sprintf("4,%d", i), msg) // 4 means message.
+		}
+		for _, enum := range fd.EnumType {
+			g.enums[prefix+enum.GetName()] = enum
+		}
+	}
+}
+
+// Generate adds the schemas of the messages in the given file to the
+// response, if it is one of the files to generate.
+func (g *jsonschema) Generate(file *generator.FileDescriptor) {
+	if !g.generating(file) {
+		return
+	}
+	dir := path.Dir(g.gen.GoOutputName(file))
+	var names []string
+	var walk func(name string, msg *pb.DescriptorProto)
+	walk = func(name string, msg *pb.DescriptorProto) {
+		if msg.GetOptions().GetMapEntry() {
+			return
+		}
+		names = append(names, name)
+		for _, nested := range msg.NestedType {
+			walk(name+"."+nested.GetName(), nested)
+		}
+	}
+	prefix := "."
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix += pkg + "."
+	}
+	for _, msg := range file.MessageType {
+		walk(prefix+msg.GetName(), msg)
+	}
+	for _, name := range names {
+		b, err := json.MarshalIndent(g.schema(name), "", "  ")
+		if err != nil {
+			g.gen.Error(err, "encoding the schema of", name)
+		}
+		g.gen.Response.File = append(g.gen.Response.File, &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(path.Join(dir, name[1:]+".schema.json")),
+			Content: proto.String(string(b) + "\n"),
+		})
+	}
+}
+
+// GenerateImports does nothing; the schemas are not Go code.
+func (g *jsonschema) GenerateImports(file *generator.FileDescriptor) {}
+
+// generating reports whether file is one of the files to generate, rather
+// than one of their imports.
+func (g *jsonschema) generating(file *generator.FileDescriptor) bool {
+	for _, name := range g.gen.Request.FileToGenerate {
+		if name == file.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// An object is a JSON object whose members keep the order they are set
+// in, so that schemas read like the messages they describe.
+type object []member
+
+type member struct {
+	key   string
+	value interface{}
+}
+
+// set sets the member key of o to value, in place if o has it already.
+func (o *object) set(key string, value interface{}) {
+	for i := range *o {
+		if (*o)[i].key == key {
+			(*o)[i].value = value
+			return
+		}
+	}
+	*o = append(*o, member{key, value})
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// schemaBuilder builds the schema of one message. The messages its fields
+// refer to, other than itself, go in the schema's $defs.
+type schemaBuilder struct {
+	g    *jsonschema
+	root string            // The message whose schema is built.
+	refs map[string]bool   // The messages referred to.
+	defs map[string]object // The schemas of those built so far.
+}
+
+// schema returns the schema of the message with the fully-qualified name.
+func (g *jsonschema) schema(name string) object {
+	b := &schemaBuilder{
+		g:    g,
+		root: name,
+		refs: make(map[string]bool),
+		defs: make(map[string]object),
+	}
+	s := object{
+		{"$schema", "https://json-schema.org/draft/2020-12/schema"},
+		{"$id", name[1:] + ".schema.json"},
+		{"title", name[1:]},
+	}
+	s = append(s, b.message(name)...)
+	for len(b.defs) < len(b.refs) {
+		for ref := range b.refs {
+			if _, ok := b.defs[ref]; !ok {
+				b.defs[ref] = b.message(ref)
+			}
+		}
+	}
+	if len(b.defs) > 0 {
+		names := make([]string, 0, len(b.defs))
+		for name := range b.defs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		var defs object
+		for _, name := range names {
+			defs.set(name[1:], b.defs[name])
+		}
+		s.set("$defs", defs)
+	}
+	return s
+}
+
+// ref returns a reference to the schema of the named message.
+func (b *schemaBuilder) ref(name string) object {
+	if name == b.root {
+		return object{{"$ref", "#"}}
+	}
+	b.refs[name] = true
+	return object{{"$ref", "#/$defs/" + name[1:]}}
+}
+
+// message returns the schema of the named message.
+func (b *schemaBuilder) message(name string) object {
+	msg, ok := b.g.messages[name]
+	if !ok {
+		b.g.gen.Fail("jsonschema: unknown message", name)
+	}
+	var s object
+	if d := description(msg.file.Comments(msg.path)); d != "" {
+		s.set("description", d)
+	}
+	s.set("type", "object")
+	var props object
+	var required []string
+	oneofs := make([][]string, len(msg.OneofDecl))
+	for i, field := range msg.Field {
+		name := jsonName(field)
+		rules := b.g.rules(field)
+		fs := object{}
+		if d := description(msg.file.Comments(fmt.Sprintf("%s,2,%d", msg.path, i))); d != "" { // 2 means field.
+			fs.set("description", d)
+		}
+		fs = append(fs, b.field(field, rules)...)
+		if field.GetOptions().GetDeprecated() {
+			fs.set("deprecated", true)
+		}
+		props.set(name, fs)
+		if field.GetLabel() == pb.FieldDescriptorProto_LABEL_REQUIRED || rules.GetRequired() {
+			required = append(required, name)
+		}
+		if field.OneofIndex != nil {
+			oneofs[field.GetOneofIndex()] = append(oneofs[field.GetOneofIndex()], name)
+		}
+	}
+	if props != nil {
+		s.set("properties", props)
+	}
+	if required != nil {
+		s.set("required", required)
+	}
+	// At most one field of each oneof may be set: exactly one of "this
+	// field is set", for each, and "none is", holds.
+	var all []object
+	for _, names := range oneofs {
+		if len(names) < 2 {
+			continue
+		}
+		var set []object
+		for _, name := range names {
+			set = append(set, object{{"required", []string{name}}})
+		}
+		all = append(all, object{{"oneOf", append(set, object{{"not", object{{"anyOf", set}}}})}})
+	}
+	if all != nil {
+		s.set("allOf", all)
+	}
+	s.set("additionalProperties", false)
+	return s
+}
+
+// field returns the schema of the value of field: an array for a repeated
+// field, an object for a map, or else the schema of its type. The rules
+// constrain it.
+func (b *schemaBuilder) field(field *pb.FieldDescriptorProto, rules *options.FieldRules) object {
+	if field.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE {
+		if entry, ok := b.g.messages[field.GetTypeName()]; ok && entry.GetOptions().GetMapEntry() {
+			s := object{{"type", "object"}}
+			if names := keyNames(entry.Field[0]); names != nil {
+				s.set("propertyNames", names)
+			}
+			s.set("additionalProperties", b.value(entry.Field[1], rules))
+			if rules.MinItems != nil {
+				s.set("minProperties", rules.GetMinItems())
+			}
+			if rules.MaxItems != nil {
+				s.set("maxProperties", rules.GetMaxItems())
+			}
+			return s
+		}
+	}
+	v := b.value(field, rules)
+	if field.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED {
+		if typ := field.GetType(); typ == pb.FieldDescriptorProto_TYPE_MESSAGE || typ == pb.FieldDescriptorProto_TYPE_GROUP {
+			// jsonpb writes null for an unset message with EmitDefaults,
+			// and reads null as unset.
+			if field.GetTypeName() != ".google.protobuf.Value" {
+				v = object{{"anyOf", []object{v, {{"type", "null"}}}}}
+			}
+		}
+		return v
+	}
+	s := object{{"type", "array"}, {"items", v}}
+	if rules.MinItems != nil {
+		s.set("minItems", rules.GetMinItems())
+	}
+	if rules.MaxItems != nil {
+		s.set("maxItems", rules.GetMaxItems())
+	}
+	return s
+}
+
+// keyNames returns the schema of the names of the members of a map's JSON
+// object, which hold its keys, or nil if the keys are strings.
+func keyNames(key *pb.FieldDescriptorProto) object {
+	switch key.GetType() {
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return object{{"enum", []string{"true", "false"}}}
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return nil
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32,
+		pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return object{{"pattern", "^[0-9]+$"}}
+	}
+	return object{{"pattern", "^-?[0-9]+$"}}
+}
+
+// value returns the schema of one value of field, constrained by rules.
+func (b *schemaBuilder) value(field *pb.FieldDescriptorProto, rules *options.FieldRules) object {
+	var s object
+	switch field.GetType() {
+	case pb.FieldDescriptorProto_TYPE_DOUBLE, pb.FieldDescriptorProto_TYPE_FLOAT:
+		s = object{{"type", "number"}}
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SFIXED32:
+		s = object{{"type", "integer"}, {"minimum", int64(-1 << 31)}, {"maximum", int64(1<<31 - 1)}}
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32:
+		s = object{{"type", "integer"}, {"minimum", 0}, {"maximum", int64(1<<32 - 1)}}
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		// jsonpb writes 64-bit integers as strings, which JavaScript
+		// cannot lose the precision of, and reads numbers too.
+		s = object{{"type", []string{"integer", "string"}}, {"pattern", "^-?[0-9]+$"}}
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		s = object{{"type", []string{"integer", "string"}}, {"pattern", "^[0-9]+$"}, {"minimum", 0}}
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		s = object{{"type", "boolean"}}
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		s = object{{"type", "string"}}
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		s = object{{"type", "string"}, {"contentEncoding", "base64"}}
+	case pb.FieldDescriptorProto_TYPE_ENUM:
+		// jsonpb writes the names of the values, and reads numbers too.
+		enum, ok := b.g.enums[field.GetTypeName()]
+		if !ok {
+			b.g.gen.Fail("jsonschema: unknown enum", field.GetTypeName())
+		}
+		var values []interface{}
+		for _, v := range enum.Value {
+			values = append(values, v.GetName())
+		}
+		for _, v := range enum.Value {
+			values = append(values, v.GetNumber())
+		}
+		s = object{{"enum", values}}
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		if wkt, ok := wellKnownTypes[field.GetTypeName()]; ok {
+			s = wkt()
+		} else {
+			s = b.ref(field.GetTypeName())
+		}
+	}
+	if rules.MinLen != nil {
+		s.set("minLength", rules.GetMinLen())
+	}
+	if rules.MaxLen != nil {
+		s.set("maxLength", rules.GetMaxLen())
+	}
+	if rules.Pattern != nil {
+		s.set("pattern", rules.GetPattern())
+	}
+	if rules.Format != nil {
+		s.set("format", rules.GetFormat())
+	}
+	if rules.Minimum != nil {
+		s.set("minimum", rules.GetMinimum())
+	}
+	if rules.Maximum != nil {
+		s.set("maximum", rules.GetMaximum())
+	}
+	return s
+}
+
+// wellKnownTypes are the schemas of the JSON forms of the well-known
+// types, which are not those of their fields.
+var wellKnownTypes = map[string]func() object{
+	".google.protobuf.Any": func() object {
+		return object{{"type", "object"}, {"properties", object{{"@type", object{{"type", "string"}}}}}, {"required", []string{"@type"}}}
+	},
+	".google.protobuf.Duration": func() object {
+		return object{{"type", "string"}, {"pattern", `^-?[0-9]+(\.[0-9]{1,9})?s$`}}
+	},
+	".google.protobuf.Empty":     func() object { return object{{"type", "object"}, {"maxProperties", 0}} },
+	".google.protobuf.FieldMask": func() object { return object{{"type", "string"}} },
+	".google.protobuf.ListValue": func() object { return object{{"type", "array"}} },
+	".google.protobuf.Struct":    func() object { return object{{"type", "object"}} },
+	".google.protobuf.Timestamp": func() object { return object{{"type", "string"}, {"format", "date-time"}} },
+	".google.protobuf.Value":     func() object { return object{} },
+
+	".google.protobuf.BoolValue":   func() object { return object{{"type", "boolean"}} },
+	".google.protobuf.BytesValue":  func() object { return object{{"type", "string"}, {"contentEncoding", "base64"}} },
+	".google.protobuf.DoubleValue": func() object { return object{{"type", "number"}} },
+	".google.protobuf.FloatValue":  func() object { return object{{"type", "number"}} },
+	".google.protobuf.Int32Value":  func() object { return object{{"type", "integer"}} },
+	".google.protobuf.Int64Value": func() object {
+		return object{{"type", []string{"integer", "string"}}, {"pattern", "^-?[0-9]+$"}}
+	},
+	".google.protobuf.StringValue": func() object { return object{{"type", "string"}} },
+	".google.protobuf.UInt32Value": func() object { return object{{"type", "integer"}, {"minimum", 0}} },
+	".google.protobuf.UInt64Value": func() object {
+		return object{{"type", []string{"integer", "string"}}, {"pattern", "^[0-9]+$"}, {"minimum", 0}}
+	},
+}
+
+// rules returns the (carno.rules) option of field, or empty rules.
+func (g *jsonschema) rules(field *pb.FieldDescriptorProto) *options.FieldRules {
+	if v := g.gen.FieldOption(field, options.E_Rules); v != nil {
+		return v.(*options.FieldRules)
+	}
+	return new(options.FieldRules)
+}
+
+// jsonName returns the name of field in JSON, which the carno plugin
+// changes in its Init to the one a (carno.json_name_override) option gives.
+func jsonName(field *pb.FieldDescriptorProto) string {
+	if name := field.GetJsonName(); name != "" {
+		return name
+	}
+	// protoc sets json_name; derive it as protoc does if it is missing.
+	var buf bytes.Buffer
+	upper := false
+	for _, c := range field.GetName() {
+		switch {
+		case c == '_':
+			upper = true
+		case upper && 'a' <= c && c <= 'z':
+			buf.WriteRune(c - 'a' + 'A')
+			upper = false
+		default:
+			buf.WriteRune(c)
+			upper = false
+		}
+	}
+	return buf.String()
+}
+
+// description returns the comment of an element, for its description:
+// the leading comment, or else the trailing one, without the space after
+// the comment markers.
+func description(c generator.Comments) string {
+	text := c.Leading
+	if text == "" {
+		text = c.Trailing
+	}
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, " ")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -31,5 +31,28 @@
 
 package main
 
-import _ "github.com/golang/protobuf/protoc-gen-go/grpc"
-import _ "github.com/ccsnake/protobuf/protoc-gen-go/carno"
+import (
+	"github.com/ccsnake/protobuf/protoc-gen-go/builder"
+	"github.com/ccsnake/protobuf/protoc-gen-go/carno"
+	"github.com/ccsnake/protobuf/protoc-gen-go/clone"
+	"github.com/ccsnake/protobuf/protoc-gen-go/equal"
+	"github.com/ccsnake/protobuf/protoc-gen-go/fastpath"
+	"github.com/ccsnake/protobuf/protoc-gen-go/fingerprint"
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/ccsnake/protobuf/protoc-gen-go/jsonschema"
+	"github.com/ccsnake/protobuf/protoc-gen-go/pool"
+)
+
+// linkedPlugins make the plugins linked in to protoc-gen-go, which the
+// plugins parameter selects among. (The grpc plugin is built on the
+// generator of github.com/golang/protobuf, so it cannot be linked in.)
+var linkedPlugins = []func() generator.Plugin{
+	builder.New,
+	carno.New,
+	clone.New,
+	equal.New,
+	fastpath.New,
+	fingerprint.New,
+	jsonschema.New,
+	pool.New,
+}
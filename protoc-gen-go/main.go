@@ -44,23 +44,39 @@
 // The generated code is documented in the package comment for
 // the library.
 //
+// Given arguments, protoc-gen-go compiles the .proto files itself, with
+// package protoparse, and needs no protocol compiler:
+// 	protoc-gen-go [-I import_path]... [-param parameter] [-out output_directory] file.proto...
+// runs as
+// 	protoc -I import_path --go_out=parameter:output_directory file.proto...
+// would, so that a go:generate line can take the place of protoc.
+//
 // See the README and documentation for protocol buffers to learn more:
 // 	https://developers.google.com/protocol-buffers/
 package main
 
 import (
+	"flag"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 
-	"github.com/golang/protobuf/proto"
 	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoparse"
 )
 
 func main() {
 	// Begin by allocating a generator. The request and response structures are stored there
 	// so we can do error handling easily - the response structure contains the field to
 	// report failure.
-	g := generator.New()
+	g := generator.NewWithPlugins(linkedPlugins...)
+
+	if len(os.Args) > 1 {
+		compile(g, os.Args[1:])
+		return
+	}
 
 	data, err := ioutil.ReadAll(os.Stdin)
 	if err != nil {
@@ -75,6 +91,21 @@ func main() {
 		g.Fail("no files to generate")
 	}
 
+	generate(g)
+
+	// Send back the results.
+	data, err = proto.Marshal(g.Response)
+	if err != nil {
+		g.Error(err, "failed to marshal output proto")
+	}
+	_, err = os.Stdout.Write(data)
+	if err != nil {
+		g.Error(err, "failed to write output proto")
+	}
+}
+
+// generate generates the files of g.Request into g.Response.
+func generate(g *generator.Generator) {
 	g.CommandLineParameters(g.Request.GetParameter())
 
 	// Create a wrapped version of the Descriptors and EnumDescriptors that
@@ -85,14 +116,45 @@ func main() {
 	g.BuildTypeNameMap()
 
 	g.GenerateAllFiles()
+}
 
-	// Send back the results.
-	data, err = proto.Marshal(g.Response)
-	if err != nil {
-		g.Error(err, "failed to marshal output proto")
+// importPaths is the value of the repeatable -I flag.
+type importPaths []string
+
+func (p *importPaths) String() string     { return strings.Join(*p, ",") }
+func (p *importPaths) Set(v string) error { *p = append(*p, v); return nil }
+
+// compile generates the files for the .proto files named in args, which
+// it compiles with protoparse, and writes them out.
+func compile(g *generator.Generator, args []string) {
+	flags := flag.NewFlagSet("protoc-gen-go", flag.ExitOnError)
+	var paths importPaths
+	flags.Var(&paths, "I", "look up imported files in `dir`; repeatable")
+	param := flags.String("param", "", "the `parameter` of the plugin, such as plugins=carno")
+	out := flags.String("out", ".", "write the generated files into `dir`")
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		g.Fail("no files to generate")
 	}
-	_, err = os.Stdout.Write(data)
+
+	p := &protoparse.Parser{ImportPaths: paths}
+	req, err := p.NewRequest(*param, flags.Args()...)
 	if err != nil {
-		g.Error(err, "failed to write output proto")
+		g.Error(err)
+	}
+	g.Request = req
+	generate(g)
+	if g.Response.Error != nil {
+		g.Fail(g.Response.GetError())
+	}
+
+	for _, f := range g.Response.File {
+		name := filepath.Join(*out, filepath.FromSlash(f.GetName()))
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			g.Error(err, "failed to write output")
+		}
+		if err := ioutil.WriteFile(name, []byte(f.GetContent()), 0644); err != nil {
+			g.Error(err, "failed to write output")
+		}
 	}
 }
@@ -0,0 +1,192 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package plugingen helps write protoc-gen-go plugins that generate
+// clients and servers for an RPC transport, as the carno plugin does.
+// It prints the parts such plugins share, such as service interfaces and
+// method signatures, leaving the transport-specific code to the plugin.
+//
+// A plugin typically keeps a Printer for the Generator it is given in
+// Init and uses it from Generate:
+//
+//	func (p *myPlugin) Init(g *generator.Generator) {
+//		p.Printer = &plugingen.Printer{Gen: g}
+//	}
+package plugingen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// ServicePath returns the source path of the index'th service of a file,
+// for use with Comments and Annotate.
+func ServicePath(index int) string {
+	return fmt.Sprintf("6,%d", index) // 6 means service.
+}
+
+// MethodPath returns the source path of the index'th method of the service
+// at servicePath.
+func MethodPath(servicePath string, index int) string {
+	return fmt.Sprintf("%s,2,%d", servicePath, index) // 2 means method in a service.
+}
+
+// Var returns the name of an unexported package-level variable or type
+// holding something of the given kind for the named service, such as
+// "_Greeter_serviceDesc" for Var("Greeter", "serviceDesc"). The leading
+// underscore keeps it from colliding with generated message types.
+func Var(servName, kind string) string {
+	return "_" + servName + "_" + kind
+}
+
+// Unexport returns s with its first letter in lower case, such as the name
+// of the type implementing an exported client interface.
+func Unexport(s string) string { return strings.ToLower(s[:1]) + s[1:] }
+
+// Streaming reports whether either side of the method streams.
+func Streaming(method *pb.MethodDescriptorProto) bool {
+	return method.GetClientStreaming() || method.GetServerStreaming()
+}
+
+// A Printer prints service code to a Generator. It must only be used
+// while the Generator is generating a file, from a plugin's Generate method.
+type Printer struct {
+	Gen *generator.Generator
+
+	// Reserved holds the CamelCased method names that would collide with
+	// methods of the generated types. MethodName adds an underscore to them.
+	Reserved map[string]bool
+}
+
+// P forwards to Gen.P.
+func (p *Printer) P(args ...interface{}) { p.Gen.P(args...) }
+
+// TypeName returns the Go name of the type with the given fully-qualified
+// name, such as a method's input type, as the current file refers to it.
+// It records the use, so the package defining the type is imported.
+func (p *Printer) TypeName(name string) string {
+	p.Gen.RecordTypeUse(name)
+	return p.Gen.TypeName(p.Gen.ObjectNamed(name))
+}
+
+// MethodName returns the Go name of a method.
+func (p *Printer) MethodName(method *pb.MethodDescriptorProto) string {
+	name := generator.CamelCase(method.GetName())
+	if p.Reserved[name] {
+		name += "_"
+	}
+	return name
+}
+
+// HasComments reports whether the element at path has leading or trailing comments.
+func (p *Printer) HasComments(path string) bool {
+	c := p.Gen.Comments(path)
+	return c.Leading != "" || c.Trailing != ""
+}
+
+// PrintComments prints the leading and trailing comments of the element at
+// path, so that a trailing comment on an rpc line documents it too.
+func (p *Printer) PrintComments(path string) {
+	c := p.Gen.Comments(path)
+	if c.Leading != "" {
+		p.Gen.PrintCommentText(c.Leading)
+	}
+	if c.Trailing != "" {
+		if c.Leading != "" {
+			p.P("//")
+		}
+		p.Gen.PrintCommentText(c.Trailing)
+	}
+}
+
+// PrintSignature prints sig, the signature of a method in an interface,
+// annotating the method name with the method's source path.
+func (p *Printer) PrintSignature(file *generator.FileDescriptor, path, sig string) {
+	i := strings.Index(sig, "(")
+	p.P(generator.Annotate(file, path, sig[:i]), sig[i:])
+}
+
+// ClientSignature returns the client-side signature of a method of the
+// service servName, in the form gRPC uses:
+//
+//	Name(ctx <contextType>, in *Request, opts ...<callOptionType>) (*Response, error)
+//
+// A streaming method takes no request if the client streams, and returns
+// a <servName>_<Name>Client instead of the response.
+func (p *Printer) ClientSignature(servName string, method *pb.MethodDescriptorProto, contextType, callOptionType string) string {
+	reqArg := ", in *" + p.TypeName(method.GetInputType())
+	if method.GetClientStreaming() {
+		reqArg = ""
+	}
+	respName := "*" + p.TypeName(method.GetOutputType())
+	if Streaming(method) {
+		respName = servName + "_" + generator.CamelCase(method.GetName()) + "Client"
+	}
+	return fmt.Sprintf("%s(ctx %s%s, opts ...%s) (%s, error)", p.MethodName(method), contextType, reqArg, callOptionType, respName)
+}
+
+// ServerSignature returns the server-side signature of a unary method:
+//
+//	Name(<contextType>, *Request) (*Response, error)
+func (p *Printer) ServerSignature(method *pb.MethodDescriptorProto, contextType string) string {
+	return fmt.Sprintf("%s(%s, *%s) (*%s, error)", p.MethodName(method), contextType,
+		p.TypeName(method.GetInputType()), p.TypeName(method.GetOutputType()))
+}
+
+// A Method is a method of an interface printed by PrintInterface.
+type Method struct {
+	Path string // source path of the method, for its comments and annotation
+	Sig  string // signature, such as one returned by ClientSignature
+}
+
+// PrintInterface prints an interface type. If path is not empty, the
+// name is annotated with it; the doc comment, if any, is up to the caller.
+// The interface embeds the interfaces named in embeds, followed by the
+// methods, each preceded by its comments.
+func (p *Printer) PrintInterface(file *generator.FileDescriptor, name, path string, embeds []string, methods []Method) {
+	if path != "" {
+		p.P("type ", generator.Annotate(file, path, name), " interface {")
+	} else {
+		p.P("type ", name, " interface {")
+	}
+	for _, e := range embeds {
+		p.P(e)
+	}
+	for _, m := range methods {
+		p.PrintComments(m.Path)
+		p.PrintSignature(file, m.Path, m.Sig)
+	}
+	p.P("}")
+	p.P()
+}
@@ -0,0 +1,142 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package plugingen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// testPlugin prints a client and a server interface for each service.
+type testPlugin struct {
+	*Printer
+}
+
+func (p *testPlugin) Name() string                     { return "plugingentest" }
+func (p *testPlugin) SetParam(key, value string) error { return nil }
+func (p *testPlugin) Init(g *generator.Generator) {
+	p.Printer = &Printer{Gen: g, Reserved: map[string]bool{"Close": true}}
+}
+func (p *testPlugin) GenerateImports(file *generator.FileDescriptor) {}
+
+func (p *testPlugin) Generate(file *generator.FileDescriptor) {
+	for i, service := range file.Service {
+		path := ServicePath(i)
+		var client, server []Method
+		for j, method := range service.Method {
+			client = append(client, Method{MethodPath(path, j), p.ClientSignature(service.GetName(), method, "Context", "CallOption")})
+			if !Streaming(method) {
+				server = append(server, Method{MethodPath(path, j), p.ServerSignature(method, "Context")})
+			}
+		}
+		p.PrintComments(path)
+		p.PrintInterface(file, service.GetName()+"Client", path, []string{"Base"}, client)
+		p.PrintInterface(file, service.GetName()+"Server", "", nil, server)
+	}
+}
+
+func TestPrinter(t *testing.T) {
+	fd := &pb.FileDescriptorProto{
+		Name:    proto.String("p.proto"),
+		Package: proto.String("p"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*pb.DescriptorProto{
+			{Name: proto.String("Req")},
+			{Name: proto.String("Resp")},
+		},
+		Service: []*pb.ServiceDescriptorProto{{
+			Name: proto.String("Svc"),
+			Method: []*pb.MethodDescriptorProto{
+				{Name: proto.String("get"), InputType: proto.String(".p.Req"), OutputType: proto.String(".p.Resp")},
+				{Name: proto.String("Watch"), InputType: proto.String(".p.Req"), OutputType: proto.String(".p.Resp"), ServerStreaming: proto.Bool(true)},
+				{Name: proto.String("Close"), InputType: proto.String(".p.Req"), OutputType: proto.String(".p.Resp")},
+			},
+		}},
+		SourceCodeInfo: &pb.SourceCodeInfo{
+			Location: []*pb.SourceCodeInfo_Location{
+				{Path: []int32{6, 0}, LeadingComments: proto.String(" Svc serves.\n")},
+				{Path: []int32{6, 0, 2, 0}, LeadingComments: proto.String(" get gets.\n"), TrailingComments: proto.String(" Cached.\n")},
+			},
+		},
+	}
+	g := generator.NewWithPlugins(func() generator.Plugin { return new(testPlugin) })
+	g.Request.FileToGenerate = []string{"p.proto"}
+	g.Request.ProtoFile = []*pb.FileDescriptorProto{fd}
+	g.CommandLineParameters("plugins=plugingentest")
+	g.WrapTypes()
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	g.GenerateAllFiles()
+	if g.Response.Error != nil {
+		t.Fatal(g.Response.GetError())
+	}
+	got := g.Response.File[0].GetContent()
+
+	want := `// Svc serves.
+type SvcClient interface {
+	Base
+	// get gets.
+	//
+	// Cached.
+	Get(ctx Context, in *Req, opts ...CallOption) (*Resp, error)
+	Watch(ctx Context, in *Req, opts ...CallOption) (Svc_WatchClient, error)
+	Close_(ctx Context, in *Req, opts ...CallOption) (*Resp, error)
+}
+
+type SvcServer interface {
+	// get gets.
+	//
+	// Cached.
+	Get(Context, *Req) (*Resp, error)
+	Close_(Context, *Req) (*Resp, error)
+}
+`
+	if !strings.Contains(got, want) {
+		t.Errorf("generated code does not contain\n%s\nfull output:\n%s", want, got)
+	}
+}
+
+func TestNames(t *testing.T) {
+	if got, want := MethodPath(ServicePath(1), 2), "6,1,2,2"; got != want {
+		t.Errorf("MethodPath(ServicePath(1), 2) = %q, want %q", got, want)
+	}
+	if got, want := Var("Greeter", "serviceDesc"), "_Greeter_serviceDesc"; got != want {
+		t.Errorf("Var = %q, want %q", got, want)
+	}
+	if got, want := Unexport("Greeter"), "greeter"; got != want {
+		t.Errorf("Unexport = %q, want %q", got, want)
+	}
+}
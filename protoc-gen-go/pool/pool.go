@@ -0,0 +1,187 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package pool outputs a sync.Pool for each message, with functions that
+// get messages from it and put them back, so that hot paths can reuse
+// messages rather than allocate them. It runs as a plugin for the Go
+// protocol buffer compiler plugin, enabled with plugins=pool. It is
+// linked in to protoc-gen-go.
+//
+// For a message Foo, it generates
+//
+//	var FooPool sync.Pool    // holds *Foo
+//	func GetFoo() *Foo      // an empty Foo, from FooPool if it has one
+//	func PutFoo(m *Foo)     // resets m and puts it in FooPool
+//
+// With carno:pool=true, the carno plugin uses them in generated clients
+// and servers.
+package pool
+
+import (
+	"fmt"
+
+	"github.com/ccsnake/protobuf/protoc-gen-go/generator"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// New returns a new instance of the pool plugin, for a generator created
+// with generator.NewWithPlugins.
+func New() generator.Plugin { return new(pool) }
+
+// pool is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates message pools.
+type pool struct {
+	gen     *generator.Generator
+	syncPkg string // The name under which the current file imports sync.
+	used    map[string]bool
+}
+
+// Name returns the name of this plugin, "pool".
+func (g *pool) Name() string {
+	return "pool"
+}
+
+// SetParam rejects all parameters; the pool plugin has none.
+func (g *pool) SetParam(key, value string) error {
+	return fmt.Errorf("unknown parameter %q", key)
+}
+
+// Init initializes the plugin.
+func (g *pool) Init(gen *generator.Generator) {
+	g.gen = gen
+}
+
+// P forwards to g.gen.P.
+func (g *pool) P(args ...interface{}) { g.gen.P(args...) }
+
+// Names returns the names of the get and put functions generated for the
+// message with the given fully-qualified name, as the file being
+// generated refers to them.
+func Names(gen *generator.Generator, typeName string) (get, put string) {
+	gen.RecordTypeUse(typeName)
+	obj := gen.ObjectNamed(typeName)
+	pkg, name := gen.DefaultPackageName(obj), generator.CamelCaseSlice(obj.TypeName())
+	return pkg + "Get" + name, pkg + "Put" + name
+}
+
+// Generate generates the pools for the messages in the given file.
+func (g *pool) Generate(file *generator.FileDescriptor) {
+	msgs := g.messages(file)
+	if len(msgs) == 0 {
+		return
+	}
+	g.syncPkg = g.gen.AddImport("sync")
+
+	// The Go names of the file's types, which the pools must not take.
+	g.used = make(map[string]bool)
+	for _, msg := range msgs {
+		g.used[generator.CamelCaseSlice(msg.TypeName())] = true
+		for _, e := range msg.EnumType {
+			g.used[generator.CamelCaseSlice(append(msg.TypeName(), e.GetName()))] = true
+		}
+	}
+	for _, e := range file.EnumType {
+		g.used[generator.CamelCase(e.GetName())] = true
+	}
+
+	for _, msg := range msgs {
+		g.generatePool(msg.Descriptor, msg.path)
+	}
+}
+
+// GenerateImports does nothing; Generate adds its imports with AddImport.
+func (g *pool) GenerateImports(file *generator.FileDescriptor) {}
+
+// message is a message and its source path.
+type message struct {
+	*generator.Descriptor
+	path string
+}
+
+// messages returns the messages of file, nested ones after the message
+// holding them, but not map entries.
+func (g *pool) messages(file *generator.FileDescriptor) []message {
+	prefix := "."
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix += pkg + "."
+	}
+	var msgs []message
+	var walk func(name, path string, msg *pb.DescriptorProto)
+	walk = func(name, path string, msg *pb.DescriptorProto) {
+		if msg.GetOptions().GetMapEntry() {
+			return
+		}
+		if d, ok := g.gen.ObjectNamed(name).(*generator.Descriptor); ok {
+			msgs = append(msgs, message{d, path})
+		}
+		for i, nested := range msg.NestedType {
+			walk(name+"."+nested.GetName(), fmt.Sprintf("%s,3,%d", path, i), nested) // 3 means nested message.
+		}
+	}
+	for i, msg := range file.MessageType {
+		walk(prefix+msg.GetName(), fmt.Sprintf("4,%d", i), msg) // 4 means message.
+	}
+	return msgs
+}
+
+// generatePool generates the pool of a message and its functions.
+func (g *pool) generatePool(d *generator.Descriptor, path string) {
+	typeName := generator.CamelCaseSlice(d.TypeName())
+	poolVar, get, put := typeName+"Pool", "Get"+typeName, "Put"+typeName
+	for _, n := range []string{poolVar, get, put} {
+		if g.used[n] {
+			g.gen.Errorf(path, "pool: the name %s, needed for the pool of %s, is taken by a type", n, typeName)
+			return
+		}
+	}
+
+	g.P("// ", poolVar, " holds ", typeName, " messages for reuse. Take them with ", get)
+	g.P("// and return them with ", put, ".")
+	g.P("var ", poolVar, " = ", g.syncPkg, ".Pool{")
+	g.P("New: func() interface{} { return new(", typeName, ") },")
+	g.P("}")
+	g.P()
+	g.P("// ", get, " returns an empty ", typeName, " from ", poolVar, ".")
+	g.P("func ", get, "() *", typeName, " {")
+	g.P("return ", poolVar, ".Get().(*", typeName, ")")
+	g.P("}")
+	g.P()
+	g.P("// ", put, " resets m, dropping its fields and any unknown fields, and")
+	g.P("// returns it to ", poolVar, ". Nothing may use m afterwards.")
+	g.P("func ", put, "(m *", typeName, ") {")
+	g.P("if m == nil {")
+	g.P("return")
+	g.P("}")
+	g.P("m.Reset()")
+	g.P(poolVar, ".Put(m)")
+	g.P("}")
+	g.P()
+}
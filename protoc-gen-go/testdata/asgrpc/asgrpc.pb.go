@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: asgrpc/asgrpc.proto
+
+/*
+Package asgrpc is a generated protocol buffer package.
+
+Package asgrpc tests the Register<Service>ServerAsGrpc functions the
+carno plugin generates with carno:grpc=true.
+
+It is generated from these files:
+	asgrpc/asgrpc.proto
+
+It has these top-level messages:
+	Chunk
+	Ack
+*/
+package asgrpc
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Chunk struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *Chunk) Reset()                    { *m = Chunk{} }
+func (m *Chunk) String() string            { return proto.CompactTextString(m) }
+func (*Chunk) ProtoMessage()               {}
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Chunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type Ack struct {
+	Size int64 `protobuf:"varint,1,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *Ack) Reset()                    { *m = Ack{} }
+func (m *Ack) String() string            { return proto.CompactTextString(m) }
+func (*Ack) ProtoMessage()               {}
+func (*Ack) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Ack) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Chunk)(nil), "asgrpc.Chunk")
+	proto.RegisterType((*Ack)(nil), "asgrpc.Ack")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Store service
+type StoreClient interface {
+	Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+	Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+}
+
+type storeClient struct {
+	client.Client
+}
+
+// NewStoreClient creates and starts a client for the Store service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewStoreClient(opts ...client.Option) (StoreClient, error) {
+	c, err := carno1.NewClient("asgrpc", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &storeClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Store_callInfo = []*callinfo.CallInfo{
+	{
+		Service:         "asgrpc@Store",
+		Method:          "Put",
+		RequestType:     "asgrpc.Chunk",
+		ResponseType:    "asgrpc.Ack",
+		File:            "asgrpc/asgrpc.proto",
+		MaxRequestBytes: 64,
+	},
+	{
+		Service:      "asgrpc@Store",
+		Method:       "Stat",
+		RequestType:  "asgrpc.Chunk",
+		ResponseType: "asgrpc.Ack",
+		File:         "asgrpc/asgrpc.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Store_callInfo...)
+}
+
+func (c *storeClient) Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[0])
+	err := c.Client.Call(ctx, "Store", "Put", in, out, opts...)
+	return out, err
+}
+
+func (c *storeClient) Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[1])
+	err := c.Client.Call(ctx, "Store", "Stat", in, out, opts...)
+	return out, err
+}
+
+// StoreClient returns a StoreClient using the client shared by the services
+// of package asgrpc.
+func (a *Asgrpc) StoreClient() StoreClient {
+	return &storeClient{Client: a.c}
+}
+
+// Server API for Store service
+type StoreServer interface {
+	Put(context.Context, *Chunk) (*Ack, error)
+	Stat(context.Context, *Chunk) (*Ack, error)
+}
+
+func RegisterStoreServer(srv StoreServer) {
+	callinfo.RegisterServer("asgrpc@Store")
+	carno1.HandleService(&_Store_serviceDesc, srv)
+}
+
+// RegisterStoreServerAsGrpc registers srv with s as the gRPC service
+// asgrpc.Store, so that gRPC clients can call it. Requests are checked as
+// with RegisterStoreServer; streaming methods are not served.
+func RegisterStoreServerAsGrpc(s *grpc.Server, srv StoreServer) {
+	s.RegisterService(&_Store_grpcServiceDesc, srv)
+}
+
+func _Store_Put_GrpcHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Chunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/asgrpc.Store/Put",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).Put(ctx, req.(*Chunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_Stat_GrpcHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Chunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).Stat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/asgrpc.Store/Stat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).Stat(ctx, req.(*Chunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Store_grpcServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asgrpc.Store",
+	HandlerType: (*StoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Put",
+			Handler:    _Store_Put_GrpcHandler,
+		},
+		{
+			MethodName: "Stat",
+			Handler:    _Store_Stat_GrpcHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "asgrpc/asgrpc.proto",
+}
+
+var _Store_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Store",
+	Methods: []string{
+		"Put",
+		"Stat",
+	},
+}
+
+func init() { proto.RegisterFile("asgrpc/asgrpc.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 152 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4e, 0x2c, 0x4e, 0x2f,
+	0x2a, 0x48, 0xd6, 0x87, 0x50, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x6c, 0x10, 0x9e, 0x94,
+	0x70, 0x72, 0x62, 0x51, 0x5e, 0xbe, 0x7e, 0x7e, 0x41, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x44, 0x52,
+	0x49, 0x9a, 0x8b, 0xd5, 0x39, 0xa3, 0x34, 0x2f, 0x5b, 0x48, 0x88, 0x8b, 0x25, 0x25, 0xb1, 0x24,
+	0x51, 0x82, 0x51, 0x81, 0x51, 0x83, 0x27, 0x08, 0xcc, 0x56, 0x92, 0xe4, 0x62, 0x76, 0x4c, 0x06,
+	0x4b, 0x15, 0x67, 0x56, 0xa5, 0x82, 0xa5, 0x98, 0x83, 0xc0, 0x6c, 0xa3, 0x10, 0x2e, 0xd6, 0xe0,
+	0x92, 0xfc, 0xa2, 0x54, 0x21, 0x75, 0x2e, 0xe6, 0x80, 0xd2, 0x12, 0x21, 0x5e, 0x3d, 0xa8, 0x9d,
+	0x60, 0xd3, 0xa4, 0xb8, 0x61, 0x5c, 0xc7, 0xe4, 0x6c, 0x25, 0x96, 0x09, 0x9b, 0x24, 0x1d, 0x84,
+	0x94, 0xb8, 0x58, 0x82, 0x4b, 0x12, 0xf1, 0xaa, 0x4c, 0x62, 0x03, 0x3b, 0xca, 0x18, 0x30, 0x00,
+	0x5f, 0xcc, 0xc7, 0x38, 0xc8, 0x00, 0x00, 0x00,
+}
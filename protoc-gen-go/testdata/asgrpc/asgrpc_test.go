@@ -0,0 +1,104 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package asgrpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+type server struct{}
+
+func (server) Put(ctx context.Context, in *Chunk) (*Ack, error) {
+	return &Ack{Size: int64(len(in.Data))}, nil
+}
+
+func (server) Stat(ctx context.Context, in *Chunk) (*Ack, error) {
+	return &Ack{Size: int64(len(in.Data))}, nil
+}
+
+func TestRegister(t *testing.T) {
+	s := grpc.NewServer()
+	RegisterStoreServerAsGrpc(s, server{})
+	info, ok := s.GetServiceInfo()["asgrpc.Store"]
+	if !ok {
+		t.Fatalf("GetServiceInfo() = %v, want asgrpc.Store", s.GetServiceInfo())
+	}
+	var names []string
+	for _, m := range info.Methods {
+		names = append(names, m.Name)
+	}
+	if got, want := strings.Join(names, ","), "Put,Stat"; got != want {
+		t.Errorf("methods = %s, want %s", got, want)
+	}
+	if info.Metadata != "asgrpc/asgrpc.proto" {
+		t.Errorf("Metadata = %v, want asgrpc/asgrpc.proto", info.Metadata)
+	}
+}
+
+// decoder returns a gRPC decode function for the encoding of m.
+func decoder(t *testing.T, m proto.Message) func(interface{}) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return func(v interface{}) error {
+		return proto.Unmarshal(b, v.(proto.Message))
+	}
+}
+
+func TestHandler(t *testing.T) {
+	ctx := context.Background()
+	srv := server{}
+
+	out, err := _Store_Stat_GrpcHandler(srv, ctx, decoder(t, &Chunk{Data: []byte("abc")}), nil)
+	if err != nil || out.(*Ack).Size != 3 {
+		t.Errorf("Stat = %v, %v; want size 3", out, err)
+	}
+
+	var method string
+	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method = info.FullMethod
+		return handler(ctx, req)
+	}
+	out, err = _Store_Put_GrpcHandler(srv, ctx, decoder(t, &Chunk{Data: []byte("ab")}), interceptor)
+	if err != nil || out.(*Ack).Size != 2 {
+		t.Errorf("Put through interceptor = %v, %v; want size 2", out, err)
+	}
+	if method != "/asgrpc.Store/Put" {
+		t.Errorf("interceptor saw method %q, want /asgrpc.Store/Put", method)
+	}
+}
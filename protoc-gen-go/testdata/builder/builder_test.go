@@ -0,0 +1,84 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestBuild(t *testing.T) {
+	creds, err := NewCredentialsBuilder().SetUser("ann").SetPassword("pw").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewRequestBuilder().
+		SetId("r1").
+		SetPriority(3).
+		SetKind(Request_WRITE).
+		SetPayload([]byte("x")).
+		AddTags("a", "b").
+		AddTags("c").
+		PutTargets("east", NewTargetBuilder().SetHost("e").Msg()).
+		SetPrimary(NewTargetBuilder().SetHost("p").SetPort(80).Msg()).
+		SetToken("tok").
+		SetCredentials(creds).
+		AddBackups(&Target{Host: proto.String("b")}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Request{
+		Id:       proto.String("r1"),
+		Priority: proto.Int32(3),
+		Kind:     Request_WRITE.Enum(),
+		Payload:  []byte("x"),
+		Tags:     []string{"a", "b", "c"},
+		Targets:  map[string]*Target{"east": {Host: proto.String("e")}},
+		Primary:  &Target{Host: proto.String("p"), Port: proto.Int32(80)},
+		Auth:     &Request_Credentials{&Credentials{User: proto.String("ann"), Password: proto.String("pw")}},
+		Backups:  []*Target{{Host: proto.String("b")}},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("Build() = %v\nwant %v", got, want)
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	if _, err := NewRequestBuilder().SetPriority(1).Build(); err == nil || err.Error() != "required field builder.Request.id not set" {
+		t.Errorf("Build() without id: error %v", err)
+	}
+	if _, err := NewTargetBuilder().SetPort(70000).Build(); err == nil || err.Error() != "port out of range" {
+		t.Errorf("Build() with bad port: error %v", err)
+	}
+}
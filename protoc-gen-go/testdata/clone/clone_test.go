@@ -0,0 +1,183 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package clone
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func kitchen() *Kitchen {
+	return &Kitchen{
+		I32:      proto.Int32(7),
+		F64:      proto.Float64(1.5),
+		Str:      proto.String("str"),
+		Data:     []byte("data"),
+		Color:    Kitchen_GREEN.Enum(),
+		Part:     &Kitchen_Part{Name: proto.String("part"), Parts: []*Kitchen_Part{{Name: proto.String("sub")}}},
+		LazyPart: &Kitchen_Part{Name: proto.String("lazy")},
+		Bag:      &Kitchen_Bag{Label: proto.String("bag")},
+		Ints:     []int64{1, 2, 3},
+		Strs:     []string{"a", "b"},
+		Datas:    [][]byte{[]byte("x"), {}, []byte("y")},
+		Colors:   []Kitchen_Color{Kitchen_RED, Kitchen_GREEN},
+		Parts:    []*Kitchen_Part{{Name: proto.String("p1")}, {Name: proto.String("p2")}},
+		Counts:   map[string]int32{"one": 1, "two": 2},
+		Blobs:    map[int32][]byte{1: []byte("blob"), 2: {}},
+		PartMap:  map[string]*Kitchen_Part{"k": {Name: proto.String("v")}},
+		Choice:   &Kitchen_Chosen{&Kitchen_Part{Name: proto.String("chosen")}},
+		Settings: &Settings{
+			Name:    "settings",
+			Data:    []byte("sdata"),
+			Weights: []float32{0.5},
+			Child:   &Settings{Name: "child"},
+			ByName:  map[string]*Settings{"n": {Value: &Settings_Text{"text"}}},
+			Value:   &Settings_Nested{&Settings{Name: "nested"}},
+		},
+		Ext: &Extendable{Name: proto.String("ext")},
+	}
+}
+
+func TestCloneMessage(t *testing.T) {
+	choices := []isKitchen_Choice{
+		nil,
+		&Kitchen_Number{5},
+		&Kitchen_Raw{[]byte("raw")},
+		&Kitchen_Raw{[]byte{}},
+		&Kitchen_Chosen{&Kitchen_Part{Name: proto.String("chosen")}},
+	}
+	for _, choice := range choices {
+		m := kitchen()
+		m.Choice = choice
+		c := m.CloneMessage()
+		if !proto.Equal(c, m) {
+			t.Errorf("CloneMessage() = %v, want %v", c, m)
+		}
+		if pc := proto.Clone(m); !proto.Equal(c, pc) {
+			t.Errorf("CloneMessage() = %v, proto.Clone = %v", c, pc)
+		}
+	}
+}
+
+func TestCloneIsDeep(t *testing.T) {
+	m := kitchen()
+	m.Choice = &Kitchen_Raw{[]byte("raw")}
+	c := m.CloneMessage()
+
+	*c.I32 = 8
+	c.Data[0] = 'D'
+	c.Part.Parts[0].Name = proto.String("changed")
+	c.LazyPart.Name = proto.String("changed")
+	c.Ints[0] = 100
+	c.Datas[0][0] = 'X'
+	c.Parts[0].Name = proto.String("changed")
+	c.Counts["three"] = 3
+	c.Blobs[1][0] = 'B'
+	c.PartMap["k"].Name = proto.String("changed")
+	c.Choice.(*Kitchen_Raw).Raw[0] = 'R'
+	c.Settings.Child.Name = "changed"
+	c.Ext.Name = proto.String("changed")
+
+	want := kitchen()
+	want.Choice = &Kitchen_Raw{[]byte("raw")}
+	if !proto.Equal(m, want) {
+		t.Errorf("changing the clone changed the original: got %v, want %v", m, want)
+	}
+}
+
+func TestCloneEmptyBytes(t *testing.T) {
+	m := kitchen()
+	m.Choice = &Kitchen_Raw{[]byte{}}
+	c := m.CloneMessage()
+	if c.Datas[1] == nil {
+		t.Error("empty repeated bytes element became nil")
+	}
+	if c.Blobs[2] == nil {
+		t.Error("empty bytes map value became nil")
+	}
+	if c.Choice.(*Kitchen_Raw).Raw == nil {
+		t.Error("empty bytes oneof member became nil")
+	}
+
+	m = &Kitchen{Datas: [][]byte{nil}}
+	if c := m.CloneMessage(); c.Data != nil || c.Datas[0] != nil {
+		t.Errorf("nil bytes became non-nil: %v", c)
+	}
+}
+
+func TestCloneNil(t *testing.T) {
+	var m *Kitchen
+	if c := m.CloneMessage(); c != nil {
+		t.Errorf("(*Kitchen)(nil).CloneMessage() = %v, want nil", c)
+	}
+	if c := new(Kitchen).CloneMessage(); !proto.Equal(c, new(Kitchen)) {
+		t.Errorf("empty Kitchen cloned to %v", c)
+	}
+}
+
+func TestCloneUnmarshaled(t *testing.T) {
+	m := kitchen()
+	m.XXX_unrecognized = []byte{0xf8, 0x3e, 0x01} // field 1000, varint 1
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	u := new(Kitchen)
+	if err := proto.Unmarshal(b, u); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	// The lazy field has not been decoded yet; the clone must still have it.
+	c := u.CloneMessage()
+	if !proto.Equal(c, m) {
+		t.Errorf("CloneMessage() = %v, want %v", c, m)
+	}
+	if cb, err := proto.Marshal(c); err != nil || len(cb) != len(b) {
+		t.Errorf("Marshal(clone) = %d bytes, %v; want %d bytes", len(cb), err, len(b))
+	}
+}
+
+func BenchmarkCloneMessage(b *testing.B) {
+	m := kitchen()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		m.CloneMessage()
+	}
+}
+
+func BenchmarkProtoClone(b *testing.B) {
+	m := kitchen()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		proto.Clone(m)
+	}
+}
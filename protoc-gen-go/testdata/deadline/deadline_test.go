@@ -0,0 +1,80 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package deadline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ccsnake/protobuf/callinfo"
+)
+
+type server struct{}
+
+func left(ctx context.Context) *Answer {
+	d, ok := ctx.Deadline()
+	if !ok {
+		return &Answer{LeftMs: -1}
+	}
+	return &Answer{LeftMs: int64(d.Sub(time.Now()) / time.Millisecond)}
+}
+
+func (server) Ask(ctx context.Context, in *Query) (*Answer, error)  { return left(ctx), nil }
+func (server) Wait(ctx context.Context, in *Query) (*Answer, error) { return left(ctx), nil }
+
+func TestCallInfoTimeouts(t *testing.T) {
+	if d := callinfo.Lookup("deadline@Oracle/Ask").DefaultTimeout; d != 1500*time.Millisecond {
+		t.Errorf("Ask DefaultTimeout = %v, want 1.5s", d)
+	}
+	if d := callinfo.Lookup("deadline@Oracle/Wait").DefaultTimeout; d != 0 {
+		t.Errorf("Wait DefaultTimeout = %v, want none", d)
+	}
+}
+
+func TestDeadlineServer(t *testing.T) {
+	srv := _Oracle_deadlineServer{server{}}
+	ctx := context.Background()
+	if a, _ := srv.Ask(ctx, &Query{}); a.LeftMs < 1000 || a.LeftMs > 1500 {
+		t.Errorf("Ask without a deadline had %dms left, want about 1500ms", a.LeftMs)
+	}
+	// A deadline the caller sets is kept.
+	long, cancel := context.WithTimeout(ctx, time.Hour)
+	defer cancel()
+	if a, _ := srv.Ask(long, &Query{}); a.LeftMs < 1500 {
+		t.Errorf("Ask with an hour's deadline had %dms left", a.LeftMs)
+	}
+	// Methods without a default timeout are not wrapped.
+	if a, _ := srv.Wait(ctx, &Query{}); a.LeftMs != -1 {
+		t.Errorf("Wait had %dms left, want no deadline", a.LeftMs)
+	}
+}
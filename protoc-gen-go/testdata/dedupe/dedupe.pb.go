@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: dedupe/dedupe.proto
+
+/*
+Package dedupe is a generated protocol buffer package.
+
+Package dedupe tests the deduplication the carno plugin generates for
+requests with a (carno.idempotency_key) field.
+
+It is generated from these files:
+	dedupe/dedupe.proto
+
+It has these top-level messages:
+	ChargeRequest
+	RefundRequest
+	BalanceRequest
+	Receipt
+*/
+package dedupe
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	dedupe1 "github.com/ccsnake/protobuf/dedupe"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ChargeRequest struct {
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	Amount    int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+}
+
+func (m *ChargeRequest) Reset()                    { *m = ChargeRequest{} }
+func (m *ChargeRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChargeRequest) ProtoMessage()               {}
+func (*ChargeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *ChargeRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *ChargeRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type RefundRequest struct {
+	Token []byte `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *RefundRequest) Reset()                    { *m = RefundRequest{} }
+func (m *RefundRequest) String() string            { return proto.CompactTextString(m) }
+func (*RefundRequest) ProtoMessage()               {}
+func (*RefundRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *RefundRequest) GetToken() []byte {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+type BalanceRequest struct {
+}
+
+func (m *BalanceRequest) Reset()                    { *m = BalanceRequest{} }
+func (m *BalanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*BalanceRequest) ProtoMessage()               {}
+func (*BalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+type Receipt struct {
+	// Number of the call the server handled to make the receipt.
+	Serial int64 `protobuf:"varint,1,opt,name=serial" json:"serial,omitempty"`
+}
+
+func (m *Receipt) Reset()                    { *m = Receipt{} }
+func (m *Receipt) String() string            { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()               {}
+func (*Receipt) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Receipt) GetSerial() int64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ChargeRequest)(nil), "dedupe.ChargeRequest")
+	proto.RegisterType((*RefundRequest)(nil), "dedupe.RefundRequest")
+	proto.RegisterType((*BalanceRequest)(nil), "dedupe.BalanceRequest")
+	proto.RegisterType((*Receipt)(nil), "dedupe.Receipt")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Payments service
+type PaymentsClient interface {
+	Charge(ctx context.Context, in *ChargeRequest, opts ...client.CallOption) (*Receipt, error)
+	Refund(ctx context.Context, in *RefundRequest, opts ...client.CallOption) (*Receipt, error)
+	Balance(ctx context.Context, in *BalanceRequest, opts ...client.CallOption) (*Receipt, error)
+}
+
+type paymentsClient struct {
+	client.Client
+}
+
+// NewPaymentsClient creates and starts a client for the Payments service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewPaymentsClient(opts ...client.Option) (PaymentsClient, error) {
+	c, err := carno1.NewClient("dedupe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &paymentsClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Payments_callInfo = []*callinfo.CallInfo{
+	{
+		Service:        "dedupe@Payments",
+		Method:         "Charge",
+		RequestType:    "dedupe.ChargeRequest",
+		ResponseType:   "dedupe.Receipt",
+		File:           "dedupe/dedupe.proto",
+		IdempotencyKey: "request_id",
+	},
+	{
+		Service:        "dedupe@Payments",
+		Method:         "Refund",
+		RequestType:    "dedupe.RefundRequest",
+		ResponseType:   "dedupe.Receipt",
+		File:           "dedupe/dedupe.proto",
+		IdempotencyKey: "token",
+	},
+	{
+		Service:      "dedupe@Payments",
+		Method:       "Balance",
+		RequestType:  "dedupe.BalanceRequest",
+		ResponseType: "dedupe.Receipt",
+		File:         "dedupe/dedupe.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Payments_callInfo...)
+}
+
+func (c *paymentsClient) Charge(ctx context.Context, in *ChargeRequest, opts ...client.CallOption) (*Receipt, error) {
+	out := new(Receipt)
+	ctx = callinfo.NewContext(ctx, _Payments_callInfo[0])
+	err := c.Client.Call(ctx, "Payments", "Charge", in, out, opts...)
+	return out, err
+}
+
+func (c *paymentsClient) Refund(ctx context.Context, in *RefundRequest, opts ...client.CallOption) (*Receipt, error) {
+	out := new(Receipt)
+	ctx = callinfo.NewContext(ctx, _Payments_callInfo[1])
+	err := c.Client.Call(ctx, "Payments", "Refund", in, out, opts...)
+	return out, err
+}
+
+func (c *paymentsClient) Balance(ctx context.Context, in *BalanceRequest, opts ...client.CallOption) (*Receipt, error) {
+	out := new(Receipt)
+	ctx = callinfo.NewContext(ctx, _Payments_callInfo[2])
+	err := c.Client.Call(ctx, "Payments", "Balance", in, out, opts...)
+	return out, err
+}
+
+// PaymentsClient returns a PaymentsClient using the client shared by the services
+// of package dedupe.
+func (a *Dedupe) PaymentsClient() PaymentsClient {
+	return &paymentsClient{Client: a.c}
+}
+
+// Server API for Payments service
+type PaymentsServer interface {
+	Charge(context.Context, *ChargeRequest) (*Receipt, error)
+	Refund(context.Context, *RefundRequest) (*Receipt, error)
+	Balance(context.Context, *BalanceRequest) (*Receipt, error)
+}
+
+// _Payments_dedupeServer answers calls whose idempotency key was seen before
+// with the stored response, and stores the responses of new ones.
+type _Payments_dedupeServer struct {
+	PaymentsServer
+}
+
+func (s _Payments_dedupeServer) Charge(ctx context.Context, in *ChargeRequest) (*Receipt, error) {
+	out, err := dedupe1.Do(ctx, _Payments_callInfo[0], in.GetRequestId(), in, new(Receipt), func(ctx context.Context) (proto.Message, error) {
+		return s.PaymentsServer.Charge(ctx, in)
+	})
+	resp, _ := out.(*Receipt)
+	return resp, err
+}
+
+func (s _Payments_dedupeServer) Refund(ctx context.Context, in *RefundRequest) (*Receipt, error) {
+	out, err := dedupe1.Do(ctx, _Payments_callInfo[1], string(in.GetToken()), in, new(Receipt), func(ctx context.Context) (proto.Message, error) {
+		return s.PaymentsServer.Refund(ctx, in)
+	})
+	resp, _ := out.(*Receipt)
+	return resp, err
+}
+
+func RegisterPaymentsServer(srv PaymentsServer) {
+	callinfo.RegisterServer("dedupe@Payments")
+	carno1.HandleService(&_Payments_serviceDesc, _Payments_dedupeServer{srv})
+}
+
+var _Payments_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Payments",
+	Methods: []string{
+		"Charge",
+		"Refund",
+		"Balance",
+	},
+}
+
+func init() { proto.RegisterFile("dedupe/dedupe.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 249 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xcd, 0x4a, 0x03, 0x31,
+	0x14, 0x85, 0x89, 0xd5, 0xd4, 0x5e, 0xac, 0x4a, 0x8a, 0xa5, 0xce, 0xaa, 0x8e, 0x9b, 0x82, 0xd0,
+	0x96, 0xfa, 0x06, 0x75, 0x25, 0xb8, 0x90, 0xbc, 0x80, 0xc4, 0xc9, 0x55, 0x83, 0x6d, 0x12, 0xf3,
+	0xb3, 0xf0, 0x4d, 0x5c, 0xfb, 0x08, 0x3e, 0xa1, 0x38, 0x49, 0x90, 0x61, 0x56, 0x33, 0xe7, 0x5c,
+	0xce, 0xb9, 0xdf, 0x0d, 0x4c, 0x24, 0xca, 0x68, 0x71, 0x95, 0x3e, 0x4b, 0xeb, 0x4c, 0x30, 0x8c,
+	0x26, 0x55, 0x4d, 0x1a, 0xe1, 0xb4, 0x59, 0x19, 0x1b, 0x94, 0xd1, 0x3e, 0x0d, 0xeb, 0x07, 0x18,
+	0xdf, 0xbd, 0x09, 0xf7, 0x8a, 0x1c, 0x3f, 0x22, 0xfa, 0xc0, 0xae, 0x01, 0x5c, 0xfa, 0x7d, 0x52,
+	0x72, 0x46, 0xe6, 0x64, 0x31, 0xda, 0x1e, 0x7e, 0xfd, 0x5c, 0x12, 0x3e, 0xca, 0xfe, 0xbd, 0x64,
+	0x53, 0xa0, 0x62, 0x6f, 0xa2, 0x0e, 0xb3, 0x83, 0x39, 0x59, 0x0c, 0x78, 0x56, 0xf5, 0x0d, 0x8c,
+	0x39, 0xbe, 0x44, 0x2d, 0x4b, 0x5b, 0x05, 0x47, 0xc1, 0xbc, 0xa3, 0x6e, 0x8b, 0x4e, 0x72, 0x51,
+	0xb2, 0xea, 0x73, 0x38, 0xdd, 0x8a, 0x9d, 0xd0, 0x4d, 0xd9, 0x5d, 0x5f, 0xc1, 0x90, 0x63, 0x83,
+	0xca, 0x86, 0xbf, 0x0d, 0x1e, 0x9d, 0x12, 0xbb, 0x36, 0x39, 0xe0, 0x59, 0x6d, 0xbe, 0x09, 0x1c,
+	0x3f, 0x8a, 0xcf, 0x3d, 0xea, 0xe0, 0xd9, 0x1a, 0x68, 0x82, 0x67, 0x17, 0xcb, 0x7c, 0x72, 0xe7,
+	0x98, 0xea, 0xac, 0xd8, 0xa5, 0x76, 0x0d, 0x34, 0x01, 0xfe, 0x27, 0x3a, 0xc0, 0xfd, 0xc4, 0x06,
+	0x86, 0x99, 0x92, 0x4d, 0xcb, 0xac, 0x8b, 0xdd, 0xcb, 0x3c, 0xd3, 0xf6, 0x6d, 0x6f, 0x7f, 0x07,
+	0x00, 0xdb, 0x26, 0x3d, 0xe5, 0x8f, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,117 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dedupe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/ccsnake/protobuf/dedupe"
+)
+
+// server numbers the calls it handles. Charges of zero are declined.
+type server struct {
+	calls int64
+}
+
+func (s *server) receipt() *Receipt {
+	s.calls++
+	return &Receipt{Serial: s.calls}
+}
+
+func (s *server) Charge(ctx context.Context, in *ChargeRequest) (*Receipt, error) {
+	if in.Amount == 0 {
+		s.calls++
+		return nil, errors.New("declined")
+	}
+	return s.receipt(), nil
+}
+
+func (s *server) Refund(ctx context.Context, in *RefundRequest) (*Receipt, error) {
+	return s.receipt(), nil
+}
+
+func (s *server) Balance(ctx context.Context, in *BalanceRequest) (*Receipt, error) {
+	return s.receipt(), nil
+}
+
+func TestCallInfoIdempotencyKey(t *testing.T) {
+	for method, want := range map[string]string{
+		"dedupe@Payments/Charge":  "request_id",
+		"dedupe@Payments/Refund":  "token",
+		"dedupe@Payments/Balance": "",
+	} {
+		if got := callinfo.Lookup(method).IdempotencyKey; got != want {
+			t.Errorf("%s IdempotencyKey = %q, want %q", method, got, want)
+		}
+	}
+}
+
+func TestDedupeServer(t *testing.T) {
+	dedupe.SetStore(dedupe.NewMemoryStore(time.Hour))
+	defer dedupe.SetStore(dedupe.NewMemoryStore(24 * time.Hour))
+	s := &server{}
+	srv := _Payments_dedupeServer{s}
+	ctx := context.Background()
+
+	serial := func(r *Receipt, err error) int64 {
+		if err != nil {
+			return -1
+		}
+		return r.Serial
+	}
+	for _, c := range []struct {
+		what string
+		got  int64
+		want int64
+	}{
+		{"charge", serial(srv.Charge(ctx, &ChargeRequest{RequestId: "a", Amount: 5})), 1},
+		{"repeated charge", serial(srv.Charge(ctx, &ChargeRequest{RequestId: "a", Amount: 5})), 1},
+		{"charge reusing a key", serial(srv.Charge(ctx, &ChargeRequest{RequestId: "a", Amount: 6})), -1},
+		{"charge with a new key", serial(srv.Charge(ctx, &ChargeRequest{RequestId: "b", Amount: 5})), 2},
+		{"charge without a key", serial(srv.Charge(ctx, &ChargeRequest{Amount: 5})), 3},
+		{"repeated charge without a key", serial(srv.Charge(ctx, &ChargeRequest{Amount: 5})), 4},
+		{"declined charge", serial(srv.Charge(ctx, &ChargeRequest{RequestId: "c"})), -1},
+		{"retried charge", serial(srv.Charge(ctx, &ChargeRequest{RequestId: "c", Amount: 5})), 6},
+		{"refund", serial(srv.Refund(ctx, &RefundRequest{Token: []byte("a")})), 7},
+		{"repeated refund", serial(srv.Refund(ctx, &RefundRequest{Token: []byte("a")})), 7},
+		{"balance", serial(srv.Balance(ctx, &BalanceRequest{})), 8},
+		{"repeated balance", serial(srv.Balance(ctx, &BalanceRequest{})), 9},
+	} {
+		if c.got != c.want {
+			t.Errorf("%s: receipt %d, want %d", c.what, c.got, c.want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//   - Redistributions of source code must retain the above copyright
+//
+// notice, this list of conditions and the following disclaimer.
+//   - Redistributions in binary form must reproduce the above
+//
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//   - Neither the name of Google Inc. nor the names of its
+//
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package descset
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestSetForMessage(t *testing.T) {
+	for _, tc := range []struct {
+		msg   descriptor.SetMessage
+		files []string
+	}{
+		{&Order{}, []string{"descset/base.proto", "descset/descset.proto"}},
+		{&Order_Line{}, []string{"descset/base.proto", "descset/descset.proto"}},
+		{&Money{}, []string{"descset/base.proto"}},
+	} {
+		set := descriptor.SetForMessage(tc.msg)
+		var files []string
+		for _, fd := range set.File {
+			files = append(files, fd.GetName())
+			if fd.SourceCodeInfo != nil {
+				t.Errorf("%T: %s has SourceCodeInfo", tc.msg, fd.GetName())
+			}
+		}
+		if len(files) != len(tc.files) {
+			t.Errorf("%T: set has files %q, want %q", tc.msg, files, tc.files)
+			continue
+		}
+		for i := range files {
+			if files[i] != tc.files[i] {
+				t.Errorf("%T: set has files %q, want %q", tc.msg, files, tc.files)
+				break
+			}
+		}
+	}
+}
+
+func TestFileSet(t *testing.T) {
+	gz := proto.FileDescriptorSet("descset/descset.proto")
+	if !bytes.Equal(gz, (&Order{}).FileDescriptor()) {
+		t.Error("registered set differs from Order.FileDescriptor()")
+	}
+	set, err := descriptor.FileSet("descset/descset.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(set, descriptor.SetForMessage(&Order{})) {
+		t.Errorf("FileSet = %v, want the set of Order", set)
+	}
+	if _, err := descriptor.FileSet("descset/missing.proto"); err == nil {
+		t.Error("FileSet of an unregistered file succeeded")
+	}
+}
+
+// Audit has a field named FileDescriptor, so the method is left out.
+func TestFieldNamedFileDescriptor(t *testing.T) {
+	var m interface{} = &Audit{FileDescriptor: []byte("x")}
+	if _, ok := m.(descriptor.SetMessage); ok {
+		t.Error("Audit is a descriptor.SetMessage")
+	}
+}
@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: entity/entity.proto
+
+/*
+Package entity is a generated protocol buffer package.
+
+It is generated from these files:
+
+	entity/entity.proto
+
+It has these top-level messages:
+
+	User
+	Profile
+	Team
+*/
+package entity
+
+import (
+	driver "database/sql/driver"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	sqlpb "github.com/ccsnake/protobuf/sqlpb"
+	proto "github.com/golang/protobuf/proto"
+	google_protobuf1 "github.com/golang/protobuf/ptypes/struct"
+	google_protobuf2 "github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Status int32
+
+const (
+	Status_STATUS_UNKNOWN Status = 0
+	Status_ACTIVE         Status = 1
+	Status_BANNED         Status = 2
+)
+
+var Status_name = map[int32]string{
+	0: "STATUS_UNKNOWN",
+	1: "ACTIVE",
+	2: "BANNED",
+}
+var Status_value = map[string]int32{
+	"STATUS_UNKNOWN": 0,
+	"ACTIVE":         1,
+	"BANNED":         2,
+}
+
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+func (Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type User struct {
+	Id        string                      `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name      string                      `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Age       int32                       `protobuf:"varint,3,opt,name=age" json:"age,omitempty"`
+	Balance   int64                       `protobuf:"varint,4,opt,name=balance" json:"balance,omitempty"`
+	Verified  bool                        `protobuf:"varint,5,opt,name=verified" json:"verified,omitempty"`
+	Score     float64                     `protobuf:"fixed64,6,opt,name=score" json:"score,omitempty"`
+	Avatar    []byte                      `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Status    Status                      `protobuf:"varint,8,opt,name=status,enum=entity.Status" json:"status,omitempty"`
+	CreatedAt *google_protobuf2.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	DeletedAt *google_protobuf2.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt" json:"deleted_at,omitempty"`
+	// An enum declared in another file, which has no Scan method.
+	Nothing google_protobuf1.NullValue `protobuf:"varint,11,opt,name=nothing,enum=google.protobuf.NullValue" json:"nothing,omitempty"`
+	// Not stored.
+	Tags    []string `protobuf:"bytes,12,rep,name=tags" json:"tags,omitempty"`
+	Profile *Profile `protobuf:"bytes,13,opt,name=profile" json:"profile,omitempty"`
+	// Types that are valid to be assigned to Contact:
+	//	*User_Phone
+	//	*User_Mail
+	Contact isUser_Contact `protobuf_oneof:"contact"`
+}
+
+func (m *User) Reset()                    { *m = User{} }
+func (m *User) String() string            { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()               {}
+func (*User) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type isUser_Contact interface{ isUser_Contact() }
+
+type User_Phone struct {
+	Phone string `protobuf:"bytes,14,opt,name=phone,oneof"`
+}
+type User_Mail struct {
+	Mail string `protobuf:"bytes,15,opt,name=mail,oneof"`
+}
+
+func (*User_Phone) isUser_Contact() {}
+func (*User_Mail) isUser_Contact()  {}
+
+func (m *User) GetContact() isUser_Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *User) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *User) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *User) GetAge() int32 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+func (m *User) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *User) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *User) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *User) GetAvatar() []byte {
+	if m != nil {
+		return m.Avatar
+	}
+	return nil
+}
+
+func (m *User) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_STATUS_UNKNOWN
+}
+
+func (m *User) GetCreatedAt() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *User) GetDeletedAt() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
+func (m *User) GetNothing() google_protobuf1.NullValue {
+	if m != nil {
+		return m.Nothing
+	}
+	return google_protobuf1.NullValue_NULL_VALUE
+}
+
+func (m *User) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *User) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *User) GetPhone() string {
+	if x, ok := m.GetContact().(*User_Phone); ok {
+		return x.Phone
+	}
+	return ""
+}
+
+func (m *User) GetMail() string {
+	if x, ok := m.GetContact().(*User_Mail); ok {
+		return x.Mail
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*User) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _User_OneofMarshaler, _User_OneofUnmarshaler, _User_OneofSizer, []interface{}{
+		(*User_Phone)(nil),
+		(*User_Mail)(nil),
+	}
+}
+
+func _User_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*User)
+	// contact
+	switch x := m.Contact.(type) {
+	case *User_Phone:
+		b.EncodeVarint(14<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Phone)
+	case *User_Mail:
+		b.EncodeVarint(15<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Mail)
+	case nil:
+	default:
+		return fmt.Errorf("User.Contact has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _User_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*User)
+	switch tag {
+	case 14: // contact.phone
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Contact = &User_Phone{x}
+		return true, err
+	case 15: // contact.mail
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Contact = &User_Mail{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _User_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*User)
+	// contact
+	switch x := m.Contact.(type) {
+	case *User_Phone:
+		n += proto.SizeVarint(14<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Phone)))
+		n += len(x.Phone)
+	case *User_Mail:
+		n += proto.SizeVarint(15<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Mail)))
+		n += len(x.Mail)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Profile struct {
+	Bio string `protobuf:"bytes,1,opt,name=bio" json:"bio,omitempty"`
+}
+
+func (m *Profile) Reset()                    { *m = Profile{} }
+func (m *Profile) String() string            { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()               {}
+func (*Profile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Profile) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+type Team struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *Team) Reset()                    { *m = Team{} }
+func (m *Team) String() string            { return proto.CompactTextString(m) }
+func (*Team) ProtoMessage()               {}
+func (*Team) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Team) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type Team_Member struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	TeamId string `protobuf:"bytes,2,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	Status Status `protobuf:"varint,3,opt,name=status,enum=entity.Status" json:"status,omitempty"`
+}
+
+func (m *Team_Member) Reset()                    { *m = Team_Member{} }
+func (m *Team_Member) String() string            { return proto.CompactTextString(m) }
+func (*Team_Member) ProtoMessage()               {}
+func (*Team_Member) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
+
+func (m *Team_Member) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Team_Member) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *Team_Member) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_STATUS_UNKNOWN
+}
+
+func init() {
+	proto.RegisterType((*User)(nil), "entity.User")
+	proto.RegisterType((*Profile)(nil), "entity.Profile")
+	proto.RegisterType((*Team)(nil), "entity.Team")
+	proto.RegisterType((*Team_Member)(nil), "entity.Team.Member")
+	proto.RegisterEnum("entity.Status", Status_name, Status_value)
+}
+
+// UserTable is the database table of User, from its (carno.entity) option.
+const UserTable = "users"
+
+// UserPrimaryKey is the column of UserTable holding the primary key.
+const UserPrimaryKey = "id"
+
+// UserColumns are the columns of UserTable, in the order of the
+// fields ScanRow sets and RowValues returns.
+var UserColumns = []string{"id", "name", "age", "balance", "verified", "score", "avatar", "status", "created_at", "deleted_at", "nothing"}
+
+// ScanRow sets the fields of m stored in UserTable from row, whose
+// columns must be UserColumns.
+func (m *User) ScanRow(row sqlpb.Row) error {
+	return row.Scan(&m.Id, &m.Name, &m.Age, &m.Balance, &m.Verified, &m.Score, &m.Avatar, &m.Status, sqlpb.Timestamp(&m.CreatedAt), sqlpb.Timestamp(&m.DeletedAt), sqlpb.Enum(&m.Nothing, google_protobuf1.NullValue_value))
+}
+
+// RowValues returns the values of the fields of m stored in UserTable,
+// in the order of UserColumns.
+func (m *User) RowValues() []interface{} {
+	return []interface{}{m.Id, m.Name, m.Age, m.Balance, m.Verified, m.Score, m.Avatar, m.Status, sqlpb.Timestamp(&m.CreatedAt), sqlpb.Timestamp(&m.DeletedAt), sqlpb.Enum(&m.Nothing, google_protobuf1.NullValue_value)}
+}
+
+// Team_MemberTable is the database table of Team_Member, from its (carno.entity) option.
+const Team_MemberTable = "team_members"
+
+// Team_MemberPrimaryKey is the column of Team_MemberTable holding the primary key.
+const Team_MemberPrimaryKey = "user_id"
+
+// Team_MemberColumns are the columns of Team_MemberTable, in the order of the
+// fields ScanRow sets and RowValues returns.
+var Team_MemberColumns = []string{"user_id", "team_id", "status"}
+
+// ScanRow sets the fields of m stored in Team_MemberTable from row, whose
+// columns must be Team_MemberColumns.
+func (m *Team_Member) ScanRow(row sqlpb.Row) error {
+	return row.Scan(&m.UserId, &m.TeamId, &m.Status)
+}
+
+// RowValues returns the values of the fields of m stored in Team_MemberTable,
+// in the order of Team_MemberColumns.
+func (m *Team_Member) RowValues() []interface{} {
+	return []interface{}{m.UserId, m.TeamId, m.Status}
+}
+
+// Value returns the number of x, as it is stored in a database.
+func (x Status) Value() (driver.Value, error) {
+	return int64(x), nil
+}
+
+// Scan sets x from a database column holding its number or name.
+func (x *Status) Scan(src interface{}) error {
+	n, err := sqlpb.EnumNumber(src, Status_value)
+	if err != nil {
+		return err
+	}
+	*x = Status(n)
+	return nil
+}
+
+func init() { proto.RegisterFile("entity/entity.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x6a, 0xdb, 0x40,
+	0x10, 0xc6, 0xb3, 0x96, 0x2d, 0xd9, 0x63, 0xd7, 0x36, 0x9b, 0x90, 0x6c, 0x9c, 0x42, 0x85, 0x0f,
+	0x45, 0xed, 0xc1, 0x86, 0x34, 0x97, 0xe6, 0xe6, 0xb4, 0x81, 0x9a, 0x52, 0xb5, 0xc8, 0x76, 0x7a,
+	0x34, 0x6b, 0x69, 0xec, 0x2c, 0x48, 0x5a, 0xa3, 0x5d, 0x05, 0x7a, 0xea, 0x3d, 0x2f, 0xd0, 0xf7,
+	0xc8, 0xfb, 0x15, 0xca, 0xea, 0x4f, 0x28, 0x29, 0x34, 0x27, 0xcd, 0x6f, 0xbe, 0x99, 0xdd, 0xd5,
+	0x37, 0x03, 0x87, 0x98, 0x6a, 0xa1, 0x7f, 0x4c, 0xcb, 0xcf, 0x64, 0x9f, 0x49, 0x2d, 0xa9, 0x5d,
+	0xd2, 0xe8, 0x30, 0xe4, 0x59, 0x2a, 0xa7, 0x72, 0xaf, 0x85, 0x4c, 0x55, 0x29, 0x8e, 0x5e, 0xee,
+	0xa4, 0xdc, 0xc5, 0x38, 0x2d, 0x68, 0x93, 0x6f, 0xa7, 0x4a, 0x67, 0x79, 0xa8, 0x2b, 0xf5, 0xd5,
+	0x53, 0x55, 0x8b, 0x04, 0x95, 0xe6, 0xc9, 0xbe, 0x2c, 0x18, 0xff, 0xb6, 0xa0, 0xb9, 0x52, 0x98,
+	0xd1, 0x3e, 0x34, 0x44, 0xc4, 0x88, 0x4b, 0xbc, 0x4e, 0xd0, 0x10, 0x11, 0xa5, 0xd0, 0x4c, 0x79,
+	0x82, 0xac, 0x51, 0x64, 0x8a, 0x98, 0x0e, 0xc1, 0xe2, 0x3b, 0x64, 0x96, 0x4b, 0xbc, 0x56, 0x60,
+	0x42, 0xca, 0xc0, 0xd9, 0xf0, 0x98, 0xa7, 0x21, 0xb2, 0xa6, 0x4b, 0x3c, 0x2b, 0xa8, 0x91, 0x8e,
+	0xa0, 0x7d, 0x87, 0x99, 0xd8, 0x0a, 0x8c, 0x58, 0xcb, 0x25, 0x5e, 0x3b, 0x78, 0x64, 0x7a, 0x04,
+	0x2d, 0x15, 0xca, 0x0c, 0x99, 0xed, 0x12, 0x8f, 0x04, 0x25, 0xd0, 0x63, 0xb0, 0xf9, 0x1d, 0xd7,
+	0x3c, 0x63, 0x8e, 0x4b, 0xbc, 0x5e, 0x50, 0x11, 0x7d, 0x0d, 0xb6, 0xd2, 0x5c, 0xe7, 0x8a, 0xb5,
+	0x5d, 0xe2, 0xf5, 0xcf, 0xfb, 0x93, 0xca, 0x9d, 0x45, 0x91, 0x0d, 0x2a, 0x95, 0xbe, 0x07, 0x08,
+	0x33, 0xe4, 0x1a, 0xa3, 0x35, 0xd7, 0xac, 0xe3, 0x12, 0xaf, 0x7b, 0x3e, 0x9a, 0x94, 0x06, 0x4c,
+	0x6a, 0x03, 0x26, 0xcb, 0xda, 0x80, 0xa0, 0x53, 0x55, 0xcf, 0xb4, 0x69, 0x8d, 0x30, 0xc6, 0xaa,
+	0x15, 0x9e, 0x6f, 0xad, 0xaa, 0x67, 0x9a, 0x5e, 0x80, 0x93, 0x4a, 0x7d, 0x2b, 0xd2, 0x1d, 0xeb,
+	0x16, 0xcf, 0xfb, 0xb7, 0xcf, 0xcf, 0xe3, 0xf8, 0x86, 0xc7, 0x39, 0x06, 0x75, 0xa9, 0x71, 0x57,
+	0xf3, 0x9d, 0x62, 0x3d, 0xd7, 0x32, 0xee, 0x9a, 0x98, 0xbe, 0x01, 0x67, 0x9f, 0xc9, 0xad, 0x88,
+	0x91, 0xbd, 0x28, 0x5e, 0x30, 0xa8, 0x7f, 0xf4, 0x5b, 0x99, 0x0e, 0x6a, 0x9d, 0x1e, 0x43, 0x6b,
+	0x7f, 0x2b, 0x53, 0x64, 0x7d, 0x33, 0x9d, 0x4f, 0x07, 0x41, 0x89, 0xf4, 0x08, 0x9a, 0x09, 0x17,
+	0x31, 0x1b, 0x54, 0xe9, 0x82, 0x2e, 0x07, 0xf7, 0x0f, 0xa7, 0x5d, 0x68, 0xe5, 0x0a, 0x33, 0x45,
+	0x1b, 0x22, 0xba, 0xea, 0x80, 0x13, 0xca, 0x54, 0xf3, 0x50, 0x8f, 0xcf, 0xc0, 0xa9, 0x4e, 0x37,
+	0xd3, 0xdd, 0x08, 0x59, 0xad, 0x80, 0x09, 0xc7, 0xbf, 0x08, 0x34, 0x97, 0xc8, 0x93, 0xa7, 0xcb,
+	0x31, 0xfa, 0x09, 0xf6, 0x17, 0x4c, 0x36, 0x98, 0xd1, 0x13, 0x70, 0xcc, 0xb9, 0xeb, 0x47, 0xd9,
+	0x36, 0x38, 0x8f, 0x8c, 0xa0, 0x91, 0x27, 0x46, 0x28, 0x57, 0xc8, 0x36, 0x38, 0x8f, 0xfe, 0x1a,
+	0xa7, 0xf5, 0xbf, 0x71, 0x5e, 0x9e, 0xdd, 0x3f, 0x9c, 0x9e, 0x40, 0xaf, 0x38, 0x24, 0x29, 0x2e,
+	0x53, 0xb4, 0xbe, 0xeb, 0xed, 0x05, 0xd8, 0x65, 0x39, 0xa5, 0xd0, 0x5f, 0x2c, 0x67, 0xcb, 0xd5,
+	0x62, 0xbd, 0xf2, 0x3f, 0xfb, 0x5f, 0xbf, 0xfb, 0xc3, 0x03, 0x0a, 0x60, 0xcf, 0x3e, 0x2c, 0xe7,
+	0x37, 0xd7, 0x43, 0x62, 0xe2, 0xab, 0x99, 0xef, 0x5f, 0x7f, 0x1c, 0x36, 0x36, 0x76, 0x31, 0x92,
+	0x77, 0x7f, 0x06, 0x00, 0x2b, 0xd2, 0x10, 0xb5, 0x66, 0x03, 0x00, 0x00,
+}
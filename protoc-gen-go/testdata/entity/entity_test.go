@@ -0,0 +1,211 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package entity
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// fakeDriver is a database/sql driver with a single table row: Exec
+// stores its arguments, as the driver gets them, in row, and Query
+// returns row.
+type fakeDriver struct {
+	row []driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{d}, nil }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt{c.d}, nil }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("no transactions") }
+
+type fakeStmt struct{ d *fakeDriver }
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return -1 }
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.row = args
+	return driver.RowsAffected(1), nil
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{row: s.d.row}, nil
+}
+
+type fakeRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (r *fakeRows) Columns() []string {
+	cols := make([]string, len(r.row))
+	for i := range cols {
+		cols[i] = UserColumns[i]
+	}
+	return cols
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.row)
+	return nil
+}
+
+var fake = new(fakeDriver)
+
+func init() { sql.Register("entitytest", fake) }
+
+func openDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("entitytest", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// scanUser queries the row and scans it into a User.
+func scanUser(t *testing.T, db *sql.DB) (*User, error) {
+	q := "SELECT " + strings.Join(UserColumns, ", ") + " FROM " + UserTable
+	rows, err := db.Query(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		t.Fatal("no row")
+	}
+	u := new(User)
+	return u, u.ScanRow(rows)
+}
+
+func TestRoundTrip(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+	u := &User{
+		Id:        "u1",
+		Name:      "Ann",
+		Age:       41,
+		Balance:   -7,
+		Verified:  true,
+		Score:     2.5,
+		Avatar:    []byte{1, 2},
+		Status:    Status_BANNED,
+		CreatedAt: &tspb.Timestamp{Seconds: 1500000000, Nanos: 5000},
+		Tags:      []string{"not", "stored"},
+		Profile:   &Profile{Bio: "not stored"},
+		Contact:   &User_Phone{Phone: "not stored"},
+	}
+	if len(u.RowValues()) != len(UserColumns) {
+		t.Fatalf("%d values for %d columns", len(u.RowValues()), len(UserColumns))
+	}
+	if _, err := db.Exec("INSERT INTO "+UserTable+" VALUES (...)", u.RowValues()...); err != nil {
+		t.Fatal(err)
+	}
+
+	// The driver gets the stored columns in the types it knows.
+	want := []driver.Value{
+		"u1", "Ann", int64(41), int64(-7), true, 2.5, []byte{1, 2}, int64(2),
+		time.Unix(1500000000, 5000).UTC(), nil, int64(0),
+	}
+	if !reflect.DeepEqual(fake.row, want) {
+		t.Errorf("driver got %#v\nwant %#v", fake.row, want)
+	}
+
+	got, err := scanUser(t, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u.Tags, u.Profile, u.Contact = nil, nil, nil
+	if !proto.Equal(got, u) {
+		t.Errorf("scanned %v\nwant %v", got, u)
+	}
+}
+
+func TestScanText(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+	// Some drivers return enums and times as text.
+	fake.row = []driver.Value{
+		"u2", "Bo", int64(0), int64(0), false, 0.0, nil, []byte("ACTIVE"),
+		"2017-07-14T02:40:00.000000005Z", nil, "NULL_VALUE",
+	}
+	got, err := scanUser(t, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &User{
+		Id:        "u2",
+		Name:      "Bo",
+		Status:    Status_ACTIVE,
+		CreatedAt: &tspb.Timestamp{Seconds: 1500000000, Nanos: 5},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("scanned %v\nwant %v", got, want)
+	}
+
+	fake.row[7] = "PENDING"
+	if _, err := scanUser(t, db); err == nil || !strings.Contains(err.Error(), "PENDING") {
+		t.Errorf("scanning an unknown enum name: got error %v", err)
+	}
+}
+
+func TestNested(t *testing.T) {
+	m := &Team_Member{UserId: "u1", TeamId: "t1", Status: Status_ACTIVE}
+	if Team_MemberTable != "team_members" || Team_MemberPrimaryKey != "user_id" {
+		t.Errorf("table %q, primary key %q", Team_MemberTable, Team_MemberPrimaryKey)
+	}
+	want := []interface{}{"u1", "t1", Status_ACTIVE}
+	if got := m.RowValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("RowValues() = %v, want %v", got, want)
+	}
+	if v, err := Status_ACTIVE.Value(); v != int64(1) || err != nil {
+		t.Errorf("Status_ACTIVE.Value() = %v, %v, want 1", v, err)
+	}
+}
@@ -0,0 +1,129 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package enumhelpers
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Color
+	}{
+		{"RED", Color_RED},
+		{"BLUE", Color_BLUE},
+		{"AZURE", Color_BLUE},
+		{"2", Color_BLUE},
+		{"7", Color(7)},
+	}
+	for _, tc := range tests {
+		got, err := ParseColor(tc.in)
+		if err != nil || got != tc.want {
+			t.Errorf("ParseColor(%q) = %v, %v; want %v", tc.in, got, err, tc.want)
+		}
+	}
+	if _, err := ParseColor("PURPLE"); err == nil {
+		t.Error("ParseColor(PURPLE) succeeded")
+	}
+	if got, err := ParsePaint_Finish("GLOSS"); err != nil || got != Paint_GLOSS {
+		t.Errorf("ParsePaint_Finish(GLOSS) = %v, %v", got, err)
+	}
+}
+
+func TestValues(t *testing.T) {
+	if got, want := ColorValues_(), []Color{Color_RED, Color_GREEN, Color_BLUE}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ColorValues_() = %v, want %v", got, want)
+	}
+	if got, want := Paint_FinishValues(), []Paint_Finish{Paint_MATTE, Paint_GLOSS}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Paint_FinishValues() = %v, want %v", got, want)
+	}
+}
+
+func TestText(t *testing.T) {
+	type config struct {
+		Color  Color
+		Finish Paint_Finish
+	}
+	b, err := json.Marshal(config{Color_GREEN, Paint_GLOSS})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"Color":"GREEN","Finish":"GLOSS"}`; got != want {
+		t.Errorf("json.Marshal = %s, want %s", got, want)
+	}
+	var c config
+	if err := json.Unmarshal([]byte(`{"Color":"AZURE","Finish":"GLOSS"}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Color != Color_BLUE || c.Finish != Paint_GLOSS {
+		t.Errorf("json.Unmarshal = %+v", c)
+	}
+	// Values without a name round-trip as numbers.
+	b, _ = Color(7).MarshalText()
+	var x Color
+	if err := x.UnmarshalText(b); err != nil || x != 7 {
+		t.Errorf("UnmarshalText(%s) = %v, %v", b, x, err)
+	}
+}
+
+func TestJSONPB(t *testing.T) {
+	in := &Paint{
+		Color:  Color_GREEN,
+		Finish: Paint_GLOSS,
+		Mix:    []Color{Color_RED, Color_BLUE},
+		Layers: map[string]Color{"base": Color_BLUE},
+	}
+	for _, m := range []jsonpb.Marshaler{{}, {EnumsAsInts: true}} {
+		s, err := m.MarshalToString(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := new(Paint)
+		if err := jsonpb.UnmarshalString(s, out); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", s, err)
+		}
+		if !proto.Equal(in, out) {
+			t.Errorf("EnumsAsInts=%v: round trip of %s = %v, want %v", m.EnumsAsInts, s, out, in)
+		}
+	}
+	m := jsonpb.Marshaler{EnumsAsInts: true}
+	s, _ := m.MarshalToString(in)
+	if want := `{"color":1,"finish":1,"mix":[0,2],"layers":{"base":2}}`; s != want {
+		t.Errorf("EnumsAsInts: got %s, want %s", s, want)
+	}
+}
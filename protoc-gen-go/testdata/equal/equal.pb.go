@@ -0,0 +1,649 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: equal/equal.proto
+
+/*
+Package equal is a generated protocol buffer package.
+
+It is generated from these files:
+	equal/equal.proto
+	equal/equal3.proto
+
+It has these top-level messages:
+	Kitchen
+	Extendable
+	Settings
+*/
+package equal
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Kitchen_Color int32
+
+const (
+	Kitchen_RED   Kitchen_Color = 0
+	Kitchen_GREEN Kitchen_Color = 1
+)
+
+var Kitchen_Color_name = map[int32]string{
+	0: "RED",
+	1: "GREEN",
+}
+var Kitchen_Color_value = map[string]int32{
+	"RED":   0,
+	"GREEN": 1,
+}
+
+func (x Kitchen_Color) Enum() *Kitchen_Color {
+	p := new(Kitchen_Color)
+	*p = x
+	return p
+}
+func (x Kitchen_Color) String() string {
+	return proto.EnumName(Kitchen_Color_name, int32(x))
+}
+func (x *Kitchen_Color) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Kitchen_Color_value, data, "Kitchen_Color")
+	if err != nil {
+		return err
+	}
+	*x = Kitchen_Color(value)
+	return nil
+}
+func (Kitchen_Color) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+type Kitchen struct {
+	I32   *int32         `protobuf:"varint,1,opt,name=i32" json:"i32,omitempty"`
+	F64   *float64       `protobuf:"fixed64,2,opt,name=f64" json:"f64,omitempty"`
+	Str   *string        `protobuf:"bytes,3,opt,name=str" json:"str,omitempty"`
+	Data  []byte         `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	Color *Kitchen_Color `protobuf:"varint,5,opt,name=color,enum=equal.Kitchen_Color" json:"color,omitempty"`
+	Part  *Kitchen_Part  `protobuf:"bytes,6,opt,name=part" json:"part,omitempty"`
+	// LazyPart is decoded on first use; read it with GetLazyPart.
+	LazyPart *Kitchen_Part            `protobuf:"bytes,7,opt,name=lazy_part,json=lazyPart,lazy" json:"lazy_part,omitempty"`
+	Bag      *Kitchen_Bag             `protobuf:"group,8,opt,name=Bag,json=bag" json:"bag,omitempty"`
+	Ints     []int64                  `protobuf:"varint,10,rep,name=ints" json:"ints,omitempty"`
+	Strs     []string                 `protobuf:"bytes,11,rep,name=strs" json:"strs,omitempty"`
+	Datas    [][]byte                 `protobuf:"bytes,12,rep,name=datas" json:"datas,omitempty"`
+	Colors   []Kitchen_Color          `protobuf:"varint,13,rep,name=colors,enum=equal.Kitchen_Color" json:"colors,omitempty"`
+	Parts    []*Kitchen_Part          `protobuf:"bytes,14,rep,name=parts" json:"parts,omitempty"`
+	Counts   map[string]int32         `protobuf:"bytes,15,rep,name=counts" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Blobs    map[int32][]byte         `protobuf:"bytes,16,rep,name=blobs" json:"blobs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PartMap  map[string]*Kitchen_Part `protobuf:"bytes,17,rep,name=part_map,json=partMap" json:"part_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Choice:
+	//	*Kitchen_Number
+	//	*Kitchen_Raw
+	//	*Kitchen_Chosen
+	Choice isKitchen_Choice `protobuf_oneof:"choice"`
+	// Declared in another file, so compared with proto.Equal.
+	Settings *Settings `protobuf:"bytes,21,opt,name=settings" json:"settings,omitempty"`
+	// Extendable, so it has no EqualMessage method.
+	Ext                  *Extendable `protobuf:"bytes,22,opt,name=ext" json:"ext,omitempty"`
+	proto.XXX_LazyFields `json:"-"`
+	XXX_unrecognized     []byte `json:"-"`
+}
+
+func (m *Kitchen) Reset()                    { *m = Kitchen{} }
+func (m *Kitchen) String() string            { return proto.CompactTextString(m) }
+func (*Kitchen) ProtoMessage()               {}
+func (*Kitchen) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type isKitchen_Choice interface{ isKitchen_Choice() }
+
+type Kitchen_Number struct {
+	Number int32 `protobuf:"varint,18,opt,name=number,oneof"`
+}
+type Kitchen_Raw struct {
+	Raw []byte `protobuf:"bytes,19,opt,name=raw,oneof"`
+}
+type Kitchen_Chosen struct {
+	Chosen *Kitchen_Part `protobuf:"bytes,20,opt,name=chosen,oneof"`
+}
+
+func (*Kitchen_Number) isKitchen_Choice() {}
+func (*Kitchen_Raw) isKitchen_Choice()    {}
+func (*Kitchen_Chosen) isKitchen_Choice() {}
+
+func (m *Kitchen) GetChoice() isKitchen_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (m *Kitchen) GetI32() int32 {
+	if m != nil && m.I32 != nil {
+		return *m.I32
+	}
+	return 0
+}
+
+func (m *Kitchen) GetF64() float64 {
+	if m != nil && m.F64 != nil {
+		return *m.F64
+	}
+	return 0
+}
+
+func (m *Kitchen) GetStr() string {
+	if m != nil && m.Str != nil {
+		return *m.Str
+	}
+	return ""
+}
+
+func (m *Kitchen) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Kitchen) GetColor() Kitchen_Color {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return Kitchen_RED
+}
+
+func (m *Kitchen) GetPart() *Kitchen_Part {
+	if m != nil {
+		return m.Part
+	}
+	return nil
+}
+
+func (m *Kitchen) GetLazyPart() *Kitchen_Part {
+	if m != nil {
+		proto.DecodeLazyField(m, 7)
+		return m.LazyPart
+	}
+	return nil
+}
+
+func (m *Kitchen) GetBag() *Kitchen_Bag {
+	if m != nil {
+		return m.Bag
+	}
+	return nil
+}
+
+func (m *Kitchen) GetInts() []int64 {
+	if m != nil {
+		return m.Ints
+	}
+	return nil
+}
+
+func (m *Kitchen) GetStrs() []string {
+	if m != nil {
+		return m.Strs
+	}
+	return nil
+}
+
+func (m *Kitchen) GetDatas() [][]byte {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+func (m *Kitchen) GetColors() []Kitchen_Color {
+	if m != nil {
+		return m.Colors
+	}
+	return nil
+}
+
+func (m *Kitchen) GetParts() []*Kitchen_Part {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+func (m *Kitchen) GetCounts() map[string]int32 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *Kitchen) GetBlobs() map[int32][]byte {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *Kitchen) GetPartMap() map[string]*Kitchen_Part {
+	if m != nil {
+		return m.PartMap
+	}
+	return nil
+}
+
+func (m *Kitchen) GetNumber() int32 {
+	if x, ok := m.GetChoice().(*Kitchen_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (m *Kitchen) GetRaw() []byte {
+	if x, ok := m.GetChoice().(*Kitchen_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+func (m *Kitchen) GetChosen() *Kitchen_Part {
+	if x, ok := m.GetChoice().(*Kitchen_Chosen); ok {
+		return x.Chosen
+	}
+	return nil
+}
+
+func (m *Kitchen) GetSettings() *Settings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *Kitchen) GetExt() *Extendable {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Kitchen) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Kitchen_OneofMarshaler, _Kitchen_OneofUnmarshaler, _Kitchen_OneofSizer, []interface{}{
+		(*Kitchen_Number)(nil),
+		(*Kitchen_Raw)(nil),
+		(*Kitchen_Chosen)(nil),
+	}
+}
+
+func _Kitchen_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Kitchen)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Kitchen_Number:
+		b.EncodeVarint(18<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Number))
+	case *Kitchen_Raw:
+		b.EncodeVarint(19<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.Raw)
+	case *Kitchen_Chosen:
+		b.EncodeVarint(20<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Chosen); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Kitchen.Choice has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Kitchen_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Kitchen)
+	switch tag {
+	case 18: // choice.number
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Choice = &Kitchen_Number{int32(x)}
+		return true, err
+	case 19: // choice.raw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Choice = &Kitchen_Raw{x}
+		return true, err
+	case 20: // choice.chosen
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Kitchen_Part)
+		err := b.DecodeMessage(msg)
+		m.Choice = &Kitchen_Chosen{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Kitchen_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Kitchen)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Kitchen_Number:
+		n += proto.SizeVarint(18<<3 | proto.WireVarint)
+		n += proto.SizeVarint(uint64(x.Number))
+	case *Kitchen_Raw:
+		n += proto.SizeVarint(19<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Raw)))
+		n += len(x.Raw)
+	case *Kitchen_Chosen:
+		s := proto.Size(x.Chosen)
+		n += proto.SizeVarint(20<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Kitchen_Part struct {
+	Name             *string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Parts            []*Kitchen_Part `protobuf:"bytes,2,rep,name=parts" json:"parts,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *Kitchen_Part) Reset()                    { *m = Kitchen_Part{} }
+func (m *Kitchen_Part) String() string            { return proto.CompactTextString(m) }
+func (*Kitchen_Part) ProtoMessage()               {}
+func (*Kitchen_Part) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+func (m *Kitchen_Part) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Kitchen_Part) GetParts() []*Kitchen_Part {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+type Kitchen_Bag struct {
+	Label            *string `protobuf:"bytes,9,opt,name=label" json:"label,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Kitchen_Bag) Reset()                    { *m = Kitchen_Bag{} }
+func (m *Kitchen_Bag) String() string            { return proto.CompactTextString(m) }
+func (*Kitchen_Bag) ProtoMessage()               {}
+func (*Kitchen_Bag) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 1} }
+
+func (m *Kitchen_Bag) GetLabel() string {
+	if m != nil && m.Label != nil {
+		return *m.Label
+	}
+	return ""
+}
+
+type Extendable struct {
+	Name                         *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+}
+
+func (m *Extendable) Reset()                    { *m = Extendable{} }
+func (m *Extendable) String() string            { return proto.CompactTextString(m) }
+func (*Extendable) ProtoMessage()               {}
+func (*Extendable) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+var extRange_Extendable = []proto.ExtensionRange{
+	{100, 200},
+}
+
+func (*Extendable) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_Extendable
+}
+
+func (m *Extendable) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+var E_Level = &proto.ExtensionDesc{
+	ExtendedType:  (*Extendable)(nil),
+	ExtensionType: (*int32)(nil),
+	Field:         100,
+	Name:          "equal.level",
+	Tag:           "varint,100,opt,name=level",
+	Filename:      "equal/equal.proto",
+}
+
+func init() {
+	proto.RegisterType((*Kitchen)(nil), "equal.Kitchen")
+	proto.RegisterType((*Kitchen_Part)(nil), "equal.Kitchen.Part")
+	proto.RegisterType((*Kitchen_Bag)(nil), "equal.Kitchen.Bag")
+	proto.RegisterType((*Extendable)(nil), "equal.Extendable")
+	proto.RegisterEnum("equal.Kitchen_Color", Kitchen_Color_name, Kitchen_Color_value)
+	proto.RegisterExtension(E_Level)
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *Kitchen) EqualMessage(o *Kitchen) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	proto.DecodeLazy(m)
+	proto.DecodeLazy(o)
+	if (m.I32 == nil) != (o.I32 == nil) || m.I32 != nil && *m.I32 != *o.I32 {
+		return false
+	}
+	if (m.F64 == nil) != (o.F64 == nil) || m.F64 != nil && *m.F64 != *o.F64 {
+		return false
+	}
+	if (m.Str == nil) != (o.Str == nil) || m.Str != nil && *m.Str != *o.Str {
+		return false
+	}
+	if (m.Data == nil) != (o.Data == nil) || string(m.Data) != string(o.Data) {
+		return false
+	}
+	if (m.Color == nil) != (o.Color == nil) || m.Color != nil && *m.Color != *o.Color {
+		return false
+	}
+	if !m.Part.EqualMessage(o.Part) {
+		return false
+	}
+	if !m.LazyPart.EqualMessage(o.LazyPart) {
+		return false
+	}
+	if !m.Bag.EqualMessage(o.Bag) {
+		return false
+	}
+	if len(m.Ints) != len(o.Ints) {
+		return false
+	}
+	for i, v := range m.Ints {
+		if v != o.Ints[i] {
+			return false
+		}
+	}
+	if len(m.Strs) != len(o.Strs) {
+		return false
+	}
+	for i, v := range m.Strs {
+		if v != o.Strs[i] {
+			return false
+		}
+	}
+	if len(m.Datas) != len(o.Datas) {
+		return false
+	}
+	for i, v := range m.Datas {
+		if (v == nil) != (o.Datas[i] == nil) || string(v) != string(o.Datas[i]) {
+			return false
+		}
+	}
+	if len(m.Colors) != len(o.Colors) {
+		return false
+	}
+	for i, v := range m.Colors {
+		if v != o.Colors[i] {
+			return false
+		}
+	}
+	if len(m.Parts) != len(o.Parts) {
+		return false
+	}
+	for i, v := range m.Parts {
+		if !v.EqualMessage(o.Parts[i]) {
+			return false
+		}
+	}
+	if len(m.Counts) != len(o.Counts) {
+		return false
+	}
+	for k, v := range m.Counts {
+		if w, ok := o.Counts[k]; !ok || v != w {
+			return false
+		}
+	}
+	if len(m.Blobs) != len(o.Blobs) {
+		return false
+	}
+	for k, v := range m.Blobs {
+		if w, ok := o.Blobs[k]; !ok || (v == nil) != (w == nil) || string(v) != string(w) {
+			return false
+		}
+	}
+	if len(m.PartMap) != len(o.PartMap) {
+		return false
+	}
+	for k, v := range m.PartMap {
+		if w, ok := o.PartMap[k]; !ok || !v.EqualMessage(w) {
+			return false
+		}
+	}
+	switch x := m.Choice.(type) {
+	case nil:
+		if o.Choice != nil {
+			return false
+		}
+	case *Kitchen_Number:
+		if y, ok := o.Choice.(*Kitchen_Number); !ok || x.Number != y.Number {
+			return false
+		}
+	case *Kitchen_Raw:
+		if y, ok := o.Choice.(*Kitchen_Raw); !ok || (x.Raw == nil) != (y.Raw == nil) || string(x.Raw) != string(y.Raw) {
+			return false
+		}
+	case *Kitchen_Chosen:
+		if y, ok := o.Choice.(*Kitchen_Chosen); !ok || !x.Chosen.EqualMessage(y.Chosen) {
+			return false
+		}
+	}
+	if !proto.Equal(m.Settings, o.Settings) {
+		return false
+	}
+	if !proto.Equal(m.Ext, o.Ext) {
+		return false
+	}
+	if string(m.XXX_unrecognized) != string(o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *Kitchen_Part) EqualMessage(o *Kitchen_Part) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if (m.Name == nil) != (o.Name == nil) || m.Name != nil && *m.Name != *o.Name {
+		return false
+	}
+	if len(m.Parts) != len(o.Parts) {
+		return false
+	}
+	for i, v := range m.Parts {
+		if !v.EqualMessage(o.Parts[i]) {
+			return false
+		}
+	}
+	if string(m.XXX_unrecognized) != string(o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *Kitchen_Bag) EqualMessage(o *Kitchen_Bag) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if (m.Label == nil) != (o.Label == nil) || m.Label != nil && *m.Label != *o.Label {
+		return false
+	}
+	if string(m.XXX_unrecognized) != string(o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func init() { proto.RegisterFile("equal/equal.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xff, 0x4e, 0x13, 0x41,
+	0x10, 0xc7, 0xd9, 0x6e, 0xb7, 0xbd, 0x0e, 0x15, 0xca, 0x80, 0x66, 0x2d, 0xff, 0x6c, 0xd0, 0x84,
+	0x03, 0x15, 0x92, 0x42, 0x08, 0xf2, 0x67, 0xb5, 0x91, 0xc4, 0xf8, 0x23, 0xeb, 0x03, 0x90, 0x6d,
+	0xbb, 0x96, 0xc6, 0xe3, 0xae, 0xde, 0x6d, 0x11, 0x7c, 0x32, 0x5f, 0xc1, 0xb7, 0x32, 0xb3, 0x7b,
+	0x81, 0x0b, 0xb4, 0xf1, 0x9f, 0xcb, 0xcc, 0xce, 0xe7, 0x3b, 0xb3, 0xb3, 0x33, 0x07, 0x1b, 0xf6,
+	0xe7, 0xdc, 0x24, 0x87, 0xfe, 0x7b, 0x30, 0xcb, 0x33, 0x97, 0xa1, 0xf0, 0x4e, 0x17, 0x2b, 0x91,
+	0xa3, 0x10, 0xda, 0xf9, 0x13, 0x41, 0xf3, 0xe3, 0xd4, 0x8d, 0x2e, 0x6d, 0x8a, 0x1d, 0xe0, 0xd3,
+	0xa3, 0x9e, 0x64, 0x8a, 0xc5, 0x42, 0x93, 0x49, 0x27, 0xdf, 0x4f, 0x8e, 0x65, 0x4d, 0xb1, 0x98,
+	0x69, 0x32, 0xe9, 0xa4, 0x70, 0xb9, 0xe4, 0x8a, 0xc5, 0x2d, 0x4d, 0x26, 0x22, 0xd4, 0xc7, 0xc6,
+	0x19, 0x59, 0x57, 0x2c, 0x6e, 0x6b, 0x6f, 0xe3, 0x3e, 0x88, 0x51, 0x96, 0x64, 0xb9, 0x14, 0x8a,
+	0xc5, 0x6b, 0xbd, 0xad, 0x83, 0x70, 0x9b, 0xb2, 0xd0, 0xc1, 0x3b, 0x8a, 0xe9, 0x80, 0xe0, 0x2e,
+	0xd4, 0x67, 0x26, 0x77, 0xb2, 0xa1, 0x58, 0xbc, 0xda, 0xdb, 0x7c, 0x80, 0x7e, 0x35, 0xb9, 0xd3,
+	0x1e, 0xc0, 0x63, 0x68, 0x25, 0xe6, 0xf7, 0xed, 0x85, 0xa7, 0x9b, 0x4b, 0xe9, 0x7e, 0x2d, 0x66,
+	0x3a, 0x22, 0x92, 0x3c, 0x7c, 0x09, 0x7c, 0x68, 0x26, 0x32, 0x52, 0x2c, 0x86, 0x1e, 0x3e, 0xe0,
+	0xfb, 0x66, 0xa2, 0x29, 0x4c, 0x4d, 0x4c, 0x53, 0x57, 0x48, 0x50, 0x3c, 0xe6, 0xda, 0xdb, 0x74,
+	0x56, 0xb8, 0xbc, 0x90, 0xab, 0x8a, 0xc7, 0x2d, 0xed, 0x6d, 0xdc, 0x02, 0x41, 0x0d, 0x16, 0xb2,
+	0xad, 0x78, 0xdc, 0xd6, 0xc1, 0xc1, 0xd7, 0xd0, 0xf0, 0xbd, 0x14, 0xf2, 0x89, 0xe2, 0x4b, 0xfb,
+	0x2d, 0x19, 0xdc, 0x03, 0x41, 0x2d, 0x14, 0x72, 0x4d, 0xf1, 0x65, 0x1d, 0x07, 0x02, 0x7b, 0x94,
+	0x78, 0x4e, 0x17, 0x5b, 0xf7, 0x6c, 0xf7, 0x51, 0x62, 0x0a, 0x0e, 0x52, 0x97, 0xdf, 0xea, 0x92,
+	0xc4, 0x43, 0x10, 0xc3, 0x24, 0x1b, 0x16, 0xb2, 0xe3, 0x25, 0xcf, 0x1f, 0xb6, 0x4c, 0xb1, 0xa0,
+	0x08, 0x1c, 0x9e, 0x40, 0x44, 0xd5, 0x2e, 0xae, 0xcc, 0x4c, 0x6e, 0x78, 0xcd, 0xf6, 0x82, 0x2b,
+	0x7d, 0x32, 0xb3, 0xa0, 0x6a, 0xce, 0x82, 0x87, 0x12, 0x1a, 0xe9, 0xfc, 0x6a, 0x68, 0x73, 0x89,
+	0xb4, 0x31, 0xe7, 0x2b, 0xba, 0xf4, 0x11, 0x81, 0xe7, 0xe6, 0x97, 0xdc, 0xa4, 0x8d, 0x38, 0x5f,
+	0xd1, 0xe4, 0xe0, 0x1b, 0x68, 0x8c, 0x2e, 0xb3, 0xc2, 0xa6, 0x72, 0x6b, 0xe9, 0xe8, 0x28, 0x45,
+	0x80, 0xf0, 0x15, 0x44, 0x85, 0x75, 0x6e, 0x9a, 0x4e, 0x0a, 0xf9, 0xd4, 0x0b, 0xd6, 0x4b, 0xc1,
+	0xb7, 0xf2, 0x58, 0xdf, 0x01, 0xf8, 0x02, 0xb8, 0xbd, 0x71, 0xf2, 0x99, 0xe7, 0x36, 0x4a, 0x6e,
+	0x70, 0xe3, 0x6c, 0x3a, 0x36, 0xc3, 0xc4, 0x6a, 0x8a, 0x76, 0x07, 0x50, 0xf7, 0x0b, 0x81, 0x50,
+	0x4f, 0xcd, 0x95, 0xf5, 0x6b, 0xde, 0xd2, 0xde, 0xbe, 0x1f, 0x49, 0xed, 0x7f, 0x23, 0xe9, 0x6e,
+	0x03, 0xef, 0x9b, 0x09, 0x2d, 0x42, 0x62, 0x86, 0x36, 0x91, 0x2d, 0x9f, 0x26, 0x38, 0xdd, 0xb7,
+	0xb0, 0x5a, 0x19, 0x09, 0xfd, 0x2c, 0x3f, 0xec, 0x6d, 0x59, 0x89, 0x4c, 0x92, 0x5d, 0x9b, 0x64,
+	0x6e, 0xfd, 0x2f, 0x25, 0x74, 0x70, 0xce, 0x6a, 0xa7, 0xac, 0x7b, 0x0a, 0x70, 0x3f, 0x9a, 0xaa,
+	0x52, 0x2c, 0x50, 0xb6, 0xab, 0xca, 0x2f, 0xd0, 0xae, 0x0e, 0x68, 0x41, 0xd5, 0xbd, 0xaa, 0x76,
+	0x59, 0x7b, 0x77, 0x09, 0x77, 0xb6, 0x41, 0xf8, 0x8d, 0xc5, 0x26, 0x70, 0x3d, 0x78, 0xdf, 0x59,
+	0xc1, 0x16, 0x88, 0x0f, 0x7a, 0x30, 0xf8, 0xdc, 0x61, 0xfd, 0xc8, 0xcf, 0x71, 0x3a, 0xb2, 0x3b,
+	0xbb, 0x00, 0xf7, 0x6f, 0xbc, 0xe8, 0x59, 0xf7, 0x45, 0x34, 0xee, 0xfc, 0x65, 0x67, 0xbb, 0x20,
+	0x12, 0x7b, 0x6d, 0x13, 0x7c, 0x3c, 0x1a, 0x39, 0x0e, 0xef, 0xe0, 0xe3, 0xff, 0x06, 0x00, 0x0f,
+	0xcf, 0x6c, 0x93, 0xbb, 0x04, 0x00, 0x00,
+}
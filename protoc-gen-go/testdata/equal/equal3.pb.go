@@ -0,0 +1,310 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: equal/equal3.proto
+
+package equal
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type Settings struct {
+	Name    string               `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Data    []byte               `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Weights []float32            `protobuf:"fixed32,3,rep,packed,name=weights" json:"weights,omitempty"`
+	Child   *Settings            `protobuf:"bytes,4,opt,name=child" json:"child,omitempty"`
+	ByName  map[string]*Settings `protobuf:"bytes,5,rep,name=by_name,json=byName" json:"by_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Blobs   map[string][]byte    `protobuf:"bytes,6,rep,name=blobs" json:"blobs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Datas   [][]byte             `protobuf:"bytes,7,rep,name=datas,proto3" json:"datas,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//	*Settings_Text
+	//	*Settings_Raw
+	//	*Settings_Nested
+	Value isSettings_Value `protobuf_oneof:"value"`
+}
+
+func (m *Settings) Reset()                    { *m = Settings{} }
+func (m *Settings) String() string            { return proto.CompactTextString(m) }
+func (*Settings) ProtoMessage()               {}
+func (*Settings) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+type isSettings_Value interface{ isSettings_Value() }
+
+type Settings_Text struct {
+	Text string `protobuf:"bytes,8,opt,name=text,oneof"`
+}
+type Settings_Raw struct {
+	Raw []byte `protobuf:"bytes,9,opt,name=raw,proto3,oneof"`
+}
+type Settings_Nested struct {
+	Nested *Settings `protobuf:"bytes,10,opt,name=nested,oneof"`
+}
+
+func (*Settings_Text) isSettings_Value()   {}
+func (*Settings_Raw) isSettings_Value()    {}
+func (*Settings_Nested) isSettings_Value() {}
+
+func (m *Settings) GetValue() isSettings_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Settings) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Settings) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Settings) GetWeights() []float32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *Settings) GetChild() *Settings {
+	if m != nil {
+		return m.Child
+	}
+	return nil
+}
+
+func (m *Settings) GetByName() map[string]*Settings {
+	if m != nil {
+		return m.ByName
+	}
+	return nil
+}
+
+func (m *Settings) GetBlobs() map[string][]byte {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *Settings) GetDatas() [][]byte {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+func (m *Settings) GetText() string {
+	if x, ok := m.GetValue().(*Settings_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (m *Settings) GetRaw() []byte {
+	if x, ok := m.GetValue().(*Settings_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+func (m *Settings) GetNested() *Settings {
+	if x, ok := m.GetValue().(*Settings_Nested); ok {
+		return x.Nested
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Settings) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Settings_OneofMarshaler, _Settings_OneofUnmarshaler, _Settings_OneofSizer, []interface{}{
+		(*Settings_Text)(nil),
+		(*Settings_Raw)(nil),
+		(*Settings_Nested)(nil),
+	}
+}
+
+func _Settings_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Settings)
+	// value
+	switch x := m.Value.(type) {
+	case *Settings_Text:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Text)
+	case *Settings_Raw:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.Raw)
+	case *Settings_Nested:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Nested); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Settings.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Settings_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Settings)
+	switch tag {
+	case 8: // value.text
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &Settings_Text{x}
+		return true, err
+	case 9: // value.raw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Value = &Settings_Raw{x}
+		return true, err
+	case 10: // value.nested
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Settings)
+		err := b.DecodeMessage(msg)
+		m.Value = &Settings_Nested{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Settings_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Settings)
+	// value
+	switch x := m.Value.(type) {
+	case *Settings_Text:
+		n += proto.SizeVarint(8<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Text)))
+		n += len(x.Text)
+	case *Settings_Raw:
+		n += proto.SizeVarint(9<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Raw)))
+		n += len(x.Raw)
+	case *Settings_Nested:
+		s := proto.Size(x.Nested)
+		n += proto.SizeVarint(10<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*Settings)(nil), "equal.Settings")
+}
+
+// EqualMessage reports whether m and o are equal, as proto.Equal defines it.
+func (m *Settings) EqualMessage(o *Settings) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.Name != o.Name {
+		return false
+	}
+	if string(m.Data) != string(o.Data) {
+		return false
+	}
+	if len(m.Weights) != len(o.Weights) {
+		return false
+	}
+	for i, v := range m.Weights {
+		if v != o.Weights[i] {
+			return false
+		}
+	}
+	if !m.Child.EqualMessage(o.Child) {
+		return false
+	}
+	if len(m.ByName) != len(o.ByName) {
+		return false
+	}
+	for k, v := range m.ByName {
+		if w, ok := o.ByName[k]; !ok || !v.EqualMessage(w) {
+			return false
+		}
+	}
+	if len(m.Blobs) != len(o.Blobs) {
+		return false
+	}
+	for k, v := range m.Blobs {
+		if w, ok := o.Blobs[k]; !ok || (v == nil) != (w == nil) || string(v) != string(w) {
+			return false
+		}
+	}
+	if len(m.Datas) != len(o.Datas) {
+		return false
+	}
+	for i, v := range m.Datas {
+		if string(v) != string(o.Datas[i]) {
+			return false
+		}
+	}
+	switch x := m.Value.(type) {
+	case nil:
+		if o.Value != nil {
+			return false
+		}
+	case *Settings_Text:
+		if y, ok := o.Value.(*Settings_Text); !ok || x.Text != y.Text {
+			return false
+		}
+	case *Settings_Raw:
+		if y, ok := o.Value.(*Settings_Raw); !ok || string(x.Raw) != string(y.Raw) {
+			return false
+		}
+	case *Settings_Nested:
+		if y, ok := o.Value.(*Settings_Nested); !ok || !x.Nested.EqualMessage(y.Nested) {
+			return false
+		}
+	}
+	return true
+}
+
+func init() { proto.RegisterFile("equal/equal3.proto", fileDescriptor1) }
+
+var fileDescriptor1 = []byte{
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x6d, 0xb2, 0x4d, 0xd2, 0x4e, 0x0b, 0xca, 0xd0, 0xc3, 0x12, 0x2f, 0x8b, 0x20, 0xac, 0x97,
+	0x28, 0xad, 0x87, 0xe2, 0xb1, 0x20, 0x04, 0x0f, 0x1e, 0xd6, 0x0f, 0x90, 0x8d, 0x59, 0xda, 0x60,
+	0x9a, 0x68, 0xb2, 0xb5, 0xe6, 0x43, 0xfd, 0x1f, 0xd9, 0x4d, 0x8a, 0x41, 0x73, 0x09, 0xf3, 0xf2,
+	0xe6, 0xcd, 0x9b, 0x37, 0x0b, 0xa8, 0x3e, 0x0e, 0x32, 0xbf, 0xb1, 0xdf, 0x55, 0xf4, 0x5e, 0x95,
+	0xba, 0x44, 0xcf, 0xa2, 0xcb, 0x6f, 0x02, 0x93, 0x67, 0xa5, 0x75, 0x56, 0x6c, 0x6b, 0x44, 0x18,
+	0x17, 0x72, 0xaf, 0xa8, 0xc3, 0x1c, 0x3e, 0x15, 0xb6, 0x36, 0xff, 0x52, 0xa9, 0x25, 0x75, 0x99,
+	0xc3, 0xe7, 0xc2, 0xd6, 0x48, 0x21, 0x38, 0xaa, 0x6c, 0xbb, 0xd3, 0x35, 0x25, 0x8c, 0x70, 0x57,
+	0x9c, 0x20, 0x5e, 0x81, 0xf7, 0xba, 0xcb, 0xf2, 0x94, 0x8e, 0x99, 0xc3, 0x67, 0xcb, 0xb3, 0xc8,
+	0xba, 0x44, 0x27, 0x07, 0xd1, 0xb2, 0x78, 0x07, 0x41, 0xd2, 0xbc, 0x58, 0x2f, 0x8f, 0x11, 0x3e,
+	0x5b, 0x5e, 0xfc, 0x69, 0x8c, 0x36, 0xcd, 0x93, 0xdc, 0xab, 0x87, 0x42, 0x57, 0x8d, 0xf0, 0x13,
+	0x0b, 0xf0, 0x16, 0xbc, 0x24, 0x2f, 0x93, 0x9a, 0xfa, 0x56, 0x13, 0xfe, 0xd3, 0x18, 0xb2, 0x95,
+	0xb4, 0x8d, 0xb8, 0x00, 0xcf, 0x2c, 0x5c, 0xd3, 0x80, 0x11, 0x3e, 0x17, 0x2d, 0xc0, 0x05, 0x8c,
+	0xb5, 0xfa, 0xd2, 0x74, 0x62, 0x62, 0xc6, 0x23, 0x61, 0x11, 0x22, 0x90, 0x4a, 0x1e, 0xe9, 0xd4,
+	0xe4, 0x8c, 0x47, 0xc2, 0x00, 0xbc, 0x06, 0xbf, 0x50, 0xb5, 0x56, 0x29, 0x85, 0xc1, 0x3c, 0xf1,
+	0x48, 0x74, 0x0d, 0xe1, 0x23, 0xcc, 0x7a, 0x3b, 0xe3, 0x39, 0x90, 0x37, 0xd5, 0x74, 0x97, 0x34,
+	0xa5, 0x39, 0xcd, 0xa7, 0xcc, 0x0f, 0x8a, 0xba, 0x83, 0xa3, 0x44, 0xcb, 0xde, 0xbb, 0x6b, 0x27,
+	0x5c, 0x03, 0xfc, 0x66, 0x19, 0x18, 0xb5, 0xe8, 0x8f, 0x9a, 0xf7, 0x94, 0x9b, 0xa0, 0x63, 0x12,
+	0xdf, 0xbe, 0xf2, 0xea, 0x67, 0x00, 0xcd, 0xa3, 0x2d, 0x4f, 0xfb, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,202 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package equal
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func kitchen() *Kitchen {
+	return &Kitchen{
+		I32:      proto.Int32(7),
+		F64:      proto.Float64(1.5),
+		Str:      proto.String("str"),
+		Data:     []byte("data"),
+		Color:    Kitchen_GREEN.Enum(),
+		Part:     &Kitchen_Part{Name: proto.String("part"), Parts: []*Kitchen_Part{{Name: proto.String("sub")}}},
+		LazyPart: &Kitchen_Part{Name: proto.String("lazy")},
+		Bag:      &Kitchen_Bag{Label: proto.String("bag")},
+		Ints:     []int64{1, 2, 3},
+		Strs:     []string{"a", "b"},
+		Datas:    [][]byte{[]byte("x"), {}, []byte("y")},
+		Colors:   []Kitchen_Color{Kitchen_RED, Kitchen_GREEN},
+		Parts:    []*Kitchen_Part{{Name: proto.String("p1")}, {Name: proto.String("p2")}},
+		Counts:   map[string]int32{"one": 1, "two": 2},
+		Blobs:    map[int32][]byte{1: []byte("blob"), 2: {}},
+		PartMap:  map[string]*Kitchen_Part{"k": {Name: proto.String("v")}},
+		Choice:   &Kitchen_Chosen{&Kitchen_Part{Name: proto.String("chosen")}},
+		Settings: &Settings{
+			Name:    "settings",
+			Data:    []byte("sdata"),
+			Weights: []float32{0.5},
+			Child:   &Settings{Name: "child"},
+			ByName:  map[string]*Settings{"n": {Value: &Settings_Text{"text"}}},
+			Blobs:   map[string][]byte{"b": []byte("blob")},
+			Datas:   [][]byte{[]byte("d")},
+			Value:   &Settings_Nested{&Settings{Name: "nested"}},
+		},
+		Ext: &Extendable{Name: proto.String("ext")},
+	}
+}
+
+// variants returns kitchens that each differ from kitchen() in one way,
+// some of them only in ways proto.Equal ignores.
+func variants(t *testing.T) []*Kitchen {
+	changes := []func(m *Kitchen){
+		func(m *Kitchen) {},
+		func(m *Kitchen) { m.I32 = nil },
+		func(m *Kitchen) { *m.I32 = 0 },
+		func(m *Kitchen) { *m.F64 = math.NaN() },
+		func(m *Kitchen) { m.Str = proto.String("") },
+		func(m *Kitchen) { m.Data = nil },
+		func(m *Kitchen) { m.Data = []byte{} },
+		func(m *Kitchen) { m.Color = nil },
+		func(m *Kitchen) { m.Part = nil },
+		func(m *Kitchen) { m.Part.Parts[0].Name = proto.String("changed") },
+		func(m *Kitchen) { m.LazyPart = &Kitchen_Part{} },
+		func(m *Kitchen) { m.Bag.Label = nil },
+		func(m *Kitchen) { m.Ints = m.Ints[:2] },
+		func(m *Kitchen) { m.Ints[2] = 4 },
+		func(m *Kitchen) { m.Strs = nil },
+		func(m *Kitchen) { m.Datas[1] = nil },
+		func(m *Kitchen) { m.Colors[0] = Kitchen_GREEN },
+		func(m *Kitchen) { m.Parts[1] = nil },
+		func(m *Kitchen) { m.Counts["two"] = 3 },
+		func(m *Kitchen) { delete(m.Counts, "two"); m.Counts["three"] = 2 },
+		func(m *Kitchen) { m.Counts = map[string]int32{} },
+		func(m *Kitchen) { m.Blobs[2] = nil },
+		func(m *Kitchen) { m.PartMap["k"] = nil },
+		func(m *Kitchen) { m.PartMap["k"].Name = nil },
+		func(m *Kitchen) { m.Choice = nil },
+		func(m *Kitchen) { m.Choice = &Kitchen_Number{0} },
+		func(m *Kitchen) { m.Choice = &Kitchen_Number{1} },
+		func(m *Kitchen) { m.Choice = &Kitchen_Raw{nil} },
+		func(m *Kitchen) { m.Choice = &Kitchen_Raw{[]byte{}} },
+		func(m *Kitchen) { m.Choice = &Kitchen_Chosen{nil} },
+		func(m *Kitchen) { m.Settings.Data = []byte{} },
+		func(m *Kitchen) { m.Settings.Data = nil },
+		func(m *Kitchen) { m.Settings.Weights[0] = float32(math.NaN()) },
+		func(m *Kitchen) { m.Settings.Blobs["b"] = nil },
+		func(m *Kitchen) { m.Settings.Datas[0] = nil },
+		func(m *Kitchen) { m.Settings.Value = &Settings_Raw{nil} },
+		func(m *Kitchen) { m.Settings.Value = &Settings_Raw{[]byte{}} },
+		func(m *Kitchen) { m.Settings.Child = nil },
+		func(m *Kitchen) { m.Ext.Name = proto.String("other") },
+		func(m *Kitchen) {
+			if err := proto.SetExtension(m.Ext, E_Level, proto.Int32(1)); err != nil {
+				t.Fatalf("SetExtension: %v", err)
+			}
+		},
+		func(m *Kitchen) { m.XXX_unrecognized = []byte{0xf8, 0x3e, 0x01} }, // field 1000, varint 1
+		func(m *Kitchen) { m.Bag.XXX_unrecognized = []byte{0xf8, 0x3e, 0x01} },
+	}
+	var ms []*Kitchen
+	for _, change := range changes {
+		m := kitchen()
+		change(m)
+		ms = append(ms, m)
+	}
+	return append(ms, nil, new(Kitchen))
+}
+
+func TestEqualMessage(t *testing.T) {
+	ms := variants(t)
+	// The second set is distinct from the first, so that nothing is
+	// compared only with itself.
+	for i, a := range ms {
+		for j, b := range variants(t) {
+			got, want := a.EqualMessage(b), proto.Equal(a, b)
+			if got != want {
+				t.Errorf("variant %d.EqualMessage(variant %d) = %v, proto.Equal says %v\n%v\n%v", i, j, got, want, a, b)
+			}
+		}
+	}
+}
+
+func TestEqualMessageUnmarshaled(t *testing.T) {
+	m := kitchen()
+	m.XXX_unrecognized = []byte{0xf8, 0x3e, 0x01} // field 1000, varint 1
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	u, v := new(Kitchen), new(Kitchen)
+	if err := proto.Unmarshal(b, u); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if err := proto.Unmarshal(b, v); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	// The lazy fields have not been decoded yet.
+	if !u.EqualMessage(v) {
+		t.Errorf("EqualMessage of two decodings of the same bytes = false")
+	}
+	if !u.EqualMessage(m) || !m.EqualMessage(u) {
+		t.Errorf("EqualMessage of a message and its decoding = false")
+	}
+	m.LazyPart.Name = proto.String("changed")
+	if w := new(Kitchen); proto.Unmarshal(b, w) != nil || w.EqualMessage(m) {
+		t.Errorf("EqualMessage with a different lazy field = true")
+	}
+}
+
+func TestEqualMessageNil(t *testing.T) {
+	var m *Kitchen
+	if !m.EqualMessage(nil) {
+		t.Error("(*Kitchen)(nil).EqualMessage(nil) = false")
+	}
+	if m.EqualMessage(new(Kitchen)) || new(Kitchen).EqualMessage(m) {
+		t.Error("EqualMessage of nil and an empty Kitchen = true")
+	}
+	if !new(Settings).EqualMessage(&Settings{Data: []byte{}, Datas: [][]byte{}}) {
+		t.Error("EqualMessage of Settings with nil and empty bytes = false")
+	}
+}
+
+func BenchmarkEqualMessage(b *testing.B) {
+	m, o := kitchen(), kitchen()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		m.EqualMessage(o)
+	}
+}
+
+func BenchmarkProtoEqual(b *testing.B) {
+	m, o := kitchen(), kitchen()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		proto.Equal(m, o)
+	}
+}
@@ -0,0 +1,90 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ccsnake/protobuf/eventpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestEnvelope(t *testing.T) {
+	if UserCreatedTopic != "users" || UserCreatedEventName != "event.UserCreated" || UserCreatedEventVersion != 2 {
+		t.Errorf("UserCreated: topic %q, name %q, version %d", UserCreatedTopic, UserCreatedEventName, UserCreatedEventVersion)
+	}
+	occurred := time.Date(2017, 7, 14, 2, 40, 0, 0, time.UTC)
+	e := &UserCreated{UserId: "u1", Email: "ann@example.com"}
+	env, err := NewUserCreatedEnvelope(e, occurred)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// As a consumer reads it off the topic.
+	b, err := proto.Marshal(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := new(eventpb.Envelope)
+	if err := proto.Unmarshal(b, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.GetName() != UserCreatedEventName || got.GetVersion() != UserCreatedEventVersion {
+		t.Errorf("envelope of %s version %d, want %s version %d", got.GetName(), got.GetVersion(), UserCreatedEventName, UserCreatedEventVersion)
+	}
+	if ts, err := ptypes.Timestamp(got.GetTime()); err != nil || !ts.Equal(occurred) {
+		t.Errorf("occurred at %v, %v, want %v", ts, err, occurred)
+	}
+	event, err := got.Unwrap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(event, e) {
+		t.Errorf("Unwrap() = %v, want %v", event, e)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	// The name is given and the version is left at 1.
+	if Account_ClosedTopic != "accounts" || Account_ClosedEventName != "accounts.closed" || Account_ClosedEventVersion != 1 {
+		t.Errorf("Account_Closed: topic %q, name %q, version %d", Account_ClosedTopic, Account_ClosedEventName, Account_ClosedEventVersion)
+	}
+	env, err := NewAccount_ClosedEnvelope(&Account_Closed{AccountId: "a1"}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.GetName() != "accounts.closed" || env.GetVersion() != 1 {
+		t.Errorf("envelope of %s version %d", env.GetName(), env.GetVersion())
+	}
+}
@@ -0,0 +1,342 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: fanout/fanout.proto
+
+/*
+Package fanout is a generated protocol buffer package.
+
+Package fanout tests the <Service>FanOut types the carno plugin
+generates for services with the (carno.shardable) option.
+
+It is generated from these files:
+	fanout/fanout.proto
+
+It has these top-level messages:
+	Query
+	Hit
+	Results
+*/
+package fanout
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	fanout1 "github.com/ccsnake/protobuf/fanout"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Query struct {
+	Text string `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
+}
+
+func (m *Query) Reset()                    { *m = Query{} }
+func (m *Query) String() string            { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()               {}
+func (*Query) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Query) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type Hit struct {
+	Doc   string  `protobuf:"bytes,1,opt,name=doc" json:"doc,omitempty"`
+	Score float64 `protobuf:"fixed64,2,opt,name=score" json:"score,omitempty"`
+}
+
+func (m *Hit) Reset()                    { *m = Hit{} }
+func (m *Hit) String() string            { return proto.CompactTextString(m) }
+func (*Hit) ProtoMessage()               {}
+func (*Hit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Hit) GetDoc() string {
+	if m != nil {
+		return m.Doc
+	}
+	return ""
+}
+
+func (m *Hit) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type Results struct {
+	Hits  []*Hit `protobuf:"bytes,1,rep,name=hits" json:"hits,omitempty"`
+	Total int64  `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (m *Results) Reset()                    { *m = Results{} }
+func (m *Results) String() string            { return proto.CompactTextString(m) }
+func (*Results) ProtoMessage()               {}
+func (*Results) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Results) GetHits() []*Hit {
+	if m != nil {
+		return m.Hits
+	}
+	return nil
+}
+
+func (m *Results) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Query)(nil), "fanout.Query")
+	proto.RegisterType((*Hit)(nil), "fanout.Hit")
+	proto.RegisterType((*Results)(nil), "fanout.Results")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Index service
+type IndexClient interface {
+	Search(ctx context.Context, in *Query, opts ...client.CallOption) (*Results, error)
+	Count(ctx context.Context, in *Query, opts ...client.CallOption) (*Results, error)
+}
+
+type indexClient struct {
+	client.Client
+}
+
+// NewIndexClient creates and starts a client for the Index service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewIndexClient(opts ...client.Option) (IndexClient, error) {
+	c, err := carno1.NewClient("fanout", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &indexClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Index_callInfo = []*callinfo.CallInfo{
+	{
+		Service:      "fanout@Index",
+		Method:       "Search",
+		RequestType:  "fanout.Query",
+		ResponseType: "fanout.Results",
+		File:         "fanout/fanout.proto",
+	},
+	{
+		Service:      "fanout@Index",
+		Method:       "Count",
+		RequestType:  "fanout.Query",
+		ResponseType: "fanout.Results",
+		File:         "fanout/fanout.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Index_callInfo...)
+}
+
+func (c *indexClient) Search(ctx context.Context, in *Query, opts ...client.CallOption) (*Results, error) {
+	out := new(Results)
+	ctx = callinfo.NewContext(ctx, _Index_callInfo[0])
+	err := c.Client.Call(ctx, "Index", "Search", in, out, opts...)
+	return out, err
+}
+
+func (c *indexClient) Count(ctx context.Context, in *Query, opts ...client.CallOption) (*Results, error) {
+	out := new(Results)
+	ctx = callinfo.NewContext(ctx, _Index_callInfo[1])
+	err := c.Client.Call(ctx, "Index", "Count", in, out, opts...)
+	return out, err
+}
+
+// IndexClient returns a IndexClient using the client shared by the services
+// of package fanout.
+func (a *Fanout) IndexClient() IndexClient {
+	return &indexClient{Client: a.c}
+}
+
+// IndexFanOut calls the methods of Index on many servers at once,
+// each holding a shard of its data, and merges their responses.
+// Client and Target must be set; a nil reducer merges the responses
+// of its method with proto.Merge.
+type IndexFanOut struct {
+	// Client makes the calls.
+	Client IndexClient
+
+	// Target returns the call option that sends a call to target.
+	Target func(target string) client.CallOption
+
+	// SearchReduce merges a response of Search into acc.
+	SearchReduce func(acc, resp *Results)
+
+	// CountReduce merges a response of Count into acc.
+	CountReduce func(acc, resp *Results)
+}
+
+// SearchFanOut calls Search with in on each of targets concurrently and
+// merges the responses, in the order of targets, into a new response.
+// If any calls fail, it returns the merged responses of the others and
+// a *fanout.Error.
+func (f *IndexFanOut) SearchFanOut(ctx context.Context, in *Query, targets []string) (*Results, error) {
+	out := new(Results)
+	err := fanout1.Call(ctx, targets, func(ctx context.Context, target string) (proto.Message, error) {
+		return f.Client.Search(ctx, in, f.Target(target))
+	}, func(resp proto.Message) {
+		if f.SearchReduce != nil {
+			f.SearchReduce(out, resp.(*Results))
+		} else {
+			proto.Merge(out, resp)
+		}
+	})
+	return out, err
+}
+
+// CountFanOut calls Count with in on each of targets concurrently and
+// merges the responses, in the order of targets, into a new response.
+// If any calls fail, it returns the merged responses of the others and
+// a *fanout.Error.
+func (f *IndexFanOut) CountFanOut(ctx context.Context, in *Query, targets []string) (*Results, error) {
+	out := new(Results)
+	err := fanout1.Call(ctx, targets, func(ctx context.Context, target string) (proto.Message, error) {
+		return f.Client.Count(ctx, in, f.Target(target))
+	}, func(resp proto.Message) {
+		if f.CountReduce != nil {
+			f.CountReduce(out, resp.(*Results))
+		} else {
+			proto.Merge(out, resp)
+		}
+	})
+	return out, err
+}
+
+// Server API for Index service
+type IndexServer interface {
+	Search(context.Context, *Query) (*Results, error)
+	Count(context.Context, *Query) (*Results, error)
+}
+
+func RegisterIndexServer(srv IndexServer) {
+	callinfo.RegisterServer("fanout@Index")
+	carno1.HandleService(&_Index_serviceDesc, srv)
+}
+
+var _Index_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Index",
+	Methods: []string{
+		"Search",
+		"Count",
+	},
+}
+
+// Client API for Catalog service
+//
+// Catalog is not shardable, so it gets no CatalogFanOut.
+type CatalogClient interface {
+	Search(ctx context.Context, in *Query, opts ...client.CallOption) (*Results, error)
+}
+
+type catalogClient struct {
+	client.Client
+}
+
+// NewCatalogClient creates and starts a client for the Catalog service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewCatalogClient(opts ...client.Option) (CatalogClient, error) {
+	c, err := carno1.NewClient("fanout", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &catalogClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Catalog_callInfo = []*callinfo.CallInfo{
+	{
+		Service:      "fanout@Catalog",
+		Method:       "Search",
+		RequestType:  "fanout.Query",
+		ResponseType: "fanout.Results",
+		File:         "fanout/fanout.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Catalog_callInfo...)
+}
+
+func (c *catalogClient) Search(ctx context.Context, in *Query, opts ...client.CallOption) (*Results, error) {
+	out := new(Results)
+	ctx = callinfo.NewContext(ctx, _Catalog_callInfo[0])
+	err := c.Client.Call(ctx, "Catalog", "Search", in, out, opts...)
+	return out, err
+}
+
+// CatalogClient returns a CatalogClient using the client shared by the services
+// of package fanout.
+func (a *Fanout) CatalogClient() CatalogClient {
+	return &catalogClient{Client: a.c}
+}
+
+// Server API for Catalog service
+//
+// Catalog is not shardable, so it gets no CatalogFanOut.
+type CatalogServer interface {
+	Search(context.Context, *Query) (*Results, error)
+}
+
+func RegisterCatalogServer(srv CatalogServer) {
+	callinfo.RegisterServer("fanout@Catalog")
+	carno1.HandleService(&_Catalog_serviceDesc, srv)
+}
+
+var _Catalog_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Catalog",
+	Methods: []string{
+		"Search",
+	},
+}
+
+func init() { proto.RegisterFile("fanout/fanout.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 232 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0x65, 0xf2, 0x53, 0x71, 0x2b, 0x04, 0xba, 0x65, 0x08, 0x61, 0x20, 0xca, 0x42, 0x16,
+	0x5a, 0x29, 0x7d, 0x01, 0xa4, 0x2e, 0x65, 0xc4, 0xbc, 0x00, 0x26, 0x35, 0x34, 0x52, 0xe4, 0x5b,
+	0xd9, 0xd7, 0x52, 0xd9, 0x78, 0x26, 0x9e, 0x10, 0xd5, 0x4e, 0x66, 0x3a, 0xf9, 0x7c, 0x3a, 0xf6,
+	0x39, 0x47, 0x86, 0xc5, 0xa7, 0x32, 0xe4, 0x79, 0x15, 0x8f, 0xe5, 0xc1, 0x12, 0x13, 0xe6, 0x91,
+	0xca, 0x45, 0xa7, 0xac, 0xa1, 0x15, 0x1d, 0xb8, 0x27, 0xe3, 0xa2, 0x59, 0xdf, 0x43, 0xf6, 0xea,
+	0xb5, 0xfd, 0x46, 0x84, 0x94, 0xf5, 0x91, 0x0b, 0x51, 0x89, 0xe6, 0x52, 0x06, 0x5d, 0x3f, 0x41,
+	0xb2, 0xed, 0x19, 0x6f, 0x20, 0xd9, 0x51, 0x37, 0x3a, 0x27, 0x89, 0xb7, 0x90, 0xb9, 0x8e, 0xac,
+	0x2e, 0x2e, 0x2a, 0xd1, 0x08, 0x19, 0xa1, 0x7e, 0x86, 0x99, 0xd4, 0xce, 0x0f, 0xec, 0xf0, 0x01,
+	0xd2, 0x7d, 0xcf, 0xae, 0x10, 0x55, 0xd2, 0xcc, 0xdb, 0xf9, 0x72, 0x1c, 0xb4, 0xed, 0x59, 0x06,
+	0xe3, 0x94, 0xc0, 0xc4, 0x6a, 0x08, 0x09, 0x89, 0x8c, 0xd0, 0xbe, 0x43, 0xf6, 0x62, 0x76, 0xfa,
+	0x88, 0x0d, 0xe4, 0x6f, 0x5a, 0xd9, 0x6e, 0x8f, 0x57, 0xd3, 0xdb, 0x30, 0xb3, 0xbc, 0x9e, 0x70,
+	0x6a, 0x7a, 0x84, 0x6c, 0x43, 0xde, 0xf0, 0x7f, 0x17, 0xcb, 0xf4, 0xe7, 0xf7, 0x4e, 0xb4, 0x6b,
+	0x98, 0x6d, 0x14, 0xab, 0x81, 0xbe, 0xce, 0xef, 0xf8, 0xc8, 0xc3, 0x5f, 0xad, 0xff, 0x06, 0x00,
+	0xd4, 0x3d, 0xf8, 0x3b, 0x5f, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,101 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package fanout
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/fanout"
+)
+
+// shards is an IndexClient whose Count counts one result per shard and
+// whose Search always fails. It ignores its call options; its target
+// method, the IndexFanOut's Target, records the targets called.
+type shards struct {
+	mu      sync.Mutex
+	targets []string
+}
+
+func (s *shards) target(target string) client.CallOption {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.targets = append(s.targets, target)
+	return nil
+}
+
+func (s *shards) Search(ctx context.Context, in *Query, opts ...client.CallOption) (*Results, error) {
+	return nil, errors.New("Search is not used")
+}
+
+func (s *shards) Count(ctx context.Context, in *Query, opts ...client.CallOption) (*Results, error) {
+	return &Results{Total: 1}, nil
+}
+
+func TestFanOut(t *testing.T) {
+	s := new(shards)
+	f := &IndexFanOut{Client: s, Target: s.target}
+	out, err := f.CountFanOut(context.Background(), &Query{Text: "x"}, []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Without CountReduce, the totals are merged as proto.Merge does,
+	// keeping the last.
+	if out.Total != 1 {
+		t.Errorf("Total = %d, want 1", out.Total)
+	}
+	sort.Strings(s.targets)
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(s.targets, want) {
+		t.Errorf("targets called = %v, want %v", s.targets, want)
+	}
+
+	f.CountReduce = func(acc, resp *Results) { acc.Total += resp.Total }
+	out, err = f.CountFanOut(context.Background(), &Query{Text: "x"}, []string{"a", "b", "c"})
+	if err != nil || out.Total != 3 {
+		t.Errorf("CountFanOut with CountReduce = %v, %v; want a total of 3", out, err)
+	}
+}
+
+func TestFanOutErrors(t *testing.T) {
+	s := new(shards)
+	f := &IndexFanOut{Client: s, Target: s.target}
+	_, err := f.SearchFanOut(context.Background(), &Query{}, []string{"a", "b"})
+	e, ok := err.(*fanout.Error)
+	if !ok || len(e.Targets) != 2 {
+		t.Errorf("err = %v, want a *fanout.Error for both targets", err)
+	}
+}
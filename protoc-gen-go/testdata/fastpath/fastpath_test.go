@@ -0,0 +1,456 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package fastpath
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// The plain types have the layout of the generated ones but none of their
+// methods, so the proto package encodes them by reflection.
+type (
+	plainScalars  Scalars
+	plainRepeated Repeated
+	plainHolder   Holder
+	plainOptional Optional
+	plainEmpty    Empty
+	plainUnpacked Unpacked
+)
+
+func (m *plainScalars) Reset()         { *m = plainScalars{} }
+func (m *plainScalars) String() string { return proto.CompactTextString(m) }
+func (*plainScalars) ProtoMessage()    {}
+
+func (m *plainRepeated) Reset()         { *m = plainRepeated{} }
+func (m *plainRepeated) String() string { return proto.CompactTextString(m) }
+func (*plainRepeated) ProtoMessage()    {}
+
+func (m *plainHolder) Reset()         { *m = plainHolder{} }
+func (m *plainHolder) String() string { return proto.CompactTextString(m) }
+func (*plainHolder) ProtoMessage()    {}
+
+func (m *plainOptional) Reset()         { *m = plainOptional{} }
+func (m *plainOptional) String() string { return proto.CompactTextString(m) }
+func (*plainOptional) ProtoMessage()    {}
+
+func (m *plainEmpty) Reset()         { *m = plainEmpty{} }
+func (m *plainEmpty) String() string { return proto.CompactTextString(m) }
+func (*plainEmpty) ProtoMessage()    {}
+
+func (m *plainUnpacked) Reset()         { *m = plainUnpacked{} }
+func (m *plainUnpacked) String() string { return proto.CompactTextString(m) }
+func (*plainUnpacked) ProtoMessage()    {}
+
+func fullScalars() *Scalars {
+	return &Scalars{
+		FDouble:   math.Inf(-1),
+		FFloat:    float32(math.Copysign(0, -1)),
+		FInt64:    math.MinInt64,
+		FUint64:   math.MaxUint64,
+		FInt32:    -1,
+		FFixed64:  math.MaxUint64,
+		FFixed32:  7,
+		FBool:     true,
+		FString:   "héllo",
+		FBytes:    []byte{0, 1, 2},
+		FUint32:   math.MaxUint32,
+		FColor:    Color_BLUE,
+		FSfixed32: math.MinInt32,
+		FSfixed64: -2,
+		FSint32:   math.MinInt32,
+		FSint64:   math.MaxInt64,
+		FMsg:      &Scalars{FString: "inner", FSint32: -1},
+		FBig:      300,
+	}
+}
+
+func fullOptional() *Optional {
+	return &Optional{
+		ODouble:   proto.Float64(1.5),
+		OFloat:    proto.Float32(0),
+		OInt64:    proto.Int64(-3),
+		OUint64:   proto.Uint64(0),
+		OInt32:    proto.Int32(math.MinInt32),
+		OFixed64:  proto.Uint64(9),
+		OFixed32:  proto.Uint32(0),
+		OBool:     proto.Bool(false),
+		OString:   proto.String(""),
+		OBytes:    []byte{},
+		OUint32:   proto.Uint32(1 << 31),
+		OShape:    Optional_SQUARE.Enum(),
+		OSfixed32: proto.Int32(-5),
+		OSfixed64: proto.Int64(5),
+		OSint32:   proto.Int32(-7),
+		OSint64:   proto.Int64(math.MinInt64),
+		OMsg:      &Optional{OString: proto.String("inner")},
+		OScalars:  fullScalars(),
+		RInt32:    []int32{0, -1, 1 << 20},
+		RPacked:   []int64{-1, 0, 1},
+		RMsg:      []*Optional{{}, {OInt32: proto.Int32(1)}},
+		RBytes:    [][]byte{{}, {1}},
+	}
+}
+
+// tests pairs each test message with its plain version.
+var tests = []struct {
+	desc       string
+	msg, plain proto.Message
+}{
+	{"empty proto3", &Scalars{}, &plainScalars{}},
+	{"full proto3", fullScalars(), (*plainScalars)(fullScalars())},
+	{"empty repeated", &Repeated{}, &plainRepeated{}},
+	{"full repeated", &Repeated{
+		RDouble:   []float64{0, -1.5, math.Inf(1)},
+		RFloat:    []float32{1, 0},
+		RInt64:    []int64{math.MinInt64, 0},
+		RUint64:   []uint64{math.MaxUint64},
+		RInt32:    []int32{-1, 0, 1},
+		RFixed64:  []uint64{0, 1},
+		RFixed32:  []uint32{2},
+		RBool:     []bool{true, false, true},
+		RString:   []string{"", "a"},
+		RBytes:    [][]byte{{}, {1, 2}},
+		RUint32:   []uint32{math.MaxUint32},
+		RColor:    []Color{Color_BLUE, Color_RED, Color_GREEN},
+		RSfixed32: []int32{-1},
+		RSfixed64: []int64{-1},
+		RSint32:   []int32{math.MinInt32, math.MaxInt32},
+		RSint64:   []int64{math.MinInt64, math.MaxInt64},
+		RMsg:      []*Scalars{{}, fullScalars()},
+		RUnpacked: []int32{0, -1},
+	}, nil},
+	{"holder", &Holder{
+		Choice:  &Holder_Choice{Choice: &Holder_Choice_Number{Number: 3}},
+		Counts:  &Holder_Counts{Counts: map[string]int32{"a": 1}},
+		Sized:   &Sized{Size: 4},
+		Choices: []*Holder_Choice{{}, {Choice: &Holder_Choice_Name{Name: "b"}}},
+	}, nil},
+	{"empty proto2", &Optional{}, &plainOptional{}},
+	{"full proto2", fullOptional(), (*plainOptional)(fullOptional())},
+	{"unknown fields", &Optional{
+		OInt32:           proto.Int32(1),
+		XXX_unrecognized: []byte{0xa0, 0x06, 0x01},
+	}, nil},
+	{"nested unknown fields", nestedUnknown(), nil},
+	{"unpacked", &Unpacked{RInt32: []int32{1, -1}, RSint64: []int64{-2}}, nil},
+}
+
+// nestedUnknown returns a message with unknown fields in it and in the
+// message nested in it.
+func nestedUnknown() *Optional {
+	return &Optional{
+		OInt32:           proto.Int32(1),
+		OMsg:             &Optional{OString: proto.String("s"), XXX_unrecognized: []byte{0xa0, 0x06, 0x01}},
+		XXX_unrecognized: []byte{0xa0, 0x06, 0x02},
+	}
+}
+
+func init() {
+	// Fill in the missing plain messages as the generated ones converted.
+	for i := range tests {
+		if tests[i].plain == nil {
+			v := reflect.ValueOf(tests[i].msg)
+			tests[i].plain = v.Convert(plainType(v.Type())).Interface().(proto.Message)
+		}
+	}
+}
+
+// plainType returns the plain type for a generated message type.
+func plainType(t reflect.Type) reflect.Type {
+	for _, p := range []proto.Message{&plainScalars{}, &plainRepeated{}, &plainHolder{}, &plainOptional{}, &plainEmpty{}, &plainUnpacked{}} {
+		if pt := reflect.TypeOf(p); t.ConvertibleTo(pt) {
+			return pt
+		}
+	}
+	panic("no plain type for " + t.String())
+}
+
+// fresh returns a new, empty message of the same type as m.
+func fresh(m proto.Message) proto.Message {
+	return reflect.New(reflect.TypeOf(m).Elem()).Interface().(proto.Message)
+}
+
+func TestGenerated(t *testing.T) {
+	for _, m := range []proto.Message{&Scalars{}, &Repeated{}, &Holder{}, &Optional{}, &Empty{}, &Unpacked{}} {
+		if _, ok := m.(proto.SizedMarshaler); !ok {
+			t.Errorf("%T has no generated methods", m)
+		}
+	}
+	for _, m := range []proto.Message{&Holder_Choice{}, &Holder_Counts{}, &Sized{}} {
+		if _, ok := m.(proto.Sizer); ok {
+			t.Errorf("%T has generated methods, want none", m)
+		}
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	for _, test := range tests {
+		want, err := proto.Marshal(test.plain)
+		if err != nil {
+			t.Errorf("%s: reflection Marshal: %v", test.desc, err)
+			continue
+		}
+		got, err := proto.Marshal(test.msg)
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("%s: Marshal = %x, %v; want %x", test.desc, got, err, want)
+		}
+		if n := proto.Size(test.msg); n != len(want) {
+			t.Errorf("%s: Size = %d, want %d", test.desc, n, len(want))
+		}
+		got, err = proto.MarshalOptions{}.MarshalAppend([]byte("prefix"), test.msg)
+		if err != nil || !bytes.Equal(got, append([]byte("prefix"), want...)) {
+			t.Errorf("%s: MarshalAppend = %x, %v; want prefix then %x", test.desc, got, err, want)
+		}
+	}
+}
+
+func TestMarshalOptions(t *testing.T) {
+	for _, test := range tests {
+		want, err := proto.MarshalOptions{Deterministic: true}.Marshal(test.plain)
+		if err != nil {
+			t.Errorf("%s: reflection deterministic Marshal: %v", test.desc, err)
+			continue
+		}
+		got, err := proto.MarshalOptions{Deterministic: true}.Marshal(test.msg)
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("%s: deterministic Marshal = %x, %v; want %x", test.desc, got, err, want)
+		}
+		want, err = new(proto.Canonicalizer).Marshal(test.plain)
+		if err != nil {
+			t.Errorf("%s: reflection canonical Marshal: %v", test.desc, err)
+			continue
+		}
+		got, err = new(proto.Canonicalizer).Marshal(test.msg)
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("%s: canonical Marshal = %x, %v; want %x", test.desc, got, err, want)
+		}
+		if _, err := proto.HashCanonical(test.msg, sha256.New()); err != nil {
+			t.Errorf("%s: HashCanonical: %v", test.desc, err)
+		}
+	}
+
+	// Map entries in a message nested in a generated one are sorted.
+	counts := make(map[string]int32)
+	for i := 0; i < 20; i++ {
+		counts[string('a'+rune(i))] = int32(i)
+	}
+	holder := &Holder{Counts: &Holder_Counts{Counts: counts}}
+	want, err := proto.MarshalOptions{Deterministic: true}.Marshal((*plainHolder)(holder))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		got, err := proto.MarshalOptions{Deterministic: true}.Marshal(holder)
+		if err != nil || !bytes.Equal(got, want) {
+			t.Fatalf("deterministic Marshal of map = %x, %v; want %x", got, err, want)
+		}
+	}
+
+	// Unknown fields are left out at every level.
+	got, err := new(proto.Canonicalizer).Marshal(nestedUnknown())
+	known := &Optional{OInt32: proto.Int32(1), OMsg: &Optional{OString: proto.String("s")}}
+	if want, _ := proto.Marshal(known); err != nil || !bytes.Equal(got, want) {
+		t.Errorf("canonical Marshal of nested unknown fields = %x, %v; want %x", got, err, want)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	for _, test := range tests {
+		b, err := proto.Marshal(test.plain)
+		if err != nil {
+			t.Errorf("%s: reflection Marshal: %v", test.desc, err)
+			continue
+		}
+		want := fresh(test.plain)
+		if err := proto.Unmarshal(b, want); err != nil {
+			t.Errorf("%s: reflection Unmarshal: %v", test.desc, err)
+			continue
+		}
+		got := fresh(test.msg)
+		if err := proto.Unmarshal(b, got); err != nil {
+			t.Errorf("%s: Unmarshal: %v", test.desc, err)
+			continue
+		}
+		if want := reflect.ValueOf(want).Convert(reflect.TypeOf(got)).Interface(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: Unmarshal = %v, want %v", test.desc, got, want)
+		}
+
+		// Truncated input fails as it does by reflection.
+		for i := 0; i < len(b); i++ {
+			err := proto.Unmarshal(b[:i], fresh(test.msg))
+			wantErr := proto.Unmarshal(b[:i], fresh(test.plain))
+			if (err == nil) != (wantErr == nil) {
+				t.Errorf("%s: Unmarshal of %d of %d bytes: got error %v, want %v", test.desc, i, len(b), err, wantErr)
+			}
+		}
+	}
+}
+
+func TestUnmarshalMerge(t *testing.T) {
+	m := &Optional{OInt32: proto.Int32(1), OMsg: &Optional{OInt64: proto.Int64(2)}, RInt32: []int32{3}}
+	b, err := proto.Marshal(&Optional{OUint32: proto.Uint32(4), OMsg: &Optional{OString: proto.String("x")}, RInt32: []int32{5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.UnmarshalMerge(b, m); err != nil {
+		t.Fatal(err)
+	}
+	want := &Optional{
+		OInt32:  proto.Int32(1),
+		OUint32: proto.Uint32(4),
+		OMsg:    &Optional{OInt64: proto.Int64(2), OString: proto.String("x")},
+		RInt32:  []int32{3, 5},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("UnmarshalMerge = %v, want %v", m, want)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	b, err := proto.Marshal(fullOptional())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e Empty
+	if err := proto.Unmarshal(b, &e); err != nil {
+		t.Fatal(err)
+	}
+	got, err := proto.Marshal(&e)
+	if err != nil || !bytes.Equal(got, b) {
+		t.Errorf("Marshal of unknown fields = %x, %v; want %x", got, err, b)
+	}
+
+	// Proto3 messages drop them.
+	var s Scalars
+	if err := proto.Unmarshal([]byte{0xa0, 0x06, 0x01}, &s); err != nil || proto.Size(&s) != 0 {
+		t.Errorf("Unmarshal of unknown field into proto3 message = %v, %v; want empty message", &s, err)
+	}
+}
+
+func TestPacked(t *testing.T) {
+	// Both forms of a repeated scalar field are accepted.
+	r := &Repeated{RInt32: []int32{1, -1}, RSint64: []int64{-2}}
+	u := &Unpacked{RInt32: []int32{1, -1}, RSint64: []int64{-2}}
+	b, err := proto.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotU := new(Unpacked)
+	if err := proto.Unmarshal(b, gotU); err != nil || !proto.Equal(gotU, u) {
+		t.Errorf("Unmarshal of packed fields = %v, %v; want %v", gotU, err, u)
+	}
+	b, err = proto.Marshal(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotR := new(Repeated)
+	if err := proto.Unmarshal(b, gotR); err != nil || !proto.Equal(gotR, r) {
+		t.Errorf("Unmarshal of unpacked fields = %v, %v; want %v", gotR, err, r)
+	}
+}
+
+func TestBadWireType(t *testing.T) {
+	// Field 5 with wire type 1 (fixed64) is not an int32.
+	b := []byte{0x29, 0, 0, 0, 0, 0, 0, 0, 0}
+	if err := proto.Unmarshal(b, new(Scalars)); err == nil {
+		t.Error("Unmarshal with bad wire type succeeded")
+	}
+	if err := proto.Unmarshal(b, new(plainScalars)); err == nil {
+		t.Error("reflection Unmarshal with bad wire type succeeded")
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	// nest returns Optional messages nested depth deep in o_msg, counting
+	// the outermost.
+	nest := func(depth int) []byte {
+		var b []byte
+		for i := 1; i < depth; i++ {
+			b = append(append([]byte{0x8a, 0x01}, proto.EncodeVarint(uint64(len(b)))...), b...)
+		}
+		return b
+	}
+	for _, test := range []struct {
+		b        []byte
+		maxDepth int
+		err      error
+	}{
+		{nest(proto.DefaultMaxDepth), 0, nil},
+		{nest(proto.DefaultMaxDepth + 1), 0, proto.ErrTooDeep},
+		{nest(3), 3, nil},
+		{nest(4), 3, proto.ErrTooDeep},
+		// Groups nested in unknown field 1000.
+		{bytes.Repeat([]byte{0xc3, 0x3e}, 2*proto.DefaultMaxDepth), 0, proto.ErrTooDeep},
+	} {
+		err := proto.UnmarshalOptions{MaxDepth: test.maxDepth}.Unmarshal(test.b, new(Optional))
+		if err != test.err {
+			t.Errorf("Unmarshal of %d bytes with MaxDepth %d = %v, want %v", len(test.b), test.maxDepth, err, test.err)
+		}
+	}
+	if err := new(Optional).Unmarshal(nest(proto.DefaultMaxDepth + 1)); err != proto.ErrTooDeep {
+		t.Errorf("Optional.Unmarshal of messages too deep = %v, want %v", err, proto.ErrTooDeep)
+	}
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	m := fullOptional()
+	for i := 0; i < b.N; i++ {
+		proto.Marshal(m)
+	}
+}
+
+func BenchmarkMarshalReflect(b *testing.B) {
+	m := (*plainOptional)(fullOptional())
+	for i := 0; i < b.N; i++ {
+		proto.Marshal(m)
+	}
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	buf, _ := proto.Marshal(fullOptional())
+	for i := 0; i < b.N; i++ {
+		proto.Unmarshal(buf, new(Optional))
+	}
+}
+
+func BenchmarkUnmarshalReflect(b *testing.B) {
+	buf, _ := proto.Marshal(fullOptional())
+	for i := 0; i < b.N; i++ {
+		proto.Unmarshal(buf, new(plainOptional))
+	}
+}
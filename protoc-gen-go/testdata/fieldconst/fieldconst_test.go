@@ -0,0 +1,103 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package fieldconst
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// TestNames checks the constants and maps against the struct tags.
+func TestNames(t *testing.T) {
+	for _, tc := range []struct {
+		msg   proto.Message
+		names map[int32]string
+	}{
+		{&Account{}, AccountFieldNames},
+		{&Account_Address{}, Account_AddressFieldNames},
+	} {
+		want := make(map[int32]string)
+		sprops := proto.GetProperties(reflect.TypeOf(tc.msg).Elem())
+		for _, p := range sprops.Prop {
+			if p.Tag > 0 {
+				want[int32(p.Tag)] = p.OrigName
+			}
+		}
+		for _, op := range sprops.OneofTypes {
+			want[int32(op.Prop.Tag)] = op.Prop.OrigName
+		}
+		if !reflect.DeepEqual(tc.names, want) {
+			t.Errorf("%T: field names %v, want %v", tc.msg, tc.names, want)
+		}
+	}
+
+	consts := map[string]int{
+		"id":           Account_FieldId_,
+		"display_name": Account_FieldDisplayName,
+		"address":      Account_FieldAddress,
+		"emails":       Account_FieldEmails,
+		"password":     Account_FieldPassword,
+		"field_id":     Account_FieldFieldId,
+	}
+	for name, n := range consts {
+		if got := AccountFieldNames[int32(n)]; got != name {
+			t.Errorf("AccountFieldNames[%d] = %q, want %q", n, got, name)
+		}
+	}
+}
+
+// TestWire uses the constants to find a field in encoded data.
+func TestWire(t *testing.T) {
+	b, err := proto.Marshal(&Account{Id: "a1", DisplayName: "Ann"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := proto.NewBuffer(b)
+	for {
+		key, err := buf.DecodeVarint()
+		if err != nil {
+			t.Fatal("display_name not found")
+		}
+		s, err := buf.DecodeStringBytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key>>3 == Account_FieldDisplayName {
+			if s != "Ann" {
+				t.Errorf("display_name = %q, want Ann", s)
+			}
+			return
+		}
+	}
+}
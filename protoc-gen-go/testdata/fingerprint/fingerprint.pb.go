@@ -0,0 +1,1175 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: fingerprint/fingerprint.proto
+
+/*
+Package fingerprint is a generated protocol buffer package.
+
+It is generated from these files:
+	fingerprint/fingerprint.proto
+	fingerprint/fingerprint3.proto
+
+It has these top-level messages:
+	Record
+	Settings
+*/
+package fingerprint
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Record_Kind int32
+
+const (
+	Record_NONE     Record_Kind = 0
+	Record_SOME     Record_Kind = 1
+	Record_NEGATIVE Record_Kind = -1
+)
+
+var Record_Kind_name = map[int32]string{
+	0:  "NONE",
+	1:  "SOME",
+	-1: "NEGATIVE",
+}
+var Record_Kind_value = map[string]int32{
+	"NONE":     0,
+	"SOME":     1,
+	"NEGATIVE": -1,
+}
+
+func (x Record_Kind) Enum() *Record_Kind {
+	p := new(Record_Kind)
+	*p = x
+	return p
+}
+func (x Record_Kind) String() string {
+	return proto.EnumName(Record_Kind_name, int32(x))
+}
+func (x *Record_Kind) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Record_Kind_value, data, "Record_Kind")
+	if err != nil {
+		return err
+	}
+	*x = Record_Kind(value)
+	return nil
+}
+func (Record_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+type Record struct {
+	I32  *int32       `protobuf:"varint,1,opt,name=i32" json:"i32,omitempty"`
+	S64  *int64       `protobuf:"zigzag64,2,opt,name=s64" json:"s64,omitempty"`
+	U64  *uint64      `protobuf:"varint,3,opt,name=u64" json:"u64,omitempty"`
+	F32  *uint32      `protobuf:"fixed32,4,opt,name=f32" json:"f32,omitempty"`
+	Sf64 *int64       `protobuf:"fixed64,5,opt,name=sf64" json:"sf64,omitempty"`
+	Flt  *float32     `protobuf:"fixed32,6,opt,name=flt" json:"flt,omitempty"`
+	Dbl  *float64     `protobuf:"fixed64,7,opt,name=dbl" json:"dbl,omitempty"`
+	Flag *bool        `protobuf:"varint,8,opt,name=flag" json:"flag,omitempty"`
+	Str  *string      `protobuf:"bytes,9,opt,name=str" json:"str,omitempty"`
+	Data []byte       `protobuf:"bytes,10,opt,name=data" json:"data,omitempty"`
+	Kind *Record_Kind `protobuf:"varint,11,opt,name=kind,enum=fingerprint.Record_Kind" json:"kind,omitempty"`
+	Part *Record_Part `protobuf:"bytes,12,opt,name=part" json:"part,omitempty"`
+	// LazyPart is decoded on first use; read it with GetLazyPart.
+	LazyPart    *Record_Part            `protobuf:"bytes,13,opt,name=lazy_part,json=lazyPart,lazy" json:"lazy_part,omitempty"`
+	Bag         *Record_Bag             `protobuf:"group,14,opt,name=Bag,json=bag" json:"bag,omitempty"`
+	Ints        []int32                 `protobuf:"varint,16,rep,name=ints" json:"ints,omitempty"`
+	PackedInts  []int32                 `protobuf:"zigzag32,17,rep,packed,name=packed_ints,json=packedInts" json:"packed_ints,omitempty"`
+	PackedDbls  []float64               `protobuf:"fixed64,18,rep,packed,name=packed_dbls,json=packedDbls" json:"packed_dbls,omitempty"`
+	PackedFlags []bool                  `protobuf:"varint,19,rep,packed,name=packed_flags,json=packedFlags" json:"packed_flags,omitempty"`
+	Strs        []string                `protobuf:"bytes,20,rep,name=strs" json:"strs,omitempty"`
+	Datas       [][]byte                `protobuf:"bytes,21,rep,name=datas" json:"datas,omitempty"`
+	Parts       []*Record_Part          `protobuf:"bytes,22,rep,name=parts" json:"parts,omitempty"`
+	Item        []*Record_Item          `protobuf:"group,23,rep,name=Item,json=item" json:"item,omitempty"`
+	Counts      map[string]int64        `protobuf:"bytes,25,rep,name=counts" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Blobs       map[int32][]byte        `protobuf:"bytes,26,rep,name=blobs" json:"blobs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Flags       map[bool]string         `protobuf:"bytes,27,rep,name=flags" json:"flags,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PartMap     map[uint64]*Record_Part `protobuf:"bytes,28,rep,name=part_map,json=partMap" json:"part_map,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Kinds       map[int32]Record_Kind   `protobuf:"bytes,29,rep,name=kinds" json:"kinds,omitempty" protobuf_key:"zigzag32,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=fingerprint.Record_Kind"`
+	// Types that are valid to be assigned to Choice:
+	//	*Record_Number
+	//	*Record_Raw
+	//	*Record_Chosen
+	//	*Record_Pick_
+	Choice isRecord_Choice `protobuf_oneof:"choice"`
+	// Declared in another file, so marshaled for its fingerprint.
+	Settings             *Settings          `protobuf:"bytes,35,opt,name=settings" json:"settings,omitempty"`
+	Weights              map[uint64]float32 `protobuf:"bytes,36,rep,name=weights" json:"weights,omitempty" protobuf_key:"fixed64,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"`
+	Seen                 map[string]bool    `protobuf:"bytes,37,rep,name=seen" json:"seen,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	proto.XXX_LazyFields `json:"-"`
+	XXX_unrecognized     []byte `json:"-"`
+}
+
+func (m *Record) Reset()                    { *m = Record{} }
+func (m *Record) String() string            { return proto.CompactTextString(m) }
+func (*Record) ProtoMessage()               {}
+func (*Record) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type isRecord_Choice interface{ isRecord_Choice() }
+
+type Record_Number struct {
+	Number int32 `protobuf:"varint,30,opt,name=number,oneof"`
+}
+type Record_Raw struct {
+	Raw []byte `protobuf:"bytes,31,opt,name=raw,oneof"`
+}
+type Record_Chosen struct {
+	Chosen *Record_Part `protobuf:"bytes,32,opt,name=chosen,oneof"`
+}
+type Record_Pick_ struct {
+	Pick *Record_Pick `protobuf:"group,33,opt,name=Pick,json=pick,oneof"`
+}
+
+func (*Record_Number) isRecord_Choice() {}
+func (*Record_Raw) isRecord_Choice()    {}
+func (*Record_Chosen) isRecord_Choice() {}
+func (*Record_Pick_) isRecord_Choice()  {}
+
+func (m *Record) GetChoice() isRecord_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (m *Record) GetI32() int32 {
+	if m != nil && m.I32 != nil {
+		return *m.I32
+	}
+	return 0
+}
+
+func (m *Record) GetS64() int64 {
+	if m != nil && m.S64 != nil {
+		return *m.S64
+	}
+	return 0
+}
+
+func (m *Record) GetU64() uint64 {
+	if m != nil && m.U64 != nil {
+		return *m.U64
+	}
+	return 0
+}
+
+func (m *Record) GetF32() uint32 {
+	if m != nil && m.F32 != nil {
+		return *m.F32
+	}
+	return 0
+}
+
+func (m *Record) GetSf64() int64 {
+	if m != nil && m.Sf64 != nil {
+		return *m.Sf64
+	}
+	return 0
+}
+
+func (m *Record) GetFlt() float32 {
+	if m != nil && m.Flt != nil {
+		return *m.Flt
+	}
+	return 0
+}
+
+func (m *Record) GetDbl() float64 {
+	if m != nil && m.Dbl != nil {
+		return *m.Dbl
+	}
+	return 0
+}
+
+func (m *Record) GetFlag() bool {
+	if m != nil && m.Flag != nil {
+		return *m.Flag
+	}
+	return false
+}
+
+func (m *Record) GetStr() string {
+	if m != nil && m.Str != nil {
+		return *m.Str
+	}
+	return ""
+}
+
+func (m *Record) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Record) GetKind() Record_Kind {
+	if m != nil && m.Kind != nil {
+		return *m.Kind
+	}
+	return Record_NONE
+}
+
+func (m *Record) GetPart() *Record_Part {
+	if m != nil {
+		return m.Part
+	}
+	return nil
+}
+
+func (m *Record) GetLazyPart() *Record_Part {
+	if m != nil {
+		proto.DecodeLazyField(m, 13)
+		return m.LazyPart
+	}
+	return nil
+}
+
+func (m *Record) GetBag() *Record_Bag {
+	if m != nil {
+		return m.Bag
+	}
+	return nil
+}
+
+func (m *Record) GetInts() []int32 {
+	if m != nil {
+		return m.Ints
+	}
+	return nil
+}
+
+func (m *Record) GetPackedInts() []int32 {
+	if m != nil {
+		return m.PackedInts
+	}
+	return nil
+}
+
+func (m *Record) GetPackedDbls() []float64 {
+	if m != nil {
+		return m.PackedDbls
+	}
+	return nil
+}
+
+func (m *Record) GetPackedFlags() []bool {
+	if m != nil {
+		return m.PackedFlags
+	}
+	return nil
+}
+
+func (m *Record) GetStrs() []string {
+	if m != nil {
+		return m.Strs
+	}
+	return nil
+}
+
+func (m *Record) GetDatas() [][]byte {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+func (m *Record) GetParts() []*Record_Part {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+func (m *Record) GetItem() []*Record_Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *Record) GetCounts() map[string]int64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *Record) GetBlobs() map[int32][]byte {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *Record) GetFlags() map[bool]string {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+func (m *Record) GetPartMap() map[uint64]*Record_Part {
+	if m != nil {
+		return m.PartMap
+	}
+	return nil
+}
+
+func (m *Record) GetKinds() map[int32]Record_Kind {
+	if m != nil {
+		return m.Kinds
+	}
+	return nil
+}
+
+func (m *Record) GetNumber() int32 {
+	if x, ok := m.GetChoice().(*Record_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (m *Record) GetRaw() []byte {
+	if x, ok := m.GetChoice().(*Record_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+func (m *Record) GetChosen() *Record_Part {
+	if x, ok := m.GetChoice().(*Record_Chosen); ok {
+		return x.Chosen
+	}
+	return nil
+}
+
+func (m *Record) GetPick() *Record_Pick {
+	if x, ok := m.GetChoice().(*Record_Pick_); ok {
+		return x.Pick
+	}
+	return nil
+}
+
+func (m *Record) GetSettings() *Settings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *Record) GetWeights() map[uint64]float32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *Record) GetSeen() map[string]bool {
+	if m != nil {
+		return m.Seen
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Record) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Record_OneofMarshaler, _Record_OneofUnmarshaler, _Record_OneofSizer, []interface{}{
+		(*Record_Number)(nil),
+		(*Record_Raw)(nil),
+		(*Record_Chosen)(nil),
+		(*Record_Pick_)(nil),
+	}
+}
+
+func _Record_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Record)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Record_Number:
+		b.EncodeVarint(30<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Number))
+	case *Record_Raw:
+		b.EncodeVarint(31<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.Raw)
+	case *Record_Chosen:
+		b.EncodeVarint(32<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Chosen); err != nil {
+			return err
+		}
+	case *Record_Pick_:
+		b.EncodeVarint(33<<3 | proto.WireStartGroup)
+		if err := b.Marshal(x.Pick); err != nil {
+			return err
+		}
+		b.EncodeVarint(33<<3 | proto.WireEndGroup)
+	case nil:
+	default:
+		return fmt.Errorf("Record.Choice has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Record_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Record)
+	switch tag {
+	case 30: // choice.number
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Choice = &Record_Number{int32(x)}
+		return true, err
+	case 31: // choice.raw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Choice = &Record_Raw{x}
+		return true, err
+	case 32: // choice.chosen
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Record_Part)
+		err := b.DecodeMessage(msg)
+		m.Choice = &Record_Chosen{msg}
+		return true, err
+	case 33: // choice.pick
+		if wire != proto.WireStartGroup {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Record_Pick)
+		err := b.DecodeGroup(msg)
+		m.Choice = &Record_Pick_{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Record_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Record)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Record_Number:
+		n += proto.SizeVarint(30<<3 | proto.WireVarint)
+		n += proto.SizeVarint(uint64(x.Number))
+	case *Record_Raw:
+		n += proto.SizeVarint(31<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Raw)))
+		n += len(x.Raw)
+	case *Record_Chosen:
+		s := proto.Size(x.Chosen)
+		n += proto.SizeVarint(32<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Record_Pick_:
+		n += proto.SizeVarint(33<<3 | proto.WireStartGroup)
+		n += proto.Size(x.Pick)
+		n += proto.SizeVarint(33<<3 | proto.WireEndGroup)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Record_Part struct {
+	Name             *string        `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Parts            []*Record_Part `protobuf:"bytes,2,rep,name=parts" json:"parts,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *Record_Part) Reset()                    { *m = Record_Part{} }
+func (m *Record_Part) String() string            { return proto.CompactTextString(m) }
+func (*Record_Part) ProtoMessage()               {}
+func (*Record_Part) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+func (m *Record_Part) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Record_Part) GetParts() []*Record_Part {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+type Record_Bag struct {
+	Label            *string `protobuf:"bytes,15,opt,name=label" json:"label,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Record_Bag) Reset()                    { *m = Record_Bag{} }
+func (m *Record_Bag) String() string            { return proto.CompactTextString(m) }
+func (*Record_Bag) ProtoMessage()               {}
+func (*Record_Bag) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 1} }
+
+func (m *Record_Bag) GetLabel() string {
+	if m != nil && m.Label != nil {
+		return *m.Label
+	}
+	return ""
+}
+
+type Record_Item struct {
+	Id               *int32 `protobuf:"varint,24,opt,name=id" json:"id,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Record_Item) Reset()                    { *m = Record_Item{} }
+func (m *Record_Item) String() string            { return proto.CompactTextString(m) }
+func (*Record_Item) ProtoMessage()               {}
+func (*Record_Item) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 2} }
+
+func (m *Record_Item) GetId() int32 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+type Record_Pick struct {
+	Pick             *string `protobuf:"bytes,34,opt,name=pick" json:"pick,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Record_Pick) Reset()                    { *m = Record_Pick{} }
+func (m *Record_Pick) String() string            { return proto.CompactTextString(m) }
+func (*Record_Pick) ProtoMessage()               {}
+func (*Record_Pick) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 8} }
+
+func (m *Record_Pick) GetPick() string {
+	if m != nil && m.Pick != nil {
+		return *m.Pick
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Record)(nil), "fingerprint.Record")
+	proto.RegisterType((*Record_Part)(nil), "fingerprint.Record.Part")
+	proto.RegisterType((*Record_Bag)(nil), "fingerprint.Record.Bag")
+	proto.RegisterType((*Record_Item)(nil), "fingerprint.Record.Item")
+	proto.RegisterType((*Record_Pick)(nil), "fingerprint.Record.Pick")
+	proto.RegisterEnum("fingerprint.Record_Kind", Record_Kind_name, Record_Kind_value)
+}
+
+// Fingerprint returns the FNV-1a hash of the canonical encoding of m,
+// the number proto.HashCanonical(m, fnv.New64a()) returns read big-endian,
+// without marshaling m.
+func (m *Record) Fingerprint() uint64 {
+	return m.fingerprintTo(proto.FingerprintBasis)
+}
+
+// fingerprintTo adds the canonical encoding of m to the fingerprint h.
+func (m *Record) fingerprintTo(h uint64) uint64 {
+	if m == nil {
+		return h
+	}
+	proto.DecodeLazy(m)
+	if m.I32 != nil {
+		h = proto.FingerprintVarint(h, 1<<3|proto.WireVarint)
+		h = proto.FingerprintVarint(h, uint64(*m.I32))
+	}
+	if m.S64 != nil {
+		h = proto.FingerprintVarint(h, 2<<3|proto.WireVarint)
+		h = proto.FingerprintVarint(h, (uint64(*m.S64)<<1)^uint64(*m.S64>>63))
+	}
+	if m.U64 != nil {
+		h = proto.FingerprintVarint(h, 3<<3|proto.WireVarint)
+		h = proto.FingerprintVarint(h, *m.U64)
+	}
+	if m.F32 != nil {
+		h = proto.FingerprintVarint(h, 4<<3|proto.WireFixed32)
+		h = proto.FingerprintFixed32(h, *m.F32)
+	}
+	if m.Sf64 != nil {
+		h = proto.FingerprintVarint(h, 5<<3|proto.WireFixed64)
+		h = proto.FingerprintFixed64(h, uint64(*m.Sf64))
+	}
+	if m.Flt != nil {
+		h = proto.FingerprintVarint(h, 6<<3|proto.WireFixed32)
+		h = proto.FingerprintFixed32(h, math.Float32bits(*m.Flt))
+	}
+	if m.Dbl != nil {
+		h = proto.FingerprintVarint(h, 7<<3|proto.WireFixed64)
+		h = proto.FingerprintFixed64(h, math.Float64bits(*m.Dbl))
+	}
+	if m.Flag != nil {
+		h = proto.FingerprintVarint(h, 8<<3|proto.WireVarint)
+		h = proto.FingerprintBool(h, *m.Flag)
+	}
+	if m.Str != nil {
+		h = proto.FingerprintVarint(h, 9<<3|proto.WireBytes)
+		h = proto.FingerprintString(h, *m.Str)
+	}
+	if m.Data != nil {
+		h = proto.FingerprintVarint(h, 10<<3|proto.WireBytes)
+		h = proto.FingerprintBytes(h, m.Data)
+	}
+	if m.Kind != nil {
+		h = proto.FingerprintVarint(h, 11<<3|proto.WireVarint)
+		h = proto.FingerprintVarint(h, uint64(*m.Kind))
+	}
+	if m.Part != nil {
+		h = proto.FingerprintVarint(h, 12<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(m.Part.canonicalSize()))
+		h = m.Part.fingerprintTo(h)
+	}
+	if m.LazyPart != nil {
+		h = proto.FingerprintVarint(h, 13<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(m.LazyPart.canonicalSize()))
+		h = m.LazyPart.fingerprintTo(h)
+	}
+	if m.Bag != nil {
+		h = proto.FingerprintVarint(h, 14<<3|proto.WireStartGroup)
+		h = m.Bag.fingerprintTo(h)
+		h = proto.FingerprintVarint(h, 14<<3|proto.WireEndGroup)
+	}
+	for _, v := range m.Ints {
+		h = proto.FingerprintVarint(h, 16<<3|proto.WireVarint)
+		h = proto.FingerprintVarint(h, uint64(v))
+	}
+	if len(m.PackedInts) > 0 {
+		l := 0
+		for _, v := range m.PackedInts {
+			l += proto.SizeVarint(uint64((uint32(v) << 1) ^ uint32(v>>31)))
+		}
+		h = proto.FingerprintVarint(h, 17<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(l))
+		for _, v := range m.PackedInts {
+			h = proto.FingerprintVarint(h, uint64((uint32(v)<<1)^uint32(v>>31)))
+		}
+	}
+	if len(m.PackedDbls) > 0 {
+		l := len(m.PackedDbls) * 8
+		h = proto.FingerprintVarint(h, 18<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(l))
+		for _, v := range m.PackedDbls {
+			h = proto.FingerprintFixed64(h, math.Float64bits(v))
+		}
+	}
+	if len(m.PackedFlags) > 0 {
+		l := len(m.PackedFlags)
+		h = proto.FingerprintVarint(h, 19<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(l))
+		for _, v := range m.PackedFlags {
+			h = proto.FingerprintBool(h, v)
+		}
+	}
+	for _, v := range m.Strs {
+		h = proto.FingerprintVarint(h, 20<<3|proto.WireBytes)
+		h = proto.FingerprintString(h, v)
+	}
+	for _, v := range m.Datas {
+		h = proto.FingerprintVarint(h, 21<<3|proto.WireBytes)
+		h = proto.FingerprintBytes(h, v)
+	}
+	for _, v := range m.Parts {
+		h = proto.FingerprintVarint(h, 22<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(v.canonicalSize()))
+		h = v.fingerprintTo(h)
+	}
+	for _, v := range m.Item {
+		h = proto.FingerprintVarint(h, 23<<3|proto.WireStartGroup)
+		h = v.fingerprintTo(h)
+		h = proto.FingerprintVarint(h, 23<<3|proto.WireEndGroup)
+	}
+	if len(m.Counts) > 0 {
+		keys := make([]string, 0, len(m.Counts))
+		for k := range m.Counts {
+			keys = append(keys, k)
+		}
+		proto.SortStringKeys(keys)
+		for _, k := range keys {
+			v := m.Counts[k]
+			e := 1 + proto.SizeVarint(uint64(len(k))) + len(k)
+			e += 1 + proto.SizeVarint(uint64(v))
+			h = proto.FingerprintVarint(h, 25<<3|proto.WireBytes)
+			h = proto.FingerprintVarint(h, uint64(e))
+			h = proto.FingerprintVarint(h, 1<<3|proto.WireBytes)
+			h = proto.FingerprintString(h, k)
+			h = proto.FingerprintVarint(h, 2<<3|proto.WireVarint)
+			h = proto.FingerprintVarint(h, uint64(v))
+		}
+	}
+	if len(m.Blobs) > 0 {
+		keys := make([]int32, 0, len(m.Blobs))
+		for k := range m.Blobs {
+			keys = append(keys, k)
+		}
+		proto.SortInt32Keys(keys)
+		for _, k := range keys {
+			v := m.Blobs[k]
+			e := 1 + proto.SizeVarint(uint64(k))
+			if v != nil {
+				e += 1 + proto.SizeVarint(uint64(len(v))) + len(v)
+			}
+			h = proto.FingerprintVarint(h, 26<<3|proto.WireBytes)
+			h = proto.FingerprintVarint(h, uint64(e))
+			h = proto.FingerprintVarint(h, 1<<3|proto.WireVarint)
+			h = proto.FingerprintVarint(h, uint64(k))
+			if v != nil {
+				h = proto.FingerprintVarint(h, 2<<3|proto.WireBytes)
+				h = proto.FingerprintBytes(h, v)
+			}
+		}
+	}
+	for _, k := range []bool{false, true} {
+		v, ok := m.Flags[k]
+		if !ok {
+			continue
+		}
+		e := 1 + 1
+		e += 1 + proto.SizeVarint(uint64(len(v))) + len(v)
+		h = proto.FingerprintVarint(h, 27<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(e))
+		h = proto.FingerprintVarint(h, 1<<3|proto.WireVarint)
+		h = proto.FingerprintBool(h, k)
+		h = proto.FingerprintVarint(h, 2<<3|proto.WireBytes)
+		h = proto.FingerprintString(h, v)
+	}
+	if len(m.PartMap) > 0 {
+		keys := make([]uint64, 0, len(m.PartMap))
+		for k := range m.PartMap {
+			keys = append(keys, k)
+		}
+		proto.SortUint64Keys(keys)
+		for _, k := range keys {
+			v := m.PartMap[k]
+			e := 1 + proto.SizeVarint(k)
+			if v != nil {
+				l := v.canonicalSize()
+				e += 1 + proto.SizeVarint(uint64(l)) + l
+			}
+			h = proto.FingerprintVarint(h, 28<<3|proto.WireBytes)
+			h = proto.FingerprintVarint(h, uint64(e))
+			h = proto.FingerprintVarint(h, 1<<3|proto.WireVarint)
+			h = proto.FingerprintVarint(h, k)
+			if v != nil {
+				h = proto.FingerprintVarint(h, 2<<3|proto.WireBytes)
+				h = proto.FingerprintVarint(h, uint64(v.canonicalSize()))
+				h = v.fingerprintTo(h)
+			}
+		}
+	}
+	if len(m.Kinds) > 0 {
+		keys := make([]int32, 0, len(m.Kinds))
+		for k := range m.Kinds {
+			keys = append(keys, k)
+		}
+		proto.SortInt32Keys(keys)
+		for _, k := range keys {
+			v := m.Kinds[k]
+			e := 1 + proto.SizeVarint(uint64((uint32(k)<<1)^uint32(k>>31)))
+			e += 1 + proto.SizeVarint(uint64(v))
+			h = proto.FingerprintVarint(h, 29<<3|proto.WireBytes)
+			h = proto.FingerprintVarint(h, uint64(e))
+			h = proto.FingerprintVarint(h, 1<<3|proto.WireVarint)
+			h = proto.FingerprintVarint(h, uint64((uint32(k)<<1)^uint32(k>>31)))
+			h = proto.FingerprintVarint(h, 2<<3|proto.WireVarint)
+			h = proto.FingerprintVarint(h, uint64(v))
+		}
+	}
+	if m.Settings != nil {
+		h = proto.FingerprintVarint(h, 35<<3|proto.WireBytes)
+		h = proto.FingerprintMessage(h, m.Settings)
+	}
+	if len(m.Weights) > 0 {
+		keys := make([]uint64, 0, len(m.Weights))
+		for k := range m.Weights {
+			keys = append(keys, k)
+		}
+		proto.SortUint64Keys(keys)
+		for _, k := range keys {
+			v := m.Weights[k]
+			e := 1 + 8
+			e += 1 + 4
+			h = proto.FingerprintVarint(h, 36<<3|proto.WireBytes)
+			h = proto.FingerprintVarint(h, uint64(e))
+			h = proto.FingerprintVarint(h, 1<<3|proto.WireFixed64)
+			h = proto.FingerprintFixed64(h, k)
+			h = proto.FingerprintVarint(h, 2<<3|proto.WireFixed32)
+			h = proto.FingerprintFixed32(h, math.Float32bits(v))
+		}
+	}
+	if len(m.Seen) > 0 {
+		keys := make([]string, 0, len(m.Seen))
+		for k := range m.Seen {
+			keys = append(keys, k)
+		}
+		proto.SortStringKeys(keys)
+		for _, k := range keys {
+			v := m.Seen[k]
+			e := 1 + proto.SizeVarint(uint64(len(k))) + len(k)
+			e += 1 + 1
+			h = proto.FingerprintVarint(h, 37<<3|proto.WireBytes)
+			h = proto.FingerprintVarint(h, uint64(e))
+			h = proto.FingerprintVarint(h, 1<<3|proto.WireBytes)
+			h = proto.FingerprintString(h, k)
+			h = proto.FingerprintVarint(h, 2<<3|proto.WireVarint)
+			h = proto.FingerprintBool(h, v)
+		}
+	}
+	switch x := m.Choice.(type) {
+	case *Record_Number:
+		h = proto.FingerprintVarint(h, 30<<3|proto.WireVarint)
+		h = proto.FingerprintVarint(h, uint64(x.Number))
+	case *Record_Raw:
+		h = proto.FingerprintVarint(h, 31<<3|proto.WireBytes)
+		h = proto.FingerprintBytes(h, x.Raw)
+	case *Record_Chosen:
+		h = proto.FingerprintVarint(h, 32<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(x.Chosen.canonicalSize()))
+		h = x.Chosen.fingerprintTo(h)
+	case *Record_Pick_:
+		h = proto.FingerprintVarint(h, 33<<3|proto.WireStartGroup)
+		h = x.Pick.fingerprintTo(h)
+		h = proto.FingerprintVarint(h, 33<<3|proto.WireEndGroup)
+	}
+	return h
+}
+
+// canonicalSize returns the size of the canonical encoding of m.
+func (m *Record) canonicalSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	proto.DecodeLazy(m)
+	if m.I32 != nil {
+		n += 1 + proto.SizeVarint(uint64(*m.I32))
+	}
+	if m.S64 != nil {
+		n += 1 + proto.SizeVarint((uint64(*m.S64)<<1)^uint64(*m.S64>>63))
+	}
+	if m.U64 != nil {
+		n += 1 + proto.SizeVarint(*m.U64)
+	}
+	if m.F32 != nil {
+		n += 1 + 4
+	}
+	if m.Sf64 != nil {
+		n += 1 + 8
+	}
+	if m.Flt != nil {
+		n += 1 + 4
+	}
+	if m.Dbl != nil {
+		n += 1 + 8
+	}
+	if m.Flag != nil {
+		n += 1 + 1
+	}
+	if m.Str != nil {
+		n += 1 + proto.SizeVarint(uint64(len(*m.Str))) + len(*m.Str)
+	}
+	if m.Data != nil {
+		n += 1 + proto.SizeVarint(uint64(len(m.Data))) + len(m.Data)
+	}
+	if m.Kind != nil {
+		n += 1 + proto.SizeVarint(uint64(*m.Kind))
+	}
+	if m.Part != nil {
+		l := m.Part.canonicalSize()
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if m.LazyPart != nil {
+		l := m.LazyPart.canonicalSize()
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if m.Bag != nil {
+		n += 2 + m.Bag.canonicalSize()
+	}
+	for _, v := range m.Ints {
+		n += 2 + proto.SizeVarint(uint64(v))
+	}
+	if len(m.PackedInts) > 0 {
+		l := 0
+		for _, v := range m.PackedInts {
+			l += proto.SizeVarint(uint64((uint32(v) << 1) ^ uint32(v>>31)))
+		}
+		n += 2 + proto.SizeVarint(uint64(l)) + l
+	}
+	if len(m.PackedDbls) > 0 {
+		l := len(m.PackedDbls) * 8
+		n += 2 + proto.SizeVarint(uint64(l)) + l
+	}
+	if len(m.PackedFlags) > 0 {
+		l := len(m.PackedFlags)
+		n += 2 + proto.SizeVarint(uint64(l)) + l
+	}
+	for _, v := range m.Strs {
+		n += 2 + proto.SizeVarint(uint64(len(v))) + len(v)
+	}
+	for _, v := range m.Datas {
+		n += 2 + proto.SizeVarint(uint64(len(v))) + len(v)
+	}
+	for _, v := range m.Parts {
+		l := v.canonicalSize()
+		n += 2 + proto.SizeVarint(uint64(l)) + l
+	}
+	for _, v := range m.Item {
+		n += 4 + v.canonicalSize()
+	}
+	for k, v := range m.Counts {
+		e := 1 + proto.SizeVarint(uint64(len(k))) + len(k)
+		e += 1 + proto.SizeVarint(uint64(v))
+		n += 2 + proto.SizeVarint(uint64(e)) + e
+	}
+	for k, v := range m.Blobs {
+		e := 1 + proto.SizeVarint(uint64(k))
+		if v != nil {
+			e += 1 + proto.SizeVarint(uint64(len(v))) + len(v)
+		}
+		n += 2 + proto.SizeVarint(uint64(e)) + e
+	}
+	for _, v := range m.Flags {
+		e := 1 + 1
+		e += 1 + proto.SizeVarint(uint64(len(v))) + len(v)
+		n += 2 + proto.SizeVarint(uint64(e)) + e
+	}
+	for k, v := range m.PartMap {
+		e := 1 + proto.SizeVarint(k)
+		if v != nil {
+			l := v.canonicalSize()
+			e += 1 + proto.SizeVarint(uint64(l)) + l
+		}
+		n += 2 + proto.SizeVarint(uint64(e)) + e
+	}
+	for k, v := range m.Kinds {
+		e := 1 + proto.SizeVarint(uint64((uint32(k)<<1)^uint32(k>>31)))
+		e += 1 + proto.SizeVarint(uint64(v))
+		n += 2 + proto.SizeVarint(uint64(e)) + e
+	}
+	if m.Settings != nil {
+		l := proto.CanonicalSize(m.Settings)
+		n += 2 + proto.SizeVarint(uint64(l)) + l
+	}
+	for range m.Weights {
+		e := 1 + 8
+		e += 1 + 4
+		n += 2 + proto.SizeVarint(uint64(e)) + e
+	}
+	for k := range m.Seen {
+		e := 1 + proto.SizeVarint(uint64(len(k))) + len(k)
+		e += 1 + 1
+		n += 2 + proto.SizeVarint(uint64(e)) + e
+	}
+	switch x := m.Choice.(type) {
+	case *Record_Number:
+		n += 2 + proto.SizeVarint(uint64(x.Number))
+	case *Record_Raw:
+		n += 2 + proto.SizeVarint(uint64(len(x.Raw))) + len(x.Raw)
+	case *Record_Chosen:
+		l := x.Chosen.canonicalSize()
+		n += 2 + proto.SizeVarint(uint64(l)) + l
+	case *Record_Pick_:
+		n += 4 + x.Pick.canonicalSize()
+	}
+	return n
+}
+
+// Fingerprint returns the FNV-1a hash of the canonical encoding of m,
+// the number proto.HashCanonical(m, fnv.New64a()) returns read big-endian,
+// without marshaling m.
+func (m *Record_Part) Fingerprint() uint64 {
+	return m.fingerprintTo(proto.FingerprintBasis)
+}
+
+// fingerprintTo adds the canonical encoding of m to the fingerprint h.
+func (m *Record_Part) fingerprintTo(h uint64) uint64 {
+	if m == nil {
+		return h
+	}
+	if m.Name != nil {
+		h = proto.FingerprintVarint(h, 1<<3|proto.WireBytes)
+		h = proto.FingerprintString(h, *m.Name)
+	}
+	for _, v := range m.Parts {
+		h = proto.FingerprintVarint(h, 2<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(v.canonicalSize()))
+		h = v.fingerprintTo(h)
+	}
+	return h
+}
+
+// canonicalSize returns the size of the canonical encoding of m.
+func (m *Record_Part) canonicalSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.Name != nil {
+		n += 1 + proto.SizeVarint(uint64(len(*m.Name))) + len(*m.Name)
+	}
+	for _, v := range m.Parts {
+		l := v.canonicalSize()
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	return n
+}
+
+// Fingerprint returns the FNV-1a hash of the canonical encoding of m,
+// the number proto.HashCanonical(m, fnv.New64a()) returns read big-endian,
+// without marshaling m.
+func (m *Record_Bag) Fingerprint() uint64 {
+	return m.fingerprintTo(proto.FingerprintBasis)
+}
+
+// fingerprintTo adds the canonical encoding of m to the fingerprint h.
+func (m *Record_Bag) fingerprintTo(h uint64) uint64 {
+	if m == nil {
+		return h
+	}
+	if m.Label != nil {
+		h = proto.FingerprintVarint(h, 15<<3|proto.WireBytes)
+		h = proto.FingerprintString(h, *m.Label)
+	}
+	return h
+}
+
+// canonicalSize returns the size of the canonical encoding of m.
+func (m *Record_Bag) canonicalSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.Label != nil {
+		n += 1 + proto.SizeVarint(uint64(len(*m.Label))) + len(*m.Label)
+	}
+	return n
+}
+
+// Fingerprint returns the FNV-1a hash of the canonical encoding of m,
+// the number proto.HashCanonical(m, fnv.New64a()) returns read big-endian,
+// without marshaling m.
+func (m *Record_Item) Fingerprint() uint64 {
+	return m.fingerprintTo(proto.FingerprintBasis)
+}
+
+// fingerprintTo adds the canonical encoding of m to the fingerprint h.
+func (m *Record_Item) fingerprintTo(h uint64) uint64 {
+	if m == nil {
+		return h
+	}
+	if m.Id != nil {
+		h = proto.FingerprintVarint(h, 24<<3|proto.WireVarint)
+		h = proto.FingerprintVarint(h, uint64(*m.Id))
+	}
+	return h
+}
+
+// canonicalSize returns the size of the canonical encoding of m.
+func (m *Record_Item) canonicalSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.Id != nil {
+		n += 2 + proto.SizeVarint(uint64(*m.Id))
+	}
+	return n
+}
+
+// Fingerprint returns the FNV-1a hash of the canonical encoding of m,
+// the number proto.HashCanonical(m, fnv.New64a()) returns read big-endian,
+// without marshaling m.
+func (m *Record_Pick) Fingerprint() uint64 {
+	return m.fingerprintTo(proto.FingerprintBasis)
+}
+
+// fingerprintTo adds the canonical encoding of m to the fingerprint h.
+func (m *Record_Pick) fingerprintTo(h uint64) uint64 {
+	if m == nil {
+		return h
+	}
+	if m.Pick != nil {
+		h = proto.FingerprintVarint(h, 34<<3|proto.WireBytes)
+		h = proto.FingerprintString(h, *m.Pick)
+	}
+	return h
+}
+
+// canonicalSize returns the size of the canonical encoding of m.
+func (m *Record_Pick) canonicalSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.Pick != nil {
+		n += 2 + proto.SizeVarint(uint64(len(*m.Pick))) + len(*m.Pick)
+	}
+	return n
+}
+
+func init() { proto.RegisterFile("fingerprint/fingerprint.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x6f, 0xdb, 0x36,
+	0x10, 0xc6, 0x43, 0x49, 0x76, 0xe4, 0xb3, 0x97, 0x3a, 0x5c, 0xd3, 0xde, 0xd4, 0x25, 0xe5, 0xd2,
+	0x15, 0xe0, 0x80, 0xc1, 0x43, 0x9c, 0x20, 0x6d, 0xb3, 0xa7, 0x79, 0xf3, 0x96, 0x6c, 0x68, 0x3a,
+	0x30, 0xc5, 0xf6, 0x18, 0xc8, 0x16, 0xed, 0x0a, 0x96, 0x65, 0x43, 0x92, 0x57, 0x64, 0xef, 0xfb,
+	0xbb, 0x37, 0x1c, 0xa9, 0xd6, 0xca, 0x20, 0x7b, 0xf3, 0xd3, 0x47, 0xfa, 0xf7, 0x1d, 0xc5, 0xe3,
+	0x47, 0xc2, 0xe1, 0x24, 0x4e, 0xa7, 0x3a, 0x5b, 0x66, 0x71, 0x5a, 0x7c, 0x53, 0xd1, 0xbd, 0x65,
+	0xb6, 0x28, 0x16, 0xbc, 0x5d, 0x99, 0x0a, 0x8e, 0x36, 0xb0, 0xa7, 0x16, 0x3e, 0xfe, 0xeb, 0x01,
+	0x34, 0x95, 0x1e, 0x2f, 0xb2, 0x88, 0x77, 0xc1, 0x8d, 0x4f, 0xfb, 0xc8, 0x04, 0x93, 0x0d, 0x45,
+	0x92, 0x66, 0xf2, 0xf3, 0x33, 0x74, 0x04, 0x93, 0x5c, 0x91, 0xa4, 0x99, 0xd5, 0xf9, 0x19, 0xba,
+	0x82, 0x49, 0x4f, 0xb9, 0x2b, 0x3b, 0x33, 0x39, 0xed, 0xa3, 0x27, 0x98, 0xdc, 0x55, 0x24, 0x39,
+	0x07, 0x2f, 0x9f, 0x9c, 0x9f, 0x61, 0x43, 0x30, 0xd9, 0x55, 0x46, 0x1b, 0x2a, 0x29, 0xb0, 0x29,
+	0x98, 0x74, 0x14, 0x49, 0x9a, 0x89, 0x46, 0x09, 0xee, 0x0a, 0x26, 0x99, 0x22, 0x49, 0xbe, 0x49,
+	0x12, 0x4e, 0xd1, 0x17, 0x4c, 0xfa, 0xca, 0x68, 0xf3, 0x05, 0x45, 0x86, 0x2d, 0xc1, 0x64, 0x4b,
+	0x91, 0x24, 0x2a, 0x0a, 0x8b, 0x10, 0x41, 0x30, 0xd9, 0x51, 0x46, 0xf3, 0xaf, 0xc1, 0x9b, 0xc5,
+	0x69, 0x84, 0x6d, 0xc1, 0xe4, 0x5e, 0x1f, 0x7b, 0xd5, 0x9e, 0xd8, 0xcd, 0xf5, 0x7e, 0x89, 0xd3,
+	0x48, 0x19, 0x8a, 0xe8, 0x65, 0x98, 0x15, 0xd8, 0x11, 0x4c, 0xb6, 0xeb, 0xe9, 0x5f, 0xc3, 0xac,
+	0x50, 0x86, 0xe2, 0xaf, 0xa0, 0x95, 0x84, 0x7f, 0xde, 0xdd, 0x1a, 0xcb, 0x27, 0xdb, 0x2d, 0x03,
+	0x47, 0x32, 0xe5, 0x13, 0x4e, 0x23, 0xfe, 0x15, 0xb8, 0xa3, 0x70, 0x8a, 0x7b, 0x82, 0x49, 0xe8,
+	0x3f, 0xae, 0x33, 0x0d, 0xc2, 0xa9, 0x22, 0x86, 0x76, 0x15, 0xa7, 0x45, 0x8e, 0x5d, 0xe1, 0xca,
+	0x86, 0x32, 0x9a, 0x3f, 0x83, 0xf6, 0x32, 0x1c, 0xcf, 0x74, 0x74, 0x6b, 0xfe, 0xda, 0x17, 0xae,
+	0xdc, 0x1f, 0x38, 0x5d, 0xa6, 0xc0, 0x4e, 0x5f, 0xdd, 0x87, 0xa2, 0x51, 0x92, 0x23, 0x17, 0xae,
+	0x64, 0x55, 0xe8, 0x87, 0x51, 0x92, 0xf3, 0xe7, 0xd0, 0x29, 0x21, 0x6a, 0x6a, 0x8e, 0x9f, 0x0a,
+	0x57, 0xfa, 0x86, 0x2a, 0xcd, 0x3f, 0xd2, 0xb4, 0x39, 0xb8, 0x22, 0xcb, 0xf1, 0xa1, 0x70, 0x65,
+	0x4b, 0x19, 0xcd, 0x1f, 0x42, 0x83, 0x5a, 0x9c, 0xe3, 0x81, 0x70, 0x65, 0x47, 0xd9, 0x01, 0xef,
+	0x41, 0x83, 0xfa, 0x91, 0xe3, 0x23, 0xe1, 0x6e, 0xed, 0xa1, 0xc5, 0xa8, 0xe5, 0x71, 0xa1, 0xe7,
+	0xf8, 0x58, 0xb8, 0x12, 0xea, 0xf1, 0xab, 0x42, 0xcf, 0x95, 0xa1, 0xf8, 0x0b, 0x68, 0x8e, 0x17,
+	0x2b, 0xda, 0xf3, 0x67, 0xa6, 0xfc, 0xd3, 0x3a, 0xfe, 0x7b, 0x43, 0x0c, 0xd3, 0x22, 0xbb, 0x53,
+	0x25, 0xce, 0xcf, 0xa0, 0x31, 0x4a, 0x16, 0xa3, 0x1c, 0x03, 0xe3, 0x3b, 0xaa, 0x6d, 0x39, 0x01,
+	0xd6, 0x66, 0x61, 0x72, 0xd9, 0xb6, 0x3c, 0xd9, 0xec, 0x32, 0x0d, 0x2a, 0x5d, 0x06, 0xe6, 0xdf,
+	0x82, 0x4f, 0x7b, 0xbb, 0x9d, 0x87, 0x4b, 0xfc, 0xdc, 0x18, 0xc5, 0xa6, 0x2e, 0xbc, 0x0e, 0x97,
+	0xd6, 0xba, 0xbb, 0xb4, 0x23, 0x5a, 0x92, 0xa2, 0x98, 0xe3, 0xe1, 0xe6, 0x25, 0x29, 0xb1, 0x1f,
+	0x96, 0x34, 0x30, 0x47, 0x68, 0xa6, 0xab, 0xf9, 0x48, 0x67, 0x78, 0x44, 0x77, 0xf4, 0x72, 0x47,
+	0x95, 0x63, 0xce, 0xc1, 0xcd, 0xc2, 0xf7, 0xf8, 0x94, 0xee, 0xc4, 0xe5, 0x8e, 0xa2, 0x01, 0xef,
+	0x43, 0x73, 0xfc, 0x6e, 0x91, 0xeb, 0x14, 0xc5, 0xf6, 0xd4, 0x52, 0x1d, 0x4b, 0xf2, 0x1e, 0x78,
+	0xcb, 0x78, 0x3c, 0xc3, 0x2f, 0x4c, 0x64, 0xeb, 0x1d, 0xf1, 0x78, 0x76, 0xb9, 0xa3, 0x0c, 0xc7,
+	0x4f, 0xc0, 0xcf, 0x75, 0x51, 0xc4, 0xe9, 0x34, 0xc7, 0x67, 0x66, 0x95, 0x83, 0x7b, 0x9e, 0x9b,
+	0xf2, 0x4f, 0xf5, 0x11, 0xe3, 0x17, 0xb0, 0xfb, 0x5e, 0xc7, 0xd3, 0x77, 0x45, 0x8e, 0x5f, 0x6e,
+	0x6e, 0xdb, 0xef, 0x16, 0x29, 0xdb, 0x56, 0x1a, 0xf8, 0x09, 0x78, 0xb9, 0xd6, 0x29, 0x3e, 0x37,
+	0xc6, 0xc3, 0x3a, 0xe3, 0x8d, 0xd6, 0xa9, 0x75, 0x19, 0x34, 0xf8, 0x19, 0x3c, 0x73, 0x17, 0x39,
+	0x78, 0x69, 0x38, 0xd7, 0xe6, 0x75, 0x6b, 0x29, 0xa3, 0xd7, 0x29, 0x76, 0xfe, 0x57, 0x8a, 0x83,
+	0x27, 0xe0, 0x0e, 0xc2, 0x29, 0x5d, 0x89, 0x24, 0x1c, 0xe9, 0x04, 0x1f, 0x98, 0x5a, 0x76, 0x10,
+	0x3c, 0x02, 0x8f, 0x22, 0xcc, 0xf7, 0xc0, 0x89, 0x23, 0x44, 0xf3, 0x88, 0x3a, 0x71, 0x14, 0xbc,
+	0x82, 0x76, 0x25, 0xaa, 0xf4, 0xa0, 0xcd, 0xf4, 0x5d, 0xf9, 0x19, 0x24, 0xa9, 0xdc, 0x1f, 0x61,
+	0xb2, 0xd2, 0xe6, 0x99, 0x75, 0x95, 0x1d, 0x5c, 0x38, 0x2f, 0x59, 0xf0, 0x12, 0x60, 0x9d, 0xd6,
+	0xaa, 0xb3, 0x51, 0xe3, 0xec, 0xfc, 0xcb, 0xb9, 0x4e, 0x6c, 0xd5, 0xe9, 0xd7, 0x38, 0x5b, 0x55,
+	0xe7, 0x5b, 0xe8, 0x54, 0x23, 0x5b, 0xf5, 0x7a, 0xd6, 0xdb, 0xab, 0x7a, 0xb7, 0x76, 0x6d, 0x5d,
+	0x55, 0x01, 0xac, 0xe3, 0x5c, 0xad, 0xb9, 0x5f, 0x53, 0x73, 0xdb, 0x0b, 0x5e, 0xa9, 0x19, 0x80,
+	0x47, 0x59, 0xa4, 0x93, 0x35, 0x99, 0x3d, 0xb6, 0x27, 0x4b, 0x3a, 0xb8, 0x80, 0x4e, 0x35, 0x41,
+	0xd5, 0x15, 0x9b, 0x35, 0x1d, 0x70, 0xaa, 0x75, 0x5f, 0x40, 0xeb, 0x63, 0x88, 0xfe, 0xeb, 0xb8,
+	0xfc, 0x8a, 0xf1, 0xf8, 0x04, 0x3c, 0xfa, 0x46, 0xee, 0x83, 0x77, 0xfd, 0xe6, 0x7a, 0xd8, 0xdd,
+	0x21, 0x75, 0xf3, 0xe6, 0xf5, 0xb0, 0xcb, 0xf8, 0x01, 0xf8, 0xd7, 0xc3, 0x9f, 0xbe, 0x7b, 0x7b,
+	0xf5, 0xdb, 0xb0, 0xfb, 0xf7, 0x87, 0x1f, 0x1b, 0xf8, 0xe6, 0x8e, 0xc6, 0x63, 0xfd, 0xcf, 0x00,
+	0x68, 0x1e, 0x0c, 0xdd, 0xd4, 0x07, 0x00, 0x00,
+}
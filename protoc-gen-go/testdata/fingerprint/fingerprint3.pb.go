@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: fingerprint/fingerprint3.proto
+
+package fingerprint
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type Settings struct {
+	Name    string               `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Data    []byte               `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Weight  float32              `protobuf:"fixed32,3,opt,name=weight" json:"weight,omitempty"`
+	Weights []float32            `protobuf:"fixed32,4,rep,packed,name=weights" json:"weights,omitempty"`
+	Ids     []int64              `protobuf:"varint,5,rep,packed,name=ids" json:"ids,omitempty"`
+	Child   *Settings            `protobuf:"bytes,6,opt,name=child" json:"child,omitempty"`
+	ByName  map[string]*Settings `protobuf:"bytes,7,rep,name=by_name,json=byName" json:"by_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Blobs   map[string][]byte    `protobuf:"bytes,8,rep,name=blobs" json:"blobs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Datas   [][]byte             `protobuf:"bytes,9,rep,name=datas,proto3" json:"datas,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//	*Settings_Text
+	//	*Settings_Raw
+	//	*Settings_Nested
+	Value isSettings_Value `protobuf_oneof:"value"`
+}
+
+func (m *Settings) Reset()                    { *m = Settings{} }
+func (m *Settings) String() string            { return proto.CompactTextString(m) }
+func (*Settings) ProtoMessage()               {}
+func (*Settings) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+type isSettings_Value interface{ isSettings_Value() }
+
+type Settings_Text struct {
+	Text string `protobuf:"bytes,10,opt,name=text,oneof"`
+}
+type Settings_Raw struct {
+	Raw []byte `protobuf:"bytes,11,opt,name=raw,proto3,oneof"`
+}
+type Settings_Nested struct {
+	Nested *Settings `protobuf:"bytes,12,opt,name=nested,oneof"`
+}
+
+func (*Settings_Text) isSettings_Value()   {}
+func (*Settings_Raw) isSettings_Value()    {}
+func (*Settings_Nested) isSettings_Value() {}
+
+func (m *Settings) GetValue() isSettings_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Settings) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Settings) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Settings) GetWeight() float32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *Settings) GetWeights() []float32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *Settings) GetIds() []int64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *Settings) GetChild() *Settings {
+	if m != nil {
+		return m.Child
+	}
+	return nil
+}
+
+func (m *Settings) GetByName() map[string]*Settings {
+	if m != nil {
+		return m.ByName
+	}
+	return nil
+}
+
+func (m *Settings) GetBlobs() map[string][]byte {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *Settings) GetDatas() [][]byte {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+func (m *Settings) GetText() string {
+	if x, ok := m.GetValue().(*Settings_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (m *Settings) GetRaw() []byte {
+	if x, ok := m.GetValue().(*Settings_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+func (m *Settings) GetNested() *Settings {
+	if x, ok := m.GetValue().(*Settings_Nested); ok {
+		return x.Nested
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Settings) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Settings_OneofMarshaler, _Settings_OneofUnmarshaler, _Settings_OneofSizer, []interface{}{
+		(*Settings_Text)(nil),
+		(*Settings_Raw)(nil),
+		(*Settings_Nested)(nil),
+	}
+}
+
+func _Settings_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Settings)
+	// value
+	switch x := m.Value.(type) {
+	case *Settings_Text:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Text)
+	case *Settings_Raw:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.Raw)
+	case *Settings_Nested:
+		b.EncodeVarint(12<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Nested); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Settings.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Settings_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Settings)
+	switch tag {
+	case 10: // value.text
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &Settings_Text{x}
+		return true, err
+	case 11: // value.raw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Value = &Settings_Raw{x}
+		return true, err
+	case 12: // value.nested
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Settings)
+		err := b.DecodeMessage(msg)
+		m.Value = &Settings_Nested{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Settings_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Settings)
+	// value
+	switch x := m.Value.(type) {
+	case *Settings_Text:
+		n += proto.SizeVarint(10<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Text)))
+		n += len(x.Text)
+	case *Settings_Raw:
+		n += proto.SizeVarint(11<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Raw)))
+		n += len(x.Raw)
+	case *Settings_Nested:
+		s := proto.Size(x.Nested)
+		n += proto.SizeVarint(12<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*Settings)(nil), "fingerprint.Settings")
+}
+
+// Fingerprint returns the FNV-1a hash of the canonical encoding of m,
+// the number proto.HashCanonical(m, fnv.New64a()) returns read big-endian,
+// without marshaling m.
+func (m *Settings) Fingerprint() uint64 {
+	return m.fingerprintTo(proto.FingerprintBasis)
+}
+
+// fingerprintTo adds the canonical encoding of m to the fingerprint h.
+func (m *Settings) fingerprintTo(h uint64) uint64 {
+	if m == nil {
+		return h
+	}
+	if len(m.Name) > 0 {
+		h = proto.FingerprintVarint(h, 1<<3|proto.WireBytes)
+		h = proto.FingerprintString(h, m.Name)
+	}
+	if len(m.Data) > 0 {
+		h = proto.FingerprintVarint(h, 2<<3|proto.WireBytes)
+		h = proto.FingerprintBytes(h, m.Data)
+	}
+	if math.Float32bits(m.Weight) != 0 {
+		h = proto.FingerprintVarint(h, 3<<3|proto.WireFixed32)
+		h = proto.FingerprintFixed32(h, math.Float32bits(m.Weight))
+	}
+	if len(m.Weights) > 0 {
+		l := len(m.Weights) * 4
+		h = proto.FingerprintVarint(h, 4<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(l))
+		for _, v := range m.Weights {
+			h = proto.FingerprintFixed32(h, math.Float32bits(v))
+		}
+	}
+	if len(m.Ids) > 0 {
+		l := 0
+		for _, v := range m.Ids {
+			l += proto.SizeVarint(uint64(v))
+		}
+		h = proto.FingerprintVarint(h, 5<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(l))
+		for _, v := range m.Ids {
+			h = proto.FingerprintVarint(h, uint64(v))
+		}
+	}
+	if m.Child != nil {
+		h = proto.FingerprintVarint(h, 6<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(m.Child.canonicalSize()))
+		h = m.Child.fingerprintTo(h)
+	}
+	if len(m.ByName) > 0 {
+		keys := make([]string, 0, len(m.ByName))
+		for k := range m.ByName {
+			keys = append(keys, k)
+		}
+		proto.SortStringKeys(keys)
+		for _, k := range keys {
+			v := m.ByName[k]
+			e := 1 + proto.SizeVarint(uint64(len(k))) + len(k)
+			if v != nil {
+				l := v.canonicalSize()
+				e += 1 + proto.SizeVarint(uint64(l)) + l
+			}
+			h = proto.FingerprintVarint(h, 7<<3|proto.WireBytes)
+			h = proto.FingerprintVarint(h, uint64(e))
+			h = proto.FingerprintVarint(h, 1<<3|proto.WireBytes)
+			h = proto.FingerprintString(h, k)
+			if v != nil {
+				h = proto.FingerprintVarint(h, 2<<3|proto.WireBytes)
+				h = proto.FingerprintVarint(h, uint64(v.canonicalSize()))
+				h = v.fingerprintTo(h)
+			}
+		}
+	}
+	if len(m.Blobs) > 0 {
+		keys := make([]string, 0, len(m.Blobs))
+		for k := range m.Blobs {
+			keys = append(keys, k)
+		}
+		proto.SortStringKeys(keys)
+		for _, k := range keys {
+			v := m.Blobs[k]
+			e := 1 + proto.SizeVarint(uint64(len(k))) + len(k)
+			if len(v) > 0 {
+				e += 1 + proto.SizeVarint(uint64(len(v))) + len(v)
+			}
+			h = proto.FingerprintVarint(h, 8<<3|proto.WireBytes)
+			h = proto.FingerprintVarint(h, uint64(e))
+			h = proto.FingerprintVarint(h, 1<<3|proto.WireBytes)
+			h = proto.FingerprintString(h, k)
+			if len(v) > 0 {
+				h = proto.FingerprintVarint(h, 2<<3|proto.WireBytes)
+				h = proto.FingerprintBytes(h, v)
+			}
+		}
+	}
+	for _, v := range m.Datas {
+		h = proto.FingerprintVarint(h, 9<<3|proto.WireBytes)
+		h = proto.FingerprintBytes(h, v)
+	}
+	switch x := m.Value.(type) {
+	case *Settings_Text:
+		h = proto.FingerprintVarint(h, 10<<3|proto.WireBytes)
+		h = proto.FingerprintString(h, x.Text)
+	case *Settings_Raw:
+		h = proto.FingerprintVarint(h, 11<<3|proto.WireBytes)
+		h = proto.FingerprintBytes(h, x.Raw)
+	case *Settings_Nested:
+		h = proto.FingerprintVarint(h, 12<<3|proto.WireBytes)
+		h = proto.FingerprintVarint(h, uint64(x.Nested.canonicalSize()))
+		h = x.Nested.fingerprintTo(h)
+	}
+	return h
+}
+
+// canonicalSize returns the size of the canonical encoding of m.
+func (m *Settings) canonicalSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	if len(m.Name) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.Name))) + len(m.Name)
+	}
+	if len(m.Data) > 0 {
+		n += 1 + proto.SizeVarint(uint64(len(m.Data))) + len(m.Data)
+	}
+	if math.Float32bits(m.Weight) != 0 {
+		n += 1 + 4
+	}
+	if len(m.Weights) > 0 {
+		l := len(m.Weights) * 4
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if len(m.Ids) > 0 {
+		l := 0
+		for _, v := range m.Ids {
+			l += proto.SizeVarint(uint64(v))
+		}
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	if m.Child != nil {
+		l := m.Child.canonicalSize()
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	for k, v := range m.ByName {
+		e := 1 + proto.SizeVarint(uint64(len(k))) + len(k)
+		if v != nil {
+			l := v.canonicalSize()
+			e += 1 + proto.SizeVarint(uint64(l)) + l
+		}
+		n += 1 + proto.SizeVarint(uint64(e)) + e
+	}
+	for k, v := range m.Blobs {
+		e := 1 + proto.SizeVarint(uint64(len(k))) + len(k)
+		if len(v) > 0 {
+			e += 1 + proto.SizeVarint(uint64(len(v))) + len(v)
+		}
+		n += 1 + proto.SizeVarint(uint64(e)) + e
+	}
+	for _, v := range m.Datas {
+		n += 1 + proto.SizeVarint(uint64(len(v))) + len(v)
+	}
+	switch x := m.Value.(type) {
+	case *Settings_Text:
+		n += 1 + proto.SizeVarint(uint64(len(x.Text))) + len(x.Text)
+	case *Settings_Raw:
+		n += 1 + proto.SizeVarint(uint64(len(x.Raw))) + len(x.Raw)
+	case *Settings_Nested:
+		l := x.Nested.canonicalSize()
+		n += 1 + proto.SizeVarint(uint64(l)) + l
+	}
+	return n
+}
+
+func init() { proto.RegisterFile("fingerprint/fingerprint3.proto", fileDescriptor1) }
+
+var fileDescriptor1 = []byte{
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4d, 0x6b, 0xea, 0x40,
+	0x14, 0x35, 0x99, 0x7c, 0xe8, 0x4d, 0x16, 0x8f, 0x8b, 0xef, 0x71, 0x71, 0xf1, 0x98, 0xf7, 0x56,
+	0x03, 0x85, 0x08, 0x0a, 0x45, 0x5c, 0x0a, 0x05, 0x57, 0xa5, 0x4c, 0x7f, 0x40, 0x49, 0x9a, 0xa9,
+	0x86, 0x6a, 0x94, 0x64, 0x5a, 0x9b, 0x1f, 0xd3, 0xff, 0x5a, 0x66, 0x12, 0x69, 0x16, 0xd6, 0xdd,
+	0x39, 0x37, 0xe7, 0x5c, 0xce, 0x3d, 0x19, 0xf8, 0xfb, 0x52, 0x94, 0x1b, 0x55, 0x1d, 0xab, 0xa2,
+	0xd4, 0xd3, 0x1e, 0x9e, 0x27, 0xc7, 0xea, 0xa0, 0x0f, 0x18, 0xf5, 0x66, 0xff, 0x3f, 0x3d, 0x18,
+	0x3e, 0x2a, 0xad, 0x8b, 0x72, 0x53, 0x23, 0x82, 0x57, 0xa6, 0x7b, 0x45, 0x0e, 0x77, 0xc4, 0x48,
+	0x5a, 0x6c, 0x66, 0x79, 0xaa, 0x53, 0x72, 0xb9, 0x23, 0x62, 0x69, 0x31, 0xfe, 0x81, 0xe0, 0xa4,
+	0x8a, 0xcd, 0x56, 0x13, 0xe3, 0x8e, 0x70, 0x65, 0xc7, 0x90, 0x20, 0x6c, 0x51, 0x4d, 0x1e, 0x67,
+	0xc2, 0x95, 0x67, 0x8a, 0xbf, 0x80, 0x15, 0x79, 0x4d, 0x3e, 0x67, 0x82, 0x49, 0x03, 0xf1, 0x06,
+	0xfc, 0xe7, 0x6d, 0xb1, 0xcb, 0x29, 0xe0, 0x8e, 0x88, 0x66, 0xbf, 0x93, 0x5e, 0xaa, 0xe4, 0x9c,
+	0x48, 0xb6, 0x1a, 0x5c, 0x42, 0x98, 0x35, 0x4f, 0x36, 0x5b, 0xc8, 0x99, 0x88, 0x66, 0xff, 0x2e,
+	0xca, 0x93, 0x55, 0x73, 0x9f, 0xee, 0xd5, 0x5d, 0xa9, 0xab, 0x46, 0x06, 0x99, 0x25, 0x78, 0x0b,
+	0x7e, 0xb6, 0x3b, 0x64, 0x35, 0x0d, 0xad, 0x93, 0xff, 0xe0, 0x34, 0x92, 0xd6, 0xd8, 0xca, 0x71,
+	0x0c, 0xbe, 0x39, 0xb6, 0xa6, 0x11, 0x67, 0x22, 0x96, 0x2d, 0xc1, 0x31, 0x78, 0x5a, 0x7d, 0x68,
+	0x02, 0x53, 0xd1, 0x7a, 0x20, 0x2d, 0x43, 0x04, 0x56, 0xa5, 0x27, 0x8a, 0x4c, 0x47, 0xeb, 0x81,
+	0x34, 0x04, 0xa7, 0x10, 0x94, 0xaa, 0xd6, 0x2a, 0xa7, 0xf8, 0xca, 0x85, 0xeb, 0x81, 0xec, 0x64,
+	0x93, 0x07, 0x88, 0x7a, 0xf9, 0x4d, 0x65, 0xaf, 0xaa, 0xe9, 0xfe, 0x85, 0x81, 0xa6, 0xb2, 0xf7,
+	0x74, 0xf7, 0xa6, 0xc8, 0xbd, 0xb2, 0x50, 0xb6, 0x9a, 0xa5, 0xbb, 0x70, 0x26, 0x0b, 0x80, 0xef,
+	0xbb, 0x2e, 0x2c, 0x1c, 0xf7, 0x17, 0xc6, 0x3d, 0xe7, 0x2a, 0xec, 0xbe, 0x64, 0x81, 0x7d, 0x33,
+	0xf3, 0xaf, 0x01, 0x00, 0xed, 0xff, 0x4a, 0x48, 0x55, 0x02, 0x00, 0x00,
+}
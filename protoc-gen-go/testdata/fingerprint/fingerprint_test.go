@@ -0,0 +1,252 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package fingerprint
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func settings() *Settings {
+	return &Settings{
+		Name:    "s",
+		Data:    []byte{1},
+		Weight:  0.5,
+		Weights: []float32{1, math.Float32frombits(0x80000000)},
+		Ids:     []int64{-1, 300},
+		Child:   &Settings{Name: "child"},
+		ByName:  map[string]*Settings{"a": {Name: "a"}, "b": nil, "c": {}},
+		Blobs:   map[string][]byte{"x": {1, 2}, "y": {}, "z": nil},
+		Datas:   [][]byte{{}, {3}},
+		Value:   &Settings_Nested{&Settings{Name: "t"}},
+	}
+}
+
+func record() *Record {
+	return &Record{
+		I32:         proto.Int32(-1),
+		S64:         proto.Int64(-2),
+		U64:         proto.Uint64(1 << 63),
+		F32:         proto.Uint32(4),
+		Sf64:        proto.Int64(-5),
+		Flt:         proto.Float32(6.5),
+		Dbl:         proto.Float64(math.Inf(-1)),
+		Flag:        proto.Bool(false),
+		Str:         proto.String("str"),
+		Data:        []byte{},
+		Kind:        Record_NEGATIVE.Enum(),
+		Part:        &Record_Part{Name: proto.String("part"), Parts: []*Record_Part{{}}},
+		LazyPart:    &Record_Part{Name: proto.String("lazy")},
+		Bag:         &Record_Bag{Label: proto.String("bag")},
+		Ints:        []int32{1, -1},
+		PackedInts:  []int32{-64, 63, math.MinInt32},
+		PackedDbls:  []float64{1, math.NaN()},
+		PackedFlags: []bool{true, false},
+		Strs:        []string{"", "a"},
+		Datas:       [][]byte{nil, {1}},
+		Parts:       []*Record_Part{{Name: proto.String("p")}, {}},
+		Item:        []*Record_Item{{Id: proto.Int32(1)}, {}},
+		Counts:      map[string]int64{"a": 1, "b": 0, "": -1},
+		Blobs:       map[int32][]byte{-1: {1}, 0: {}, 1: nil},
+		Flags:       map[bool]string{true: "t", false: ""},
+		PartMap:     map[uint64]*Record_Part{1: {Name: proto.String("1")}, 2: {}, 3: nil},
+		Kinds:       map[int32]Record_Kind{-1: Record_SOME, 1: Record_NEGATIVE},
+		Choice:      &Record_Pick_{&Record_Pick{Pick: proto.String("pick")}},
+		Settings:    settings(),
+		Weights:     map[uint64]float32{1 << 40: 1, 0: 0},
+		Seen:        map[string]bool{"a": true, "b": false},
+	}
+}
+
+// variants returns messages that differ from record or settings in one
+// way each.
+func variants() []proto.Message {
+	var ms []proto.Message
+	add := func(f func(m *Record)) {
+		m := record()
+		f(m)
+		ms = append(ms, m)
+	}
+	add(func(m *Record) {})
+	add(func(m *Record) { *m = Record{} })
+	add(func(m *Record) { m.I32 = nil })
+	add(func(m *Record) { m.I32 = proto.Int32(0) })
+	add(func(m *Record) { m.S64 = proto.Int64(math.MinInt64) })
+	add(func(m *Record) { m.Flt = proto.Float32(float32(math.Copysign(0, -1))) })
+	add(func(m *Record) { m.Dbl = proto.Float64(math.NaN()) })
+	add(func(m *Record) { m.Flag = nil })
+	add(func(m *Record) { m.Data = nil })
+	add(func(m *Record) { m.Kind = Record_NONE.Enum() })
+	add(func(m *Record) { m.Part = &Record_Part{} })
+	add(func(m *Record) { m.Part.Parts = nil })
+	add(func(m *Record) { m.LazyPart = nil })
+	add(func(m *Record) { m.Bag = &Record_Bag{} })
+	add(func(m *Record) { m.Ints = nil })
+	add(func(m *Record) { m.PackedInts = []int32{} })
+	add(func(m *Record) { m.PackedDbls = []float64{0} })
+	add(func(m *Record) { m.PackedFlags = nil })
+	add(func(m *Record) { m.Strs = []string{"a", ""} })
+	add(func(m *Record) { m.Datas = [][]byte{{}} })
+	add(func(m *Record) { m.Parts = nil })
+	add(func(m *Record) { m.Item = []*Record_Item{{}} })
+	add(func(m *Record) { m.Counts = nil })
+	add(func(m *Record) { m.Counts["b"] = 1 })
+	add(func(m *Record) { delete(m.Blobs, 1) })
+	add(func(m *Record) { m.Blobs[0] = nil })
+	add(func(m *Record) { delete(m.Flags, false) })
+	add(func(m *Record) { m.PartMap[3] = &Record_Part{} })
+	add(func(m *Record) { m.Kinds[0] = Record_NONE })
+	add(func(m *Record) { m.Choice = nil })
+	add(func(m *Record) { m.Choice = &Record_Number{0} })
+	add(func(m *Record) { m.Choice = &Record_Raw{nil} })
+	add(func(m *Record) { m.Choice = &Record_Chosen{&Record_Part{}} })
+	add(func(m *Record) { m.Choice = &Record_Pick_{&Record_Pick{}} })
+	add(func(m *Record) { m.Settings = nil })
+	add(func(m *Record) { m.Settings = &Settings{} })
+	add(func(m *Record) { m.Settings.Weight = 0 })
+	add(func(m *Record) { m.Settings.Blobs["y"] = []byte{0} })
+	add(func(m *Record) { m.Weights[0] = float32(math.Copysign(0, -1)) })
+	add(func(m *Record) { m.Seen["b"] = true })
+
+	addSettings := func(f func(s *Settings)) {
+		s := settings()
+		f(s)
+		ms = append(ms, s)
+	}
+	addSettings(func(s *Settings) {})
+	addSettings(func(s *Settings) { *s = Settings{} })
+	addSettings(func(s *Settings) { s.Name = "" })
+	addSettings(func(s *Settings) { s.Data = nil })
+	addSettings(func(s *Settings) { s.Weight = float32(math.Copysign(0, -1)) })
+	addSettings(func(s *Settings) { s.Weights = nil })
+	addSettings(func(s *Settings) { s.Ids = []int64{0} })
+	addSettings(func(s *Settings) { s.Child = &Settings{} })
+	addSettings(func(s *Settings) { s.ByName["b"] = &Settings{} })
+	addSettings(func(s *Settings) { s.Blobs["y"] = nil })
+	addSettings(func(s *Settings) { s.Value = &Settings_Text{""} })
+	addSettings(func(s *Settings) { s.Value = &Settings_Raw{[]byte{}} })
+	addSettings(func(s *Settings) { s.Value = &Settings_Nested{&Settings{}} })
+	return ms
+}
+
+type fingerprinter interface {
+	proto.Message
+	Fingerprint() uint64
+}
+
+// hashCanonical returns the fingerprint of m as HashCanonical computes it.
+func hashCanonical(t *testing.T, m proto.Message) uint64 {
+	b, err := proto.HashCanonical(m, fnv.New64a())
+	if err != nil {
+		t.Fatalf("HashCanonical(%v): %v", m, err)
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+func TestFingerprint(t *testing.T) {
+	for i, m := range variants() {
+		got := m.(fingerprinter).Fingerprint()
+		if want := hashCanonical(t, m); got != want {
+			t.Errorf("variant %d: Fingerprint() = %#x, HashCanonical = %#x\n%v", i, got, want, m)
+		}
+	}
+}
+
+func TestFingerprintUnmarshaled(t *testing.T) {
+	for i, m := range variants() {
+		b, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatalf("variant %d: Marshal: %v", i, err)
+		}
+		// Unrecognized fields do not count.
+		b = append(b, 0xf8, 0x07, 1) // field 127, varint
+		u := proto.Clone(m)
+		if err := proto.Unmarshal(b, u); err != nil {
+			t.Fatalf("variant %d: Unmarshal: %v", i, err)
+		}
+		if got, want := u.(fingerprinter).Fingerprint(), m.(fingerprinter).Fingerprint(); got != want {
+			t.Errorf("variant %d: Fingerprint() = %#x after a round trip, want %#x", i, got, want)
+		}
+	}
+}
+
+func TestFingerprintMapOrder(t *testing.T) {
+	want := record().Fingerprint()
+	for i := 0; i < 20; i++ {
+		m := record()
+		m.Counts = make(map[string]int64)
+		for j := 0; j < 50; j++ {
+			m.Counts[string(rune('a'+j))] = int64(j)
+		}
+		n := record()
+		n.Counts = make(map[string]int64)
+		for j := 49; j >= 0; j-- {
+			n.Counts[string(rune('a'+j))] = int64(j)
+		}
+		if m.Fingerprint() != n.Fingerprint() {
+			t.Fatal("Fingerprint depends on the order of map insertion")
+		}
+		if m.Fingerprint() == want {
+			t.Fatal("Fingerprint ignores map entries")
+		}
+	}
+}
+
+func TestFingerprintNil(t *testing.T) {
+	var m *Record
+	if got := m.Fingerprint(); got != proto.FingerprintBasis {
+		t.Errorf("nil Fingerprint() = %#x, want %#x", got, proto.FingerprintBasis)
+	}
+	if got, want := new(Record).Fingerprint(), m.Fingerprint(); got != want {
+		t.Errorf("empty Fingerprint() = %#x, want %#x", got, want)
+	}
+}
+
+func BenchmarkFingerprint(b *testing.B) {
+	m := record()
+	for i := 0; i < b.N; i++ {
+		m.Fingerprint()
+	}
+}
+
+func BenchmarkHashCanonical(b *testing.B) {
+	m := record()
+	h := fnv.New64a()
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		proto.HashCanonical(m, h)
+	}
+}
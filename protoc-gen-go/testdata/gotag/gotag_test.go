@@ -0,0 +1,63 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package gotag
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGoTags(t *testing.T) {
+	for _, test := range []struct {
+		v          interface{}
+		field, key string
+		want       string
+	}{
+		{User{}, "UserId", "db", "user_id"},
+		{User{}, "Email", "db", "email"},
+		{User{}, "Email", "validate", "required,email"},
+		{User{}, "Email", "json", "email,omitempty"},
+		{User{}, "Labels", "db", "-"},
+		{User_Phone{}, "Phone", "db", "phone"},
+		{User{}, "Nickname", "db", ""},
+		{User_Pager{}, "Pager", "db", ""},
+	} {
+		f, ok := reflect.TypeOf(test.v).FieldByName(test.field)
+		if !ok {
+			t.Errorf("%T has no field %s", test.v, test.field)
+			continue
+		}
+		if got := f.Tag.Get(test.key); got != test.want {
+			t.Errorf("%T.%s tag %s = %q, want %q", test.v, test.field, test.key, got, test.want)
+		}
+	}
+}
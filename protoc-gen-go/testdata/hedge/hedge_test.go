@@ -0,0 +1,94 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package hedge
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ccsnake/carno/client"
+)
+
+// slow is a CacheClient whose first call to each method takes a second,
+// or until it is canceled, and whose later calls return at once.
+type slow struct {
+	calls int32
+}
+
+func (s *slow) call(ctx context.Context, in *Key) (*Value, error) {
+	if atomic.AddInt32(&s.calls, 1) == 1 {
+		select {
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		return &Value{Value: "slow " + in.Key}, nil
+	}
+	return &Value{Value: "fast " + in.Key}, nil
+}
+
+func (s *slow) Get(ctx context.Context, in *Key, opts ...client.CallOption) (*Value, error) {
+	return s.call(ctx, in)
+}
+
+func (s *slow) Touch(ctx context.Context, in *Key, opts ...client.CallOption) (*Value, error) {
+	return s.call(ctx, in)
+}
+
+func (s *slow) Incr(ctx context.Context, in *Key, opts ...client.CallOption) (*Value, error) {
+	return s.call(ctx, in)
+}
+
+func TestHedgingClient(t *testing.T) {
+	for _, test := range []struct {
+		method string
+		call   func(CacheClient) (*Value, error)
+		want   string
+		calls  int32
+	}{
+		{"Get", func(c CacheClient) (*Value, error) { return c.Get(context.Background(), &Key{Key: "k"}) }, "fast k", 2},
+		{"Touch", func(c CacheClient) (*Value, error) { return c.Touch(context.Background(), &Key{Key: "k"}) }, "fast k", 2},
+		// Incr is not idempotent, so it is not hedged.
+		{"Incr", func(c CacheClient) (*Value, error) { return c.Incr(context.Background(), &Key{Key: "k"}) }, "slow k", 1},
+	} {
+		s := new(slow)
+		v, err := test.call(NewCacheHedgingClient(s, 10*time.Millisecond))
+		if err != nil || v.Value != test.want {
+			t.Errorf("%s = %v, %v; want %q", test.method, v, err, test.want)
+		}
+		if got := atomic.LoadInt32(&s.calls); got != test.calls {
+			t.Errorf("%s made %d calls, want %d", test.method, got, test.calls)
+		}
+	}
+}
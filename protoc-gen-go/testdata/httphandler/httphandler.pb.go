@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: httphandler/httphandler.proto
+
+/*
+Package httphandler is a generated protocol buffer package.
+
+Package httphandler tests the New<Service>HTTPHandler functions the
+carno plugin generates with carno:http=true.
+
+It is generated from these files:
+	httphandler/httphandler.proto
+
+It has these top-level messages:
+	Chunk
+	Ack
+*/
+package httphandler
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+	http "net/http"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	httprpc "github.com/ccsnake/protobuf/httprpc"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Chunk struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *Chunk) Reset()                    { *m = Chunk{} }
+func (m *Chunk) String() string            { return proto.CompactTextString(m) }
+func (*Chunk) ProtoMessage()               {}
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Chunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Chunk) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type Ack struct {
+	Size int64  `protobuf:"varint,1,opt,name=size" json:"size,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *Ack) Reset()                    { *m = Ack{} }
+func (m *Ack) String() string            { return proto.CompactTextString(m) }
+func (*Ack) ProtoMessage()               {}
+func (*Ack) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Ack) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Ack) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Chunk)(nil), "httphandler.Chunk")
+	proto.RegisterType((*Ack)(nil), "httphandler.Ack")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Store service
+type StoreClient interface {
+	Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+	Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+}
+
+type storeClient struct {
+	client.Client
+}
+
+// NewStoreClient creates and starts a client for the Store service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewStoreClient(opts ...client.Option) (StoreClient, error) {
+	c, err := carno1.NewClient("httphandler", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &storeClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Store_callInfo = []*callinfo.CallInfo{
+	{
+		Service:         "httphandler@Store",
+		Method:          "Put",
+		RequestType:     "httphandler.Chunk",
+		ResponseType:    "httphandler.Ack",
+		File:            "httphandler/httphandler.proto",
+		MaxRequestBytes: 64,
+	},
+	{
+		Service:      "httphandler@Store",
+		Method:       "Stat",
+		RequestType:  "httphandler.Chunk",
+		ResponseType: "httphandler.Ack",
+		File:         "httphandler/httphandler.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Store_callInfo...)
+}
+
+func (c *storeClient) Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[0])
+	err := c.Client.Call(ctx, "Store", "Put", in, out, opts...)
+	return out, err
+}
+
+func (c *storeClient) Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[1])
+	err := c.Client.Call(ctx, "Store", "Stat", in, out, opts...)
+	return out, err
+}
+
+// StoreClient returns a StoreClient using the client shared by the services
+// of package httphandler.
+func (a *Httphandler) StoreClient() StoreClient {
+	return &storeClient{Client: a.c}
+}
+
+// Server API for Store service
+type StoreServer interface {
+	Put(context.Context, *Chunk) (*Ack, error)
+	Stat(context.Context, *Chunk) (*Ack, error)
+}
+
+func RegisterStoreServer(srv StoreServer) {
+	callinfo.RegisterServer("httphandler@Store")
+	carno1.HandleService(&_Store_serviceDesc, srv)
+}
+
+// StoreHTTPPathPrefix is the path under which NewStoreHTTPHandler
+// serves each method of Store, as POST StoreHTTPPathPrefix+<Method>.
+const StoreHTTPPathPrefix = "/httphandler.Store/"
+
+// NewStoreHTTPHandler returns a handler serving srv over HTTP, with
+// requests and responses in the binary format or in JSON, for clients that
+// cannot use the carno transport; see package httprpc. Requests are checked
+// as with RegisterStoreServer; streaming methods are not served.
+func NewStoreHTTPHandler(srv StoreServer) http.Handler {
+	return httprpc.NewHandler("httphandler.Store", map[string]httprpc.Method{
+		"Put": {
+			Info: _Store_callInfo[0],
+			Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+				in := new(Chunk)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+				return srv.Put(ctx, in)
+			},
+		},
+		"Stat": {
+			Info: _Store_callInfo[1],
+			Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+				in := new(Chunk)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+				return srv.Stat(ctx, in)
+			},
+		},
+	})
+}
+
+var _Store_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Store",
+	Methods: []string{
+		"Put",
+		"Stat",
+	},
+}
+
+func init() { proto.RegisterFile("httphandler/httphandler.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 176 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x28, 0x29, 0x29,
+	0xc8, 0x48, 0xcc, 0x4b, 0xc9, 0x49, 0x2d, 0xd2, 0x47, 0x62, 0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4,
+	0x0b, 0x71, 0x23, 0x09, 0x49, 0x09, 0x27, 0x27, 0x16, 0xe5, 0xe5, 0xeb, 0xe7, 0x17, 0x94, 0x64,
+	0xe6, 0xe7, 0x15, 0x43, 0x54, 0x28, 0xe9, 0x73, 0xb1, 0x3a, 0x67, 0x94, 0xe6, 0x65, 0x0b, 0x09,
+	0x71, 0xb1, 0xa4, 0x24, 0x96, 0x24, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0x81, 0xd9, 0x20,
+	0xb1, 0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x30, 0x5b, 0x49, 0x97,
+	0x8b, 0xd9, 0x31, 0x19, 0xac, 0xbc, 0x38, 0xb3, 0x2a, 0x15, 0xac, 0x9c, 0x39, 0x08, 0xcc, 0xc6,
+	0xa6, 0xdc, 0x28, 0x83, 0x8b, 0x35, 0xb8, 0x24, 0xbf, 0x28, 0x55, 0xc8, 0x90, 0x8b, 0x39, 0xa0,
+	0xb4, 0x44, 0x48, 0x48, 0x0f, 0xd9, 0x95, 0x60, 0xab, 0xa5, 0x04, 0x50, 0xc4, 0x1c, 0x93, 0xb3,
+	0x95, 0x58, 0x26, 0x6c, 0x92, 0x74, 0x10, 0xd2, 0xe1, 0x62, 0x09, 0x2e, 0x49, 0x24, 0x52, 0x4f,
+	0x12, 0x1b, 0xd8, 0x43, 0xc6, 0x80, 0x01, 0x00, 0x38, 0xbf, 0x03, 0x3d, 0x13, 0x01, 0x00, 0x00,
+}
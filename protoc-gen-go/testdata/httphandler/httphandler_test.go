@@ -0,0 +1,95 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package httphandler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+type server struct{}
+
+func (server) Put(ctx context.Context, in *Chunk) (*Ack, error) {
+	return &Ack{Size: int64(len(in.Data)), Name: in.Name}, nil
+}
+
+func (server) Stat(ctx context.Context, in *Chunk) (*Ack, error) {
+	return &Ack{Size: int64(len(in.Data)), Name: in.Name}, nil
+}
+
+func post(h http.Handler, path, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", path, strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestHTTPHandler(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(StoreHTTPPathPrefix, NewStoreHTTPHandler(server{}))
+
+	w := post(mux, "/httphandler.Store/Stat", "application/json", `{"data": "YWJj", "name": "x"}`)
+	if got, want := w.Body.String(), `{"size":"3","name":"x"}`; w.Code != http.StatusOK || got != want {
+		t.Errorf("JSON Stat = %d %s, want 200 %s", w.Code, got, want)
+	}
+
+	b, err := proto.Marshal(&Chunk{Data: []byte("abcd")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w = post(mux, "/httphandler.Store/Put", "application/protobuf", string(b))
+	ack := new(Ack)
+	if err := proto.Unmarshal(w.Body.Bytes(), ack); w.Code != http.StatusOK || err != nil || ack.Size != 4 {
+		t.Errorf("protobuf Put = %d %v %v, want 200 with size 4", w.Code, ack, err)
+	}
+}
+
+func TestHTTPHandlerLimit(t *testing.T) {
+	h := NewStoreHTTPHandler(server{})
+	big, err := proto.Marshal(&Chunk{Data: make([]byte, 63)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := post(h, "/httphandler.Store/Put", "application/protobuf", string(big)); w.Code != http.StatusBadRequest {
+		t.Errorf("Put of 65 bytes = %d %s, want 400", w.Code, w.Body)
+	}
+	// Stat has no limit.
+	if w := post(h, "/httphandler.Store/Stat", "application/protobuf", string(big)); w.Code != http.StatusOK {
+		t.Errorf("Stat of 65 bytes = %d %s, want 200", w.Code, w.Body)
+	}
+}
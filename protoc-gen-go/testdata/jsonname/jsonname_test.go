@@ -0,0 +1,104 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonname
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+func account() *Account {
+	return &Account{
+		AccountId:   "a1",
+		CreatedAt:   1500000000,
+		DisplayName: "Ann",
+		Email:       "ann@example.com",
+		Addresses:   []*Account_Address{{PostalCode: "12345"}},
+		Labels:      map[string]string{"tier": "gold"},
+	}
+}
+
+const legacyJSON = `{"AccountID":"a1","created_ts":"1500000000","displayName":"Ann","e-mail":"ann@example.com","addr_list":[{"ZIP":"12345"}],"Tags":{"tier":"gold"}}`
+
+func TestMarshalOverride(t *testing.T) {
+	got, err := new(jsonpb.Marshaler).MarshalToString(account())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != legacyJSON {
+		t.Errorf("got  %s\nwant %s", got, legacyJSON)
+	}
+
+	// OrigName still asks for the names in the .proto file.
+	got, err = (&jsonpb.Marshaler{OrigName: true}).MarshalToString(account())
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `{"account_id":"a1","created_at":"1500000000","display_name":"Ann","email":"ann@example.com","addresses":[{"postal_code":"12345"}],"labels":{"tier":"gold"}}`
+	if got != want {
+		t.Errorf("OrigName: got  %s\nwant %s", got, want)
+	}
+}
+
+func TestUnmarshalOverride(t *testing.T) {
+	for _, js := range []string{
+		legacyJSON,
+		`{"account_id":"a1","created_at":1500000000,"display_name":"Ann","email":"ann@example.com","addresses":[{"postal_code":"12345"}],"labels":{"tier":"gold"}}`,
+	} {
+		got := new(Account)
+		if err := jsonpb.UnmarshalString(js, got); err != nil {
+			t.Errorf("UnmarshalString(%s): %v", js, err)
+			continue
+		}
+		if !proto.Equal(got, account()) {
+			t.Errorf("UnmarshalString(%s) = %v, want %v", js, got, account())
+		}
+	}
+
+	// The name the override replaces is no longer accepted.
+	if err := jsonpb.UnmarshalString(`{"accountId":"a1"}`, new(Account)); err == nil {
+		t.Error("UnmarshalString accepted the replaced JSON name")
+	}
+}
+
+func TestDescriptorOverride(t *testing.T) {
+	_, md := descriptor.ForMessage(new(Account))
+	want := []string{"AccountID", "created_ts", "displayName", "e-mail", "addr_list", "Tags"}
+	for i, f := range md.Field {
+		if f.GetJsonName() != want[i] {
+			t.Errorf("field %s has JSON name %q in its descriptor, want %q", f.GetName(), f.GetJsonName(), want[i])
+		}
+	}
+}
@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: jsonschema/jsonschema.proto
+
+/*
+Package jsonschema is a generated protocol buffer package.
+
+It is generated from these files:
+
+	jsonschema/jsonschema.proto
+
+It has these top-level messages:
+
+	Signup
+	Address
+*/
+package jsonschema
+
+import (
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+	google_protobuf1 "github.com/golang/protobuf/ptypes/duration"
+	google_protobuf2 "github.com/golang/protobuf/ptypes/struct"
+	google_protobuf3 "github.com/golang/protobuf/ptypes/timestamp"
+	google_protobuf4 "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Plan int32
+
+const (
+	Plan_FREE Plan = 0
+	Plan_PRO  Plan = 1
+)
+
+var Plan_name = map[int32]string{
+	0: "FREE",
+	1: "PRO",
+}
+var Plan_value = map[string]int32{
+	"FREE": 0,
+	"PRO":  1,
+}
+
+func (x Plan) String() string {
+	return proto.EnumName(Plan_name, int32(x))
+}
+func (Plan) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+// Signup is the payload of a request to create an account.
+type Signup struct {
+	// The address to send the confirmation to.
+	Email         string             `protobuf:"bytes,1,opt,name=email" json:"email,omitempty"`
+	DisplayName   string             `protobuf:"bytes,2,opt,name=display_name,json=displayName" json:"display_name,omitempty"`
+	Age           int32              `protobuf:"varint,3,opt,name=age" json:"age,omitempty"`
+	ReferrerId    int64              `protobuf:"varint,4,opt,name=referrer_id,json=referrerId" json:"referrer_id,omitempty"`
+	Quota         uint64             `protobuf:"varint,5,opt,name=quota" json:"quota,omitempty"`
+	Score         float64            `protobuf:"fixed64,6,opt,name=score" json:"score,omitempty"`
+	Newsletter    bool               `protobuf:"varint,7,opt,name=newsletter" json:"newsletter,omitempty"`
+	Avatar        []byte             `protobuf:"bytes,8,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Plan          Plan               `protobuf:"varint,9,opt,name=plan,enum=jsonschema.Plan" json:"plan,omitempty"`
+	Tags          []string           `protobuf:"bytes,10,rep,name=tags" json:"tags,omitempty"`
+	Counters      map[string]int32   `protobuf:"bytes,11,rep,name=counters" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	AddressesById map[int64]*Address `protobuf:"bytes,12,rep,name=addresses_by_id,json=addressesById" json:"addresses_by_id,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Address       *Address           `protobuf:"bytes,13,opt,name=address" json:"address,omitempty"`
+	LegacyId      string             `protobuf:"bytes,14,opt,name=legacy_id,json=LegacyID" json:"legacy_id,omitempty"`
+	Nick          string             `protobuf:"bytes,15,opt,name=nick,json=nickname" json:"nick,omitempty"`
+	// How to reach the user, if at all.
+	//
+	// Types that are valid to be assigned to Contact:
+	//	*Signup_Phone
+	//	*Signup_Mail
+	Contact isSignup_Contact             `protobuf_oneof:"contact"`
+	Born    *google_protobuf3.Timestamp  `protobuf:"bytes,18,opt,name=born" json:"born,omitempty"`
+	Trial   *google_protobuf1.Duration   `protobuf:"bytes,19,opt,name=trial" json:"trial,omitempty"`
+	Budget  *google_protobuf4.Int64Value `protobuf:"bytes,20,opt,name=budget" json:"budget,omitempty"`
+	Prefs   *google_protobuf2.Struct     `protobuf:"bytes,21,opt,name=prefs" json:"prefs,omitempty"`
+}
+
+func (m *Signup) Reset()                    { *m = Signup{} }
+func (m *Signup) String() string            { return proto.CompactTextString(m) }
+func (*Signup) ProtoMessage()               {}
+func (*Signup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type isSignup_Contact interface{ isSignup_Contact() }
+
+type Signup_Phone struct {
+	Phone string `protobuf:"bytes,16,opt,name=phone,oneof"`
+}
+type Signup_Mail struct {
+	Mail *Address `protobuf:"bytes,17,opt,name=mail,oneof"`
+}
+
+func (*Signup_Phone) isSignup_Contact() {}
+func (*Signup_Mail) isSignup_Contact()  {}
+
+func (m *Signup) GetContact() isSignup_Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *Signup) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Signup) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *Signup) GetAge() int32 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+func (m *Signup) GetReferrerId() int64 {
+	if m != nil {
+		return m.ReferrerId
+	}
+	return 0
+}
+
+func (m *Signup) GetQuota() uint64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+func (m *Signup) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *Signup) GetNewsletter() bool {
+	if m != nil {
+		return m.Newsletter
+	}
+	return false
+}
+
+func (m *Signup) GetAvatar() []byte {
+	if m != nil {
+		return m.Avatar
+	}
+	return nil
+}
+
+func (m *Signup) GetPlan() Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return Plan_FREE
+}
+
+func (m *Signup) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Signup) GetCounters() map[string]int32 {
+	if m != nil {
+		return m.Counters
+	}
+	return nil
+}
+
+func (m *Signup) GetAddressesById() map[int64]*Address {
+	if m != nil {
+		return m.AddressesById
+	}
+	return nil
+}
+
+func (m *Signup) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Signup) GetLegacyId() string {
+	if m != nil {
+		return m.LegacyId
+	}
+	return ""
+}
+
+func (m *Signup) GetNick() string {
+	if m != nil {
+		return m.Nick
+	}
+	return ""
+}
+
+func (m *Signup) GetPhone() string {
+	if x, ok := m.GetContact().(*Signup_Phone); ok {
+		return x.Phone
+	}
+	return ""
+}
+
+func (m *Signup) GetMail() *Address {
+	if x, ok := m.GetContact().(*Signup_Mail); ok {
+		return x.Mail
+	}
+	return nil
+}
+
+func (m *Signup) GetBorn() *google_protobuf3.Timestamp {
+	if m != nil {
+		return m.Born
+	}
+	return nil
+}
+
+func (m *Signup) GetTrial() *google_protobuf1.Duration {
+	if m != nil {
+		return m.Trial
+	}
+	return nil
+}
+
+func (m *Signup) GetBudget() *google_protobuf4.Int64Value {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+func (m *Signup) GetPrefs() *google_protobuf2.Struct {
+	if m != nil {
+		return m.Prefs
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Signup) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Signup_OneofMarshaler, _Signup_OneofUnmarshaler, _Signup_OneofSizer, []interface{}{
+		(*Signup_Phone)(nil),
+		(*Signup_Mail)(nil),
+	}
+}
+
+func _Signup_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Signup)
+	// contact
+	switch x := m.Contact.(type) {
+	case *Signup_Phone:
+		b.EncodeVarint(16<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Phone)
+	case *Signup_Mail:
+		b.EncodeVarint(17<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Mail); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Signup.Contact has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Signup_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Signup)
+	switch tag {
+	case 16: // contact.phone
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Contact = &Signup_Phone{x}
+		return true, err
+	case 17: // contact.mail
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Address)
+		err := b.DecodeMessage(msg)
+		m.Contact = &Signup_Mail{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Signup_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Signup)
+	// contact
+	switch x := m.Contact.(type) {
+	case *Signup_Phone:
+		n += proto.SizeVarint(16<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Phone)))
+		n += len(x.Phone)
+	case *Signup_Mail:
+		s := proto.Size(x.Mail)
+		n += proto.SizeVarint(17<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Address is a postal address.
+type Address struct {
+	Lines     []string     `protobuf:"bytes,1,rep,name=lines" json:"lines,omitempty"`
+	Country   string       `protobuf:"bytes,2,opt,name=country" json:"country,omitempty"`
+	ForwardTo *Address     `protobuf:"bytes,3,opt,name=forward_to,json=forwardTo" json:"forward_to,omitempty"`
+	Geo       *Address_Geo `protobuf:"bytes,4,opt,name=geo" json:"geo,omitempty"`
+}
+
+func (m *Address) Reset()                    { *m = Address{} }
+func (m *Address) String() string            { return proto.CompactTextString(m) }
+func (*Address) ProtoMessage()               {}
+func (*Address) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Address) GetLines() []string {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func (m *Address) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *Address) GetForwardTo() *Address {
+	if m != nil {
+		return m.ForwardTo
+	}
+	return nil
+}
+
+func (m *Address) GetGeo() *Address_Geo {
+	if m != nil {
+		return m.Geo
+	}
+	return nil
+}
+
+type Address_Geo struct {
+	Lat float32 `protobuf:"fixed32,1,opt,name=lat" json:"lat,omitempty"`
+	Lng float32 `protobuf:"fixed32,2,opt,name=lng" json:"lng,omitempty"`
+}
+
+func (m *Address_Geo) Reset()                    { *m = Address_Geo{} }
+func (m *Address_Geo) String() string            { return proto.CompactTextString(m) }
+func (*Address_Geo) ProtoMessage()               {}
+func (*Address_Geo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 0} }
+
+func (m *Address_Geo) GetLat() float32 {
+	if m != nil {
+		return m.Lat
+	}
+	return 0
+}
+
+func (m *Address_Geo) GetLng() float32 {
+	if m != nil {
+		return m.Lng
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Signup)(nil), "jsonschema.Signup")
+	proto.RegisterType((*Address)(nil), "jsonschema.Address")
+	proto.RegisterType((*Address_Geo)(nil), "jsonschema.Address.Geo")
+	proto.RegisterEnum("jsonschema.Plan", Plan_name, Plan_value)
+}
+
+func init() { proto.RegisterFile("jsonschema/jsonschema.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0xc7, 0x43, 0x4b, 0xb2, 0xe5, 0xe3, 0x34, 0xf5, 0x98, 0x2e, 0xa3, 0xdd, 0xa2, 0x15, 0x82,
+	0x6c, 0x50, 0x3c, 0xc4, 0xc6, 0xdc, 0x6d, 0xd8, 0x47, 0x31, 0xa4, 0x5e, 0xb3, 0x26, 0xc0, 0x3e,
+	0x0a, 0xb6, 0xdb, 0xc5, 0x8a, 0x26, 0xa0, 0x25, 0x46, 0xd5, 0x2a, 0x93, 0x1a, 0x45, 0x35, 0x30,
+	0x86, 0xdd, 0xec, 0x72, 0x8f, 0x91, 0x47, 0xf0, 0x13, 0xec, 0x25, 0xf6, 0x3a, 0xc3, 0x40, 0x4a,
+	0x6e, 0xbc, 0x7a, 0xf1, 0x8d, 0x78, 0xfe, 0xe7, 0x77, 0xfe, 0x94, 0x78, 0x0e, 0x0d, 0xb7, 0x7f,
+	0x29, 0xa4, 0x28, 0xa2, 0x97, 0x7c, 0xc6, 0x46, 0x57, 0xcb, 0x61, 0xae, 0xa4, 0x96, 0x18, 0xae,
+	0x94, 0xfe, 0x76, 0xc4, 0x94, 0x90, 0x23, 0x99, 0xeb, 0x54, 0x8a, 0xa2, 0x02, 0xfa, 0x77, 0x13,
+	0x29, 0x93, 0x8c, 0x8f, 0x6c, 0x34, 0x2d, 0xcf, 0x47, 0x71, 0xa9, 0x98, 0x01, 0xea, 0xfc, 0x9d,
+	0xb7, 0xf3, 0x85, 0x56, 0x65, 0xa4, 0xeb, 0xec, 0xbd, 0xb7, 0xb3, 0x3a, 0x9d, 0xf1, 0x42, 0xb3,
+	0x59, 0x7e, 0x9d, 0xfd, 0x85, 0x62, 0x79, 0xce, 0x55, 0xbd, 0xfd, 0xee, 0x1f, 0x3e, 0x34, 0x9f,
+	0xa6, 0x89, 0x28, 0x73, 0xfc, 0x01, 0x78, 0x7c, 0xc6, 0xd2, 0x8c, 0xa0, 0x00, 0x85, 0xed, 0x49,
+	0xf7, 0x72, 0xd1, 0xdb, 0xf4, 0x11, 0xf9, 0x07, 0x0d, 0x2a, 0x9d, 0x56, 0x0f, 0xfc, 0x09, 0x6c,
+	0xc6, 0x69, 0x91, 0x67, 0x6c, 0x7e, 0x26, 0xd8, 0x8c, 0x93, 0x86, 0xc5, 0xf1, 0xe5, 0xa2, 0xb7,
+	0xd5, 0x45, 0xe4, 0x70, 0xd7, 0x3f, 0x7d, 0x7e, 0xfa, 0xe0, 0xab, 0x17, 0x83, 0x3d, 0xda, 0xa9,
+	0xb9, 0xef, 0xd9, 0x8c, 0xe3, 0x10, 0x1c, 0x96, 0x70, 0xe2, 0x04, 0x28, 0xf4, 0x26, 0x3b, 0x97,
+	0x8b, 0x1e, 0xfe, 0x68, 0xc3, 0xfe, 0x06, 0x87, 0x9f, 0xdb, 0xe7, 0x5f, 0xd3, 0x43, 0x6a, 0x10,
+	0x7c, 0x0f, 0x3a, 0x8a, 0x9f, 0x73, 0xa5, 0xb8, 0x3a, 0x4b, 0x63, 0xe2, 0x06, 0x28, 0x74, 0x28,
+	0x2c, 0xa5, 0x93, 0x18, 0xdf, 0x02, 0xef, 0xd7, 0x52, 0x6a, 0x46, 0xbc, 0x00, 0x85, 0x2e, 0xad,
+	0x02, 0xa3, 0x16, 0x91, 0x54, 0x9c, 0x34, 0x03, 0x14, 0x22, 0x5a, 0x05, 0xf8, 0x2e, 0x80, 0xe0,
+	0x17, 0x45, 0xc6, 0xb5, 0xe6, 0x8a, 0xb4, 0x02, 0x14, 0xfa, 0x74, 0x45, 0xc1, 0x3b, 0xd0, 0x64,
+	0xaf, 0x99, 0x66, 0x8a, 0xf8, 0x01, 0x0a, 0x37, 0x69, 0x1d, 0xe1, 0x3d, 0x70, 0xf3, 0x8c, 0x09,
+	0xd2, 0x0e, 0x50, 0xb8, 0x35, 0xee, 0x0e, 0x57, 0x3a, 0xfb, 0x24, 0x63, 0x82, 0xda, 0x2c, 0xbe,
+	0x03, 0xae, 0x66, 0x49, 0x41, 0x20, 0x70, 0xc2, 0xf6, 0xc4, 0xbf, 0x5c, 0xf4, 0x5c, 0xd2, 0x3d,
+	0xf6, 0xa8, 0x55, 0xf1, 0x03, 0xf0, 0x23, 0x59, 0x0a, 0xcd, 0x55, 0x41, 0x3a, 0x81, 0x13, 0x76,
+	0xc6, 0xc1, 0xaa, 0x4f, 0x75, 0xee, 0xc3, 0xaf, 0x6b, 0xe4, 0x48, 0x68, 0x35, 0xa7, 0x6f, 0x2a,
+	0xf0, 0x77, 0x70, 0x93, 0xc5, 0xb1, 0xe2, 0x45, 0xc1, 0x8b, 0xb3, 0xe9, 0xdc, 0x1c, 0xc5, 0xa6,
+	0x35, 0x79, 0xff, 0x7f, 0x4c, 0x1e, 0x2e, 0xc9, 0xc9, 0xfc, 0x24, 0xae, 0x9c, 0x6e, 0xb0, 0x55,
+	0x0d, 0x1f, 0x40, 0xab, 0x16, 0xc8, 0x8d, 0x00, 0x85, 0x9d, 0xf1, 0xf6, 0xaa, 0x4d, 0x5d, 0x4f,
+	0x97, 0x0c, 0xfe, 0x10, 0xda, 0x19, 0x4f, 0x58, 0x64, 0xf7, 0xdd, 0xb2, 0x2d, 0xde, 0xfa, 0x73,
+	0xd1, 0xf3, 0xbf, 0xb5, 0xe2, 0xc9, 0x23, 0x82, 0xe8, 0x9b, 0x35, 0xde, 0x01, 0x57, 0xa4, 0xd1,
+	0x2b, 0x72, 0xd3, 0x70, 0xd4, 0x37, 0x6b, 0x33, 0x1a, 0x78, 0x07, 0xbc, 0xfc, 0xa5, 0x14, 0x9c,
+	0x74, 0x4d, 0xe2, 0x78, 0x83, 0x56, 0x21, 0xde, 0x07, 0xd7, 0x4e, 0xda, 0x3b, 0xd7, 0xbe, 0xc8,
+	0xf1, 0x06, 0xb5, 0x08, 0x1e, 0x82, 0x3b, 0x95, 0x4a, 0x10, 0x6c, 0xd1, 0xfe, 0xb0, 0x9a, 0xe7,
+	0xe1, 0x72, 0x9e, 0x87, 0xcf, 0x96, 0x03, 0x4f, 0x2d, 0x87, 0x47, 0xe0, 0x69, 0x95, 0xb2, 0x8c,
+	0x6c, 0xdb, 0x82, 0xde, 0x5a, 0xc1, 0xa3, 0xfa, 0x7e, 0xd1, 0x8a, 0xc3, 0xf7, 0xa1, 0x39, 0x2d,
+	0xe3, 0x84, 0x6b, 0x72, 0xcb, 0x56, 0xdc, 0x5e, 0xab, 0x38, 0x11, 0xfa, 0xd3, 0x8f, 0x7f, 0x62,
+	0x59, 0xc9, 0x69, 0x8d, 0xe2, 0x03, 0xf0, 0x72, 0xc5, 0xcf, 0x0b, 0xf2, 0xae, 0xad, 0x79, 0x6f,
+	0xad, 0xe6, 0xa9, 0xbd, 0xa5, 0xb4, 0xa2, 0xfa, 0x5f, 0xc2, 0x8d, 0xff, 0x74, 0x19, 0x77, 0xc1,
+	0x79, 0xc5, 0xe7, 0xd5, 0x4d, 0xa3, 0x66, 0x69, 0xa6, 0xf7, 0xb5, 0xd9, 0xc2, 0x5e, 0x27, 0x8f,
+	0x56, 0xc1, 0x17, 0x8d, 0xcf, 0x50, 0xff, 0x47, 0xc0, 0xeb, 0xdd, 0x5d, 0x75, 0x70, 0x2a, 0x87,
+	0xfd, 0x55, 0x87, 0x6b, 0xda, 0x7b, 0x65, 0x3b, 0x69, 0x43, 0x2b, 0x92, 0x42, 0xb3, 0x48, 0xef,
+	0xfe, 0x8d, 0xa0, 0x55, 0x13, 0xe6, 0x3d, 0xb2, 0x54, 0xf0, 0x82, 0x20, 0x33, 0xd2, 0xb4, 0x0a,
+	0xf0, 0xc0, 0xc0, 0xa5, 0xd9, 0x98, 0x34, 0xae, 0xfe, 0x1d, 0x76, 0xe1, 0xf4, 0xf9, 0xc3, 0x83,
+	0x9f, 0x5f, 0xfc, 0x36, 0xfe, 0x7d, 0x8f, 0x2e, 0x01, 0x3c, 0x06, 0x38, 0x97, 0xea, 0x82, 0xa9,
+	0xf8, 0x4c, 0x4b, 0xe2, 0x5c, 0xff, 0x32, 0xed, 0x1a, 0x7b, 0x26, 0xf1, 0x3e, 0x38, 0x09, 0x97,
+	0xc4, 0xad, 0x4f, 0x73, 0x1d, 0x1e, 0x3e, 0xe6, 0x92, 0x1a, 0xa6, 0xbf, 0x0f, 0xce, 0x63, 0x2e,
+	0xcd, 0xf7, 0x67, 0x4c, 0xdb, 0xef, 0x6f, 0x50, 0xb3, 0xb4, 0x8a, 0x48, 0x48, 0xa3, 0x56, 0x44,
+	0x32, 0xe8, 0x81, 0x6b, 0xee, 0x2a, 0xf6, 0xc1, 0xfd, 0x86, 0x1e, 0x1d, 0x75, 0x37, 0x70, 0x0b,
+	0x9c, 0x27, 0xf4, 0x87, 0x2e, 0x9a, 0x36, 0x6d, 0xa7, 0xee, 0xff, 0x3b, 0x00, 0xcc, 0x8d, 0xfd,
+	0xbb, 0xbd, 0x05, 0x00, 0x00,
+}
@@ -0,0 +1,313 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//   - Redistributions of source code must retain the above copyright
+//
+// notice, this list of conditions and the following disclaimer.
+//   - Redistributions in binary form must reproduce the above
+//
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//   - Neither the name of Google Inc. nor the names of its
+//
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// validate reports the first way in which v, decoded JSON, does not match
+// schema, or nil. It knows the keywords the jsonschema plugin writes, and
+// ignores the annotations among them, such as format.
+func validate(root, schema map[string]interface{}, v interface{}, at string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		target := root
+		if ref != "#" {
+			target, _ = root["$defs"].(map[string]interface{})[strings.TrimPrefix(ref, "#/$defs/")].(map[string]interface{})
+			if target == nil {
+				return fmt.Errorf("%s: unresolved $ref %s", at, ref)
+			}
+		}
+		if err := validate(root, target, v, at); err != nil {
+			return err
+		}
+	}
+	if t, ok := schema["type"]; ok {
+		types, ok := t.([]interface{})
+		if !ok {
+			types = []interface{}{t}
+		}
+		match := false
+		for _, t := range types {
+			match = match || hasType(v, t.(string))
+		}
+		if !match {
+			return fmt.Errorf("%s: %v is not of type %v", at, v, t)
+		}
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, e := range enum {
+			found = found || reflect.DeepEqual(e, v)
+		}
+		if !found {
+			return fmt.Errorf("%s: %v is not in %v", at, v, enum)
+		}
+	}
+	for _, sub := range list(schema["allOf"]) {
+		if err := validate(root, sub, v, at); err != nil {
+			return err
+		}
+	}
+	if subs := list(schema["oneOf"]); subs != nil {
+		n := 0
+		for _, sub := range subs {
+			if validate(root, sub, v, at) == nil {
+				n++
+			}
+		}
+		if n != 1 {
+			return fmt.Errorf("%s: %d of oneOf match", at, n)
+		}
+	}
+	if subs := list(schema["anyOf"]); subs != nil {
+		match := false
+		for _, sub := range subs {
+			match = match || validate(root, sub, v, at) == nil
+		}
+		if !match {
+			return fmt.Errorf("%s: none of anyOf match", at)
+		}
+	}
+	if not, ok := schema["not"].(map[string]interface{}); ok && validate(root, not, v, at) == nil {
+		return fmt.Errorf("%s: matches not", at)
+	}
+	switch v := v.(type) {
+	case string:
+		if n, ok := schema["minLength"].(float64); ok && float64(utf8.RuneCountInString(v)) < n {
+			return fmt.Errorf("%s: %q is shorter than %v", at, v, n)
+		}
+		if n, ok := schema["maxLength"].(float64); ok && float64(utf8.RuneCountInString(v)) > n {
+			return fmt.Errorf("%s: %q is longer than %v", at, v, n)
+		}
+		if p, ok := schema["pattern"].(string); ok && !regexp.MustCompile(p).MatchString(v) {
+			return fmt.Errorf("%s: %q does not match %s", at, v, p)
+		}
+	case float64:
+		if n, ok := schema["minimum"].(float64); ok && v < n {
+			return fmt.Errorf("%s: %v is less than %v", at, v, n)
+		}
+		if n, ok := schema["maximum"].(float64); ok && v > n {
+			return fmt.Errorf("%s: %v is more than %v", at, v, n)
+		}
+	case []interface{}:
+		if n, ok := schema["minItems"].(float64); ok && float64(len(v)) < n {
+			return fmt.Errorf("%s: fewer than %v items", at, n)
+		}
+		if n, ok := schema["maxItems"].(float64); ok && float64(len(v)) > n {
+			return fmt.Errorf("%s: more than %v items", at, n)
+		}
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, e := range v {
+				if err := validate(root, items, e, fmt.Sprintf("%s[%d]", at, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]interface{}:
+		if req, ok := schema["required"].([]interface{}); ok {
+			for _, name := range req {
+				if _, ok := v[name.(string)]; !ok {
+					return fmt.Errorf("%s: %s is required", at, name)
+				}
+			}
+		}
+		if n, ok := schema["maxProperties"].(float64); ok && float64(len(v)) > n {
+			return fmt.Errorf("%s: more than %v properties", at, n)
+		}
+		props, _ := schema["properties"].(map[string]interface{})
+		for name, e := range v {
+			if names, ok := schema["propertyNames"].(map[string]interface{}); ok {
+				if err := validate(root, names, name, at+"."+name); err != nil {
+					return err
+				}
+			}
+			sub, ok := props[name].(map[string]interface{})
+			if !ok {
+				switch extra := schema["additionalProperties"].(type) {
+				case bool:
+					if !extra {
+						return fmt.Errorf("%s: unknown property %s", at, name)
+					}
+					continue
+				case map[string]interface{}:
+					sub = extra
+				default:
+					continue
+				}
+			}
+			if err := validate(root, sub, e, at+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hasType reports whether v is of the JSON Schema type t.
+func hasType(v interface{}, t string) bool {
+	switch v := v.(type) {
+	case nil:
+		return t == "null"
+	case bool:
+		return t == "boolean"
+	case string:
+		return t == "string"
+	case float64:
+		return t == "number" || t == "integer" && v == math.Trunc(v)
+	case []interface{}:
+		return t == "array"
+	case map[string]interface{}:
+		return t == "object"
+	}
+	return false
+}
+
+// list returns v as a list of schemas, or nil.
+func list(v interface{}) []map[string]interface{} {
+	l, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	var schemas []map[string]interface{}
+	for _, s := range l {
+		if s, ok := s.(map[string]interface{}); ok {
+			schemas = append(schemas, s)
+		}
+	}
+	return schemas
+}
+
+// decode decodes the JSON data into a generic value.
+func decode(t *testing.T, data []byte) interface{} {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func schema(t *testing.T, name string) map[string]interface{} {
+	data, err := ioutil.ReadFile(name + ".schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decode(t, data).(map[string]interface{})
+}
+
+func signup() *Signup {
+	return &Signup{
+		Email:         "ann@example.com",
+		DisplayName:   "Ann",
+		Age:           30,
+		ReferrerId:    1 << 60,
+		Quota:         1 << 63,
+		Score:         0.5,
+		Newsletter:    true,
+		Avatar:        []byte{0xff, 0xfe},
+		Plan:          Plan_PRO,
+		Tags:          []string{"a", "b"},
+		Counters:      map[string]int32{"x": -1},
+		AddressesById: map[int64]*Address{-7: {Country: "NL"}},
+		Address: &Address{
+			Lines:     []string{"Main St 1"},
+			Country:   "US",
+			ForwardTo: &Address{Country: "CA"},
+			Geo:       &Address_Geo{Lat: 1.5, Lng: -2},
+		},
+		LegacyId: "L1",
+		Nick:     "annie",
+		Contact:  &Signup_Mail{&Address{Country: "FR"}},
+		Born:     &timestamp.Timestamp{Seconds: 1e9},
+		Trial:    &duration.Duration{Seconds: 30, Nanos: 5e8},
+		Budget:   &wrappers.Int64Value{Value: 100},
+	}
+}
+
+func TestMarshaledPayloadsValidate(t *testing.T) {
+	s := schema(t, "jsonschema.Signup")
+	for _, m := range []jsonpb.Marshaler{{}, {EmitDefaults: true}, {EnumsAsInts: true}} {
+		out, err := m.MarshalToString(signup())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := validate(s, s, decode(t, []byte(out)), "Signup"); err != nil {
+			t.Errorf("%+v: %v\n%s", m, err, out)
+		}
+	}
+
+	out, err := (&jsonpb.Marshaler{}).MarshalToString(&Address_Geo{Lat: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	geo := schema(t, "jsonschema.Address.Geo")
+	if err := validate(geo, geo, decode(t, []byte(out)), "Geo"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInvalidPayloads(t *testing.T) {
+	s := schema(t, "jsonschema.Signup")
+	for _, payload := range []string{
+		`{}`,
+		`{"email": "a@b.c", "displayName": ""}`,
+		`{"email": "a@b.c", "displayName": "<b>"}`,
+		`{"email": "a@b.c", "age": 12}`,
+		`{"email": "a@b.c", "age": 13.5}`,
+		`{"email": "a@b.c", "referrerId": "1e3"}`,
+		`{"email": "a@b.c", "plan": "GOLD"}`,
+		`{"email": "a@b.c", "tags": ["a", "b", "c", "d", "e", "f"]}`,
+		`{"email": "a@b.c", "tags": ["aaaaaaaaaaaaaaaaa"]}`,
+		`{"email": "a@b.c", "addressesById": {"x": {}}}`,
+		`{"email": "a@b.c", "address": {"forwardTo": {"country": "usa"}}}`,
+		`{"email": "a@b.c", "phone": "1", "mail": {}}`,
+		`{"email": "a@b.c", "legacyId": "L1"}`,
+		`{"email": "a@b.c", "trial": "30"}`,
+	} {
+		if validate(s, s, decode(t, []byte(payload)), "Signup") == nil {
+			t.Errorf("%s validates", payload)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: lazy/lazy.proto
+
+/*
+Package lazy is a generated protocol buffer package.
+
+Package lazy tests the clients the carno plugin generates with
+carno:lazy_aggregate=true.
+
+It is generated from these files:
+	lazy/lazy.proto
+
+It has these top-level messages:
+	Msg
+*/
+package lazy
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+	sync "sync"
+
+	carno "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Msg struct {
+	Text string `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
+}
+
+func (m *Msg) Reset()                    { *m = Msg{} }
+func (m *Msg) String() string            { return proto.CompactTextString(m) }
+func (*Msg) ProtoMessage()               {}
+func (*Msg) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Msg) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Msg)(nil), "lazy.Msg")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Echo service
+type EchoClient interface {
+	Say(ctx context.Context, in *Msg, opts ...client.CallOption) (*Msg, error)
+}
+
+type echoClient struct {
+	client.Client
+}
+
+// NewEchoClient creates and starts a client for the Echo service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewEchoClient(opts ...client.Option) (EchoClient, error) {
+	c, err := carno.NewClient("lazy", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &echoClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Echo_callInfo = []*callinfo.CallInfo{
+	{
+		Service:      "lazy@Echo",
+		Method:       "Say",
+		RequestType:  "lazy.Msg",
+		ResponseType: "lazy.Msg",
+		File:         "lazy/lazy.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Echo_callInfo...)
+}
+
+func (c *echoClient) Say(ctx context.Context, in *Msg, opts ...client.CallOption) (*Msg, error) {
+	out := new(Msg)
+	ctx = callinfo.NewContext(ctx, _Echo_callInfo[0])
+	err := c.Client.Call(ctx, "Echo", "Say", in, out, opts...)
+	return out, err
+}
+
+// _Echo_lazyClient wires a EchoClient on its first call.
+// It is safe for concurrent use: the client is wired once, after the
+// shared client has started.
+type _Echo_lazyClient struct {
+	conn *_Lazy_lazyConn
+	mu   sync.Mutex
+	c    EchoClient
+}
+
+func (l *_Echo_lazyClient) get() (EchoClient, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.c == nil {
+		c, err := l.conn.get()
+		if err != nil {
+			return nil, err
+		}
+		l.c = &echoClient{Client: c}
+	}
+	return l.c, nil
+}
+
+func (l *_Echo_lazyClient) Say(ctx context.Context, in *Msg, opts ...client.CallOption) (*Msg, error) {
+	c, err := l.get()
+	if err != nil {
+		return nil, err
+	}
+	return c.Say(ctx, in, opts...)
+}
+
+// EchoClient returns a EchoClient using the client shared by the services
+// of package lazy.
+func (a *Lazy) EchoClient() EchoClient {
+	return &_Echo_lazyClient{conn: a.conn}
+}
+
+// Server API for Echo service
+type EchoServer interface {
+	Say(context.Context, *Msg) (*Msg, error)
+}
+
+func RegisterEchoServer(srv EchoServer) {
+	callinfo.RegisterServer("lazy@Echo")
+	carno.HandleService(&_Echo_serviceDesc, srv)
+}
+
+var _Echo_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Echo",
+	Methods: []string{
+		"Say",
+	},
+}
+
+// Client API for Count service
+type CountClient interface {
+	Add(ctx context.Context, in *Msg, opts ...client.CallOption) (*Msg, error)
+}
+
+type countClient struct {
+	client.Client
+}
+
+// NewCountClient creates and starts a client for the Count service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewCountClient(opts ...client.Option) (CountClient, error) {
+	c, err := carno.NewClient("lazy", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &countClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Count_callInfo = []*callinfo.CallInfo{
+	{
+		Service:      "lazy@Count",
+		Method:       "Add",
+		RequestType:  "lazy.Msg",
+		ResponseType: "lazy.Msg",
+		File:         "lazy/lazy.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Count_callInfo...)
+}
+
+func (c *countClient) Add(ctx context.Context, in *Msg, opts ...client.CallOption) (*Msg, error) {
+	out := new(Msg)
+	ctx = callinfo.NewContext(ctx, _Count_callInfo[0])
+	err := c.Client.Call(ctx, "Count", "Add", in, out, opts...)
+	return out, err
+}
+
+// _Count_lazyClient wires a CountClient on its first call.
+// It is safe for concurrent use: the client is wired once, after the
+// shared client has started.
+type _Count_lazyClient struct {
+	conn *_Lazy_lazyConn
+	mu   sync.Mutex
+	c    CountClient
+}
+
+func (l *_Count_lazyClient) get() (CountClient, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.c == nil {
+		c, err := l.conn.get()
+		if err != nil {
+			return nil, err
+		}
+		l.c = &countClient{Client: c}
+	}
+	return l.c, nil
+}
+
+func (l *_Count_lazyClient) Add(ctx context.Context, in *Msg, opts ...client.CallOption) (*Msg, error) {
+	c, err := l.get()
+	if err != nil {
+		return nil, err
+	}
+	return c.Add(ctx, in, opts...)
+}
+
+// CountClient returns a CountClient using the client shared by the services
+// of package lazy.
+func (a *Lazy) CountClient() CountClient {
+	return &_Count_lazyClient{conn: a.conn}
+}
+
+// Server API for Count service
+type CountServer interface {
+	Add(context.Context, *Msg) (*Msg, error)
+}
+
+func RegisterCountServer(srv CountServer) {
+	callinfo.RegisterServer("lazy@Count")
+	carno.HandleService(&_Count_serviceDesc, srv)
+}
+
+var _Count_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Count",
+	Methods: []string{
+		"Add",
+	},
+}
+
+func init() { proto.RegisterFile("lazy/lazy.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 110 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcf, 0x49, 0xac, 0xaa,
+	0xd4, 0x07, 0x11, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x2c, 0x20, 0xb6, 0x92, 0x24, 0x17,
+	0xb3, 0x6f, 0x71, 0xba, 0x90, 0x10, 0x17, 0x4b, 0x49, 0x6a, 0x45, 0x89, 0x04, 0xa3, 0x02, 0xa3,
+	0x06, 0x67, 0x10, 0x98, 0x6d, 0xa4, 0xcc, 0xc5, 0xe2, 0x9a, 0x9c, 0x91, 0x2f, 0x24, 0xcd, 0xc5,
+	0x1c, 0x9c, 0x58, 0x29, 0xc4, 0xa9, 0x07, 0xd6, 0xec, 0x5b, 0x9c, 0x2e, 0x85, 0x60, 0x1a, 0xa9,
+	0x70, 0xb1, 0x3a, 0xe7, 0x97, 0xe6, 0x95, 0x80, 0x54, 0x39, 0xa6, 0xa4, 0x60, 0x57, 0x95, 0xc4,
+	0x06, 0xb6, 0xd2, 0x18, 0x30, 0x00, 0x8a, 0xf5, 0x95, 0xf3, 0x85, 0x00, 0x00, 0x00,
+}
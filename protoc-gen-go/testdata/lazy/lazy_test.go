@@ -0,0 +1,137 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package lazy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/callinfo"
+)
+
+// fakeClient stands in for the carno client, echoing each request.
+// It fails calls whose context lacks the method's CallInfo, and fails to
+// start with startErr.
+type fakeClient struct {
+	calls    int32
+	startErr error
+	closed   bool
+}
+
+func (c *fakeClient) Start() error { return c.startErr }
+
+func (c *fakeClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *fakeClient) Call(ctx context.Context, service, method string, in, out interface{}, opts ...client.CallOption) error {
+	atomic.AddInt32(&c.calls, 1)
+	if info, ok := callinfo.FromContext(ctx); !ok || info.FullMethod() != "lazy@"+service+"/"+method {
+		return fmt.Errorf("call to %s.%s has CallInfo %+v", service, method, info)
+	}
+	out.(*Msg).Text = service + "." + method + ":" + in.(*Msg).GetText()
+	return nil
+}
+
+// Run with -race: the first calls through a lazy aggregate race to wire
+// the service clients.
+func TestConcurrentFirstCalls(t *testing.T) {
+	fake := new(fakeClient)
+	conn := &_Lazy_lazyConn{newClient: func(string, ...client.Option) (client.Client, error) {
+		return fake, nil
+	}}
+	agg := &Lazy{conn: conn}
+	echo, count := agg.EchoClient(), agg.CountClient()
+
+	const n = 50
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			out, err := echo.Say(context.Background(), &Msg{Text: "hi"})
+			if err != nil || out.GetText() != "Echo.Say:hi" {
+				t.Errorf("Say = %v, %v; want Echo.Say:hi", out, err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			out, err := count.Add(context.Background(), &Msg{Text: "1"})
+			if err != nil || out.GetText() != "Count.Add:1" {
+				t.Errorf("Add = %v, %v; want Count.Add:1", out, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := atomic.LoadInt32(&fake.calls); got != 2*n {
+		t.Errorf("client got %d calls, want %d", got, 2*n)
+	}
+}
+
+// A client that fails to start is closed, and the next call starts
+// another.
+func TestFailedStart(t *testing.T) {
+	var made []*fakeClient
+	conn := &_Lazy_lazyConn{newClient: func(pkg string, opts ...client.Option) (client.Client, error) {
+		if pkg != "lazy" {
+			t.Errorf("NewClient(%q), want lazy", pkg)
+		}
+		c := new(fakeClient)
+		if len(made) == 0 {
+			c.startErr = errors.New("no route to host")
+		}
+		made = append(made, c)
+		return c, nil
+	}}
+	agg := &Lazy{conn: conn}
+
+	if _, err := agg.EchoClient().Say(context.Background(), &Msg{Text: "hi"}); err == nil || err.Error() != "no route to host" {
+		t.Fatalf("first Say returned %v, want the start error", err)
+	}
+	if !made[0].closed {
+		t.Error("client that failed to start was not closed")
+	}
+	out, err := agg.EchoClient().Say(context.Background(), &Msg{Text: "hi"})
+	if err != nil || out.GetText() != "Echo.Say:hi" {
+		t.Fatalf("Say after a failed start = %v, %v; want Echo.Say:hi", out, err)
+	}
+	agg.EchoClient().Say(context.Background(), &Msg{Text: "hi"})
+	if len(made) != 2 || made[1].closed || made[1].calls != 2 {
+		t.Errorf("made %d clients; want 2, the second open with 2 calls", len(made))
+	}
+}
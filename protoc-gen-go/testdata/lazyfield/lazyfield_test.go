@@ -0,0 +1,289 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package lazyfield
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+func envelope() *Envelope {
+	return &Envelope{
+		Route: proto.String("a/b"),
+		Payload: &Payload{
+			Text:   proto.String("payload"),
+			Values: []int64{1, 2, 3},
+			Child:  &Payload{Text: proto.String("child")},
+			Counts: map[string]int32{"x": 1},
+		},
+		Eager: &Payload{Text: proto.String("eager")},
+	}
+}
+
+func unmarshal(t *testing.T, b []byte) *Envelope {
+	m := new(Envelope)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	return m
+}
+
+func TestGetter(t *testing.T) {
+	want := envelope()
+	b, err := proto.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := unmarshal(t, b)
+	if m.Payload != nil {
+		t.Errorf("Payload = %v before GetPayload, want nil", m.Payload)
+	}
+	if m.Eager == nil {
+		t.Errorf("Eager = nil, want it decoded")
+	}
+	p := m.GetPayload()
+	if p == nil || p.GetText() != "payload" || m.Payload != p {
+		t.Fatalf("GetPayload = %v, want the decoded payload", p)
+	}
+	if p.Child != nil {
+		t.Errorf("Child = %v before GetChild, want nil", p.Child)
+	}
+	if got := p.GetChild().GetText(); got != "child" {
+		t.Errorf("GetChild().GetText() = %q, want child", got)
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("got %v, want %v", m, want)
+	}
+}
+
+func TestAbsent(t *testing.T) {
+	m := unmarshal(t, nil)
+	if p := m.GetPayload(); p != nil {
+		t.Errorf("GetPayload = %v, want nil", p)
+	}
+	var nilm *Envelope
+	if p := nilm.GetPayload(); p != nil {
+		t.Errorf("GetPayload of nil = %v, want nil", p)
+	}
+
+	// An empty message is still present.
+	b, _ := proto.Marshal(&Envelope{Payload: &Payload{}})
+	if p := unmarshal(t, b).GetPayload(); p == nil {
+		t.Error("GetPayload = nil, want an empty payload")
+	}
+}
+
+func TestMarshalUndecoded(t *testing.T) {
+	b, err := proto.Marshal(envelope())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := unmarshal(t, b)
+	if n := proto.Size(m); n != len(b) {
+		t.Errorf("Size = %d, want %d", n, len(b))
+	}
+	got, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, b) {
+		t.Errorf("Marshal = %x, want %x", got, b)
+	}
+	if m.Payload != nil {
+		t.Errorf("Marshal decoded Payload")
+	}
+
+	// Deterministic marshaling decodes the field first.
+	got, err = proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Payload == nil {
+		t.Errorf("deterministic Marshal left Payload undecoded")
+	}
+	if !bytes.Equal(got, b) {
+		t.Errorf("deterministic Marshal = %x, want %x", got, b)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a, _ := proto.Marshal(&Envelope{Payload: &Payload{Text: proto.String("a"), Values: []int64{1}}})
+	b, _ := proto.Marshal(&Envelope{Payload: &Payload{Values: []int64{2}}})
+
+	// A field repeated on the wire merges, decoded or not.
+	m := unmarshal(t, append(append([]byte(nil), a...), b...))
+	want := &Payload{Text: proto.String("a"), Values: []int64{1, 2}}
+	if p := m.GetPayload(); !proto.Equal(p, want) {
+		t.Errorf("GetPayload = %v, want %v", p, want)
+	}
+
+	// Merging into a set field merges at once.
+	m = unmarshal(t, a)
+	m.GetPayload()
+	if err := proto.UnmarshalMerge(b, m); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(m.Payload, want) {
+		t.Errorf("Payload = %v, want %v", m.Payload, want)
+	}
+
+	// Merging into an undecoded field keeps it undecoded.
+	m = unmarshal(t, a)
+	if err := proto.UnmarshalMerge(b, m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Payload != nil {
+		t.Errorf("UnmarshalMerge decoded Payload")
+	}
+	if p := m.GetPayload(); !proto.Equal(p, want) {
+		t.Errorf("GetPayload = %v, want %v", p, want)
+	}
+}
+
+func TestSetDirectly(t *testing.T) {
+	b, _ := proto.Marshal(envelope())
+	m := unmarshal(t, b)
+	set := &Payload{Text: proto.String("set")}
+	m.Payload = set
+	if p := m.GetPayload(); p != set {
+		t.Errorf("GetPayload = %v, want %v", p, set)
+	}
+	m = unmarshal(t, b)
+	m.Payload = set
+	got, _ := proto.Marshal(m)
+	want, _ := proto.Marshal(&Envelope{Route: m.Route, Payload: set, Eager: m.Eager})
+	if !bytes.Equal(got, want) {
+		t.Errorf("Marshal = %x, want %x", got, want)
+	}
+}
+
+func TestReaders(t *testing.T) {
+	want := envelope()
+	b, _ := proto.Marshal(want)
+
+	if !proto.Equal(unmarshal(t, b), want) || !proto.Equal(want, unmarshal(t, b)) {
+		t.Error("Equal = false, want true")
+	}
+	if c := proto.Clone(unmarshal(t, b)); !proto.Equal(c, want) {
+		t.Errorf("Clone = %v, want %v", c, want)
+	}
+	m := new(Envelope)
+	proto.Merge(m, unmarshal(t, b))
+	if !proto.Equal(m, want) {
+		t.Errorf("Merge gave %v, want %v", m, want)
+	}
+	if got, w := unmarshal(t, b).String(), want.String(); got != w {
+		t.Errorf("String = %q, want %q", got, w)
+	}
+	js, err := new(jsonpb.Marshaler).MarshalToString(unmarshal(t, b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(js, `"child"`) {
+		t.Errorf("jsonpb gave %s, want the child", js)
+	}
+}
+
+func TestConcurrentGetters(t *testing.T) {
+	b, _ := proto.Marshal(envelope())
+	m := unmarshal(t, b)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := m.GetPayload().GetChild().GetText(); got != "child" {
+				t.Errorf("GetText = %q, want child", got)
+			}
+			if _, err := proto.Marshal(m); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDecodeErrors(t *testing.T) {
+	// Field 2, the payload, holding a truncated varint.
+	b := []byte{0x12, 0x02, 0x10, 0x80}
+	m := unmarshal(t, b)
+	if err := proto.DecodeLazyField(m, 2); err == nil {
+		t.Error("DecodeLazyField succeeded, want an error")
+	}
+	if err := proto.DecodeLazy(m); err != nil {
+		t.Errorf("DecodeLazy after DecodeLazyField = %v, want nil", err)
+	}
+
+	m = unmarshal(t, b)
+	if err := proto.DecodeLazy(m); err == nil {
+		t.Error("DecodeLazy succeeded, want an error")
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	deep := &Envelope{Payload: &Payload{Child: &Payload{Child: &Payload{}}}}
+	b, _ := proto.Marshal(deep)
+	o := proto.UnmarshalOptions{MaxDepth: 3}
+	m := new(Envelope)
+	if err := o.Unmarshal(b, m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if err := proto.DecodeLazy(m); err != nil {
+		t.Fatalf("DecodeLazy: %v", err)
+	}
+	if err := proto.DecodeLazy(m.Payload); err != nil {
+		t.Fatalf("DecodeLazy of the payload: %v", err)
+	}
+	// The limit applies when the deepest message is decoded.
+	if err := proto.DecodeLazy(m.Payload.Child); err != proto.ErrTooDeep {
+		t.Errorf("DecodeLazy of the child = %v, want %v", err, proto.ErrTooDeep)
+	}
+}
+
+func BenchmarkRoute(b *testing.B) {
+	data, _ := proto.Marshal(envelope())
+	m := new(Envelope)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := proto.Unmarshal(data, m); err != nil {
+			b.Fatal(err)
+		}
+		if m.GetRoute() == "" {
+			b.Fatal("no route")
+		}
+	}
+}
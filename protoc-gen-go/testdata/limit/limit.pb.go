@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: limit/limit.proto
+
+/*
+Package limit is a generated protocol buffer package.
+
+Package limit tests the request limits the carno plugin generates from
+(carno.max_request_bytes) and (carno.max_request_fields).
+
+It is generated from these files:
+	limit/limit.proto
+
+It has these top-level messages:
+	Chunk
+	Ack
+*/
+package limit
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	carnotest "github.com/ccsnake/protobuf/carnotest"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Chunk struct {
+	Data []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Tags []string `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+}
+
+func (m *Chunk) Reset()                    { *m = Chunk{} }
+func (m *Chunk) String() string            { return proto.CompactTextString(m) }
+func (*Chunk) ProtoMessage()               {}
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Chunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Chunk) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type Ack struct {
+	Size int64 `protobuf:"varint,1,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *Ack) Reset()                    { *m = Ack{} }
+func (m *Ack) String() string            { return proto.CompactTextString(m) }
+func (*Ack) ProtoMessage()               {}
+func (*Ack) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Ack) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Chunk)(nil), "limit.Chunk")
+	proto.RegisterType((*Ack)(nil), "limit.Ack")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Store service
+type StoreClient interface {
+	Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+	Tag(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+	Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+}
+
+type storeClient struct {
+	client.Client
+}
+
+// NewStoreClient creates and starts a client for the Store service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewStoreClient(opts ...client.Option) (StoreClient, error) {
+	c, err := carno1.NewClient("limit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &storeClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Store_callInfo = []*callinfo.CallInfo{
+	{
+		Service:          "limit@Store",
+		Method:           "Put",
+		RequestType:      "limit.Chunk",
+		ResponseType:     "limit.Ack",
+		File:             "limit/limit.proto",
+		MaxRequestBytes:  64,
+		MaxRequestFields: 4,
+	},
+	{
+		Service:          "limit@Store",
+		Method:           "Tag",
+		RequestType:      "limit.Chunk",
+		ResponseType:     "limit.Ack",
+		File:             "limit/limit.proto",
+		MaxRequestFields: 8,
+	},
+	{
+		Service:      "limit@Store",
+		Method:       "Stat",
+		RequestType:  "limit.Chunk",
+		ResponseType: "limit.Ack",
+		File:         "limit/limit.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Store_callInfo...)
+}
+
+func (c *storeClient) Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[0])
+	err := c.Client.Call(ctx, "Store", "Put", in, out, opts...)
+	return out, err
+}
+
+func (c *storeClient) Tag(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[1])
+	err := c.Client.Call(ctx, "Store", "Tag", in, out, opts...)
+	return out, err
+}
+
+func (c *storeClient) Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[2])
+	err := c.Client.Call(ctx, "Store", "Stat", in, out, opts...)
+	return out, err
+}
+
+// StoreClient returns a StoreClient using the client shared by the services
+// of package limit.
+func (a *Limit) StoreClient() StoreClient {
+	return &storeClient{Client: a.c}
+}
+
+// Server API for Store service
+type StoreServer interface {
+	Put(context.Context, *Chunk) (*Ack, error)
+	Tag(context.Context, *Chunk) (*Ack, error)
+	Stat(context.Context, *Chunk) (*Ack, error)
+}
+
+func RegisterStoreServer(srv StoreServer) {
+	callinfo.RegisterServer("limit@Store")
+	carno1.HandleService(&_Store_serviceDesc, srv)
+}
+
+// NewStoreTestServer returns a StoreClient that calls srv in
+// memory, for testing srv without a network. Calls go through the same
+// encoding and decoding as with a carno client and server, and requests
+// are checked as with RegisterStoreServer; see package carnotest.
+// Streaming methods are not served.
+func NewStoreTestServer(srv StoreServer) StoreClient {
+	c := carnotest.NewClient("Store", map[string]carnotest.Method{
+		"Put": {
+			Info: _Store_callInfo[0],
+			Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+				in := new(Chunk)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+				return srv.Put(ctx, in)
+			},
+		},
+		"Tag": {
+			Info: _Store_callInfo[1],
+			Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+				in := new(Chunk)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+				return srv.Tag(ctx, in)
+			},
+		},
+		"Stat": {
+			Info: _Store_callInfo[2],
+			Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+				in := new(Chunk)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+				return srv.Stat(ctx, in)
+			},
+		},
+	})
+	return &storeClient{Client: c}
+}
+
+var _Store_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Store",
+	Methods: []string{
+		"Put",
+		"Tag",
+		"Stat",
+	},
+}
+
+func init() { proto.RegisterFile("limit/limit.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 185 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcc, 0xc9, 0xcc, 0xcd,
+	0x2c, 0xd1, 0x07, 0x93, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xac, 0x60, 0x8e, 0x94, 0x70,
+	0x72, 0x62, 0x51, 0x5e, 0xbe, 0x7e, 0x7e, 0x41, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x44, 0x4e, 0x49,
+	0x9f, 0x8b, 0xd5, 0x39, 0xa3, 0x34, 0x2f, 0x5b, 0x48, 0x88, 0x8b, 0x25, 0x25, 0xb1, 0x24, 0x51,
+	0x82, 0x51, 0x81, 0x51, 0x83, 0x27, 0x08, 0xcc, 0x06, 0x89, 0x95, 0x24, 0xa6, 0x17, 0x4b, 0x30,
+	0x29, 0x30, 0x6b, 0x70, 0x06, 0x81, 0xd9, 0x4a, 0x92, 0x5c, 0xcc, 0x8e, 0xc9, 0x60, 0xe5, 0xc5,
+	0x99, 0x55, 0xa9, 0x60, 0xe5, 0xcc, 0x41, 0x60, 0xb6, 0x51, 0x35, 0x17, 0x6b, 0x70, 0x49, 0x7e,
+	0x51, 0xaa, 0x90, 0x26, 0x17, 0x73, 0x40, 0x69, 0x89, 0x10, 0x8f, 0x1e, 0xc4, 0x15, 0x60, 0x0b,
+	0xa4, 0xb8, 0xa0, 0x3c, 0xc7, 0xe4, 0x6c, 0x25, 0x8e, 0x09, 0x9b, 0x24, 0x1d, 0x66, 0x6c, 0x92,
+	0x64, 0x11, 0x52, 0xe5, 0x62, 0x0e, 0x49, 0x4c, 0xc7, 0xa3, 0x94, 0x65, 0xc6, 0x26, 0x49, 0x0e,
+	0x21, 0x05, 0x2e, 0x96, 0xe0, 0x92, 0x44, 0x3c, 0x46, 0x26, 0xb1, 0x81, 0xfd, 0x63, 0x0c, 0x18,
+	0x00, 0x4a, 0x56, 0xfb, 0xdc, 0x00, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,103 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package limit
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+)
+
+type server struct{}
+
+func (server) Put(ctx context.Context, in *Chunk) (*Ack, error) {
+	return &Ack{Size: int64(len(in.Data))}, nil
+}
+
+func (server) Tag(ctx context.Context, in *Chunk) (*Ack, error) {
+	return &Ack{Size: int64(len(in.Tags))}, nil
+}
+
+func (server) Stat(ctx context.Context, in *Chunk) (*Ack, error) {
+	return &Ack{Size: int64(len(in.Data))}, nil
+}
+
+func TestCallInfoLimits(t *testing.T) {
+	for _, test := range []struct {
+		method              string
+		maxBytes, maxFields int
+	}{
+		{"limit@Store/Put", 64, 4},
+		{"limit@Store/Tag", 0, 8},
+		{"limit@Store/Stat", 0, 0},
+	} {
+		info := callinfo.Lookup(test.method)
+		if info == nil {
+			t.Fatalf("Lookup(%s) = nil", test.method)
+		}
+		if info.MaxRequestBytes != test.maxBytes || info.MaxRequestFields != test.maxFields {
+			t.Errorf("%s limits = %d, %d; want %d, %d", test.method, info.MaxRequestBytes, info.MaxRequestFields, test.maxBytes, test.maxFields)
+		}
+	}
+
+	b, err := proto.Marshal(&Chunk{Tags: []string{"a", "b", "c", "d", "e"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := callinfo.Lookup("limit@Store/Put").UnmarshalRequest(b, new(Chunk)); err != proto.ErrTooManyFields {
+		t.Errorf("Put request with 5 fields: %v, want %v", err, proto.ErrTooManyFields)
+	}
+	if err := callinfo.Lookup("limit@Store/Tag").UnmarshalRequest(b, new(Chunk)); err != nil {
+		t.Errorf("Tag request with 5 fields: %v", err)
+	}
+}
+
+// The test server decodes requests with the limits, as the HTTP handler
+// and the queue subscriber do.
+func TestTestServer(t *testing.T) {
+	c := NewStoreTestServer(server{})
+	ctx := context.Background()
+	if ack, err := c.Put(ctx, &Chunk{Data: make([]byte, 62)}); err != nil || ack.Size != 62 {
+		t.Errorf("Put of 64 bytes = %v, %v; want size 62", ack, err)
+	}
+	if _, err := c.Put(ctx, &Chunk{Data: make([]byte, 63)}); err == nil || !strings.Contains(err.Error(), proto.ErrInputTooLarge.Error()) {
+		t.Errorf("Put of 65 bytes: %v, want %v", err, proto.ErrInputTooLarge)
+	}
+	big := &Chunk{Data: bytes.Repeat([]byte("x"), 1000)}
+	if ack, err := c.Stat(ctx, big); err != nil || ack.Size != 1000 {
+		t.Errorf("Stat = %v, %v; want size 1000", ack, err)
+	}
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: logging/logging.proto
+
+/*
+Package logging is a generated protocol buffer package.
+
+Package logging tests the logging decorators the carno plugin
+generates with carno:log=true.
+
+It is generated from these files:
+	logging/logging.proto
+
+It has these top-level messages:
+	Chunk
+	Ack
+*/
+package logging
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	logpb "github.com/ccsnake/protobuf/logpb"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	toggle "github.com/ccsnake/protobuf/toggle"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Chunk struct {
+	Data  []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Token string `protobuf:"bytes,3,opt,name=token,sensitive" json:"token,omitempty"`
+}
+
+func (m *Chunk) Reset()                    { *m = Chunk{} }
+func (m *Chunk) String() string            { return proto.CompactTextString(m) }
+func (*Chunk) ProtoMessage()               {}
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Chunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Chunk) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Chunk) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type Ack struct {
+	Size int64  `protobuf:"varint,1,opt,name=size" json:"size,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *Ack) Reset()                    { *m = Ack{} }
+func (m *Ack) String() string            { return proto.CompactTextString(m) }
+func (*Ack) ProtoMessage()               {}
+func (*Ack) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Ack) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Ack) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Chunk)(nil), "logging.Chunk")
+	proto.RegisterType((*Ack)(nil), "logging.Ack")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Store service
+
+// StoreReadClient is the Read group of StoreClient.
+type StoreReadClient interface {
+	Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+}
+
+type StoreClient interface {
+	StoreReadClient
+	Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+}
+
+type storeClient struct {
+	client.Client
+}
+
+// NewStoreClient creates and starts a client for the Store service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewStoreClient(opts ...client.Option) (StoreClient, error) {
+	c, err := carno1.NewClient("logging", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &storeClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Store_callInfo = []*callinfo.CallInfo{
+	{
+		Service:         "logging@Store",
+		Method:          "Put",
+		RequestType:     "logging.Chunk",
+		ResponseType:    "logging.Ack",
+		File:            "logging/logging.proto",
+		MaxRequestBytes: 64,
+	},
+	{
+		Service:      "logging@Store",
+		Method:       "Stat",
+		RequestType:  "logging.Chunk",
+		ResponseType: "logging.Ack",
+		File:         "logging/logging.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Store_callInfo...)
+}
+
+func (c *storeClient) Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[0])
+	err := c.Client.Call(ctx, "Store", "Put", in, out, opts...)
+	return out, err
+}
+
+func (c *storeClient) Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[1])
+	err := c.Client.Call(ctx, "Store", "Stat", in, out, opts...)
+	return out, err
+}
+
+// StoreClient returns a StoreClient using the client shared by the services
+// of package logging.
+func (a *Logging) StoreClient() StoreClient {
+	return &storeClient{Client: a.c}
+}
+
+// Server API for Store service
+type StoreServer interface {
+	Put(context.Context, *Chunk) (*Ack, error)
+	Stat(context.Context, *Chunk) (*Ack, error)
+}
+
+func RegisterStoreServer(srv StoreServer) {
+	callinfo.RegisterServer("logging@Store")
+	carno1.HandleService(&_Store_serviceDesc, srv)
+}
+
+// NewStoreLoggingServer returns a StoreServer that logs each call
+// of srv with l, unless the toggle.Logging feature is off for its method.
+// Streaming methods are not logged.
+func NewStoreLoggingServer(srv StoreServer, l logpb.CallLogger) StoreServer {
+	return _Store_logServer{srv, l}
+}
+
+type _Store_logServer struct {
+	StoreServer
+	l logpb.CallLogger
+}
+
+func (s _Store_logServer) Put(ctx context.Context, in *Chunk) (*Ack, error) {
+	if !toggle.Enabled(toggle.Logging, "logging@Store/Put") {
+		return s.StoreServer.Put(ctx, in)
+	}
+	start := time.Now()
+	out, err := s.StoreServer.Put(ctx, in)
+	s.l.LogCall(ctx, &logpb.Call{
+		Info:     _Store_callInfo[0],
+		Start:    start,
+		Duration: time.Since(start),
+		Request:  in,
+		Response: out,
+		Err:      err,
+	})
+	return out, err
+}
+
+func (s _Store_logServer) Stat(ctx context.Context, in *Chunk) (*Ack, error) {
+	if !toggle.Enabled(toggle.Logging, "logging@Store/Stat") {
+		return s.StoreServer.Stat(ctx, in)
+	}
+	start := time.Now()
+	out, err := s.StoreServer.Stat(ctx, in)
+	s.l.LogCall(ctx, &logpb.Call{
+		Info:     _Store_callInfo[1],
+		Start:    start,
+		Duration: time.Since(start),
+		Request:  in,
+		Response: out,
+		Err:      err,
+	})
+	return out, err
+}
+
+// NewStoreLoggingClient returns a StoreClient that logs each call
+// of c with l, unless the toggle.Logging feature is off for its method.
+// Streaming methods are not logged.
+func NewStoreLoggingClient(c StoreClient, l logpb.CallLogger) StoreClient {
+	return _Store_logClient{c, l}
+}
+
+type _Store_logClient struct {
+	StoreClient
+	l logpb.CallLogger
+}
+
+func (c _Store_logClient) Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	if !toggle.Enabled(toggle.Logging, "logging@Store/Put") {
+		return c.StoreClient.Put(ctx, in, opts...)
+	}
+	start := time.Now()
+	out, err := c.StoreClient.Put(ctx, in, opts...)
+	c.l.LogCall(ctx, &logpb.Call{
+		Info:     _Store_callInfo[0],
+		Client:   true,
+		Start:    start,
+		Duration: time.Since(start),
+		Request:  in,
+		Response: out,
+		Err:      err,
+	})
+	return out, err
+}
+
+func (c _Store_logClient) Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	if !toggle.Enabled(toggle.Logging, "logging@Store/Stat") {
+		return c.StoreClient.Stat(ctx, in, opts...)
+	}
+	start := time.Now()
+	out, err := c.StoreClient.Stat(ctx, in, opts...)
+	c.l.LogCall(ctx, &logpb.Call{
+		Info:     _Store_callInfo[1],
+		Client:   true,
+		Start:    start,
+		Duration: time.Since(start),
+		Request:  in,
+		Response: out,
+		Err:      err,
+	})
+	return out, err
+}
+
+var _Store_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Store",
+	Methods: []string{
+		"Put",
+		"Stat",
+	},
+}
+
+func init() { proto.RegisterFile("logging/logging.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 202 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcd, 0xc9, 0x4f, 0x4f,
+	0xcf, 0xcc, 0x4b, 0xd7, 0x87, 0xd2, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xec, 0x50, 0xae,
+	0x94, 0x70, 0x72, 0x62, 0x51, 0x5e, 0xbe, 0x7e, 0x7e, 0x41, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x44,
+	0x56, 0xc9, 0x9b, 0x8b, 0xd5, 0x39, 0xa3, 0x34, 0x2f, 0x5b, 0x48, 0x88, 0x8b, 0x25, 0x25, 0xb1,
+	0x24, 0x51, 0x82, 0x51, 0x81, 0x51, 0x83, 0x27, 0x08, 0xcc, 0x06, 0x89, 0xe5, 0x25, 0xe6, 0xa6,
+	0x4a, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0x81, 0xd9, 0x42, 0x52, 0x5c, 0xac, 0x25, 0xf9, 0xd9,
+	0xa9, 0x79, 0x12, 0xcc, 0x20, 0x41, 0x27, 0x96, 0x86, 0x4d, 0x92, 0x8c, 0x41, 0x10, 0x21, 0x25,
+	0x5d, 0x2e, 0x66, 0xc7, 0x64, 0xb0, 0x51, 0xc5, 0x99, 0x55, 0xa9, 0x60, 0xa3, 0x98, 0x83, 0xc0,
+	0x6c, 0x6c, 0x46, 0x19, 0x25, 0x71, 0xb1, 0x06, 0x97, 0xe4, 0x17, 0xa5, 0x0a, 0x69, 0x72, 0x31,
+	0x07, 0x94, 0x96, 0x08, 0xf1, 0xe9, 0xc1, 0x5c, 0x0e, 0x76, 0x92, 0x14, 0x0f, 0x9c, 0xef, 0x98,
+	0x9c, 0xad, 0xc4, 0x32, 0x61, 0x93, 0xa4, 0x83, 0x90, 0x1e, 0x17, 0x4b, 0x70, 0x49, 0x22, 0x21,
+	0xb5, 0x1c, 0x5d, 0x9b, 0x24, 0x59, 0x8a, 0x52, 0x13, 0x53, 0x92, 0xd8, 0xc0, 0xde, 0x34, 0x06,
+	0x0c, 0x00, 0x91, 0x3c, 0x7c, 0xfb, 0x1d, 0x01, 0x00, 0x00,
+}
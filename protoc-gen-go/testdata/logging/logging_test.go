@@ -0,0 +1,124 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/logpb"
+	"github.com/ccsnake/protobuf/toggle"
+)
+
+type server struct{}
+
+func (server) Put(ctx context.Context, in *Chunk) (*Ack, error) {
+	if in.Name == "" {
+		return nil, errors.New("no name")
+	}
+	return &Ack{Size: int64(len(in.Data)), Name: in.Name}, nil
+}
+
+func (server) Stat(ctx context.Context, in *Chunk) (*Ack, error) {
+	return &Ack{Name: in.Name}, nil
+}
+
+// local is a StoreClient that calls a StoreServer directly.
+type local struct {
+	srv StoreServer
+}
+
+func (c local) Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	return c.srv.Put(ctx, in)
+}
+
+func (c local) Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	return c.srv.Stat(ctx, in)
+}
+
+// recorder is a CallLogger that keeps the calls it logs.
+type recorder []*logpb.Call
+
+func (r *recorder) LogCall(ctx context.Context, c *logpb.Call) { *r = append(*r, c) }
+
+func TestLoggingServer(t *testing.T) {
+	var r recorder
+	srv := NewStoreLoggingServer(server{}, &r)
+	srv.Put(context.Background(), &Chunk{Data: []byte("abc"), Name: "x"})
+	srv.Put(context.Background(), &Chunk{})
+	if len(r) != 2 {
+		t.Fatalf("logged %d calls, want 2", len(r))
+	}
+	if c := r[0]; c.Info.FullMethod() != "logging@Store/Put" || c.Client || c.Err != nil || c.Response.(*Ack).Size != 3 {
+		t.Errorf("first call logged as %+v", c)
+	}
+	if c := r[1]; c.Status() != "error" || c.Err.Error() != "no name" {
+		t.Errorf("failed call logged as %+v", c)
+	}
+}
+
+func TestLoggingClient(t *testing.T) {
+	var buf bytes.Buffer
+	l := logpb.NewSlogLogger(slog.New(slog.NewTextHandler(&buf, nil)))
+	c := NewStoreLoggingClient(local{server{}}, l)
+	if _, err := c.Stat(context.Background(), &Chunk{Name: "x", Token: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{"method=logging@Store/Stat", "side=client", "status=ok", "request.token=REDACTED"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log %q does not contain %q", out, want)
+		}
+	}
+	if strings.Contains(out, "secret") {
+		t.Errorf("log %q contains the sensitive token", out)
+	}
+}
+
+func TestLoggingToggle(t *testing.T) {
+	toggle.Set(toggle.Logging, "logging@Store", false)
+	defer toggle.Default.Clear(toggle.Logging, "logging@Store")
+
+	var r recorder
+	srv := NewStoreLoggingServer(server{}, &r)
+	if _, err := srv.Stat(context.Background(), &Chunk{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(r) != 0 {
+		t.Errorf("logged %d calls with logging off, want 0", len(r))
+	}
+}
@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: longrunning/longrunning.proto
+
+/*
+Package longrunning is a generated protocol buffer package.
+
+Package longrunning tests the helpers the carno plugin generates for
+methods starting long-running operations.
+
+It is generated from these files:
+	longrunning/longrunning.proto
+	longrunning/longrunning2.proto
+
+It has these top-level messages:
+	BuildRequest
+	Artifact
+	Status
+	Operation
+	GetOperationRequest
+	ExportJob
+	ExportRequest
+	GetExportRequest
+	Task
+	GetTaskRequest
+*/
+package longrunning
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	lro "github.com/ccsnake/protobuf/lro"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type BuildRequest struct {
+	Target string `protobuf:"bytes,1,opt,name=target" json:"target,omitempty"`
+}
+
+func (m *BuildRequest) Reset()                    { *m = BuildRequest{} }
+func (m *BuildRequest) String() string            { return proto.CompactTextString(m) }
+func (*BuildRequest) ProtoMessage()               {}
+func (*BuildRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *BuildRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+type Artifact struct {
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+}
+
+func (m *Artifact) Reset()                    { *m = Artifact{} }
+func (m *Artifact) String() string            { return proto.CompactTextString(m) }
+func (*Artifact) ProtoMessage()               {}
+func (*Artifact) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Artifact) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type Status struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *Status) Reset()                    { *m = Status{} }
+func (m *Status) String() string            { return proto.CompactTextString(m) }
+func (*Status) ProtoMessage()               {}
+func (*Status) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Status) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *Status) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// Operation follows the usual pattern, found without naming its fields.
+type Operation struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Done bool   `protobuf:"varint,2,opt,name=done" json:"done,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//	*Operation_Response
+	//	*Operation_Error
+	Result isOperation_Result `protobuf_oneof:"result"`
+}
+
+func (m *Operation) Reset()                    { *m = Operation{} }
+func (m *Operation) String() string            { return proto.CompactTextString(m) }
+func (*Operation) ProtoMessage()               {}
+func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+type isOperation_Result interface{ isOperation_Result() }
+
+type Operation_Response struct {
+	Response *Artifact `protobuf:"bytes,3,opt,name=response,oneof"`
+}
+type Operation_Error struct {
+	Error *Status `protobuf:"bytes,4,opt,name=error,oneof"`
+}
+
+func (*Operation_Response) isOperation_Result() {}
+func (*Operation_Error) isOperation_Result()    {}
+
+func (m *Operation) GetResult() isOperation_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *Operation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Operation) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *Operation) GetResponse() *Artifact {
+	if x, ok := m.GetResult().(*Operation_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (m *Operation) GetError() *Status {
+	if x, ok := m.GetResult().(*Operation_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Operation) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Operation_OneofMarshaler, _Operation_OneofUnmarshaler, _Operation_OneofSizer, []interface{}{
+		(*Operation_Response)(nil),
+		(*Operation_Error)(nil),
+	}
+}
+
+func _Operation_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Operation)
+	// result
+	switch x := m.Result.(type) {
+	case *Operation_Response:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Response); err != nil {
+			return err
+		}
+	case *Operation_Error:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Operation.Result has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Operation_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Operation)
+	switch tag {
+	case 3: // result.response
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Artifact)
+		err := b.DecodeMessage(msg)
+		m.Result = &Operation_Response{msg}
+		return true, err
+	case 4: // result.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Status)
+		err := b.DecodeMessage(msg)
+		m.Result = &Operation_Error{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Operation_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Operation)
+	// result
+	switch x := m.Result.(type) {
+	case *Operation_Response:
+		s := proto.Size(x.Response)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Operation_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type GetOperationRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *GetOperationRequest) Reset()                    { *m = GetOperationRequest{} }
+func (m *GetOperationRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetOperationRequest) ProtoMessage()               {}
+func (*GetOperationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *GetOperationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// ExportJob has a result of its own, and no error.
+type ExportJob struct {
+	Id       string    `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Finished bool      `protobuf:"varint,2,opt,name=finished" json:"finished,omitempty"`
+	Archive  *Artifact `protobuf:"bytes,3,opt,name=archive" json:"archive,omitempty"`
+}
+
+func (m *ExportJob) Reset()                    { *m = ExportJob{} }
+func (m *ExportJob) String() string            { return proto.CompactTextString(m) }
+func (*ExportJob) ProtoMessage()               {}
+func (*ExportJob) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *ExportJob) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExportJob) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *ExportJob) GetArchive() *Artifact {
+	if m != nil {
+		return m.Archive
+	}
+	return nil
+}
+
+type ExportRequest struct {
+	Target string `protobuf:"bytes,1,opt,name=target" json:"target,omitempty"`
+}
+
+func (m *ExportRequest) Reset()                    { *m = ExportRequest{} }
+func (m *ExportRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()               {}
+func (*ExportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ExportRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+type GetExportRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetExportRequest) Reset()                    { *m = GetExportRequest{} }
+func (m *GetExportRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetExportRequest) ProtoMessage()               {}
+func (*GetExportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *GetExportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BuildRequest)(nil), "longrunning.BuildRequest")
+	proto.RegisterType((*Artifact)(nil), "longrunning.Artifact")
+	proto.RegisterType((*Status)(nil), "longrunning.Status")
+	proto.RegisterType((*Operation)(nil), "longrunning.Operation")
+	proto.RegisterType((*GetOperationRequest)(nil), "longrunning.GetOperationRequest")
+	proto.RegisterType((*ExportJob)(nil), "longrunning.ExportJob")
+	proto.RegisterType((*ExportRequest)(nil), "longrunning.ExportRequest")
+	proto.RegisterType((*GetExportRequest)(nil), "longrunning.GetExportRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Builder service
+type BuilderClient interface {
+	Build(ctx context.Context, in *BuildRequest, opts ...client.CallOption) (*Operation, error)
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...client.CallOption) (*Operation, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...client.CallOption) (*ExportJob, error)
+	GetExport(ctx context.Context, in *GetExportRequest, opts ...client.CallOption) (*ExportJob, error)
+}
+
+type builderClient struct {
+	client.Client
+}
+
+// NewBuilderClient creates and starts a client for the Builder service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewBuilderClient(opts ...client.Option) (BuilderClient, error) {
+	c, err := carno1.NewClient("longrunning", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &builderClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Builder_callInfo = []*callinfo.CallInfo{
+	{
+		Service:      "longrunning@Builder",
+		Method:       "Build",
+		RequestType:  "longrunning.BuildRequest",
+		ResponseType: "longrunning.Operation",
+		File:         "longrunning/longrunning.proto",
+	},
+	{
+		Service:      "longrunning@Builder",
+		Method:       "GetOperation",
+		RequestType:  "longrunning.GetOperationRequest",
+		ResponseType: "longrunning.Operation",
+		File:         "longrunning/longrunning.proto",
+	},
+	{
+		Service:      "longrunning@Builder",
+		Method:       "Export",
+		RequestType:  "longrunning.ExportRequest",
+		ResponseType: "longrunning.ExportJob",
+		File:         "longrunning/longrunning.proto",
+	},
+	{
+		Service:      "longrunning@Builder",
+		Method:       "GetExport",
+		RequestType:  "longrunning.GetExportRequest",
+		ResponseType: "longrunning.ExportJob",
+		File:         "longrunning/longrunning.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Builder_callInfo...)
+}
+
+func (c *builderClient) Build(ctx context.Context, in *BuildRequest, opts ...client.CallOption) (*Operation, error) {
+	out := new(Operation)
+	ctx = callinfo.NewContext(ctx, _Builder_callInfo[0])
+	err := c.Client.Call(ctx, "Builder", "Build", in, out, opts...)
+	return out, err
+}
+
+func (c *builderClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...client.CallOption) (*Operation, error) {
+	out := new(Operation)
+	ctx = callinfo.NewContext(ctx, _Builder_callInfo[1])
+	err := c.Client.Call(ctx, "Builder", "GetOperation", in, out, opts...)
+	return out, err
+}
+
+func (c *builderClient) Export(ctx context.Context, in *ExportRequest, opts ...client.CallOption) (*ExportJob, error) {
+	out := new(ExportJob)
+	ctx = callinfo.NewContext(ctx, _Builder_callInfo[2])
+	err := c.Client.Call(ctx, "Builder", "Export", in, out, opts...)
+	return out, err
+}
+
+func (c *builderClient) GetExport(ctx context.Context, in *GetExportRequest, opts ...client.CallOption) (*ExportJob, error) {
+	out := new(ExportJob)
+	ctx = callinfo.NewContext(ctx, _Builder_callInfo[3])
+	err := c.Client.Call(ctx, "Builder", "GetExport", in, out, opts...)
+	return out, err
+}
+
+// BuilderClient returns a BuilderClient using the client shared by the services
+// of package longrunning.
+func (a *Longrunning) BuilderClient() BuilderClient {
+	return &builderClient{Client: a.c}
+}
+
+// BuilderWaiter waits for the long-running operations the methods of
+// Builder start. Client must be set.
+type BuilderWaiter struct {
+	// Client makes the calls.
+	Client BuilderClient
+}
+
+// BuildAndWait calls Build with in, then GetOperation every
+// pollInterval until the operation it started is done, and returns its response.
+// It returns an *lro.Error if the operation failed, and the error of
+// ctx if ctx is done first; the operation may still be running then.
+func (w *BuilderWaiter) BuildAndWait(ctx context.Context, in *BuildRequest, pollInterval time.Duration, opts ...client.CallOption) (*Artifact, error) {
+	op, err := w.Client.Build(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for !op.GetDone() {
+		if err := lro.Sleep(ctx, pollInterval); err != nil {
+			return nil, err
+		}
+		op, err = w.Client.GetOperation(ctx, &GetOperationRequest{Name: op.GetName()}, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if e := op.GetError(); e != nil {
+		return nil, &lro.Error{Method: "longrunning@Builder/Build", Status: e}
+	}
+	return op.GetResponse(), nil
+}
+
+// ExportAndWait calls Export with in, then GetExport every
+// pollInterval until the operation it started is done, and returns its archive.
+// It returns an *lro.Error if the operation failed, and the error of
+// ctx if ctx is done first; the operation may still be running then.
+func (w *BuilderWaiter) ExportAndWait(ctx context.Context, in *ExportRequest, pollInterval time.Duration, opts ...client.CallOption) (*Artifact, error) {
+	op, err := w.Client.Export(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for !op.GetFinished() {
+		if err := lro.Sleep(ctx, pollInterval); err != nil {
+			return nil, err
+		}
+		op, err = w.Client.GetExport(ctx, &GetExportRequest{Id: op.GetId()}, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return op.GetArchive(), nil
+}
+
+// Server API for Builder service
+type BuilderServer interface {
+	Build(context.Context, *BuildRequest) (*Operation, error)
+	GetOperation(context.Context, *GetOperationRequest) (*Operation, error)
+	Export(context.Context, *ExportRequest) (*ExportJob, error)
+	GetExport(context.Context, *GetExportRequest) (*ExportJob, error)
+}
+
+func RegisterBuilderServer(srv BuilderServer) {
+	callinfo.RegisterServer("longrunning@Builder")
+	carno1.HandleService(&_Builder_serviceDesc, srv)
+}
+
+var _Builder_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Builder",
+	Methods: []string{
+		"Build",
+		"GetOperation",
+		"Export",
+		"GetExport",
+	},
+}
+
+func init() { proto.RegisterFile("longrunning/longrunning.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdd, 0x6a, 0xdb, 0x30,
+	0x14, 0x6e, 0xbc, 0xc6, 0x71, 0x4e, 0xbb, 0x32, 0x4e, 0x58, 0x70, 0x0c, 0x1d, 0x41, 0x17, 0x5d,
+	0xc7, 0xa0, 0x81, 0x16, 0x76, 0xbf, 0xc0, 0x68, 0xe8, 0xc5, 0x06, 0xde, 0x13, 0x28, 0xd1, 0x69,
+	0x2c, 0x48, 0x25, 0x4f, 0x92, 0xc7, 0xde, 0x66, 0xef, 0xd0, 0x47, 0xc8, 0xfd, 0xde, 0x69, 0x58,
+	0xfe, 0xc1, 0x0e, 0xd9, 0xcf, 0xdd, 0x77, 0x74, 0xbe, 0x4f, 0xfa, 0xce, 0x27, 0x09, 0x2e, 0x77,
+	0x5a, 0x6d, 0x4d, 0xa1, 0x94, 0x54, 0xdb, 0x45, 0x07, 0xdf, 0xe4, 0x46, 0x3b, 0x8d, 0x67, 0x9d,
+	0xa5, 0x64, 0xb2, 0xe1, 0x46, 0xe9, 0x85, 0xce, 0x9d, 0xd4, 0xca, 0x56, 0x0c, 0x76, 0x05, 0xe7,
+	0xcb, 0x42, 0xee, 0x44, 0x4a, 0xdf, 0x0a, 0xb2, 0x0e, 0xa7, 0x10, 0x3a, 0x6e, 0xb6, 0xe4, 0xe2,
+	0xc1, 0x7c, 0x70, 0x3d, 0x4e, 0xeb, 0x8a, 0xbd, 0x81, 0xe8, 0xa3, 0x71, 0xf2, 0x91, 0x6f, 0x1c,
+	0x22, 0x9c, 0xe6, 0xdc, 0x65, 0x35, 0xc3, 0x63, 0xf6, 0x01, 0xc2, 0xaf, 0x8e, 0xbb, 0xc2, 0x96,
+	0xdd, 0x8d, 0x16, 0xe4, 0xbb, 0xc3, 0xd4, 0x63, 0x8c, 0x61, 0xf4, 0x44, 0xd6, 0xf2, 0x2d, 0xc5,
+	0x81, 0x17, 0x35, 0x25, 0xfb, 0x39, 0x80, 0xf1, 0x97, 0x9c, 0x0c, 0x2f, 0x4d, 0x95, 0x5a, 0xc5,
+	0x9f, 0xa8, 0xd9, 0xb9, 0xc4, 0xe5, 0x9a, 0xd0, 0xaa, 0x12, 0x46, 0xa9, 0xc7, 0x78, 0x07, 0x91,
+	0x21, 0x9b, 0x6b, 0x65, 0x29, 0x7e, 0x31, 0x1f, 0x5c, 0x9f, 0xdd, 0xbe, 0xbe, 0xe9, 0x4e, 0xdf,
+	0x58, 0x5d, 0x9d, 0xa4, 0x2d, 0x11, 0xdf, 0xc3, 0x90, 0x8c, 0xd1, 0x26, 0x3e, 0xf5, 0x8a, 0x49,
+	0x4f, 0x51, 0x99, 0x5f, 0x9d, 0xa4, 0x15, 0x67, 0x19, 0x41, 0x68, 0xc8, 0x16, 0x3b, 0xc7, 0xde,
+	0xc1, 0xe4, 0x9e, 0x5c, 0xeb, 0xb1, 0x09, 0xea, 0x88, 0x55, 0x96, 0xc1, 0xf8, 0xd3, 0x8f, 0x5c,
+	0x1b, 0xf7, 0xa0, 0xd7, 0x78, 0x01, 0x81, 0x14, 0x75, 0x3b, 0x90, 0x02, 0x13, 0x88, 0x1e, 0xa5,
+	0x92, 0x36, 0x23, 0x51, 0xcf, 0xd2, 0xd6, 0xb8, 0x80, 0x11, 0x37, 0x9b, 0x4c, 0x7e, 0xff, 0xfb,
+	0x38, 0x69, 0xc3, 0x62, 0x6f, 0xe1, 0x65, 0x75, 0xd2, 0xbf, 0xee, 0x8d, 0xc1, 0xab, 0x7b, 0x72,
+	0x7d, 0xee, 0x81, 0xb3, 0xdb, 0x5f, 0x01, 0x8c, 0xfc, 0x23, 0x20, 0x83, 0x9f, 0x61, 0xe8, 0x21,
+	0xce, 0x7a, 0x0e, 0xba, 0x6f, 0x24, 0x99, 0xf6, 0x5a, 0x6d, 0x32, 0x0c, 0xf7, 0xcf, 0xb3, 0x0b,
+	0x38, 0xef, 0xa6, 0x85, 0xab, 0x83, 0x7a, 0xde, 0xd3, 0x1e, 0x09, 0xf6, 0x4f, 0xbb, 0xa3, 0x80,
+	0xb0, 0x1a, 0x03, 0x93, 0x1e, 0xa3, 0x37, 0x5b, 0x32, 0x3d, 0xd2, 0x7b, 0xd0, 0x6b, 0x76, 0xb5,
+	0x7f, 0x9e, 0x31, 0x18, 0xb7, 0x59, 0x60, 0x20, 0x45, 0xd2, 0x5e, 0x00, 0x6b, 0x82, 0xc5, 0x65,
+	0x97, 0x73, 0x79, 0x68, 0xf6, 0xbf, 0xce, 0x5a, 0x87, 0xfe, 0x6b, 0xdd, 0xfd, 0x1e, 0x00, 0x78,
+	0xf2, 0x2e, 0x36, 0x9d, 0x03, 0x00, 0x00,
+}
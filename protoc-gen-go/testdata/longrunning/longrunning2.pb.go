@@ -0,0 +1,216 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: longrunning/longrunning2.proto
+
+package longrunning
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	lro "github.com/ccsnake/protobuf/lro"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Task has no result but itself, and a string error.
+type Task struct {
+	Id               *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Finished         *bool   `protobuf:"varint,2,opt,name=finished" json:"finished,omitempty"`
+	Failure          *string `protobuf:"bytes,3,opt,name=failure" json:"failure,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Task) Reset()                    { *m = Task{} }
+func (m *Task) String() string            { return proto.CompactTextString(m) }
+func (*Task) ProtoMessage()               {}
+func (*Task) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+func (m *Task) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *Task) GetFinished() bool {
+	if m != nil && m.Finished != nil {
+		return *m.Finished
+	}
+	return false
+}
+
+func (m *Task) GetFailure() string {
+	if m != nil && m.Failure != nil {
+		return *m.Failure
+	}
+	return ""
+}
+
+type GetTaskRequest struct {
+	Id               *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *GetTaskRequest) Reset()                    { *m = GetTaskRequest{} }
+func (m *GetTaskRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTaskRequest) ProtoMessage()               {}
+func (*GetTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1} }
+
+func (m *GetTaskRequest) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Task)(nil), "longrunning.Task")
+	proto.RegisterType((*GetTaskRequest)(nil), "longrunning.GetTaskRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Tasks service
+type TasksClient interface {
+	Start(ctx context.Context, in *Task, opts ...client.CallOption) (*Task, error)
+	Get(ctx context.Context, in *GetTaskRequest, opts ...client.CallOption) (*Task, error)
+}
+
+type tasksClient struct {
+	client.Client
+}
+
+// NewTasksClient creates and starts a client for the Tasks service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewTasksClient(opts ...client.Option) (TasksClient, error) {
+	c, err := carno1.NewClient("longrunning", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &tasksClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Tasks_callInfo = []*callinfo.CallInfo{
+	{
+		Service:      "longrunning@Tasks",
+		Method:       "Start",
+		RequestType:  "longrunning.Task",
+		ResponseType: "longrunning.Task",
+		File:         "longrunning/longrunning2.proto",
+	},
+	{
+		Service:      "longrunning@Tasks",
+		Method:       "Get",
+		RequestType:  "longrunning.GetTaskRequest",
+		ResponseType: "longrunning.Task",
+		File:         "longrunning/longrunning2.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Tasks_callInfo...)
+}
+
+func (c *tasksClient) Start(ctx context.Context, in *Task, opts ...client.CallOption) (*Task, error) {
+	out := new(Task)
+	ctx = callinfo.NewContext(ctx, _Tasks_callInfo[0])
+	err := c.Client.Call(ctx, "Tasks", "Start", in, out, opts...)
+	return out, err
+}
+
+func (c *tasksClient) Get(ctx context.Context, in *GetTaskRequest, opts ...client.CallOption) (*Task, error) {
+	out := new(Task)
+	ctx = callinfo.NewContext(ctx, _Tasks_callInfo[1])
+	err := c.Client.Call(ctx, "Tasks", "Get", in, out, opts...)
+	return out, err
+}
+
+// TasksClient returns a TasksClient using the client shared by the services
+// of package longrunning.
+func (a *Longrunning) TasksClient() TasksClient {
+	return &tasksClient{Client: a.c}
+}
+
+// TasksWaiter waits for the long-running operations the methods of
+// Tasks start. Client must be set.
+type TasksWaiter struct {
+	// Client makes the calls.
+	Client TasksClient
+}
+
+// StartAndWait calls Start with in, then Get every
+// pollInterval until the operation it started is done, and returns the operation.
+// It returns an *lro.Error if the operation failed, and the error of
+// ctx if ctx is done first; the operation may still be running then.
+func (w *TasksWaiter) StartAndWait(ctx context.Context, in *Task, pollInterval time.Duration, opts ...client.CallOption) (*Task, error) {
+	op, err := w.Client.Start(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for !op.GetFinished() {
+		if err := lro.Sleep(ctx, pollInterval); err != nil {
+			return nil, err
+		}
+		op, err = w.Client.Get(ctx, &GetTaskRequest{Id: proto.String(op.GetId())}, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if e := op.GetFailure(); e != "" {
+		return nil, &lro.Error{Method: "longrunning@Tasks/Start", Message: e}
+	}
+	return op, nil
+}
+
+// Server API for Tasks service
+type TasksServer interface {
+	Start(context.Context, *Task) (*Task, error)
+	Get(context.Context, *GetTaskRequest) (*Task, error)
+}
+
+func RegisterTasksServer(srv TasksServer) {
+	callinfo.RegisterServer("longrunning@Tasks")
+	carno1.HandleService(&_Tasks_serviceDesc, srv)
+}
+
+var _Tasks_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Tasks",
+	Methods: []string{
+		"Start",
+		"Get",
+	},
+}
+
+func init() { proto.RegisterFile("longrunning/longrunning2.proto", fileDescriptor1) }
+
+var fileDescriptor1 = []byte{
+	// 206 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0xc9, 0xcf, 0x4b,
+	0x2f, 0x2a, 0xcd, 0xcb, 0xcb, 0xcc, 0x4b, 0xd7, 0x47, 0x62, 0x1b, 0xe9, 0x15, 0x14, 0xe5, 0x97,
+	0xe4, 0x0b, 0x71, 0x23, 0x89, 0x49, 0x09, 0x27, 0x27, 0x16, 0xe5, 0xe5, 0xeb, 0xe7, 0x17, 0x94,
+	0x64, 0xe6, 0xe7, 0x15, 0x43, 0x54, 0x28, 0xf9, 0x70, 0xb1, 0x84, 0x24, 0x16, 0x67, 0x0b, 0xf1,
+	0x71, 0x31, 0x65, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x31, 0x65, 0xa6, 0x08, 0x49,
+	0x71, 0x71, 0xa4, 0x65, 0xe6, 0x65, 0x16, 0x67, 0xa4, 0xa6, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70,
+	0x04, 0xc1, 0xf9, 0x42, 0x12, 0x5c, 0xec, 0x69, 0x89, 0x99, 0x39, 0xa5, 0x45, 0xa9, 0x12, 0xcc,
+	0x60, 0x0d, 0x30, 0xae, 0x92, 0x02, 0x17, 0x9f, 0x7b, 0x6a, 0x09, 0xc8, 0xc0, 0xa0, 0xd4, 0xc2,
+	0xd2, 0xd4, 0xe2, 0x12, 0x74, 0x73, 0x8d, 0xfa, 0x19, 0xb9, 0x58, 0x41, 0xf2, 0xc5, 0x42, 0xfe,
+	0x5c, 0xac, 0xc1, 0x25, 0x89, 0x45, 0x25, 0x42, 0x82, 0x7a, 0x48, 0xae, 0xd4, 0x03, 0x49, 0x4a,
+	0x61, 0x0a, 0x29, 0x29, 0x1c, 0xda, 0x24, 0x29, 0xc3, 0xc5, 0xec, 0x9e, 0x5a, 0x22, 0xc4, 0x94,
+	0x99, 0x22, 0x05, 0x77, 0x8d, 0x16, 0xcc, 0x72, 0x21, 0x53, 0x88, 0xac, 0x34, 0x8a, 0x5e, 0x54,
+	0xe7, 0x60, 0x31, 0x18, 0x30, 0x00, 0x5e, 0xb5, 0x63, 0xb0, 0x44, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,156 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package longrunning
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/lro"
+	"github.com/golang/protobuf/proto"
+)
+
+// builder is a BuilderClient whose operations are done on the polls-th
+// poll, and fail with status if it is set.
+type builder struct {
+	BuilderClient // not implemented
+	polls         int
+	status        *Status
+	names         []string // of the operations polled
+}
+
+func (b *builder) Build(ctx context.Context, in *BuildRequest, opts ...client.CallOption) (*Operation, error) {
+	return &Operation{Name: "operations/" + in.Target, Done: b.polls == 0}, nil
+}
+
+func (b *builder) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...client.CallOption) (*Operation, error) {
+	b.names = append(b.names, in.Name)
+	op := &Operation{Name: in.Name}
+	if len(b.names) < b.polls {
+		return op, nil
+	}
+	op.Done = true
+	if b.status != nil {
+		op.Result = &Operation_Error{b.status}
+	} else {
+		op.Result = &Operation_Response{&Artifact{Path: "out/" + in.Name}}
+	}
+	return op, nil
+}
+
+func (b *builder) Export(ctx context.Context, in *ExportRequest, opts ...client.CallOption) (*ExportJob, error) {
+	return &ExportJob{Id: in.Target}, nil
+}
+
+func (b *builder) GetExport(ctx context.Context, in *GetExportRequest, opts ...client.CallOption) (*ExportJob, error) {
+	return &ExportJob{Id: in.Id, Finished: true, Archive: &Artifact{Path: in.Id + ".tar"}}, nil
+}
+
+func TestAndWait(t *testing.T) {
+	b := &builder{polls: 3}
+	w := &BuilderWaiter{Client: b}
+	got, err := w.BuildAndWait(context.Background(), &BuildRequest{Target: "app"}, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&Artifact{Path: "out/operations/app"}); !proto.Equal(got, want) {
+		t.Errorf("BuildAndWait = %v, want %v", got, want)
+	}
+	if want := []string{"operations/app", "operations/app", "operations/app"}; !reflect.DeepEqual(b.names, want) {
+		t.Errorf("polled %q, want %q", b.names, want)
+	}
+
+	// The poll request and the result can be named by the option.
+	got, err = w.ExportAndWait(context.Background(), &ExportRequest{Target: "app"}, time.Millisecond)
+	if want := (&Artifact{Path: "app.tar"}); err != nil || !proto.Equal(got, want) {
+		t.Errorf("ExportAndWait = %v, %v; want %v", got, err, want)
+	}
+}
+
+func TestAndWaitDone(t *testing.T) {
+	// An operation done at once is not polled.
+	b := &builder{}
+	w := &BuilderWaiter{Client: b}
+	if _, err := w.BuildAndWait(context.Background(), &BuildRequest{Target: "app"}, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.names) != 0 {
+		t.Errorf("polled %q, want no polls", b.names)
+	}
+}
+
+func TestAndWaitErrors(t *testing.T) {
+	w := &BuilderWaiter{Client: &builder{polls: 1, status: &Status{Code: 13, Message: "out of disk"}}}
+	got, err := w.BuildAndWait(context.Background(), &BuildRequest{Target: "app"}, time.Millisecond)
+	e, ok := err.(*lro.Error)
+	if got != nil || !ok || e.Method != "longrunning@Builder/Build" || !proto.Equal(e.Status, &Status{Code: 13, Message: "out of disk"}) {
+		t.Errorf("BuildAndWait of a failing operation = %v, %#v", got, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	w = &BuilderWaiter{Client: &builder{polls: 1 << 30}}
+	if _, err := w.BuildAndWait(ctx, &BuildRequest{Target: "app"}, time.Millisecond); err != context.DeadlineExceeded {
+		t.Errorf("BuildAndWait past the deadline = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+// tasks is a TasksClient whose tasks fail on the first poll.
+type tasks struct{}
+
+func (tasks) Start(ctx context.Context, in *Task, opts ...client.CallOption) (*Task, error) {
+	return in, nil
+}
+
+func (tasks) Get(ctx context.Context, in *GetTaskRequest, opts ...client.CallOption) (*Task, error) {
+	if in.GetId() == "" {
+		return nil, errors.New("no id")
+	}
+	return &Task{Id: in.Id, Finished: proto.Bool(true), Failure: proto.String("crashed")}, nil
+}
+
+func TestAndWaitProto2(t *testing.T) {
+	w := &TasksWaiter{Client: tasks{}}
+	_, err := w.StartAndWait(context.Background(), &Task{Id: proto.String("t1")}, time.Millisecond)
+	if e, ok := err.(*lro.Error); !ok || e.Method != "longrunning@Tasks/Start" || e.Message != "crashed" {
+		t.Errorf("StartAndWait = %#v, want a *lro.Error with message crashed", err)
+	}
+
+	task, err := w.StartAndWait(context.Background(), &Task{Id: proto.String("t2"), Finished: proto.Bool(true)}, time.Millisecond)
+	if err != nil || task.GetId() != "t2" {
+		t.Errorf("StartAndWait of a finished task = %v, %v; want t2", task, err)
+	}
+}
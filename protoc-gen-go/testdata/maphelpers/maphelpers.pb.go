@@ -0,0 +1,331 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: maphelpers/maphelpers.proto
+
+/*
+Package maphelpers is a generated protocol buffer package.
+
+It is generated from these files:
+	maphelpers/maphelpers.proto
+
+It has these top-level messages:
+	Inventory
+	Item
+*/
+package maphelpers
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Kind int32
+
+const (
+	Kind_UNKNOWN Kind = 0
+	Kind_TOOL    Kind = 1
+)
+
+var Kind_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "TOOL",
+}
+var Kind_value = map[string]int32{
+	"UNKNOWN": 0,
+	"TOOL":    1,
+}
+
+func (x Kind) String() string {
+	return proto.EnumName(Kind_name, int32(x))
+}
+func (Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type Inventory struct {
+	Counts  map[string]int32   `protobuf:"bytes,1,rep,name=counts" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Names   map[int32]string   `protobuf:"bytes,2,rep,name=names" json:"names,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Items   map[int64]*Item    `protobuf:"bytes,3,rep,name=items" json:"items,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Blobs   map[uint32][]byte  `protobuf:"bytes,4,rep,name=blobs" json:"blobs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Weights map[uint64]float64 `protobuf:"bytes,5,rep,name=weights" json:"weights,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Flags   map[bool]string    `protobuf:"bytes,6,rep,name=flags" json:"flags,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Kinds   map[int32]Kind     `protobuf:"bytes,7,rep,name=kinds" json:"kinds,omitempty" protobuf_key:"zigzag32,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=maphelpers.Kind"`
+	// Named so that its getter takes the name of the Get<Field>Or method of
+	// counts.
+	CountsOr string `protobuf:"bytes,8,opt,name=counts_or,json=countsOr" json:"counts_or,omitempty"`
+}
+
+func (m *Inventory) Reset()                    { *m = Inventory{} }
+func (m *Inventory) String() string            { return proto.CompactTextString(m) }
+func (*Inventory) ProtoMessage()               {}
+func (*Inventory) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+// RangeCountsSorted calls f for each entry of counts, in order of key, as
+// the text format and the deterministic encoding order them.
+func (m *Inventory) RangeCountsSorted(f func(k string, v int32)) {
+	entries := m.GetCounts()
+	keys := make([]string, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	proto.SortStringKeys(keys)
+	for _, k := range keys {
+		f(k, entries[k])
+	}
+}
+
+// GetCountsOr_ returns the value for k in counts, or def if it has none.
+func (m *Inventory) GetCountsOr_(k string, def int32) int32 {
+	if v, ok := m.GetCounts()[k]; ok {
+		return v
+	}
+	return def
+}
+
+// RangeNamesSorted calls f for each entry of names, in order of key, as
+// the text format and the deterministic encoding order them.
+func (m *Inventory) RangeNamesSorted(f func(k int32, v string)) {
+	entries := m.GetNames()
+	keys := make([]int32, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	proto.SortInt32Keys(keys)
+	for _, k := range keys {
+		f(k, entries[k])
+	}
+}
+
+// GetNamesOr returns the value for k in names, or def if it has none.
+func (m *Inventory) GetNamesOr(k int32, def string) string {
+	if v, ok := m.GetNames()[k]; ok {
+		return v
+	}
+	return def
+}
+
+// RangeItemsSorted calls f for each entry of items, in order of key, as
+// the text format and the deterministic encoding order them.
+func (m *Inventory) RangeItemsSorted(f func(k int64, v *Item)) {
+	entries := m.GetItems()
+	keys := make([]int64, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	proto.SortInt64Keys(keys)
+	for _, k := range keys {
+		f(k, entries[k])
+	}
+}
+
+// GetItemsOr returns the value for k in items, or def if it has none.
+func (m *Inventory) GetItemsOr(k int64, def *Item) *Item {
+	if v, ok := m.GetItems()[k]; ok {
+		return v
+	}
+	return def
+}
+
+// RangeBlobsSorted calls f for each entry of blobs, in order of key, as
+// the text format and the deterministic encoding order them.
+func (m *Inventory) RangeBlobsSorted(f func(k uint32, v []byte)) {
+	entries := m.GetBlobs()
+	keys := make([]uint32, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	proto.SortUint32Keys(keys)
+	for _, k := range keys {
+		f(k, entries[k])
+	}
+}
+
+// GetBlobsOr returns the value for k in blobs, or def if it has none.
+func (m *Inventory) GetBlobsOr(k uint32, def []byte) []byte {
+	if v, ok := m.GetBlobs()[k]; ok {
+		return v
+	}
+	return def
+}
+
+// RangeWeightsSorted calls f for each entry of weights, in order of key, as
+// the text format and the deterministic encoding order them.
+func (m *Inventory) RangeWeightsSorted(f func(k uint64, v float64)) {
+	entries := m.GetWeights()
+	keys := make([]uint64, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	proto.SortUint64Keys(keys)
+	for _, k := range keys {
+		f(k, entries[k])
+	}
+}
+
+// GetWeightsOr returns the value for k in weights, or def if it has none.
+func (m *Inventory) GetWeightsOr(k uint64, def float64) float64 {
+	if v, ok := m.GetWeights()[k]; ok {
+		return v
+	}
+	return def
+}
+
+// RangeFlagsSorted calls f for each entry of flags, in order of key, as
+// the text format and the deterministic encoding order them.
+func (m *Inventory) RangeFlagsSorted(f func(k bool, v string)) {
+	entries := m.GetFlags()
+	for _, k := range []bool{false, true} {
+		if v, ok := entries[k]; ok {
+			f(k, v)
+		}
+	}
+}
+
+// GetFlagsOr returns the value for k in flags, or def if it has none.
+func (m *Inventory) GetFlagsOr(k bool, def string) string {
+	if v, ok := m.GetFlags()[k]; ok {
+		return v
+	}
+	return def
+}
+
+// RangeKindsSorted calls f for each entry of kinds, in order of key, as
+// the text format and the deterministic encoding order them.
+func (m *Inventory) RangeKindsSorted(f func(k int32, v Kind)) {
+	entries := m.GetKinds()
+	keys := make([]int32, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	proto.SortInt32Keys(keys)
+	for _, k := range keys {
+		f(k, entries[k])
+	}
+}
+
+// GetKindsOr returns the value for k in kinds, or def if it has none.
+func (m *Inventory) GetKindsOr(k int32, def Kind) Kind {
+	if v, ok := m.GetKinds()[k]; ok {
+		return v
+	}
+	return def
+}
+
+func (m *Inventory) GetCounts() map[string]int32 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *Inventory) GetNames() map[int32]string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *Inventory) GetItems() map[int64]*Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Inventory) GetBlobs() map[uint32][]byte {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *Inventory) GetWeights() map[uint64]float64 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *Inventory) GetFlags() map[bool]string {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+func (m *Inventory) GetKinds() map[int32]Kind {
+	if m != nil {
+		return m.Kinds
+	}
+	return nil
+}
+
+func (m *Inventory) GetCountsOr() string {
+	if m != nil {
+		return m.CountsOr
+	}
+	return ""
+}
+
+type Item struct {
+	Sku string `protobuf:"bytes,1,opt,name=sku" json:"sku,omitempty"`
+}
+
+func (m *Item) Reset()                    { *m = Item{} }
+func (m *Item) String() string            { return proto.CompactTextString(m) }
+func (*Item) ProtoMessage()               {}
+func (*Item) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Item) GetSku() string {
+	if m != nil {
+		return m.Sku
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Inventory)(nil), "maphelpers.Inventory")
+	proto.RegisterType((*Item)(nil), "maphelpers.Item")
+	proto.RegisterEnum("maphelpers.Kind", Kind_name, Kind_value)
+}
+
+func init() { proto.RegisterFile("maphelpers/maphelpers.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xd3, 0xcd, 0x6b, 0xe2, 0x40,
+	0x18, 0x06, 0xf0, 0x8d, 0xf9, 0x30, 0x79, 0xe3, 0x2e, 0xd9, 0x61, 0x0f, 0x83, 0xb2, 0x90, 0xf5,
+	0xb0, 0xc8, 0x1e, 0x5c, 0x70, 0x61, 0x51, 0xe9, 0xa9, 0xa5, 0x05, 0x6b, 0x49, 0x40, 0x5a, 0x3c,
+	0x96, 0xd8, 0x4e, 0x55, 0xf2, 0x25, 0x99, 0xc4, 0xe2, 0x3f, 0xde, 0x73, 0x99, 0x99, 0xd8, 0x8c,
+	0x12, 0x42, 0x6f, 0x31, 0x3c, 0xbf, 0x79, 0xf3, 0xce, 0x83, 0xd0, 0x8b, 0x83, 0xdd, 0x86, 0x44,
+	0x3b, 0x92, 0xd1, 0xbf, 0xd5, 0xe3, 0x70, 0x97, 0xa5, 0x79, 0x8a, 0xa0, 0x7a, 0xd3, 0x7f, 0x33,
+	0xc0, 0x9a, 0x25, 0x7b, 0x92, 0xe4, 0x69, 0x76, 0x40, 0x13, 0x30, 0x9e, 0xd2, 0x22, 0xc9, 0x29,
+	0x56, 0x5c, 0x75, 0x60, 0x8f, 0x7e, 0x0d, 0x25, 0xfc, 0x11, 0x1b, 0x5e, 0xf1, 0xcc, 0x75, 0x92,
+	0x67, 0x87, 0x45, 0x09, 0xd0, 0x7f, 0xd0, 0x93, 0x20, 0x26, 0x14, 0xb7, 0xb8, 0x74, 0xeb, 0xa5,
+	0xc7, 0x22, 0x02, 0x8a, 0x38, 0x73, 0xdb, 0x9c, 0xc4, 0x14, 0xab, 0x4d, 0x6e, 0xc6, 0x22, 0xa5,
+	0xe3, 0x71, 0xe6, 0x56, 0x51, 0xba, 0xa2, 0x58, 0x6b, 0x72, 0x97, 0x2c, 0x52, 0x3a, 0x1e, 0x47,
+	0x17, 0xd0, 0x7e, 0x25, 0xdb, 0xf5, 0x26, 0xa7, 0x58, 0xe7, 0xb2, 0x5f, 0x2f, 0x97, 0x22, 0x24,
+	0xec, 0x91, 0xb0, 0xa9, 0x2f, 0x51, 0xb0, 0xa6, 0xd8, 0x68, 0x9a, 0x7a, 0xc3, 0x22, 0xe5, 0x54,
+	0x1e, 0x67, 0x2e, 0xdc, 0x26, 0xcf, 0x14, 0xb7, 0x9b, 0xdc, 0x9c, 0x45, 0x4a, 0xc7, 0xe3, 0xa8,
+	0x07, 0x96, 0xb8, 0xdf, 0xc7, 0x34, 0xc3, 0xa6, 0xab, 0x0c, 0xac, 0x85, 0x29, 0x5e, 0xf8, 0x59,
+	0x77, 0x02, 0xb6, 0xd4, 0x04, 0x72, 0x40, 0x0d, 0xc9, 0x01, 0x2b, 0x3c, 0xc5, 0x1e, 0xd1, 0x0f,
+	0xd0, 0xf7, 0x41, 0x54, 0x10, 0xdc, 0x72, 0x95, 0x81, 0xbe, 0x10, 0x3f, 0xa6, 0xad, 0xb1, 0xd2,
+	0x1d, 0x03, 0x54, 0x55, 0xc8, 0x52, 0xaf, 0x91, 0x96, 0x2c, 0x6f, 0x01, 0xaa, 0x32, 0x64, 0xa9,
+	0x0a, 0xf9, 0x5b, 0x96, 0xf6, 0xc8, 0x39, 0xd9, 0x34, 0x27, 0xf1, 0xd9, 0x57, 0x54, 0x05, 0xc9,
+	0x67, 0x7d, 0xad, 0xf9, 0x8a, 0x8e, 0x2c, 0xa7, 0xd0, 0x91, 0x0b, 0x92, 0xad, 0x56, 0x63, 0x95,
+	0xb3, 0xa9, 0x55, 0x41, 0xb2, 0x34, 0x3f, 0xb1, 0x7b, 0x55, 0x91, 0x2c, 0xbf, 0xd7, 0xec, 0xfe,
+	0xed, 0x74, 0x77, 0x06, 0xa5, 0xb3, 0xfa, 0x18, 0x34, 0x76, 0x1d, 0xec, 0x14, 0x1a, 0x16, 0xc7,
+	0xd6, 0x68, 0x58, 0xfc, 0xf9, 0x09, 0x1a, 0x0b, 0x23, 0x1b, 0xda, 0x0f, 0xde, 0xdc, 0xf3, 0x97,
+	0x9e, 0xf3, 0x05, 0x99, 0xa0, 0xdd, 0xfb, 0xfe, 0x9d, 0xa3, 0xac, 0x0c, 0xfe, 0x27, 0xfe, 0xf7,
+	0x3e, 0x00, 0x3a, 0xd4, 0x66, 0x28, 0xe3, 0x03, 0x00, 0x00,
+}
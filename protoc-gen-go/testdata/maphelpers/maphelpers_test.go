@@ -0,0 +1,127 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package maphelpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRangeSorted(t *testing.T) {
+	m := &Inventory{
+		Counts:  map[string]int32{"b": 2, "a": 1, "c": 3, "": 0},
+		Names:   map[int32]string{3: "c", -1: "z", 0: "o"},
+		Items:   map[int64]*Item{-5: {Sku: "x"}, 7: {Sku: "y"}},
+		Blobs:   map[uint32][]byte{1 << 31: []byte("hi"), 1: nil},
+		Weights: map[uint64]float64{1 << 63: 2, 9: 1},
+		Flags:   map[bool]string{true: "t", false: "f"},
+		Kinds:   map[int32]Kind{-1: Kind_TOOL, 1: Kind_UNKNOWN},
+	}
+
+	var keys []string
+	m.RangeCountsSorted(func(k string, v int32) { keys = append(keys, k) })
+	if want := []string{"", "a", "b", "c"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("RangeCountsSorted keys = %q, want %q", keys, want)
+	}
+	var names []string
+	m.RangeNamesSorted(func(k int32, v string) { names = append(names, v) })
+	if want := []string{"z", "o", "c"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("RangeNamesSorted values = %q, want %q", names, want)
+	}
+	var skus []string
+	m.RangeItemsSorted(func(k int64, v *Item) { skus = append(skus, v.Sku) })
+	if want := []string{"x", "y"}; !reflect.DeepEqual(skus, want) {
+		t.Errorf("RangeItemsSorted values = %q, want %q", skus, want)
+	}
+	var blobs []uint32
+	m.RangeBlobsSorted(func(k uint32, v []byte) { blobs = append(blobs, k) })
+	if want := []uint32{1, 1 << 31}; !reflect.DeepEqual(blobs, want) {
+		t.Errorf("RangeBlobsSorted keys = %v, want %v", blobs, want)
+	}
+	var weights []uint64
+	m.RangeWeightsSorted(func(k uint64, v float64) { weights = append(weights, k) })
+	if want := []uint64{9, 1 << 63}; !reflect.DeepEqual(weights, want) {
+		t.Errorf("RangeWeightsSorted keys = %v, want %v", weights, want)
+	}
+	var flags []string
+	m.RangeFlagsSorted(func(k bool, v string) { flags = append(flags, v) })
+	if want := []string{"f", "t"}; !reflect.DeepEqual(flags, want) {
+		t.Errorf("RangeFlagsSorted values = %q, want %q", flags, want)
+	}
+	var kinds []Kind
+	m.RangeKindsSorted(func(k int32, v Kind) { kinds = append(kinds, v) })
+	if want := []Kind{Kind_TOOL, Kind_UNKNOWN}; !reflect.DeepEqual(kinds, want) {
+		t.Errorf("RangeKindsSorted values = %v, want %v", kinds, want)
+	}
+
+	// A nil message has no entries.
+	var nilm *Inventory
+	nilm.RangeCountsSorted(func(k string, v int32) { t.Errorf("RangeCountsSorted on nil called f(%q, %d)", k, v) })
+	nilm.RangeFlagsSorted(func(k bool, v string) { t.Errorf("RangeFlagsSorted on nil called f(%v, %q)", k, v) })
+}
+
+func TestGetOr(t *testing.T) {
+	m := &Inventory{
+		Counts:   map[string]int32{"a": 1, "zero": 0},
+		Items:    map[int64]*Item{1: {Sku: "x"}},
+		Flags:    map[bool]string{true: "t"},
+		CountsOr: "field",
+	}
+	if got := m.GetCountsOr_("a", 7); got != 1 {
+		t.Errorf(`GetCountsOr_("a", 7) = %d, want 1`, got)
+	}
+	if got := m.GetCountsOr_("zero", 7); got != 0 {
+		t.Errorf(`GetCountsOr_("zero", 7) = %d, want the stored 0`, got)
+	}
+	if got := m.GetCountsOr_("b", 7); got != 7 {
+		t.Errorf(`GetCountsOr_("b", 7) = %d, want 7`, got)
+	}
+	// The getter of counts_or keeps its name.
+	if got := m.GetCountsOr(); got != "field" {
+		t.Errorf("GetCountsOr() = %q, want %q", got, "field")
+	}
+	def := &Item{Sku: "def"}
+	if got := m.GetItemsOr(1, def); got.GetSku() != "x" {
+		t.Errorf("GetItemsOr(1, def) = %v, want the stored item", got)
+	}
+	if got := m.GetItemsOr(2, def); got != def {
+		t.Errorf("GetItemsOr(2, def) = %v, want def", got)
+	}
+	if got := m.GetFlagsOr(false, "f"); got != "f" {
+		t.Errorf(`GetFlagsOr(false, "f") = %q, want "f"`, got)
+	}
+
+	var nilm *Inventory
+	if got := nilm.GetNamesOr(1, "def"); got != "def" {
+		t.Errorf(`nil GetNamesOr(1, "def") = %q, want "def"`, got)
+	}
+}
@@ -20,10 +20,13 @@ It has these top-level messages:
 */
 package my_test
 
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/golang/protobuf/protoc-gen-go/testdata/multi"
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/protoc-gen-go/testdata/multi"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
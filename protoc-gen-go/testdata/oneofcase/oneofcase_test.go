@@ -0,0 +1,132 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package oneofcase
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWhich(t *testing.T) {
+	for _, test := range []struct {
+		m    *Config
+		want Config_SourceCase
+	}{
+		{nil, Config_SourceCase_NOT_SET},
+		{&Config{}, Config_SourceCase_NOT_SET},
+		{&Config{Source: &Config_Path{"/etc/x"}}, Config_SourceCase_Path},
+		{&Config{Source: &Config_Inline{[]byte("x")}}, Config_SourceCase_Inline},
+		{&Config{Source: &Config_Remote{&Remote{}}}, Config_SourceCase_Remote},
+	} {
+		if got := test.m.WhichSource(); got != test.want {
+			t.Errorf("%v.WhichSource() = %v, want %v", test.m, got, test.want)
+		}
+	}
+	if Config_SourceCase_Remote != 4 {
+		t.Errorf("Config_SourceCase_Remote = %d, want the field number 4", Config_SourceCase_Remote)
+	}
+
+	m := &Config{Mode: &Config_Auto{true}, Extra: &Config_ModeCase{1}}
+	if got := m.WhichMode(); got != Config_ModeCase__Auto {
+		t.Errorf("WhichMode() = %v, want %v", got, Config_ModeCase__Auto)
+	}
+	if got := m.WhichExtra(); got != Config_ExtraCase_ModeCase {
+		t.Errorf("WhichExtra() = %v, want %v", got, Config_ExtraCase_ModeCase)
+	}
+}
+
+func TestNilSafeGetters(t *testing.T) {
+	var m *Config
+	if m.GetTls().GetCert() != nil || m.GetRemote().GetUrl() != "" {
+		t.Error("getters of a nil message returned values")
+	}
+
+	// Getters on the oneof wrapper types are nil-safe too.
+	var w *Config_Remote
+	if w.GetRemote().GetUrl() != "" {
+		t.Error("GetRemote of a nil wrapper returned a value")
+	}
+	var p *Config_Path
+	var f *Config_Fixed
+	var a *Config_Auto
+	if p.GetPath() != "" || f.GetFixed() != Mode_OFF || a.GetAuto() {
+		t.Error("getters of nil wrappers returned values")
+	}
+
+	m = &Config{Tls: &Tls{Cert: []byte("pem")}, Source: &Config_Remote{&Remote{Url: "https://x"}}}
+	if !bytes.Equal(m.GetTls().GetCert(), []byte("pem")) {
+		t.Errorf("GetTls().GetCert() = %q", m.GetTls().GetCert())
+	}
+	if w, ok := m.GetSource().(*Config_Remote); !ok || w.GetRemote().GetUrl() != "https://x" {
+		t.Errorf("GetSource() = %v", m.GetSource())
+	}
+}
+
+func TestVisit(t *testing.T) {
+	for _, test := range []struct {
+		m    *Config
+		want string
+	}{
+		{nil, "none"},
+		{&Config{}, "none"},
+		{&Config{Source: &Config_Path{"/etc/x"}}, "path /etc/x"},
+		{&Config{Source: &Config_Inline{[]byte("x")}}, "inline x"},
+		{&Config{Source: &Config_Remote{&Remote{Url: "https://x"}}}, "remote https://x"},
+	} {
+		var got string
+		test.m.VisitSource(
+			func(path string) { got = "path " + path },
+			func(inline []byte) { got = "inline " + string(inline) },
+			func(remote *Remote) { got = "remote " + remote.GetUrl() },
+			func() { got = "none" },
+		)
+		if got != test.want {
+			t.Errorf("%v.VisitSource called %q, want %q", test.m, got, test.want)
+		}
+	}
+
+	// A nil function ignores its case.
+	called := false
+	m := &Config{Mode: &Config_Auto{true}}
+	m.VisitMode(nil, nil, nil, func() { called = true })
+	if called {
+		t.Error("VisitMode called the function for no field set")
+	}
+
+	// The parameter for the field named default gets the usual name, and
+	// the one for no field set an underscore.
+	var got string
+	(&Config{Extra: &Config_Default{true}}).VisitExtra(nil, func(bool) { got = "default" }, func() { got = "none" })
+	if got != "default" {
+		t.Errorf("VisitExtra called %q, want default", got)
+	}
+}
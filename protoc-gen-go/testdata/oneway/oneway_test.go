@@ -0,0 +1,93 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package oneway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ccsnake/carno/client"
+	"github.com/ccsnake/protobuf/callinfo"
+)
+
+// fakeClient stands in for the carno client. It blocks each call until
+// release is closed, and sends the methods it calls to calls.
+type fakeClient struct {
+	calls   chan string
+	release chan struct{}
+}
+
+func (c *fakeClient) Start() error { return nil }
+
+func (c *fakeClient) Call(ctx context.Context, service, method string, in, out interface{}, opts ...client.CallOption) error {
+	<-c.release
+	info, _ := callinfo.FromContext(ctx)
+	c.calls <- info.FullMethod()
+	return nil
+}
+
+func TestCallInfoOneway(t *testing.T) {
+	if !callinfo.Lookup("oneway@Telemetry/Report").Oneway {
+		t.Error("Report is not oneway")
+	}
+	if callinfo.Lookup("oneway@Telemetry/Flush").Oneway {
+		t.Error("Flush is oneway")
+	}
+}
+
+func TestOnewayClient(t *testing.T) {
+	fake := &fakeClient{calls: make(chan string, 1), release: make(chan struct{})}
+	c := &telemetryClient{Client: fake}
+
+	// Report returns before the call is made.
+	if out, err := c.Report(context.Background(), &Event{Name: "start"}); out == nil || err != nil {
+		t.Fatalf("Report = %v, %v", out, err)
+	}
+	close(fake.release)
+	select {
+	case m := <-fake.calls:
+		if m != "oneway@Telemetry/Report" {
+			t.Errorf("Report called %s", m)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Report was never called")
+	}
+
+	// Flush waits for its call.
+	if _, err := c.Flush(context.Background(), &Event{}); err != nil {
+		t.Fatal(err)
+	}
+	if m := <-fake.calls; m != "oneway@Telemetry/Flush" {
+		t.Errorf("Flush called %s", m)
+	}
+}
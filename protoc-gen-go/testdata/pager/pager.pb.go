@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: pager/pager.proto
+
+/*
+Package pager is a generated protocol buffer package.
+
+Package pager tests the pagination helpers the carno plugin generates
+for list methods.
+
+It is generated from these files:
+	pager/pager.proto
+	pager/pager2.proto
+
+It has these top-level messages:
+	Book
+	ListBooksRequest
+	ListBooksResponse
+	ListShelvesRequest
+	ListShelvesResponse
+	ListTagsRequest
+	ListTagsResponse
+	ListOldRequest
+	ListOldResponse
+*/
+package pager
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Book struct {
+	Title string `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+}
+
+func (m *Book) Reset()                    { *m = Book{} }
+func (m *Book) String() string            { return proto.CompactTextString(m) }
+func (*Book) ProtoMessage()               {}
+func (*Book) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Book) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+type ListBooksRequest struct {
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+}
+
+func (m *ListBooksRequest) Reset()                    { *m = ListBooksRequest{} }
+func (m *ListBooksRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListBooksRequest) ProtoMessage()               {}
+func (*ListBooksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ListBooksRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListBooksRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListBooksResponse struct {
+	Books         []*Book `protobuf:"bytes,1,rep,name=books" json:"books,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+}
+
+func (m *ListBooksResponse) Reset()                    { *m = ListBooksResponse{} }
+func (m *ListBooksResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListBooksResponse) ProtoMessage()               {}
+func (*ListBooksResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ListBooksResponse) GetBooks() []*Book {
+	if m != nil {
+		return m.Books
+	}
+	return nil
+}
+
+func (m *ListBooksResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ListShelvesRequest struct {
+	Cursor string `protobuf:"bytes,1,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *ListShelvesRequest) Reset()                    { *m = ListShelvesRequest{} }
+func (m *ListShelvesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListShelvesRequest) ProtoMessage()               {}
+func (*ListShelvesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *ListShelvesRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ListShelvesResponse struct {
+	Shelves    []string `protobuf:"bytes,1,rep,name=shelves" json:"shelves,omitempty"`
+	Warnings   []string `protobuf:"bytes,2,rep,name=warnings" json:"warnings,omitempty"`
+	NextCursor string   `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
+}
+
+func (m *ListShelvesResponse) Reset()                    { *m = ListShelvesResponse{} }
+func (m *ListShelvesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListShelvesResponse) ProtoMessage()               {}
+func (*ListShelvesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *ListShelvesResponse) GetShelves() []string {
+	if m != nil {
+		return m.Shelves
+	}
+	return nil
+}
+
+func (m *ListShelvesResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *ListShelvesResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type ListTagsRequest struct {
+	PageToken string `protobuf:"bytes,1,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+}
+
+func (m *ListTagsRequest) Reset()                    { *m = ListTagsRequest{} }
+func (m *ListTagsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListTagsRequest) ProtoMessage()               {}
+func (*ListTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *ListTagsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListTagsResponse struct {
+	Tags          []string         `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty"`
+	Warnings      []string         `protobuf:"bytes,2,rep,name=warnings" json:"warnings,omitempty"`
+	Counts        map[string]int32 `protobuf:"bytes,3,rep,name=counts" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	NextPageToken string           `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+}
+
+func (m *ListTagsResponse) Reset()                    { *m = ListTagsResponse{} }
+func (m *ListTagsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListTagsResponse) ProtoMessage()               {}
+func (*ListTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ListTagsResponse) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ListTagsResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *ListTagsResponse) GetCounts() map[string]int32 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *ListTagsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Book)(nil), "pager.Book")
+	proto.RegisterType((*ListBooksRequest)(nil), "pager.ListBooksRequest")
+	proto.RegisterType((*ListBooksResponse)(nil), "pager.ListBooksResponse")
+	proto.RegisterType((*ListShelvesRequest)(nil), "pager.ListShelvesRequest")
+	proto.RegisterType((*ListShelvesResponse)(nil), "pager.ListShelvesResponse")
+	proto.RegisterType((*ListTagsRequest)(nil), "pager.ListTagsRequest")
+	proto.RegisterType((*ListTagsResponse)(nil), "pager.ListTagsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Library service
+type LibraryClient interface {
+	ListBooks(ctx context.Context, in *ListBooksRequest, opts ...client.CallOption) (*ListBooksResponse, error)
+	ListShelves(ctx context.Context, in *ListShelvesRequest, opts ...client.CallOption) (*ListShelvesResponse, error)
+	// Its response has two lists, so it gets no All.
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...client.CallOption) (*ListTagsResponse, error)
+	GetBook(ctx context.Context, in *Book, opts ...client.CallOption) (*Book, error)
+}
+
+type libraryClient struct {
+	client.Client
+}
+
+// NewLibraryClient creates and starts a client for the Library service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewLibraryClient(opts ...client.Option) (LibraryClient, error) {
+	c, err := carno1.NewClient("pager", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &libraryClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Library_callInfo = []*callinfo.CallInfo{
+	{
+		Service:      "pager@Library",
+		Method:       "ListBooks",
+		RequestType:  "pager.ListBooksRequest",
+		ResponseType: "pager.ListBooksResponse",
+		File:         "pager/pager.proto",
+	},
+	{
+		Service:      "pager@Library",
+		Method:       "ListShelves",
+		RequestType:  "pager.ListShelvesRequest",
+		ResponseType: "pager.ListShelvesResponse",
+		File:         "pager/pager.proto",
+	},
+	{
+		Service:      "pager@Library",
+		Method:       "ListTags",
+		RequestType:  "pager.ListTagsRequest",
+		ResponseType: "pager.ListTagsResponse",
+		File:         "pager/pager.proto",
+	},
+	{
+		Service:      "pager@Library",
+		Method:       "GetBook",
+		RequestType:  "pager.Book",
+		ResponseType: "pager.Book",
+		File:         "pager/pager.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Library_callInfo...)
+}
+
+func (c *libraryClient) ListBooks(ctx context.Context, in *ListBooksRequest, opts ...client.CallOption) (*ListBooksResponse, error) {
+	out := new(ListBooksResponse)
+	ctx = callinfo.NewContext(ctx, _Library_callInfo[0])
+	err := c.Client.Call(ctx, "Library", "ListBooks", in, out, opts...)
+	return out, err
+}
+
+func (c *libraryClient) ListShelves(ctx context.Context, in *ListShelvesRequest, opts ...client.CallOption) (*ListShelvesResponse, error) {
+	out := new(ListShelvesResponse)
+	ctx = callinfo.NewContext(ctx, _Library_callInfo[1])
+	err := c.Client.Call(ctx, "Library", "ListShelves", in, out, opts...)
+	return out, err
+}
+
+func (c *libraryClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...client.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	ctx = callinfo.NewContext(ctx, _Library_callInfo[2])
+	err := c.Client.Call(ctx, "Library", "ListTags", in, out, opts...)
+	return out, err
+}
+
+func (c *libraryClient) GetBook(ctx context.Context, in *Book, opts ...client.CallOption) (*Book, error) {
+	out := new(Book)
+	ctx = callinfo.NewContext(ctx, _Library_callInfo[3])
+	err := c.Client.Call(ctx, "Library", "GetBook", in, out, opts...)
+	return out, err
+}
+
+// LibraryClient returns a LibraryClient using the client shared by the services
+// of package pager.
+func (a *Pager) LibraryClient() LibraryClient {
+	return &libraryClient{Client: a.c}
+}
+
+// LibraryPager walks the pages of the results of the list methods of
+// Library. Client must be set.
+type LibraryPager struct {
+	// Client makes the calls.
+	Client LibraryClient
+}
+
+// ListBooksPages returns an iterator over the pages of the responses
+// of ListBooks to in, from the page its page_token selects to the last.
+// in is not modified.
+func (p *LibraryPager) ListBooksPages(ctx context.Context, in *ListBooksRequest, opts ...client.CallOption) *Library_ListBooksPages {
+	return &Library_ListBooksPages{c: p.Client, ctx: ctx, in: proto.Clone(in).(*ListBooksRequest), opts: opts}
+}
+
+// Library_ListBooksPages iterates over the pages of the responses of ListBooks.
+type Library_ListBooksPages struct {
+	c    LibraryClient
+	ctx  context.Context
+	in   *ListBooksRequest // request for the next page
+	opts []client.CallOption
+	page *ListBooksResponse
+	err  error
+	done bool
+}
+
+// Next calls ListBooks for the next page and reports whether it got
+// one, which Page then returns. It returns false after the last page,
+// whose next_page_token is empty, or once a call fails; Err returns
+// the error.
+func (p *Library_ListBooksPages) Next() bool {
+	if p.done {
+		return false
+	}
+	page, err := p.c.ListBooks(p.ctx, p.in, p.opts...)
+	if err != nil {
+		p.err, p.done = err, true
+		return false
+	}
+	p.page = page
+	switch token := page.GetNextPageToken(); token {
+	case "":
+		p.done = true
+	case p.in.GetPageToken():
+		// Asking for the same page again would never end.
+		p.err, p.done = fmt.Errorf("Library.ListBooks returned its page token %q as the next one", token), true
+	default:
+		p.in.PageToken = token
+	}
+	return true
+}
+
+// Page returns the page the last call to Next got.
+func (p *Library_ListBooksPages) Page() *ListBooksResponse {
+	return p.page
+}
+
+// Err returns the error of the call that stopped Next, if any.
+func (p *Library_ListBooksPages) Err() error {
+	return p.err
+}
+
+// All calls Next until the last page and returns the books of the
+// pages it got, with the error of the call that failed, if any.
+func (p *Library_ListBooksPages) All() ([]*Book, error) {
+	var all []*Book
+	for p.Next() {
+		all = append(all, p.page.Books...)
+	}
+	return all, p.err
+}
+
+// ListShelvesPages returns an iterator over the pages of the responses
+// of ListShelves to in, from the page its cursor selects to the last.
+// in is not modified.
+func (p *LibraryPager) ListShelvesPages(ctx context.Context, in *ListShelvesRequest, opts ...client.CallOption) *Library_ListShelvesPages {
+	return &Library_ListShelvesPages{c: p.Client, ctx: ctx, in: proto.Clone(in).(*ListShelvesRequest), opts: opts}
+}
+
+// Library_ListShelvesPages iterates over the pages of the responses of ListShelves.
+type Library_ListShelvesPages struct {
+	c    LibraryClient
+	ctx  context.Context
+	in   *ListShelvesRequest // request for the next page
+	opts []client.CallOption
+	page *ListShelvesResponse
+	err  error
+	done bool
+}
+
+// Next calls ListShelves for the next page and reports whether it got
+// one, which Page then returns. It returns false after the last page,
+// whose next_cursor is empty, or once a call fails; Err returns
+// the error.
+func (p *Library_ListShelvesPages) Next() bool {
+	if p.done {
+		return false
+	}
+	page, err := p.c.ListShelves(p.ctx, p.in, p.opts...)
+	if err != nil {
+		p.err, p.done = err, true
+		return false
+	}
+	p.page = page
+	switch token := page.GetNextCursor(); token {
+	case "":
+		p.done = true
+	case p.in.GetCursor():
+		// Asking for the same page again would never end.
+		p.err, p.done = fmt.Errorf("Library.ListShelves returned its page token %q as the next one", token), true
+	default:
+		p.in.Cursor = token
+	}
+	return true
+}
+
+// Page returns the page the last call to Next got.
+func (p *Library_ListShelvesPages) Page() *ListShelvesResponse {
+	return p.page
+}
+
+// Err returns the error of the call that stopped Next, if any.
+func (p *Library_ListShelvesPages) Err() error {
+	return p.err
+}
+
+// All calls Next until the last page and returns the shelves of the
+// pages it got, with the error of the call that failed, if any.
+func (p *Library_ListShelvesPages) All() ([]string, error) {
+	var all []string
+	for p.Next() {
+		all = append(all, p.page.Shelves...)
+	}
+	return all, p.err
+}
+
+// ListTagsPages returns an iterator over the pages of the responses
+// of ListTags to in, from the page its page_token selects to the last.
+// in is not modified.
+func (p *LibraryPager) ListTagsPages(ctx context.Context, in *ListTagsRequest, opts ...client.CallOption) *Library_ListTagsPages {
+	return &Library_ListTagsPages{c: p.Client, ctx: ctx, in: proto.Clone(in).(*ListTagsRequest), opts: opts}
+}
+
+// Library_ListTagsPages iterates over the pages of the responses of ListTags.
+type Library_ListTagsPages struct {
+	c    LibraryClient
+	ctx  context.Context
+	in   *ListTagsRequest // request for the next page
+	opts []client.CallOption
+	page *ListTagsResponse
+	err  error
+	done bool
+}
+
+// Next calls ListTags for the next page and reports whether it got
+// one, which Page then returns. It returns false after the last page,
+// whose next_page_token is empty, or once a call fails; Err returns
+// the error.
+func (p *Library_ListTagsPages) Next() bool {
+	if p.done {
+		return false
+	}
+	page, err := p.c.ListTags(p.ctx, p.in, p.opts...)
+	if err != nil {
+		p.err, p.done = err, true
+		return false
+	}
+	p.page = page
+	switch token := page.GetNextPageToken(); token {
+	case "":
+		p.done = true
+	case p.in.GetPageToken():
+		// Asking for the same page again would never end.
+		p.err, p.done = fmt.Errorf("Library.ListTags returned its page token %q as the next one", token), true
+	default:
+		p.in.PageToken = token
+	}
+	return true
+}
+
+// Page returns the page the last call to Next got.
+func (p *Library_ListTagsPages) Page() *ListTagsResponse {
+	return p.page
+}
+
+// Err returns the error of the call that stopped Next, if any.
+func (p *Library_ListTagsPages) Err() error {
+	return p.err
+}
+
+// Server API for Library service
+type LibraryServer interface {
+	ListBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error)
+	ListShelves(context.Context, *ListShelvesRequest) (*ListShelvesResponse, error)
+	// Its response has two lists, so it gets no All.
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	GetBook(context.Context, *Book) (*Book, error)
+}
+
+func RegisterLibraryServer(srv LibraryServer) {
+	callinfo.RegisterServer("pager@Library")
+	carno1.HandleService(&_Library_serviceDesc, srv)
+}
+
+var _Library_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Library",
+	Methods: []string{
+		"ListBooks",
+		"ListShelves",
+		"ListTags",
+		"GetBook",
+	},
+}
+
+func init() { proto.RegisterFile("pager/pager.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x95, 0x93, 0x38, 0x89, 0xc7, 0x42, 0x4d, 0xa7, 0xa8, 0x75, 0x97, 0xaf, 0xe2, 0x4a, 0xa8,
+	0x07, 0x94, 0xa2, 0x72, 0x01, 0x2a, 0x71, 0xa0, 0x42, 0x5c, 0x2a, 0x84, 0xdc, 0x9e, 0xa9, 0x9c,
+	0x68, 0xe5, 0x5a, 0xb1, 0x76, 0xcd, 0xee, 0xba, 0x90, 0xfe, 0x0f, 0xfe, 0x08, 0x47, 0x7e, 0x0e,
+	0xbf, 0x04, 0xed, 0x87, 0xcd, 0xa6, 0x09, 0xbd, 0x44, 0x9e, 0x99, 0xb7, 0x6f, 0xde, 0xbe, 0x7d,
+	0x81, 0xed, 0x3a, 0x2f, 0xa8, 0x38, 0x36, 0xbf, 0xd3, 0x5a, 0x70, 0xc5, 0x31, 0x34, 0x05, 0xd9,
+	0x99, 0xe7, 0x82, 0xf1, 0x63, 0x5e, 0xab, 0x92, 0x33, 0x69, 0x67, 0xe9, 0x63, 0x18, 0x7c, 0xe0,
+	0x7c, 0x81, 0x0f, 0x21, 0x54, 0xa5, 0xaa, 0x68, 0x12, 0x1c, 0x04, 0x47, 0x51, 0x66, 0x8b, 0xf4,
+	0x33, 0x4c, 0xce, 0x4b, 0xa9, 0x34, 0x42, 0x66, 0xf4, 0x5b, 0x43, 0xa5, 0xc2, 0x47, 0x10, 0x69,
+	0xbe, 0x2b, 0x59, 0xde, 0x5a, 0x74, 0x98, 0x8d, 0x75, 0xe3, 0xa2, 0xbc, 0xa5, 0xf8, 0x04, 0xc0,
+	0x0c, 0x15, 0x5f, 0x50, 0x96, 0xf4, 0x0c, 0x97, 0x81, 0x5f, 0xea, 0x46, 0xfa, 0x15, 0xb6, 0x3d,
+	0x3e, 0x59, 0x73, 0x26, 0x29, 0x3e, 0x87, 0x70, 0xa6, 0x1b, 0x49, 0x70, 0xd0, 0x3f, 0x8a, 0x4f,
+	0xe2, 0xa9, 0xd5, 0xae, 0x41, 0x99, 0x9d, 0xe0, 0x0b, 0xd8, 0x62, 0xf4, 0x87, 0xba, 0x5a, 0xe3,
+	0x7e, 0xa0, 0xdb, 0x5f, 0x3a, 0xfe, 0x97, 0x80, 0x9a, 0xff, 0xe2, 0x9a, 0x56, 0x37, 0xb4, 0x53,
+	0xbc, 0x0b, 0xc3, 0x79, 0x23, 0x24, 0x17, 0xee, 0x72, 0xae, 0x4a, 0x2b, 0xd8, 0x59, 0x41, 0x3b,
+	0x3d, 0x09, 0x8c, 0xa4, 0x6d, 0x19, 0x45, 0x51, 0xd6, 0x96, 0x48, 0x60, 0xfc, 0x3d, 0x17, 0xac,
+	0x64, 0x85, 0x4c, 0x7a, 0x66, 0xd4, 0xd5, 0xf8, 0x0c, 0x62, 0x23, 0xd1, 0x6d, 0xea, 0x9b, 0x4d,
+	0xa0, 0x5b, 0x67, 0x76, 0xdb, 0x2b, 0xd8, 0xd2, 0xdb, 0x2e, 0xf3, 0xa2, 0x13, 0xb6, 0xea, 0x56,
+	0x70, 0xd7, 0xad, 0x3f, 0x01, 0x4c, 0xfe, 0x1d, 0x71, 0xea, 0x10, 0x06, 0x2a, 0x2f, 0x5a, 0x69,
+	0xe6, 0xfb, 0x5e, 0x5d, 0xa7, 0x30, 0x9c, 0xf3, 0x86, 0x29, 0x99, 0xf4, 0x8d, 0xbd, 0x87, 0xce,
+	0xde, 0xbb, 0xc4, 0xd3, 0x33, 0x83, 0xfa, 0xc8, 0x94, 0x58, 0x66, 0xee, 0xc8, 0x26, 0xdf, 0x07,
+	0x1b, 0x7c, 0x27, 0x6f, 0x21, 0xf6, 0x8e, 0xe3, 0x04, 0xfa, 0x0b, 0xba, 0x74, 0x17, 0xd2, 0x9f,
+	0x3a, 0x5e, 0x37, 0x79, 0xd5, 0x50, 0xf3, 0x6c, 0x61, 0x66, 0x8b, 0x77, 0xbd, 0x37, 0xc1, 0xc9,
+	0xcf, 0x1e, 0x8c, 0xce, 0xcb, 0x99, 0xc8, 0xc5, 0x12, 0xdf, 0x43, 0xd4, 0xc5, 0x03, 0xf7, 0x3c,
+	0xa1, 0x7e, 0x00, 0x49, 0xb2, 0x3e, 0x70, 0xde, 0x5c, 0x43, 0xec, 0x3d, 0x28, 0xee, 0x7b, 0xc0,
+	0xd5, 0x48, 0x10, 0xb2, 0x69, 0x64, 0x59, 0xd2, 0xf4, 0xf7, 0xaf, 0xfd, 0xa7, 0x6d, 0x64, 0xd0,
+	0x7f, 0x55, 0xd2, 0x25, 0xe1, 0x14, 0xc6, 0xad, 0x81, 0xb8, 0xbb, 0xe6, 0xa8, 0xdd, 0xb1, 0xf7,
+	0x1f, 0xa7, 0xf1, 0x10, 0x46, 0x9f, 0xa8, 0x91, 0x8e, 0x7e, 0xd8, 0x89, 0x5f, 0xcc, 0x86, 0xe6,
+	0xff, 0xf9, 0xfa, 0xef, 0x00, 0x4b, 0x30, 0x7e, 0x17, 0xd0, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: pager/pager2.proto
+
+package pager
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ListOldRequest struct {
+	PageToken        *string `protobuf:"bytes,1,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ListOldRequest) Reset()                    { *m = ListOldRequest{} }
+func (m *ListOldRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListOldRequest) ProtoMessage()               {}
+func (*ListOldRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+func (m *ListOldRequest) GetPageToken() string {
+	if m != nil && m.PageToken != nil {
+		return *m.PageToken
+	}
+	return ""
+}
+
+type ListOldResponse struct {
+	Names            []string `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
+	NextPageToken    *string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *ListOldResponse) Reset()                    { *m = ListOldResponse{} }
+func (m *ListOldResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListOldResponse) ProtoMessage()               {}
+func (*ListOldResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1} }
+
+func (m *ListOldResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *ListOldResponse) GetNextPageToken() string {
+	if m != nil && m.NextPageToken != nil {
+		return *m.NextPageToken
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ListOldRequest)(nil), "pager.ListOldRequest")
+	proto.RegisterType((*ListOldResponse)(nil), "pager.ListOldResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Archive service
+type ArchiveClient interface {
+	ListOld(ctx context.Context, in *ListOldRequest, opts ...client.CallOption) (*ListOldResponse, error)
+}
+
+type archiveClient struct {
+	client.Client
+}
+
+// NewArchiveClient creates and starts a client for the Archive service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewArchiveClient(opts ...client.Option) (ArchiveClient, error) {
+	c, err := carno1.NewClient("pager", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &archiveClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Archive_callInfo = []*callinfo.CallInfo{
+	{
+		Service:      "pager@Archive",
+		Method:       "ListOld",
+		RequestType:  "pager.ListOldRequest",
+		ResponseType: "pager.ListOldResponse",
+		File:         "pager/pager2.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Archive_callInfo...)
+}
+
+func (c *archiveClient) ListOld(ctx context.Context, in *ListOldRequest, opts ...client.CallOption) (*ListOldResponse, error) {
+	out := new(ListOldResponse)
+	ctx = callinfo.NewContext(ctx, _Archive_callInfo[0])
+	err := c.Client.Call(ctx, "Archive", "ListOld", in, out, opts...)
+	return out, err
+}
+
+// ArchiveClient returns a ArchiveClient using the client shared by the services
+// of package pager.
+func (a *Pager) ArchiveClient() ArchiveClient {
+	return &archiveClient{Client: a.c}
+}
+
+// ArchivePager walks the pages of the results of the list methods of
+// Archive. Client must be set.
+type ArchivePager struct {
+	// Client makes the calls.
+	Client ArchiveClient
+}
+
+// ListOldPages returns an iterator over the pages of the responses
+// of ListOld to in, from the page its page_token selects to the last.
+// in is not modified.
+func (p *ArchivePager) ListOldPages(ctx context.Context, in *ListOldRequest, opts ...client.CallOption) *Archive_ListOldPages {
+	return &Archive_ListOldPages{c: p.Client, ctx: ctx, in: proto.Clone(in).(*ListOldRequest), opts: opts}
+}
+
+// Archive_ListOldPages iterates over the pages of the responses of ListOld.
+type Archive_ListOldPages struct {
+	c    ArchiveClient
+	ctx  context.Context
+	in   *ListOldRequest // request for the next page
+	opts []client.CallOption
+	page *ListOldResponse
+	err  error
+	done bool
+}
+
+// Next calls ListOld for the next page and reports whether it got
+// one, which Page then returns. It returns false after the last page,
+// whose next_page_token is empty, or once a call fails; Err returns
+// the error.
+func (p *Archive_ListOldPages) Next() bool {
+	if p.done {
+		return false
+	}
+	page, err := p.c.ListOld(p.ctx, p.in, p.opts...)
+	if err != nil {
+		p.err, p.done = err, true
+		return false
+	}
+	p.page = page
+	switch token := page.GetNextPageToken(); token {
+	case "":
+		p.done = true
+	case p.in.GetPageToken():
+		// Asking for the same page again would never end.
+		p.err, p.done = fmt.Errorf("Archive.ListOld returned its page token %q as the next one", token), true
+	default:
+		p.in.PageToken = proto.String(token)
+	}
+	return true
+}
+
+// Page returns the page the last call to Next got.
+func (p *Archive_ListOldPages) Page() *ListOldResponse {
+	return p.page
+}
+
+// Err returns the error of the call that stopped Next, if any.
+func (p *Archive_ListOldPages) Err() error {
+	return p.err
+}
+
+// All calls Next until the last page and returns the names of the
+// pages it got, with the error of the call that failed, if any.
+func (p *Archive_ListOldPages) All() ([]string, error) {
+	var all []string
+	for p.Next() {
+		all = append(all, p.page.Names...)
+	}
+	return all, p.err
+}
+
+// Server API for Archive service
+type ArchiveServer interface {
+	ListOld(context.Context, *ListOldRequest) (*ListOldResponse, error)
+}
+
+func RegisterArchiveServer(srv ArchiveServer) {
+	callinfo.RegisterServer("pager@Archive")
+	carno1.HandleService(&_Archive_serviceDesc, srv)
+}
+
+var _Archive_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Archive",
+	Methods: []string{
+		"ListOld",
+	},
+}
+
+func init() { proto.RegisterFile("pager/pager2.proto", fileDescriptor1) }
+
+var fileDescriptor1 = []byte{
+	// 166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2a, 0x48, 0x4c, 0x4f,
+	0x2d, 0xd2, 0x07, 0x93, 0x46, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xac, 0x60, 0x9e, 0x92,
+	0x3e, 0x17, 0x9f, 0x4f, 0x66, 0x71, 0x89, 0x7f, 0x4e, 0x4a, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71,
+	0x89, 0x90, 0x2c, 0x17, 0x17, 0x48, 0x2a, 0xbe, 0x24, 0x3f, 0x3b, 0x35, 0x4f, 0x82, 0x51, 0x81,
+	0x51, 0x83, 0x33, 0x88, 0x13, 0x24, 0x12, 0x02, 0x12, 0x50, 0xf2, 0xe7, 0xe2, 0x87, 0x6b, 0x28,
+	0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x12, 0xe1, 0x62, 0xcd, 0x4b, 0xcc, 0x4d, 0x2d, 0x96, 0x60,
+	0x54, 0x60, 0xd6, 0xe0, 0x0c, 0x82, 0x70, 0x84, 0xd4, 0xb8, 0xf8, 0xf3, 0x52, 0x2b, 0x4a, 0xe2,
+	0x91, 0x0c, 0x63, 0x02, 0x1b, 0xc6, 0x0b, 0x12, 0x0e, 0x80, 0x19, 0x68, 0xe4, 0xcc, 0xc5, 0xee,
+	0x58, 0x94, 0x9c, 0x91, 0x59, 0x96, 0x2a, 0x64, 0xc1, 0xc5, 0x0e, 0x35, 0x5b, 0x48, 0x54, 0x0f,
+	0xec, 0x3e, 0x3d, 0x54, 0xc7, 0x49, 0x89, 0xa1, 0x0b, 0x43, 0x9c, 0x00, 0x18, 0x00, 0xb5, 0x8c,
+	0x3e, 0x64, 0xe2, 0x00, 0x00, 0x00,
+}
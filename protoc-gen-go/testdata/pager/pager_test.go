@@ -0,0 +1,167 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package pager
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/ccsnake/carno/client"
+	"github.com/golang/protobuf/proto"
+)
+
+// library is a LibraryClient with books pages of two books.
+type library struct {
+	LibraryClient // not implemented
+	books         []string
+	fail          string // page token to fail at
+	stuck         bool   // return the page token as the next one
+}
+
+func (l *library) ListBooks(ctx context.Context, in *ListBooksRequest, opts ...client.CallOption) (*ListBooksResponse, error) {
+	if in.PageToken != "" && in.PageToken == l.fail {
+		return nil, errors.New("unavailable")
+	}
+	start, _ := strconv.Atoi(in.PageToken)
+	out := new(ListBooksResponse)
+	for i := start; i < start+2 && i < len(l.books); i++ {
+		out.Books = append(out.Books, &Book{Title: l.books[i]})
+	}
+	if start+2 < len(l.books) {
+		out.NextPageToken = strconv.Itoa(start + 2)
+	}
+	if l.stuck {
+		out.NextPageToken = in.PageToken
+		if out.NextPageToken == "" {
+			out.NextPageToken = "0"
+		}
+	}
+	return out, nil
+}
+
+func (l *library) ListShelves(ctx context.Context, in *ListShelvesRequest, opts ...client.CallOption) (*ListShelvesResponse, error) {
+	if in.Cursor == "" {
+		return &ListShelvesResponse{Shelves: []string{"a"}, NextCursor: "x"}, nil
+	}
+	return &ListShelvesResponse{Shelves: []string{"b"}}, nil
+}
+
+func titles(books []*Book) []string {
+	var t []string
+	for _, b := range books {
+		t = append(t, b.Title)
+	}
+	return t
+}
+
+func TestPages(t *testing.T) {
+	p := &LibraryPager{Client: &library{books: []string{"a", "b", "c", "d", "e"}}}
+	in := &ListBooksRequest{PageSize: 2}
+	pages := p.ListBooksPages(context.Background(), in)
+	var got [][]string
+	for pages.Next() {
+		got = append(got, titles(pages.Page().Books))
+	}
+	if err := pages.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("pages = %q, want %q", got, want)
+	}
+	if !proto.Equal(in, &ListBooksRequest{PageSize: 2}) {
+		t.Errorf("request was modified: %v", in)
+	}
+	if pages.Next() {
+		t.Error("Next after the last page returned true")
+	}
+}
+
+func TestPagesAll(t *testing.T) {
+	p := &LibraryPager{Client: &library{books: []string{"a", "b", "c"}}}
+	all, err := p.ListBooksPages(context.Background(), &ListBooksRequest{PageToken: "1"}).All()
+	if got, want := titles(all), []string{"b", "c"}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("All from page 1 = %q, %v; want %q", got, err, want)
+	}
+
+	shelves, err := p.ListShelvesPages(context.Background(), &ListShelvesRequest{}).All()
+	if want := []string{"a", "b"}; err != nil || !reflect.DeepEqual(shelves, want) {
+		t.Errorf("All shelves = %q, %v; want %q", shelves, err, want)
+	}
+}
+
+func TestPagesErrors(t *testing.T) {
+	p := &LibraryPager{Client: &library{books: []string{"a", "b", "c", "d", "e"}, fail: "2"}}
+	all, err := p.ListBooksPages(context.Background(), &ListBooksRequest{}).All()
+	if got, want := titles(all), []string{"a", "b"}; err == nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("All failing at page 2 = %q, %v; want %q and an error", got, err, want)
+	}
+
+	p = &LibraryPager{Client: &library{books: []string{"a", "b", "c"}, stuck: true}}
+	pages := p.ListBooksPages(context.Background(), &ListBooksRequest{})
+	n := 0
+	for pages.Next() {
+		n++
+	}
+	if n != 2 || pages.Err() == nil {
+		t.Errorf("a repeated page token gave %d pages and error %v; want 2 and an error", n, pages.Err())
+	}
+}
+
+// archive is an ArchiveClient with one name on each of three pages.
+type archive struct{}
+
+func (archive) ListOld(ctx context.Context, in *ListOldRequest, opts ...client.CallOption) (*ListOldResponse, error) {
+	n, _ := strconv.Atoi(in.GetPageToken())
+	out := &ListOldResponse{Names: []string{strconv.Itoa(n)}}
+	if n < 2 {
+		out.NextPageToken = proto.String(strconv.Itoa(n + 1))
+	}
+	return out, nil
+}
+
+func TestPagesProto2(t *testing.T) {
+	p := &ArchivePager{Client: archive{}}
+	names, err := p.ListOldPages(context.Background(), &ListOldRequest{}).All()
+	if want := []string{"0", "1", "2"}; err != nil || !reflect.DeepEqual(names, want) {
+		t.Errorf("All = %q, %v; want %q", names, err, want)
+	}
+}
+
+// ListTags has no All, as its response has two repeated fields.
+var _ interface {
+	Next() bool
+	Page() *ListTagsResponse
+	Err() error
+} = (*Library_ListTagsPages)(nil)
@@ -0,0 +1,118 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package pool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ccsnake/carno/client"
+)
+
+func TestPut(t *testing.T) {
+	m := GetMsg()
+	if m == nil || m.Text != "" {
+		t.Fatalf("GetMsg = %v, want an empty message", m)
+	}
+	m.Text = "hi"
+	m.Part = &Msg_Part{Text: "part"}
+	PutMsg(m)
+	if m.Text != "" || m.Part != nil {
+		t.Errorf("PutMsg left %v, want it reset", m)
+	}
+	PutMsg(nil)
+}
+
+// server records the requests it handles, and echoes them from Say.
+type server struct {
+	got []string
+}
+
+func (s *server) Say(ctx context.Context, in *Msg) (*Msg, error) {
+	s.got = append(s.got, in.Text)
+	return in, nil
+}
+
+func (s *server) Shout(ctx context.Context, in *Msg) (*Reply, error) {
+	s.got = append(s.got, in.Text)
+	return &Reply{Text: in.Text + "!"}, nil
+}
+
+func TestPoolServer(t *testing.T) {
+	srv := &server{}
+	ps := _Echo_poolServer{srv}
+
+	in := &Msg{Text: "a"}
+	out, err := ps.Shout(context.Background(), in)
+	if err != nil || out.Text != "a!" {
+		t.Errorf("Shout = %v, %v; want a!", out, err)
+	}
+	if in.Text != "" {
+		t.Errorf("request after Shout = %v, want it returned to the pool", in)
+	}
+
+	// A request that is also the response stays intact.
+	in = &Msg{Text: "b"}
+	if out, err := ps.Say(context.Background(), in); err != nil || out.Text != "b" {
+		t.Errorf("Say = %v, %v; want b", out, err)
+	}
+	if want := []string{"a", "b"}; len(srv.got) != 2 || srv.got[0] != want[0] || srv.got[1] != want[1] {
+		t.Errorf("server got %q, want %q", srv.got, want)
+	}
+}
+
+// fakeClient stands in for the carno client, answering each call.
+type fakeClient struct{}
+
+func (fakeClient) Start() error { return nil }
+
+func (fakeClient) Call(ctx context.Context, service, method string, in, out interface{}, opts ...client.CallOption) error {
+	switch out := out.(type) {
+	case *Msg:
+		out.Text = in.(*Msg).Text
+	case *Reply:
+		out.Text = in.(*Msg).Text + "!"
+	}
+	return nil
+}
+
+func TestPoolClient(t *testing.T) {
+	c := &echoClient{Client: fakeClient{}}
+	for i := 0; i < 3; i++ {
+		out, err := c.Shout(context.Background(), &Msg{Text: "c"})
+		if err != nil || out.Text != "c!" {
+			t.Fatalf("Shout = %v, %v; want c!", out, err)
+		}
+		// Responses taken from the pool come back empty.
+		PutReply(out)
+	}
+}
@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: queue/queue.proto
+
+/*
+Package queue is a generated protocol buffer package.
+
+Package queue tests the queue transport bindings the carno plugin
+generates with carno:queue=true.
+
+It is generated from these files:
+	queue/queue.proto
+
+It has these top-level messages:
+	Chunk
+	Ack
+*/
+package queue
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	queuerpc "github.com/ccsnake/protobuf/queuerpc"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Chunk struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *Chunk) Reset()                    { *m = Chunk{} }
+func (m *Chunk) String() string            { return proto.CompactTextString(m) }
+func (*Chunk) ProtoMessage()               {}
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Chunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Chunk) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type Ack struct {
+	Size int64  `protobuf:"varint,1,opt,name=size" json:"size,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *Ack) Reset()                    { *m = Ack{} }
+func (m *Ack) String() string            { return proto.CompactTextString(m) }
+func (*Ack) ProtoMessage()               {}
+func (*Ack) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Ack) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Ack) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Chunk)(nil), "queue.Chunk")
+	proto.RegisterType((*Ack)(nil), "queue.Ack")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Store service
+type StoreClient interface {
+	Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+	Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error)
+}
+
+type storeClient struct {
+	client.Client
+}
+
+// NewStoreClient creates and starts a client for the Store service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewStoreClient(opts ...client.Option) (StoreClient, error) {
+	c, err := carno1.NewClient("queue", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &storeClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Store_callInfo = []*callinfo.CallInfo{
+	{
+		Service:         "queue@Store",
+		Method:          "Put",
+		RequestType:     "queue.Chunk",
+		ResponseType:    "queue.Ack",
+		File:            "queue/queue.proto",
+		MaxRequestBytes: 64,
+	},
+	{
+		Service:      "queue@Store",
+		Method:       "Stat",
+		RequestType:  "queue.Chunk",
+		ResponseType: "queue.Ack",
+		File:         "queue/queue.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Store_callInfo...)
+}
+
+func (c *storeClient) Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[0])
+	err := c.Client.Call(ctx, "Store", "Put", in, out, opts...)
+	return out, err
+}
+
+func (c *storeClient) Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[1])
+	err := c.Client.Call(ctx, "Store", "Stat", in, out, opts...)
+	return out, err
+}
+
+// StoreClient returns a StoreClient using the client shared by the services
+// of package queue.
+func (a *Queue) StoreClient() StoreClient {
+	return &storeClient{Client: a.c}
+}
+
+// Server API for Store service
+type StoreServer interface {
+	Put(context.Context, *Chunk) (*Ack, error)
+	Stat(context.Context, *Chunk) (*Ack, error)
+}
+
+func RegisterStoreServer(srv StoreServer) {
+	callinfo.RegisterServer("queue@Store")
+	carno1.HandleService(&_Store_serviceDesc, srv)
+}
+
+// NewStoreQueueClient returns a StoreClient that sends each call
+// as a request to the method's subject with b, "queue@Store/<Method>",
+// and waits for the reply. Call options are ignored.
+func NewStoreQueueClient(b queuerpc.Broker) StoreClient {
+	return &_Store_queueClient{b}
+}
+
+type _Store_queueClient struct {
+	b queuerpc.Broker
+}
+
+func (c *_Store_queueClient) Put(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[0])
+	err := queuerpc.Call(ctx, c.b, "queue@Store/Put", in, out)
+	return out, err
+}
+
+func (c *_Store_queueClient) Stat(ctx context.Context, in *Chunk, opts ...client.CallOption) (*Ack, error) {
+	out := new(Ack)
+	ctx = callinfo.NewContext(ctx, _Store_callInfo[1])
+	err := queuerpc.Call(ctx, c.b, "queue@Store/Stat", in, out)
+	return out, err
+}
+
+// StorePublisher sends requests to the methods of Store for
+// asynchronous processing: each method publishes its request to the
+// method's subject and returns without waiting for it to be handled.
+type StorePublisher interface {
+	Put(ctx context.Context, in *Chunk) error
+	Stat(ctx context.Context, in *Chunk) error
+}
+
+// NewStorePublisher returns a StorePublisher that publishes with b.
+func NewStorePublisher(b queuerpc.Broker) StorePublisher {
+	return _Store_publisher{b}
+}
+
+type _Store_publisher struct {
+	b queuerpc.Broker
+}
+
+func (p _Store_publisher) Put(ctx context.Context, in *Chunk) error {
+	return queuerpc.Publish(ctx, p.b, "queue@Store/Put", in)
+}
+
+func (p _Store_publisher) Stat(ctx context.Context, in *Chunk) error {
+	return queuerpc.Publish(ctx, p.b, "queue@Store/Stat", in)
+}
+
+// SubscribeStoreServer subscribes srv to the subject of each method of
+// Store with b, in the queue group "queue@Store", so that each request
+// is handled by one subscriber. Requests are checked as with
+// RegisterStoreServer; streaming methods are not served. Unsubscribing
+// the result stops the server.
+func SubscribeStoreServer(b queuerpc.Broker, srv StoreServer) (queuerpc.Subscription, error) {
+	return queuerpc.Subscribe(b, "queue@Store", map[string]queuerpc.Method{
+		"Put": {
+			Info: _Store_callInfo[0],
+			Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+				in := new(Chunk)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+				return srv.Put(ctx, in)
+			},
+		},
+		"Stat": {
+			Info: _Store_callInfo[1],
+			Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+				in := new(Chunk)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+				return srv.Stat(ctx, in)
+			},
+		},
+	})
+}
+
+var _Store_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Store",
+	Methods: []string{
+		"Put",
+		"Stat",
+	},
+}
+
+func init() { proto.RegisterFile("queue/queue.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2c, 0x2c, 0x4d, 0x2d,
+	0x4d, 0xd5, 0x07, 0x93, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xac, 0x60, 0x8e, 0x94, 0x70,
+	0x72, 0x62, 0x51, 0x5e, 0xbe, 0x7e, 0x7e, 0x41, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x44, 0x4e, 0x49,
+	0x9f, 0x8b, 0xd5, 0x39, 0xa3, 0x34, 0x2f, 0x5b, 0x48, 0x88, 0x8b, 0x25, 0x25, 0xb1, 0x24, 0x51,
+	0x82, 0x51, 0x81, 0x51, 0x83, 0x27, 0x08, 0xcc, 0x06, 0x89, 0xe5, 0x25, 0xe6, 0xa6, 0x4a, 0x30,
+	0x29, 0x30, 0x6a, 0x70, 0x06, 0x81, 0xd9, 0x4a, 0xba, 0x5c, 0xcc, 0x8e, 0xc9, 0x60, 0xe5, 0xc5,
+	0x99, 0x55, 0xa9, 0x60, 0xe5, 0xcc, 0x41, 0x60, 0x36, 0x36, 0xe5, 0x46, 0x01, 0x5c, 0xac, 0xc1,
+	0x25, 0xf9, 0x45, 0xa9, 0x42, 0xaa, 0x5c, 0xcc, 0x01, 0xa5, 0x25, 0x42, 0x3c, 0x7a, 0x10, 0x97,
+	0x81, 0x2d, 0x95, 0xe2, 0x82, 0xf2, 0x1c, 0x93, 0xb3, 0x95, 0x58, 0x26, 0x6c, 0x92, 0x74, 0x10,
+	0x52, 0xe0, 0x62, 0x09, 0x2e, 0x49, 0xc4, 0xa3, 0x2e, 0x89, 0x0d, 0xec, 0x70, 0x63, 0xc0, 0x00,
+	0x1e, 0xfb, 0x33, 0x62, 0xe9, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,101 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package queue
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ccsnake/protobuf/queuerpc"
+)
+
+type server struct {
+	stats []string
+}
+
+func (s *server) Put(ctx context.Context, in *Chunk) (*Ack, error) {
+	return &Ack{Size: int64(len(in.Data)), Name: in.Name}, nil
+}
+
+func (s *server) Stat(ctx context.Context, in *Chunk) (*Ack, error) {
+	s.stats = append(s.stats, in.Name)
+	return &Ack{Size: int64(len(in.Data)), Name: in.Name}, nil
+}
+
+func TestQueueClient(t *testing.T) {
+	b := queuerpc.NewLocalBroker()
+	srv := new(server)
+	sub, err := SubscribeStoreServer(b, srv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewStoreQueueClient(b)
+	ack, err := c.Put(context.Background(), &Chunk{Data: []byte("abc"), Name: "x"})
+	if err != nil || ack.Size != 3 || ack.Name != "x" {
+		t.Errorf("Put = %v, %v; want size 3, name x", ack, err)
+	}
+
+	// Put is limited to 64 bytes; Stat is not.
+	big := &Chunk{Data: make([]byte, 63)}
+	if _, err := c.Put(context.Background(), big); err == nil || !strings.Contains(err.Error(), "queue@Store/Put") {
+		t.Errorf("Put of 65 bytes: err = %v, want an error from queue@Store/Put", err)
+	}
+	if _, err := c.Stat(context.Background(), big); err != nil {
+		t.Errorf("Stat of 65 bytes: %v", err)
+	}
+
+	if err := sub.Unsubscribe(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Stat(context.Background(), &Chunk{}); err != queuerpc.ErrNoSubscribers {
+		t.Errorf("Stat after Unsubscribe: err = %v, want %v", err, queuerpc.ErrNoSubscribers)
+	}
+}
+
+func TestPublisher(t *testing.T) {
+	b := queuerpc.NewLocalBroker()
+	srv := new(server)
+	if _, err := SubscribeStoreServer(b, srv); err != nil {
+		t.Fatal(err)
+	}
+	p := NewStorePublisher(b)
+	for _, name := range []string{"a", "b"} {
+		if err := p.Stat(context.Background(), &Chunk{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The local broker delivers synchronously.
+	if got := strings.Join(srv.stats, ","); got != "a,b" {
+		t.Errorf("published Stat calls = %q, want %q", got, "a,b")
+	}
+}
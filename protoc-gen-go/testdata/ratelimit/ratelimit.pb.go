@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: ratelimit/ratelimit.proto
+
+/*
+Package ratelimit is a generated protocol buffer package.
+
+Package ratelimit tests the rate limits the carno plugin generates from
+(carno.rate_limit).
+
+It is generated from these files:
+	ratelimit/ratelimit.proto
+
+It has these top-level messages:
+	PurgeRequest
+	PurgeResponse
+*/
+package ratelimit
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	ratelimit1 "github.com/ccsnake/protobuf/ratelimit"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type PurgeRequest struct {
+	Prefix string `protobuf:"bytes,1,opt,name=prefix" json:"prefix,omitempty"`
+}
+
+func (m *PurgeRequest) Reset()                    { *m = PurgeRequest{} }
+func (m *PurgeRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurgeRequest) ProtoMessage()               {}
+func (*PurgeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *PurgeRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+type PurgeResponse struct {
+	Purged int64 `protobuf:"varint,1,opt,name=purged" json:"purged,omitempty"`
+}
+
+func (m *PurgeResponse) Reset()                    { *m = PurgeResponse{} }
+func (m *PurgeResponse) String() string            { return proto.CompactTextString(m) }
+func (*PurgeResponse) ProtoMessage()               {}
+func (*PurgeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *PurgeResponse) GetPurged() int64 {
+	if m != nil {
+		return m.Purged
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PurgeRequest)(nil), "ratelimit.PurgeRequest")
+	proto.RegisterType((*PurgeResponse)(nil), "ratelimit.PurgeResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Admin service
+type AdminClient interface {
+	Purge(ctx context.Context, in *PurgeRequest, opts ...client.CallOption) (*PurgeResponse, error)
+	Flush(ctx context.Context, in *PurgeRequest, opts ...client.CallOption) (*PurgeResponse, error)
+	Stat(ctx context.Context, in *PurgeRequest, opts ...client.CallOption) (*PurgeResponse, error)
+}
+
+type adminClient struct {
+	client.Client
+}
+
+// NewAdminClient creates and starts a client for the Admin service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewAdminClient(opts ...client.Option) (AdminClient, error) {
+	c, err := carno1.NewClient("ratelimit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &adminClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Admin_callInfo = []*callinfo.CallInfo{
+	{
+		Service:      "ratelimit@Admin",
+		Method:       "Purge",
+		RequestType:  "ratelimit.PurgeRequest",
+		ResponseType: "ratelimit.PurgeResponse",
+		File:         "ratelimit/ratelimit.proto",
+		RateLimit:    0.5,
+		RateBurst:    2,
+	},
+	{
+		Service:      "ratelimit@Admin",
+		Method:       "Flush",
+		RequestType:  "ratelimit.PurgeRequest",
+		ResponseType: "ratelimit.PurgeResponse",
+		File:         "ratelimit/ratelimit.proto",
+		RateLimit:    100,
+	},
+	{
+		Service:      "ratelimit@Admin",
+		Method:       "Stat",
+		RequestType:  "ratelimit.PurgeRequest",
+		ResponseType: "ratelimit.PurgeResponse",
+		File:         "ratelimit/ratelimit.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Admin_callInfo...)
+}
+
+func (c *adminClient) Purge(ctx context.Context, in *PurgeRequest, opts ...client.CallOption) (*PurgeResponse, error) {
+	out := new(PurgeResponse)
+	ctx = callinfo.NewContext(ctx, _Admin_callInfo[0])
+	err := c.Client.Call(ctx, "Admin", "Purge", in, out, opts...)
+	return out, err
+}
+
+func (c *adminClient) Flush(ctx context.Context, in *PurgeRequest, opts ...client.CallOption) (*PurgeResponse, error) {
+	out := new(PurgeResponse)
+	ctx = callinfo.NewContext(ctx, _Admin_callInfo[1])
+	err := c.Client.Call(ctx, "Admin", "Flush", in, out, opts...)
+	return out, err
+}
+
+func (c *adminClient) Stat(ctx context.Context, in *PurgeRequest, opts ...client.CallOption) (*PurgeResponse, error) {
+	out := new(PurgeResponse)
+	ctx = callinfo.NewContext(ctx, _Admin_callInfo[2])
+	err := c.Client.Call(ctx, "Admin", "Stat", in, out, opts...)
+	return out, err
+}
+
+// AdminClient returns a AdminClient using the client shared by the services
+// of package ratelimit.
+func (a *Ratelimit) AdminClient() AdminClient {
+	return &adminClient{Client: a.c}
+}
+
+// Server API for Admin service
+type AdminServer interface {
+	Purge(context.Context, *PurgeRequest) (*PurgeResponse, error)
+	Flush(context.Context, *PurgeRequest) (*PurgeResponse, error)
+	Stat(context.Context, *PurgeRequest) (*PurgeResponse, error)
+}
+
+// _Admin_rateLimitServer rejects calls over the RateLimit of their method
+// in _Admin_callInfo before calling the wrapped server.
+type _Admin_rateLimitServer struct {
+	AdminServer
+}
+
+func (s _Admin_rateLimitServer) Purge(ctx context.Context, in *PurgeRequest) (*PurgeResponse, error) {
+	if err := ratelimit1.Check(ctx, _Admin_callInfo[0]); err != nil {
+		return nil, err
+	}
+	return s.AdminServer.Purge(ctx, in)
+}
+
+func (s _Admin_rateLimitServer) Flush(ctx context.Context, in *PurgeRequest) (*PurgeResponse, error) {
+	if err := ratelimit1.Check(ctx, _Admin_callInfo[1]); err != nil {
+		return nil, err
+	}
+	return s.AdminServer.Flush(ctx, in)
+}
+
+func RegisterAdminServer(srv AdminServer) {
+	callinfo.RegisterServer("ratelimit@Admin")
+	carno1.HandleService(&_Admin_serviceDesc, _Admin_rateLimitServer{srv})
+}
+
+var _Admin_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Admin",
+	Methods: []string{
+		"Purge",
+		"Flush",
+		"Stat",
+	},
+}
+
+func init() { proto.RegisterFile("ratelimit/ratelimit.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 199 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x4a, 0x2c, 0x49,
+	0xcd, 0xc9, 0xcc, 0xcd, 0x2c, 0xd1, 0x87, 0xb3, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x38,
+	0xe1, 0x02, 0x52, 0xc2, 0xc9, 0x89, 0x45, 0x79, 0xf9, 0xfa, 0xf9, 0x05, 0x25, 0x99, 0xf9, 0x79,
+	0xc5, 0x10, 0x79, 0x25, 0x35, 0x2e, 0x9e, 0x80, 0xd2, 0xa2, 0xf4, 0xd4, 0xa0, 0xd4, 0xc2, 0xd2,
+	0xd4, 0xe2, 0x12, 0x21, 0x31, 0x2e, 0xb6, 0x82, 0xa2, 0xd4, 0xb4, 0xcc, 0x0a, 0x09, 0x46, 0x05,
+	0x46, 0x0d, 0xce, 0x20, 0x28, 0x4f, 0x49, 0x9d, 0x8b, 0x17, 0xaa, 0xae, 0xb8, 0x20, 0x3f, 0xaf,
+	0x38, 0x15, 0xac, 0x10, 0x24, 0x90, 0x02, 0x56, 0xc8, 0x1c, 0x04, 0xe5, 0x19, 0xdd, 0x62, 0xe4,
+	0x62, 0x75, 0x4c, 0xc9, 0xcd, 0xcc, 0x13, 0xf2, 0xe6, 0x62, 0x05, 0x6b, 0x11, 0x12, 0xd7, 0x43,
+	0xb8, 0x0a, 0xd9, 0x32, 0x29, 0x09, 0x4c, 0x09, 0x88, 0xe9, 0x4a, 0xfc, 0x8b, 0x36, 0x49, 0x72,
+	0x73, 0x32, 0x80, 0xc1, 0x03, 0x7b, 0x01, 0x26, 0x21, 0x4f, 0x2e, 0x56, 0xb7, 0x9c, 0xd2, 0xe2,
+	0x0c, 0x72, 0x0c, 0xe3, 0x5d, 0xb4, 0x49, 0x92, 0x13, 0x6a, 0x58, 0xa4, 0x83, 0x90, 0x25, 0x17,
+	0x4b, 0x70, 0x49, 0x62, 0x09, 0x19, 0x26, 0x25, 0xb1, 0x81, 0x03, 0xcd, 0x18, 0x30, 0x00, 0xb8,
+	0xac, 0xc1, 0xe7, 0x71, 0x01, 0x00, 0x00,
+}
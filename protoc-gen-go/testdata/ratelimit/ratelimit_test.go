@@ -0,0 +1,98 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/ccsnake/protobuf/ratelimit"
+)
+
+type server struct{}
+
+func (server) Purge(ctx context.Context, in *PurgeRequest) (*PurgeResponse, error) {
+	return &PurgeResponse{Purged: 1}, nil
+}
+
+func (server) Flush(ctx context.Context, in *PurgeRequest) (*PurgeResponse, error) {
+	return &PurgeResponse{}, nil
+}
+
+func (server) Stat(ctx context.Context, in *PurgeRequest) (*PurgeResponse, error) {
+	return &PurgeResponse{}, nil
+}
+
+func TestCallInfoRateLimits(t *testing.T) {
+	for _, test := range []struct {
+		method string
+		rps    float64
+		burst  int
+	}{
+		{"ratelimit@Admin/Purge", 0.5, 2},
+		{"ratelimit@Admin/Flush", 100, 0},
+		{"ratelimit@Admin/Stat", 0, 0},
+	} {
+		info := callinfo.Lookup(test.method)
+		if info == nil {
+			t.Fatalf("Lookup(%s) = nil", test.method)
+		}
+		if info.RateLimit != test.rps || info.RateBurst != test.burst {
+			t.Errorf("%s rate limit = %v, %d; want %v, %d", test.method, info.RateLimit, info.RateBurst, test.rps, test.burst)
+		}
+	}
+}
+
+func TestRateLimitServer(t *testing.T) {
+	defer ratelimit.SetLimiter(ratelimit.GetLimiter())
+	ratelimit.SetLimiter(ratelimit.NewTokenBuckets())
+
+	srv := _Admin_rateLimitServer{server{}}
+	ctx := context.Background()
+	// Purge allows a burst of 2, and gains a call every other second.
+	for i := 0; i < 2; i++ {
+		if _, err := srv.Purge(ctx, &PurgeRequest{}); err != nil {
+			t.Fatalf("Purge %d: %v", i, err)
+		}
+	}
+	_, err := srv.Purge(ctx, &PurgeRequest{})
+	if e, ok := err.(*ratelimit.Error); !ok || e.Method != "ratelimit@Admin/Purge" {
+		t.Errorf("third Purge: %v, want a *ratelimit.Error for the method", err)
+	}
+	// Methods without a rate limit are not wrapped.
+	for i := 0; i < 10; i++ {
+		if _, err := srv.Stat(ctx, &PurgeRequest{}); err != nil {
+			t.Fatalf("Stat %d: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//   - Redistributions of source code must retain the above copyright
+//
+// notice, this list of conditions and the following disclaimer.
+//   - Redistributions in binary form must reproduce the above
+//
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//   - Neither the name of Google Inc. nor the names of its
+//
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package split
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ccsnake/carno/client"
+)
+
+// fakeClient stands in for the carno client, echoing each request.
+type fakeClient struct{}
+
+func (fakeClient) Start() error { return nil }
+
+func (fakeClient) Call(ctx context.Context, service, method string, in, out interface{}, opts ...client.CallOption) error {
+	out.(*Msg).Text = service + "." + method + ":" + in.(*Msg).GetText()
+	return nil
+}
+
+// The aggregate has the services of both files, though protoc was run
+// once for each of them.
+func TestAggregate(t *testing.T) {
+	agg := &Split{c: fakeClient{}}
+	if out, err := agg.EchoClient().Say(context.Background(), &Msg{Text: "hi"}); err != nil || out.GetText() != "Echo.Say:hi" {
+		t.Errorf("Say = %v, %v; want Echo.Say:hi", out, err)
+	}
+	if out, err := agg.CountClient().Add(context.Background(), &Msg{Text: "1"}); err != nil || out.GetText() != "Count.Add:1" {
+		t.Errorf("Add = %v, %v; want Count.Add:1", out, err)
+	}
+	if ServerName != "split" {
+		t.Errorf("ServerName = %q, want split", ServerName)
+	}
+}
@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: testserver/testserver.proto
+
+/*
+Package testserver is a generated protocol buffer package.
+
+Package testserver tests the New<Service>TestServer functions the carno
+plugin generates with carno:testserver=true.
+
+It is generated from these files:
+	testserver/testserver.proto
+
+It has these top-level messages:
+	AddRequest
+	ResetRequest
+	Total
+*/
+package testserver
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	carno1 "github.com/ccsnake/carno"
+	client "github.com/ccsnake/carno/client"
+	mux "github.com/ccsnake/carno/mux"
+	callinfo "github.com/ccsnake/protobuf/callinfo"
+	carnotest "github.com/ccsnake/protobuf/carnotest"
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type AddRequest struct {
+	Counter string `protobuf:"bytes,1,opt,name=counter" json:"counter,omitempty"`
+	Delta   int64  `protobuf:"varint,2,opt,name=delta" json:"delta,omitempty"`
+}
+
+func (m *AddRequest) Reset()                    { *m = AddRequest{} }
+func (m *AddRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddRequest) ProtoMessage()               {}
+func (*AddRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *AddRequest) GetCounter() string {
+	if m != nil {
+		return m.Counter
+	}
+	return ""
+}
+
+func (m *AddRequest) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+type ResetRequest struct {
+	Counter string `protobuf:"bytes,1,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (m *ResetRequest) Reset()                    { *m = ResetRequest{} }
+func (m *ResetRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResetRequest) ProtoMessage()               {}
+func (*ResetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ResetRequest) GetCounter() string {
+	if m != nil {
+		return m.Counter
+	}
+	return ""
+}
+
+type Total struct {
+	Counter string `protobuf:"bytes,1,opt,name=counter" json:"counter,omitempty"`
+	Value   int64  `protobuf:"varint,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *Total) Reset()                    { *m = Total{} }
+func (m *Total) String() string            { return proto.CompactTextString(m) }
+func (*Total) ProtoMessage()               {}
+func (*Total) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Total) GetCounter() string {
+	if m != nil {
+		return m.Counter
+	}
+	return ""
+}
+
+func (m *Total) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AddRequest)(nil), "testserver.AddRequest")
+	proto.RegisterType((*ResetRequest)(nil), "testserver.ResetRequest")
+	proto.RegisterType((*Total)(nil), "testserver.Total")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the carno package it is being compiled against.
+
+// Client API for Counter service
+type CounterClient interface {
+	Add(ctx context.Context, in *AddRequest, opts ...client.CallOption) (*Total, error)
+	Reset(ctx context.Context, in *ResetRequest, opts ...client.CallOption) (*Total, error)
+}
+
+type counterClient struct {
+	client.Client
+}
+
+// NewCounterClient creates and starts a client for the Counter service.
+// Like the carno client it wraps, it is safe for concurrent use by multiple goroutines.
+func NewCounterClient(opts ...client.Option) (CounterClient, error) {
+	c, err := carno1.NewClient("testserver", opts...)
+	if err != nil {
+		return nil, err
+	}
+	rv := &counterClient{Client: c}
+	return rv, c.Start()
+}
+
+var _Counter_callInfo = []*callinfo.CallInfo{
+	{
+		Service:         "testserver@Counter",
+		Method:          "Add",
+		RequestType:     "testserver.AddRequest",
+		ResponseType:    "testserver.Total",
+		File:            "testserver/testserver.proto",
+		MaxRequestBytes: 16,
+	},
+	{
+		Service:      "testserver@Counter",
+		Method:       "Reset",
+		RequestType:  "testserver.ResetRequest",
+		ResponseType: "testserver.Total",
+		File:         "testserver/testserver.proto",
+	},
+}
+
+func init() {
+	callinfo.Register(_Counter_callInfo...)
+}
+
+func (c *counterClient) Add(ctx context.Context, in *AddRequest, opts ...client.CallOption) (*Total, error) {
+	out := new(Total)
+	ctx = callinfo.NewContext(ctx, _Counter_callInfo[0])
+	err := c.Client.Call(ctx, "Counter", "Add", in, out, opts...)
+	return out, err
+}
+
+func (c *counterClient) Reset(ctx context.Context, in *ResetRequest, opts ...client.CallOption) (*Total, error) {
+	out := new(Total)
+	ctx = callinfo.NewContext(ctx, _Counter_callInfo[1])
+	err := c.Client.Call(ctx, "Counter", "Reset", in, out, opts...)
+	return out, err
+}
+
+// CounterClient returns a CounterClient using the client shared by the services
+// of package testserver.
+func (a *Testserver) CounterClient() CounterClient {
+	return &counterClient{Client: a.c}
+}
+
+// Server API for Counter service
+type CounterServer interface {
+	Add(context.Context, *AddRequest) (*Total, error)
+	Reset(context.Context, *ResetRequest) (*Total, error)
+}
+
+var _Counter_methodRoles = map[string][]string{
+	"Reset": {"admin"},
+}
+
+// _Counter_authzServer checks the roles in _Counter_methodRoles with the registered
+// carno.Authorizer before calling the wrapped server. It fails closed:
+// if no authorizer is registered, guarded methods are rejected.
+type _Counter_authzServer struct {
+	CounterServer
+}
+
+func (s _Counter_authzServer) Reset(ctx context.Context, in *ResetRequest) (*Total, error) {
+	authz := carno1.GetAuthorizer()
+	if authz == nil {
+		return nil, fmt.Errorf("carno: no authorizer registered, denying %s", "testserver@Counter/Reset")
+	}
+	if err := authz.Authorize(ctx, "testserver@Counter/Reset", _Counter_methodRoles["Reset"]); err != nil {
+		return nil, err
+	}
+	return s.CounterServer.Reset(ctx, in)
+}
+
+func RegisterCounterServer(srv CounterServer) {
+	callinfo.RegisterServer("testserver@Counter")
+	carno1.HandleService(&_Counter_serviceDesc, _Counter_authzServer{srv})
+}
+
+// NewCounterTestServer returns a CounterClient that calls srv in
+// memory, for testing srv without a network. Calls go through the same
+// encoding and decoding as with a carno client and server, and requests
+// are checked as with RegisterCounterServer; see package carnotest.
+// Streaming methods are not served.
+func NewCounterTestServer(srv CounterServer) CounterClient {
+	srv = _Counter_authzServer{srv}
+	c := carnotest.NewClient("Counter", map[string]carnotest.Method{
+		"Add": {
+			Info: _Counter_callInfo[0],
+			Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+				in := new(AddRequest)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+				return srv.Add(ctx, in)
+			},
+		},
+		"Reset": {
+			Info: _Counter_callInfo[1],
+			Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+				in := new(ResetRequest)
+				if err := decode(in); err != nil {
+					return nil, err
+				}
+				return srv.Reset(ctx, in)
+			},
+		},
+	})
+	return &counterClient{Client: c}
+}
+
+var _Counter_serviceDesc = mux.ServiceDesc{
+	ServiceName: "Counter",
+	Methods: []string{
+		"Add",
+		"Reset",
+	},
+}
+
+func init() { proto.RegisterFile("testserver/testserver.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 210 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x49, 0x2d, 0x2e,
+	0x29, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x47, 0x30, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85,
+	0xb8, 0x10, 0x22, 0x52, 0xc2, 0xc9, 0x89, 0x45, 0x79, 0xf9, 0xfa, 0xf9, 0x05, 0x25, 0x99, 0xf9,
+	0x79, 0xc5, 0x10, 0x05, 0x4a, 0x36, 0x5c, 0x5c, 0x8e, 0x29, 0x29, 0x41, 0xa9, 0x85, 0xa5, 0xa9,
+	0xc5, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
+	0x8c, 0x1a, 0x9c, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x4a, 0x6a, 0x4e, 0x49, 0xa2, 0x04,
+	0x93, 0x02, 0xa3, 0x06, 0x73, 0x10, 0x84, 0xa3, 0xa4, 0xc1, 0xc5, 0x13, 0x94, 0x5a, 0x9c, 0x5a,
+	0x42, 0x50, 0xbf, 0x92, 0x39, 0x17, 0x6b, 0x48, 0x7e, 0x49, 0x62, 0x0e, 0x7e, 0x2b, 0xca, 0x12,
+	0x73, 0x4a, 0x53, 0x61, 0x56, 0x80, 0x39, 0x46, 0x4d, 0x8c, 0x5c, 0xec, 0xce, 0x50, 0x15, 0x66,
+	0x5c, 0xcc, 0x8e, 0x29, 0x29, 0x42, 0x62, 0x7a, 0x48, 0xfe, 0x44, 0xb8, 0x5e, 0x4a, 0x10, 0x59,
+	0x1c, 0x6c, 0x9b, 0x12, 0xcb, 0x84, 0x4d, 0x92, 0x02, 0x42, 0xf6, 0x5c, 0xac, 0x60, 0x67, 0x0a,
+	0x49, 0x20, 0xab, 0x40, 0x76, 0x39, 0x36, 0xbd, 0x9c, 0x4d, 0x9b, 0x24, 0x59, 0x13, 0x53, 0x72,
+	0x33, 0xf3, 0x92, 0xd8, 0xc0, 0x81, 0x65, 0x0c, 0x18, 0x00, 0x01, 0x90, 0xa0, 0xc4, 0x6c, 0x01,
+	0x00, 0x00,
+}
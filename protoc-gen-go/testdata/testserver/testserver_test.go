@@ -0,0 +1,107 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package testserver
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+)
+
+// counters is a CounterServer keeping its counters in memory.
+type counters struct {
+	mu     sync.Mutex
+	values map[string]int64
+	infos  []*callinfo.CallInfo // of the calls to Add
+}
+
+func (s *counters) Add(ctx context.Context, in *AddRequest) (*Total, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, _ := callinfo.FromContext(ctx)
+	s.infos = append(s.infos, info)
+	s.values[in.Counter] += in.Delta
+	in.Delta = 0 // a handler scribbling on its request must not affect the caller's
+	return &Total{Counter: in.Counter, Value: s.values[in.Counter]}, nil
+}
+
+func (s *counters) Reset(ctx context.Context, in *ResetRequest) (*Total, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, in.Counter)
+	return &Total{Counter: in.Counter}, nil
+}
+
+func TestTestServer(t *testing.T) {
+	srv := &counters{values: make(map[string]int64)}
+	c := NewCounterTestServer(srv)
+	ctx := context.Background()
+
+	in := &AddRequest{Counter: "hits", Delta: 2}
+	for i, want := range []int64{2, 4} {
+		got, err := c.Add(ctx, in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(got, &Total{Counter: "hits", Value: want}) {
+			t.Errorf("call %d: Add = %v, want %d", i, got, want)
+		}
+	}
+	if in.Delta != 2 {
+		t.Errorf("request changed to %v by the server", in)
+	}
+	if len(srv.infos) != 2 || srv.infos[0] == nil || srv.infos[0].FullMethod() != "testserver@Counter/Add" {
+		t.Errorf("server got call infos %v, want those of Add", srv.infos)
+	}
+}
+
+func TestTestServerChecks(t *testing.T) {
+	srv := &counters{values: make(map[string]int64)}
+	c := NewCounterTestServer(srv)
+	ctx := context.Background()
+
+	// Over (carno.max_request_bytes).
+	if _, err := c.Add(ctx, &AddRequest{Counter: "a counter with a long name"}); err == nil {
+		t.Error("Add with a request over its limit succeeded")
+	}
+	if len(srv.infos) != 0 {
+		t.Error("request over its limit reached the server")
+	}
+	// (carno.require_roles) fails closed, with no authorizer registered.
+	if _, err := c.Reset(ctx, &ResetRequest{Counter: "hits"}); err == nil || !strings.Contains(err.Error(), "no authorizer") {
+		t.Errorf("Reset without an authorizer: err = %v, want it denied", err)
+	}
+}
@@ -0,0 +1,91 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// protocompat-check reports the breaking changes between two versions of
+// a schema, each a FileDescriptorSet written by
+// protoc --descriptor_set_out --include_imports, and exits with status 1
+// if there are any, so that a CI can reject them.
+//
+// Usage:
+//
+//	protocompat-check old.pb new.pb
+//
+// Each change is printed on its own line, as
+// file: element: description.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protocompat"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("protocompat-check: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: protocompat-check old.pb new.pb\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+	}
+
+	before, after := readSet(flag.Arg(0)), readSet(flag.Arg(1))
+	problems := protocompat.Compare(before, after)
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	if len(problems) > 0 {
+		log.Printf("%d breaking change(s)", len(problems))
+		os.Exit(1)
+	}
+}
+
+func readSet(fn string) *pb.FileDescriptorSet {
+	b, err := ioutil.ReadFile(fn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	set := new(pb.FileDescriptorSet)
+	if err := proto.Unmarshal(b, set); err != nil {
+		log.Fatalf("%s: %v", fn, err)
+	}
+	return set
+}
@@ -0,0 +1,279 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package protocompat compares two versions of a schema, as sets of file
+descriptors, and reports the changes that break the wire compatibility
+of messages or the API of services, so that a CI can reject them before
+old and new binaries exchange messages:
+
+	for _, p := range protocompat.Compare(old, new) {
+		fmt.Println(p) // api.proto: pkg.User.id: number changed from 1 to 2
+	}
+
+The changes reported are:
+
+  - a field whose number changed, found by its name;
+  - a field whose type changed to one that does not share its encoding,
+    as when int32 becomes string or sint32 becomes int32, or whose
+    message type changed;
+  - a field that became repeated or singular, or required or not;
+  - a required field removed, or added;
+  - a service removed, a method removed from a service, or a method whose
+    request or response type, or streaming, changed.
+
+Types sharing an encoding are those the protocol buffer language guide
+calls compatible: int32, uint32, int64, uint64, bool and enums; sint32
+and sint64; string and bytes; fixed32 and sfixed32; fixed64 and sfixed64.
+
+Elements are matched by their full names, so a message or service that
+is renamed or moved to another package counts as removed. A descriptor
+set from protoc --descriptor_set_out --include_imports holds every file
+a schema needs; the protocompat-check command compares two of them.
+*/
+package protocompat
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// A Problem is a breaking change between two versions of a schema.
+type Problem struct {
+	File    string // name of the file declaring the element in the old schema
+	Element string // full name of the element, such as "pkg.User.id"
+	Message string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s: %s", p.File, p.Element, p.Message)
+}
+
+// Compare returns the breaking changes from the schema old to the schema
+// new, in the order the elements are declared in old.
+func Compare(old, new *pb.FileDescriptorSet) []Problem {
+	c := &checker{
+		messages: make(map[string]*pb.DescriptorProto),
+		services: make(map[string]*pb.ServiceDescriptorProto),
+	}
+	for _, fd := range new.GetFile() {
+		c.indexMessages(prefix(fd), fd.MessageType)
+		for _, sd := range fd.Service {
+			c.services[prefix(fd)+sd.GetName()] = sd
+		}
+	}
+	for _, fd := range old.GetFile() {
+		c.file = fd.GetName()
+		c.compareMessages(prefix(fd), fd.MessageType)
+		for _, sd := range fd.Service {
+			c.compareService(prefix(fd)+sd.GetName(), sd)
+		}
+	}
+	return c.problems
+}
+
+// prefix returns the prefix of the full names of the top-level elements
+// of fd.
+func prefix(fd *pb.FileDescriptorProto) string {
+	if fd.GetPackage() == "" {
+		return ""
+	}
+	return fd.GetPackage() + "."
+}
+
+// checker accumulates the problems found comparing two schemas.
+type checker struct {
+	// Messages and services of the new schema, by full name.
+	messages map[string]*pb.DescriptorProto
+	services map[string]*pb.ServiceDescriptorProto
+
+	file     string // name of the old file being compared
+	problems []Problem
+}
+
+func (c *checker) report(element, format string, args ...interface{}) {
+	c.problems = append(c.problems, Problem{File: c.file, Element: element, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *checker) indexMessages(prefix string, msgs []*pb.DescriptorProto) {
+	for _, m := range msgs {
+		name := prefix + m.GetName()
+		c.messages[name] = m
+		c.indexMessages(name+".", m.NestedType)
+	}
+}
+
+// compareMessages compares the messages of the old schema with their
+// namesakes in the new one. Messages that are gone are not reported
+// themselves; the fields and methods that used them are.
+func (c *checker) compareMessages(prefix string, msgs []*pb.DescriptorProto) {
+	for _, m := range msgs {
+		name := prefix + m.GetName()
+		if n, ok := c.messages[name]; ok {
+			c.compareFields(name, m, n)
+		}
+		c.compareMessages(name+".", m.NestedType)
+	}
+}
+
+func (c *checker) compareFields(msgName string, old, new *pb.DescriptorProto) {
+	byNumber := make(map[int32]*pb.FieldDescriptorProto)
+	byName := make(map[string]*pb.FieldDescriptorProto)
+	for _, f := range new.Field {
+		byNumber[f.GetNumber()] = f
+		byName[f.GetName()] = f
+	}
+	oldNumbers := make(map[int32]bool)
+	for _, f := range old.Field {
+		oldNumbers[f.GetNumber()] = true
+		name := msgName + "." + f.GetName()
+		n, ok := byNumber[f.GetNumber()]
+		if !ok {
+			if n, ok := byName[f.GetName()]; ok {
+				c.report(name, "number changed from %d to %d", f.GetNumber(), n.GetNumber())
+			} else if f.GetLabel() == pb.FieldDescriptorProto_LABEL_REQUIRED {
+				c.report(name, "required field %d removed", f.GetNumber())
+			}
+			continue
+		}
+		if n.GetName() != f.GetName() {
+			name = msgName + "." + n.GetName()
+		}
+		if !compatibleTypes(f, n) {
+			c.report(name, "type changed from %s to %s", typeName(f), typeName(n))
+		}
+		if ol, nl := f.GetLabel(), n.GetLabel(); ol != nl {
+			if ol == pb.FieldDescriptorProto_LABEL_REPEATED || nl == pb.FieldDescriptorProto_LABEL_REPEATED ||
+				ol == pb.FieldDescriptorProto_LABEL_REQUIRED || nl == pb.FieldDescriptorProto_LABEL_REQUIRED {
+				c.report(name, "changed from %s to %s", labelName(ol), labelName(nl))
+			}
+		}
+	}
+	for _, n := range new.Field {
+		if !oldNumbers[n.GetNumber()] && n.GetLabel() == pb.FieldDescriptorProto_LABEL_REQUIRED {
+			if _, renumbered := fieldByName(old, n.GetName()); !renumbered {
+				c.report(msgName+"."+n.GetName(), "required field %d added", n.GetNumber())
+			}
+		}
+	}
+}
+
+// fieldByName returns the field of m with the given name.
+func fieldByName(m *pb.DescriptorProto, name string) (*pb.FieldDescriptorProto, bool) {
+	for _, f := range m.Field {
+		if f.GetName() == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// encodings groups the field types that share an encoding.
+var encodings = map[pb.FieldDescriptorProto_Type]string{
+	pb.FieldDescriptorProto_TYPE_INT32:    "varint",
+	pb.FieldDescriptorProto_TYPE_UINT32:   "varint",
+	pb.FieldDescriptorProto_TYPE_INT64:    "varint",
+	pb.FieldDescriptorProto_TYPE_UINT64:   "varint",
+	pb.FieldDescriptorProto_TYPE_BOOL:     "varint",
+	pb.FieldDescriptorProto_TYPE_ENUM:     "varint",
+	pb.FieldDescriptorProto_TYPE_SINT32:   "zigzag",
+	pb.FieldDescriptorProto_TYPE_SINT64:   "zigzag",
+	pb.FieldDescriptorProto_TYPE_STRING:   "bytes",
+	pb.FieldDescriptorProto_TYPE_BYTES:    "bytes",
+	pb.FieldDescriptorProto_TYPE_FIXED32:  "fixed32",
+	pb.FieldDescriptorProto_TYPE_SFIXED32: "fixed32",
+	pb.FieldDescriptorProto_TYPE_FIXED64:  "fixed64",
+	pb.FieldDescriptorProto_TYPE_SFIXED64: "fixed64",
+}
+
+// compatibleTypes reports whether a field of type f can read values
+// written as type n, and the other way around.
+func compatibleTypes(f, n *pb.FieldDescriptorProto) bool {
+	ft, nt := f.GetType(), n.GetType()
+	switch ft {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		return nt == ft && n.GetTypeName() == f.GetTypeName()
+	}
+	if ft == nt {
+		return true
+	}
+	e, ok := encodings[ft]
+	return ok && encodings[nt] == e
+}
+
+// typeName names the type of f as the .proto file does.
+func typeName(f *pb.FieldDescriptorProto) string {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_ENUM:
+		return strings.TrimPrefix(f.GetTypeName(), ".")
+	case pb.FieldDescriptorProto_TYPE_GROUP:
+		return "group " + strings.TrimPrefix(f.GetTypeName(), ".")
+	}
+	return strings.ToLower(strings.TrimPrefix(f.GetType().String(), "TYPE_"))
+}
+
+func labelName(l pb.FieldDescriptorProto_Label) string {
+	return strings.ToLower(strings.TrimPrefix(l.String(), "LABEL_"))
+}
+
+func (c *checker) compareService(name string, old *pb.ServiceDescriptorProto) {
+	new, ok := c.services[name]
+	if !ok {
+		c.report(name, "service removed")
+		return
+	}
+	methods := make(map[string]*pb.MethodDescriptorProto)
+	for _, m := range new.Method {
+		methods[m.GetName()] = m
+	}
+	for _, m := range old.Method {
+		mname := name + "." + m.GetName()
+		n, ok := methods[m.GetName()]
+		if !ok {
+			c.report(mname, "method removed")
+			continue
+		}
+		if m.GetInputType() != n.GetInputType() {
+			c.report(mname, "request type changed from %s to %s", strings.TrimPrefix(m.GetInputType(), "."), strings.TrimPrefix(n.GetInputType(), "."))
+		}
+		if m.GetOutputType() != n.GetOutputType() {
+			c.report(mname, "response type changed from %s to %s", strings.TrimPrefix(m.GetOutputType(), "."), strings.TrimPrefix(n.GetOutputType(), "."))
+		}
+		if m.GetClientStreaming() != n.GetClientStreaming() {
+			c.report(mname, "client streaming changed from %v to %v", m.GetClientStreaming(), n.GetClientStreaming())
+		}
+		if m.GetServerStreaming() != n.GetServerStreaming() {
+			c.report(mname, "server streaming changed from %v to %v", m.GetServerStreaming(), n.GetServerStreaming())
+		}
+	}
+}
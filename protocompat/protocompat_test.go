@@ -0,0 +1,170 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package protocompat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+const oldSchema = `
+file {
+  name: "api.proto"
+  package: "api"
+  message_type {
+    name: "User"
+    field { name: "id" number: 1 label: LABEL_REQUIRED type: TYPE_INT64 }
+    field { name: "name" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING }
+    field { name: "age" number: 3 label: LABEL_OPTIONAL type: TYPE_INT32 }
+    field { name: "score" number: 4 label: LABEL_OPTIONAL type: TYPE_SINT32 }
+    field { name: "tags" number: 5 label: LABEL_REPEATED type: TYPE_STRING }
+    field { name: "address" number: 6 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".api.User.Address" }
+    field { name: "token" number: 7 label: LABEL_REQUIRED type: TYPE_BYTES }
+    field { name: "kind" number: 8 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: ".api.Kind" }
+    field { name: "note" number: 9 label: LABEL_OPTIONAL type: TYPE_STRING }
+    nested_type {
+      name: "Address"
+      field { name: "zip" number: 1 label: LABEL_OPTIONAL type: TYPE_FIXED32 }
+    }
+  }
+  message_type { name: "Empty" }
+  enum_type { name: "Kind" value { name: "A" number: 0 } }
+  service {
+    name: "Users"
+    method { name: "Get" input_type: ".api.User" output_type: ".api.User" }
+    method { name: "List" input_type: ".api.Empty" output_type: ".api.User" server_streaming: true }
+    method { name: "Delete" input_type: ".api.User" output_type: ".api.Empty" }
+  }
+  service { name: "Admin" }
+}
+`
+
+const newSchema = `
+file {
+  name: "api.proto"
+  package: "api"
+  message_type {
+    name: "User"
+    field { name: "id" number: 10 label: LABEL_REQUIRED type: TYPE_INT64 }
+    field { name: "name" number: 2 label: LABEL_OPTIONAL type: TYPE_BYTES }
+    field { name: "age" number: 3 label: LABEL_OPTIONAL type: TYPE_STRING }
+    field { name: "score" number: 4 label: LABEL_OPTIONAL type: TYPE_INT32 }
+    field { name: "tags" number: 5 label: LABEL_OPTIONAL type: TYPE_STRING }
+    field { name: "address" number: 6 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".api.Empty" }
+    field { name: "kind" number: 8 label: LABEL_OPTIONAL type: TYPE_UINT64 }
+    field { name: "remark" number: 9 label: LABEL_OPTIONAL type: TYPE_STRING }
+    field { name: "email" number: 11 label: LABEL_REQUIRED type: TYPE_STRING }
+    nested_type {
+      name: "Address"
+      field { name: "zip" number: 1 label: LABEL_OPTIONAL type: TYPE_SFIXED32 }
+      field { name: "city" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING }
+    }
+  }
+  message_type { name: "Empty" }
+  service {
+    name: "Users"
+    method { name: "Get" input_type: ".api.Empty" output_type: ".api.User" }
+    method { name: "List" input_type: ".api.Empty" output_type: ".api.User" }
+  }
+}
+`
+
+func parseSet(t *testing.T, s string) *pb.FileDescriptorSet {
+	set := new(pb.FileDescriptorSet)
+	if err := proto.UnmarshalText(s, set); err != nil {
+		t.Fatal(err)
+	}
+	return set
+}
+
+func TestCompare(t *testing.T) {
+	old, new := parseSet(t, oldSchema), parseSet(t, newSchema)
+	want := []string{
+		"api.proto: api.User.id: number changed from 1 to 10",
+		"api.proto: api.User.age: type changed from int32 to string",
+		"api.proto: api.User.score: type changed from sint32 to int32",
+		"api.proto: api.User.tags: changed from repeated to optional",
+		"api.proto: api.User.address: type changed from api.User.Address to api.Empty",
+		"api.proto: api.User.token: required field 7 removed",
+		"api.proto: api.User.email: required field 11 added",
+		"api.proto: api.Users.Get: request type changed from api.User to api.Empty",
+		"api.proto: api.Users.List: server streaming changed from true to false",
+		"api.proto: api.Users.Delete: method removed",
+		"api.proto: api.Admin: service removed",
+	}
+	var got []string
+	for _, p := range Compare(old, new) {
+		got = append(got, p.String())
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compare:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if got := Compare(old, old); len(got) != 0 {
+		t.Errorf("Compare of a schema with itself = %v, want none", got)
+	}
+	// Going back has its own problems, such as the required field removed.
+	if got := Compare(new, old); len(got) == 0 {
+		t.Errorf("Compare of the new schema with the old = none, want problems")
+	}
+}
+
+func TestCompatibleTypes(t *testing.T) {
+	field := func(typ pb.FieldDescriptorProto_Type, typeName string) *pb.FieldDescriptorProto {
+		f := &pb.FieldDescriptorProto{Type: typ.Enum()}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	for _, test := range []struct {
+		a, b *pb.FieldDescriptorProto
+		want bool
+	}{
+		{field(pb.FieldDescriptorProto_TYPE_INT32, ""), field(pb.FieldDescriptorProto_TYPE_UINT64, ""), true},
+		{field(pb.FieldDescriptorProto_TYPE_BOOL, ""), field(pb.FieldDescriptorProto_TYPE_ENUM, ".E"), true},
+		{field(pb.FieldDescriptorProto_TYPE_SINT64, ""), field(pb.FieldDescriptorProto_TYPE_SINT32, ""), true},
+		{field(pb.FieldDescriptorProto_TYPE_FIXED64, ""), field(pb.FieldDescriptorProto_TYPE_SFIXED64, ""), true},
+		{field(pb.FieldDescriptorProto_TYPE_FIXED64, ""), field(pb.FieldDescriptorProto_TYPE_DOUBLE, ""), false},
+		{field(pb.FieldDescriptorProto_TYPE_FLOAT, ""), field(pb.FieldDescriptorProto_TYPE_FIXED32, ""), false},
+		{field(pb.FieldDescriptorProto_TYPE_STRING, ""), field(pb.FieldDescriptorProto_TYPE_MESSAGE, ".M"), false},
+		{field(pb.FieldDescriptorProto_TYPE_MESSAGE, ".M"), field(pb.FieldDescriptorProto_TYPE_GROUP, ".M"), false},
+		{field(pb.FieldDescriptorProto_TYPE_MESSAGE, ".M"), field(pb.FieldDescriptorProto_TYPE_MESSAGE, ".M"), true},
+	} {
+		if got := compatibleTypes(test.a, test.b); got != test.want {
+			t.Errorf("compatibleTypes(%s, %s) = %v, want %v", typeName(test.a), typeName(test.b), got, test.want)
+		}
+	}
+}
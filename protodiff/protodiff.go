@@ -0,0 +1,497 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package protodiff compares two messages field by field and reports each
+difference with the path of the field, for test assertions and for audit
+logs of configuration carried in messages:
+
+	for _, d := range protodiff.Diff(old, new, protodiff.RedactSensitive()) {
+		log.Printf("config change: %v", d)
+	}
+
+Paths name fields as they are declared in the .proto file, separated by
+dots. Elements of repeated fields follow their field's name as "[i]",
+values of map fields as "[key]", with string keys quoted, and extensions
+are named "[pkg.ext]", as in the text format:
+
+	spec.replicas
+	spec.containers[1].image
+	labels["env"]
+	[pkg.priority]
+*/
+package protodiff
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Kind says how a field differs.
+type Kind int
+
+const (
+	Added   Kind = iota + 1 // set in b only
+	Removed                 // set in a only
+	Changed                 // set in both, to different values
+)
+
+var kindNames = map[Kind]string{
+	Added:   "added",
+	Removed: "removed",
+	Changed: "changed",
+}
+
+func (k Kind) String() string {
+	if s, ok := kindNames[k]; ok {
+		return s
+	}
+	return strconv.Itoa(int(k))
+}
+
+// A FieldDelta is one difference between two messages.
+type FieldDelta struct {
+	Path string
+	Kind Kind
+
+	// The values of the field in a and in b, or nil where it is unset.
+	// Scalars are Go values, such as int32 or an enum type, and messages
+	// are proto.Messages. Unknown fields and unregistered extensions are
+	// their encoded bytes.
+	Before, After interface{}
+}
+
+// String formats d on one line, as "path: before -> after".
+func (d FieldDelta) String() string {
+	switch d.Kind {
+	case Added:
+		return fmt.Sprintf("%s: added %s", d.Path, formatValue(d.After))
+	case Removed:
+		return fmt.Sprintf("%s: removed %s", d.Path, formatValue(d.Before))
+	}
+	return fmt.Sprintf("%s: %s -> %s", d.Path, formatValue(d.Before), formatValue(d.After))
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	case []byte:
+		return strconv.Quote(string(v))
+	case proto.Message:
+		return "{" + proto.CompactTextString(v) + "}"
+	}
+	return fmt.Sprint(v)
+}
+
+// An Option changes how Diff compares messages.
+type Option func(*differ)
+
+// IgnoreUnknown leaves unrecognized fields out of the comparison, so that
+// messages decoded by binaries that know different versions of a message
+// can be compared.
+func IgnoreUnknown() Option {
+	return func(d *differ) { d.ignoreUnknown = true }
+}
+
+// RedactSensitive reports the values of sensitive fields, as proto.Redact
+// defines them, as proto.RedactedValue, and redacts the messages it
+// reports, so that the deltas can be logged. Differences in sensitive
+// fields are still reported.
+func RedactSensitive() Option {
+	return func(d *differ) { d.redact = true }
+}
+
+// unknownPath names the unrecognized fields of a message in paths.
+const unknownPath = "<unknown>"
+
+// Diff returns the differences between a and b, messages of the same type,
+// in the order the fields are declared. It returns no deltas exactly when
+// proto.Equal reports the messages equal, unless options leave fields out
+// of the comparison. A nil message is compared as an empty one. If a and b
+// have different types, Diff returns a single delta, with an empty path,
+// that replaces a with b.
+func Diff(a, b proto.Message, opts ...Option) []FieldDelta {
+	d := new(differ)
+	for _, opt := range opts {
+		opt(d)
+	}
+	if a == nil && b == nil {
+		return nil
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if a == nil {
+		va = reflect.Zero(vb.Type())
+	}
+	if b == nil {
+		vb = reflect.Zero(va.Type())
+	}
+	if va.Type() != vb.Type() || va.Kind() != reflect.Ptr || va.Type().Elem().Kind() != reflect.Struct {
+		d.add(FieldDelta{Kind: Changed, Before: d.value(va, false), After: d.value(vb, false)})
+		return d.deltas
+	}
+	d.message("", va, vb)
+	return d.deltas
+}
+
+// differ accumulates the deltas between two messages.
+type differ struct {
+	ignoreUnknown bool
+	redact        bool
+
+	deltas []FieldDelta
+}
+
+func (d *differ) add(delta FieldDelta) {
+	d.deltas = append(d.deltas, delta)
+}
+
+// join appends the field name to the path of its message.
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// message compares a and b, pointers to message structs of the same type,
+// either of which may be nil.
+func (d *differ) message(path string, a, b reflect.Value) {
+	if a.IsNil() && b.IsNil() {
+		return
+	}
+	t := a.Type().Elem()
+	sa, sb := reflect.Zero(t), reflect.Zero(t)
+	if !a.IsNil() {
+		proto.DecodeLazy(a.Interface().(proto.Message))
+		sa = a.Elem()
+	}
+	if !b.IsNil() {
+		proto.DecodeLazy(b.Interface().(proto.Message))
+		sb = b.Elem()
+	}
+
+	sprops := proto.GetProperties(t)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		if f.Tag.Get("protobuf_oneof") != "" {
+			d.oneof(path, sprops, sa.Field(i), sb.Field(i))
+			continue
+		}
+		p := sprops.Prop[i]
+		proto3 := strings.Contains(f.Tag.Get("protobuf"), ",proto3")
+		d.field(join(path, p.OrigName), sa.Field(i), sb.Field(i), p, proto3)
+	}
+
+	d.extensions(path, a, b)
+
+	if uf := sa.FieldByName("XXX_unrecognized"); uf.IsValid() && !d.ignoreUnknown {
+		d.bytes(join(path, unknownPath), uf.Bytes(), sb.FieldByName("XXX_unrecognized").Bytes(), true, false)
+	}
+}
+
+// oneof compares the oneof fields a and b, interfaces holding the wrapper
+// of the field set, if any.
+func (d *differ) oneof(path string, sprops *proto.StructProperties, a, b reflect.Value) {
+	var fa, fb *proto.Properties
+	for _, op := range sprops.OneofTypes {
+		if !a.IsNil() && a.Elem().Type() == op.Type {
+			fa = op.Prop
+		}
+		if !b.IsNil() && b.Elem().Type() == op.Type {
+			fb = op.Prop
+		}
+	}
+	switch {
+	case fa == nil && fb == nil:
+	case fa == fb:
+		d.element(join(path, fa.OrigName), a.Elem().Elem().Field(0), b.Elem().Elem().Field(0), fa)
+	default:
+		if fa != nil {
+			d.add(FieldDelta{Path: join(path, fa.OrigName), Kind: Removed, Before: d.value(a.Elem().Elem().Field(0), fa.Sensitive)})
+		}
+		if fb != nil {
+			d.add(FieldDelta{Path: join(path, fb.OrigName), Kind: Added, After: d.value(b.Elem().Elem().Field(0), fb.Sensitive)})
+		}
+	}
+}
+
+// field compares a and b, the values of the field with properties p in
+// two messages, of proto3 syntax if proto3 is set.
+func (d *differ) field(path string, a, b reflect.Value, p *proto.Properties, proto3 bool) {
+	switch a.Kind() {
+	case reflect.Ptr:
+		na, nb := a.IsNil(), b.IsNil()
+		switch {
+		case na && nb:
+		case nb:
+			d.add(FieldDelta{Path: path, Kind: Removed, Before: d.value(a, p.Sensitive)})
+		case na:
+			d.add(FieldDelta{Path: path, Kind: Added, After: d.value(b, p.Sensitive)})
+		default:
+			d.element(path, a, b, p)
+		}
+	case reflect.Slice:
+		if a.Type().Elem().Kind() == reflect.Uint8 {
+			// Proto3 bytes fields are unset when empty, others when nil.
+			d.bytes(path, a.Bytes(), b.Bytes(), proto3, p.Sensitive && d.redact)
+			return
+		}
+		for i := 0; i < a.Len() || i < b.Len(); i++ {
+			ipath := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= b.Len():
+				d.add(FieldDelta{Path: ipath, Kind: Removed, Before: d.value(a.Index(i), p.Sensitive)})
+			case i >= a.Len():
+				d.add(FieldDelta{Path: ipath, Kind: Added, After: d.value(b.Index(i), p.Sensitive)})
+			default:
+				d.element(ipath, a.Index(i), b.Index(i), p)
+			}
+		}
+	case reflect.Map:
+		keys := a.MapKeys()
+		for _, k := range b.MapKeys() {
+			if !a.MapIndex(k).IsValid() {
+				keys = append(keys, k)
+			}
+		}
+		sort.Sort(mapKeys(keys))
+		for _, k := range keys {
+			kpath := path + "[" + formatKey(k) + "]"
+			va, vb := a.MapIndex(k), b.MapIndex(k)
+			switch {
+			case !vb.IsValid():
+				d.add(FieldDelta{Path: kpath, Kind: Removed, Before: d.value(va, p.Sensitive)})
+			case !va.IsValid():
+				d.add(FieldDelta{Path: kpath, Kind: Added, After: d.value(vb, p.Sensitive)})
+			default:
+				d.element(kpath, va, vb, p)
+			}
+		}
+	default:
+		// A proto3 scalar, which is unset when zero.
+		za := a.Interface() == reflect.Zero(a.Type()).Interface()
+		zb := b.Interface() == reflect.Zero(b.Type()).Interface()
+		switch {
+		case za && zb:
+		case zb:
+			d.add(FieldDelta{Path: path, Kind: Removed, Before: d.value(a, p.Sensitive)})
+		case za:
+			d.add(FieldDelta{Path: path, Kind: Added, After: d.value(b, p.Sensitive)})
+		default:
+			d.element(path, a, b, p)
+		}
+	}
+}
+
+// element compares a and b, two values present for the field with
+// properties p: those of a singular field, elements of a repeated field
+// or values of a map field.
+func (d *differ) element(path string, a, b reflect.Value, p *proto.Properties) {
+	sensitive := p != nil && p.Sensitive
+	if a.Kind() == reflect.Ptr {
+		if a.Type().Elem().Kind() == reflect.Struct {
+			// Map values may be nil messages, which are empty ones.
+			d.message(path, a, b)
+			return
+		}
+		a, b = a.Elem(), b.Elem()
+	}
+	if a.Kind() == reflect.Slice {
+		// Bytes, for which proto.Equal tells nil from empty.
+		if !bytes.Equal(a.Bytes(), b.Bytes()) || a.IsNil() != b.IsNil() {
+			d.add(FieldDelta{Path: path, Kind: Changed, Before: d.value(a, sensitive), After: d.value(b, sensitive)})
+		}
+		return
+	}
+	if a.Interface() != b.Interface() {
+		d.add(FieldDelta{Path: path, Kind: Changed, Before: d.value(a, sensitive), After: d.value(b, sensitive)})
+	}
+}
+
+// bytes compares the bytes of a field, the unrecognized fields of a
+// message or an unregistered extension, which are unset if nil, or if
+// empty when unsetIfEmpty is set.
+func (d *differ) bytes(path string, a, b []byte, unsetIfEmpty, redact bool) {
+	var before, after interface{}
+	if a != nil && (len(a) > 0 || !unsetIfEmpty) {
+		before = a
+	}
+	if b != nil && (len(b) > 0 || !unsetIfEmpty) {
+		after = b
+	}
+	if (before == nil) == (after == nil) && bytes.Equal(a, b) {
+		return
+	}
+	if redact {
+		if before != nil {
+			before = proto.RedactedValue
+		}
+		if after != nil {
+			after = proto.RedactedValue
+		}
+	}
+	kind := Changed
+	switch {
+	case before == nil:
+		kind = Added
+	case after == nil:
+		kind = Removed
+	}
+	d.add(FieldDelta{Path: path, Kind: kind, Before: before, After: after})
+}
+
+// extensions compares the extensions of a and b, pointers to message
+// structs of the same type, either of which may be nil.
+func (d *differ) extensions(path string, a, b reflect.Value) {
+	ea, eb := extensions(a), extensions(b)
+	if len(ea) == 0 && len(eb) == 0 {
+		return
+	}
+	var fields []int
+	for f := range ea {
+		fields = append(fields, int(f))
+	}
+	for f := range eb {
+		if _, ok := ea[f]; !ok {
+			fields = append(fields, int(f))
+		}
+	}
+	sort.Ints(fields)
+	for _, f := range fields {
+		xa, xb := ea[int32(f)], eb[int32(f)]
+		desc := xa.desc
+		if desc == nil {
+			desc = xb.desc
+		}
+		epath := join(path, "["+desc.Name+"]")
+		if desc.ExtensionType == nil {
+			epath = join(path, "["+strconv.Itoa(f)+"]")
+		}
+		switch {
+		case xa.desc == nil:
+			d.add(FieldDelta{Path: epath, Kind: Added, After: d.value(reflect.ValueOf(xb.value), false)})
+		case xb.desc == nil:
+			d.add(FieldDelta{Path: epath, Kind: Removed, Before: d.value(reflect.ValueOf(xa.value), false)})
+		case desc.ExtensionType == nil:
+			d.bytes(epath, xa.value.([]byte), xb.value.([]byte), false, false)
+		default:
+			d.field(epath, reflect.ValueOf(xa.value), reflect.ValueOf(xb.value), new(proto.Properties), false)
+		}
+	}
+}
+
+type extension struct {
+	desc  *proto.ExtensionDesc
+	value interface{}
+}
+
+// extensions returns the extensions present in m, a pointer to a message
+// struct, by field number.
+func extensions(m reflect.Value) map[int32]extension {
+	exts := make(map[int32]extension)
+	if m.IsNil() {
+		return exts
+	}
+	// RangeExtensions fails, leaving exts empty, if m is not extendable.
+	proto.RangeExtensions(m.Interface().(proto.Message), func(desc *proto.ExtensionDesc, v interface{}) bool {
+		exts[desc.Field] = extension{desc, v}
+		return true
+	})
+	return exts
+}
+
+// value returns v, a present value, as a FieldDelta reports it:
+// scalars held by pointers are dereferenced, and the values of sensitive
+// fields and the sensitive fields of messages are redacted if the differ
+// redacts.
+func (d *differ) value(v reflect.Value, sensitive bool) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Kind() == reflect.Ptr && !v.IsNil() && v.Type().Elem().Kind() != reflect.Struct {
+		v = v.Elem()
+	}
+	x := v.Interface()
+	if !d.redact {
+		return x
+	}
+	if m, ok := x.(proto.Message); ok {
+		if sensitive {
+			return proto.RedactedValue
+		}
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return x
+		}
+		m = proto.Clone(m)
+		proto.Redact(m)
+		return m
+	}
+	if sensitive {
+		return proto.RedactedValue
+	}
+	return x
+}
+
+// formatKey formats a map key for a path.
+func formatKey(k reflect.Value) string {
+	if k.Kind() == reflect.String {
+		return strconv.Quote(k.String())
+	}
+	return fmt.Sprint(k.Interface())
+}
+
+// mapKeys sorts the keys of a map field: strings, integers or bools.
+type mapKeys []reflect.Value
+
+func (s mapKeys) Len() int      { return len(s) }
+func (s mapKeys) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s mapKeys) Less(i, j int) bool {
+	a, b := s[i], s[j]
+	switch a.Kind() {
+	case reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint32, reflect.Uint64:
+		return a.Uint() < b.Uint()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	}
+	return a.String() < b.String()
+}
@@ -0,0 +1,248 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package protodiff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	pblog "github.com/golang/protobuf/logpb/logpb_test_proto"
+	"github.com/golang/protobuf/proto"
+	pb3 "github.com/golang/protobuf/proto/proto3_proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+// lines formats deltas one per line.
+func lines(deltas []FieldDelta) string {
+	var s []string
+	for _, d := range deltas {
+		s = append(s, d.String())
+	}
+	return strings.Join(s, "\n")
+}
+
+func TestDiff(t *testing.T) {
+	a := &pb3.Message{
+		Name:     "a",
+		Hilarity: pb3.Message_PUNS,
+		Key:      []uint64{1, 2, 3},
+		Nested:   &pb3.Nested{Bunny: "flopsy", Cute: true},
+		Terrain: map[string]*pb3.Nested{
+			"hill":  {Bunny: "a"},
+			"field": {Bunny: "b"},
+		},
+		Children: []*pb3.Message{{Name: "c0"}, {Name: "c1"}},
+	}
+	b := &pb3.Message{
+		Name:       "b",
+		Hilarity:   pb3.Message_SLAPSTICK,
+		HeightInCm: 180,
+		Key:        []uint64{1, 5},
+		Nested:     &pb3.Nested{Bunny: "flopsy"},
+		Terrain: map[string]*pb3.Nested{
+			"hill":  {Bunny: "a"},
+			"field": {Bunny: "c"},
+			"bog":   {},
+		},
+		Submessage: &pb3.Message{Name: "sub"},
+		Children:   []*pb3.Message{{Name: "c0"}, {Name: "c1", ResultCount: 2}, {}},
+	}
+	want := strings.Join([]string{
+		`name: "a" -> "b"`,
+		`hilarity: PUNS -> SLAPSTICK`,
+		`height_in_cm: added 180`,
+		`key[1]: 2 -> 5`,
+		`key[2]: removed 3`,
+		`nested.cute: removed true`,
+		`terrain["bog"]: added {}`,
+		`terrain["field"].bunny: "b" -> "c"`,
+		`submessage: added {name:"sub" }`,
+		`children[1].result_count: added 2`,
+		`children[2]: added {}`,
+	}, "\n")
+	if got := lines(Diff(a, b)); got != want {
+		t.Errorf("Diff:\n%s\nwant:\n%s", got, want)
+	}
+
+	deltas := Diff(a, b)
+	if d := deltas[1]; d.Kind != Changed || d.Before != pb3.Message_PUNS || d.After != pb3.Message_SLAPSTICK {
+		t.Errorf("hilarity delta = %#v", d)
+	}
+	if d := deltas[8]; d.Kind != Added || d.Before != nil || !proto.Equal(d.After.(proto.Message), b.Submessage) {
+		t.Errorf("submessage delta = %#v", d)
+	}
+
+	if got := Diff(a, proto.Clone(a)); len(got) != 0 {
+		t.Errorf("Diff of a message and its clone = %v, want none", got)
+	}
+	if got := Diff(nil, nil); len(got) != 0 {
+		t.Errorf("Diff(nil, nil) = %v, want none", got)
+	}
+	if got := lines(Diff(nil, &pb3.Message{Name: "x"})); got != `name: added "x"` {
+		t.Errorf("Diff from nil = %s", got)
+	}
+	if got := lines(Diff(&pb3.Message{Name: "x"}, (*pb3.Message)(nil))); got != `name: removed "x"` {
+		t.Errorf("Diff to a nil message = %s", got)
+	}
+}
+
+func TestDiffProto2(t *testing.T) {
+	a := &pb.MyMessage{
+		Count:    proto.Int32(1),
+		Pet:      []string{"cat"},
+		Bikeshed: pb.MyMessage_RED.Enum(),
+		RepBytes: [][]byte{[]byte("x"), nil},
+	}
+	b := &pb.MyMessage{
+		Count:    proto.Int32(0),
+		Quote:    proto.String(""),
+		Pet:      []string{"cat"},
+		RepBytes: [][]byte{[]byte("x"), {}},
+		Inner:    &pb.InnerMessage{Host: proto.String("h")},
+	}
+	want := strings.Join([]string{
+		`count: 1 -> 0`,
+		`quote: added ""`,
+		`inner: added {host:"h" }`,
+		`bikeshed: removed RED`,
+		`rep_bytes[1]: "" -> ""`,
+	}, "\n")
+	if got := lines(Diff(a, b)); got != want {
+		t.Errorf("Diff:\n%s\nwant:\n%s", got, want)
+	}
+	if d := Diff(a, b)[0]; d.Before != int32(1) || d.After != int32(0) {
+		t.Errorf("count delta = %#v, want values of type int32", d)
+	}
+
+	// Bytes fields are set when not nil in proto2, and when not empty in
+	// proto3, as proto.Equal has it.
+	if got := Diff(&pb.GoTest{F_BytesOptional: nil}, &pb.GoTest{F_BytesOptional: []byte{}}); len(got) != 1 || got[0].Kind != Added {
+		t.Errorf("Diff of nil and empty proto2 bytes = %v, want added", got)
+	}
+	if got := Diff(&pb3.Message{Data: nil}, &pb3.Message{Data: []byte{}}); len(got) != 0 {
+		t.Errorf("Diff of nil and empty proto3 bytes = %v, want none", got)
+	}
+}
+
+func TestDiffExtensions(t *testing.T) {
+	a := &pb.MyMessage{Count: proto.Int32(1)}
+	b := &pb.MyMessage{Count: proto.Int32(1)}
+	if err := proto.SetExtension(a, pb.E_Ext_Number, proto.Int32(1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(a, pb.E_Greeting, []string{"hi"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(b, pb.E_Ext_Number, proto.Int32(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(b, pb.E_Ext_More, &pb.Ext{Data: proto.String("d")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.SetExtension(b, pb.E_Greeting, []string{"hi", "ho"}); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		`[testdata.Ext.more]: added {data:"d" }`,
+		`[testdata.Ext.number]: 1 -> 2`,
+		`[testdata.greeting][1]: added "ho"`,
+	}, "\n")
+	if got := lines(Diff(a, b)); got != want {
+		t.Errorf("Diff:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDiffUnknown(t *testing.T) {
+	a := &pb.InnerMessage{Host: proto.String("x")}
+	b := &pb.InnerMessage{Host: proto.String("x"), XXX_unrecognized: proto.EncodeVarint(1000<<3 | proto.WireVarint)}
+	b.XXX_unrecognized = append(b.XXX_unrecognized, 1)
+	got := Diff(a, b)
+	if len(got) != 1 || got[0].Path != "<unknown>" || got[0].Kind != Added || !reflect.DeepEqual(got[0].After, b.XXX_unrecognized) {
+		t.Errorf("Diff with unknown fields = %v", got)
+	}
+	if got := Diff(a, b, IgnoreUnknown()); len(got) != 0 {
+		t.Errorf("Diff with IgnoreUnknown = %v, want none", got)
+	}
+}
+
+func TestDiffOneof(t *testing.T) {
+	for _, test := range []struct {
+		a, b *pblog.Login
+		want string
+	}{
+		{&pblog.Login{}, &pblog.Login{Credential: &pblog.Login_KeyId{KeyId: 7}}, "key_id: added 7"},
+		{&pblog.Login{Credential: &pblog.Login_KeyId{KeyId: 7}}, &pblog.Login{Credential: &pblog.Login_KeyId{KeyId: 8}}, "key_id: 7 -> 8"},
+		{&pblog.Login{Credential: &pblog.Login_KeyId{KeyId: 0}}, &pblog.Login{}, "key_id: removed 0"},
+		{&pblog.Login{Credential: &pblog.Login_Otp{Otp: "1"}}, &pblog.Login{Credential: &pblog.Login_KeyId{KeyId: 1}}, "otp: removed \"1\"\nkey_id: added 1"},
+	} {
+		if got := lines(Diff(test.a, test.b)); got != test.want {
+			t.Errorf("Diff(%v, %v):\n%s\nwant:\n%s", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestRedactSensitive(t *testing.T) {
+	a := &pblog.Login{User: "gopher", Password: "hunter2", RecoveryCodes: []string{"a1"}}
+	b := &pblog.Login{
+		User:          "gopher",
+		Password:      "hunter3",
+		RecoveryCodes: []string{"a1", "b2"},
+		Delegate:      &pblog.Login{User: "admin", Password: "secret"},
+		Credential:    &pblog.Login_Otp{Otp: "123456"},
+	}
+	want := strings.Join([]string{
+		`password: "***" -> "***"`,
+		`delegate: added {user:"admin" password:*** }`,
+		`otp: added "***"`,
+		`recovery_codes[1]: added "***"`,
+	}, "\n")
+	got := lines(Diff(a, b, RedactSensitive()))
+	if got != want {
+		t.Errorf("Diff with RedactSensitive:\n%s\nwant:\n%s", got, want)
+	}
+	// The reported message is a redacted copy.
+	deltas := Diff(a, b, RedactSensitive())
+	if d := deltas[1].After.(*pblog.Login); d.Password != proto.RedactedValue || b.Delegate.Password != "secret" {
+		t.Errorf("delegate delta has password %q, message has %q; want %q, secret", d.Password, b.Delegate.Password, proto.RedactedValue)
+	}
+	if got := lines(Diff(a, b)); !strings.Contains(got, "hunter3") {
+		t.Errorf("Diff without RedactSensitive = %s, want the password", got)
+	}
+}
+
+func TestDiffTypes(t *testing.T) {
+	got := Diff(&pb3.Message{}, &pb3.Nested{})
+	if len(got) != 1 || got[0].Path != "" || got[0].Kind != Changed {
+		t.Errorf("Diff of different types = %v, want one change", got)
+	}
+}
@@ -0,0 +1,267 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package protofuzz holds fuzz targets for the binary, JSON and text
+parsers, for use with go-fuzz (github.com/dvyukov/go-fuzz):
+
+	go-fuzz-build -tags gofuzz -func FuzzBinary github.com/golang/protobuf/protofuzz
+	protofuzz-corpus -o workdir
+	go-fuzz -bin protofuzz-fuzz.zip -workdir workdir/Binary
+
+or, with Go 1.18 and later, with go test:
+
+	go test -fuzz FuzzBinary github.com/golang/protobuf/protofuzz
+
+Each target parses its input into a proto2 message with a group,
+extensions and a required field, a message with a oneof and a proto3
+message with maps and an Any. Besides not panicking, every message that
+parses must survive a round trip: marshaling it and parsing the result
+must give a message that marshals to the same bytes.
+*/
+package protofuzz
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/proto/proto3_proto"
+	"github.com/golang/protobuf/proto/testdata"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// messages returns an empty message of every fuzzed type.
+func messages() []proto.Message {
+	return []proto.Message{
+		new(testdata.MyMessage),
+		new(testdata.Communique),
+		new(proto3_proto.Message),
+	}
+}
+
+// A format is one of the encodings the targets parse. Its name is that of
+// its target without the Fuzz prefix.
+type format struct {
+	name      string
+	marshal   func(proto.Message) ([]byte, error)
+	unmarshal func([]byte, proto.Message) error
+}
+
+var (
+	binaryFormat = format{"Binary", proto.Marshal, proto.Unmarshal}
+	jsonFormat   = format{"JSON", marshalJSON, unmarshalJSON}
+	textFormat   = format{"Text", marshalText, unmarshalText}
+)
+
+func marshalJSON(m proto.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, m)
+	return buf.Bytes(), err
+}
+
+// unmarshalJSON rejects messages with missing required fields, as the
+// binary and text parsers do and jsonpb does not.
+func unmarshalJSON(b []byte, m proto.Message) error {
+	if err := jsonpb.Unmarshal(bytes.NewReader(b), m); err != nil {
+		return err
+	}
+	if _, err := proto.Marshal(m); err != nil {
+		if _, ok := err.(*proto.RequiredNotSetError); ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func marshalText(m proto.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := proto.MarshalText(&buf, m)
+	return buf.Bytes(), err
+}
+
+func unmarshalText(b []byte, m proto.Message) error {
+	return proto.UnmarshalText(string(b), m)
+}
+
+// CheckBinary parses data in the binary format into each fuzzed message
+// type. It reports whether data parsed as any of them, and returns an
+// error if one of them did not survive a round trip.
+func CheckBinary(data []byte) (bool, error) {
+	return check(binaryFormat, data)
+}
+
+// CheckJSON is like CheckBinary for the JSON format.
+func CheckJSON(data []byte) (bool, error) {
+	return check(jsonFormat, data)
+}
+
+// CheckText is like CheckBinary for the text format.
+func CheckText(data []byte) (bool, error) {
+	return check(textFormat, data)
+}
+
+func check(f format, data []byte) (bool, error) {
+	parsed := false
+	for _, m := range messages() {
+		if err := f.unmarshal(data, m); err != nil {
+			continue
+		}
+		parsed = true
+		if err := roundTrip(f, m); err != nil {
+			return parsed, fmt.Errorf("%s round trip of %T: %v", f.name, m, err)
+		}
+	}
+	return parsed, nil
+}
+
+// roundTrip marshals m in format f, parses the result and checks that the
+// message it gives has the same binary encoding as m. The messages are
+// compared by encoding rather than with proto.Equal, which never holds for
+// a NaN field.
+func roundTrip(f format, m proto.Message) error {
+	want, err := canonical(m)
+	if err != nil {
+		return fmt.Errorf("marshaling parsed message: %v", err)
+	}
+	if n := proto.Size(m); n != len(want) {
+		return fmt.Errorf("proto.Size is %d, encoding is %d bytes", n, len(want))
+	}
+	b, err := f.marshal(m)
+	if err != nil {
+		return fmt.Errorf("marshaling parsed message: %v", err)
+	}
+	m2 := reflect.New(reflect.TypeOf(m).Elem()).Interface().(proto.Message)
+	if err := f.unmarshal(b, m2); err != nil {
+		return fmt.Errorf("parsing %q: %v", b, err)
+	}
+	got, err := canonical(m2)
+	if err != nil {
+		return fmt.Errorf("marshaling reparsed message: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		return fmt.Errorf("round trip through %q changed the message:\nbefore: %v\nafter:  %v",
+			b, proto.CompactTextString(m), proto.CompactTextString(m2))
+	}
+	return nil
+}
+
+// canonical returns the deterministic binary encoding of m.
+func canonical(m proto.Message) ([]byte, error) {
+	var buf proto.Buffer
+	buf.SetDeterministic(true)
+	err := buf.Marshal(m)
+	return buf.Bytes(), err
+}
+
+// Corpus returns the initial corpus of each target, keyed by the name of
+// the target without its Fuzz prefix ("Binary", "JSON" or "Text"): a
+// sample message of every fuzzed type, in the target's format.
+func Corpus() map[string][][]byte {
+	corpus := make(map[string][][]byte)
+	for _, f := range []format{binaryFormat, jsonFormat, textFormat} {
+		for _, m := range samples() {
+			b, err := f.marshal(m)
+			if err != nil {
+				panic(fmt.Sprintf("protofuzz: marshaling %T: %v", m, err))
+			}
+			corpus[f.name] = append(corpus[f.name], b)
+		}
+	}
+	return corpus
+}
+
+// samples returns a populated message of every fuzzed type.
+func samples() []proto.Message {
+	mm := &testdata.MyMessage{
+		Count: proto.Int32(42),
+		Name:  proto.String("Dave"),
+		Quote: proto.String(`"I didn't want to go."`),
+		Pet:   []string{"bunny", "kitty"},
+		Inner: &testdata.InnerMessage{
+			Host:      proto.String("footrest.syd"),
+			Port:      proto.Int32(7001),
+			Connected: proto.Bool(true),
+		},
+		Others: []*testdata.OtherMessage{{
+			Key:   proto.Int64(0xdeadbeef),
+			Value: []byte{1, 65, 7, 12},
+		}},
+		RepInner:  []*testdata.InnerMessage{{Host: proto.String("a")}},
+		Bikeshed:  testdata.MyMessage_BLUE.Enum(),
+		Somegroup: &testdata.MyMessage_SomeGroup{GroupField: proto.Int32(8)},
+		RepBytes:  [][]byte{[]byte("sham"), []byte("wow")},
+		Bigfloat:  proto.Float64(1e300),
+	}
+	if err := proto.SetExtension(mm, testdata.E_Ext_Number, proto.Int32(1729)); err != nil {
+		panic(err)
+	}
+	if err := proto.SetExtension(mm, testdata.E_Greeting, []string{"hello", "world"}); err != nil {
+		panic(err)
+	}
+
+	any, err := ptypes.MarshalAny(&proto3_proto.Nested{Bunny: "Monty", Cute: true})
+	if err != nil {
+		panic(err)
+	}
+	m3 := &proto3_proto.Message{
+		Name:         "Rob",
+		Hilarity:     proto3_proto.Message_PUNS,
+		HeightInCm:   178,
+		Data:         []byte("roboto"),
+		ResultCount:  47,
+		TrueScotsman: true,
+		Score:        8.1,
+		Key:          []uint64{1, 0xffffffffffffffff},
+		ShortKey:     []int32{-1, 3},
+		Nested:       &proto3_proto.Nested{Bunny: "Fuzzy"},
+		RFunny:       []proto3_proto.Message_Humour{proto3_proto.Message_SLAPSTICK},
+		Terrain: map[string]*proto3_proto.Nested{
+			"kitchen": {Bunny: "Luna"},
+			"garden":  {Cute: true},
+		},
+		Proto2Field: &testdata.SubDefaults{N: proto.Int64(7)},
+		Anything:    any,
+		Children:    []*proto3_proto.Message{{Name: "Ken"}},
+	}
+
+	return []proto.Message{
+		mm,
+		&testdata.Communique{Union: &testdata.Communique_Name{Name: "union"}},
+		&testdata.Communique{
+			MakeMeCry: proto.Bool(true),
+			Union:     &testdata.Communique_Msg{Msg: &testdata.Strings{StringField: proto.String("s")}},
+		},
+		m3,
+	}
+}
@@ -0,0 +1,94 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package protofuzz
+
+import "testing"
+
+var checks = map[string]func([]byte) (bool, error){
+	"Binary": CheckBinary,
+	"JSON":   CheckJSON,
+	"Text":   CheckText,
+}
+
+func TestCorpus(t *testing.T) {
+	corpus := Corpus()
+	if len(corpus) != len(checks) {
+		t.Errorf("Corpus has %d targets, want %d", len(corpus), len(checks))
+	}
+	for name, check := range checks {
+		if len(corpus[name]) == 0 {
+			t.Errorf("%s: empty corpus", name)
+		}
+		for _, data := range corpus[name] {
+			parsed, err := check(data)
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+			if !parsed {
+				t.Errorf("%s: %q did not parse", name, data)
+			}
+		}
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	// Every prefix of a valid input must be rejected or round trip.
+	for name, check := range checks {
+		for _, data := range Corpus()[name] {
+			for i := range data {
+				if _, err := check(data[:i]); err != nil {
+					t.Fatalf("%s: %q: %v", name, data[:i], err)
+				}
+			}
+		}
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	// Inputs that must be rejected or round trip.
+	for _, tc := range []struct {
+		name string
+		data string
+	}{
+		{"Binary", "\x0a\xff\xff\xff\xff\x0f"},
+		{"Binary", "\x43\x44"},
+		{"Binary", "\x08\x80\x80\x80\x80\x80\x80\x80\x80\x80\x80\x01"},
+		{"JSON", `{"count": 1, "count": "x"}`},
+		{"JSON", `{"terrain": {"a": null}}`},
+		{"Text", `count: 1 inner < host: "h" `},
+		{"Text", `[testdata.greeting]: "x"`},
+	} {
+		if _, err := checks[tc.name]([]byte(tc.data)); err != nil {
+			t.Errorf("%s: %q: %v", tc.name, tc.data, err)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package protoparse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokInt
+	tokFloat
+	tokString
+	tokPunct // a single character, such as '{' or '='
+)
+
+// A position is a place in a .proto file, with zero-based line and column
+// numbers, as in the spans of SourceCodeInfo.
+type position struct {
+	line, col int
+}
+
+// A comment is a comment in a .proto file, or several line comments on
+// consecutive lines, with its delimiters removed.
+type comment struct {
+	text           string
+	start, end     position // of the first and the last line
+	startsOwnLine  bool     // no token precedes it on its first line
+	joinedWithNext bool     // only for line comments being gathered
+}
+
+// A token is a token of a .proto file.
+type token struct {
+	kind tokenKind
+	text string // as written; for strings, the unquoted, unescaped value
+	pos  position
+	end  position // just past the token
+
+	// comments are the comments between the previous token and this one.
+	comments []comment
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of file"
+	}
+	return strconv.Quote(t.text)
+}
+
+// A lexer splits a .proto file into tokens.
+type lexer struct {
+	src  string
+	off  int
+	pos  position
+	prev position // end of the previous token
+	err  error
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src}
+}
+
+// errorf records the first error, at p.
+func (l *lexer) errorf(p position, format string, args ...interface{}) {
+	if l.err == nil {
+		l.err = &posError{pos: p, msg: fmt.Sprintf(format, args...)}
+	}
+}
+
+// peekByte returns the byte at the offset i past the current one, or 0.
+func (l *lexer) peekByte(i int) byte {
+	if l.off+i < len(l.src) {
+		return l.src[l.off+i]
+	}
+	return 0
+}
+
+// advance moves past n bytes, which do not hold a newline unless n is 1.
+func (l *lexer) advance(n int) {
+	for i := 0; i < n; i++ {
+		if l.src[l.off] == '\n' {
+			l.pos.line++
+			l.pos.col = 0
+		} else {
+			l.pos.col++
+		}
+		l.off++
+	}
+}
+
+// next returns the next token, with the comments before it.
+func (l *lexer) next() token {
+	var comments []comment
+	for l.err == nil {
+		// Skip white space.
+		for l.off < len(l.src) && strings.IndexByte(" \t\r\n\f\v", l.src[l.off]) >= 0 {
+			l.advance(1)
+		}
+		if l.peekByte(0) != '/' || (l.peekByte(1) != '/' && l.peekByte(1) != '*') {
+			break
+		}
+		c := l.comment()
+		// Line comments on consecutive lines, with nothing else on them,
+		// make one comment.
+		if n := len(comments); n > 0 && comments[n-1].joinedWithNext && c.joinedWithNext &&
+			comments[n-1].startsOwnLine && c.startsOwnLine && comments[n-1].end.line+1 == c.start.line {
+			comments[n-1].text += c.text
+			comments[n-1].end = c.end
+			continue
+		}
+		comments = append(comments, c)
+	}
+	t := l.token()
+	t.comments = comments
+	l.prev = t.end
+	return t
+}
+
+// comment reads a comment.
+func (l *lexer) comment() comment {
+	c := comment{start: l.pos, startsOwnLine: l.prev.line != l.pos.line || l.prev == (position{})}
+	if l.peekByte(1) == '/' {
+		l.advance(2)
+		i := strings.IndexByte(l.src[l.off:], '\n')
+		if i < 0 {
+			i = len(l.src) - l.off
+		}
+		c.text = l.src[l.off:l.off+i] + "\n"
+		c.end = l.pos
+		l.advance(i)
+		c.joinedWithNext = true
+		return c
+	}
+	l.advance(2)
+	i := strings.Index(l.src[l.off:], "*/")
+	if i < 0 {
+		l.errorf(c.start, "unterminated comment")
+		l.off, c.end = len(l.src), l.pos
+		return c
+	}
+	text := l.src[l.off : l.off+i]
+	l.advance(i + 2)
+	c.end = l.pos
+	// As protoc does, drop the leading white space and '*' of each line
+	// after the first.
+	lines := strings.Split(text, "\n")
+	for j := 1; j < len(lines); j++ {
+		s := strings.TrimLeft(lines[j], " \t")
+		if strings.HasPrefix(s, "*") && !strings.HasPrefix(s, "*/") {
+			s = s[1:]
+		}
+		lines[j] = s
+	}
+	c.text = strings.Join(lines, "\n")
+	return c
+}
+
+// token reads the token at the current offset.
+func (l *lexer) token() token {
+	t := token{pos: l.pos}
+	if l.err != nil || l.off >= len(l.src) {
+		return token{kind: tokEOF, pos: l.pos, end: l.pos}
+	}
+	c := l.src[l.off]
+	start := l.off
+	switch {
+	case isLetter(c):
+		for l.off < len(l.src) && (isLetter(l.src[l.off]) || isDigit(l.src[l.off])) {
+			l.advance(1)
+		}
+		t.kind, t.text = tokIdent, l.src[start:l.off]
+	case isDigit(c) || c == '.' && isDigit(l.peekByte(1)):
+		t.kind = l.number()
+		t.text = l.src[start:l.off]
+	case c == '"' || c == '\'':
+		t.kind = tokString
+		t.text = l.str(c)
+	default:
+		t.kind, t.text = tokPunct, string(c)
+		l.advance(1)
+	}
+	t.end = l.pos
+	return t
+}
+
+// number reads a number, and returns whether it is an integer or a float.
+func (l *lexer) number() tokenKind {
+	kind := tokInt
+	if l.peekByte(0) == '0' && (l.peekByte(1) == 'x' || l.peekByte(1) == 'X') {
+		l.advance(2)
+		for isHex(l.peekByte(0)) {
+			l.advance(1)
+		}
+		return kind
+	}
+	for isDigit(l.peekByte(0)) {
+		l.advance(1)
+	}
+	if l.peekByte(0) == '.' {
+		kind = tokFloat
+		l.advance(1)
+		for isDigit(l.peekByte(0)) {
+			l.advance(1)
+		}
+	}
+	if c := l.peekByte(0); c == 'e' || c == 'E' {
+		kind = tokFloat
+		l.advance(1)
+		if c := l.peekByte(0); c == '+' || c == '-' {
+			l.advance(1)
+		}
+		if !isDigit(l.peekByte(0)) {
+			l.errorf(l.pos, "invalid number")
+		}
+		for isDigit(l.peekByte(0)) {
+			l.advance(1)
+		}
+	}
+	if isLetter(l.peekByte(0)) {
+		l.errorf(l.pos, "invalid number")
+	}
+	return kind
+}
+
+// str reads a string literal delimited by quote, and returns its value.
+func (l *lexer) str(quote byte) string {
+	start := l.pos
+	l.advance(1)
+	var b []byte
+	for {
+		if l.off >= len(l.src) || l.src[l.off] == '\n' {
+			l.errorf(start, "unterminated string")
+			return string(b)
+		}
+		c := l.src[l.off]
+		if c == quote {
+			l.advance(1)
+			return string(b)
+		}
+		if c != '\\' {
+			b = append(b, c)
+			l.advance(1)
+			continue
+		}
+		l.advance(1)
+		c = l.peekByte(0)
+		switch c {
+		case 'a':
+			b = append(b, '\a')
+		case 'b':
+			b = append(b, '\b')
+		case 'f':
+			b = append(b, '\f')
+		case 'n':
+			b = append(b, '\n')
+		case 'r':
+			b = append(b, '\r')
+		case 't':
+			b = append(b, '\t')
+		case 'v':
+			b = append(b, '\v')
+		case '\\', '\'', '"', '?':
+			b = append(b, c)
+		case 'x', 'X':
+			n, v := 0, 0
+			for n < 2 && isHex(l.peekByte(1+n)) {
+				v = v*16 + hexVal(l.peekByte(1+n))
+				n++
+			}
+			if n == 0 {
+				l.errorf(l.pos, "invalid escape \\%c", c)
+			}
+			b = append(b, byte(v))
+			l.advance(n)
+		case 'u', 'U':
+			digits := 4
+			if c == 'U' {
+				digits = 8
+			}
+			v := 0
+			for i := 1; i <= digits; i++ {
+				if !isHex(l.peekByte(i)) {
+					l.errorf(l.pos, "invalid escape \\%c", c)
+					return string(b)
+				}
+				v = v*16 + hexVal(l.peekByte(i))
+			}
+			var buf [utf8.UTFMax]byte
+			b = append(b, buf[:utf8.EncodeRune(buf[:], rune(v))]...)
+			l.advance(digits)
+		default:
+			if c < '0' || c > '7' {
+				l.errorf(l.pos, "invalid escape \\%c", c)
+				return string(b)
+			}
+			n, v := 0, 0
+			for n < 3 && l.peekByte(n) >= '0' && l.peekByte(n) <= '7' {
+				v = v*8 + int(l.peekByte(n)-'0')
+				n++
+			}
+			b = append(b, byte(v))
+			l.advance(n - 1)
+		}
+		l.advance(1)
+	}
+}
+
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isHex(c byte) bool    { return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F' }
+
+func hexVal(c byte) int {
+	switch {
+	case c >= 'a':
+		return int(c-'a') + 10
+	case c >= 'A':
+		return int(c-'A') + 10
+	}
+	return int(c - '0')
+}
+
+// A posError is an error at a position in a file. The file name is added
+// by the parser.
+type posError struct {
+	pos position
+	msg string
+}
+
+func (e *posError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.pos.line+1, e.pos.col+1, e.msg)
+}
@@ -0,0 +1,807 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package protoparse
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protowire"
+)
+
+type symbolKind int
+
+const (
+	symPackage symbolKind = iota
+	symMessage
+	symEnum
+	symEnumValue
+	symField
+	symOneof
+	symService
+	symMethod
+)
+
+// A symbol is a package or an element declared in a file.
+type symbol struct {
+	kind  symbolKind
+	file  string // empty for packages
+	msg   *pb.DescriptorProto
+	enum  *pb.EnumDescriptorProto
+	field *pb.FieldDescriptorProto
+}
+
+// isType reports whether s can be the type of a field.
+func (s *symbol) isType() bool {
+	return s.kind == symMessage || s.kind == symEnum
+}
+
+// A linker resolves the names used in files, in dependency order, and
+// interprets their options.
+type linker struct {
+	syms map[string]*symbol
+	deps map[string][]string // the public dependencies of each file
+}
+
+func newLinker() *linker {
+	return &linker{syms: make(map[string]*symbol), deps: make(map[string][]string)}
+}
+
+// A linkError is an error in an element of a file, located by its
+// SourceCodeInfo path.
+type linkError struct {
+	path []int32
+	msg  string
+}
+
+func (e *linkError) Error() string { return e.msg }
+
+func errorAt(path []int32, format string, args ...interface{}) error {
+	return &linkError{path: append([]int32(nil), path...), msg: fmt.Sprintf(format, args...)}
+}
+
+// declare adds the package and the elements of fd to the symbol table.
+func (l *linker) declare(fd *pb.FileDescriptorProto) error {
+	for _, i := range fd.PublicDependency {
+		l.deps[fd.GetName()] = append(l.deps[fd.GetName()], fd.Dependency[i])
+	}
+	if pkg := fd.GetPackage(); pkg != "" {
+		for i := range pkg {
+			if pkg[i] == '.' {
+				if err := l.add(pkg[:i], &symbol{kind: symPackage}, []int32{filePackageTag}); err != nil {
+					return err
+				}
+			}
+		}
+		if err := l.add(pkg, &symbol{kind: symPackage}, []int32{filePackageTag}); err != nil {
+			return err
+		}
+	}
+	name, pkg := fd.GetName(), fd.GetPackage()
+	for i, m := range fd.MessageType {
+		if err := l.declareMessage(name, pkg, m, []int32{fileMessageTag, int32(i)}); err != nil {
+			return err
+		}
+	}
+	for i, e := range fd.EnumType {
+		if err := l.declareEnum(name, pkg, e, []int32{fileEnumTag, int32(i)}); err != nil {
+			return err
+		}
+	}
+	for i, f := range fd.Extension {
+		if err := l.add(qualify(pkg, f.GetName()), &symbol{kind: symField, file: name, field: f}, []int32{fileExtensionTag, int32(i)}); err != nil {
+			return err
+		}
+	}
+	for i, s := range fd.Service {
+		path := []int32{fileServiceTag, int32(i)}
+		full := qualify(pkg, s.GetName())
+		if err := l.add(full, &symbol{kind: symService, file: name}, path); err != nil {
+			return err
+		}
+		for j, m := range s.Method {
+			if err := l.add(full+"."+m.GetName(), &symbol{kind: symMethod, file: name}, append(path, serviceMethodTag, int32(j))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (l *linker) declareMessage(file, scope string, m *pb.DescriptorProto, path []int32) error {
+	full := qualify(scope, m.GetName())
+	if err := l.add(full, &symbol{kind: symMessage, file: file, msg: m}, path); err != nil {
+		return err
+	}
+	for i, f := range m.Field {
+		if err := l.add(full+"."+f.GetName(), &symbol{kind: symField, file: file, field: f}, child(path, messageFieldTag, i)); err != nil {
+			return err
+		}
+	}
+	for i, f := range m.Extension {
+		if err := l.add(full+"."+f.GetName(), &symbol{kind: symField, file: file, field: f}, child(path, messageExtTag, i)); err != nil {
+			return err
+		}
+	}
+	for i, o := range m.OneofDecl {
+		if err := l.add(full+"."+o.GetName(), &symbol{kind: symOneof, file: file}, child(path, messageOneofTag, i)); err != nil {
+			return err
+		}
+	}
+	for i, n := range m.NestedType {
+		if err := l.declareMessage(file, full, n, child(path, messageNestedTag, i)); err != nil {
+			return err
+		}
+	}
+	for i, e := range m.EnumType {
+		if err := l.declareEnum(file, full, e, child(path, messageEnumTag, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// declareEnum adds an enum and its values, which, as in C++, are declared
+// in the scope of the enum rather than in the enum itself.
+func (l *linker) declareEnum(file, scope string, e *pb.EnumDescriptorProto, path []int32) error {
+	if err := l.add(qualify(scope, e.GetName()), &symbol{kind: symEnum, file: file, enum: e}, path); err != nil {
+		return err
+	}
+	for i, v := range e.Value {
+		if err := l.add(qualify(scope, v.GetName()), &symbol{kind: symEnumValue, file: file}, child(path, enumValueTag, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *linker) add(name string, s *symbol, path []int32) error {
+	if old, ok := l.syms[name]; ok {
+		if old.kind == symPackage && s.kind == symPackage {
+			return nil
+		}
+		if old.kind == symPackage {
+			return errorAt(path, "%s is already defined as a package", name)
+		}
+		if old.file != s.file {
+			return errorAt(path, "%s is already defined in %s", name, old.file)
+		}
+		return errorAt(path, "%s is already defined", name)
+	}
+	l.syms[name] = s
+	return nil
+}
+
+// child returns the path of the i'th element in the field tag of the
+// element at path.
+func child(path []int32, tag int32, i int) []int32 {
+	return append(append([]int32(nil), path...), tag, int32(i))
+}
+
+// visible returns the names of the files whose symbols fd can refer to:
+// fd, its dependencies, and the files they publicly import.
+func (l *linker) visible(fd *pb.FileDescriptorProto) map[string]bool {
+	vis := map[string]bool{fd.GetName(): true}
+	var add func(name string)
+	add = func(name string) {
+		if vis[name] {
+			return
+		}
+		vis[name] = true
+		for _, dep := range l.deps[name] {
+			add(dep)
+		}
+	}
+	for _, dep := range fd.Dependency {
+		add(dep)
+	}
+	return vis
+}
+
+// lookup resolves name as protoc does: relative to scope and then to each
+// scope enclosing it, unless it starts with a dot. Only types are found if
+// typ is set.
+func (l *linker) lookup(name, scope string, vis map[string]bool, typ bool) (string, *symbol) {
+	get := func(full string) *symbol {
+		s := l.syms[full]
+		if s == nil || s.kind != symPackage && !vis[s.file] {
+			return nil
+		}
+		return s
+	}
+	if strings.HasPrefix(name, ".") {
+		return name[1:], get(name[1:])
+	}
+	first := name
+	if i := strings.Index(name, "."); i >= 0 {
+		first = name[:i]
+	}
+	for {
+		if s := get(qualify(scope, first)); s != nil {
+			if first == name {
+				if !typ || s.isType() {
+					return qualify(scope, name), s
+				}
+			} else if s.kind == symPackage || s.kind == symMessage || s.kind == symEnum || s.kind == symService {
+				// The rest of the name must be found within s.
+				full := qualify(scope, name)
+				return full, get(full)
+			}
+		}
+		if scope == "" {
+			return name, nil
+		}
+		if i := strings.LastIndex(scope, "."); i >= 0 {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+}
+
+// resolve resolves the type names of fd, which has been declared along
+// with its dependencies, and fills in the JSON names of its fields.
+func (l *linker) resolve(fd *pb.FileDescriptorProto) error {
+	vis := l.visible(fd)
+	pkg := fd.GetPackage()
+	for i, m := range fd.MessageType {
+		if err := l.resolveMessage(m, qualify(pkg, m.GetName()), []int32{fileMessageTag, int32(i)}, vis); err != nil {
+			return err
+		}
+	}
+	for i, f := range fd.Extension {
+		if err := l.resolveField(f, pkg, []int32{fileExtensionTag, int32(i)}, vis); err != nil {
+			return err
+		}
+	}
+	for i, s := range fd.Service {
+		for j, m := range s.Method {
+			path := []int32{fileServiceTag, int32(i), serviceMethodTag, int32(j)}
+			for _, t := range []*string{m.InputType, m.OutputType} {
+				full, sym := l.lookup(*t, pkg, vis, true)
+				if sym == nil || sym.kind != symMessage {
+					return errorAt(path, "%s is not a message type", *t)
+				}
+				*t = "." + full
+			}
+		}
+	}
+	return nil
+}
+
+func (l *linker) resolveMessage(m *pb.DescriptorProto, full string, path []int32, vis map[string]bool) error {
+	numbers := make(map[int32]string)
+	for i, f := range m.Field {
+		fpath := child(path, messageFieldTag, i)
+		if err := l.resolveField(f, full, fpath, vis); err != nil {
+			return err
+		}
+		n := f.GetNumber()
+		if other, ok := numbers[n]; ok {
+			return errorAt(fpath, "field number %d of %s is already used by %s", n, f.GetName(), other)
+		}
+		numbers[n] = f.GetName()
+		for _, r := range m.ReservedRange {
+			if n >= r.GetStart() && n < r.GetEnd() {
+				return errorAt(fpath, "field number %d of %s is reserved", n, f.GetName())
+			}
+		}
+		for _, r := range m.ExtensionRange {
+			if n >= r.GetStart() && n < r.GetEnd() {
+				return errorAt(fpath, "field number %d of %s is in an extension range", n, f.GetName())
+			}
+		}
+		for _, name := range m.ReservedName {
+			if name == f.GetName() {
+				return errorAt(fpath, "field name %s is reserved", name)
+			}
+		}
+	}
+	for i, f := range m.Extension {
+		if err := l.resolveField(f, full, child(path, messageExtTag, i), vis); err != nil {
+			return err
+		}
+	}
+	for i, n := range m.NestedType {
+		if err := l.resolveMessage(n, full+"."+n.GetName(), child(path, messageNestedTag, i), vis); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveField resolves the type of f, and its extendee if it is an
+// extension, declared in scope.
+func (l *linker) resolveField(f *pb.FieldDescriptorProto, scope string, path []int32, vis map[string]bool) error {
+	if f.JsonName == nil {
+		f.JsonName = proto.String(jsonName(f.GetName()))
+	}
+	if f.TypeName != nil {
+		full, sym := l.lookup(f.GetTypeName(), scope, vis, true)
+		if sym == nil {
+			return errorAt(path, "%s is not defined", f.GetTypeName())
+		}
+		f.TypeName = proto.String("." + full)
+		switch {
+		case f.GetType() == pb.FieldDescriptorProto_TYPE_GROUP:
+		case sym.kind == symMessage:
+			f.Type = pb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+		default:
+			f.Type = pb.FieldDescriptorProto_TYPE_ENUM.Enum()
+		}
+		if f.DefaultValue != nil {
+			if sym.kind != symEnum {
+				return errorAt(path, "message fields cannot have default values")
+			}
+			if enumValue(sym.enum, f.GetDefaultValue()) == nil {
+				return errorAt(path, "enum %s has no value %s", full, f.GetDefaultValue())
+			}
+		}
+	}
+	if f.Extendee != nil {
+		full, sym := l.lookup(f.GetExtendee(), scope, vis, true)
+		if sym == nil || sym.kind != symMessage {
+			return errorAt(path, "%s is not a message type", f.GetExtendee())
+		}
+		f.Extendee = proto.String("." + full)
+		ok := false
+		for _, r := range sym.msg.ExtensionRange {
+			ok = ok || f.GetNumber() >= r.GetStart() && f.GetNumber() < r.GetEnd()
+		}
+		if !ok {
+			return errorAt(path, "%s does not declare %d as an extension number", full, f.GetNumber())
+		}
+	}
+	return nil
+}
+
+func enumValue(e *pb.EnumDescriptorProto, name string) *pb.EnumValueDescriptorProto {
+	for _, v := range e.Value {
+		if v.GetName() == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// jsonName returns the JSON name protoc gives a field named name.
+func jsonName(name string) string {
+	var b []byte
+	upper := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_':
+			upper = true
+		case upper && 'a' <= c && c <= 'z':
+			b = append(b, c-'a'+'A')
+			upper = false
+		default:
+			b = append(b, c)
+			upper = false
+		}
+	}
+	return string(b)
+}
+
+// camelCase returns the name protoc gives the entry type of a map field
+// named name, without its Entry suffix.
+func camelCase(name string) string {
+	var b []byte
+	upper := true
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_':
+			upper = true
+		case upper && 'a' <= c && c <= 'z':
+			b = append(b, c-'a'+'A')
+			upper = false
+		default:
+			b = append(b, c)
+			upper = false
+		}
+	}
+	return string(b)
+}
+
+// An optionValue is the value of an options message, or of a message
+// within one, built up from the options that set its fields.
+type optionValue struct {
+	name   string // full name of the message type
+	msg    *pb.DescriptorProto
+	fields []*optionField
+}
+
+// An optionField is a field of an optionValue, with either its encoding
+// or, for a message, its value.
+type optionField struct {
+	desc  *pb.FieldDescriptorProto
+	enc   []byte
+	value *optionValue
+}
+
+// interpret sets the options of the elements of p.fd from the option
+// statements p recorded, once they and the extensions they use have been
+// resolved.
+func (l *linker) interpret(p *parser) error {
+	vis := l.visible(p.fd)
+	values := make(map[proto.Message]*optionValue)
+	var targets []proto.Message
+	for _, o := range p.options {
+		v := values[o.target]
+		if v == nil {
+			s := l.syms[o.msgName]
+			if s == nil || s.kind != symMessage {
+				return &posError{pos: o.pos, msg: fmt.Sprintf("%s is not defined", o.msgName)}
+			}
+			v = &optionValue{name: o.msgName, msg: s.msg}
+			values[o.target] = v
+			targets = append(targets, o.target)
+		}
+		if err := l.setOption(v, o, vis); err != nil {
+			if pe, ok := err.(*posError); ok {
+				return pe
+			}
+			return &posError{pos: o.pos, msg: fmt.Sprintf("option %s: %v", optionName(o.name), err)}
+		}
+	}
+	for _, t := range targets {
+		if err := proto.Unmarshal(values[t].encode(nil), t); err != nil {
+			return fmt.Errorf("%s: %v", values[t].name, err)
+		}
+	}
+	for _, m := range p.toMax {
+		if m.msg.GetOptions().GetMessageSetWireFormat() {
+			m.r.End = proto.Int32(math.MaxInt32)
+		}
+	}
+	return nil
+}
+
+func optionName(name []namePart) string {
+	var parts []string
+	for _, n := range name {
+		if n.ext {
+			parts = append(parts, "("+n.name+")")
+		} else {
+			parts = append(parts, n.name)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// setOption sets the field of v that o names, or of a message within it.
+func (l *linker) setOption(v *optionValue, o *option, vis map[string]bool) error {
+	for i, part := range o.name {
+		var f *pb.FieldDescriptorProto
+		if part.ext {
+			full, s := l.lookup(part.name, o.scope, vis, false)
+			if s == nil || s.kind != symField || s.field.Extendee == nil {
+				return fmt.Errorf("%s is not an extension", part.name)
+			}
+			if s.field.GetExtendee() != "."+v.name {
+				return fmt.Errorf("%s does not extend %s", full, v.name)
+			}
+			f = s.field
+		} else if f = v.field(part.name); f == nil {
+			return fmt.Errorf("%s has no field %s", v.name, part.name)
+		}
+		if i == len(o.name)-1 {
+			return l.setField(v, f, o.val, vis, o.scope)
+		}
+		if f.GetType() != pb.FieldDescriptorProto_TYPE_MESSAGE && f.GetType() != pb.FieldDescriptorProto_TYPE_GROUP {
+			return fmt.Errorf("%s is not a message", f.GetName())
+		}
+		if f.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED {
+			return fmt.Errorf("%s is repeated", f.GetName())
+		}
+		next := v.get(f)
+		if next == nil {
+			var err error
+			if next, err = l.newValue(f); err != nil {
+				return err
+			}
+			v.fields = append(v.fields, &optionField{desc: f, value: next})
+		}
+		v = next
+	}
+	return nil
+}
+
+func (l *linker) newValue(f *pb.FieldDescriptorProto) (*optionValue, error) {
+	name := strings.TrimPrefix(f.GetTypeName(), ".")
+	s := l.syms[name]
+	if s == nil || s.kind != symMessage {
+		return nil, fmt.Errorf("%s is not defined", name)
+	}
+	return &optionValue{name: name, msg: s.msg}, nil
+}
+
+// field returns the field of v's type with the given name. The field of
+// a group may also be named by the type of the group.
+func (v *optionValue) field(name string) *pb.FieldDescriptorProto {
+	for _, f := range v.msg.Field {
+		if f.GetName() == name {
+			return f
+		}
+		if f.GetType() == pb.FieldDescriptorProto_TYPE_GROUP && strings.HasSuffix(f.GetTypeName(), "."+name) {
+			return f
+		}
+	}
+	return nil
+}
+
+// get returns the value of the message field f of v, if it is set.
+func (v *optionValue) get(f *pb.FieldDescriptorProto) *optionValue {
+	for _, of := range v.fields {
+		if of.desc == f {
+			return of.value
+		}
+	}
+	return nil
+}
+
+// setField sets f in v to val, or, for a repeated field, appends val.
+func (l *linker) setField(v *optionValue, f *pb.FieldDescriptorProto, val *value, vis map[string]bool, scope string) error {
+	repeated := f.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED
+	if !repeated {
+		for _, of := range v.fields {
+			if of.desc == f {
+				return fmt.Errorf("%s is already set", f.GetName())
+			}
+		}
+	}
+	if val.isList {
+		if !repeated {
+			return fmt.Errorf("%s is not repeated", f.GetName())
+		}
+		for _, e := range val.list {
+			if err := l.setField(v, f, e, vis, scope); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		if !val.isAgg {
+			return &posError{pos: val.pos, msg: fmt.Sprintf("%s must be set to a message in braces", f.GetName())}
+		}
+		m, err := l.newValue(f)
+		if err != nil {
+			return err
+		}
+		for _, af := range val.agg {
+			var ff *pb.FieldDescriptorProto
+			if af.ext {
+				_, s := l.lookup(af.name, scope, vis, false)
+				if s == nil || s.kind != symField || s.field.GetExtendee() != "."+m.name {
+					return &posError{pos: af.pos, msg: fmt.Sprintf("%s is not an extension of %s", af.name, m.name)}
+				}
+				ff = s.field
+			} else if ff = m.field(af.name); ff == nil {
+				return &posError{pos: af.pos, msg: fmt.Sprintf("%s has no field %s", m.name, af.name)}
+			}
+			if err := l.setField(m, ff, af.val, vis, scope); err != nil {
+				if _, ok := err.(*posError); ok {
+					return err
+				}
+				return &posError{pos: af.pos, msg: err.Error()}
+			}
+		}
+		v.fields = append(v.fields, &optionField{desc: f, value: m})
+		return nil
+	}
+	if val.isAgg {
+		return &posError{pos: val.pos, msg: fmt.Sprintf("%s is not a message", f.GetName())}
+	}
+	enc, err := l.encodeScalar(f, val)
+	if err != nil {
+		return &posError{pos: val.pos, msg: fmt.Sprintf("invalid value for %s: %v", f.GetName(), err)}
+	}
+	v.fields = append(v.fields, &optionField{desc: f, enc: enc})
+	return nil
+}
+
+// encodeScalar returns the encoding of val, with no tag, as a value of
+// the field f, which is not a message.
+func (l *linker) encodeScalar(f *pb.FieldDescriptorProto, val *value) ([]byte, error) {
+	t := f.GetType()
+	switch t {
+	case pb.FieldDescriptorProto_TYPE_STRING, pb.FieldDescriptorProto_TYPE_BYTES:
+		if val.str == nil {
+			return nil, fmt.Errorf("expected a string")
+		}
+		return protowire.AppendBytes(nil, []byte(*val.str)), nil
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		switch {
+		case val.ident == "true" && !val.neg:
+			return []byte{1}, nil
+		case val.ident == "false" && !val.neg:
+			return []byte{0}, nil
+		}
+		return nil, fmt.Errorf("expected true or false")
+	case pb.FieldDescriptorProto_TYPE_ENUM:
+		s := l.syms[strings.TrimPrefix(f.GetTypeName(), ".")]
+		if s == nil || s.kind != symEnum {
+			return nil, fmt.Errorf("%s is not defined", f.GetTypeName())
+		}
+		ev := enumValue(s.enum, val.ident)
+		if ev == nil || val.neg {
+			return nil, fmt.Errorf("expected a value of %s", strings.TrimPrefix(f.GetTypeName(), "."))
+		}
+		return protowire.AppendVarint(nil, uint64(int64(ev.GetNumber()))), nil
+	case pb.FieldDescriptorProto_TYPE_FLOAT, pb.FieldDescriptorProto_TYPE_DOUBLE:
+		var x float64
+		switch {
+		case val.ident == "inf":
+			x = math.Inf(1)
+		case val.ident == "nan":
+			x = math.NaN()
+		case val.number != "":
+			var err error
+			if x, err = strconv.ParseFloat(val.number, 64); err != nil {
+				u, uerr := strconv.ParseUint(val.number, 0, 64)
+				if uerr != nil {
+					return nil, fmt.Errorf("expected a number")
+				}
+				x = float64(u)
+			}
+		default:
+			return nil, fmt.Errorf("expected a number")
+		}
+		if val.neg {
+			x = -x
+		}
+		if t == pb.FieldDescriptorProto_TYPE_FLOAT {
+			return protowire.AppendFixed32(nil, math.Float32bits(float32(x))), nil
+		}
+		return protowire.AppendFixed64(nil, math.Float64bits(x)), nil
+	}
+	if val.number == "" || val.float {
+		return nil, fmt.Errorf("expected an integer")
+	}
+	u, err := strconv.ParseUint(val.number, 0, 64)
+	if err != nil || !fitsInt(t, u, val.neg) {
+		return nil, fmt.Errorf("integer out of range")
+	}
+	n := int64(u)
+	if val.neg {
+		n = -n
+	}
+	switch t {
+	case pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SINT64:
+		return protowire.AppendVarint(nil, protowire.EncodeZigZag(n)), nil
+	case pb.FieldDescriptorProto_TYPE_FIXED32, pb.FieldDescriptorProto_TYPE_SFIXED32:
+		return protowire.AppendFixed32(nil, uint32(n)), nil
+	case pb.FieldDescriptorProto_TYPE_FIXED64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		return protowire.AppendFixed64(nil, uint64(n)), nil
+	}
+	return protowire.AppendVarint(nil, uint64(n)), nil
+}
+
+// fitsInt reports whether u, negated if neg is set, is in the range of
+// the integer type t.
+func fitsInt(t pb.FieldDescriptorProto_Type, u uint64, neg bool) bool {
+	switch t {
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SFIXED32:
+		if neg {
+			return u <= 1<<31
+		}
+		return u <= math.MaxInt32
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		if neg {
+			return u <= 1<<63
+		}
+		return u <= math.MaxInt64
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32:
+		return !neg && u <= math.MaxUint32
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return !neg
+	}
+	return false
+}
+
+// encode appends the encoding of v to b, with its fields in order of
+// number, as protoc writes options.
+func (v *optionValue) encode(b []byte) []byte {
+	fields := append([]*optionField(nil), v.fields...)
+	sort.Stable(byNumber(fields))
+	for _, f := range fields {
+		num := protowire.Number(f.desc.GetNumber())
+		switch {
+		case f.desc.GetType() == pb.FieldDescriptorProto_TYPE_GROUP:
+			b = protowire.AppendTag(b, num, protowire.StartGroupType)
+			b = f.value.encode(b)
+			b = protowire.AppendTag(b, num, protowire.EndGroupType)
+		case f.value != nil:
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			b = protowire.AppendBytes(b, f.value.encode(nil))
+		default:
+			b = protowire.AppendTag(b, num, wireType(f.desc.GetType()))
+			b = append(b, f.enc...)
+		}
+	}
+	return b
+}
+
+func wireType(t pb.FieldDescriptorProto_Type) protowire.Type {
+	switch t {
+	case pb.FieldDescriptorProto_TYPE_STRING, pb.FieldDescriptorProto_TYPE_BYTES:
+		return protowire.BytesType
+	case pb.FieldDescriptorProto_TYPE_FIXED32, pb.FieldDescriptorProto_TYPE_SFIXED32, pb.FieldDescriptorProto_TYPE_FLOAT:
+		return protowire.Fixed32Type
+	case pb.FieldDescriptorProto_TYPE_FIXED64, pb.FieldDescriptorProto_TYPE_SFIXED64, pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return protowire.Fixed64Type
+	}
+	return protowire.VarintType
+}
+
+type byNumber []*optionField
+
+func (s byNumber) Len() int           { return len(s) }
+func (s byNumber) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byNumber) Less(i, j int) bool { return s[i].desc.GetNumber() < s[j].desc.GetNumber() }
+
+// cEscape escapes s as protoc writes the default values of bytes fields.
+func cEscape(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\n':
+			b = append(b, `\n`...)
+		case '\r':
+			b = append(b, `\r`...)
+		case '\t':
+			b = append(b, `\t`...)
+		case '"':
+			b = append(b, `\"`...)
+		case '\'':
+			b = append(b, `\'`...)
+		case '\\':
+			b = append(b, `\\`...)
+		default:
+			if c < 0x20 || c >= 0x7f {
+				b = append(b, '\\', '0'+c>>6, '0'+c>>3&7, '0'+c&7)
+			} else {
+				b = append(b, c)
+			}
+		}
+	}
+	return string(b)
+}
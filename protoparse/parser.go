@@ -0,0 +1,1039 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package protoparse
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// The field numbers of FileDescriptorProto and the other descriptors that
+// make up the paths of SourceCodeInfo locations.
+const (
+	filePackageTag         = 2
+	fileDependencyTag      = 3
+	fileMessageTag         = 4
+	fileEnumTag            = 5
+	fileServiceTag         = 6
+	fileExtensionTag       = 7
+	fileOptionsTag         = 8
+	fileSyntaxTag          = 12
+	messageFieldTag        = 2
+	messageNestedTag       = 3
+	messageEnumTag         = 4
+	messageExtRangeTag     = 5
+	messageExtTag          = 6
+	messageOptionsTag      = 7
+	messageOneofTag        = 8
+	messageReservedTag     = 9
+	messageReservedNameTag = 10
+	enumValueTag           = 2
+	enumOptionsTag         = 3
+	serviceMethodTag       = 2
+	serviceOptionsTag      = 3
+)
+
+// maxField is the largest field number.
+const maxField = 1<<29 - 1
+
+var scalarTypes = map[string]pb.FieldDescriptorProto_Type{
+	"double":   pb.FieldDescriptorProto_TYPE_DOUBLE,
+	"float":    pb.FieldDescriptorProto_TYPE_FLOAT,
+	"int64":    pb.FieldDescriptorProto_TYPE_INT64,
+	"uint64":   pb.FieldDescriptorProto_TYPE_UINT64,
+	"int32":    pb.FieldDescriptorProto_TYPE_INT32,
+	"fixed64":  pb.FieldDescriptorProto_TYPE_FIXED64,
+	"fixed32":  pb.FieldDescriptorProto_TYPE_FIXED32,
+	"bool":     pb.FieldDescriptorProto_TYPE_BOOL,
+	"string":   pb.FieldDescriptorProto_TYPE_STRING,
+	"bytes":    pb.FieldDescriptorProto_TYPE_BYTES,
+	"uint32":   pb.FieldDescriptorProto_TYPE_UINT32,
+	"sfixed32": pb.FieldDescriptorProto_TYPE_SFIXED32,
+	"sfixed64": pb.FieldDescriptorProto_TYPE_SFIXED64,
+	"sint32":   pb.FieldDescriptorProto_TYPE_SINT32,
+	"sint64":   pb.FieldDescriptorProto_TYPE_SINT64,
+}
+
+// An option is an option set in a file, to be interpreted once the types
+// it refers to are known.
+type option struct {
+	target  proto.Message // the options message of the element, such as a *pb.FieldOptions
+	msgName string        // full name of its type, such as "google.protobuf.FieldOptions"
+	scope   string        // full name of the scope of the element, for resolving names
+	name    []namePart
+	val     *value
+	pos     position
+}
+
+// A namePart is a component of the name of an option: a field name, or
+// the full name of an extension, written in parentheses.
+type namePart struct {
+	name string
+	ext  bool
+}
+
+// A value is the value of an option.
+type value struct {
+	pos    position
+	ident  string // an identifier, such as true or an enum value
+	neg    bool   // preceded by a minus sign
+	number string // an integer or a floating-point number, as written
+	float  bool   // number is a floating-point number
+	str    *string
+	agg    []aggField // fields of a message, as in text format
+	isAgg  bool
+	list   []*value // values of a repeated field within an aggregate
+	isList bool
+}
+
+// An aggField is a field of a message value.
+type aggField struct {
+	name string // field name, or extension name if ext
+	ext  bool
+	val  *value
+	pos  position
+}
+
+// parser turns the source of one .proto file into a FileDescriptorProto
+// whose type names are not yet resolved.
+type parser struct {
+	name    string
+	lex     *lexer
+	tok     token    // the current token
+	prevEnd position // end of the token before it
+	fd      *pb.FileDescriptorProto
+	proto3  bool
+	locs    []*pb.SourceCodeInfo_Location
+	options []*option
+
+	// toMax are the extension ranges that end at max, which is larger
+	// for messages with the message_set_wire_format option.
+	toMax []maxRange
+}
+
+// A maxRange is an extension range of msg that ends at max.
+type maxRange struct {
+	msg *pb.DescriptorProto
+	r   *pb.DescriptorProto_ExtensionRange
+}
+
+// qualify returns the full name of name, declared in scope.
+func qualify(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// parse parses src, the contents of the file with the given name.
+func parse(name, src string) (p *parser, err error) {
+	p = &parser{name: name, lex: newLexer(src), fd: &pb.FileDescriptorProto{Name: proto.String(name)}}
+	defer func() {
+		if e := recover(); e != nil {
+			pe, ok := e.(*posError)
+			if !ok {
+				panic(e)
+			}
+			err = p.error(pe)
+		}
+	}()
+	p.tok = p.lex.next()
+	p.checkLex()
+	p.parseFile()
+	return p, nil
+}
+
+// fail stops parsing with an error at pos.
+func (p *parser) fail(pos position, format string, args ...interface{}) {
+	panic(&posError{pos: pos, msg: fmt.Sprintf(format, args...)})
+}
+
+func (p *parser) checkLex() {
+	if err, ok := p.lex.err.(*posError); ok {
+		panic(err)
+	}
+}
+
+// next consumes the current token and returns it.
+func (p *parser) next() token {
+	t := p.tok
+	p.prevEnd = t.end
+	p.tok = p.lex.next()
+	p.checkLex()
+	return t
+}
+
+// is reports whether the current token is the identifier or punctuation s.
+func (p *parser) is(s string) bool {
+	return (p.tok.kind == tokIdent || p.tok.kind == tokPunct) && p.tok.text == s
+}
+
+// accept consumes the current token if it is s.
+func (p *parser) accept(s string) bool {
+	if p.is(s) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+// expect consumes the current token, which must be s.
+func (p *parser) expect(s string) token {
+	if !p.is(s) {
+		p.fail(p.tok.pos, "expected %q, found %v", s, p.tok)
+	}
+	return p.next()
+}
+
+// ident consumes an identifier.
+func (p *parser) ident() token {
+	if p.tok.kind != tokIdent {
+		p.fail(p.tok.pos, "expected identifier, found %v", p.tok)
+	}
+	return p.next()
+}
+
+// fullIdent consumes a dotted name, with a leading dot if dot is set.
+func (p *parser) fullIdent(dot bool) string {
+	var s string
+	if dot && p.accept(".") {
+		s = "."
+	}
+	s += p.ident().text
+	for p.accept(".") {
+		s += "." + p.ident().text
+	}
+	return s
+}
+
+// str consumes one or more adjacent string literals.
+func (p *parser) str() string {
+	if p.tok.kind != tokString {
+		p.fail(p.tok.pos, "expected string, found %v", p.tok)
+	}
+	s := p.next().text
+	for p.tok.kind == tokString {
+		s += p.next().text
+	}
+	return s
+}
+
+// integer consumes an integer, with a minus sign if neg is set, no larger
+// in magnitude than max.
+func (p *parser) integer(neg bool, max uint64) int64 {
+	pos := p.tok.pos
+	if neg && p.accept("-") {
+		if p.tok.kind != tokInt {
+			p.fail(p.tok.pos, "expected integer, found %v", p.tok)
+		}
+		v, err := strconv.ParseUint(p.next().text, 0, 64)
+		if err != nil || v > max+1 {
+			p.fail(pos, "integer out of range")
+		}
+		return -int64(v)
+	}
+	if p.tok.kind != tokInt {
+		p.fail(p.tok.pos, "expected integer, found %v", p.tok)
+	}
+	v, err := strconv.ParseUint(p.next().text, 0, 64)
+	if err != nil || v > max {
+		p.fail(pos, "integer out of range")
+	}
+	return int64(v)
+}
+
+// fieldNumber consumes a field number.
+func (p *parser) fieldNumber() int32 {
+	pos := p.tok.pos
+	n := p.integer(false, math.MaxInt32)
+	if n < 1 || n > maxField {
+		p.fail(pos, "field numbers must be between 1 and %d", maxField)
+	}
+	return int32(n)
+}
+
+// addLoc records the location of the element at path, which starts at
+// the token start and ends at the last token consumed, with the comments
+// before start.
+func (p *parser) addLoc(path []int32, start token) *pb.SourceCodeInfo_Location {
+	loc := &pb.SourceCodeInfo_Location{Path: append([]int32(nil), path...)}
+	var own []comment
+	for _, c := range start.comments {
+		if c.startsOwnLine {
+			own = append(own, c)
+		}
+	}
+	if n := len(own); n > 0 && own[n-1].end.line+1 >= start.pos.line {
+		text := own[n-1].text
+		loc.LeadingComments = &text
+		own = own[:n-1]
+	}
+	for _, c := range own {
+		loc.LeadingDetachedComments = append(loc.LeadingDetachedComments, c.text)
+	}
+	loc.Span = []int32{int32(start.pos.line), int32(start.pos.col)}
+	p.locs = append(p.locs, loc)
+	p.endLoc(loc)
+	return loc
+}
+
+// endLoc ends the span of loc at the last token consumed.
+func (p *parser) endLoc(loc *pb.SourceCodeInfo_Location) {
+	loc.Span = loc.Span[:2]
+	if line := int32(p.prevEnd.line); line != loc.Span[0] {
+		loc.Span = append(loc.Span, line)
+	}
+	loc.Span = append(loc.Span, int32(p.prevEnd.col))
+}
+
+// trailing sets the trailing comment of loc, a comment on the line of the
+// last token consumed after it.
+func (p *parser) trailing(loc *pb.SourceCodeInfo_Location) {
+	if cs := p.tok.comments; len(cs) > 0 && !cs[0].startsOwnLine && cs[0].start.line == p.prevEnd.line {
+		text := cs[0].text
+		loc.TrailingComments = &text
+	}
+}
+
+func (p *parser) parseFile() {
+	fd := p.fd
+	first := true
+	for p.tok.kind != tokEOF {
+		start := p.tok
+		switch {
+		case p.is("syntax"):
+			if !first {
+				p.fail(start.pos, "syntax must come first")
+			}
+			p.next()
+			p.expect("=")
+			pos := p.tok.pos
+			switch s := p.str(); s {
+			case "proto2":
+			case "proto3":
+				p.proto3 = true
+				fd.Syntax = proto.String(s)
+			default:
+				p.fail(pos, "unknown syntax %q", s)
+			}
+			p.expect(";")
+			p.addLoc([]int32{fileSyntaxTag}, start)
+		case p.is("package"):
+			if fd.Package != nil {
+				p.fail(start.pos, "multiple package statements")
+			}
+			p.next()
+			fd.Package = proto.String(p.fullIdent(false))
+			p.expect(";")
+			p.addLoc([]int32{filePackageTag}, start)
+		case p.is("import"):
+			p.next()
+			i := int32(len(fd.Dependency))
+			if p.accept("public") {
+				fd.PublicDependency = append(fd.PublicDependency, i)
+			} else if p.accept("weak") {
+				fd.WeakDependency = append(fd.WeakDependency, i)
+			}
+			fd.Dependency = append(fd.Dependency, p.str())
+			p.expect(";")
+			p.addLoc([]int32{fileDependencyTag, i}, start)
+		case p.is("option"):
+			if fd.Options == nil {
+				fd.Options = new(pb.FileOptions)
+			}
+			p.optionStmt(fd.Options, "google.protobuf.FileOptions", fd.GetPackage())
+		case p.is("message"):
+			path := []int32{fileMessageTag, int32(len(fd.MessageType))}
+			fd.MessageType = append(fd.MessageType, p.message(path, fd.GetPackage()))
+		case p.is("enum"):
+			path := []int32{fileEnumTag, int32(len(fd.EnumType))}
+			fd.EnumType = append(fd.EnumType, p.enum(path, fd.GetPackage()))
+		case p.is("service"):
+			path := []int32{fileServiceTag, int32(len(fd.Service))}
+			fd.Service = append(fd.Service, p.service(path, fd.GetPackage()))
+		case p.is("extend"):
+			fd.Extension = p.extend(fd.Extension, []int32{fileExtensionTag}, fd.GetPackage())
+		case p.accept(";"):
+		default:
+			p.fail(start.pos, "unexpected %v", start)
+		}
+		first = false
+	}
+}
+
+// optionStmt parses an option statement setting an option of target, an
+// options message of the type msgName, of an element in scope.
+func (p *parser) optionStmt(target proto.Message, msgName, scope string) {
+	p.expect("option")
+	p.option(target, msgName, scope)
+	p.expect(";")
+}
+
+// option parses the name and the value of an option, as in an option
+// statement or the options of a field, and records it for target.
+func (p *parser) option(target proto.Message, msgName, scope string) {
+	o := &option{target: target, msgName: msgName, scope: scope, pos: p.tok.pos}
+	o.name = p.optionName()
+	p.expect("=")
+	o.val = p.value(false)
+	p.options = append(p.options, o)
+}
+
+// optionName parses the name of an option, such as deprecated,
+// (my.ext) or (my.ext).field.
+func (p *parser) optionName() []namePart {
+	var parts []namePart
+	for {
+		if p.accept("(") {
+			parts = append(parts, namePart{name: p.fullIdent(true), ext: true})
+			p.expect(")")
+		} else {
+			parts = append(parts, namePart{name: p.ident().text})
+		}
+		if !p.accept(".") {
+			return parts
+		}
+	}
+}
+
+// value parses the value of an option: an identifier, a number, a string
+// or, in braces, a message in text format. inAgg is set within such a
+// message, where lists of values are allowed too.
+func (p *parser) value(inAgg bool) *value {
+	v := &value{pos: p.tok.pos}
+	switch {
+	case p.is("{"):
+		v.isAgg = true
+		v.agg = p.aggregate()
+	case inAgg && p.is("["):
+		p.next()
+		v.isList = true
+		for !p.accept("]") {
+			if len(v.list) > 0 {
+				p.expect(",")
+			}
+			v.list = append(v.list, p.value(true))
+		}
+	case p.tok.kind == tokString:
+		s := p.str()
+		v.str = &s
+	default:
+		v.neg = p.accept("-")
+		switch p.tok.kind {
+		case tokInt:
+			v.number = p.next().text
+		case tokFloat:
+			v.number, v.float = p.next().text, true
+		case tokIdent:
+			v.ident = p.next().text
+		default:
+			p.fail(p.tok.pos, "expected option value, found %v", p.tok)
+		}
+	}
+	return v
+}
+
+// aggregate parses a message value in text format, in braces.
+func (p *parser) aggregate() []aggField {
+	open := p.expect("{")
+	var fields []aggField
+	for !p.accept("}") {
+		if p.tok.kind == tokEOF {
+			p.fail(open.pos, "unterminated message value")
+		}
+		f := aggField{pos: p.tok.pos}
+		if p.accept("[") {
+			f.name, f.ext = p.fullIdent(false), true
+			p.expect("]")
+		} else {
+			f.name = p.ident().text
+		}
+		if !p.accept(":") && !p.is("{") {
+			p.fail(p.tok.pos, "expected \":\" or \"{\", found %v", p.tok)
+		}
+		f.val = p.value(true)
+		fields = append(fields, f)
+		if !p.accept(",") {
+			p.accept(";")
+		}
+	}
+	return fields
+}
+
+// message parses a message definition, at path, in scope.
+func (p *parser) message(path []int32, scope string) *pb.DescriptorProto {
+	start := p.expect("message")
+	name := p.ident().text
+	msg := &pb.DescriptorProto{Name: proto.String(name)}
+	p.expect("{")
+	loc := p.addLoc(path, start)
+	p.trailing(loc)
+	p.messageBody(msg, path, qualify(scope, name))
+	p.endLoc(loc)
+	return msg
+}
+
+// messageBody parses the declarations of msg up to its closing brace.
+func (p *parser) messageBody(msg *pb.DescriptorProto, path []int32, scope string) {
+	child := func(tag int32, i int) []int32 {
+		return append(append([]int32(nil), path...), tag, int32(i))
+	}
+	for !p.accept("}") {
+		start := p.tok
+		switch {
+		case start.kind == tokEOF:
+			p.fail(start.pos, "unexpected end of file in message %s", msg.GetName())
+		case p.is("message"):
+			msg.NestedType = append(msg.NestedType, p.message(child(messageNestedTag, len(msg.NestedType)), scope))
+		case p.is("enum"):
+			msg.EnumType = append(msg.EnumType, p.enum(child(messageEnumTag, len(msg.EnumType)), scope))
+		case p.is("extend"):
+			msg.Extension = p.extend(msg.Extension, append(append([]int32(nil), path...), messageExtTag), scope)
+		case p.is("option"):
+			if msg.Options == nil {
+				msg.Options = new(pb.MessageOptions)
+			}
+			p.optionStmt(msg.Options, "google.protobuf.MessageOptions", scope)
+		case p.is("oneof"):
+			p.oneof(msg, path, scope)
+		case p.is("extensions"):
+			p.extensions(msg, path)
+		case p.is("reserved"):
+			p.reserved(msg, path)
+		case p.accept(";"):
+		default:
+			p.field(msg, &msg.Field, child(messageFieldTag, len(msg.Field)), scope, nil)
+		}
+	}
+}
+
+// field parses a field of msg, or of the extend block at the end of the
+// path, if msg is nil, and adds it to fields. A field in a oneof has the
+// oneof's index.
+func (p *parser) field(msg *pb.DescriptorProto, fields *[]*pb.FieldDescriptorProto, path []int32, scope string, oneof *int32) {
+	start := p.tok
+	f := &pb.FieldDescriptorProto{OneofIndex: oneof}
+	switch {
+	case oneof != nil:
+		if p.is("required") || p.is("optional") || p.is("repeated") {
+			p.fail(start.pos, "fields in oneofs must not have labels")
+		}
+		f.Label = pb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	case p.accept("required"):
+		if p.proto3 {
+			p.fail(start.pos, "required fields are not allowed in proto3")
+		}
+		f.Label = pb.FieldDescriptorProto_LABEL_REQUIRED.Enum()
+	case p.accept("optional"):
+		if p.proto3 {
+			p.fail(start.pos, "optional fields in proto3 are not supported")
+		}
+		f.Label = pb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	case p.accept("repeated"):
+		f.Label = pb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	case p.is("map"):
+		// A map field, unless a message named map is meant.
+	case p.proto3:
+		f.Label = pb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	default:
+		p.fail(start.pos, "expected field label, found %v", p.tok)
+	}
+
+	if f.Label == nil && p.accept("map") {
+		p.mapField(msg, f, fields, path, scope, start)
+		return
+	}
+	if p.is("group") {
+		p.group(msg, f, fields, path, scope, start)
+		return
+	}
+	typ := p.fullIdent(true)
+	if t, ok := scalarTypes[typ]; ok {
+		f.Type = t.Enum()
+	} else {
+		f.TypeName = proto.String(typ)
+	}
+	f.Name = proto.String(p.ident().text)
+	p.expect("=")
+	f.Number = proto.Int32(p.fieldNumber())
+	p.fieldOptions(f, scope)
+	p.expect(";")
+	loc := p.addLoc(path, start)
+	p.trailing(loc)
+	*fields = append(*fields, f)
+}
+
+// fieldOptions parses the options of f in brackets, if it has any.
+func (p *parser) fieldOptions(f *pb.FieldDescriptorProto, scope string) {
+	if !p.accept("[") {
+		return
+	}
+	for {
+		switch {
+		case p.is("default"):
+			pos := p.next().pos
+			p.expect("=")
+			if p.proto3 {
+				p.fail(pos, "default values are not allowed in proto3")
+			}
+			if f.DefaultValue != nil {
+				p.fail(pos, "default is set twice")
+			}
+			f.DefaultValue = proto.String(p.defaultValue(f))
+		case p.is("json_name"):
+			p.next()
+			p.expect("=")
+			f.JsonName = proto.String(p.str())
+		default:
+			if f.Options == nil {
+				f.Options = new(pb.FieldOptions)
+			}
+			p.option(f.Options, "google.protobuf.FieldOptions", scope)
+		}
+		if !p.accept(",") {
+			break
+		}
+	}
+	p.expect("]")
+}
+
+// defaultValue parses the default value of f, and returns it as
+// FieldDescriptorProto.default_value holds it. The value of an enum field
+// is checked once its type is known.
+func (p *parser) defaultValue(f *pb.FieldDescriptorProto) string {
+	v := p.value(false)
+	bad := func() string {
+		p.fail(v.pos, "invalid default value for field %s", f.GetName())
+		return ""
+	}
+	if f.Type == nil {
+		// An enum, or a message, which is refused once resolved.
+		if v.ident == "" || v.neg {
+			return bad()
+		}
+		return v.ident
+	}
+	switch t := f.GetType(); t {
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		if v.str == nil {
+			return bad()
+		}
+		return *v.str
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		if v.str == nil {
+			return bad()
+		}
+		return cEscape(*v.str)
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		if v.ident != "true" && v.ident != "false" || v.neg {
+			return bad()
+		}
+		return v.ident
+	case pb.FieldDescriptorProto_TYPE_FLOAT, pb.FieldDescriptorProto_TYPE_DOUBLE:
+		var s string
+		switch {
+		case v.ident == "inf" || v.ident == "nan":
+			s = v.ident
+		case v.number != "":
+			x, err := strconv.ParseFloat(v.number, 64)
+			if err != nil {
+				if u, uerr := strconv.ParseUint(v.number, 0, 64); uerr == nil {
+					x, err = float64(u), nil
+				}
+			}
+			if err != nil {
+				return bad()
+			}
+			s = strconv.FormatFloat(x, 'g', -1, 64)
+		default:
+			return bad()
+		}
+		if v.neg {
+			s = "-" + s
+		}
+		return s
+	default:
+		if v.number == "" || v.float {
+			return bad()
+		}
+		n, err := strconv.ParseUint(v.number, 0, 64)
+		if err != nil || !fitsInt(t, n, v.neg) {
+			return bad()
+		}
+		if v.neg {
+			return "-" + strconv.FormatUint(n, 10)
+		}
+		return strconv.FormatUint(n, 10)
+	}
+}
+
+// mapField parses the rest of a map field, after the map keyword, adding
+// its entry type to msg.
+func (p *parser) mapField(msg *pb.DescriptorProto, f *pb.FieldDescriptorProto, fields *[]*pb.FieldDescriptorProto, path []int32, scope string, start token) {
+	if msg == nil {
+		p.fail(start.pos, "map fields are not allowed in extend blocks")
+	}
+	p.expect("<")
+	keyPos := p.tok.pos
+	key := p.fullIdent(true)
+	kt, ok := scalarTypes[key]
+	if !ok || kt == pb.FieldDescriptorProto_TYPE_DOUBLE || kt == pb.FieldDescriptorProto_TYPE_FLOAT || kt == pb.FieldDescriptorProto_TYPE_BYTES {
+		p.fail(keyPos, "invalid map key type %s", key)
+	}
+	p.expect(",")
+	val := p.fullIdent(true)
+	p.expect(">")
+	f.Name = proto.String(p.ident().text)
+	p.expect("=")
+	f.Number = proto.Int32(p.fieldNumber())
+	p.fieldOptions(f, scope)
+	p.expect(";")
+	loc := p.addLoc(path, start)
+	p.trailing(loc)
+
+	entryName := camelCase(f.GetName()) + "Entry"
+	entry := &pb.DescriptorProto{
+		Name: proto.String(entryName),
+		Field: []*pb.FieldDescriptorProto{
+			{Name: proto.String("key"), Number: proto.Int32(1), Label: pb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(), Type: kt.Enum(), JsonName: proto.String("key")},
+			{Name: proto.String("value"), Number: proto.Int32(2), Label: pb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(), JsonName: proto.String("value")},
+		},
+		Options: &pb.MessageOptions{MapEntry: proto.Bool(true)},
+	}
+	if t, ok := scalarTypes[val]; ok {
+		entry.Field[1].Type = t.Enum()
+	} else {
+		entry.Field[1].TypeName = proto.String(val)
+	}
+	msg.NestedType = append(msg.NestedType, entry)
+	f.Label = pb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	f.Type = pb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+	f.TypeName = proto.String(entryName)
+	*fields = append(*fields, f)
+}
+
+// group parses a group, a proto2 field whose message type is declared
+// with it, adding the type to msg or, for an extension, to the scope the
+// extend block is in.
+func (p *parser) group(msg *pb.DescriptorProto, f *pb.FieldDescriptorProto, fields *[]*pb.FieldDescriptorProto, path []int32, scope string, start token) {
+	if p.proto3 {
+		p.fail(start.pos, "groups are not allowed in proto3")
+	}
+	if msg == nil {
+		p.fail(start.pos, "groups in extend blocks are not supported")
+	}
+	p.expect("group")
+	namePos := p.tok.pos
+	name := p.ident().text
+	if name[0] < 'A' || name[0] > 'Z' {
+		p.fail(namePos, "group names must start with a capital letter")
+	}
+	f.Name = proto.String(strings.ToLower(name))
+	f.Type = pb.FieldDescriptorProto_TYPE_GROUP.Enum()
+	f.TypeName = proto.String(name)
+	p.expect("=")
+	f.Number = proto.Int32(p.fieldNumber())
+	p.fieldOptions(f, scope)
+	p.expect("{")
+	loc := p.addLoc(path, start)
+	p.trailing(loc)
+
+	n := len(msg.NestedType)
+	group := &pb.DescriptorProto{Name: proto.String(name)}
+	msg.NestedType = append(msg.NestedType, group)
+	*fields = append(*fields, f)
+	msgPath := append(append([]int32(nil), path[:len(path)-2]...), messageNestedTag, int32(n))
+	p.messageBody(group, msgPath, qualify(scope, name))
+	p.endLoc(loc)
+}
+
+// oneof parses a oneof of msg.
+func (p *parser) oneof(msg *pb.DescriptorProto, path []int32, scope string) {
+	start := p.expect("oneof")
+	index := int32(len(msg.OneofDecl))
+	n := len(msg.Field)
+	decl := &pb.OneofDescriptorProto{Name: proto.String(p.ident().text)}
+	msg.OneofDecl = append(msg.OneofDecl, decl)
+	p.expect("{")
+	loc := p.addLoc(append(append([]int32(nil), path...), messageOneofTag, index), start)
+	p.trailing(loc)
+	for !p.accept("}") {
+		switch {
+		case p.tok.kind == tokEOF:
+			p.fail(p.tok.pos, "unexpected end of file in oneof %s", decl.GetName())
+		case p.is("option"):
+			if decl.Options == nil {
+				decl.Options = new(pb.OneofOptions)
+			}
+			p.optionStmt(decl.Options, "google.protobuf.OneofOptions", scope)
+		case p.accept(";"):
+		default:
+			fpath := append(append([]int32(nil), path...), messageFieldTag, int32(len(msg.Field)))
+			p.field(msg, &msg.Field, fpath, scope, proto.Int32(index))
+		}
+	}
+	if len(msg.Field) == n {
+		p.fail(start.pos, "oneof %s has no fields", decl.GetName())
+	}
+	p.endLoc(loc)
+}
+
+// extensions parses the extension ranges of msg.
+func (p *parser) extensions(msg *pb.DescriptorProto, path []int32) {
+	start := p.expect("extensions")
+	if p.proto3 {
+		p.fail(start.pos, "extension ranges are not allowed in proto3")
+	}
+	for {
+		lo, hi, max := p.numberRange()
+		r := &pb.DescriptorProto_ExtensionRange{Start: proto.Int32(lo), End: proto.Int32(hi + 1)}
+		msg.ExtensionRange = append(msg.ExtensionRange, r)
+		if max {
+			p.toMax = append(p.toMax, maxRange{msg, r})
+		}
+		if !p.accept(",") {
+			break
+		}
+	}
+	if p.is("[") {
+		p.fail(p.tok.pos, "options of extension ranges are not supported")
+	}
+	p.expect(";")
+	p.addLoc(append(append([]int32(nil), path...), messageExtRangeTag), start)
+}
+
+// reserved parses the reserved numbers or names of msg.
+func (p *parser) reserved(msg *pb.DescriptorProto, path []int32) {
+	start := p.expect("reserved")
+	tag := int32(messageReservedTag)
+	if p.tok.kind == tokString {
+		tag = messageReservedNameTag
+		for {
+			msg.ReservedName = append(msg.ReservedName, p.str())
+			if !p.accept(",") {
+				break
+			}
+		}
+	} else {
+		for {
+			lo, hi, _ := p.numberRange()
+			msg.ReservedRange = append(msg.ReservedRange, &pb.DescriptorProto_ReservedRange{Start: proto.Int32(lo), End: proto.Int32(hi + 1)})
+			if !p.accept(",") {
+				break
+			}
+		}
+	}
+	p.expect(";")
+	p.addLoc(append(append([]int32(nil), path...), tag), start)
+}
+
+// numberRange parses a field number or a range of them, such as 5 to 10
+// or 100 to max, reporting whether it ends at max.
+func (p *parser) numberRange() (lo, hi int32, max bool) {
+	lo = p.fieldNumber()
+	if !p.accept("to") {
+		return lo, lo, false
+	}
+	if p.accept("max") {
+		return lo, maxField, true
+	}
+	pos := p.tok.pos
+	hi = p.fieldNumber()
+	if hi < lo {
+		p.fail(pos, "range end %d is before its start %d", hi, lo)
+	}
+	return lo, hi, false
+}
+
+// extend parses an extend block in scope, adding its fields to exts.
+func (p *parser) extend(exts []*pb.FieldDescriptorProto, path []int32, scope string) []*pb.FieldDescriptorProto {
+	start := p.expect("extend")
+	extendee := p.fullIdent(true)
+	p.expect("{")
+	p.addLoc(path, start)
+	n := len(exts)
+	for !p.accept("}") {
+		if p.tok.kind == tokEOF {
+			p.fail(p.tok.pos, "unexpected end of file in extend block")
+		}
+		if p.accept(";") {
+			continue
+		}
+		fpath := append(append([]int32(nil), path...), int32(len(exts)))
+		p.field(nil, &exts, fpath, scope, nil)
+		exts[len(exts)-1].Extendee = proto.String(extendee)
+	}
+	if len(exts) == n {
+		p.fail(start.pos, "extend block has no fields")
+	}
+	return exts
+}
+
+// enum parses an enum definition, at path, in scope.
+func (p *parser) enum(path []int32, scope string) *pb.EnumDescriptorProto {
+	start := p.expect("enum")
+	e := &pb.EnumDescriptorProto{Name: proto.String(p.ident().text)}
+	p.expect("{")
+	loc := p.addLoc(path, start)
+	p.trailing(loc)
+	for !p.accept("}") {
+		vstart := p.tok
+		switch {
+		case vstart.kind == tokEOF:
+			p.fail(vstart.pos, "unexpected end of file in enum %s", e.GetName())
+		case p.is("option"):
+			if e.Options == nil {
+				e.Options = new(pb.EnumOptions)
+			}
+			p.optionStmt(e.Options, "google.protobuf.EnumOptions", scope)
+		case p.is("reserved"):
+			p.fail(vstart.pos, "reserved values of enums are not supported")
+		case p.accept(";"):
+		default:
+			v := &pb.EnumValueDescriptorProto{Name: proto.String(p.ident().text)}
+			p.expect("=")
+			v.Number = proto.Int32(int32(p.integer(true, math.MaxInt32)))
+			if p.accept("[") {
+				v.Options = new(pb.EnumValueOptions)
+				for {
+					p.option(v.Options, "google.protobuf.EnumValueOptions", scope)
+					if !p.accept(",") {
+						break
+					}
+				}
+				p.expect("]")
+			}
+			p.expect(";")
+			vloc := p.addLoc(append(append([]int32(nil), path...), enumValueTag, int32(len(e.Value))), vstart)
+			p.trailing(vloc)
+			e.Value = append(e.Value, v)
+		}
+	}
+	if len(e.Value) == 0 {
+		p.fail(start.pos, "enum %s has no values", e.GetName())
+	}
+	if p.proto3 && e.Value[0].GetNumber() != 0 {
+		p.fail(start.pos, "the first value of enum %s must be zero in proto3", e.GetName())
+	}
+	p.endLoc(loc)
+	return e
+}
+
+// service parses a service definition, at path, in scope.
+func (p *parser) service(path []int32, scope string) *pb.ServiceDescriptorProto {
+	start := p.expect("service")
+	s := &pb.ServiceDescriptorProto{Name: proto.String(p.ident().text)}
+	p.expect("{")
+	loc := p.addLoc(path, start)
+	p.trailing(loc)
+	for !p.accept("}") {
+		mstart := p.tok
+		switch {
+		case mstart.kind == tokEOF:
+			p.fail(mstart.pos, "unexpected end of file in service %s", s.GetName())
+		case p.is("option"):
+			if s.Options == nil {
+				s.Options = new(pb.ServiceOptions)
+			}
+			p.optionStmt(s.Options, "google.protobuf.ServiceOptions", scope)
+		case p.accept(";"):
+		default:
+			mpath := append(append([]int32(nil), path...), serviceMethodTag, int32(len(s.Method)))
+			s.Method = append(s.Method, p.method(mpath, scope))
+		}
+	}
+	p.endLoc(loc)
+	return s
+}
+
+// method parses an rpc declaration.
+func (p *parser) method(path []int32, scope string) *pb.MethodDescriptorProto {
+	start := p.expect("rpc")
+	m := &pb.MethodDescriptorProto{Name: proto.String(p.ident().text)}
+	p.expect("(")
+	if p.is("stream") {
+		p.next()
+		if !p.is(")") {
+			m.ClientStreaming = proto.Bool(true)
+		} else {
+			// A message named stream.
+			m.InputType = proto.String("stream")
+		}
+	}
+	if m.InputType == nil {
+		m.InputType = proto.String(p.fullIdent(true))
+	}
+	p.expect(")")
+	p.expect("returns")
+	p.expect("(")
+	if p.is("stream") {
+		p.next()
+		if !p.is(")") {
+			m.ServerStreaming = proto.Bool(true)
+		} else {
+			m.OutputType = proto.String("stream")
+		}
+	}
+	if m.OutputType == nil {
+		m.OutputType = proto.String(p.fullIdent(true))
+	}
+	p.expect(")")
+	if !p.accept("{") {
+		p.expect(";")
+		loc := p.addLoc(path, start)
+		p.trailing(loc)
+		return m
+	}
+	loc := p.addLoc(path, start)
+	p.trailing(loc)
+	for !p.accept("}") {
+		switch {
+		case p.tok.kind == tokEOF:
+			p.fail(p.tok.pos, "unexpected end of file in method %s", m.GetName())
+		case p.is("option"):
+			if m.Options == nil {
+				m.Options = new(pb.MethodOptions)
+			}
+			p.optionStmt(m.Options, "google.protobuf.MethodOptions", scope)
+		case p.accept(";"):
+		default:
+			p.fail(p.tok.pos, "unexpected %v in method %s", p.tok, m.GetName())
+		}
+	}
+	p.endLoc(loc)
+	return m
+}
@@ -0,0 +1,278 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package protoparse compiles .proto files in-process into the descriptors
+// protoc gives its plugins, so that protoc-gen-go can run without a protoc
+// toolchain, as from a go:generate line:
+//
+//	p := &protoparse.Parser{ImportPaths: []string{"proto"}}
+//	req, err := p.NewRequest("plugins=carno", "foo/foo.proto")
+//
+// The request is the one protoc would send the plugin, down to the
+// comments that the generated code copies, which are recorded in its
+// SourceCodeInfo along with the positions of the declarations. Both proto2
+// and proto3 files are supported, with options, custom options and their
+// aggregate values, maps, oneofs and groups. Files that are not found in
+// the import paths are taken from the descriptors registered by the
+// generated code linked into the program, which includes
+// google/protobuf/descriptor.proto and the well-known types.
+//
+// Options of extension ranges, reserved enum values, proto3 optional
+// fields and Any values in aggregate options are not supported, nor are
+// the few checks protoc makes that do not affect code generation, such as
+// on the names of JSON fields.
+package protoparse
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+
+	// The well-known types, for the files that import them.
+	_ "github.com/golang/protobuf/ptypes/any"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/empty"
+	_ "github.com/golang/protobuf/ptypes/fieldmask"
+	_ "github.com/golang/protobuf/ptypes/struct"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	_ "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// descriptorFile is the file declaring the types of options.
+const descriptorFile = "google/protobuf/descriptor.proto"
+
+// A Parser compiles .proto files.
+type Parser struct {
+	// ImportPaths are the directories in which files are looked up, in
+	// order, by the names they are given and imported with. If it is
+	// empty, the current directory is used.
+	ImportPaths []string
+}
+
+// An Error is an error in a .proto file. Line and Col are one-based, and
+// zero if the error has no position.
+type Error struct {
+	File      string
+	Line, Col int
+	Msg       string
+}
+
+func (e *Error) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.File, e.Msg)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Col, e.Msg)
+}
+
+// ParseFiles compiles the named files and returns their descriptors,
+// preceded by those of the files they import, in dependency order, as in
+// the proto_file of a CodeGeneratorRequest.
+func (p *Parser) ParseFiles(names ...string) ([]*pb.FileDescriptorProto, error) {
+	c := &compilation{
+		parser:  p,
+		linker:  newLinker(),
+		files:   make(map[string]*pb.FileDescriptorProto),
+		loading: make(map[string]bool),
+	}
+	// The types of options are needed whether or not a file imports them.
+	if err := c.load(descriptorFile); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if err := c.load(name); err != nil {
+			return nil, err
+		}
+	}
+
+	var out []*pb.FileDescriptorProto
+	added := make(map[string]bool)
+	var add func(name string)
+	add = func(name string) {
+		if added[name] {
+			return
+		}
+		added[name] = true
+		fd := c.files[name]
+		for _, dep := range fd.Dependency {
+			add(dep)
+		}
+		out = append(out, fd)
+	}
+	for _, name := range names {
+		add(name)
+	}
+	return out, nil
+}
+
+// NewRequest compiles the named files and returns the request that protoc
+// would send a plugin to generate code for them, with the given parameter.
+func (p *Parser) NewRequest(parameter string, names ...string) (*plugin.CodeGeneratorRequest, error) {
+	files, err := p.ParseFiles(names...)
+	if err != nil {
+		return nil, err
+	}
+	req := &plugin.CodeGeneratorRequest{
+		FileToGenerate: names,
+		ProtoFile:      files,
+	}
+	if parameter != "" {
+		req.Parameter = proto.String(parameter)
+	}
+	return req, nil
+}
+
+// A compilation is the state of one call to ParseFiles.
+type compilation struct {
+	parser  *Parser
+	linker  *linker
+	files   map[string]*pb.FileDescriptorProto
+	loading map[string]bool // the files whose imports are being loaded
+}
+
+// load compiles the named file, after the files it imports, or takes its
+// descriptor from those registered, if it is not in the import paths.
+func (c *compilation) load(name string) error {
+	if c.files[name] != nil {
+		return nil
+	}
+	if c.loading[name] {
+		return &Error{File: name, Msg: "import cycle"}
+	}
+	c.loading[name] = true
+	defer delete(c.loading, name)
+
+	src, err := c.read(name)
+	if os.IsNotExist(err) {
+		fd := descriptor.Global().FindFileByPath(name)
+		if fd == nil {
+			return &Error{File: name, Msg: "file not found"}
+		}
+		fd = proto.Clone(fd).(*pb.FileDescriptorProto)
+		for _, dep := range fd.Dependency {
+			if err := c.load(dep); err != nil {
+				return err
+			}
+		}
+		if err := c.linker.declare(fd); err != nil {
+			return &Error{File: name, Msg: err.Error()}
+		}
+		c.files[name] = fd
+		return nil
+	}
+	if err != nil {
+		return &Error{File: name, Msg: err.Error()}
+	}
+
+	p, err := parse(name, src)
+	if err != nil {
+		return err
+	}
+	fd := p.fd
+	for i, dep := range fd.Dependency {
+		if err := c.load(dep); err != nil {
+			if e, ok := err.(*Error); ok && e.File == dep && e.Line == 0 {
+				// Report a missing or cyclic import where it is imported.
+				return p.errorAt([]int32{fileDependencyTag, int32(i)}, fmt.Sprintf("%s: %s", dep, e.Msg))
+			}
+			return err
+		}
+	}
+	if err := c.linker.declare(fd); err != nil {
+		return p.error(err)
+	}
+	if err := c.linker.resolve(fd); err != nil {
+		return p.error(err)
+	}
+	if err := c.linker.interpret(p); err != nil {
+		return p.error(err)
+	}
+	fd.SourceCodeInfo = &pb.SourceCodeInfo{Location: p.locs}
+	c.files[name] = fd
+	return nil
+}
+
+// read returns the contents of the named file, from the first import path
+// that has it.
+func (c *compilation) read(name string) (string, error) {
+	dirs := c.parser.ImportPaths
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+	for _, dir := range dirs {
+		b, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if os.IsNotExist(err) {
+			continue
+		}
+		return string(b), err
+	}
+	return "", os.ErrNotExist
+}
+
+// error returns err, an error in p's file, as an *Error.
+func (p *parser) error(err error) error {
+	switch e := err.(type) {
+	case *posError:
+		return &Error{File: p.name, Line: e.pos.line + 1, Col: e.pos.col + 1, Msg: e.msg}
+	case *linkError:
+		return p.errorAt(e.path, e.msg)
+	}
+	return &Error{File: p.name, Msg: err.Error()}
+}
+
+// errorAt returns an *Error at the element of p's file at path.
+func (p *parser) errorAt(path []int32, msg string) error {
+	e := &Error{File: p.name, Msg: msg}
+	for _, loc := range p.locs {
+		if equalPath(loc.Path, path) {
+			e.Line, e.Col = int(loc.Span[0])+1, int(loc.Span[1])+1
+			break
+		}
+	}
+	return e
+}
+
+func equalPath(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,384 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package protoparse
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	_ "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	"github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/proto/proto3_proto"
+	_ "github.com/golang/protobuf/proto/testdata"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	_ "github.com/golang/protobuf/protoc-gen-go/plugin"
+
+	// carno/options.proto, which the carno test files import.
+	_ "github.com/ccsnake/protobuf/protoc-gen-go/carno/options"
+)
+
+// carnoTestdata holds the .proto files of the golden tests of the carno
+// plugin, and the descriptor sets protoc compiled them into.
+const carnoTestdata = "../protoc-gen-go/carno/testdata"
+
+// TestDescriptorSets checks that the files of the carno golden tests
+// compile to the descriptors protoc gave them, and that the locations
+// recorded for them have protoc's spans and comments.
+func TestDescriptorSets(t *testing.T) {
+	sets, err := filepath.Glob(filepath.Join(carnoTestdata, "*", "descriptor_set.pb"))
+	if err != nil || len(sets) == 0 {
+		t.Fatalf("no descriptor sets in %s: %v", carnoTestdata, err)
+	}
+	for _, path := range sets {
+		dir := filepath.Base(filepath.Dir(path))
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		set := new(pb.FileDescriptorSet)
+		if err := proto.Unmarshal(b, set); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		want := make(map[string]*pb.FileDescriptorProto)
+		var names []string
+		for _, fd := range set.File {
+			if strings.HasPrefix(fd.GetName(), dir+"/") {
+				want[fd.GetName()] = fd
+				names = append(names, fd.GetName())
+			}
+		}
+
+		p := &Parser{ImportPaths: []string{carnoTestdata}}
+		files, err := p.ParseFiles(names...)
+		if err != nil {
+			t.Errorf("%s: %v", dir, err)
+			continue
+		}
+		for _, got := range files {
+			w := want[got.GetName()]
+			if w == nil {
+				continue
+			}
+			delete(want, got.GetName())
+			checkLocations(t, got, w)
+			got, w = withoutSourceInfo(got), withoutSourceInfo(w)
+			if !proto.Equal(got, w) {
+				t.Errorf("%s: got\n%s\nwant\n%s", got.GetName(), proto.MarshalTextString(got), proto.MarshalTextString(w))
+			}
+		}
+		for name := range want {
+			t.Errorf("%s: %s was not compiled", dir, name)
+		}
+	}
+}
+
+// TestRegisteredFiles checks that .proto files in the repository compile
+// to the descriptors protoc gave their generated code.
+func TestRegisteredFiles(t *testing.T) {
+	wkt := make(map[string]string)
+	for src, name := range map[string]string{
+		"../protoc-gen-go/descriptor/descriptor.proto": "google/protobuf/descriptor.proto",
+		"../protoc-gen-go/plugin/plugin.proto":         "google/protobuf/compiler/plugin.proto",
+		"../ptypes/any/any.proto":                      "google/protobuf/any.proto",
+		"../ptypes/duration/duration.proto":            "google/protobuf/duration.proto",
+		"../ptypes/empty/empty.proto":                  "google/protobuf/empty.proto",
+		"../ptypes/fieldmask/field_mask.proto":         "google/protobuf/field_mask.proto",
+		"../ptypes/struct/struct.proto":                "google/protobuf/struct.proto",
+		"../ptypes/timestamp/timestamp.proto":          "google/protobuf/timestamp.proto",
+		"../ptypes/wrappers/wrappers.proto":            "google/protobuf/wrappers.proto",
+	} {
+		b, err := ioutil.ReadFile(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wkt[name] = string(b)
+	}
+	wktDir := writeFiles(t, wkt)
+	defer os.RemoveAll(wktDir)
+
+	tests := []struct {
+		dir, name string
+	}{
+		{"../proto/testdata", "test.proto"},
+		{"../proto", "proto3_proto/proto3.proto"},
+		{"../jsonpb/jsonpb_test_proto", "test_objects.proto"},
+		{"../jsonpb/jsonpb_test_proto", "more_test_objects.proto"},
+	}
+	for name := range wkt {
+		tests = append(tests, struct{ dir, name string }{wktDir, name})
+	}
+	for _, test := range tests {
+		want := descriptor.Global().FindFileByPath(test.name)
+		if want == nil {
+			// Not linked into the test.
+			continue
+		}
+		p := &Parser{ImportPaths: []string{test.dir}}
+		files, err := p.ParseFiles(test.name)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		got := withoutSourceInfo(files[len(files)-1])
+		if !proto.Equal(got, want) {
+			t.Errorf("%s: got\n%s\nwant\n%s", test.name, proto.MarshalTextString(got), proto.MarshalTextString(want))
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		src, err string
+	}{
+		{"syntax = \"proto4\";", "a.proto:1:10: unknown syntax \"proto4\""},
+		{"message A { int32 a = 1; }", "a.proto:1:13: expected field label, found \"int32\""},
+		{"syntax = \"proto3\";\nmessage A {\n  B b = 1;\n}", "a.proto:3:3: B is not defined"},
+		{"syntax = \"proto3\";\nmessage A { int32 a = 1; string a = 2; }", "a.proto:2:26: A.a is already defined"},
+		{"syntax = \"proto3\";\nmessage A { int32 a = 1; int32 b = 1; }", "a.proto:2:26: field number 1 of b is already used by a"},
+		{"syntax = \"proto3\";\nmessage A { reserved 2 to 4; int32 a = 3; }", "a.proto:2:30: field number 3 of a is reserved"},
+		{"syntax = \"proto3\";\nmessage A { string s = 1 [default = \"x\"]; }", "a.proto:2:27: default values are not allowed in proto3"},
+		{"syntax = \"proto3\";\nmessage A { optional int32 a = 1; }", "a.proto:2:13: optional fields in proto3 are not supported"},
+		{"syntax = \"proto3\";\nenum E { A = 1; }", "a.proto:2:1: the first value of enum E must be zero in proto3"},
+		{"syntax = \"proto3\";\nimport \"b.proto\";", "a.proto:2:1: b.proto: file not found"},
+		{"syntax = \"proto3\";\nmessage A { int32 a = 1;", "a.proto:2:25: unexpected end of file in message A"},
+		{"syntax = \"proto3\";\nmessage A { int32 a = 1 [deprecated = 1]; }", "a.proto:2:39: invalid value for deprecated: expected true or false"},
+		{"syntax = \"proto3\";\nmessage A { int32 a = 1 [(b) = 1]; }", "a.proto:2:26: option (b): b is not an extension"},
+		{"syntax = \"proto3\";\nmessage A { int32 a = 1 [packed = true, packed = true]; }", "a.proto:2:41: option packed: packed is already set"},
+		{"syntax = \"proto2\";\nmessage A { extensions 10 to 20; }\nextend A { optional int32 b = 30; }", "a.proto:3:12: A does not declare 30 as an extension number"},
+		{"syntax = \"proto3\";\n/* unterminated", "a.proto:2:1: unterminated comment"},
+	}
+	for _, test := range tests {
+		dir := writeFiles(t, map[string]string{"a.proto": test.src})
+		p := &Parser{ImportPaths: []string{dir}}
+		_, err := p.ParseFiles("a.proto")
+		os.RemoveAll(dir)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("%q: got error %v, want %s", test.src, err, test.err)
+		}
+	}
+}
+
+// TestOptions checks the interpretation of custom options with message
+// values, which are set here to descriptors to decode them.
+func TestOptions(t *testing.T) {
+	src := `syntax = "proto2";
+package opts;
+import "google/protobuf/descriptor.proto";
+
+extend google.protobuf.MessageOptions {
+  optional google.protobuf.FileDescriptorProto file = 50000;
+  repeated int32 numbers = 50001;
+}
+
+message M {
+  option (file) = {
+    name: "x.proto"
+    dependency: ["a.proto", "b.proto"]
+    public_dependency: 1
+    message_type { name: "N" field { name: "f" number: 1 type: TYPE_STRING } }
+    message_type: { name: "O" }
+  };
+  option (opts.file).package = "x";
+  option (file).options.java_package = "com.example.x";
+  option (numbers) = 1;
+  option (numbers) = -2;
+}
+`
+	dir := writeFiles(t, map[string]string{"opts.proto": src})
+	defer os.RemoveAll(dir)
+	p := &Parser{ImportPaths: []string{dir}}
+	files, err := p.ParseFiles("opts.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := files[len(files)-1].MessageType[0].Options
+
+	v, err := proto.GetExtension(opts, &proto.ExtensionDesc{
+		ExtendedType:  (*pb.MessageOptions)(nil),
+		ExtensionType: (*pb.FileDescriptorProto)(nil),
+		Field:         50000,
+		Name:          "opts.file",
+		Tag:           "bytes,50000,opt,name=file",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.FileDescriptorProto{
+		Name:             proto.String("x.proto"),
+		Package:          proto.String("x"),
+		Dependency:       []string{"a.proto", "b.proto"},
+		PublicDependency: []int32{1},
+		MessageType: []*pb.DescriptorProto{
+			{Name: proto.String("N"), Field: []*pb.FieldDescriptorProto{
+				{Name: proto.String("f"), Number: proto.Int32(1), Type: pb.FieldDescriptorProto_TYPE_STRING.Enum()},
+			}},
+			{Name: proto.String("O")},
+		},
+		Options: &pb.FileOptions{JavaPackage: proto.String("com.example.x")},
+	}
+	if got := v.(*pb.FileDescriptorProto); !proto.Equal(got, want) {
+		t.Errorf("(file) = %v, want %v", got, want)
+	}
+
+	v, err = proto.GetExtension(opts, &proto.ExtensionDesc{
+		ExtendedType:  (*pb.MessageOptions)(nil),
+		ExtensionType: ([]int32)(nil),
+		Field:         50001,
+		Name:          "opts.numbers",
+		Tag:           "varint,50001,rep,name=numbers",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := v.([]int32); !reflect.DeepEqual(got, []int32{1, -2}) {
+		t.Errorf("(numbers) = %v, want [1 -2]", got)
+	}
+}
+
+// TestReservedLocations checks that reserved numbers and reserved names
+// are located under the fields of DescriptorProto that hold them.
+func TestReservedLocations(t *testing.T) {
+	src := "syntax = \"proto3\";\nmessage M {\n  reserved 2;\n  reserved \"x\";\n}\n"
+	dir := writeFiles(t, map[string]string{"r.proto": src})
+	defer os.RemoveAll(dir)
+	p := &Parser{ImportPaths: []string{dir}}
+	files, err := p.ParseFiles("r.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := make(map[int32]string) // first line to path
+	for _, loc := range files[0].GetSourceCodeInfo().GetLocation() {
+		lines[loc.Span[0]] = fmt.Sprint(loc.Path)
+	}
+	if got, want := lines[2], "[4 0 9]"; got != want {
+		t.Errorf("reserved 2 is at %s, want %s", got, want)
+	}
+	if got, want := lines[3], "[4 0 10]"; got != want {
+		t.Errorf("reserved \"x\" is at %s, want %s", got, want)
+	}
+}
+
+// TestNewRequest checks that the files to generate are compiled after the
+// registered files they import.
+func TestNewRequest(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a/a.proto": "syntax = \"proto3\";\npackage a;\nimport \"google/protobuf/timestamp.proto\";\nmessage A { google.protobuf.Timestamp t = 1; }\n",
+		"b/b.proto": "syntax = \"proto3\";\npackage b;\nimport public \"a/a.proto\";\nmessage B { a.A a = 1; }\n",
+		"c/c.proto": "syntax = \"proto3\";\npackage c;\nimport \"b/b.proto\";\nmessage C { repeated a.A a = 1; map<string, b.B> b = 2; }\n",
+	})
+	defer os.RemoveAll(dir)
+	p := &Parser{ImportPaths: []string{dir}}
+	req, err := p.NewRequest("plugins=carno", "c/c.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := req.GetParameter(); got != "plugins=carno" {
+		t.Errorf("parameter = %q, want plugins=carno", got)
+	}
+	if !reflect.DeepEqual(req.FileToGenerate, []string{"c/c.proto"}) {
+		t.Errorf("files to generate = %v, want [c/c.proto]", req.FileToGenerate)
+	}
+	var names []string
+	for _, fd := range req.ProtoFile {
+		names = append(names, fd.GetName())
+	}
+	if want := []string{"google/protobuf/timestamp.proto", "a/a.proto", "b/b.proto", "c/c.proto"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("files = %v, want %v", names, want)
+	}
+	c := req.ProtoFile[3].MessageType[0]
+	if got := c.Field[0].GetTypeName(); got != ".a.A" {
+		t.Errorf("type of C.a = %s, want .a.A", got)
+	}
+	if got := c.Field[1].GetTypeName(); got != ".c.C.BEntry" {
+		t.Errorf("type of C.b = %s, want .c.C.BEntry", got)
+	}
+	if got := c.NestedType[0].Field[1].GetTypeName(); got != ".b.B" {
+		t.Errorf("type of C.BEntry.value = %s, want .b.B", got)
+	}
+}
+
+func withoutSourceInfo(fd *pb.FileDescriptorProto) *pb.FileDescriptorProto {
+	fd = proto.Clone(fd).(*pb.FileDescriptorProto)
+	fd.SourceCodeInfo = nil
+	return fd
+}
+
+// checkLocations checks the locations of got against those protoc gave
+// the same paths in want.
+func checkLocations(t *testing.T, got, want *pb.FileDescriptorProto) {
+	locs := make(map[string]*pb.SourceCodeInfo_Location)
+	for _, loc := range want.GetSourceCodeInfo().GetLocation() {
+		locs[pathKey(loc.Path)] = loc
+	}
+	if len(got.GetSourceCodeInfo().GetLocation()) == 0 {
+		t.Errorf("%s: no locations", got.GetName())
+	}
+	for _, loc := range got.GetSourceCodeInfo().GetLocation() {
+		w := locs[pathKey(loc.Path)]
+		if w == nil {
+			t.Errorf("%s: location %v is not in protoc's", got.GetName(), loc.Path)
+			continue
+		}
+		if !proto.Equal(loc, w) {
+			t.Errorf("%s: location %v:\ngot  %v\nwant %v", got.GetName(), loc.Path, loc, w)
+		}
+	}
+}
+
+func pathKey(path []int32) string {
+	return fmt.Sprint(path)
+}
+
+// writeFiles writes files to a new directory, for ParseFiles, and returns
+// the directory.
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "protoparse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
@@ -0,0 +1,380 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package protowire reads and writes the protocol buffer wire format one
+field at a time, without unmarshaling whole messages. It lets filters,
+field-level routers and partial readers work on encoded messages
+directly: a middleware can find the one field it routes on and pass the
+message along untouched.
+
+The Consume functions take the start of a buffer and return the value
+there with the number of bytes it took. A negative length reports an
+error, which ParseError turns into an error value:
+
+	// Find the routing key, field 3 of the request, a string.
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if num == 3 && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			return route(string(v))
+		}
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+
+Range does the same loop with a callback. Values returned are slices of
+the input, not copies. The Append functions encode fields, so that a
+filter can rebuild a message from the fields it keeps.
+*/
+package protowire
+
+import (
+	"errors"
+	"io"
+	"math"
+)
+
+// Number is a field number.
+type Number int32
+
+const (
+	MinValidNumber Number = 1
+	MaxValidNumber Number = 1<<29 - 1
+)
+
+// Type is a wire type.
+type Type int8
+
+const (
+	VarintType     Type = 0
+	Fixed64Type    Type = 1
+	BytesType      Type = 2
+	StartGroupType Type = 3
+	EndGroupType   Type = 4
+	Fixed32Type    Type = 5
+)
+
+// The error codes the Consume functions return as negative lengths.
+const (
+	_ = -iota
+	errCodeTruncated
+	errCodeFieldNumber
+	errCodeOverflow
+	errCodeWireType
+	errCodeEndGroup
+	errCodeRecursionDepth
+)
+
+// maxDepth limits the nesting of groups, so that hostile input cannot
+// exhaust the stack.
+const maxDepth = 10000
+
+// ErrTooDeep is the error for groups nested more than 10000 deep, which the
+// Consume functions do not parse, so that hostile input cannot exhaust the
+// stack.
+var ErrTooDeep = errors.New("protowire: exceeded maximum group nesting")
+
+var (
+	errFieldNumber = errors.New("protowire: invalid field number")
+	errOverflow    = errors.New("protowire: variable length integer overflow")
+	errWireType    = errors.New("protowire: invalid wire type")
+	errEndGroup    = errors.New("protowire: mismatching end group marker")
+	errParse       = errors.New("protowire: parse error")
+)
+
+// ParseError returns the error for n, a negative length returned by one
+// of the Consume functions. Truncated input is io.ErrUnexpectedEOF.
+func ParseError(n int) error {
+	if n >= 0 {
+		return nil
+	}
+	switch n {
+	case errCodeTruncated:
+		return io.ErrUnexpectedEOF
+	case errCodeFieldNumber:
+		return errFieldNumber
+	case errCodeOverflow:
+		return errOverflow
+	case errCodeWireType:
+		return errWireType
+	case errCodeEndGroup:
+		return errEndGroup
+	case errCodeRecursionDepth:
+		return ErrTooDeep
+	}
+	return errParse
+}
+
+// ConsumeVarint parses the varint at the start of b.
+func ConsumeVarint(b []byte) (v uint64, n int) {
+	for shift := uint(0); shift < 64; shift += 7 {
+		if n >= len(b) {
+			return 0, errCodeTruncated
+		}
+		c := b[n]
+		n++
+		if shift == 63 && c > 1 {
+			return 0, errCodeOverflow
+		}
+		v |= uint64(c&0x7f) << shift
+		if c < 0x80 {
+			return v, n
+		}
+	}
+	return 0, errCodeOverflow
+}
+
+// ConsumeTag parses the field number and wire type of the tag at the
+// start of b.
+func ConsumeTag(b []byte) (Number, Type, int) {
+	v, n := ConsumeVarint(b)
+	if n < 0 {
+		return 0, 0, n
+	}
+	num, typ := DecodeTag(v)
+	if num < MinValidNumber || v>>3 > uint64(MaxValidNumber) {
+		return 0, 0, errCodeFieldNumber
+	}
+	return num, typ, n
+}
+
+// ConsumeFixed32 parses the little-endian 32-bit value at the start of b.
+func ConsumeFixed32(b []byte) (v uint32, n int) {
+	if len(b) < 4 {
+		return 0, errCodeTruncated
+	}
+	v = uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+	return v, 4
+}
+
+// ConsumeFixed64 parses the little-endian 64-bit value at the start of b.
+func ConsumeFixed64(b []byte) (v uint64, n int) {
+	if len(b) < 8 {
+		return 0, errCodeTruncated
+	}
+	v = uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 |
+		uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56
+	return v, 8
+}
+
+// ConsumeBytes parses the length-delimited value at the start of b. The
+// value is a slice of b.
+func ConsumeBytes(b []byte) (v []byte, n int) {
+	m, n := ConsumeVarint(b)
+	if n < 0 {
+		return nil, n
+	}
+	if m > uint64(len(b)-n) {
+		return nil, errCodeTruncated
+	}
+	return b[n : n+int(m)], n + int(m)
+}
+
+// ConsumeGroup parses the fields of group num at the start of b, which
+// follows the group's start tag. The value is the fields without the end
+// tag, and n counts the end tag too.
+func ConsumeGroup(num Number, b []byte) (v []byte, n int) {
+	return consumeGroup(num, b, 0)
+}
+
+func consumeGroup(num Number, b []byte, depth int) (v []byte, n int) {
+	if depth >= maxDepth {
+		return nil, errCodeRecursionDepth
+	}
+	for n < len(b) {
+		num2, typ, m := ConsumeTag(b[n:])
+		if m < 0 {
+			return nil, m
+		}
+		if typ == EndGroupType {
+			if num2 != num {
+				return nil, errCodeEndGroup
+			}
+			return b[:n], n + m
+		}
+		k := consumeFieldValue(num2, typ, b[n+m:], depth+1)
+		if k < 0 {
+			return nil, k
+		}
+		n += m + k
+	}
+	return nil, errCodeTruncated
+}
+
+// ConsumeFieldValue returns the length of the value of field num, of
+// wire type typ, at the start of b, which follows the field's tag.
+func ConsumeFieldValue(num Number, typ Type, b []byte) int {
+	return consumeFieldValue(num, typ, b, 0)
+}
+
+func consumeFieldValue(num Number, typ Type, b []byte, depth int) (n int) {
+	switch typ {
+	case VarintType:
+		_, n = ConsumeVarint(b)
+	case Fixed32Type:
+		_, n = ConsumeFixed32(b)
+	case Fixed64Type:
+		_, n = ConsumeFixed64(b)
+	case BytesType:
+		_, n = ConsumeBytes(b)
+	case StartGroupType:
+		_, n = consumeGroup(num, b, depth)
+	case EndGroupType:
+		n = errCodeEndGroup
+	default:
+		n = errCodeWireType
+	}
+	return n
+}
+
+// ConsumeField parses the tag and value of the field at the start of b,
+// and returns the length of both.
+func ConsumeField(b []byte) (Number, Type, int) {
+	num, typ, n := ConsumeTag(b)
+	if n < 0 {
+		return 0, 0, n
+	}
+	m := ConsumeFieldValue(num, typ, b[n:])
+	if m < 0 {
+		return 0, 0, m
+	}
+	return num, typ, n + m
+}
+
+// Range calls f with each field of the encoded message b, in order, until
+// f returns false. The value is the field's encoded varint or fixed-size
+// bytes, the contents of a length-delimited field, or the fields of a
+// group, as a slice of b.
+func Range(b []byte, f func(num Number, typ Type, value []byte) bool) error {
+	for len(b) > 0 {
+		num, typ, n := ConsumeTag(b)
+		if n < 0 {
+			return ParseError(n)
+		}
+		b = b[n:]
+		var v []byte
+		switch typ {
+		case BytesType:
+			v, n = ConsumeBytes(b)
+		case StartGroupType:
+			v, n = ConsumeGroup(num, b)
+		default:
+			n = ConsumeFieldValue(num, typ, b)
+			if n >= 0 {
+				v = b[:n]
+			}
+		}
+		if n < 0 {
+			return ParseError(n)
+		}
+		b = b[n:]
+		if !f(num, typ, v) {
+			break
+		}
+	}
+	return nil
+}
+
+// EncodeTag returns the tag of field num with wire type typ.
+func EncodeTag(num Number, typ Type) uint64 {
+	return uint64(num)<<3 | uint64(typ&7)
+}
+
+// DecodeTag splits a tag into its field number and wire type.
+func DecodeTag(x uint64) (Number, Type) {
+	// Field numbers too large for a Number come out as zero, which is
+	// not valid either.
+	if x>>3 > uint64(math.MaxInt32) {
+		return 0, Type(x & 7)
+	}
+	return Number(x >> 3), Type(x & 7)
+}
+
+// EncodeZigZag encodes a signed integer as sint64 fields do.
+func EncodeZigZag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
+
+// DecodeZigZag decodes a sint64 or sint32 field's varint.
+func DecodeZigZag(x uint64) int64 {
+	return int64(x>>1) ^ int64(x)<<63>>63
+}
+
+// SizeVarint returns the encoded length of the varint v.
+func SizeVarint(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+
+// AppendVarint appends the varint v to b.
+func AppendVarint(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+// AppendTag appends the tag of field num with wire type typ to b.
+func AppendTag(b []byte, num Number, typ Type) []byte {
+	return AppendVarint(b, EncodeTag(num, typ))
+}
+
+// AppendFixed32 appends v to b in little-endian order.
+func AppendFixed32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+// AppendFixed64 appends v to b in little-endian order.
+func AppendFixed64(b []byte, v uint64) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24),
+		byte(v>>32), byte(v>>40), byte(v>>48), byte(v>>56))
+}
+
+// AppendBytes appends v to b, preceded by its length.
+func AppendBytes(b []byte, v []byte) []byte {
+	return append(AppendVarint(b, uint64(len(v))), v...)
+}
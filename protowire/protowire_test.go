@@ -0,0 +1,244 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package protowire
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestConsumeVarint(t *testing.T) {
+	for _, test := range []struct {
+		v uint64
+		b []byte
+	}{
+		{0, []byte{0}},
+		{1, []byte{1}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{300, []byte{0xac, 0x02}},
+		{1<<32 - 1, []byte{0xff, 0xff, 0xff, 0xff, 0x0f}},
+		{1 << 63, []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x01}},
+		{math.MaxUint64, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	} {
+		v := test.v
+		b := AppendVarint(nil, v)
+		if !bytes.Equal(b, test.b) {
+			t.Errorf("AppendVarint(%d) = %x, want %x", v, b, test.b)
+		}
+		if len(b) != SizeVarint(v) {
+			t.Errorf("SizeVarint(%d) = %d, want %d", v, SizeVarint(v), len(b))
+		}
+		got, n := ConsumeVarint(b)
+		if got != v || n != len(b) {
+			t.Errorf("ConsumeVarint(%x) = %d, %d; want %d, %d", b, got, n, v, len(b))
+		}
+		if _, n := ConsumeVarint(b[:len(b)-1]); ParseError(n) != io.ErrUnexpectedEOF {
+			t.Errorf("ConsumeVarint(%x) = %v, want %v", b[:len(b)-1], ParseError(n), io.ErrUnexpectedEOF)
+		}
+	}
+	overflow := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02}
+	if _, n := ConsumeVarint(overflow); ParseError(n) != errOverflow {
+		t.Errorf("ConsumeVarint(%x) = %v, want %v", overflow, ParseError(n), errOverflow)
+	}
+}
+
+func TestZigZag(t *testing.T) {
+	for _, v := range []int64{0, 1, -1, 2, -2, math.MaxInt64, math.MinInt64} {
+		if got := DecodeZigZag(EncodeZigZag(v)); got != v {
+			t.Errorf("DecodeZigZag(EncodeZigZag(%d)) = %d", v, got)
+		}
+	}
+	if got := EncodeZigZag(-1); got != 1 {
+		t.Errorf("EncodeZigZag(-1) = %d, want 1", got)
+	}
+}
+
+func TestConsumeTag(t *testing.T) {
+	b := AppendTag(nil, MaxValidNumber, Fixed32Type)
+	num, typ, n := ConsumeTag(b)
+	if num != MaxValidNumber || typ != Fixed32Type || n != len(b) {
+		t.Errorf("ConsumeTag(%x) = %d, %d, %d", b, num, typ, n)
+	}
+	for _, v := range []uint64{0, uint64(MaxValidNumber+1) << 3, math.MaxUint64} {
+		b := AppendVarint(nil, v)
+		if _, _, n := ConsumeTag(b); ParseError(n) != errFieldNumber {
+			t.Errorf("ConsumeTag(%x) = %v, want %v", b, ParseError(n), errFieldNumber)
+		}
+	}
+}
+
+func TestConsumeField(t *testing.T) {
+	var b []byte
+	b = AppendTag(b, 1, VarintType)
+	b = AppendVarint(b, 150)
+	b = AppendTag(b, 2, Fixed32Type)
+	b = AppendFixed32(b, 0xdeadbeef)
+	b = AppendTag(b, 3, Fixed64Type)
+	b = AppendFixed64(b, math.Float64bits(1.5))
+	b = AppendTag(b, 4, BytesType)
+	b = AppendBytes(b, []byte("hello"))
+	b = AppendTag(b, 5, StartGroupType)
+	b = AppendTag(b, 1, VarintType)
+	b = AppendVarint(b, 7)
+	b = AppendTag(b, 5, EndGroupType)
+
+	var nums []Number
+	ends := make(map[int]bool)
+	for off := 0; off < len(b); {
+		num, _, n := ConsumeField(b[off:])
+		if n < 0 {
+			t.Fatal(ParseError(n))
+		}
+		nums = append(nums, num)
+		off += n
+		ends[off] = true
+	}
+	if len(nums) != 5 {
+		t.Errorf("ConsumeField found fields %v, want 1 through 5", nums)
+	}
+
+	// Every proper prefix ends in the middle of a field.
+	for i := 1; i < len(b); i++ {
+		rest := b[:i]
+		for len(rest) > 0 {
+			_, _, n := ConsumeField(rest)
+			if n < 0 {
+				break
+			}
+			rest = rest[n:]
+		}
+		if len(rest) == 0 && !ends[i] {
+			t.Errorf("fields of %x parsed without error", b[:i])
+		}
+	}
+}
+
+func TestConsumeGroupErrors(t *testing.T) {
+	var b []byte
+	b = AppendTag(b, 1, VarintType)
+	b = AppendVarint(b, 1)
+	b = AppendTag(b, 6, EndGroupType)
+	if _, n := ConsumeGroup(5, b); ParseError(n) != errEndGroup {
+		t.Errorf("ConsumeGroup with wrong end = %v, want %v", ParseError(n), errEndGroup)
+	}
+	if _, _, n := ConsumeField(AppendTag(nil, 1, EndGroupType)); ParseError(n) != errEndGroup {
+		t.Errorf("ConsumeField of end group = %v, want %v", ParseError(n), errEndGroup)
+	}
+	if _, _, n := ConsumeField(AppendTag(nil, 1, 6)); ParseError(n) != errWireType {
+		t.Errorf("ConsumeField of wire type 6 = %v, want %v", ParseError(n), errWireType)
+	}
+
+	var deep []byte
+	for i := 0; i <= maxDepth; i++ {
+		deep = AppendTag(deep, 1, StartGroupType)
+	}
+	if _, _, n := ConsumeField(deep); ParseError(n) != ErrTooDeep {
+		t.Errorf("ConsumeField of deep groups = %v, want %v", ParseError(n), ErrTooDeep)
+	}
+}
+
+func TestRange(t *testing.T) {
+	// The fields of GoSkipTest in proto/testdata.
+	var b []byte
+	b = AppendTag(b, 11, VarintType)
+	b = AppendVarint(b, math.MaxUint64-2) // int32(-3)
+	b = AppendTag(b, 12, Fixed32Type)
+	b = AppendFixed32(b, 42)
+	b = AppendTag(b, 13, Fixed64Type)
+	b = AppendFixed64(b, 1<<40)
+	b = AppendTag(b, 14, BytesType)
+	b = AppendBytes(b, []byte("routing"))
+	b = AppendTag(b, 15, StartGroupType)
+	b = AppendTag(b, 16, VarintType)
+	b = AppendVarint(b, 7)
+	b = AppendTag(b, 17, BytesType)
+	b = AppendBytes(b, []byte("inner"))
+	b = AppendTag(b, 15, EndGroupType)
+
+	var seen []Number
+	err := Range(b, func(num Number, typ Type, v []byte) bool {
+		seen = append(seen, num)
+		switch num {
+		case 11:
+			if x, _ := ConsumeVarint(v); int32(x) != -3 {
+				t.Errorf("skip_int32 = %d, want -3", int32(x))
+			}
+		case 12:
+			if x, _ := ConsumeFixed32(v); x != 42 {
+				t.Errorf("skip_fixed32 = %d, want 42", x)
+			}
+		case 13:
+			if x, _ := ConsumeFixed64(v); x != 1<<40 {
+				t.Errorf("skip_fixed64 = %d, want %d", x, uint64(1<<40))
+			}
+		case 14:
+			if string(v) != "routing" {
+				t.Errorf("skip_string = %q, want %q", v, "routing")
+			}
+		case 15:
+			var inner string
+			Range(v, func(num Number, typ Type, v []byte) bool {
+				if num == 17 {
+					inner = string(v)
+				}
+				return true
+			})
+			if inner != "inner" {
+				t.Errorf("group_string = %q, want %q", inner, "inner")
+			}
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 5 {
+		t.Errorf("Range saw fields %v, want 11 through 15", seen)
+	}
+
+	// Stopping early skips the rest of the message.
+	seen = nil
+	err = Range(b, func(num Number, typ Type, v []byte) bool {
+		seen = append(seen, num)
+		return num != 12
+	})
+	if err != nil || len(seen) != 2 {
+		t.Errorf("Range stopped after %v, %v; want fields 11 and 12", seen, err)
+	}
+
+	if err := Range(b[:len(b)-1], func(Number, Type, []byte) bool { return true }); err != io.ErrUnexpectedEOF {
+		t.Errorf("Range of truncated message = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
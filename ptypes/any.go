@@ -63,11 +63,21 @@ func AnyMessageName(any *any.Any) (string, error) {
 
 // MarshalAny takes the protocol buffer and encodes it into google.protobuf.Any.
 func MarshalAny(pb proto.Message) (*any.Any, error) {
+	return MarshalAnyWithPrefix(pb, googleApis)
+}
+
+// MarshalAnyWithPrefix is like MarshalAny, but the type URL of the result
+// starts with prefix rather than "type.googleapis.com/", for types served
+// by a private registry. A slash is added to prefix if it lacks one.
+func MarshalAnyWithPrefix(pb proto.Message, prefix string) (*any.Any, error) {
 	value, err := proto.Marshal(pb)
 	if err != nil {
 		return nil, err
 	}
-	return &any.Any{TypeUrl: googleApis + proto.MessageName(pb), Value: value}, nil
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &any.Any{TypeUrl: prefix + proto.MessageName(pb), Value: value}, nil
 }
 
 // DynamicAny is a value that can be passed to UnmarshalAny to automatically
@@ -128,6 +138,21 @@ func UnmarshalAny(any *any.Any, pb proto.Message) error {
 	return proto.Unmarshal(any.Value, pb)
 }
 
+// UnmarshalAnyTo parses the protocol buffer representation in a
+// google.protobuf.Any message into a new message of the type it names,
+// whatever the prefix of its type URL. It returns an error if that type
+// isn't linked in.
+func UnmarshalAnyTo(any *any.Any) (proto.Message, error) {
+	pb, err := Empty(any)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(any.Value, pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
 // Is returns true if any value contains a given message type.
 func Is(any *any.Any, pb proto.Message) bool {
 	aname, err := AnyMessageName(any)
@@ -111,3 +111,47 @@ func TestEmpty(t *testing.T) {
 		t.Errorf("got no error for an attempt to create a message of type %q, which shouldn't be linked in", a.TypeUrl)
 	}
 }
+
+func TestMarshalAnyWithPrefix(t *testing.T) {
+	want := &pb.FileDescriptorProto{Name: proto.String("foo")}
+	for _, prefix := range []string{"types.example.com/", "types.example.com"} {
+		a, err := MarshalAnyWithPrefix(want, prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.TypeUrl != "types.example.com/google.protobuf.FileDescriptorProto" {
+			t.Errorf("MarshalAnyWithPrefix(_, %q) type URL = %q", prefix, a.TypeUrl)
+		}
+		if !Is(a, want) {
+			t.Errorf("Is(%v, FileDescriptorProto) = false", a)
+		}
+		got := &pb.FileDescriptorProto{}
+		if err := UnmarshalAny(a, got); err != nil || !proto.Equal(got, want) {
+			t.Errorf("UnmarshalAny = %v, %v; want %v", got, err, want)
+		}
+	}
+}
+
+func TestUnmarshalAnyTo(t *testing.T) {
+	want := &pb.FileDescriptorProto{Name: proto.String("foo")}
+	a, err := MarshalAnyWithPrefix(want, "types.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := UnmarshalAnyTo(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("UnmarshalAnyTo = %v, want %v", got, want)
+	}
+
+	a.Value = []byte{0xff}
+	if _, err := UnmarshalAnyTo(a); err == nil {
+		t.Error("UnmarshalAnyTo of invalid data succeeded")
+	}
+	a.TypeUrl = "types.example.com/google.protobuf.FieldMask"
+	if _, err := UnmarshalAnyTo(a); err == nil {
+		t.Error("UnmarshalAnyTo of a type not linked in succeeded")
+	}
+}
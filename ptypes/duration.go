@@ -37,6 +37,7 @@ package ptypes
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	durpb "github.com/golang/protobuf/ptypes/duration"
@@ -100,3 +101,31 @@ func DurationProto(d time.Duration) *durpb.Duration {
 		Nanos:   int32(nanos),
 	}
 }
+
+// DurationOrClamp converts a durpb.Duration to a time.Duration like
+// Duration, but instead of returning an error it clamps durations too
+// large for a time.Duration to the largest or smallest one. A nil
+// Duration is zero, and an invalid one is taken at face value.
+func DurationOrClamp(p *durpb.Duration) time.Duration {
+	return clampDuration(p.GetSeconds(), int64(p.GetNanos()))
+}
+
+// clampDuration returns secs seconds plus nanos nanoseconds, clamped to
+// the range of time.Duration.
+func clampDuration(secs, nanos int64) time.Duration {
+	const maxSecs = math.MaxInt64 / int64(time.Second)
+	if secs > maxSecs {
+		return math.MaxInt64
+	}
+	if secs < -maxSecs {
+		return math.MinInt64
+	}
+	d, n := time.Duration(secs)*time.Second, time.Duration(nanos)
+	if n > 0 && d > math.MaxInt64-n {
+		return math.MaxInt64
+	}
+	if n < 0 && d < math.MinInt64-n {
+		return math.MinInt64
+	}
+	return d + n
+}
@@ -119,3 +119,24 @@ func TestDurationProto(t *testing.T) {
 		}
 	}
 }
+
+func TestDurationOrClamp(t *testing.T) {
+	for _, test := range durationTests {
+		if !test.isValid {
+			continue
+		}
+		want := test.dur
+		if !test.inRange {
+			want = math.MaxInt64
+			if test.proto.Seconds < 0 {
+				want = math.MinInt64
+			}
+		}
+		if got := DurationOrClamp(test.proto); got != want {
+			t.Errorf("DurationOrClamp(%v) = %v, want %v", test.proto, got, want)
+		}
+	}
+	if got := DurationOrClamp(nil); got != 0 {
+		t.Errorf("DurationOrClamp(nil) = %v, want 0", got)
+	}
+}
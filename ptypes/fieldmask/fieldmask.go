@@ -0,0 +1,280 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package fieldmask
+
+// This file implements operations on google.protobuf.FieldMask: checking
+// its paths against a message type, combining masks, and applying a mask
+// to copy fields from one message to another, as partial-update methods
+// do with their update masks.
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Validate checks that each path of mask names a field of m's message
+// type. A path is a dot-separated list of field names as written in the
+// .proto file; each name but the last must be that of a singular message
+// field, since repeated and map fields cannot be descended into.
+func Validate(mask *FieldMask, m proto.Message) error {
+	t := reflect.TypeOf(m)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("fieldmask: %T is not a generated message", m)
+	}
+	for _, p := range mask.GetPaths() {
+		if err := validatePath(p, t.Elem()); err != nil {
+			return fmt.Errorf("fieldmask: invalid path %q for %s: %v", p, proto.MessageName(m), err)
+		}
+	}
+	return nil
+}
+
+func validatePath(p string, t reflect.Type) error {
+	if p == "" {
+		return errors.New("empty path")
+	}
+	names := strings.Split(p, ".")
+	for i, name := range names {
+		f, ok := lookup(t, name)
+		if !ok {
+			return fmt.Errorf("no field %q", name)
+		}
+		if i == len(names)-1 {
+			break
+		}
+		if f.typ.Kind() != reflect.Ptr || f.typ.Elem().Kind() != reflect.Struct {
+			return fmt.Errorf("field %q is not a singular message", name)
+		}
+		t = f.typ.Elem()
+	}
+	return nil
+}
+
+// Canonicalize returns the canonical form of mask: its paths sorted,
+// without duplicates and without paths that another path covers, as "a"
+// covers "a.b".
+func Canonicalize(mask *FieldMask) *FieldMask {
+	paths := append([]string(nil), mask.GetPaths()...)
+	sort.Strings(paths)
+	out := &FieldMask{}
+	for _, p := range paths {
+		// Sorting puts the paths a path covers right after it, as '.'
+		// sorts before the characters of field names.
+		if n := len(out.Paths); n > 0 && covers(out.Paths[n-1], p) {
+			continue
+		}
+		out.Paths = append(out.Paths, p)
+	}
+	return out
+}
+
+// Union returns the canonical mask of the paths of all the masks.
+func Union(masks ...*FieldMask) *FieldMask {
+	u := &FieldMask{}
+	for _, m := range masks {
+		u.Paths = append(u.Paths, m.GetPaths()...)
+	}
+	return Canonicalize(u)
+}
+
+// Intersect returns the canonical mask of the fields that both a and b
+// cover.
+func Intersect(a, b *FieldMask) *FieldMask {
+	i := &FieldMask{}
+	for _, p := range a.GetPaths() {
+		for _, q := range b.GetPaths() {
+			switch {
+			case covers(q, p):
+				i.Paths = append(i.Paths, p)
+			case covers(p, q):
+				i.Paths = append(i.Paths, q)
+			}
+		}
+	}
+	return Canonicalize(i)
+}
+
+// covers reports whether the path p is q or an ancestor of q.
+func covers(p, q string) bool {
+	return p == q || strings.HasPrefix(q, p) && q[len(p)] == '.'
+}
+
+// ApplyToMessage sets each field of dst that mask names to its value in
+// src, which must have the same type. A field unset in src is cleared in
+// dst, and message fields on the way to a field are created in dst as
+// needed, so that applying a request's update mask to the stored message
+// with the request's message as src performs the update. Values are
+// copied deeply; dst shares nothing with src afterwards.
+func ApplyToMessage(mask *FieldMask, dst, src proto.Message) error {
+	if reflect.TypeOf(dst) != reflect.TypeOf(src) {
+		return fmt.Errorf("fieldmask: cannot apply a mask from %T to %T", src, dst)
+	}
+	if err := Validate(mask, dst); err != nil {
+		return err
+	}
+	dv, sv := reflect.ValueOf(dst), reflect.ValueOf(src)
+	if dv.IsNil() {
+		return errors.New("fieldmask: nil destination message")
+	}
+	for _, p := range Canonicalize(mask).Paths {
+		apply(dv, sv, strings.Split(p, "."))
+	}
+	return nil
+}
+
+// apply copies the field at path from src to dst, both message pointers;
+// a nil src is a message with no fields set.
+func apply(dst, src reflect.Value, path []string) {
+	proto.DecodeLazy(dst.Interface().(proto.Message))
+	if !src.IsNil() {
+		proto.DecodeLazy(src.Interface().(proto.Message))
+	}
+	f, _ := lookup(dst.Type().Elem(), path[0])
+	var v reflect.Value
+	set := false
+	if !src.IsNil() {
+		v, set = f.get(src.Elem())
+	}
+	if len(path) == 1 {
+		if set {
+			f.set(dst.Elem(), deepCopy(v))
+		} else {
+			f.clear(dst.Elem())
+		}
+		return
+	}
+	if !set {
+		v = reflect.Zero(f.typ)
+	}
+	d, ok := f.get(dst.Elem())
+	if !ok || d.IsNil() {
+		if v.IsNil() {
+			return // nothing to clear
+		}
+		d = reflect.New(f.typ.Elem())
+		f.set(dst.Elem(), d)
+	}
+	apply(d, v, path[1:])
+}
+
+// field locates a field of a generated message struct.
+type field struct {
+	index int          // of the struct field, which is the oneof's for oneof fields
+	oneof reflect.Type // for oneof fields, the pointer to its wrapper type
+	typ   reflect.Type // the Go type of the field's value
+}
+
+// lookup finds the field of the message struct type t named name in the
+// .proto file.
+func lookup(t reflect.Type, name string) (field, bool) {
+	sprops := proto.GetProperties(t)
+	for i, p := range sprops.Prop {
+		if p.OrigName == name && !strings.HasPrefix(t.Field(i).Name, "XXX_") {
+			return field{index: i, typ: t.Field(i).Type}, true
+		}
+	}
+	if op, ok := sprops.OneofTypes[name]; ok {
+		return field{index: op.Field, oneof: op.Type, typ: op.Type.Elem().Field(0).Type}, true
+	}
+	return field{}, false
+}
+
+// get returns the value of f in the message struct sv, and whether it is
+// set there, which only a oneof field can fail to be.
+func (f field) get(sv reflect.Value) (reflect.Value, bool) {
+	fv := sv.Field(f.index)
+	if f.oneof == nil {
+		return fv, true
+	}
+	if fv.IsNil() || fv.Elem().Type() != f.oneof {
+		return reflect.Value{}, false
+	}
+	return fv.Elem().Elem().Field(0), true
+}
+
+func (f field) set(sv, v reflect.Value) {
+	if f.oneof == nil {
+		sv.Field(f.index).Set(v)
+		return
+	}
+	w := reflect.New(f.oneof.Elem())
+	w.Elem().Field(0).Set(v)
+	sv.Field(f.index).Set(w)
+}
+
+func (f field) clear(sv reflect.Value) {
+	if _, ok := f.get(sv); ok {
+		fv := sv.Field(f.index)
+		fv.Set(reflect.Zero(fv.Type()))
+	}
+}
+
+// deepCopy returns a copy of v, the value of a field, that shares no
+// memory with it.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		if m, ok := v.Interface().(proto.Message); ok {
+			return reflect.ValueOf(proto.Clone(m))
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(v.Elem())
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMap(v.Type())
+		for _, k := range v.MapKeys() {
+			c.SetMapIndex(k, deepCopy(v.MapIndex(k)))
+		}
+		return c
+	}
+	return v
+}
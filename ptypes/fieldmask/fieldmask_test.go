@@ -0,0 +1,160 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package fieldmask
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	"github.com/golang/protobuf/proto"
+	p3 "github.com/golang/protobuf/proto/proto3_proto"
+	testpb "github.com/golang/protobuf/proto/testdata"
+)
+
+func mask(paths ...string) *FieldMask { return &FieldMask{Paths: paths} }
+
+func TestValidate(t *testing.T) {
+	for _, p := range []string{"name", "terrain", "submessage.name", "submessage.submessage.children", "proto2_field.n"} {
+		if err := Validate(mask(p), &p3.Message{}); err != nil {
+			t.Errorf("Validate(%q): %v", p, err)
+		}
+	}
+	if err := Validate(mask("title", "salary"), &pb.MsgWithOneof{}); err != nil {
+		t.Errorf("Validate(oneof fields): %v", err)
+	}
+	for _, p := range []string{"", "nope", "Name", "name.x", "children.name", "terrain.bunny", "submessage.", "submessage..name"} {
+		if err := Validate(mask(p), &p3.Message{}); err == nil {
+			t.Errorf("Validate(%q) succeeded", p)
+		}
+	}
+}
+
+func TestCombine(t *testing.T) {
+	tests := []struct {
+		name      string
+		got, want *FieldMask
+	}{
+		{"Canonicalize", Canonicalize(mask("b", "a.b", "a", "a.c", "ab", "b")), mask("a", "ab", "b")},
+		{"Canonicalize nil", Canonicalize(nil), mask()},
+		{"Union", Union(mask("a.b", "c"), mask("a", "d"), nil), mask("a", "c", "d")},
+		{"Intersect", Intersect(mask("a", "b.c", "d"), mask("a.x", "b", "e")), mask("a.x", "b.c")},
+		{"Intersect disjoint", Intersect(mask("a"), mask("ab")), mask()},
+	}
+	for _, test := range tests {
+		if len(test.got.Paths) == 0 && len(test.want.Paths) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(test.got.Paths, test.want.Paths) {
+			t.Errorf("%s = %v, want %v", test.name, test.got.Paths, test.want.Paths)
+		}
+	}
+}
+
+func TestApplyToMessage(t *testing.T) {
+	dst := &p3.Message{
+		Name:       "old",
+		Hilarity:   p3.Message_PUNS,
+		Key:        []uint64{1},
+		Submessage: &p3.Message{Name: "sub", HeightInCm: 3},
+		Terrain:    map[string]*p3.Nested{"x": {Bunny: "old"}},
+	}
+	src := &p3.Message{
+		Name:        "new",
+		Key:         []uint64{2, 3},
+		Submessage:  &p3.Message{HeightInCm: 4},
+		Proto2Field: &testpb.SubDefaults{N: proto.Int64(5)},
+		Terrain:     map[string]*p3.Nested{"y": {Bunny: "new"}},
+	}
+	err := ApplyToMessage(mask("name", "hilarity", "key", "submessage.height_in_cm", "submessage.name", "proto2_field.n", "terrain"), dst, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &p3.Message{
+		Name:        "new",
+		Key:         []uint64{2, 3},
+		Submessage:  &p3.Message{HeightInCm: 4},
+		Proto2Field: &testpb.SubDefaults{N: proto.Int64(5)},
+		Terrain:     map[string]*p3.Nested{"y": {Bunny: "new"}},
+	}
+	if !proto.Equal(dst, want) {
+		t.Errorf("ApplyToMessage =\n%v\nwant\n%v", dst, want)
+	}
+	// dst must not share memory with src.
+	src.Key[0] = 9
+	src.Terrain["y"].Bunny = "changed"
+	*src.Proto2Field.N = 9
+	if !proto.Equal(dst, want) {
+		t.Errorf("ApplyToMessage shares memory with src: %v", dst)
+	}
+
+	// Unset message fields on the way are not created.
+	dst = &p3.Message{}
+	if err := ApplyToMessage(mask("submessage.name"), dst, &p3.Message{}); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Submessage != nil {
+		t.Errorf("ApplyToMessage created submessage: %v", dst)
+	}
+
+	if err := ApplyToMessage(mask("nope"), dst, src); err == nil {
+		t.Error("ApplyToMessage with an invalid path succeeded")
+	}
+	if err := ApplyToMessage(mask("name"), dst, &pb.MsgWithOneof{}); err == nil {
+		t.Error("ApplyToMessage across types succeeded")
+	}
+}
+
+func TestApplyToMessageOneof(t *testing.T) {
+	dst := &pb.MsgWithOneof{Union: &pb.MsgWithOneof_Title{Title: "t"}}
+	src := &pb.MsgWithOneof{Union: &pb.MsgWithOneof_Salary{Salary: 7}}
+	// salary is set in src; title is not, but dst no longer holds it.
+	if err := ApplyToMessage(mask("title", "salary"), dst, src); err != nil {
+		t.Fatal(err)
+	}
+	if got := dst.GetSalary(); got != 7 {
+		t.Errorf("salary = %d, want 7 (dst %v)", got, dst)
+	}
+	// Clearing a oneof field that dst does not hold leaves dst alone.
+	if err := ApplyToMessage(mask("title"), dst, &pb.MsgWithOneof{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := dst.GetSalary(); got != 7 {
+		t.Errorf("after clearing title, salary = %d, want 7", got)
+	}
+	if err := ApplyToMessage(mask("salary"), dst, &pb.MsgWithOneof{}); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Union != nil {
+		t.Errorf("after clearing salary, union = %v, want nil", dst.Union)
+	}
+}
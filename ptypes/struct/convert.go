@@ -0,0 +1,344 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package structpb
+
+// This file implements conversions between Value, Struct and ListValue
+// and the native Go values that encoding/json works with.
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// maxExact is the largest integer magnitude a float64, and so a Value,
+// holds exactly.
+const maxExact = 1 << 53
+
+var numberType = reflect.TypeOf(json.Number(""))
+
+// A Converter converts between Values and native Go values. The zero
+// Converter is the one used by NewValue, NewStruct, NewList and the
+// AsInterface, AsMap and AsSlice methods.
+type Converter struct {
+	// UseNumber makes Interface, Map and Slice return numbers as
+	// json.Numbers rather than float64s, like json.Decoder.UseNumber.
+	UseNumber bool
+
+	// StrictIntegers makes Value, Struct and List reject integers of
+	// magnitude above 2^53, which a Value cannot hold exactly, rather
+	// than rounding them.
+	StrictIntegers bool
+}
+
+// Value converts v to a Value. v may be nil, a bool, a number of any
+// integer or floating-point type, a json.Number, a string, a []byte,
+// which is base64-encoded as encoding/json does, or a map with string
+// keys or a slice or array whose elements are such values in turn.
+// It returns an error for other types, and for maps and slices that
+// contain themselves.
+func (c Converter) Value(v interface{}) (*Value, error) {
+	s := &toValue{c: c, seen: make(map[visit]bool)}
+	return s.value(reflect.ValueOf(v))
+}
+
+// Struct converts m to a Struct, as Value does its entries.
+func (c Converter) Struct(m map[string]interface{}) (*Struct, error) {
+	s := &toValue{c: c, seen: make(map[visit]bool)}
+	return s.structValue(reflect.ValueOf(m))
+}
+
+// List converts l to a ListValue, as Value does its elements.
+func (c Converter) List(l []interface{}) (*ListValue, error) {
+	s := &toValue{c: c, seen: make(map[visit]bool)}
+	return s.listValue(reflect.ValueOf(l))
+}
+
+// Interface converts v to the value encoding/json would decode v's
+// JSON form to: nil, a bool, a float64 (or json.Number), a string, a
+// map[string]interface{} or a []interface{}. An unset Value is nil.
+//
+// Decoded messages cannot contain themselves, but ones built in code
+// can; Interface returns an error for those, along with the result with
+// nil where the cycle would repeat.
+func (c Converter) Interface(v *Value) (interface{}, error) {
+	s := &fromValue{c: c, seen: make(map[interface{}]bool)}
+	return s.iface(v), s.err
+}
+
+// Map converts s to a map[string]interface{}, as Interface does.
+func (c Converter) Map(s *Struct) (map[string]interface{}, error) {
+	f := &fromValue{c: c, seen: make(map[interface{}]bool)}
+	return f.structMap(s), f.err
+}
+
+// Slice converts l to a []interface{}, as Interface does.
+func (c Converter) Slice(l *ListValue) ([]interface{}, error) {
+	f := &fromValue{c: c, seen: make(map[interface{}]bool)}
+	return f.listSlice(l), f.err
+}
+
+// NewValue converts v to a Value with the zero Converter.
+func NewValue(v interface{}) (*Value, error) { return Converter{}.Value(v) }
+
+// NewStruct converts m to a Struct with the zero Converter.
+func NewStruct(m map[string]interface{}) (*Struct, error) { return Converter{}.Struct(m) }
+
+// NewList converts l to a ListValue with the zero Converter.
+func NewList(l []interface{}) (*ListValue, error) { return Converter{}.List(l) }
+
+// AsInterface converts m with the zero Converter, leaving nil where a
+// Value that contains itself would repeat.
+func (m *Value) AsInterface() interface{} {
+	v, _ := Converter{}.Interface(m)
+	return v
+}
+
+// AsMap converts m with the zero Converter, like AsInterface.
+func (m *Struct) AsMap() map[string]interface{} {
+	v, _ := Converter{}.Map(m)
+	return v
+}
+
+// AsSlice converts m with the zero Converter, like AsInterface.
+func (m *ListValue) AsSlice() []interface{} {
+	v, _ := Converter{}.Slice(m)
+	return v
+}
+
+// visit identifies a map or slice being converted, to detect cycles.
+type visit struct {
+	t   reflect.Type
+	p   uintptr
+	len int
+}
+
+// toValue holds the state of a conversion to a Value.
+type toValue struct {
+	c    Converter
+	seen map[visit]bool // the maps and slices enclosing the current value
+}
+
+func (s *toValue) value(rv reflect.Value) (*Value, error) {
+	if !rv.IsValid() {
+		return &Value{Kind: &Value_NullValue{}}, nil
+	}
+	if rv.Type() == numberType {
+		return s.number(json.Number(rv.String()))
+	}
+	switch rv.Kind() {
+	case reflect.Interface:
+		if rv.IsNil() {
+			return &Value{Kind: &Value_NullValue{}}, nil
+		}
+		return s.value(rv.Elem())
+	case reflect.Bool:
+		return &Value{Kind: &Value_BoolValue{rv.Bool()}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := rv.Int()
+		if s.c.StrictIntegers && (n > maxExact || n < -maxExact) {
+			return nil, fmt.Errorf("structpb: %d cannot be held exactly by a Value", n)
+		}
+		return &Value{Kind: &Value_NumberValue{float64(n)}}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n := rv.Uint()
+		if s.c.StrictIntegers && n > maxExact {
+			return nil, fmt.Errorf("structpb: %d cannot be held exactly by a Value", n)
+		}
+		return &Value{Kind: &Value_NumberValue{float64(n)}}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Value{Kind: &Value_NumberValue{rv.Float()}}, nil
+	case reflect.String:
+		return &Value{Kind: &Value_StringValue{rv.String()}}, nil
+	case reflect.Map:
+		if rv.IsNil() {
+			return &Value{Kind: &Value_NullValue{}}, nil
+		}
+		st, err := s.structValue(rv)
+		if err != nil {
+			return nil, err
+		}
+		return &Value{Kind: &Value_StructValue{st}}, nil
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return &Value{Kind: &Value_NullValue{}}, nil
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 && rv.Kind() == reflect.Slice {
+			return &Value{Kind: &Value_StringValue{base64.StdEncoding.EncodeToString(rv.Bytes())}}, nil
+		}
+		l, err := s.listValue(rv)
+		if err != nil {
+			return nil, err
+		}
+		return &Value{Kind: &Value_ListValue{l}}, nil
+	}
+	return nil, fmt.Errorf("structpb: cannot convert %v to a Value", rv.Type())
+}
+
+func (s *toValue) number(n json.Number) (*Value, error) {
+	f, err := strconv.ParseFloat(string(n), 64)
+	if err != nil {
+		return nil, fmt.Errorf("structpb: invalid number %q", n)
+	}
+	if s.c.StrictIntegers {
+		// Only integers are checked; other numbers are inexact anyway.
+		i, err := strconv.ParseInt(string(n), 10, 64)
+		if err == nil && (i > maxExact || i < -maxExact) || err != nil && err.(*strconv.NumError).Err == strconv.ErrRange {
+			return nil, fmt.Errorf("structpb: %s cannot be held exactly by a Value", n)
+		}
+	}
+	return &Value{Kind: &Value_NumberValue{f}}, nil
+}
+
+// enter records that rv, a map or slice, is being converted, and reports
+// whether it already was, which means it contains itself.
+func (s *toValue) enter(rv reflect.Value) (visit, error) {
+	var v visit
+	if rv.Kind() == reflect.Array {
+		return v, nil // arrays are values, so they cannot contain themselves
+	}
+	v = visit{rv.Type(), rv.Pointer(), 0}
+	if rv.Kind() == reflect.Slice {
+		v.len = rv.Len()
+	}
+	if s.seen[v] {
+		return v, fmt.Errorf("structpb: cannot convert %v that contains itself", rv.Type())
+	}
+	s.seen[v] = true
+	return v, nil
+}
+
+func (s *toValue) structValue(rv reflect.Value) (*Struct, error) {
+	if rv.Type().Key().Kind() != reflect.String {
+		return nil, fmt.Errorf("structpb: cannot convert %v to a Struct", rv.Type())
+	}
+	v, err := s.enter(rv)
+	if err != nil {
+		return nil, err
+	}
+	defer delete(s.seen, v)
+	st := &Struct{Fields: make(map[string]*Value, rv.Len())}
+	for _, k := range rv.MapKeys() {
+		fv, err := s.value(rv.MapIndex(k))
+		if err != nil {
+			return nil, err
+		}
+		st.Fields[k.String()] = fv
+	}
+	return st, nil
+}
+
+func (s *toValue) listValue(rv reflect.Value) (*ListValue, error) {
+	v, err := s.enter(rv)
+	if err != nil {
+		return nil, err
+	}
+	defer delete(s.seen, v)
+	l := &ListValue{Values: make([]*Value, rv.Len())}
+	for i := range l.Values {
+		if l.Values[i], err = s.value(rv.Index(i)); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// fromValue holds the state of a conversion from a Value.
+type fromValue struct {
+	c    Converter
+	seen map[interface{}]bool // the Structs and ListValues enclosing the current value
+	err  error                // the first cycle found
+}
+
+func (f *fromValue) iface(v *Value) interface{} {
+	switch k := v.GetKind().(type) {
+	case *Value_NumberValue:
+		if f.c.UseNumber {
+			return json.Number(strconv.FormatFloat(k.NumberValue, 'g', -1, 64))
+		}
+		return k.NumberValue
+	case *Value_StringValue:
+		return k.StringValue
+	case *Value_BoolValue:
+		return k.BoolValue
+	case *Value_StructValue:
+		if m := f.structMap(k.StructValue); m != nil {
+			return m
+		}
+	case *Value_ListValue:
+		if l := f.listSlice(k.ListValue); l != nil {
+			return l
+		}
+	}
+	return nil
+}
+
+// enter records that p, a *Struct or *ListValue, is being converted. It
+// reports false, and records an error, if it already was.
+func (f *fromValue) enter(p interface{}) bool {
+	if f.seen[p] {
+		if f.err == nil {
+			f.err = fmt.Errorf("structpb: cannot convert %T that contains itself", p)
+		}
+		return false
+	}
+	f.seen[p] = true
+	return true
+}
+
+// structMap converts s, or returns nil if s contains itself.
+func (f *fromValue) structMap(s *Struct) map[string]interface{} {
+	if !f.enter(s) {
+		return nil
+	}
+	defer delete(f.seen, s)
+	m := make(map[string]interface{}, len(s.GetFields()))
+	for k, v := range s.GetFields() {
+		m[k] = f.iface(v)
+	}
+	return m
+}
+
+// listSlice converts l, or returns nil if l contains itself.
+func (f *fromValue) listSlice(l *ListValue) []interface{} {
+	if !f.enter(l) {
+		return nil
+	}
+	defer delete(f.seen, l)
+	vs := make([]interface{}, len(l.GetValues()))
+	for i, v := range l.GetValues() {
+		vs[i] = f.iface(v)
+	}
+	return vs
+}
@@ -0,0 +1,173 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package structpb
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestNewValue(t *testing.T) {
+	type name string
+	in := map[string]interface{}{
+		"nil":    nil,
+		"bool":   true,
+		"int":    -3,
+		"uint8":  uint8(200),
+		"float":  float32(1.5),
+		"string": name("s"),
+		"bytes":  []byte("hi"),
+		"number": json.Number("12.5"),
+		"list":   []interface{}{1, "a", []int{2}},
+		"array":  [2]bool{true, false},
+		"nested": map[string]string{"k": "v"},
+	}
+	got, err := NewValue(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	num := func(f float64) *Value { return &Value{Kind: &Value_NumberValue{f}} }
+	str := func(s string) *Value { return &Value{Kind: &Value_StringValue{s}} }
+	boo := func(b bool) *Value { return &Value{Kind: &Value_BoolValue{b}} }
+	list := func(vs ...*Value) *Value { return &Value{Kind: &Value_ListValue{&ListValue{Values: vs}}} }
+	want := &Value{Kind: &Value_StructValue{&Struct{Fields: map[string]*Value{
+		"nil":    {Kind: &Value_NullValue{}},
+		"bool":   boo(true),
+		"int":    num(-3),
+		"uint8":  num(200),
+		"float":  num(1.5),
+		"string": str("s"),
+		"bytes":  str("aGk="),
+		"number": num(12.5),
+		"list":   list(num(1), str("a"), list(num(2))),
+		"array":  list(boo(true), boo(false)),
+		"nested": {Kind: &Value_StructValue{&Struct{Fields: map[string]*Value{"k": str("v")}}}},
+	}}}}
+	if !proto.Equal(got, want) {
+		t.Errorf("NewValue(%v) =\n%v\nwant\n%v", in, got, want)
+	}
+
+	for _, bad := range []interface{}{
+		make(chan int),
+		map[int]string{1: "a"},
+		[]interface{}{struct{}{}},
+		json.Number("x"),
+	} {
+		if _, err := NewValue(bad); err == nil {
+			t.Errorf("NewValue(%#v) succeeded", bad)
+		}
+	}
+}
+
+func TestNewValueCycles(t *testing.T) {
+	m := map[string]interface{}{}
+	m["self"] = m
+	if _, err := NewStruct(m); err == nil {
+		t.Error("NewStruct of a map containing itself succeeded")
+	}
+	l := []interface{}{nil}
+	l[0] = l
+	if _, err := NewList(l); err == nil {
+		t.Error("NewList of a slice containing itself succeeded")
+	}
+	// Sharing is not a cycle.
+	shared := []interface{}{1}
+	if _, err := NewList([]interface{}{shared, shared}); err != nil {
+		t.Errorf("NewList with a shared slice: %v", err)
+	}
+}
+
+func TestStrictIntegers(t *testing.T) {
+	c := Converter{StrictIntegers: true}
+	for _, in := range []interface{}{int64(1 << 53), -(1 << 53), uint64(1 << 53), json.Number("9007199254740992"), 1e300, json.Number("1.5e300")} {
+		if _, err := c.Value(in); err != nil {
+			t.Errorf("Value(%v): %v", in, err)
+		}
+	}
+	for _, in := range []interface{}{int64(1<<53 + 1), uint64(math.MaxUint64), json.Number("9007199254740993"), json.Number("-100000000000000000000")} {
+		if _, err := c.Value(in); err == nil {
+			t.Errorf("Value(%v) succeeded", in)
+		}
+		if _, err := NewValue(in); err != nil {
+			t.Errorf("NewValue(%v): %v", in, err)
+		}
+	}
+}
+
+func TestAsInterface(t *testing.T) {
+	const js = `{"a": [1, "x", true, null, {"b": 2.5}], "c": {}}`
+	var want map[string]interface{}
+	if err := json.Unmarshal([]byte(js), &want); err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewStruct(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.AsMap(); !reflect.DeepEqual(got, want) {
+		t.Errorf("AsMap() = %v, want %v", got, want)
+	}
+	if got := (&Value{Kind: &Value_StructValue{s}}).AsInterface(); !reflect.DeepEqual(got, want) {
+		t.Errorf("AsInterface() = %v, want %v", got, want)
+	}
+	if got := (*Value)(nil).AsInterface(); got != nil {
+		t.Errorf("nil AsInterface() = %v, want nil", got)
+	}
+
+	d := json.NewDecoder(strings.NewReader(js))
+	d.UseNumber()
+	var wantNum map[string]interface{}
+	if err := d.Decode(&wantNum); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Converter{UseNumber: true}.Map(s)
+	if err != nil || !reflect.DeepEqual(got, wantNum) {
+		t.Errorf("UseNumber Map() = %v, %v; want %v", got, err, wantNum)
+	}
+}
+
+func TestAsInterfaceCycles(t *testing.T) {
+	s := &Struct{Fields: map[string]*Value{"n": {Kind: &Value_NumberValue{1}}}}
+	s.Fields["self"] = &Value{Kind: &Value_StructValue{s}}
+	want := map[string]interface{}{"n": 1.0, "self": nil}
+	if got := s.AsMap(); !reflect.DeepEqual(got, want) {
+		t.Errorf("AsMap() = %v, want %v", got, want)
+	}
+	if _, err := (Converter{}).Map(s); err == nil {
+		t.Error("Map of a Struct containing itself succeeded")
+	}
+}
@@ -36,6 +36,7 @@ package ptypes
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	tspb "github.com/golang/protobuf/ptypes/timestamp"
@@ -132,3 +133,78 @@ func TimestampString(ts *tspb.Timestamp) string {
 	}
 	return t.Format(time.RFC3339Nano)
 }
+
+// TimestampNowTrunc returns a google.protobuf.Timestamp for the current
+// time rounded down to a multiple of d, as by time.Time.Truncate.
+func TimestampNowTrunc(d time.Duration) *tspb.Timestamp {
+	ts, err := TimestampProto(time.Now().Truncate(d))
+	if err != nil {
+		panic("ptypes: time.Now() out of Timestamp range")
+	}
+	return ts
+}
+
+// TimestampBefore reports whether a is before b. Like the other
+// comparison and arithmetic functions, it treats a nil Timestamp as the
+// empty one, the Unix epoch.
+func TimestampBefore(a, b *tspb.Timestamp) bool {
+	if a.GetSeconds() != b.GetSeconds() {
+		return a.GetSeconds() < b.GetSeconds()
+	}
+	return a.GetNanos() < b.GetNanos()
+}
+
+// TimestampAfter reports whether a is after b.
+func TimestampAfter(a, b *tspb.Timestamp) bool {
+	return TimestampBefore(b, a)
+}
+
+// TimestampWithin reports whether a and b are at most d apart.
+func TimestampWithin(a, b *tspb.Timestamp, d time.Duration) bool {
+	diff := TimestampSub(a, b)
+	return -d <= diff && diff <= d
+}
+
+// TimestampAdd returns ts plus d. Rather than returning an invalid
+// Timestamp, it saturates at the earliest or latest valid one; an
+// invalid ts is first brought into the valid range the same way.
+func TimestampAdd(ts *tspb.Timestamp, d time.Duration) *tspb.Timestamp {
+	secs := ts.GetSeconds()
+	switch {
+	case secs < minValidSeconds:
+		secs = minValidSeconds
+	case secs >= maxValidSeconds:
+		secs = maxValidSeconds - 1
+	}
+	secs += int64(d / time.Second)
+	nanos := int64(ts.GetNanos()) + int64(d%time.Second)
+	// Normalize nanos to [0, 1e9).
+	secs += nanos / 1e9
+	nanos %= 1e9
+	if nanos < 0 {
+		secs--
+		nanos += 1e9
+	}
+	switch {
+	case secs < minValidSeconds:
+		return &tspb.Timestamp{Seconds: minValidSeconds}
+	case secs >= maxValidSeconds:
+		return &tspb.Timestamp{Seconds: maxValidSeconds - 1, Nanos: 1e9 - 1}
+	}
+	return &tspb.Timestamp{Seconds: secs, Nanos: int32(nanos)}
+}
+
+// TimestampSub returns the duration a-b. Rather than overflowing, it
+// saturates at the largest or smallest time.Duration, so that deadline
+// math on far-off Timestamps keeps its sign.
+func TimestampSub(a, b *tspb.Timestamp) time.Duration {
+	secs := a.GetSeconds() - b.GetSeconds()
+	// Invalid Timestamps may overflow the subtraction itself.
+	switch {
+	case b.GetSeconds() < 0 && secs < a.GetSeconds():
+		return math.MaxInt64
+	case b.GetSeconds() > 0 && secs > a.GetSeconds():
+		return math.MinInt64
+	}
+	return clampDuration(secs, int64(a.GetNanos())-int64(b.GetNanos()))
+}
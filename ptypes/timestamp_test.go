@@ -151,3 +151,91 @@ func TestTimestampNow(t *testing.T) {
 		t.Errorf("between %v and %v\nTimestamp(TimestampNow()) = %v", before, after, tm)
 	}
 }
+
+func TestTimestampNowTrunc(t *testing.T) {
+	before := time.Now().Truncate(time.Minute)
+	ts := TimestampNowTrunc(time.Minute)
+	after := time.Now()
+
+	tm, err := Timestamp(ts)
+	if err != nil {
+		t.Fatalf("TimestampNowTrunc(time.Minute) = %v, which is invalid (%v)", ts, err)
+	}
+	if ts.Seconds%60 != 0 || ts.Nanos != 0 {
+		t.Errorf("TimestampNowTrunc(time.Minute) = %v, not a whole minute", ts)
+	}
+	if tm.Before(before) || tm.After(after) {
+		t.Errorf("between %v and %v\nTimestampNowTrunc(time.Minute) = %v", before, after, tm)
+	}
+}
+
+func TestTimestampCompare(t *testing.T) {
+	a := &tspb.Timestamp{Seconds: 100, Nanos: 5}
+	b := &tspb.Timestamp{Seconds: 100, Nanos: 7}
+	c := &tspb.Timestamp{Seconds: 101}
+	tests := []struct {
+		x, y          *tspb.Timestamp
+		before, after bool
+	}{
+		{a, b, true, false},
+		{b, a, false, true},
+		{b, c, true, false},
+		{a, a, false, false},
+		{nil, a, true, false},
+		{nil, &tspb.Timestamp{}, false, false},
+	}
+	for _, test := range tests {
+		if got := TimestampBefore(test.x, test.y); got != test.before {
+			t.Errorf("TimestampBefore(%v, %v) = %t", test.x, test.y, got)
+		}
+		if got := TimestampAfter(test.x, test.y); got != test.after {
+			t.Errorf("TimestampAfter(%v, %v) = %t", test.x, test.y, got)
+		}
+	}
+	if !TimestampWithin(a, c, time.Second) || !TimestampWithin(c, a, time.Second) {
+		t.Errorf("TimestampWithin(%v, %v, 1s) = false", a, c)
+	}
+	if TimestampWithin(a, c, time.Second-6) {
+		t.Errorf("TimestampWithin(%v, %v, 1s-6ns) = true", a, c)
+	}
+}
+
+func TestTimestampAdd(t *testing.T) {
+	tests := []struct {
+		ts   *tspb.Timestamp
+		d    time.Duration
+		want *tspb.Timestamp
+	}{
+		{&tspb.Timestamp{Seconds: 10, Nanos: 5e8}, 1500 * time.Millisecond, &tspb.Timestamp{Seconds: 12}},
+		{&tspb.Timestamp{Seconds: 10, Nanos: 5e8}, -600 * time.Millisecond, &tspb.Timestamp{Seconds: 9, Nanos: 9e8}},
+		{nil, time.Second, &tspb.Timestamp{Seconds: 1}},
+		// Saturation at both ends of the valid range.
+		{&tspb.Timestamp{Seconds: maxValidSeconds - 1}, math.MaxInt64, &tspb.Timestamp{Seconds: maxValidSeconds - 1, Nanos: 1e9 - 1}},
+		{&tspb.Timestamp{Seconds: minValidSeconds}, -time.Nanosecond, &tspb.Timestamp{Seconds: minValidSeconds}},
+		{&tspb.Timestamp{Seconds: math.MaxInt64}, -time.Hour, &tspb.Timestamp{Seconds: maxValidSeconds - 3601, Nanos: 0}},
+	}
+	for _, test := range tests {
+		if got := TimestampAdd(test.ts, test.d); !proto.Equal(got, test.want) {
+			t.Errorf("TimestampAdd(%v, %v) = %v, want %v", test.ts, test.d, got, test.want)
+		}
+	}
+}
+
+func TestTimestampSub(t *testing.T) {
+	tests := []struct {
+		a, b *tspb.Timestamp
+		want time.Duration
+	}{
+		{&tspb.Timestamp{Seconds: 12}, &tspb.Timestamp{Seconds: 10, Nanos: 5e8}, 1500 * time.Millisecond},
+		{&tspb.Timestamp{Seconds: 10, Nanos: 5e8}, &tspb.Timestamp{Seconds: 12}, -1500 * time.Millisecond},
+		{&tspb.Timestamp{Seconds: maxValidSeconds - 1}, &tspb.Timestamp{Seconds: minValidSeconds}, math.MaxInt64},
+		{&tspb.Timestamp{Seconds: minValidSeconds}, &tspb.Timestamp{Seconds: maxValidSeconds - 1}, math.MinInt64},
+		{&tspb.Timestamp{Seconds: math.MaxInt64}, &tspb.Timestamp{Seconds: math.MinInt64}, math.MaxInt64},
+		{&tspb.Timestamp{Seconds: math.MinInt64}, &tspb.Timestamp{Seconds: 1}, math.MinInt64},
+	}
+	for _, test := range tests {
+		if got := TimestampSub(test.a, test.b); got != test.want {
+			t.Errorf("TimestampSub(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package wrappers_test
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+func TestGetValueOr(t *testing.T) {
+	var unset *wrappers.Int32Value
+	if got := unset.GetValueOr(7); got != 7 {
+		t.Errorf("nil GetValueOr(7) = %d, want 7", got)
+	}
+	if got := wrappers.Int32(0).GetValueOr(7); got != 0 {
+		t.Errorf("Int32(0).GetValueOr(7) = %d, want 0", got)
+	}
+	if got := wrappers.String("x").GetValueOr("def"); got != "x" {
+		t.Errorf(`String("x").GetValueOr("def") = %q, want "x"`, got)
+	}
+	if got := (*wrappers.BytesValue)(nil).GetValueOr([]byte("d")); string(got) != "d" {
+		t.Errorf(`nil GetValueOr("d") = %q, want "d"`, got)
+	}
+}
+
+var known = &pb.KnownTypes{
+	Dbl:   wrappers.Double(1.5),
+	Flt:   wrappers.Float(2.5),
+	I64:   wrappers.Int64(-3),
+	U64:   wrappers.UInt64(4),
+	I32:   wrappers.Int32(-5),
+	U32:   wrappers.UInt32(6),
+	Bool:  wrappers.Bool(true),
+	Str:   wrappers.String("s"),
+	Bytes: wrappers.Bytes([]byte("b")),
+}
+
+func TestInlineText(t *testing.T) {
+	tm := proto.TextMarshaler{Compact: true, InlineWrappers: true}
+	const want = `dbl:1.5 flt:2.5 i64:-3 u64:4 i32:-5 u32:6 bool:true str:"s" bytes:"b" `
+	if got := tm.Text(known); got != want {
+		t.Errorf("InlineWrappers text = %q\nwant %q", got, want)
+	}
+	for _, text := range []string{want, proto.CompactTextString(known)} {
+		got := new(pb.KnownTypes)
+		if err := proto.UnmarshalText(text, got); err != nil {
+			t.Fatalf("UnmarshalText(%q): %v", text, err)
+		}
+		if !proto.Equal(got, known) {
+			t.Errorf("UnmarshalText(%q) = %v, want %v", text, got, known)
+		}
+	}
+}
+
+func TestInlineJSON(t *testing.T) {
+	m := jsonpb.Marshaler{}
+	s, err := m.MarshalToString(known)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `{"dbl":1.5,"flt":2.5,"i64":"-3","u64":"4","i32":-5,"u32":6,"bool":true,"str":"s","bytes":"Yg=="}`
+	if s != want {
+		t.Errorf("jsonpb = %s\nwant %s", s, want)
+	}
+	got := new(pb.KnownTypes)
+	if err := jsonpb.UnmarshalString(s, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, known) {
+		t.Errorf("jsonpb round trip = %v, want %v", got, known)
+	}
+}
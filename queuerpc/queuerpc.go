@@ -0,0 +1,308 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package queuerpc carries the calls of carno services over a message
+broker, such as NATS, for asynchronous processing of the same messages
+and by the same server implementations as the carno transport. The carno
+plugin generates, for each service with carno:queue=true,
+
+	func New<Service>QueueClient(b queuerpc.Broker) <Service>Client
+	func New<Service>Publisher(b queuerpc.Broker) <Service>Publisher
+	func Subscribe<Service>Server(b queuerpc.Broker, srv <Service>Server) (queuerpc.Subscription, error)
+
+Each method has its own subject, its carno name "pkg@Service/Method", as
+in package callinfo. Servers subscribe in a queue group named after the
+service, so that each request is handled by one of them. A client call
+is a request that waits for the server's reply; a publisher sends the
+request without waiting, and the server's response is dropped.
+
+Brokers are pluggable: a Broker adapts a message system to the three
+operations the bindings use. NewLocalBroker returns one that delivers
+messages within the program, for tests.
+*/
+package queuerpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+)
+
+// A Broker sends messages to the subscribers of subjects.
+type Broker interface {
+	// Publish sends data to subject without waiting for a reply.
+	Publish(ctx context.Context, subject string, data []byte) error
+
+	// Request sends data to subject and returns the reply of the
+	// subscriber that handled it.
+	Request(ctx context.Context, subject string, data []byte) ([]byte, error)
+
+	// QueueSubscribe calls h with each message sent to subject. Of the
+	// subscribers sharing a queue name, only one receives each message.
+	// The result of h is the reply to a Request; it is dropped for a
+	// Publish.
+	QueueSubscribe(subject, queue string, h func(ctx context.Context, data []byte) []byte) (Subscription, error)
+}
+
+// A Subscription is a subscription made with a Broker.
+type Subscription interface {
+	// Unsubscribe stops the delivery of messages.
+	Unsubscribe() error
+}
+
+// ErrStreaming is returned by the methods of generated clients for
+// streaming methods, which the bindings do not support.
+var ErrStreaming = errors.New("queuerpc: streaming methods are not supported")
+
+// An Error is the error a server method returned, as its client sees it.
+type Error struct {
+	Subject string // subject of the method
+	Msg     string // message of the server's error
+}
+
+func (e *Error) Error() string { return "queuerpc: " + e.Subject + ": " + e.Msg }
+
+// Replies start with a byte telling a response from an error.
+const (
+	replyOK    = 0 // followed by the encoded response
+	replyError = 1 // followed by the error message
+)
+
+// Call sends the request in to subject with b and decodes the response
+// into out. If the server method failed, the error is an *Error.
+func Call(ctx context.Context, b Broker, subject string, in, out proto.Message) error {
+	data, err := proto.Marshal(in)
+	if err != nil {
+		return err
+	}
+	reply, err := b.Request(ctx, subject, data)
+	if err != nil {
+		return err
+	}
+	if len(reply) == 0 {
+		return fmt.Errorf("queuerpc: %s: empty reply", subject)
+	}
+	switch reply[0] {
+	case replyOK:
+		return proto.Unmarshal(reply[1:], out)
+	case replyError:
+		return &Error{Subject: subject, Msg: string(reply[1:])}
+	}
+	return fmt.Errorf("queuerpc: %s: malformed reply", subject)
+}
+
+// Publish sends the request in to subject with b, without waiting for
+// the server to handle it.
+func Publish(ctx context.Context, b Broker, subject string, in proto.Message) error {
+	data, err := proto.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return b.Publish(ctx, subject, data)
+}
+
+// A Method is one method of a service served by Subscribe.
+type Method struct {
+	// Info describes the method. If it is not nil, requests are decoded
+	// with its UnmarshalRequest, which enforces the method's request
+	// limits.
+	Info *callinfo.CallInfo
+
+	// Call decodes the request with decode and calls the server's method
+	// with it. Generated code provides it.
+	Call func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error)
+}
+
+// Subscribe subscribes the methods of the service with the given carno
+// name, such as "demo.users@UserService", keyed by the method names
+// declared in the .proto file, to their subjects, in the queue group
+// named after the service. If a subscription fails, those already made
+// are undone.
+func Subscribe(b Broker, service string, methods map[string]Method) (Subscription, error) {
+	var subs multiSub
+	for name, m := range methods {
+		subject := service + "/" + name
+		sub, err := b.QueueSubscribe(subject, service, handler(subject, m))
+		if err != nil {
+			subs.Unsubscribe()
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+// handler returns the message handler of the method m, which replies
+// with its response or error.
+func handler(subject string, m Method) func(ctx context.Context, data []byte) []byte {
+	return func(ctx context.Context, data []byte) []byte {
+		decode := func(in proto.Message) error {
+			if m.Info != nil {
+				return m.Info.UnmarshalRequest(data, in)
+			}
+			return proto.Unmarshal(data, in)
+		}
+		out, err := m.Call(ctx, decode)
+		if err != nil {
+			return append([]byte{replyError}, err.Error()...)
+		}
+		b, err := proto.Marshal(out)
+		if err != nil {
+			return append([]byte{replyError}, fmt.Sprintf("encoding response: %v", err)...)
+		}
+		return append([]byte{replyOK}, b...)
+	}
+}
+
+// multiSub is the Subscription of the methods of a service.
+type multiSub []Subscription
+
+// Unsubscribe undoes each subscription and returns the first error.
+func (s multiSub) Unsubscribe() error {
+	var first error
+	for _, sub := range s {
+		if err := sub.Unsubscribe(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// ErrNoSubscribers is returned by the Request method of a LocalBroker
+// when no one subscribes to the subject.
+var ErrNoSubscribers = errors.New("queuerpc: no subscribers")
+
+// A LocalBroker is a Broker that delivers messages within the program,
+// synchronously, in the goroutine that sends them. Each queue group takes
+// turns among its subscribers. It is safe for concurrent use.
+type LocalBroker struct {
+	mu     sync.Mutex
+	groups map[string][]*localGroup // by subject, in subscription order
+}
+
+type localGroup struct {
+	queue string
+	subs  []*localSub
+	next  int // index of the subscriber for the next message
+}
+
+type localSub struct {
+	b       *LocalBroker
+	subject string
+	h       func(ctx context.Context, data []byte) []byte
+}
+
+// NewLocalBroker returns a LocalBroker with no subscribers.
+func NewLocalBroker() *LocalBroker {
+	return &LocalBroker{groups: make(map[string][]*localGroup)}
+}
+
+// pick returns the handler of the next subscriber of each queue group of
+// subject.
+func (b *LocalBroker) pick(subject string) []func(ctx context.Context, data []byte) []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var hs []func(ctx context.Context, data []byte) []byte
+	for _, g := range b.groups[subject] {
+		hs = append(hs, g.subs[g.next%len(g.subs)].h)
+		g.next++
+	}
+	return hs
+}
+
+// Publish calls one subscriber of each queue group of subject.
+func (b *LocalBroker) Publish(ctx context.Context, subject string, data []byte) error {
+	for _, h := range b.pick(subject) {
+		h(ctx, data)
+	}
+	return nil
+}
+
+// Request calls one subscriber of each queue group of subject and returns
+// the reply of the first.
+func (b *LocalBroker) Request(ctx context.Context, subject string, data []byte) ([]byte, error) {
+	hs := b.pick(subject)
+	if len(hs) == 0 {
+		return nil, ErrNoSubscribers
+	}
+	reply := hs[0](ctx, data)
+	for _, h := range hs[1:] {
+		h(ctx, data)
+	}
+	return reply, nil
+}
+
+// QueueSubscribe adds a subscriber for subject to its queue group. An
+// empty queue name makes a group of one.
+func (b *LocalBroker) QueueSubscribe(subject, queue string, h func(ctx context.Context, data []byte) []byte) (Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	sub := &localSub{b: b, subject: subject, h: h}
+	for _, g := range b.groups[subject] {
+		if queue != "" && g.queue == queue {
+			g.subs = append(g.subs, sub)
+			return sub, nil
+		}
+	}
+	b.groups[subject] = append(b.groups[subject], &localGroup{queue: queue, subs: []*localSub{sub}})
+	return sub, nil
+}
+
+// Unsubscribe removes the subscriber from its queue group.
+func (s *localSub) Unsubscribe() error {
+	b := s.b
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	groups := b.groups[s.subject]
+	for i, g := range groups {
+		for j, sub := range g.subs {
+			if sub != s {
+				continue
+			}
+			g.subs = append(g.subs[:j], g.subs[j+1:]...)
+			if len(g.subs) == 0 {
+				groups = append(groups[:i], groups[i+1:]...)
+			}
+			if len(groups) == 0 {
+				delete(b.groups, s.subject)
+			} else {
+				b.groups[s.subject] = groups
+			}
+			return nil
+		}
+	}
+	return nil
+}
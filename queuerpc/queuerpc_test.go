@@ -0,0 +1,132 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package queuerpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ccsnake/protobuf/callinfo"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/proto3_proto"
+)
+
+// echo returns a method that records the bunnies it sees in seen, and
+// fails for the bunny "broken".
+func echo(seen *[]string) Method {
+	return Method{Call: func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+		in := new(pb.Nested)
+		if err := decode(in); err != nil {
+			return nil, err
+		}
+		*seen = append(*seen, in.Bunny)
+		if in.Bunny == "broken" {
+			return nil, errors.New("broken bunny")
+		}
+		return in, nil
+	}}
+}
+
+func TestCall(t *testing.T) {
+	b := NewLocalBroker()
+	var seen []string
+	limited := echo(&seen)
+	limited.Info = &callinfo.CallInfo{MaxRequestBytes: 8}
+	sub, err := Subscribe(b, "test@Hutch", map[string]Method{"Echo": echo(&seen), "Limited": limited})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	out := new(pb.Nested)
+	if err := Call(ctx, b, "test@Hutch/Echo", &pb.Nested{Bunny: "Flopsy", Cute: true}, out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Bunny != "Flopsy" || !out.Cute {
+		t.Errorf("response = %v, want the request", out)
+	}
+
+	err = Call(ctx, b, "test@Hutch/Echo", &pb.Nested{Bunny: "broken"}, out)
+	if e, ok := err.(*Error); !ok || e.Subject != "test@Hutch/Echo" || e.Msg != "broken bunny" {
+		t.Errorf("failed call: %#v, want the server's error", err)
+	}
+	err = Call(ctx, b, "test@Hutch/Limited", &pb.Nested{Bunny: "Cottontail"}, out)
+	if err == nil || !strings.Contains(err.Error(), proto.ErrInputTooLarge.Error()) {
+		t.Errorf("call over the limit: %v, want %v", err, proto.ErrInputTooLarge)
+	}
+
+	if err := Publish(ctx, b, "test@Hutch/Echo", &pb.Nested{Bunny: "Mopsy"}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(seen, ","), "Flopsy,broken,Mopsy"; got != want {
+		t.Errorf("server saw %s, want %s", got, want)
+	}
+
+	if err := sub.Unsubscribe(); err != nil {
+		t.Fatal(err)
+	}
+	if err := Call(ctx, b, "test@Hutch/Echo", &pb.Nested{}, out); err != ErrNoSubscribers {
+		t.Errorf("call after Unsubscribe: %v, want %v", err, ErrNoSubscribers)
+	}
+}
+
+func TestLocalBrokerQueueGroups(t *testing.T) {
+	b := NewLocalBroker()
+	var got []string
+	handler := func(name string) func(context.Context, []byte) []byte {
+		return func(ctx context.Context, data []byte) []byte {
+			got = append(got, name)
+			return []byte(name)
+		}
+	}
+	a, _ := b.QueueSubscribe("s", "workers", handler("a"))
+	b.QueueSubscribe("s", "workers", handler("b"))
+	b.QueueSubscribe("s", "", handler("audit"))
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		b.Publish(ctx, "s", nil)
+	}
+	// Each message goes to one worker in turn, and to the audit subscriber.
+	if want := "a,audit,b,audit,a,audit"; strings.Join(got, ",") != want {
+		t.Errorf("deliveries = %s, want %s", strings.Join(got, ","), want)
+	}
+
+	a.Unsubscribe()
+	got = nil
+	reply, err := b.Request(ctx, "s", nil)
+	if err != nil || string(reply) != "b" {
+		t.Errorf("Request = %q, %v; want the reply of b", reply, err)
+	}
+}
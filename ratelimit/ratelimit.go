@@ -0,0 +1,164 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package ratelimit limits the rate of calls to the methods of carno
+services that have the (carno.rate_limit) option:
+
+	rpc Purge(PurgeRequest) returns (PurgeResponse) {
+	  option (carno.rate_limit) = {rps: 100, burst: 20};
+	}
+
+The method's callinfo.CallInfo records the limit, and the server
+generated by the carno plugin calls Check before each such call, which
+rejects it with an *Error if the registered Limiter does not allow it.
+The default Limiter, TokenBuckets, keeps a token bucket for each method
+in the process; SetLimiter replaces it with one that, say, shares
+buckets between servers or limits each caller separately.
+*/
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ccsnake/protobuf/callinfo"
+)
+
+// A Limiter decides whether calls may proceed.
+type Limiter interface {
+	// Allow reports whether a call to the method described by info may
+	// proceed now. It is called only for methods with a rate limit.
+	Allow(ctx context.Context, info *callinfo.CallInfo) bool
+}
+
+var (
+	mu      sync.RWMutex
+	limiter Limiter = NewTokenBuckets()
+)
+
+// SetLimiter registers the Limiter that Check consults. If l is nil,
+// calls are not limited.
+func SetLimiter(l Limiter) {
+	mu.Lock()
+	defer mu.Unlock()
+	limiter = l
+}
+
+// GetLimiter returns the registered Limiter.
+func GetLimiter() Limiter {
+	mu.RLock()
+	defer mu.RUnlock()
+	return limiter
+}
+
+// Check returns an *Error if the method described by info has a rate
+// limit and the registered Limiter does not allow a call to it now, and
+// nil otherwise.
+func Check(ctx context.Context, info *callinfo.CallInfo) error {
+	if info.RateLimit <= 0 {
+		return nil
+	}
+	if l := GetLimiter(); l != nil && !l.Allow(ctx, info) {
+		return &Error{Method: info.FullMethod()}
+	}
+	return nil
+}
+
+// An Error reports a call rejected by the rate limit of its method.
+type Error struct {
+	Method string // the method's carno name, "pkg@Service/Method"
+}
+
+func (e *Error) Error() string { return "ratelimit: rate limit of " + e.Method + " exceeded" }
+
+// TokenBuckets is a Limiter with a token bucket for each method, which
+// holds up to the method's RateBurst tokens, at least one, and gains
+// RateLimit tokens a second. Each call takes a token, and is refused if
+// there is none. It is safe for concurrent use. The zero value has all its
+// buckets full, like the TokenBuckets of NewTokenBuckets.
+type TokenBuckets struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time // time.Now, but for tests
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBuckets returns a TokenBuckets whose buckets are all full.
+func NewTokenBuckets() *TokenBuckets {
+	return new(TokenBuckets)
+}
+
+// clock returns the current time.
+func (t *TokenBuckets) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+// Allow implements Limiter.
+func (t *TokenBuckets) Allow(ctx context.Context, info *callinfo.CallInfo) bool {
+	size := float64(info.RateBurst)
+	if size < 1 {
+		size = 1
+	}
+	now := t.clock()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	name := info.FullMethod()
+	b := t.buckets[name]
+	if b == nil {
+		if t.buckets == nil {
+			t.buckets = make(map[string]*bucket)
+		}
+		b = &bucket{tokens: size, last: now}
+		t.buckets[name] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * info.RateLimit
+		if b.tokens > size {
+			b.tokens = size
+		}
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
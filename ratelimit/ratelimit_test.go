@@ -0,0 +1,116 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ccsnake/protobuf/callinfo"
+)
+
+// clock is a fake time for TokenBuckets.
+type clock struct {
+	t time.Time
+}
+
+func (c *clock) now() time.Time          { return c.t }
+func (c *clock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+// allowed returns how many of n calls t allows at once.
+func allowed(t *TokenBuckets, info *callinfo.CallInfo, n int) int {
+	k := 0
+	for i := 0; i < n; i++ {
+		if t.Allow(context.Background(), info) {
+			k++
+		}
+	}
+	return k
+}
+
+func TestTokenBuckets(t *testing.T) {
+	c := &clock{t: time.Unix(1e9, 0)}
+	tb := NewTokenBuckets()
+	tb.now = c.now
+	purge := &callinfo.CallInfo{Service: "demo@Admin", Method: "Purge", RateLimit: 10, RateBurst: 5}
+	stat := &callinfo.CallInfo{Service: "demo@Admin", Method: "Stat", RateLimit: 1}
+
+	if got := allowed(tb, purge, 8); got != 5 {
+		t.Errorf("full bucket allowed %d of 8 calls, want the burst of 5", got)
+	}
+	c.advance(300 * time.Millisecond)
+	if got := allowed(tb, purge, 8); got != 3 {
+		t.Errorf("after 300ms at 10 rps, allowed %d calls, want 3", got)
+	}
+	c.advance(time.Hour)
+	if got := allowed(tb, purge, 8); got != 5 {
+		t.Errorf("after an hour, allowed %d calls, want no more than the burst of 5", got)
+	}
+
+	// Each method has its own bucket; a zero burst means one.
+	if got := allowed(tb, stat, 3); got != 1 {
+		t.Errorf("Stat allowed %d of 3 calls, want 1", got)
+	}
+}
+
+func TestZeroTokenBuckets(t *testing.T) {
+	var tb TokenBuckets
+	stat := &callinfo.CallInfo{Service: "demo@Admin", Method: "Stat", RateLimit: 1, RateBurst: 2}
+	if got := allowed(&tb, stat, 3); got != 2 {
+		t.Errorf("zero TokenBuckets allowed %d of 3 calls, want the burst of 2", got)
+	}
+}
+
+// deny is a Limiter that refuses every call.
+type deny struct{}
+
+func (deny) Allow(ctx context.Context, info *callinfo.CallInfo) bool { return false }
+
+func TestCheck(t *testing.T) {
+	defer SetLimiter(GetLimiter())
+	SetLimiter(deny{})
+
+	limited := &callinfo.CallInfo{Service: "demo@Admin", Method: "Purge", RateLimit: 10}
+	err := Check(context.Background(), limited)
+	if e, ok := err.(*Error); !ok || e.Method != "demo@Admin/Purge" {
+		t.Errorf("Check of a limited method = %v, want an *Error for demo@Admin/Purge", err)
+	}
+	if err := Check(context.Background(), &callinfo.CallInfo{Service: "demo@Admin", Method: "Stat"}); err != nil {
+		t.Errorf("Check of a method without a limit = %v, want nil", err)
+	}
+
+	SetLimiter(nil)
+	if err := Check(context.Background(), limited); err != nil {
+		t.Errorf("Check with no limiter = %v, want nil", err)
+	}
+}
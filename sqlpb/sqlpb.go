@@ -0,0 +1,218 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package sqlpb moves messages between carno RPC and database/sql. For a
+message with the (carno.entity) option,
+
+	message User {
+	  option (carno.entity) = { table: "users" pk: "id" };
+	  string id = 1;
+	  Status status = 2;
+	  google.protobuf.Timestamp created_at = 3;
+	}
+
+the carno plugin generates the constants UserTable and UserPrimaryKey,
+UserColumns, the names of its columns, a ScanRow method setting the
+message from a row with those columns, and a RowValues method returning
+its values for them:
+
+	rows, err := db.Query("SELECT " + strings.Join(pb.UserColumns, ", ") + " FROM " + pb.UserTable)
+	...
+	for rows.Next() {
+		u := new(pb.User)
+		if err := u.ScanRow(rows); err != nil {
+			...
+		}
+	}
+
+The columns are the singular scalar, enum and google.protobuf.Timestamp
+fields outside oneofs, named as in the .proto file. Enums are stored as
+their numbers; the enums of a file with entities that its entities use
+get Value and Scan methods, so they can be query arguments, and Scan
+also accepts their names. Timestamps are stored as time.Time, and a nil
+Timestamp as NULL.
+*/
+package sqlpb
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// A Row is a row of a query result, such as a *sql.Row or *sql.Rows.
+type Row interface {
+	Scan(dest ...interface{}) error
+}
+
+// A Column is a field of a message stored in a column, which a Row can
+// be scanned into and whose value can be a query argument.
+type Column interface {
+	sql.Scanner
+	driver.Valuer
+}
+
+// Timestamp returns the Column of the Timestamp field *p. A NULL column
+// scans as nil, and a nil field is written as NULL.
+func Timestamp(p **tspb.Timestamp) Column {
+	return timestampColumn{p}
+}
+
+type timestampColumn struct {
+	p **tspb.Timestamp
+}
+
+func (c timestampColumn) Scan(src interface{}) error {
+	var t time.Time
+	switch src := src.(type) {
+	case nil:
+		*c.p = nil
+		return nil
+	case time.Time:
+		t = src
+	case string:
+		return c.parse(src)
+	case []byte:
+		return c.parse(string(src))
+	default:
+		return fmt.Errorf("sqlpb: cannot scan %T into a Timestamp", src)
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return fmt.Errorf("sqlpb: %v", err)
+	}
+	*c.p = ts
+	return nil
+}
+
+// parse scans a time in text, as some drivers return them.
+func (c timestampColumn) parse(s string) error {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return fmt.Errorf("sqlpb: cannot scan %q into a Timestamp: %v", s, err)
+	}
+	return c.Scan(t)
+}
+
+func (c timestampColumn) Value() (driver.Value, error) {
+	if *c.p == nil {
+		return nil, nil
+	}
+	t, err := ptypes.Timestamp(*c.p)
+	if err != nil {
+		return nil, fmt.Errorf("sqlpb: %v", err)
+	}
+	return t, nil
+}
+
+// Enum returns the Column of the enum field *p, where p points to a
+// field of an enum type, or of a pointer to one, as in proto2, and
+// values maps the names of the enum to their numbers. Generated code
+// uses it for enums without a Scan method, those declared in other
+// files. A NULL column scans as the zero value of the field.
+func Enum(p interface{}, values map[string]int32) Column {
+	v := reflect.ValueOf(p)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		panic(fmt.Sprintf("sqlpb: Enum of %T, not a pointer to a field", p))
+	}
+	return enumColumn{v.Elem(), values}
+}
+
+type enumColumn struct {
+	v      reflect.Value
+	values map[string]int32
+}
+
+func (c enumColumn) Scan(src interface{}) error {
+	if src == nil {
+		c.v.Set(reflect.Zero(c.v.Type()))
+		return nil
+	}
+	n, err := EnumNumber(src, c.values)
+	if err != nil {
+		return err
+	}
+	v := c.v
+	if v.Kind() == reflect.Ptr {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	v.SetInt(int64(n))
+	return nil
+}
+
+func (c enumColumn) Value() (driver.Value, error) {
+	v := c.v
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	return v.Int(), nil
+}
+
+// EnumNumber returns the number of the enum value in the column src,
+// which holds its number or its name, one of those in values. A NULL
+// column holds zero. The generated Scan methods of enums call it.
+func EnumNumber(src interface{}, values map[string]int32) (int32, error) {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		if int64(int32(src)) != src {
+			return 0, fmt.Errorf("sqlpb: enum number %d out of range", src)
+		}
+		return int32(src), nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return 0, fmt.Errorf("sqlpb: cannot scan %T into an enum", src)
+	}
+	if n, ok := values[s]; ok {
+		return n, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("sqlpb: unknown enum value %q", s)
+	}
+	return int32(n), nil
+}
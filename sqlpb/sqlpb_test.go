@@ -0,0 +1,133 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package sqlpb
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// color stands in for a generated enum type.
+type color int32
+
+var colorValue = map[string]int32{"RED": 0, "GREEN": 1, "BLUE": 2}
+
+func TestEnumNumber(t *testing.T) {
+	for _, test := range []struct {
+		src  interface{}
+		want int32
+		err  string
+	}{
+		{nil, 0, ""},
+		{int64(2), 2, ""},
+		{"GREEN", 1, ""},
+		{[]byte("BLUE"), 2, ""},
+		{"7", 7, ""},
+		{int64(1) << 40, 0, "out of range"},
+		{"PURPLE", 0, `unknown enum value "PURPLE"`},
+		{2.0, 0, "cannot scan float64"},
+	} {
+		got, err := EnumNumber(test.src, colorValue)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("EnumNumber(%#v): got error %v, want %s", test.src, err, test.err)
+			}
+			continue
+		}
+		if got != test.want || err != nil {
+			t.Errorf("EnumNumber(%#v) = %d, %v, want %d", test.src, got, err, test.want)
+		}
+	}
+}
+
+func TestEnum(t *testing.T) {
+	var c color
+	col := Enum(&c, colorValue)
+	if err := col.Scan("BLUE"); err != nil || c != 2 {
+		t.Errorf("after Scan(BLUE): %d, %v", c, err)
+	}
+	if v, err := col.Value(); v != int64(2) || err != nil {
+		t.Errorf("Value() = %v, %v, want 2", v, err)
+	}
+
+	// As in proto2, where enum fields are pointers.
+	var p *color
+	col = Enum(&p, colorValue)
+	if v, err := col.Value(); v != nil || err != nil {
+		t.Errorf("Value() of nil = %v, %v, want nil", v, err)
+	}
+	if err := col.Scan(int64(1)); err != nil || p == nil || *p != 1 {
+		t.Errorf("after Scan(1): %v, %v", p, err)
+	}
+	if err := col.Scan(nil); err != nil || p != nil {
+		t.Errorf("after Scan(nil): %v, %v", p, err)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	var ts *tspb.Timestamp
+	col := Timestamp(&ts)
+	if v, err := col.Value(); v != nil || err != nil {
+		t.Errorf("Value() of nil = %v, %v, want nil", v, err)
+	}
+	want := time.Date(2017, 7, 14, 2, 40, 0, 5, time.UTC)
+	for _, src := range []interface{}{want, want.Format(time.RFC3339Nano), []byte(want.Format(time.RFC3339Nano))} {
+		ts = nil
+		if err := col.Scan(src); err != nil {
+			t.Errorf("Scan(%#v): %v", src, err)
+			continue
+		}
+		if ts.GetSeconds() != want.Unix() || ts.GetNanos() != 5 {
+			t.Errorf("after Scan(%#v): %v", src, ts)
+		}
+		if v, err := col.Value(); v != driver.Value(want) || err != nil {
+			t.Errorf("Value() = %v, %v, want %v", v, err, want)
+		}
+	}
+	if err := col.Scan(nil); err != nil || ts != nil {
+		t.Errorf("after Scan(nil): %v, %v", ts, err)
+	}
+	if err := col.Scan("yesterday"); err == nil {
+		t.Error("Scan(yesterday) succeeded")
+	}
+	if err := col.Scan(int64(0)); err == nil {
+		t.Error("Scan(0) succeeded")
+	}
+	ts = &tspb.Timestamp{Seconds: -1 << 62}
+	if _, err := col.Value(); err == nil {
+		t.Error("Value() of an invalid Timestamp succeeded")
+	}
+}
@@ -0,0 +1,246 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package toggle holds the switches that code generated by the carno plugin
+consults at runtime before running optional middleware, such as metrics,
+tracing, logging and caching. Operators can turn a feature on or off for
+every service, for one service or for one method, while the program runs
+and without regenerating code.
+
+A switch is named by a feature and a scope. The scope is empty for every
+service, a carno service name such as "demo.users@UserService", or a method
+name such as "demo.users@UserService/GetUser". The most specific switch
+that is set decides; a feature with no switches set is on.
+
+Switches can be set in code with Set, or all at once from a spec such as
+
+	logging=off,tracing:demo.users@UserService=off,logging:demo.users@UserService/Login=on
+
+read from the CARNO_TOGGLES environment variable when the program starts,
+or sent to the registry's HTTP handler.
+*/
+package toggle
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Features switched by generated middleware.
+const (
+	Metrics = "metrics"
+	Tracing = "tracing"
+	Logging = "logging"
+	Caching = "caching"
+)
+
+// MaxSpecBytes is the longest spec ServeHTTP reads from a request body.
+const MaxSpecBytes = 64 << 10
+
+// A Registry is a set of switches. It is safe for concurrent use, and
+// Enabled does not block while switches change. The zero value is a
+// Registry with no switches set.
+type Registry struct {
+	mu       sync.Mutex   // serializes writers
+	switches atomic.Value // map[string]bool, keyed by feature:scope; never mutated
+}
+
+// New returns a Registry with no switches set.
+func New() *Registry {
+	r := new(Registry)
+	r.switches.Store(map[string]bool{})
+	return r
+}
+
+func key(feature, scope string) string { return feature + ":" + scope }
+
+// load returns the switches, which are nil if none were ever set.
+func (r *Registry) load() map[string]bool {
+	m, _ := r.switches.Load().(map[string]bool)
+	return m
+}
+
+// Enabled reports whether feature is on for method, a carno method name
+// of the form "pkg@Service/Method".
+func (r *Registry) Enabled(feature, method string) bool {
+	m := r.load()
+	if len(m) == 0 {
+		return true
+	}
+	if on, ok := m[key(feature, method)]; ok {
+		return on
+	}
+	if i := strings.LastIndex(method, "/"); i >= 0 {
+		if on, ok := m[key(feature, method[:i])]; ok {
+			return on
+		}
+	}
+	if on, ok := m[key(feature, "")]; ok {
+		return on
+	}
+	return true
+}
+
+// update replaces the switches with a modified copy.
+func (r *Registry) update(f func(m map[string]bool)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old := r.load()
+	m := make(map[string]bool, len(old)+1)
+	for k, v := range old {
+		m[k] = v
+	}
+	f(m)
+	r.switches.Store(m)
+}
+
+// Set turns feature on or off in scope.
+func (r *Registry) Set(feature, scope string, on bool) {
+	r.update(func(m map[string]bool) { m[key(feature, scope)] = on })
+}
+
+// Clear removes the switch for feature in scope, so that a less specific
+// one decides.
+func (r *Registry) Clear(feature, scope string) {
+	r.update(func(m map[string]bool) { delete(m, key(feature, scope)) })
+}
+
+// Load replaces all switches with those in spec, a comma-separated list of
+// feature[:scope]=on|off entries. If spec is invalid, the switches are left
+// unchanged.
+func (r *Registry) Load(spec string) error {
+	m, err := parse(spec)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.switches.Store(m)
+	r.mu.Unlock()
+	return nil
+}
+
+func parse(spec string) (map[string]bool, error) {
+	m := make(map[string]bool)
+	for _, e := range strings.Split(spec, ",") {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		i := strings.Index(e, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("toggle: missing =on or =off in %q", e)
+		}
+		name, value := e[:i], e[i+1:]
+		feature, scope := name, ""
+		if j := strings.Index(name, ":"); j >= 0 {
+			feature, scope = name[:j], name[j+1:]
+		}
+		if feature == "" {
+			return nil, fmt.Errorf("toggle: missing feature in %q", e)
+		}
+		switch value {
+		case "on":
+			m[key(feature, scope)] = true
+		case "off":
+			m[key(feature, scope)] = false
+		default:
+			return nil, fmt.Errorf("toggle: bad value %q in %q, want on or off", value, e)
+		}
+	}
+	return m, nil
+}
+
+// String returns the switches that are set, in the format Load accepts.
+func (r *Registry) String() string {
+	m := r.load()
+	var entries []string
+	for k, on := range m {
+		name := strings.TrimSuffix(k, ":")
+		if on {
+			entries = append(entries, name+"=on")
+		} else {
+			entries = append(entries, name+"=off")
+		}
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// ServeHTTP lets operators inspect and change the switches. GET returns
+// the current switches, and POST or PUT replaces them with the spec in the
+// request body, which may be at most MaxSpecBytes long.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case "GET", "HEAD":
+	case "POST", "PUT":
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, req.Body, MaxSpecBytes))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := r.Load(string(body)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintln(w, r.String())
+}
+
+// Default is the registry consulted by generated code. It starts with the
+// switches in the CARNO_TOGGLES environment variable, if it is valid.
+var Default = New()
+
+func init() {
+	if spec := os.Getenv("CARNO_TOGGLES"); spec != "" {
+		if err := Default.Load(spec); err != nil {
+			log.Printf("ignoring CARNO_TOGGLES: %v", err)
+		}
+	}
+}
+
+// Enabled reports whether feature is on for method in the Default registry.
+func Enabled(feature, method string) bool { return Default.Enabled(feature, method) }
+
+// Set turns feature on or off in scope in the Default registry.
+func Set(feature, scope string, on bool) { Default.Set(feature, scope, on) }
@@ -0,0 +1,141 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package toggle
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	getUser = "demo.users@UserService/GetUser"
+	login   = "demo.users@UserService/Login"
+	purge   = "demo.users@Admin/Purge"
+)
+
+func TestEnabled(t *testing.T) {
+	r := New()
+	if !r.Enabled(Logging, getUser) {
+		t.Error("features should be on by default")
+	}
+
+	r.Set(Logging, "", false)
+	r.Set(Logging, "demo.users@UserService", true)
+	r.Set(Logging, login, false)
+	tests := []struct {
+		feature, method string
+		want            bool
+	}{
+		{Logging, getUser, true}, // service switch
+		{Logging, login, false},  // method switch
+		{Logging, purge, false},  // global switch
+		{Metrics, purge, true},   // other features are unaffected
+	}
+	for _, tc := range tests {
+		if got := r.Enabled(tc.feature, tc.method); got != tc.want {
+			t.Errorf("Enabled(%q, %q) = %v, want %v", tc.feature, tc.method, got, tc.want)
+		}
+	}
+
+	r.Clear(Logging, login)
+	if !r.Enabled(Logging, login) {
+		t.Error("after Clear, the service switch should decide")
+	}
+}
+
+func TestZeroRegistry(t *testing.T) {
+	var r Registry
+	if !r.Enabled(Logging, getUser) || r.String() != "" {
+		t.Errorf("zero Registry: Enabled = false or String() = %q", r.String())
+	}
+	r.Set(Logging, "", false)
+	if r.Enabled(Logging, getUser) {
+		t.Error("logging still on after Set")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	r := New()
+	const spec = "logging=off, tracing:demo.users@UserService=off,logging:" + login + "=on"
+	if err := r.Load(spec); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if r.Enabled(Logging, getUser) || !r.Enabled(Logging, login) || r.Enabled(Tracing, getUser) || !r.Enabled(Tracing, purge) {
+		t.Errorf("switches after Load(%q) = %s", spec, r)
+	}
+	want := "logging:" + login + "=on,logging=off,tracing:demo.users@UserService=off"
+	if got := r.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	for _, bad := range []string{"logging", "logging=maybe", ":x=on"} {
+		if err := r.Load(bad); err == nil {
+			t.Errorf("Load(%q) succeeded, want error", bad)
+		}
+	}
+	if got := r.String(); got != want {
+		t.Errorf("after bad Load, String() = %q, want %q", got, want)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	r := New()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/toggles", strings.NewReader("caching=off")))
+	if w.Code != http.StatusOK || strings.TrimSpace(w.Body.String()) != "caching=off" {
+		t.Errorf("POST: got %d %q", w.Code, w.Body.String())
+	}
+	if r.Enabled(Caching, getUser) {
+		t.Error("caching still on after POST")
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/toggles", strings.NewReader("caching")))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bad POST: got %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	w = httptest.NewRecorder()
+	long := strings.Repeat("caching=on,", MaxSpecBytes/len("caching=on,")+1)
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/toggles", strings.NewReader(long)))
+	if w.Code != http.StatusBadRequest || r.Enabled(Caching, getUser) {
+		t.Errorf("POST of %d bytes: got %d, want %d and no change", len(long), w.Code, http.StatusBadRequest)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("DELETE", "/toggles", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("DELETE: got %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,103 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// zstdpb-train trains a zstd dictionary for one message type from sample
+// messages, for use with package zstdpb.
+//
+// Usage:
+//
+//	zstdpb-train -name my.pkg.Request -o my.pkg.Request.dict [-size 16384] [-delimited] files...
+//
+// By default each file holds one marshaled message. With -delimited, each
+// file holds a stream of messages, each preceded by its length as a varint,
+// as written by proto.Buffer.EncodeMessage.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/zstdpb"
+)
+
+var (
+	name      = flag.String("name", "", "full name of the message type the samples hold")
+	out       = flag.String("o", "", "file to write the dictionary to")
+	size      = flag.Int("size", zstdpb.DefaultMaxDictSize, "maximum dictionary size in bytes")
+	delimited = flag.Bool("delimited", false, "files hold length-delimited streams of messages")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("zstdpb-train: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: zstdpb-train -name type -o file [flags] files...\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+	if *name == "" || *out == "" || flag.NArg() == 0 {
+		flag.Usage()
+	}
+
+	var samples [][]byte
+	for _, fn := range flag.Args() {
+		b, err := ioutil.ReadFile(fn)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !*delimited {
+			samples = append(samples, b)
+			continue
+		}
+		for len(b) > 0 {
+			l, n := proto.DecodeVarint(b)
+			if n == 0 || uint64(len(b)-n) < l {
+				log.Fatalf("%s: truncated message", fn)
+			}
+			samples = append(samples, b[n:n+int(l)])
+			b = b[n+int(l):]
+		}
+	}
+
+	d, err := zstdpb.TrainRaw(*name, samples, *size)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, d, 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote %d-byte dictionary for %s from %d samples", len(d), *name, len(samples))
+}
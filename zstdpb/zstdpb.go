@@ -0,0 +1,317 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package zstdpb compresses marshaled protocol buffers with zstd, using a
+dictionary trained for each message type.
+
+Small messages of the same type share most of their content: field tags,
+enum values and frequently repeated strings. A dictionary trained on sample
+messages lets zstd exploit that even when each message is compressed on its
+own, which ordinary compression of a few hundred bytes cannot.
+
+Dictionaries are trained with Train, or with the zstdpb-train command, and
+registered under the full name of the message type they were trained on:
+
+	d, err := ioutil.ReadFile("my.pkg.Request.dict")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := zstdpb.Register("my.pkg.Request", d); err != nil {
+		log.Fatal(err)
+	}
+
+Marshal then compresses each message with the dictionary registered for its
+type, or without a dictionary if there is none. Unmarshal finds the right
+dictionary from the ID stored in the compressed frame, so a reader needs the
+same dictionaries registered, but not to know which one was used.
+
+This package depends on github.com/klauspost/compress.
+*/
+package zstdpb
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/klauspost/compress/dict"
+	"github.com/klauspost/compress/zstd"
+)
+
+// DefaultMaxDictSize is the dictionary size Train uses when given a size of zero.
+const DefaultMaxDictSize = 16 << 10
+
+// DefaultMaxSize is the largest decompressed message Unmarshal accepts
+// unless a Registry's MaxSize says otherwise.
+const DefaultMaxSize = 64 << 20
+
+// DictID returns the zstd dictionary ID that Train assigns to dictionaries for
+// the named message type. Deriving the ID from the name keeps it stable when a
+// dictionary is retrained. The result avoids the ranges zstd reserves.
+func DictID(name string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	// Valid unreserved IDs are [32768, 1<<31).
+	return 32768 + h.Sum32()%(1<<31-32768)
+}
+
+// Train builds a zstd dictionary of at most maxSize bytes from sample messages,
+// which must all be of the same type. A maxSize of zero means DefaultMaxDictSize.
+// More and more varied samples give better dictionaries; a few thousand
+// typical messages is a good start.
+func Train(samples []proto.Message, maxSize int) ([]byte, error) {
+	if len(samples) == 0 {
+		return nil, errors.New("zstdpb: no samples")
+	}
+	if maxSize == 0 {
+		maxSize = DefaultMaxDictSize
+	}
+	name := proto.MessageName(samples[0])
+	if name == "" {
+		return nil, fmt.Errorf("zstdpb: unregistered message type %T", samples[0])
+	}
+	input := make([][]byte, len(samples))
+	for i, m := range samples {
+		if n := proto.MessageName(m); n != name {
+			return nil, fmt.Errorf("zstdpb: sample %d is a %s, want %s", i, n, name)
+		}
+		b, err := proto.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		input[i] = b
+	}
+	return TrainRaw(name, input, maxSize)
+}
+
+// TrainRaw is like Train, but takes samples that are already marshaled
+// messages of the named type.
+func TrainRaw(name string, samples [][]byte, maxSize int) ([]byte, error) {
+	if maxSize == 0 {
+		maxSize = DefaultMaxDictSize
+	}
+	d, err := dict.BuildZstdDict(samples, dict.Options{
+		MaxDictSize: maxSize,
+		HashBytes:   6,
+		ZstdDictID:  DictID(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("zstdpb: training dictionary for %s: %v", name, err)
+	}
+	return d, nil
+}
+
+// A Registry holds the dictionaries used to compress each message type.
+// It is safe for concurrent use. Its encoders and decoders are used with
+// mu read-locked, so that Register can close the ones it replaces.
+type Registry struct {
+	// MaxSize is the largest decompressed message, in bytes, that
+	// Unmarshal accepts. Frames that decompress to more are rejected
+	// without decompressing them in full, protecting readers of untrusted
+	// input. If it is zero, DefaultMaxSize is used. It must be set before
+	// the Registry is used.
+	MaxSize int
+
+	mu    sync.RWMutex
+	dicts map[string][]byte        // by message name
+	ids   map[uint32]string        // message name by dictionary ID
+	enc   map[string]*zstd.Encoder // by message name
+	plain *zstd.Encoder            // for types without a dictionary
+	dec   *zstd.Decoder            // knows every registered dictionary
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		dicts: make(map[string][]byte),
+		ids:   make(map[uint32]string),
+		enc:   make(map[string]*zstd.Encoder),
+	}
+}
+
+// Register installs the dictionary used for messages with the given full name,
+// replacing any previous one. Dictionaries for different types must have
+// different IDs.
+func (r *Registry) Register(name string, d []byte) error {
+	info, err := zstd.InspectDictionary(d)
+	if err != nil {
+		return fmt.Errorf("zstdpb: dictionary for %s: %v", name, err)
+	}
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderDict(d))
+	if err != nil {
+		return fmt.Errorf("zstdpb: dictionary for %s: %v", name, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if other, ok := r.ids[info.ID()]; ok && other != name {
+		enc.Close()
+		return fmt.Errorf("zstdpb: dictionary for %s has the same ID (%d) as the one for %s", name, info.ID(), other)
+	}
+	if old, ok := r.dicts[name]; ok {
+		if oldInfo, err := zstd.InspectDictionary(old); err == nil {
+			delete(r.ids, oldInfo.ID())
+		}
+	}
+	if old := r.enc[name]; old != nil {
+		old.Close()
+	}
+	if r.dec != nil {
+		r.dec.Close()
+		r.dec = nil // rebuilt with the new dictionary on next use
+	}
+	r.dicts[name] = d
+	r.ids[info.ID()] = name
+	r.enc[name] = enc
+	return nil
+}
+
+// withEncoder calls f with the encoder for the named message type, with
+// r.mu read-locked.
+func (r *Registry) withEncoder(name string, f func(*zstd.Encoder)) error {
+	for {
+		r.mu.RLock()
+		enc := r.enc[name]
+		if enc == nil {
+			enc = r.plain
+		}
+		if enc != nil {
+			f(enc)
+			r.mu.RUnlock()
+			return nil
+		}
+		r.mu.RUnlock()
+
+		r.mu.Lock()
+		if r.plain == nil {
+			plain, err := zstd.NewWriter(nil)
+			if err != nil {
+				r.mu.Unlock()
+				return err
+			}
+			r.plain = plain
+		}
+		r.mu.Unlock()
+	}
+}
+
+// withDecoder calls f with a decoder that knows every registered
+// dictionary, with r.mu read-locked.
+func (r *Registry) withDecoder(f func(*zstd.Decoder) error) error {
+	for {
+		r.mu.RLock()
+		if dec := r.dec; dec != nil {
+			err := f(dec)
+			r.mu.RUnlock()
+			return err
+		}
+		r.mu.RUnlock()
+		if err := r.newDecoder(); err != nil {
+			return err
+		}
+	}
+}
+
+// newDecoder builds r.dec if there is none.
+func (r *Registry) newDecoder() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.dec == nil {
+		var dicts [][]byte
+		for _, d := range r.dicts {
+			dicts = append(dicts, d)
+		}
+		max := r.MaxSize
+		if max <= 0 {
+			max = DefaultMaxSize
+		}
+		dec, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dicts...), zstd.WithDecoderMaxMemory(uint64(max)))
+		if err != nil {
+			return err
+		}
+		r.dec = dec
+	}
+	return nil
+}
+
+// Marshal returns the compressed wire-format encoding of m.
+func (r *Registry) Marshal(m proto.Message) ([]byte, error) {
+	return r.MarshalAppend(nil, m)
+}
+
+// MarshalAppend appends the compressed wire-format encoding of m to b.
+func (r *Registry) MarshalAppend(b []byte, m proto.Message) ([]byte, error) {
+	raw, err := proto.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	err = r.withEncoder(proto.MessageName(m), func(enc *zstd.Encoder) {
+		b = enc.EncodeAll(raw, b)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Unmarshal decompresses b, as produced by Marshal, and parses it into m.
+// It fails if b decompresses to more than MaxSize bytes.
+func (r *Registry) Unmarshal(b []byte, m proto.Message) error {
+	var raw []byte
+	err := r.withDecoder(func(dec *zstd.Decoder) error {
+		var err error
+		raw, err = dec.DecodeAll(b, nil)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("zstdpb: %v", err)
+	}
+	return proto.Unmarshal(raw, m)
+}
+
+var defaultRegistry = NewRegistry()
+
+// Register installs a dictionary in the default registry.
+func Register(name string, d []byte) error { return defaultRegistry.Register(name, d) }
+
+// Marshal compresses m using the default registry.
+func Marshal(m proto.Message) ([]byte, error) { return defaultRegistry.Marshal(m) }
+
+// MarshalAppend compresses m using the default registry, appending to b.
+func MarshalAppend(b []byte, m proto.Message) ([]byte, error) {
+	return defaultRegistry.MarshalAppend(b, m)
+}
+
+// Unmarshal decompresses b using the default registry and parses it into m.
+func Unmarshal(b []byte, m proto.Message) error { return defaultRegistry.Unmarshal(b, m) }
@@ -0,0 +1,223 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2017 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package zstdpb
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+	"github.com/klauspost/compress/zstd"
+)
+
+var pets = []string{"horsey", "bunny", "kitty", "doggie", "parrot"}
+
+func sample(i int) *pb.MyMessage {
+	return &pb.MyMessage{
+		Count: proto.Int32(int32(i)),
+		Name:  proto.String(fmt.Sprintf("user-%d@example.com", i*7919)),
+		Quote: proto.String("the quick brown fox jumps over the lazy dog"),
+		Pet:   pets[:1+i%len(pets)],
+		Inner: &pb.InnerMessage{
+			Host:      proto.String(fmt.Sprintf("host-%d.internal.example.com", i%17)),
+			Port:      proto.Int32(int32(8000 + i%4)),
+			Connected: proto.Bool(i%2 == 0),
+		},
+		Bikeshed: pb.MyMessage_BLUE.Enum(),
+	}
+}
+
+func samples(n int) []proto.Message {
+	ms := make([]proto.Message, n)
+	for i := range ms {
+		ms[i] = sample(i)
+	}
+	return ms
+}
+
+var (
+	trainOnce sync.Once
+	testDict  []byte
+	dictErr   error
+)
+
+func trained(t *testing.T) []byte {
+	trainOnce.Do(func() { testDict, dictErr = Train(samples(500), 4<<10) })
+	if dictErr != nil {
+		t.Fatalf("Train: %v", dictErr)
+	}
+	return testDict
+}
+
+func TestRoundTrip(t *testing.T) {
+	d := trained(t)
+
+	r := NewRegistry()
+	if err := r.Register("testdata.MyMessage", d); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	plain := NewRegistry()
+
+	m := sample(123456)
+	b, err := r.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	pb0, err := plain.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal without dictionary: %v", err)
+	}
+	if len(b) >= len(pb0) {
+		t.Errorf("with dictionary: %d bytes, without: %d bytes; want smaller", len(b), len(pb0))
+	}
+
+	got := new(pb.MyMessage)
+	if err := r.Unmarshal(b, got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !proto.Equal(got, m) {
+		t.Errorf("round trip: got %v, want %v", got, m)
+	}
+
+	// Messages of types without a dictionary are compressed without one.
+	other := &pb.InnerMessage{Host: proto.String("localhost")}
+	b, err = r.Marshal(other)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	gotOther := new(pb.InnerMessage)
+	if err := plain.Unmarshal(b, gotOther); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !proto.Equal(gotOther, other) {
+		t.Errorf("round trip: got %v, want %v", gotOther, other)
+	}
+
+	// A reader without the dictionary cannot decode.
+	b, _ = r.Marshal(m)
+	if err := plain.Unmarshal(b, new(pb.MyMessage)); err == nil {
+		t.Error("Unmarshal without dictionary succeeded, want error")
+	}
+}
+
+func TestTrainMixedTypes(t *testing.T) {
+	ms := append(samples(10), &pb.InnerMessage{})
+	if _, err := Train(ms, 0); err == nil {
+		t.Error("Train with mixed types succeeded, want error")
+	}
+}
+
+func TestRegisterDuplicateID(t *testing.T) {
+	d := trained(t)
+	r := NewRegistry()
+	if err := r.Register("testdata.MyMessage", d); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if err := r.Register("testdata.Other", d); err == nil {
+		t.Error("Register with duplicate dictionary ID succeeded, want error")
+	}
+	if err := r.Register("testdata.MyMessage", d); err != nil {
+		t.Errorf("re-Register: %v", err)
+	}
+}
+
+func TestMaxSize(t *testing.T) {
+	// A megabyte of zeros compresses to a few bytes.
+	m := &pb.InnerMessage{Host: proto.String(string(make([]byte, 1<<20)))}
+	b, err := Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if err := NewRegistry().Unmarshal(b, new(pb.InnerMessage)); err != nil {
+		t.Errorf("Unmarshal under DefaultMaxSize: %v", err)
+	}
+	r := NewRegistry()
+	r.MaxSize = 1 << 10
+	if err := r.Unmarshal(b, new(pb.InnerMessage)); err == nil {
+		t.Errorf("Unmarshal of %d compressed bytes over MaxSize succeeded, want error", len(b))
+	}
+}
+
+// Register closes the encoder and decoder it replaces, once calls using
+// them have finished.
+func TestReregister(t *testing.T) {
+	d := trained(t)
+	r := NewRegistry()
+	if err := r.Register("testdata.MyMessage", d); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	b, err := r.Marshal(sample(1))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if _, err := r.Marshal(sample(j)); err != nil {
+					t.Errorf("Marshal: %v", err)
+				}
+				if err := r.Unmarshal(b, new(pb.MyMessage)); err != nil {
+					t.Errorf("Unmarshal: %v", err)
+				}
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		if err := r.Register("testdata.MyMessage", d); err != nil {
+			t.Errorf("re-Register: %v", err)
+		}
+	}
+	wg.Wait()
+
+	r.mu.RLock()
+	enc, dec := r.enc["testdata.MyMessage"], r.dec
+	r.mu.RUnlock()
+	if dec == nil {
+		r.Unmarshal(b, new(pb.MyMessage))
+		dec = r.dec
+	}
+	if err := r.Register("testdata.MyMessage", d); err != nil {
+		t.Fatalf("re-Register: %v", err)
+	}
+	if _, err := dec.DecodeAll(b, nil); err != zstd.ErrDecoderClosed {
+		t.Errorf("replaced decoder returned %v, want %v", err, zstd.ErrDecoderClosed)
+	}
+	if r.enc["testdata.MyMessage"] == enc {
+		t.Error("encoder was not replaced")
+	}
+}